@@ -1,23 +1,61 @@
 package main
 
 import (
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
 	"flag"
 	"fmt"
+	"net"
 	"os"
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 
 	"net/http"
 	_ "net/http/pprof"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/anonymizer"
+	"github.com/sbezverk/gobmp/pkg/api"
+	"github.com/sbezverk/gobmp/pkg/asgraph"
+	"github.com/sbezverk/gobmp/pkg/asnotation"
+	"github.com/sbezverk/gobmp/pkg/avro"
+	"github.com/sbezverk/gobmp/pkg/baseline"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/cbor"
+	"github.com/sbezverk/gobmp/pkg/community"
+	"github.com/sbezverk/gobmp/pkg/config"
+	"github.com/sbezverk/gobmp/pkg/dedup"
 	"github.com/sbezverk/gobmp/pkg/dumper"
+	"github.com/sbezverk/gobmp/pkg/epe"
+	"github.com/sbezverk/gobmp/pkg/events"
+	"github.com/sbezverk/gobmp/pkg/failover"
 	"github.com/sbezverk/gobmp/pkg/filer"
+	"github.com/sbezverk/gobmp/pkg/flap"
 	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+	"github.com/sbezverk/gobmp/pkg/health"
 	"github.com/sbezverk/gobmp/pkg/kafka"
+	"github.com/sbezverk/gobmp/pkg/limits"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/message"
 	"github.com/sbezverk/gobmp/pkg/nats"
+	"github.com/sbezverk/gobmp/pkg/nexthop"
+	"github.com/sbezverk/gobmp/pkg/peergroup"
+	"github.com/sbezverk/gobmp/pkg/postpolicy"
+	"github.com/sbezverk/gobmp/pkg/protobuf"
 	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/report"
+	"github.com/sbezverk/gobmp/pkg/retention"
+	"github.com/sbezverk/gobmp/pkg/rib"
+	"github.com/sbezverk/gobmp/pkg/scripting"
+	"github.com/sbezverk/gobmp/pkg/srvalidator"
+	"github.com/sbezverk/gobmp/pkg/storm"
+	"github.com/sbezverk/gobmp/pkg/systemd"
+	"github.com/sbezverk/gobmp/pkg/tracing"
+	"github.com/sbezverk/gobmp/pkg/transformer"
+	"github.com/sbezverk/gobmp/pkg/verbosity"
 	"github.com/sbezverk/tools"
 )
 
@@ -31,6 +69,110 @@ var (
 	splitAF   string
 	dump      string
 	file      string
+	jsonlDir  string
+	jsonlSize int64
+	jsonlIntv string
+	jsonlKeep int
+	jsonlGzip string
+	anonymize string
+	anonKey   string
+	anonStrip string
+	apiPort   int
+	apiTenant string
+	apiCert   string
+	apiKey    string
+	apiCA     string
+	capDir    string
+	transform string
+	scripts   string
+	rcvBuf    int
+	keepAlive string
+	noDelay   string
+	reusePort string
+	dscp      int
+	tcpAuth   string
+	repPeriod string
+	repDir    string
+	repFormat string
+	repPub    string
+	repTop    int
+	rsIv      string
+	rsWindow  string
+	rsTop     int
+	asGraph   string
+	asGraphIv string
+	nhCheck   string
+	srCheck   string
+	dedupMode string
+	routeAge  string
+	wdAttrs   string
+	orgWindow string
+	orgFile   string
+	stormThr  int
+	stormWin  string
+	churnThr  int
+	churnIv   string
+	epeJoin   string
+	retain    string
+	eventsIv  string
+	tsSource  string
+	tsSkew    string
+	tsZero    string
+	lagGroups string
+	lagIv     string
+	lagThr    int64
+	jrnDir    string
+	jrnRet    string
+	rplFile   string
+	rplPort   int
+	svcCmd    string
+	encoding  string
+	asNotn    string
+	commDict  string
+	peerGrps  string
+	natsStrm  string
+	mirParse  string
+	rawUpd    string
+	limitsF   string
+	peerHdrs  int
+	postPol   string
+	maxProcs  int
+	prsWork   int
+	queueDep  int
+	kafkaBuf  int
+	kafkaFly  int
+	foSrv     string
+	foLatency string
+	foErrRate float64
+	foWindow  string
+	foBack    string
+	dedupWin  string
+	dedupSize int
+	dedupIgn  string
+	otlpURL   string
+	tlsCert   string
+	tlsKey    string
+	tlsCA     string
+	tlsRtrs   string
+	actRtrs   string
+	actBack   string
+	lstFile   string
+	quicAddr  string
+	otlpHdrs  string
+	otlpRatio float64
+	otlpName  string
+	cfgFile   string
+	saslMech  string
+	saslUser  string
+	saslPass  string
+	kafkaTLS  string
+	kafkaCA   string
+	kafkaCert string
+	kafkaKey  string
+	topicTmpl string
+	partKeys  string
+	topicPart int
+	schemaReg string
 )
 
 func init() {
@@ -38,53 +180,504 @@ func init() {
 	flag.IntVar(&srcPort, "source-port", 5000, "port exposed to outside")
 	flag.IntVar(&dstPort, "destination-port", 5050, "port openBMP is listening")
 	flag.StringVar(&kafkaSrv, "kafka-server", "", "URL to access Kafka server")
+	flag.StringVar(&lagGroups, "kafka-lag-groups", "", "Comma separated list of Kafka consumer groups whose lag on gobmp topics is monitored and exposed by the API server")
+	flag.StringVar(&lagIv, "kafka-lag-interval", "30s", "Period between polls of Kafka consumer groups lag")
+	flag.Int64Var(&lagThr, "kafka-lag-threshold", 0, "Lag in messages of a Kafka consumer group logged as a warning, 0 (default) logs only consumer groups which stopped consuming")
+	flag.StringVar(&saslMech, "kafka-sasl-mechanism", "", "SASL mechanism authenticating gobmp to Kafka brokers, \"PLAIN\", \"SCRAM-SHA-256\" or \"SCRAM-SHA-512\", SASL is disabled when not specified")
+	flag.StringVar(&saslUser, "kafka-sasl-user", "", "User name of SASL authentication to Kafka brokers")
+	flag.StringVar(&saslPass, "kafka-sasl-password-file", "", "Full path and file name of the file with the password of SASL authentication to Kafka brokers")
+	flag.StringVar(&kafkaTLS, "kafka-tls", "false", "When set \"true\", connections to Kafka brokers use TLS, implied by kafka-tls-ca and kafka-tls-cert")
+	flag.StringVar(&kafkaCA, "kafka-tls-ca", "", "Full path and file name of CA certificates verifying certificates of Kafka brokers, system CAs verify brokers when not specified")
+	flag.StringVar(&kafkaCert, "kafka-tls-cert", "", "Full path and file name of client certificate presented to Kafka brokers, requires kafka-tls-key")
+	flag.StringVar(&kafkaKey, "kafka-tls-key", "", "Full path and file name of private key of kafka-tls-cert")
+	flag.StringVar(&topicTmpl, "kafka-topic-template", "", "Template of Kafka topics sharding messages by router or peer, for example \"{type}.{router_hash}\" or \"{topic}.{peer_asn}\", placeholders are {topic}, {type}, {router_hash}, {router_ip}, {peer_hash}, {peer_ip} and {peer_asn}, default topics are used when not specified")
+	flag.StringVar(&partKeys, "kafka-partition-keys", "", "Comma separated list of type=key partition keys of Kafka messages by message type, keys are \"hash\" (default), \"router_hash\", \"peer_ip\", \"prefix\" or \"rd\", type \"*\" applies to types not listed")
+	flag.IntVar(&topicPart, "kafka-topic-partitions", 0, "Number of partitions of Kafka topics created by gobmp, 0 (default) creates topics with 1 partition")
 	flag.StringVar(&natsSrv, "nats-server", "", "URL to access NATS server")
+	flag.StringVar(&natsStrm, "nats-stream", "gobmp", "Name of NATS JetStream stream capturing subjects of published messages, the stream is created when it does not exist, empty name disables creation of the stream")
+	flag.StringVar(&foSrv, "failover-server", "", "URL of the backup Kafka server, or NATS server when \"dump=nats\", messages are switched to when delivery latency or error rate of kafka-server or nats-server crosses failover thresholds, failover is disabled when not specified")
+	flag.StringVar(&foLatency, "failover-latency", "1s", "Average delivery latency of messages within failover-window above which messages are switched to the other server, \"0\" disables the latency threshold")
+	flag.Float64Var(&foErrRate, "failover-error-rate", 0.05, "Fraction of messages failed to deliver within failover-window above which messages are switched to the other server, 0 disables the error rate threshold")
+	flag.StringVar(&foWindow, "failover-window", "10s", "Period delivery latency and error rate of messages are measured over before they are compared with failover thresholds")
+	flag.StringVar(&foBack, "failover-failback", "10m", "Period after which messages are switched back from the backup to the primary server, \"0\" keeps messages on the backup server until it crosses failover thresholds")
 	flag.StringVar(&intercept, "intercept", "false", "When intercept set \"true\", all incomming BMP messges will be copied to TCP port specified by destination-port, otherwise received BMP messages will be published to Kafka.")
 	flag.StringVar(&splitAF, "split-af", "true", "When set \"true\" (default) ipv4 and ipv6 will be published in separate topics. if set \"false\" the same topic will be used for both address families.")
 	flag.IntVar(&perfPort, "performance-port", 56767, "port used for performance debugging")
-	flag.StringVar(&dump, "dump", "", "Dump resulting messages to file when \"dump=file\", to standard output when \"dump=console\" or to NATS when \"dump=nats\"")
+	flag.StringVar(&dump, "dump", "", "Dump resulting messages to file when \"dump=file\", to rotating files of each message type in jsonl-dir when \"dump=jsonl\", to standard output when \"dump=console\" or to NATS when \"dump=nats\"")
+	flag.StringVar(&asNotn, "as-notation", "asplain", "Notation of AS numbers in published messages, \"asplain\" (default) for decimal numbers, \"asdot\" for strings with 4 bytes AS numbers as two 16 bits numbers separated by a dot, \"both\" adds asdot form to fields suffixed by _asdot")
+	flag.StringVar(&encoding, "encoding", "json", "Encoding of published messages, \"json\" (default), \"cbor\" for CBOR maps with the same fields as json messages, \"avro\" for Avro records of schemas registered with schema-registry or \"protobuf\" for protobuf messages of pkg/protobuf/messagepb/gobmp.proto")
+	flag.StringVar(&schemaReg, "schema-registry", "", "URL of Confluent Schema Registry Avro schemas of messages are registered with when \"encoding=avro\", for example \"http://registry:8081\", credentials of the URL are sent with HTTP basic authentication")
 	flag.StringVar(&file, "msg-file", "/tmp/messages.json", "Full path anf file name to store messages when \"dump=file\"")
+	flag.StringVar(&jsonlDir, "jsonl-dir", "/tmp/gobmp", "Directory where newline-delimited json messages of each message type are written to their own files when \"dump=jsonl\"")
+	flag.Int64Var(&jsonlSize, "jsonl-max-size", 100<<20, "Size in bytes of messages written to a file of jsonl-dir before the file is rotated, 0 disables rotation by size")
+	flag.StringVar(&jsonlIntv, "jsonl-interval", "1h", "Period after which files of jsonl-dir are rotated, \"0\" disables rotation by time")
+	flag.IntVar(&jsonlKeep, "jsonl-max-files", 0, "Number of files of each message type kept in jsonl-dir, oldest files are removed, 0 (default) keeps all files")
+	flag.StringVar(&jsonlGzip, "jsonl-gzip", "false", "When set \"true\", files of jsonl-dir are gzip compressed")
+	flag.StringVar(&anonymize, "anonymize", "false", "When set \"true\", addresses in messages published to all sinks are anonymized with prefix-preserving Crypto-PAn, or a comma separated list of sinks whose messages are anonymized, \"default\" for the publisher of topics without prefix and names of listeners with topic prefixes for publishers of their prefixes.")
+	flag.StringVar(&anonKey, "anonymize-key-file", "", "Full path and file name of the file with 32 bytes anonymization key encoded as 64 hex characters, if not specified a random key is generated.")
+	flag.StringVar(&anonStrip, "anonymize-strip-communities", "true", "When set \"true\" (default) and anonymization is enabled, communities are removed from published messages.")
+	flag.IntVar(&apiPort, "api-port", 0, "port of the API server streaming published messages, 0 (default) disables the API server")
+	flag.StringVar(&apiTenant, "api-tenants-file", "", "Full path and file name of json file with API tenants, their keys and filters")
+	flag.StringVar(&apiCert, "api-tls-cert", "", "Full path and file name of API server certificate, when specified together with api-tls-key, the API server uses TLS")
+	flag.StringVar(&apiKey, "api-tls-key", "", "Full path and file name of API server private key")
+	flag.StringVar(&transform, "transform-file", "", "Full path and file name of json file with transformation rules applied to messages before publishing")
+	flag.StringVar(&peerGrps, "peer-groups-file", "", "Full path and file name of json file with peer groups by AS number, address range or peer name, messages of peers of a group carry peer_group")
+	flag.StringVar(&commDict, "communities-file", "", "Full path and file name of json file with communities dictionary, labels of communities found in messages are added as communities_annotated")
+	flag.StringVar(&scripts, "scripts-file", "", "Full path and file name of json file listing Starlark scripts invoked for messages before publishing")
+	flag.StringVar(&capDir, "capture-dir", "", "Directory where hex dump files requested over the admin API are created, hex dump to files is disabled when not specified")
+	flag.IntVar(&rcvBuf, "tcp-receive-buffer", 0, "Size in bytes of BMP sessions socket receive buffer (SO_RCVBUF), 0 (default) keeps the kernel default")
+	flag.StringVar(&keepAlive, "tcp-keepalive", "15s", "Period between TCP keepalive probes of BMP sessions, \"0\" disables keepalive")
+	flag.StringVar(&noDelay, "tcp-nodelay", "true", "When set \"true\" (default), TCP_NODELAY is set on BMP sessions")
+	flag.StringVar(&reusePort, "tcp-reuseport", "false", "When set \"true\", SO_REUSEPORT is set on the listener, so multiple gobmp processes can listen on the same port")
+	flag.IntVar(&dscp, "tcp-dscp", 0, "DSCP value (0-63) marking packets sent to routers on BMP sessions, 0 (default) keeps the default marking")
+	flag.StringVar(&tlsCert, "tls-cert", "", "Full path and file name of BMP server certificate, when specified together with tls-key, BMP sessions use TLS")
+	flag.StringVar(&tlsKey, "tls-key", "", "Full path and file name of BMP server private key")
+	flag.StringVar(&tlsCA, "tls-client-ca", "", "Full path and file name of CA certificates verifying certificates of routers, when specified, routers must present certificates signed by the CAs")
+	flag.StringVar(&tlsRtrs, "tls-routers-file", "", "Full path and file name of json file with Common Names of certificates allowed for routers by address prefixes, requires tls-client-ca")
+	flag.StringVar(&tcpAuth, "tcp-auth-file", "", "Full path and file name of json file with TCP MD5 and TCP-AO keys authenticating BMP sessions of routers")
+	flag.StringVar(&repPeriod, "report-interval", "0", "Period covered by reports of peers availability and prefixes churn, for example \"24h\", \"0\" (default) disables reports")
+	flag.StringVar(&repDir, "report-dir", "", "Directory where report files are written, report files are not written when not specified")
+	flag.StringVar(&repFormat, "report-format", "json", "Format of report files, \"json\" (default) or \"csv\"")
+	flag.StringVar(&repPub, "report-publish", "false", "When set \"true\", reports are published to the report topic")
+	flag.IntVar(&repTop, "report-top-prefixes", 10, "Number of the most churning prefixes included in reports")
+	flag.StringVar(&rsIv, "route-stats-interval", "0", "Period between route_stats messages of announcements, withdrawals and flaps of routes per peer and per prefix, for example \"1m\", \"0\" (default) disables route statistics")
+	flag.StringVar(&rsWindow, "route-stats-window", "1h", "Rolling window counters of route_stats messages cover, a multiple of route-stats-interval")
+	flag.IntVar(&rsTop, "route-stats-top-prefixes", 10, "Number of the most flapping prefixes included in route_stats messages")
+	flag.StringVar(&asGraph, "as-graph", "false", "When set \"true\", the AS-level graph is built from AS paths of unicast prefixes and exposed by the API server")
+	flag.StringVar(&asGraphIv, "as-graph-interval", "1m", "Period between as_graph messages publishing links added to and removed from the AS-level graph, \"0\" disables publishing")
+	flag.StringVar(&nhCheck, "nexthop-check", "false", "When set \"true\", messages of unicast and l3vpn routes with next hop not resolvable in IGP topology received in ls_prefix messages are tagged")
+	flag.StringVar(&srCheck, "srpolicy-check", "false", "When set \"true\", SR Policies segments are validated against SIDs of BGP-LS topology and changes of validation state are published")
+	flag.StringVar(&epeJoin, "epe", "false", "When set \"true\", unicast routes of peers with BGP Peering SIDs advertised in BGP-LS are published with the egress SIDs as epe_prefix messages")
+	flag.StringVar(&dedupMode, "dedup", "", "When set \"mark\", messages of unicast and l3vpn routes of a peer already reported by another router with the same attributes are tagged as duplicate, when set \"suppress\" they are not published")
+	flag.StringVar(&wdAttrs, "enrich-withdrawals", "false", "When set \"true\", withdrawals of unicast and l3vpn routes carry previous_nexthop and previous_base_attrs, the next hop and attributes the route was last advertised with, routes are kept in memory as with route-age")
+	flag.StringVar(&routeAge, "route-age", "false", "When set \"true\", messages of unicast and l3vpn routes carry first_seen and last_changed times of the route, path_hash of its attributes and change, \"new\", \"update\" or \"duplicate\"")
+	flag.StringVar(&orgWindow, "origin-baseline", "0", "Period origin ASes of unicast prefixes are learned before routes with unexpected origin AS are published as origin_anomaly messages, for example \"168h\", \"0\" (default) disables origin baseline")
+	flag.StringVar(&orgFile, "origin-baseline-file", "", "Full path and file name of json file the learned origin baseline is saved to, an existing file is loaded in place of learning")
+	flag.IntVar(&stormThr, "peer-storm-threshold", 0, "Number of peers of a router going down within peer-storm-window summarized in a peer_storm message, 0 (default) disables peer storms")
+	flag.StringVar(&stormWin, "peer-storm-window", "30s", "Period peers of a router go down within of each other to be part of a peer storm, the storm ends when no peer goes down for the period")
+	flag.IntVar(&churnThr, "churn-summary-threshold", 0, "Rate of prefix messages per second of a peer above which its prefix messages are summarized in churn_summary messages every churn-summary-interval, 0 (default) disables churn summaries")
+	flag.StringVar(&churnIv, "churn-summary-interval", "10s", "Period prefix messages of a peer are counted over to compare their rate with churn-summary-threshold and summarized in churn_summary messages")
+	flag.StringVar(&eventsIv, "collector-events", "10s", "Period occurrences of operational problems of the collector are aggregated into collector_event messages with codes and suggested actions, \"0\" disables collector events")
+	flag.StringVar(&dedupWin, "dedup-window", "0", "Period after the published route was last reported within which the same route reported by another router is a duplicate, \"0\" (default) does not limit the period")
+	flag.IntVar(&dedupSize, "dedup-cache-size", 0, "Maximum number of routes kept by deduplication, routes first reported when the cache is full are published without deduplication, 0 (default) does not limit the number of routes")
+	flag.StringVar(&dedupIgn, "dedup-ignore", "", "Comma separated list of attributes routes reported by routers may differ in and still be duplicates, \"med\" and \"communities\"")
+	flag.StringVar(&retain, "state-retention", "0", "Period state of peers down and of routers without BMP session is kept by deduplication, route age, route statistics, reports, AS graph, next hop and SR Policy checks, egress peer engineering and origin baseline, for example \"24h\", \"0\" (default) keeps the state forever")
+	flag.StringVar(&tsSource, "timestamp-source", "peer", "Source of messages timestamps, \"peer\" (default) for Per-Peer Header timestamps, \"collector\" for the time messages are received, \"both\" adds collector_timestamp to Per-Peer Header timestamps")
+	flag.StringVar(&tsSkew, "timestamp-max-skew", "5m", "Difference between Per-Peer Header timestamps and the time messages are received logged as clock skew of the peer, \"0\" disables skew detection")
+	flag.StringVar(&tsZero, "timestamp-zero-collector", "false", "When set \"true\", Per-Peer Header timestamps of 0, time not available, are replaced by the time messages are received")
+	flag.StringVar(&mirParse, "mirror-parse", "", "Comma separated list of types of BGP messages of Route Mirroring messages decoded and published as mirrored_message messages, \"open\", \"update\", \"notification\" or \"keepalive\", mirrored messages are counted per type in the peer table")
+	flag.StringVar(&rawUpd, "raw-updates", "false", "When set \"true\", BGP Update messages of Route Monitoring messages are published as received in raw_update messages in addition to decoded routes")
+	flag.StringVar(&limitsF, "parse-limits-file", "", "Full path and file name of json file with limits of values decoded from BGP messages, numbers of attributes, AS path length, communities and SR Policy segments, limits missing in the file keep their defaults")
+	flag.IntVar(&peerHdrs, "bmp-peer-headers-tlv", 0, "Type of the non-standard TLV of BMP v4 Route Monitoring messages carrying Per-Peer Headers of additional peers of the message, messages carrying the TLV are fanned out into a message per peer, 0 (default) disables the TLV")
+	flag.StringVar(&postPol, "post-policy-topics", "", "Comma separated list of types of messages, or \"all\" for all types of messages of routes, whose Adj-RIB-In post-policy messages are published to separate {type}_post_policy topics, pre-policy messages keep their topics")
+	flag.StringVar(&actRtrs, "active-routers", "", "Comma separated list of host:port addresses of routers or BMP senders gobmp connects to, in addition to accepting BMP sessions")
+	flag.StringVar(&actBack, "active-max-backoff", "1m", "Maximum delay before routers of active-routers are reconnected, the delay starts at 1 second and doubles for every failed connection")
+	flag.StringVar(&quicAddr, "quic-listen", "", "host:port of the experimental listener of BMP sessions over QUIC, one stream per session, requires tls-cert and tls-key, empty (default) disables the listener")
+	flag.StringVar(&lstFile, "listeners-file", "", "Full path and file name of json file with additional listeners of BMP sessions, their addresses, allowed routers, TLS and topic prefixes")
+	flag.StringVar(&jrnDir, "journal-dir", "", "Directory where raw BMP messages of sessions are journaled for replay over the admin API or by replay-file, journaling is disabled when not specified")
+	flag.StringVar(&jrnRet, "journal-retention", "24h", "Period journals of closed sessions are kept after they were last written, \"0\" keeps journals forever")
+	flag.StringVar(&rplFile, "replay-file", "", "Full path and file name of journal of raw BMP messages or of pcap or pcapng capture replayed to the publisher, gobmp exits once messages of the file are published, BMP sessions are not accepted")
+	flag.IntVar(&rplPort, "replay-port", 11019, "TCP port of BMP sessions replayed from the capture of replay-file, 0 replays all TCP streams of the capture")
+	flag.StringVar(&svcCmd, "service", "", "When set \"install\", gobmp is installed as Windows service started with the rest of command line flags, when set \"remove\", the service is removed")
+	flag.IntVar(&maxProcs, "max-procs", 1, "Number of OS threads executing gobmp simultaneously (GOMAXPROCS), 0 uses all CPUs, defaults of parser-workers, queue-depth and kafka-buffer are derived from it")
+	flag.IntVar(&prsWork, "parser-workers", 0, "Maximum number of BMP messages of a session parsed concurrently, 0 (default) selects max-procs")
+	flag.IntVar(&queueDep, "queue-depth", 0, "Capacity in messages of queues between the reader, the parser and the producer of a BMP session, 0 (default) selects 64 times max-procs")
+	flag.IntVar(&kafkaBuf, "kafka-buffer", 0, "Number of messages buffered by Kafka producer before publishing blocks, 0 (default) selects 256 times max-procs")
+	flag.IntVar(&kafkaFly, "kafka-max-in-flight", 0, "Number of produce requests sent to a Kafka broker without waiting for responses, 0 (default) selects 5")
+	flag.StringVar(&otlpURL, "otlp-endpoint", "", "URL of OTLP/HTTP receiver spans of BMP messages from TCP read through parsing to publishing are exported to, for example \"http://localhost:4318\", tracing is disabled when not specified")
+	flag.StringVar(&otlpHdrs, "otlp-headers", "", "Comma separated list of key=value headers added to OTLP export requests, for example authentication headers of the receiver")
+	flag.Float64Var(&otlpRatio, "otlp-sample-ratio", 1, "Fraction of BMP messages traced when otlp-endpoint is specified, 1 (default) traces every message")
+	flag.StringVar(&otlpName, "otlp-service-name", "gobmp", "Service name of exported spans")
+	flag.StringVar(&cfgFile, "config", "", "Full path and file name of YAML configuration file with settings named as flags and listeners, flags set on the command line override the file, the file is reloaded on SIGHUP")
+	flag.StringVar(&apiCA, "api-tls-client-ca", "", "Full path and file name of CA certificates verifying API clients certificates, tenants are identified by certificates Common Name")
 }
 
 func main() {
 	flag.Parse()
-	_ = flag.Set("logtostderr", "true")
+	// Flags set on the command line are found before the configuration file sets other flags
+	explicit := config.Explicit(flag.CommandLine)
+	cfg, err := loadConfig(explicit)
+	if err != nil {
+		glog.Errorf("failed to load configuration with error: %+v", err)
+		os.Exit(1)
+	}
+	if !runningAsService() {
+		_ = flag.Set("logtostderr", "true")
+	}
+	if svcCmd != "" {
+		if err := controlService(svcCmd); err != nil {
+			glog.Errorf("failed to %s service with error: %+v", svcCmd, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	if flag.Arg(0) == "selftest" {
+		os.Exit(selfTest(flag.Args()[1:]))
+	}
+	if flag.Arg(0) == "simulate" {
+		os.Exit(simulate(flag.Args()[1:]))
+	}
+	if maxProcs < 0 {
+		glog.Errorf("invalid value %d of max-procs flag", maxProcs)
+		os.Exit(1)
+	}
+	if maxProcs == 0 {
+		maxProcs = runtime.NumCPU()
+	}
+	runtime.GOMAXPROCS(maxProcs)
+	if limitsF != "" {
+		l, err := limits.Load(limitsF)
+		if err != nil {
+			glog.Errorf("failed to load parse limits with error: %+v", err)
+			os.Exit(1)
+		}
+		limits.Set(l)
+		glog.Infof("parse limits: %+v", l)
+	}
+	if peerHdrs < 0 || peerHdrs > 0x7fff {
+		glog.Errorf("invalid bmp-peer-headers-tlv %d, the type of the TLV must be from 1 to 32767 or 0", peerHdrs)
+		os.Exit(1)
+	}
+	bmp.SetPeerHeadersTLV(uint16(peerHdrs))
+	// Health endpoints are served by the performance server from the start, so liveness probes do not fail
+	// while publishers connect to their servers
+	checker, err := health.NewChecker(0)
+	if err != nil {
+		glog.Errorf("failed to initialize health checks with error: %+v", err)
+		os.Exit(1)
+	}
+	checker.Register(http.DefaultServeMux)
 	// Starting performance collecting http server
 	go func() {
 		glog.Info(http.ListenAndServe(fmt.Sprintf(":%d", perfPort), nil))
 	}()
+	// Post-policy message types are registered before publishers, so they learn topics of post-policy messages
+	var postTypes map[int]int
+	if postPol != "" {
+		var err error
+		if postTypes, err = postpolicy.Register(strings.Split(postPol, ",")); err != nil {
+			glog.Errorf("failed to register post-policy topics with error: %+v", err)
+			os.Exit(1)
+		}
+	}
+	listeners, err := listenersConfig(cfg)
+	if err != nil {
+		glog.Errorf("failed to setup listeners with error: %+v", err)
+		os.Exit(1)
+	}
+	anon, err := newSinkAnonymizer(anonymize, listeners)
+	if err != nil {
+		glog.Errorf("failed to initialize anonymizer with error: %+v", err)
+		os.Exit(1)
+	}
 	// Initializing publisher
-	var publisher pub.Publisher
-	var err error
-	switch strings.ToLower(dump) {
-	case "file":
-		publisher, err = filer.NewFiler(file)
+	publisher, err := encodedPublisher("", natsStrm, anon)
+	if err != nil {
+		glog.Errorf("failed to initialize publisher with error: %+v", err)
+		os.Exit(1)
+	}
+	// Messages of routers of listeners with topic prefixes are published by dedicated publishers, which find
+	// routers of messages in their json encoding, so they are placed next to encoding
+	var router *pub.Router
+	if prefixed := topicPrefixes(listeners); len(prefixed) != 0 {
+		publishers := make(map[string]pub.Publisher, len(prefixed))
+		for prefix, name := range prefixed {
+			stream := ""
+			if natsStrm != "" {
+				stream = natsStrm + "_" + name
+			}
+			if publishers[prefix], err = encodedPublisher(prefix, stream, anon); err != nil {
+				glog.Errorf("failed to initialize publisher of listener %s with error: %+v", name, err)
+				os.Exit(1)
+			}
+		}
+		router = pub.NewRouter(publisher, publishers)
+		publisher = router
+	}
+	backend := publisher
+	checker.AddReadiness("publisher", func() error { return pub.Healthy(backend) })
+
+	if postTypes != nil {
+		publisher = postpolicy.NewSplitter(publisher, postTypes)
+	}
+
+	// AS numbers are rendered just before encoding, so features inspecting messages process asplain numbers
+	if publisher, err = asnotation.NewNotation(publisher, strings.ToLower(asNotn)); err != nil {
+		glog.Errorf("failed to initialize AS numbers notation with error: %+v", err)
+		os.Exit(1)
+	}
+
+	var lagMonitor kafka.LagMonitor
+	if lagGroups != "" {
+		if lagMonitor, err = kafkaLagMonitor(); err != nil {
+			glog.Errorf("failed to initialize Kafka consumer groups lag monitor with error: %+v", err)
+			os.Exit(1)
+		}
+		glog.V(5).Infof("Kafka consumer groups lag monitor has been successfully initialized.")
+	}
+
+	// reporters lists publishers storing state which expose estimated memory usage by the API
+	var reporters []memory.Reporter
+	// Prefix messages are summarized after features building state of routes have processed them
+	if churnThr != 0 {
+		interval, err := time.ParseDuration(churnIv)
+		if err != nil {
+			glog.Errorf("failed to parse the value of the churn-summary-interval flag with error: %+v", err)
+			os.Exit(1)
+		}
+		if publisher, err = verbosity.NewAdaptive(publisher, churnThr, interval); err != nil {
+			glog.Errorf("failed to initialize churn summaries with error: %+v", err)
+			os.Exit(1)
+		}
+		reporters = addMemoryReporter(reporters, publisher)
+	}
+	if dedupMode != "" {
+		config, err := dedupConfig()
 		if err != nil {
-			glog.Errorf("failed to initialize file publisher with error: %+v", err)
+			glog.Errorf("failed to initialize deduplication with error: %+v", err)
 			os.Exit(1)
 		}
-		glog.V(5).Infof("file publisher has been successfully initialized.")
-	case "console":
-		publisher, err = dumper.NewDumper()
+		if publisher, err = dedup.NewDeduplicator(publisher, dedupMode, config); err != nil {
+			glog.Errorf("failed to initialize deduplication with error: %+v", err)
+			os.Exit(1)
+		}
+		reporters = addMemoryReporter(reporters, publisher)
+	}
+
+	routeAgeFlag, err := strconv.ParseBool(routeAge)
+	if err != nil {
+		glog.Errorf("failed to parse to bool the value of the route-age flag with error: %+v", err)
+		os.Exit(1)
+	}
+	wdAttrsFlag, err := strconv.ParseBool(wdAttrs)
+	if err != nil {
+		glog.Errorf("failed to parse to bool the value of the enrich-withdrawals flag with error: %+v", err)
+		os.Exit(1)
+	}
+	// routes is exported by the API server
+	var routes rib.RIB
+	if routeAgeFlag || wdAttrsFlag {
+		routes = rib.NewRIB(publisher, wdAttrsFlag)
+		publisher = routes
+		reporters = addMemoryReporter(reporters, publisher)
+	}
+
+	if stormThr != 0 {
+		window, err := time.ParseDuration(stormWin)
 		if err != nil {
-			glog.Errorf("failed to initialize console publisher with error: %+v", err)
+			glog.Errorf("failed to parse the value of the peer-storm-window flag with error: %+v", err)
 			os.Exit(1)
 		}
-		glog.V(5).Infof("console publisher has been successfully initialized.")
-	case "nats":
-		publisher, err = nats.NewPublisher(natsSrv)
+		if publisher, err = storm.NewAggregator(publisher, stormThr, window); err != nil {
+			glog.Errorf("failed to initialize peer storms with error: %+v", err)
+			os.Exit(1)
+		}
+		reporters = addMemoryReporter(reporters, publisher)
+	}
+
+	if publisher, err = routeStatsPublisher(publisher); err != nil {
+		glog.Errorf("failed to initialize route statistics with error: %+v", err)
+		os.Exit(1)
+	}
+	reporters = addMemoryReporter(reporters, publisher)
+
+	if publisher, err = reportPublisher(publisher); err != nil {
+		glog.Errorf("failed to initialize reports with error: %+v", err)
+		os.Exit(1)
+	}
+	reporters = addMemoryReporter(reporters, publisher)
+
+	bmpListener, apiListener, err := activatedListeners()
+	if err != nil {
+		glog.Errorf("failed to use listeners of socket activation with error: %+v", err)
+		os.Exit(1)
+	}
+
+	var apiSrv api.Server
+	if apiPort != 0 || apiListener != nil {
+		tenants, err := api.LoadTenants(apiTenant)
 		if err != nil {
-			glog.Errorf("failed to initialize NATS publisher with error: %+v", err)
+			glog.Errorf("failed to load API tenants with error: %+v", err)
 			os.Exit(1)
 		}
-		glog.V(5).Infof("NATS publisher has been successfully initialized.")
-	default:
-		publisher, err = kafka.NewKafkaPublisher(kafkaSrv)
+		var tlsConfig *tls.Config
+		if apiCert != "" || apiKey != "" {
+			if tlsConfig, err = api.NewTLSConfig(apiCert, apiKey, apiCA); err != nil {
+				glog.Errorf("failed to setup API server TLS with error: %+v", err)
+				os.Exit(1)
+			}
+		}
+		if apiListener != nil {
+			apiSrv, err = api.NewServerWithListener(apiListener, tenants, tlsConfig, publisher)
+		} else {
+			apiSrv, err = api.NewServer(apiPort, tenants, tlsConfig, publisher)
+		}
 		if err != nil {
-			glog.Errorf("failed to initialize Kafka publisher with error: %+v", err)
+			glog.Errorf("failed to setup API server with error: %+v", err)
 			os.Exit(1)
 		}
-		glog.V(5).Infof("Kafka publisher has been successfully initialized.")
+		if routes != nil {
+			apiSrv.SetRIB(routes)
+		}
+		publisher = apiSrv
+	}
+
+	asGraphFlag, err := strconv.ParseBool(asGraph)
+	if err != nil {
+		glog.Errorf("failed to parse to bool the value of the as-graph flag with error: %+v", err)
+		os.Exit(1)
+	}
+	if asGraphFlag {
+		interval, err := time.ParseDuration(asGraphIv)
+		if err != nil {
+			glog.Errorf("failed to parse the value of the as-graph-interval flag with error: %+v", err)
+			os.Exit(1)
+		}
+		graph, err := asgraph.NewGraph(publisher, interval)
+		if err != nil {
+			glog.Errorf("failed to initialize as graph with error: %+v", err)
+			os.Exit(1)
+		}
+		if apiSrv != nil {
+			apiSrv.SetASGraph(graph)
+		}
+		publisher = graph
+		reporters = addMemoryReporter(reporters, publisher)
+		glog.V(5).Infof("as graph has been successfully initialized.")
+	}
+	nhCheckFlag, err := strconv.ParseBool(nhCheck)
+	if err != nil {
+		glog.Errorf("failed to parse to bool the value of the nexthop-check flag with error: %+v", err)
+		os.Exit(1)
+	}
+	if nhCheckFlag {
+		publisher = nexthop.NewChecker(publisher)
+		reporters = addMemoryReporter(reporters, publisher)
+	}
+	srCheckFlag, err := strconv.ParseBool(srCheck)
+	if err != nil {
+		glog.Errorf("failed to parse to bool the value of the srpolicy-check flag with error: %+v", err)
+		os.Exit(1)
+	}
+	if srCheckFlag {
+		publisher = srvalidator.NewValidator(publisher)
+		reporters = addMemoryReporter(reporters, publisher)
+	}
+	epeFlag, err := strconv.ParseBool(epeJoin)
+	if err != nil {
+		glog.Errorf("failed to parse to bool the value of the epe flag with error: %+v", err)
+		os.Exit(1)
+	}
+	if epeFlag {
+		publisher = epe.NewJoiner(publisher)
+		reporters = addMemoryReporter(reporters, publisher)
+	}
+	window, err := time.ParseDuration(orgWindow)
+	if err != nil {
+		glog.Errorf("failed to parse the value of the origin-baseline flag with error: %+v", err)
+		os.Exit(1)
+	}
+	if window != 0 {
+		if publisher, err = baseline.NewBaseline(publisher, window, orgFile); err != nil {
+			glog.Errorf("failed to initialize origin baseline with error: %+v", err)
+			os.Exit(1)
+		}
+		reporters = addMemoryReporter(reporters, publisher)
+	}
+
+	reloads := newReloader(explicit, cfg, listeners)
+	if scripts != "" {
+		config, err := scripting.LoadConfig(scripts)
+		if err != nil {
+			glog.Errorf("failed to load scripts configuration with error: %+v", err)
+			os.Exit(1)
+		}
+		if publisher, err = scripting.NewScripting(publisher, config); err != nil {
+			glog.Errorf("failed to initialize scripts with error: %+v", err)
+			os.Exit(1)
+		}
+		glog.V(5).Infof("%d scripts have been successfully initialized.", len(config.Scripts))
+	}
+	if transform != "" {
+		config, err := transformer.LoadConfig(transform)
+		if err != nil {
+			glog.Errorf("failed to load transformation rules with error: %+v", err)
+			os.Exit(1)
+		}
+		if publisher, err = transformer.NewTransformer(publisher, config); err != nil {
+			glog.Errorf("failed to initialize transformer with error: %+v", err)
+			os.Exit(1)
+		}
+		reloads.transformer, _ = publisher.(transformer.Reloader)
+		glog.V(5).Infof("transformer with %d rules has been successfully initialized.", len(config.Rules))
+	}
+	// Communities are annotated and peer groups are added first, so they can be used by transformation rules and scripts
+	if commDict != "" {
+		dict, err := community.LoadDictionary(commDict)
+		if err != nil {
+			glog.Errorf("failed to load communities dictionary with error: %+v", err)
+			os.Exit(1)
+		}
+		if publisher, err = community.NewAnnotator(publisher, dict); err != nil {
+			glog.Errorf("failed to initialize communities annotation with error: %+v", err)
+			os.Exit(1)
+		}
+		reloads.annotator, _ = publisher.(community.Reloader)
+		glog.V(5).Infof("communities dictionary with %d communities has been successfully loaded.", len(dict.Communities))
+	}
+	if peerGrps != "" {
+		config, err := peergroup.LoadConfig(peerGrps)
+		if err != nil {
+			glog.Errorf("failed to load peer groups with error: %+v", err)
+			os.Exit(1)
+		}
+		if publisher, err = peergroup.NewGrouper(publisher, config); err != nil {
+			glog.Errorf("failed to initialize peer groups with error: %+v", err)
+			os.Exit(1)
+		}
+		reloads.grouper, _ = publisher.(peergroup.Reloader)
+		reporters = addMemoryReporter(reporters, publisher)
+		glog.V(5).Infof("%d peer groups have been successfully loaded.", len(config.Groups))
+	}
+	retainPeriod, err := time.ParseDuration(retain)
+	if err != nil {
+		glog.Errorf("failed to parse the value of the state-retention flag with error: %+v", err)
+		os.Exit(1)
+	}
+	var rt retention.Retention
+	if retainPeriod != 0 {
+		rt = retention.NewRetention(publisher, retainPeriod)
+		for _, r := range reporters {
+			rt.Add(r, nil)
+		}
+		publisher = rt
+		reporters = rt.Reporters()
+	}
+	if routes != nil {
+		// Snapshots are published through the whole publisher chain, the same way as messages of BMP sessions
+		routes.SetSnapshotPublisher(publisher)
+	}
+	eventsInterval, err := time.ParseDuration(eventsIv)
+	if err != nil {
+		glog.Errorf("failed to parse the value of the collector-events flag with error: %+v", err)
+		os.Exit(1)
+	}
+	var eventsReporter *events.Reporter
+	if eventsInterval != 0 {
+		if eventsReporter, err = events.NewReporter(publisher, eventsInterval); err != nil {
+			glog.Errorf("failed to initialize collector events with error: %+v", err)
+			os.Exit(1)
+		}
+		events.SetDefault(eventsReporter)
+	}
+	var tracer *tracing.Tracer
+	if otlpURL != "" {
+		if tracer, err = otlpTracer(); err != nil {
+			glog.Errorf("failed to initialize tracing with error: %+v", err)
+			os.Exit(1)
+		}
+		tracing.SetDefault(tracer)
+		glog.V(5).Infof("tracing has been successfully initialized.")
 	}
 
 	// Initializing bmp server
@@ -98,17 +691,749 @@ func main() {
 		glog.Errorf("failed to parse to bool the value of the intercept flag with error: %+v", err)
 		os.Exit(1)
 	}
-	bmpSrv, err := gobmpsrv.NewBMPServer(srcPort, dstPort, interceptFlag, publisher, splitAFFlag)
+	socketOptions, err := bmpSocketOptions()
+	if err != nil {
+		glog.Errorf("failed to parse socket options with error: %+v", err)
+		os.Exit(1)
+	}
+	socketOptions.Listener = bmpListener
+	tsConfig, err := timestampConfig()
+	if err != nil {
+		glog.Errorf("failed to setup timestamps with error: %+v", err)
+		os.Exit(1)
+	}
+	mirrorConfig, err := mirrorConfig()
+	if err != nil {
+		glog.Errorf("failed to setup route mirroring with error: %+v", err)
+		os.Exit(1)
+	}
+	rawUpdFlag, err := strconv.ParseBool(rawUpd)
+	if err != nil {
+		glog.Errorf("failed to parse to bool the value of the raw-updates flag with error: %+v", err)
+		os.Exit(1)
+	}
+	if rplFile != "" {
+		n, err := gobmpsrv.Replay(publisher, &gobmpsrv.ReplayConfig{
+			File:       rplFile,
+			Port:       rplPort,
+			SplitAF:    splitAFFlag,
+			Timestamps: tsConfig,
+			Mirror:     mirrorConfig,
+			RawUpdates: rawUpdFlag,
+		})
+		if lagMonitor != nil {
+			lagMonitor.Stop()
+		}
+		if eventsReporter != nil {
+			events.SetDefault(nil)
+			eventsReporter.Stop()
+		}
+		publisher.Stop()
+		if tracer != nil {
+			tracing.SetDefault(nil)
+			tracer.Stop()
+		}
+		if err != nil {
+			glog.Errorf("failed to replay %s with error: %+v", rplFile, err)
+			os.Exit(1)
+		}
+		glog.Infof("replay of %s is completed, %d messages are replayed", rplFile, n)
+		os.Exit(0)
+	}
+	journal, err := journalConfig()
+	if err != nil {
+		glog.Errorf("failed to setup journal with error: %+v", err)
+		os.Exit(1)
+	}
+	active, err := activeConfig()
+	if err != nil {
+		glog.Errorf("failed to setup active bmp sessions with error: %+v", err)
+		os.Exit(1)
+	}
+	bmpSrv, err := gobmpsrv.NewBMPServer(srcPort, dstPort, interceptFlag, publisher, splitAFFlag, capDir, socketOptions, tsConfig, mirrorConfig, rawUpdFlag, journal, &gobmpsrv.WorkerConfig{ParserWorkers: prsWork, QueueDepth: queueDep}, active, listeners)
 	if err != nil {
 		glog.Errorf("failed to setup new gobmp server with error: %+v", err)
 		os.Exit(1)
 	}
+	if router != nil {
+		router.SetPrefixes(bmpSrv.TopicPrefix)
+	}
+	reloads.srv = bmpSrv
+	if rt != nil {
+		// Messages carry the local address of Peer Up messages as router_ip, sessions the address of the router
+		rt.SetActiveRouters(func() []string {
+			var routers []string
+			for _, s := range bmpSrv.Sessions() {
+				routers = append(routers, s.RouterIP)
+			}
+			for _, p := range bmpSrv.Peers() {
+				if p.LocalIP != "" {
+					routers = append(routers, p.LocalIP)
+				}
+			}
+			return routers
+		})
+	}
+	if apiSrv != nil {
+		apiSrv.SetSessionManager(bmpSrv)
+		apiSrv.SetMemoryReporters(reporters)
+		if lagMonitor != nil {
+			apiSrv.SetKafkaLag(lagMonitor)
+		}
+		apiSrv.Start()
+	}
+	checker.AddLiveness("bmp_listener", bmpSrv.Listening)
+	checker.SetRouters(func() []string {
+		var routers []string
+		for _, s := range bmpSrv.Sessions() {
+			routers = append(routers, s.RouterIP)
+		}
+		return routers
+	})
 	// Starting Interceptor server
 	bmpSrv.Start()
+	checker.Started()
 
-	stopCh := tools.SetupSignalHandler()
+	stopCh, stopped := stopSignal()
+	if err := systemd.Notify("READY=1"); err != nil {
+		glog.Warningf("failed to notify service manager with error: %+v", err)
+	}
+	if err := watchdog(stopCh); err != nil {
+		glog.Errorf("failed to setup watchdog with error: %+v", err)
+		os.Exit(1)
+	}
+	reloads.watch(stopCh)
 	<-stopCh
 
+	checker.Stopping()
+	if err := systemd.Notify("STOPPING=1"); err != nil {
+		glog.Warningf("failed to notify service manager with error: %+v", err)
+	}
+	if lagMonitor != nil {
+		lagMonitor.Stop()
+	}
+	if eventsReporter != nil {
+		events.SetDefault(nil)
+		eventsReporter.Stop()
+	}
 	bmpSrv.Stop()
+	if tracer != nil {
+		tracing.SetDefault(nil)
+		tracer.Stop()
+	}
+	stopped()
 	os.Exit(0)
 }
+
+// signalHandler returns the channel closed when gobmp receives a shutdown signal
+func signalHandler() (<-chan struct{}, func()) {
+	return tools.SetupSignalHandler(), func() {}
+}
+
+// activatedListeners returns listeners of BMP sessions and the API server passed by systemd socket activation,
+// the API server listener is named "api", BMP sessions listener is named "bmp" or it is the only other listener.
+func activatedListeners() (net.Listener, net.Listener, error) {
+	listeners, err := systemd.Listeners()
+	if err != nil {
+		return nil, nil, err
+	}
+	apiListener := listeners["api"]
+	delete(listeners, "api")
+	bmpListener, ok := listeners["bmp"]
+	if !ok && len(listeners) > 1 {
+		return nil, nil, fmt.Errorf("%d listeners are passed, BMP sessions listener must be named \"bmp\"", len(listeners))
+	}
+	for name, l := range listeners {
+		if bmpListener == nil {
+			bmpListener = l
+		}
+		glog.Infof("using listener %s on %s passed by socket activation", name, l.Addr())
+	}
+	if apiListener != nil {
+		glog.Infof("using listener api on %s passed by socket activation", apiListener.Addr())
+	}
+
+	return bmpListener, apiListener, nil
+}
+
+// watchdog notifies the service manager that gobmp is alive at half of the watchdog interval,
+// until stopCh is closed
+func watchdog(stopCh <-chan struct{}) error {
+	interval, err := systemd.WatchdogInterval()
+	if err != nil || interval == 0 {
+		return err
+	}
+	go func() {
+		t := time.NewTicker(interval / 2)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := systemd.Notify("WATCHDOG=1"); err != nil {
+					glog.Warningf("failed to notify service manager watchdog with error: %+v", err)
+				}
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+	glog.V(5).Infof("service manager watchdog is notified every %s", interval/2)
+
+	return nil
+}
+
+// bmpSocketOptions returns socket options of BMP sessions configured by tcp-* and tls-* flags
+func bmpSocketOptions() (*gobmpsrv.SocketOptions, error) {
+	opts := gobmpsrv.DefaultSocketOptions()
+	if rcvBuf < 0 {
+		return nil, fmt.Errorf("invalid value of the tcp-receive-buffer flag %d", rcvBuf)
+	}
+	opts.ReceiveBuffer = rcvBuf
+	ka, err := time.ParseDuration(keepAlive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the tcp-keepalive flag with error: %+v", err)
+	}
+	if ka <= 0 {
+		// Negative period disables keepalive, while 0 would select the default period
+		ka = -1
+	}
+	opts.KeepAlive = ka
+	if opts.NoDelay, err = strconv.ParseBool(noDelay); err != nil {
+		return nil, fmt.Errorf("failed to parse to bool the value of the tcp-nodelay flag with error: %+v", err)
+	}
+	if opts.ReusePort, err = strconv.ParseBool(reusePort); err != nil {
+		return nil, fmt.Errorf("failed to parse to bool the value of the tcp-reuseport flag with error: %+v", err)
+	}
+	opts.DSCP = dscp
+	if tcpAuth != "" {
+		if opts.Auth, err = gobmpsrv.LoadTCPAuth(tcpAuth); err != nil {
+			return nil, err
+		}
+	}
+	opts.QUICAddress = quicAddr
+	if tlsCert == "" && tlsKey == "" {
+		if tlsCA != "" || tlsRtrs != "" || quicAddr != "" {
+			return nil, fmt.Errorf("tls-client-ca, tls-routers-file and quic-listen flags require tls-cert and tls-key flags")
+		}
+		return opts, nil
+	}
+	opts.TLS = &gobmpsrv.TLSConfig{CertFile: tlsCert, KeyFile: tlsKey, ClientCAFile: tlsCA}
+	if tlsRtrs != "" {
+		if opts.TLS.Routers, err = gobmpsrv.LoadTLSRouters(tlsRtrs); err != nil {
+			return nil, err
+		}
+	}
+
+	return opts, nil
+}
+
+// addMemoryReporter adds publisher to reporters if it stores state and exposes its memory usage,
+// a publisher already in reporters is not added again
+func addMemoryReporter(reporters []memory.Reporter, publisher pub.Publisher) []memory.Reporter {
+	r, ok := publisher.(memory.Reporter)
+	if !ok {
+		return reporters
+	}
+	for _, o := range reporters {
+		if o == r {
+			return reporters
+		}
+	}
+
+	return append(reporters, r)
+}
+
+// reportPublisher wraps publisher with the reporter configured by report-* flags, publisher is returned
+// unchanged when reports are disabled
+func reportPublisher(publisher pub.Publisher) (pub.Publisher, error) {
+	interval, err := time.ParseDuration(repPeriod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the report-interval flag with error: %+v", err)
+	}
+	if interval <= 0 {
+		return publisher, nil
+	}
+	publish, err := strconv.ParseBool(repPub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse to bool the value of the report-publish flag with error: %+v", err)
+	}
+
+	return report.NewReporter(publisher, &report.Config{
+		Interval:    interval,
+		Dir:         repDir,
+		Format:      repFormat,
+		Publish:     publish,
+		TopPrefixes: repTop,
+	})
+}
+
+// routeStatsPublisher wraps publisher with route statistics configured by route-stats-* flags, publisher is returned
+// unchanged when route statistics are disabled
+func routeStatsPublisher(publisher pub.Publisher) (pub.Publisher, error) {
+	interval, err := time.ParseDuration(rsIv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the route-stats-interval flag with error: %+v", err)
+	}
+	if interval <= 0 {
+		return publisher, nil
+	}
+	window, err := time.ParseDuration(rsWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the route-stats-window flag with error: %+v", err)
+	}
+
+	return flap.NewStatistics(publisher, &flap.Config{
+		Interval:    interval,
+		Window:      window,
+		TopPrefixes: rsTop,
+	})
+}
+
+// encodedPublisher returns the publisher configured by dump, kafka-*, nats-*, failover-* and encoding flags,
+// topics of published messages are prefixed by the prefix and subjects are captured by NATS JetStream stream,
+// messages are anonymized before encoding when anon selects the sink of the prefix
+func encodedPublisher(prefix, stream string, anon *sinkAnonymizer) (pub.Publisher, error) {
+	var publisher pub.Publisher
+	var err error
+	if (topicTmpl != "" || partKeys != "") && (dump != "" || strings.ToLower(encoding) != "json") {
+		// Fields of topic templates and partition keys are found in json encoding of messages
+		return nil, fmt.Errorf("kafka-topic-template and kafka-partition-keys are supported by Kafka publisher with json encoding only")
+	}
+	switch strings.ToLower(dump) {
+	case "file", "jsonl", "console":
+		if prefix != "" {
+			return nil, fmt.Errorf("topic prefixes are supported by Kafka and NATS publishers only")
+		}
+		if strings.ToLower(dump) == "file" {
+			if publisher, err = filer.NewFiler(file); err != nil {
+				return nil, fmt.Errorf("failed to initialize file publisher with error: %+v", err)
+			}
+			glog.V(5).Infof("file publisher has been successfully initialized.")
+			break
+		}
+		if strings.ToLower(dump) == "jsonl" {
+			if strings.ToLower(encoding) != "json" {
+				return nil, fmt.Errorf("jsonl dump is supported with json encoding only")
+			}
+			var config *filer.RotatingConfig
+			if config, err = jsonlConfig(); err != nil {
+				return nil, err
+			}
+			if publisher, err = filer.NewRotatingFiler(config); err != nil {
+				return nil, fmt.Errorf("failed to initialize jsonl file publisher with error: %+v", err)
+			}
+			glog.V(5).Infof("jsonl file publisher has been successfully initialized.")
+			break
+		}
+		if publisher, err = dumper.NewDumper(); err != nil {
+			return nil, fmt.Errorf("failed to initialize console publisher with error: %+v", err)
+		}
+		glog.V(5).Infof("console publisher has been successfully initialized.")
+	case "nats":
+		if publisher, err = nats.NewPublisher(natsSrv, stream, prefix); err != nil {
+			return nil, fmt.Errorf("failed to initialize NATS publisher with error: %+v", err)
+		}
+		glog.V(5).Infof("NATS publisher has been successfully initialized.")
+	default:
+		var config *kafka.PublisherConfig
+		if config, err = kafkaPublisherConfig(prefix); err != nil {
+			return nil, err
+		}
+		if publisher, err = kafka.NewKafkaPublisher(kafkaSrv, config); err != nil {
+			return nil, fmt.Errorf("failed to initialize Kafka publisher with error: %+v", err)
+		}
+		glog.V(5).Infof("Kafka publisher has been successfully initialized.")
+	}
+	if foSrv != "" {
+		if publisher, err = failoverPublisher(publisher, prefix, stream); err != nil {
+			return nil, fmt.Errorf("failed to initialize publisher failover with error: %+v", err)
+		}
+		glog.V(5).Infof("publisher failover has been successfully initialized.")
+	}
+	if publisher, err = anon.wrap(prefix, publisher); err != nil {
+		return nil, fmt.Errorf("failed to initialize anonymizer with error: %+v", err)
+	}
+	switch strings.ToLower(encoding) {
+	case "json":
+	case "cbor":
+		// Messages are converted into CBOR last, so features inspecting messages process json
+		publisher = cbor.NewPublisher(publisher)
+	case "avro":
+		if schemaReg == "" {
+			return nil, fmt.Errorf("avro encoding requires schema-registry flag")
+		}
+		registry, err := avro.NewRegistry(schemaReg)
+		if err != nil {
+			return nil, err
+		}
+		// Subjects of schemas are named after topics of messages
+		publisher = avro.NewPublisher(publisher, registry, prefix)
+	case "protobuf":
+		publisher = protobuf.NewPublisher(publisher)
+	default:
+		return nil, fmt.Errorf("invalid encoding %q, supported encodings are \"json\", \"cbor\", \"avro\" and \"protobuf\"", encoding)
+	}
+
+	return publisher, nil
+}
+
+// jsonlConfig returns rotation of files of the jsonl publisher configured by jsonl-* flags
+func jsonlConfig() (*filer.RotatingConfig, error) {
+	config := &filer.RotatingConfig{Dir: jsonlDir, MaxSize: jsonlSize, MaxFiles: jsonlKeep}
+	var err error
+	if config.Interval, err = time.ParseDuration(jsonlIntv); err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the jsonl-interval flag with error: %+v", err)
+	}
+	if config.Gzip, err = strconv.ParseBool(jsonlGzip); err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the jsonl-gzip flag with error: %+v", err)
+	}
+
+	return config, nil
+}
+
+// failoverPublisher returns the publisher switching messages between the primary publisher and the backup
+// publisher of failover-server configured by failover-* flags, topics of the backup are prefixed by the prefix
+func failoverPublisher(publisher pub.Publisher, prefix, stream string) (pub.Publisher, error) {
+	config := &failover.Config{ErrorRate: foErrRate}
+	var err error
+	if config.Latency, err = time.ParseDuration(foLatency); err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the failover-latency flag with error: %+v", err)
+	}
+	if config.Window, err = time.ParseDuration(foWindow); err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the failover-window flag with error: %+v", err)
+	}
+	if config.Failback, err = time.ParseDuration(foBack); err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the failover-failback flag with error: %+v", err)
+	}
+	var backup pub.Publisher
+	switch strings.ToLower(dump) {
+	case "file", "jsonl", "console":
+		return nil, fmt.Errorf("failover is supported by Kafka and NATS publishers only")
+	case "nats":
+		backup, err = nats.NewPublisher(foSrv, stream, prefix)
+	default:
+		var kc *kafka.PublisherConfig
+		if kc, err = kafkaPublisherConfig(prefix); err != nil {
+			return nil, err
+		}
+		backup, err = kafka.NewKafkaPublisher(foSrv, kc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize backup publisher of %s with error: %+v", foSrv, err)
+	}
+
+	return failover.NewFailover(publisher, backup, config)
+}
+
+// dedupConfig returns the config of deduplication configured by dedup-* flags
+func dedupConfig() (*dedup.Config, error) {
+	config := &dedup.Config{CacheSize: dedupSize}
+	var err error
+	if config.Window, err = time.ParseDuration(dedupWin); err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the dedup-window flag with error: %+v", err)
+	}
+	if dedupIgn == "" {
+		return config, nil
+	}
+	for _, a := range strings.Split(dedupIgn, ",") {
+		switch strings.ToLower(strings.TrimSpace(a)) {
+		case "med":
+			config.IgnoreMED = true
+		case "communities":
+			config.IgnoreCommunities = true
+		default:
+			return nil, fmt.Errorf("invalid attribute %q of the dedup-ignore flag, supported attributes are \"med\" and \"communities\"", a)
+		}
+	}
+
+	return config, nil
+}
+
+// timestampConfig returns the source of messages timestamps configured by timestamp-* flags
+func timestampConfig() (*message.TimestampConfig, error) {
+	skew, err := time.ParseDuration(tsSkew)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the timestamp-max-skew flag with error: %+v", err)
+	}
+	zero, err := strconv.ParseBool(tsZero)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse to bool the value of the timestamp-zero-collector flag with error: %+v", err)
+	}
+	c := &message.TimestampConfig{Source: tsSource, MaxSkew: skew, ZeroCollector: zero}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// mirrorConfig returns types of mirrored BGP messages decoded as configured by the mirror-parse flag
+func mirrorConfig() (*message.MirrorConfig, error) {
+	c := &message.MirrorConfig{}
+	for _, t := range strings.Split(mirParse, ",") {
+		if t = strings.TrimSpace(strings.ToLower(t)); t != "" {
+			c.Parse = append(c.Parse, t)
+		}
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// journalConfig returns the journal of raw BMP messages configured by journal-* flags, nil is returned when
+// journaling is disabled
+func journalConfig() (*gobmpsrv.JournalConfig, error) {
+	if jrnDir == "" {
+		return nil, nil
+	}
+	retention, err := time.ParseDuration(jrnRet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the journal-retention flag with error: %+v", err)
+	}
+	if retention < 0 {
+		return nil, fmt.Errorf("invalid value of the journal-retention flag %s", jrnRet)
+	}
+
+	return &gobmpsrv.JournalConfig{Dir: jrnDir, Retention: retention}, nil
+}
+
+// activeConfig returns routers the collector connects to configured by active-* flags, nil is returned when
+// no routers are configured
+func activeConfig() (*gobmpsrv.ActiveConfig, error) {
+	if actRtrs == "" {
+		return nil, nil
+	}
+	backoff, err := time.ParseDuration(actBack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the active-max-backoff flag with error: %+v", err)
+	}
+	if backoff <= 0 {
+		return nil, fmt.Errorf("invalid value of the active-max-backoff flag %s", actBack)
+	}
+	c := &gobmpsrv.ActiveConfig{MaxBackoff: backoff}
+	for _, r := range strings.Split(actRtrs, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			c.Routers = append(c.Routers, r)
+		}
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// listenersConfig returns additional listeners of BMP sessions of the configuration file or configured by
+// listeners-file flag
+func listenersConfig(c *config.Config) ([]*gobmpsrv.ListenerConfig, error) {
+	if lstFile == "" {
+		return c.Listeners, nil
+	}
+	if len(c.Listeners) != 0 {
+		return nil, fmt.Errorf("listeners are configured by both the configuration file and listeners-file flag")
+	}
+
+	return gobmpsrv.LoadListeners(lstFile)
+}
+
+// topicPrefixes returns names of listeners keyed by topic prefixes of listeners, a prefix shared by several
+// listeners is keyed to the first of them
+func topicPrefixes(listeners []*gobmpsrv.ListenerConfig) map[string]string {
+	prefixes := make(map[string]string)
+	for _, l := range listeners {
+		if _, ok := prefixes[l.TopicPrefix]; !ok && l.TopicPrefix != "" {
+			prefixes[l.TopicPrefix] = l.Name
+		}
+	}
+
+	return prefixes
+}
+
+// otlpTracer returns the tracer exporting spans to OTLP/HTTP receiver configured by otlp-* flags
+func otlpTracer() (*tracing.Tracer, error) {
+	headers := make(map[string]string)
+	for _, h := range strings.Split(otlpHdrs, ",") {
+		if h = strings.TrimSpace(h); h == "" {
+			continue
+		}
+		kv := strings.SplitN(h, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("invalid header %q of otlp-headers flag, headers must be key=value", h)
+		}
+		headers[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+
+	return tracing.NewTracer(&tracing.Config{
+		Endpoint:    otlpURL,
+		Headers:     headers,
+		ServiceName: otlpName,
+		SampleRatio: otlpRatio,
+	})
+}
+
+// kafkaPublisherConfig returns the config of Kafka publishers configured by kafka-* flags, topics of published
+// messages are prefixed by the prefix
+func kafkaPublisherConfig(prefix string) (*kafka.PublisherConfig, error) {
+	security, err := kafkaSecurity()
+	if err != nil {
+		return nil, err
+	}
+	config := &kafka.PublisherConfig{
+		BufferSize:    kafkaBuf,
+		MaxInFlight:   kafkaFly,
+		TopicPrefix:   prefix,
+		TopicTemplate: topicTmpl,
+		Partitions:    int32(topicPart),
+		Security:      security,
+	}
+	if partKeys == "" {
+		return config, nil
+	}
+	config.PartitionKeys = make(map[string]string)
+	for _, k := range strings.Split(partKeys, ",") {
+		kv := strings.SplitN(strings.TrimSpace(k), "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid partition key %q, partition keys are type=key", k)
+		}
+		config.PartitionKeys[kv[0]] = kv[1]
+	}
+
+	return config, nil
+}
+
+// kafkaSecurity returns SASL authentication and TLS of connections to Kafka brokers configured by kafka-sasl-*
+// and kafka-tls-* flags, nil is returned when connections are neither authenticated nor encrypted
+func kafkaSecurity() (*kafka.SecurityConfig, error) {
+	tlsFlag, err := strconv.ParseBool(kafkaTLS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse to bool the value of the kafka-tls flag with error: %+v", err)
+	}
+	s := &kafka.SecurityConfig{
+		SASLMechanism: strings.ToUpper(saslMech),
+		SASLUser:      saslUser,
+		TLS:           tlsFlag,
+		CAFile:        kafkaCA,
+		CertFile:      kafkaCert,
+		KeyFile:       kafkaKey,
+	}
+	if saslPass != "" {
+		b, err := os.ReadFile(saslPass)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Kafka SASL password file with error: %+v", err)
+		}
+		s.SASLPassword = strings.TrimRight(string(b), "\r\n")
+	}
+	if *s == (kafka.SecurityConfig{}) {
+		return nil, nil
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// kafkaLagMonitor returns the monitor of Kafka consumer groups lag configured by kafka-lag-* flags
+func kafkaLagMonitor() (kafka.LagMonitor, error) {
+	if kafkaSrv == "" {
+		return nil, fmt.Errorf("kafka-lag-groups flag requires kafka-server flag")
+	}
+	interval, err := time.ParseDuration(lagIv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the value of the kafka-lag-interval flag with error: %+v", err)
+	}
+	var groups []string
+	for _, g := range strings.Split(lagGroups, ",") {
+		if g = strings.TrimSpace(g); g != "" {
+			groups = append(groups, g)
+		}
+	}
+	security, err := kafkaSecurity()
+	if err != nil {
+		return nil, err
+	}
+
+	return kafka.NewLagMonitor(kafkaSrv, &kafka.LagConfig{Groups: groups, Interval: interval, Threshold: lagThr, Security: security})
+}
+
+// defaultSink is the name of the sink of topics without prefix selected by anonymize flag
+const defaultSink = "default"
+
+// sinkAnonymizer anonymizes messages of sinks selected by anonymize flag, sinks are the publisher of topics without
+// prefix and publishers of topic prefixes of listeners, messages of all sinks are anonymized with the same key
+type sinkAnonymizer struct {
+	// prefixes stores topic prefixes of anonymized sinks, all is true when all sinks are anonymized
+	prefixes map[string]bool
+	all      bool
+	key      []byte
+	strip    bool
+}
+
+// newSinkAnonymizer returns the anonymizer of sinks selected by the value of anonymize flag, "true" selects all
+// sinks, otherwise sinks are listed by names, "default" or names of listeners with topic prefixes, nil is returned
+// when no sink is anonymized. The key and the removal of communities are configured by anonymize-* flags.
+func newSinkAnonymizer(value string, listeners []*gobmpsrv.ListenerConfig) (*sinkAnonymizer, error) {
+	a := &sinkAnonymizer{prefixes: make(map[string]bool)}
+	if all, err := strconv.ParseBool(value); err == nil {
+		if !all {
+			return nil, nil
+		}
+		a.all = true
+	} else {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+			if name == defaultSink {
+				a.prefixes[""] = true
+				continue
+			}
+			found := false
+			for _, l := range listeners {
+				if l.Name == name && l.TopicPrefix != "" {
+					a.prefixes[l.TopicPrefix] = true
+					found = true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("invalid sink %q of anonymize flag, sinks are %q and names of listeners with topic prefixes", name, defaultSink)
+			}
+		}
+	}
+	var err error
+	if a.strip, err = strconv.ParseBool(anonStrip); err != nil {
+		return nil, fmt.Errorf("failed to parse to bool the value of the anonymize-strip-communities flag with error: %+v", err)
+	}
+	a.key = make([]byte, anonymizer.CryptoPAnKeyLength)
+	if anonKey == "" {
+		glog.Warningf("anonymization key file is not specified, random key is used, anonymized addresses will change after restart")
+		if _, err := rand.Read(a.key); err != nil {
+			return nil, err
+		}
+	} else {
+		b, err := os.ReadFile(anonKey)
+		if err != nil {
+			return nil, err
+		}
+		if a.key, err = hex.DecodeString(strings.TrimSpace(string(b))); err != nil {
+			return nil, fmt.Errorf("failed to decode anonymization key with error: %+v", err)
+		}
+	}
+
+	return a, nil
+}
+
+// wrap returns the publisher of the sink of the topic prefix anonymizing messages when the sink is selected, the
+// publisher is returned unchanged otherwise
+func (a *sinkAnonymizer) wrap(prefix string, publisher pub.Publisher) (pub.Publisher, error) {
+	if a == nil || !a.all && !a.prefixes[prefix] {
+		return publisher, nil
+	}
+	p, err := anonymizer.NewAnonymizer(publisher, a.key, a.strip)
+	if err != nil {
+		return nil, err
+	}
+	glog.V(5).Infof("anonymizer of the sink of topic prefix %q has been successfully initialized.", prefix)
+
+	return p, nil
+}
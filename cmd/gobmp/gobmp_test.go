@@ -7,6 +7,9 @@ import (
 	"testing"
 
 	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/config"
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+	"github.com/sbezverk/gobmp/pkg/pubtest"
 )
 
 func TestMain(m *testing.M) {
@@ -124,3 +127,98 @@ func TestInitiationMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestSinkAnonymizer(t *testing.T) {
+	listeners := []*gobmpsrv.ListenerConfig{{Name: "east", TopicPrefix: "east"}, {Name: "west", TopicPrefix: "west"}, {Name: "bmp"}}
+	msg := []byte(`{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","prefix":"10.1.0.0","prefix_len":16}`)
+	tests := []struct {
+		name       string
+		value      string
+		anonymized map[string]bool
+		fail       bool
+	}{
+		{name: "disabled", value: "false"},
+		{name: "all sinks", value: "true", anonymized: map[string]bool{"": true, "east": true, "west": true}},
+		{name: "default sink", value: "default", anonymized: map[string]bool{"": true}},
+		{name: "sinks of listeners", value: "east, west", anonymized: map[string]bool{"east": true, "west": true}},
+		{name: "listener without topic prefix", value: "bmp", fail: true},
+		{name: "unknown sink", value: "north", fail: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anon, err := newSinkAnonymizer(tt.value, listeners)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("unexpected error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("expected error but succeeded")
+			}
+			for _, prefix := range []string{"", "east", "west"} {
+				rec := pubtest.NewRecorder()
+				p, err := anon.wrap(prefix, rec)
+				if err != nil {
+					t.Fatalf("unexpected error: %+v", err)
+				}
+				if err := p.PublishMessage(bmp.UnicastPrefixV4Msg, nil, msg); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+				ms, err := rec.Where(bmp.UnicastPrefixV4Msg, "router_ip", "10.0.0.1")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if anonymized := len(ms) == 0; anonymized != tt.anonymized[prefix] {
+					t.Errorf("expected messages of the sink of prefix %q anonymized %t but got %t", prefix, tt.anonymized[prefix], anonymized)
+				}
+			}
+		})
+	}
+}
+
+func TestReloaderChanges(t *testing.T) {
+	prev := &config.Config{Values: map[string]string{"split-af": "true", "kafka-server": "kafka:9092", "v": "1"}}
+	tests := []struct {
+		name   string
+		next   map[string]string
+		expect []change
+	}{
+		{
+			name: "unchanged",
+			next: map[string]string{"split-af": "true", "kafka-server": "kafka:9092", "v": "1"},
+		},
+		{
+			name: "reloadable setting",
+			next: map[string]string{"split-af": "true", "kafka-server": "kafka:9092", "v": "2"},
+		},
+		{
+			name:   "setting requiring restart",
+			next:   map[string]string{"split-af": "false", "kafka-server": "kafka:9092", "v": "1"},
+			expect: []change{{name: "split-af", value: "false", running: "true"}},
+		},
+		{
+			name:   "removed setting requiring restart",
+			next:   map[string]string{"split-af": "true", "v": "1"},
+			expect: []change{{name: "kafka-server", value: "", running: flag.Lookup("kafka-server").Value.String()}},
+		},
+		{
+			name:   "setting of the command line",
+			next:   map[string]string{"split-af": "true", "kafka-server": "kafka:9092", "v": "1", "source-port": "6000"},
+			expect: []change{{name: "source-port", value: "6000", running: "5000", explicit: true}},
+		},
+		{
+			name:   "unknown setting",
+			next:   map[string]string{"split-af": "true", "kafka-server": "kafka:9092", "v": "1", "no-such-setting": "x"},
+			expect: []change{{name: "no-such-setting", unknown: true}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReloader(map[string]bool{"source-port": true}, prev, nil)
+			if changes := r.changes(&config.Config{Values: tt.next}); !reflect.DeepEqual(changes, tt.expect) {
+				t.Errorf("expected changes %+v but got %+v", tt.expect, changes)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/community"
+	"github.com/sbezverk/gobmp/pkg/config"
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+	"github.com/sbezverk/gobmp/pkg/peergroup"
+	"github.com/sbezverk/gobmp/pkg/transformer"
+)
+
+// reloadable lists settings applied when the configuration is reloaded, other settings apply after restart
+var reloadable = map[string]bool{
+	"v":                true,
+	"vmodule":          true,
+	"transform-file":   true,
+	"communities-file": true,
+	"peer-groups-file": true,
+}
+
+// reloader reloads the configuration file, transformation rules, communities dictionary, peer groups and
+// allowed prefixes of listeners on SIGHUP, established BMP sessions are not affected
+type reloader struct {
+	// explicit lists flags set on the command line, they override the configuration file and are not reloaded
+	explicit    map[string]bool
+	config      *config.Config
+	listeners   []*gobmpsrv.ListenerConfig
+	allowed     map[string][]string
+	srv         gobmpsrv.BMPServer
+	transformer transformer.Reloader
+	annotator   community.Reloader
+	grouper     peergroup.Reloader
+}
+
+// loadConfig returns the configuration file of the config flag applied to flags not set on the command line,
+// an empty configuration is returned when the config flag is not set
+func loadConfig(explicit map[string]bool) (*config.Config, error) {
+	if cfgFile == "" {
+		return &config.Config{Values: make(map[string]string)}, nil
+	}
+	c, err := config.Load(cfgFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Apply(flag.CommandLine, explicit); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func newReloader(explicit map[string]bool, c *config.Config, listeners []*gobmpsrv.ListenerConfig) *reloader {
+	r := &reloader{
+		explicit:  explicit,
+		config:    c,
+		listeners: listeners,
+		allowed:   make(map[string][]string),
+	}
+	for _, l := range listeners {
+		r.allowed[l.Name] = l.AllowedPrefixes
+	}
+
+	return r
+}
+
+// watch reloads the configuration on SIGHUP until stopCh is closed
+func (r *reloader) watch(stopCh <-chan struct{}) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(ch)
+		for {
+			select {
+			case <-ch:
+				r.reload()
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+}
+
+func (r *reloader) reload() {
+	glog.Infof("reloading configuration")
+	next := &config.Config{Values: make(map[string]string)}
+	if cfgFile != "" {
+		c, err := config.Load(cfgFile)
+		if err != nil {
+			glog.Errorf("failed to reload configuration with error: %+v", err)
+			return
+		}
+		next = c
+	}
+	for _, c := range r.changes(next) {
+		switch {
+		case c.unknown:
+			glog.Errorf("unknown setting %s of reloaded configuration is ignored", c.name)
+		case c.explicit:
+			glog.Warningf("setting %s is set on the command line, its change to %q in the configuration file is ignored", c.name, c.value)
+		default:
+			glog.Warningf("setting %s changed to %q requires restart, the running value %q is kept", c.name, c.value, c.running)
+		}
+	}
+	for name := range reloadable {
+		if r.explicit[name] {
+			continue
+		}
+		f := flag.Lookup(name)
+		v, ok := next.Values[name]
+		if !ok {
+			v = f.DefValue
+		}
+		if v == f.Value.String() {
+			continue
+		}
+		if err := flag.Set(name, v); err != nil {
+			glog.Errorf("invalid value %q of setting %s with error: %+v", v, name, err)
+			continue
+		}
+		glog.Infof("setting %s changed to %q", name, v)
+	}
+	r.config = next
+	r.reloadTransformer()
+	r.reloadAnnotator()
+	r.reloadGrouper()
+	r.reloadListeners()
+}
+
+// change is a changed setting of the reloaded configuration which is not applied, value is the value of the
+// reloaded configuration and running is the value in use
+type change struct {
+	name     string
+	value    string
+	running  string
+	unknown  bool
+	explicit bool
+}
+
+// changes returns changed settings of the configuration which are not applied by the reload, settings set on the
+// command line and settings applied after restart
+func (r *reloader) changes(next *config.Config) []change {
+	var changes []change
+	for _, name := range next.Changed(r.config) {
+		if name == config.ListenersKey || (reloadable[name] && !r.explicit[name]) {
+			continue
+		}
+		c := change{name: name, explicit: r.explicit[name]}
+		f := flag.Lookup(name)
+		if f == nil {
+			c.unknown = true
+			changes = append(changes, c)
+			continue
+		}
+		c.running = f.Value.String()
+		c.value = f.DefValue
+		if v, ok := next.Values[name]; ok {
+			c.value = v
+		}
+		changes = append(changes, c)
+	}
+
+	return changes
+}
+
+func (r *reloader) reloadTransformer() {
+	if r.transformer == nil {
+		if transform != "" {
+			glog.Warningf("transformation rules were not enabled at start, --transform-file requires restart")
+		}
+		return
+	}
+	c := &transformer.Config{}
+	if transform != "" {
+		var err error
+		if c, err = transformer.LoadConfig(transform); err != nil {
+			glog.Errorf("failed to reload transformation rules with error: %+v", err)
+			return
+		}
+	}
+	if err := r.transformer.Reload(c); err != nil {
+		glog.Errorf("failed to reload transformation rules with error: %+v", err)
+		return
+	}
+	glog.Infof("%d transformation rules have been reloaded", len(c.Rules))
+}
+
+func (r *reloader) reloadAnnotator() {
+	if r.annotator == nil {
+		if commDict != "" {
+			glog.Warningf("communities dictionary was not enabled at start, --communities-file requires restart")
+		}
+		return
+	}
+	dict := &community.Dictionary{}
+	if commDict != "" {
+		var err error
+		if dict, err = community.LoadDictionary(commDict); err != nil {
+			glog.Errorf("failed to reload communities dictionary with error: %+v", err)
+			return
+		}
+	}
+	if err := r.annotator.Reload(dict); err != nil {
+		glog.Errorf("failed to reload communities dictionary with error: %+v", err)
+		return
+	}
+	glog.Infof("communities dictionary with %d communities has been reloaded", len(dict.Communities))
+}
+
+func (r *reloader) reloadGrouper() {
+	if r.grouper == nil {
+		if peerGrps != "" {
+			glog.Warningf("peer groups were not enabled at start, --peer-groups-file requires restart")
+		}
+		return
+	}
+	c := &peergroup.Config{}
+	if peerGrps != "" {
+		var err error
+		if c, err = peergroup.LoadConfig(peerGrps); err != nil {
+			glog.Errorf("failed to reload peer groups with error: %+v", err)
+			return
+		}
+	}
+	if err := r.grouper.Reload(c); err != nil {
+		glog.Errorf("failed to reload peer groups with error: %+v", err)
+		return
+	}
+	glog.Infof("%d peer groups have been reloaded", len(c.Groups))
+}
+
+// reloadListeners replaces allowed prefixes of running listeners, listeners added, removed or with changed
+// address, TLS or topic prefix require restart
+func (r *reloader) reloadListeners() {
+	listeners, err := listenersConfig(r.config)
+	if err != nil {
+		glog.Errorf("failed to reload listeners with error: %+v", err)
+		return
+	}
+	running := make(map[string]*gobmpsrv.ListenerConfig, len(r.listeners))
+	for _, l := range r.listeners {
+		running[l.Name] = l
+	}
+	for _, l := range listeners {
+		p, ok := running[l.Name]
+		if !ok {
+			glog.Warningf("added listener %s requires restart", l.Name)
+			continue
+		}
+		delete(running, l.Name)
+		if p.Address != l.Address || p.TopicPrefix != l.TopicPrefix || !reflect.DeepEqual(p.TLS, l.TLS) {
+			glog.Warningf("changed address, TLS or topic prefix of listener %s requires restart", l.Name)
+		}
+		if reflect.DeepEqual(r.allowed[l.Name], l.AllowedPrefixes) {
+			continue
+		}
+		if err := r.srv.SetAllowedPrefixes(l.Name, l.AllowedPrefixes); err != nil {
+			glog.Errorf("failed to reload allowed prefixes of listener %s with error: %+v", l.Name, err)
+			continue
+		}
+		r.allowed[l.Name] = l.AllowedPrefixes
+	}
+	for name := range running {
+		glog.Warningf("removed listener %s requires restart, the listener keeps running", name)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/selftest"
+)
+
+// selfTest runs the selftest command and returns the exit code of gobmp, 0 when all checks passed
+func selfTest(args []string) int {
+	fs := flag.NewFlagSet("selftest", flag.ExitOnError)
+	timeout := fs.Duration("timeout", 10*time.Second, "time to wait for published messages before checks are failed")
+	jsonOut := fs.Bool("json", false, "print the report in json")
+	_ = fs.Parse(args)
+	report, err := selftest.Run(*timeout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "self-test failed with error: %+v\n", err)
+		return 1
+	}
+	if *jsonOut {
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to marshal self-test report with error: %+v\n", err)
+			return 1
+		}
+		fmt.Println(string(b))
+	} else {
+		for _, res := range report.Results {
+			if res.Passed {
+				fmt.Printf("PASS  %s\n", res.Name)
+			} else {
+				fmt.Printf("FAIL  %s: %s\n", res.Name, res.Error)
+			}
+		}
+		names := make([]string, 0, len(report.PublishedMessages))
+		for name := range report.PublishedMessages {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Printf("\n%d BMP messages sent, published messages:\n", report.BMPMessages)
+		for _, name := range names {
+			fmt.Printf("  %-24s %d\n", name, report.PublishedMessages[name])
+		}
+		fmt.Printf("\ncompleted in %.3fs\n", report.DurationSeconds)
+	}
+	if !report.Passed() {
+		if !*jsonOut {
+			fmt.Println("self-test FAILED")
+		}
+		return 1
+	}
+	if !*jsonOut {
+		fmt.Println("self-test PASSED")
+	}
+
+	return 0
+}
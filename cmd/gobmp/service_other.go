@@ -0,0 +1,21 @@
+//go:build !windows
+
+package main
+
+import "fmt"
+
+// runningAsService returns true when gobmp is started by the Windows service control manager
+func runningAsService() bool {
+	return false
+}
+
+// controlService installs or removes the Windows service
+func controlService(_ string) error {
+	return fmt.Errorf("windows service is not supported on this platform")
+}
+
+// stopSignal returns the channel closed when gobmp is requested to stop and the function called
+// when gobmp is stopped
+func stopSignal() (<-chan struct{}, func()) {
+	return signalHandler()
+}
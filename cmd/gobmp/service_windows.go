@@ -0,0 +1,139 @@
+//go:build windows
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+const serviceName = "gobmp"
+
+// runningAsService returns true when gobmp is started by the Windows service control manager
+func runningAsService() bool {
+	ok, err := svc.IsWindowsService()
+	if err != nil {
+		glog.Warningf("failed to detect if running as Windows service with error: %+v", err)
+		return false
+	}
+
+	return ok
+}
+
+// controlService installs or removes the Windows service, the installed service runs gobmp with the command
+// line flags of the install command, except the service flag
+func controlService(cmd string) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("failed to connect to service control manager with error: %+v", err)
+	}
+	defer m.Disconnect()
+	switch cmd {
+	case "install":
+		if s, err := m.OpenService(serviceName); err == nil {
+			s.Close()
+			return fmt.Errorf("service %s is already installed", serviceName)
+		}
+		exe, err := os.Executable()
+		if err != nil {
+			return err
+		}
+		s, err := m.CreateService(serviceName, exe, mgr.Config{
+			DisplayName: "gobmp",
+			Description: "BMP collector publishing BGP and BGP-LS messages",
+			StartType:   mgr.StartAutomatic,
+		}, serviceArgs(os.Args[1:])...)
+		if err != nil {
+			return fmt.Errorf("failed to create service %s with error: %+v", serviceName, err)
+		}
+		s.Close()
+		glog.Infof("service %s is installed", serviceName)
+	case "remove":
+		s, err := m.OpenService(serviceName)
+		if err != nil {
+			return fmt.Errorf("service %s is not installed", serviceName)
+		}
+		defer s.Close()
+		if err := s.Delete(); err != nil {
+			return fmt.Errorf("failed to remove service %s with error: %+v", serviceName, err)
+		}
+		glog.Infof("service %s is removed", serviceName)
+	default:
+		return fmt.Errorf("invalid value of the service flag %q, supported values are \"install\" and \"remove\"", cmd)
+	}
+
+	return nil
+}
+
+// serviceArgs returns command line arguments without the service flag
+func serviceArgs(args []string) []string {
+	sa := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		a := strings.TrimLeft(args[i], "-")
+		switch {
+		case a == "service":
+			// The value follows as the next argument
+			i++
+		case strings.HasPrefix(a, "service="):
+		default:
+			sa = append(sa, args[i])
+		}
+	}
+
+	return sa
+}
+
+// handler is the handler of requests of the service control manager
+type handler struct {
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+func (h *handler) Execute(_ []string, r <-chan svc.ChangeRequest, changes chan<- svc.Status) (bool, uint32) {
+	changes <- svc.Status{State: svc.StartPending}
+	changes <- svc.Status{State: svc.Running, Accepts: svc.AcceptStop | svc.AcceptShutdown}
+	for c := range r {
+		switch c.Cmd {
+		case svc.Interrogate:
+			changes <- c.CurrentStatus
+		case svc.Stop, svc.Shutdown:
+			changes <- svc.Status{State: svc.StopPending, WaitHint: uint32((30 * time.Second).Milliseconds())}
+			close(h.stop)
+			<-h.stopped
+			return false, 0
+		}
+	}
+
+	return false, 0
+}
+
+// stopSignal returns the channel closed when gobmp is requested to stop and the function called
+// when gobmp is stopped, when gobmp runs as Windows service, the stop is requested by the service control manager
+func stopSignal() (<-chan struct{}, func()) {
+	if !runningAsService() {
+		return signalHandler()
+	}
+	h := &handler{
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := svc.Run(serviceName, h); err != nil {
+			glog.Errorf("failed to run service %s with error: %+v", serviceName, err)
+			os.Exit(1)
+		}
+	}()
+
+	return h.stop, func() {
+		close(h.stopped)
+		<-done
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/simulator"
+)
+
+// simulate runs the simulate command and returns the exit code of gobmp, 0 when all sessions completed
+func simulate(args []string) int {
+	fs := flag.NewFlagSet("simulate", flag.ExitOnError)
+	target := fs.String("target", "127.0.0.1:5000", "host:port address of the collector BMP sessions of simulated routers are opened to")
+	routers := fs.Int("routers", 1, "number of simulated routers, each router opens its own BMP session")
+	peers := fs.Int("peers", 1, "number of peers of each router")
+	prefixes := fs.Int("prefixes", 1000, "number of routes announced by each peer, split among families by their weights")
+	perUpdate := fs.Int("prefixes-per-update", 10, "maximum number of routes of a family announced by a Route Monitoring message")
+	families := fs.String("families", "ipv4-unicast:70,ipv6-unicast:30", "comma separated list of families of routes and their weights, ipv4-unicast, ipv6-unicast, ipv4-labeled-unicast, ipv6-labeled-unicast, ipv4-vpn and ipv6-vpn")
+	rate := fs.Int("rate", 0, "Route Monitoring messages per second of each router, 0 does not limit the rate")
+	stats := fs.Duration("stats-interval", 10*time.Second, "period between Statistics Reports of peers, 0 disables Statistics Reports")
+	duration := fs.Duration("duration", 0, "period routes are withdrawn and announced again after the table is sent, 0 closes sessions once the table is sent")
+	linger := fs.Duration("linger", 5*time.Second, "period sessions are kept open after Termination messages, so the collector publishes received messages")
+	jsonOut := fs.Bool("json", false, "print the report in json")
+	_ = fs.Parse(args)
+	fams, err := simulator.ParseFamilies(*families)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid families with error: %+v\n", err)
+		return 1
+	}
+	report, err := simulator.Run(&simulator.Config{
+		Target:            *target,
+		Routers:           *routers,
+		Peers:             *peers,
+		Prefixes:          *prefixes,
+		PrefixesPerUpdate: *perUpdate,
+		Families:          fams,
+		Rate:              *rate,
+		StatsInterval:     *stats,
+		Duration:          *duration,
+		Linger:            *linger,
+	})
+	if report == nil {
+		fmt.Fprintf(os.Stderr, "simulation failed with error: %+v\n", err)
+		return 1
+	}
+	if *jsonOut {
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to marshal simulation report with error: %+v\n", err)
+			return 1
+		}
+		fmt.Println(string(b))
+	} else {
+		names := make([]string, 0, len(report.Messages))
+		for name := range report.Messages {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Printf("%d routers with %d peers, BMP messages sent:\n", report.Routers, report.Peers)
+		for _, name := range names {
+			fmt.Printf("  %-24s %d\n", name, report.Messages[name])
+		}
+		fmt.Printf("\n%d routes announced, %d routes withdrawn, %d bytes sent\n", report.Announced, report.Withdrawn, report.Bytes)
+		fmt.Printf("completed in %.3fs, %.0f messages per second\n", report.DurationSeconds, report.MessagesPerSecond)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "simulation failed with error: %+v\n", err)
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,311 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/api"
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+)
+
+var (
+	apiSrv  string
+	apiKey  string
+	caCert  string
+	tlsCert string
+	tlsKey  string
+)
+
+func init() {
+	flag.StringVar(&apiSrv, "api-server", "http://localhost:8080", "URL of gobmp API server")
+	flag.StringVar(&apiKey, "api-key", "", "API key of a tenant, admin role is required for all commands except peers, routers, rib, as-graph and message-types")
+	flag.StringVar(&caCert, "ca-cert", "", "Full path and file name of CA certificate verifying API server certificate")
+	flag.StringVar(&tlsCert, "cert", "", "Full path and file name of client certificate")
+	flag.StringVar(&tlsKey, "key", "", "Full path and file name of client certificate private key")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), `Usage: %s [flags] command [command flags]
+
+Commands:
+  log [-v level] [-vmodule spec]              show or change logging verbosity
+  hexdump [on [-routers list] [-peers list] [-types list] [-max count] [-duration duration] [-file name]]
+                                              show or enable hex dump of received BMP messages
+  hexdump off                                 disable hex dump of received BMP messages
+  sessions                                    list BMP sessions
+  vendors                                     show counters of BMP messages, parsing errors and used features per vendor
+  memory                                      show memory used by the collector per subsystem and peer
+  limits                                      show limits of decoded BGP messages and how many times they were exceeded
+  kafka-lag                                   show lag of monitored Kafka consumer groups on gobmp topics
+  journals                                    list journals of raw BMP messages
+  replay {journal} [{offset}]                 publish messages of the journal starting from the offset
+  peers [-format json|csv]                    export the table of peers monitored over BMP sessions
+  routers [-router ip]                        show connected routers with their peers, session uptime, message counters
+                                              and the last error of sessions
+  rib -router ip -peer ip [-format json|csv]  export current routes of the peer of the router
+  rib -prefix prefix/len [-format json|csv]   export current routes of the prefix of all peers of all routers
+  as-graph [{asn}]                            show links of the AS-level graph, all links or links of the AS
+  message-types                               list types of published messages with their topics and fields
+  close {session id}                          close BMP session
+  pause {router ip}                           pause publishing of messages received from the router
+  resume {router ip}                          resume publishing of messages received from the router
+
+Flags:
+`, os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	client, err := newClient()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup API client with error: %+v\n", err)
+		os.Exit(1)
+	}
+	args := flag.Args()[1:]
+	switch flag.Arg(0) {
+	case "log":
+		err = logCommand(client, args)
+	case "hexdump":
+		err = hexDumpCommand(client, args)
+	case "sessions":
+		err = client.do(http.MethodGet, api.AdminSessionsPath, nil)
+	case "vendors":
+		err = client.do(http.MethodGet, api.AdminVendorsPath, nil)
+	case "memory":
+		err = client.do(http.MethodGet, api.AdminMemoryPath, nil)
+	case "limits":
+		err = client.do(http.MethodGet, api.AdminLimitsPath, nil)
+	case "kafka-lag":
+		err = client.do(http.MethodGet, api.AdminKafkaLagPath, nil)
+	case "journals":
+		err = client.do(http.MethodGet, api.AdminJournalsPath, nil)
+	case "replay":
+		if len(args) == 0 || len(args) > 2 {
+			err = fmt.Errorf("replay requires journal name and optional offset")
+			break
+		}
+		u := api.AdminJournalsPath + "/" + url.PathEscape(args[0]) + "/replay"
+		if len(args) == 2 {
+			u += "?offset=" + url.QueryEscape(args[1])
+		}
+		err = client.do(http.MethodPost, u, nil)
+	case "peers":
+		err = peersCommand(client, args)
+	case "routers":
+		err = routersCommand(client, args)
+	case "rib":
+		err = ribCommand(client, args)
+	case "as-graph":
+		u := api.ASGraphPath
+		if len(args) != 0 {
+			u += "?asn=" + args[0]
+		}
+		err = client.do(http.MethodGet, u, nil)
+	case "message-types":
+		err = client.do(http.MethodGet, api.MessageTypesPath, nil)
+	case "close":
+		if len(args) != 1 {
+			err = fmt.Errorf("close requires session id")
+			break
+		}
+		err = client.do(http.MethodPost, api.AdminSessionsPath+"/"+args[0]+"/close", nil)
+	case "pause", "resume":
+		if len(args) != 1 {
+			err = fmt.Errorf("%s requires router ip", flag.Arg(0))
+			break
+		}
+		err = client.do(http.MethodPost, api.AdminRoutersPath+args[0]+"/"+flag.Arg(0), nil)
+	default:
+		err = fmt.Errorf("unknown command %q", flag.Arg(0))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func logCommand(c *client, args []string) error {
+	fs := flag.NewFlagSet("log", flag.ExitOnError)
+	v := fs.Int("v", -1, "logging verbosity level")
+	vmodule := fs.String("vmodule", "", "comma separated list of pattern=N settings for file-filtered logging, \"-\" clears it")
+	_ = fs.Parse(args)
+	ls := &api.LogSettings{}
+	if *v >= 0 {
+		ls.Verbosity = v
+	}
+	if *vmodule == "-" {
+		*vmodule = ""
+		ls.VModule = vmodule
+	} else if *vmodule != "" {
+		ls.VModule = vmodule
+	}
+	if ls.Verbosity == nil && ls.VModule == nil {
+		return c.do(http.MethodGet, api.AdminLogPath, nil)
+	}
+
+	return c.do(http.MethodPut, api.AdminLogPath, ls)
+}
+
+func peersCommand(c *client, args []string) error {
+	fs := flag.NewFlagSet("peers", flag.ExitOnError)
+	format := fs.String("format", "json", "output format, json or csv")
+	_ = fs.Parse(args)
+
+	return c.do(http.MethodGet, api.PeersPath+"?format="+*format, nil)
+}
+
+func routersCommand(c *client, args []string) error {
+	fs := flag.NewFlagSet("routers", flag.ExitOnError)
+	router := fs.String("router", "", "ip address of the router, all routers when not specified")
+	_ = fs.Parse(args)
+	if *router == "" {
+		return c.do(http.MethodGet, api.RoutersPath, nil)
+	}
+
+	return c.do(http.MethodGet, api.RoutersPath+"?router="+url.QueryEscape(*router), nil)
+}
+
+func ribCommand(c *client, args []string) error {
+	fs := flag.NewFlagSet("rib", flag.ExitOnError)
+	router := fs.String("router", "", "ip address of the router")
+	peer := fs.String("peer", "", "ip address of the peer")
+	prefix := fs.String("prefix", "", "prefix and its length, routes of the prefix of all peers of all routers, or of the router and the peer when specified")
+	format := fs.String("format", "csv", "output format, json or csv")
+	_ = fs.Parse(args)
+	if *prefix == "" && (*router == "" || *peer == "") {
+		return fmt.Errorf("rib requires router and peer, or prefix")
+	}
+	q := url.Values{}
+	for k, v := range map[string]string{"router": *router, "peer": *peer, "prefix": *prefix} {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	q.Set("format", *format)
+
+	return c.do(http.MethodGet, api.RIBPath+"?"+q.Encode(), nil)
+}
+
+func hexDumpCommand(c *client, args []string) error {
+	if len(args) == 0 {
+		return c.do(http.MethodGet, api.AdminHexDumpPath, nil)
+	}
+	switch args[0] {
+	case "off":
+		return c.do(http.MethodDelete, api.AdminHexDumpPath, nil)
+	case "on":
+	default:
+		return fmt.Errorf("unknown hexdump argument %q", args[0])
+	}
+	fs := flag.NewFlagSet("hexdump", flag.ExitOnError)
+	routers := fs.String("routers", "", "comma separated list of routers ip, all routers if not specified")
+	peers := fs.String("peers", "", "comma separated list of peers ip, all peers if not specified")
+	types := fs.String("types", "", "comma separated list of BMP message types: route_monitor, statistics_report, peer_down, peer_up, initiation, termination, route_mirror")
+	maxMsgs := fs.Uint64("max", 0, "number of messages after which hex dump is disabled, 0 for no limit")
+	duration := fs.String("duration", "", "duration after which hex dump is disabled, for example \"5m\"")
+	file := fs.String("file", "", "name of the file in gobmp capture directory to write messages to instead of the log")
+	_ = fs.Parse(args[1:])
+	scope := &gobmpsrv.HexDump{
+		MaxMessages: *maxMsgs,
+		Duration:    *duration,
+		File:        *file,
+	}
+	if *routers != "" {
+		scope.Routers = strings.Split(*routers, ",")
+	}
+	if *peers != "" {
+		scope.Peers = strings.Split(*peers, ",")
+	}
+	if *types != "" {
+		scope.MessageTypes = strings.Split(*types, ",")
+	}
+
+	return c.do(http.MethodPut, api.AdminHexDumpPath, scope)
+}
+
+type client struct {
+	http *http.Client
+}
+
+func newClient() (*client, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if caCert != "" {
+		b, err := os.ReadFile(caCert)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no certificates found in %s", caCert)
+		}
+	}
+	if tlsCert != "" || tlsKey != "" {
+		cert, err := tls.LoadX509KeyPair(tlsCert, tlsKey)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return &client{
+		http: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: config},
+		},
+	}, nil
+}
+
+// do sends the request to API server and prints the response body to standard output
+func (c *client) do(method, path string, body interface{}) error {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, strings.TrimSuffix(apiSrv, "/")+path, r)
+	if err != nil {
+		return err
+	}
+	if apiKey != "" {
+		req.Header.Set(api.APIKeyHeader, apiKey)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("request failed with status %s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+	if len(b) != 0 {
+		os.Stdout.Write(b)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"container/heap"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/filer"
+)
+
+// fileMessages defines messages loaded from a file with timestamps of the messages, messages without
+// timestamp inherit the timestamp of the previous message, so they keep their position in the file
+type fileMessages struct {
+	msgs       []*filer.MsgOut
+	timestamps []time.Time
+}
+
+// expandFiles returns files of the comma separated list of files and glob patterns, files matching
+// a pattern are sorted by name
+func expandFiles(list string) ([]string, error) {
+	var files []string
+	for _, p := range strings.Split(list, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !strings.ContainsAny(p, "*?[") {
+			files = append(files, p)
+			continue
+		}
+		m, err := filepath.Glob(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid file pattern %s with error: %+v", p, err)
+		}
+		if len(m) == 0 {
+			return nil, fmt.Errorf("no files match pattern %s", p)
+		}
+		sort.Strings(m)
+		files = append(files, m...)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no message files are specified")
+	}
+
+	return files, nil
+}
+
+// loadFiles loads and decodes messages of the files in parallel, using up to the number of CPUs workers
+func loadFiles(files []string) ([]*fileMessages, error) {
+	fms := make([]*fileMessages, len(files))
+	errs := make([]error, len(files))
+	sem := make(chan struct{}, runtime.NumCPU())
+	var wg sync.WaitGroup
+	for i, file := range files {
+		wg.Add(1)
+		go func(i int, file string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			fms[i], errs[i] = loadFile(file)
+		}(i, file)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return fms, nil
+}
+
+func loadFile(file string) (*fileMessages, error) {
+	f, err := filer.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("fail to open messages file %s with error: %+v", file, err)
+	}
+	defer f.Close()
+	msgs, err := loadMessages(f)
+	if err != nil {
+		return nil, fmt.Errorf("fail to load messages file %s with error: %+v", file, err)
+	}
+	fm := &fileMessages{
+		msgs:       msgs,
+		timestamps: make([]time.Time, len(msgs)),
+	}
+	var last time.Time
+	for i, msg := range msgs {
+		if ts, ok := messageTimestamp(msg); ok {
+			last = ts
+		}
+		fm.timestamps[i] = last
+	}
+
+	return fm, nil
+}
+
+// messageTimestamp returns the timestamp embedded in the message
+func messageTimestamp(msg *filer.MsgOut) (time.Time, bool) {
+	m := &struct {
+		Timestamp string `json:"timestamp"`
+	}{}
+	if err := json.Unmarshal(msg.Value, m); err != nil || m.Timestamp == "" {
+		return time.Time{}, false
+	}
+	ts, err := time.Parse(time.RFC3339Nano, m.Timestamp)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return ts, true
+}
+
+// cursor is the position of the next message of a file in the merge
+type cursor struct {
+	file int
+	pos  int
+	fm   *fileMessages
+}
+
+type cursors []*cursor
+
+func (c cursors) Len() int { return len(c) }
+
+func (c cursors) Less(i, j int) bool {
+	ti, tj := c[i].fm.timestamps[c[i].pos], c[j].fm.timestamps[c[j].pos]
+	if !ti.Equal(tj) {
+		return ti.Before(tj)
+	}
+	// Messages with the same timestamp keep the order of files
+	return c[i].file < c[j].file
+}
+
+func (c cursors) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+
+func (c *cursors) Push(x interface{}) { *c = append(*c, x.(*cursor)) }
+
+func (c *cursors) Pop() interface{} {
+	old := *c
+	x := old[len(old)-1]
+	*c = old[:len(old)-1]
+	return x
+}
+
+// merge merges messages of the files ordered by timestamps, messages of each file are expected to be
+// ordered by timestamps already, as they are written by the collector
+func merge(fms []*fileMessages) []*filer.MsgOut {
+	n := 0
+	h := make(cursors, 0, len(fms))
+	for i, fm := range fms {
+		n += len(fm.msgs)
+		if len(fm.msgs) != 0 {
+			h = append(h, &cursor{file: i, fm: fm})
+		}
+	}
+	heap.Init(&h)
+	msgs := make([]*filer.MsgOut, 0, n)
+	for h.Len() != 0 {
+		c := h[0]
+		msgs = append(msgs, c.fm.msgs[c.pos])
+		c.pos++
+		if c.pos == len(c.fm.msgs) {
+			heap.Pop(&h)
+			continue
+		}
+		heap.Fix(&h, 0)
+	}
+
+	return msgs
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/filer"
+)
+
+// testMsg is the message of the key with the timestamp, the message has no timestamp when it is empty
+type testMsg struct {
+	key       string
+	timestamp string
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name  string
+		files [][]testMsg
+		keys  []string
+	}{
+		{
+			name: "two files interleaved",
+			files: [][]testMsg{
+				{{"a1", "2026-10-14T10:00:00Z"}, {"a2", "2026-10-14T10:00:02Z"}, {"a3", "2026-10-14T10:00:04Z"}},
+				{{"b1", "2026-10-14T10:00:01Z"}, {"b2", "2026-10-14T10:00:03Z"}},
+			},
+			keys: []string{"a1", "b1", "a2", "b2", "a3"},
+		},
+		{
+			name: "same timestamps keep the order of files",
+			files: [][]testMsg{
+				{{"a1", "2026-10-14T10:00:01Z"}, {"a2", "2026-10-14T10:00:01Z"}},
+				{{"b1", "2026-10-14T10:00:00Z"}, {"b2", "2026-10-14T10:00:01Z"}},
+				{{"c1", "2026-10-14T10:00:01Z"}, {"c2", "2026-10-14T10:00:02Z"}},
+			},
+			keys: []string{"b1", "a1", "a2", "b2", "c1", "c2"},
+		},
+		{
+			name: "fractional seconds",
+			files: [][]testMsg{
+				{{"a1", "2026-10-14T10:00:00.5Z"}},
+				{{"b1", "2026-10-14T10:00:00.25Z"}, {"b2", "2026-10-14T10:00:00.75Z"}},
+			},
+			keys: []string{"b1", "a1", "b2"},
+		},
+		{
+			name: "messages without timestamp keep their position in the file",
+			files: [][]testMsg{
+				{{"a1", "2026-10-14T10:00:00Z"}, {"a2", ""}, {"a3", "2026-10-14T10:00:05Z"}},
+				{{"b1", ""}, {"b2", "2026-10-14T10:00:01Z"}, {"b3", ""}},
+			},
+			keys: []string{"b1", "a1", "a2", "b2", "b3", "a3"},
+		},
+		{
+			name: "empty file",
+			files: [][]testMsg{
+				{},
+				{{"b1", "2026-10-14T10:00:01Z"}, {"b2", "2026-10-14T10:00:02Z"}},
+			},
+			keys: []string{"b1", "b2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for i, msgs := range tt.files {
+				p, err := filer.NewFiler(filepath.Join(dir, fmt.Sprintf("messages-%d.json", i)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, m := range msgs {
+					v := `{"prefix":"10.0.0.0"}`
+					if m.timestamp != "" {
+						v = `{"prefix":"10.0.0.0","timestamp":"` + m.timestamp + `"}`
+					}
+					if err := p.PublishMessage(bmp.UnicastPrefixV4Msg, []byte(m.key), []byte(v)); err != nil {
+						t.Fatal(err)
+					}
+				}
+				p.Stop()
+			}
+			files, err := expandFiles(filepath.Join(dir, "messages-*.json"))
+			if err != nil {
+				t.Fatalf("failed to expand files with error: %+v", err)
+			}
+			if len(files) != len(tt.files) {
+				t.Fatalf("expected %d files but got %d", len(tt.files), len(files))
+			}
+			fms, err := loadFiles(files)
+			if err != nil {
+				t.Fatalf("failed to load files with error: %+v", err)
+			}
+			keys := make([]string, 0, len(tt.keys))
+			for _, m := range merge(fms) {
+				keys = append(keys, string(m.Key))
+			}
+			if !reflect.DeepEqual(keys, tt.keys) {
+				t.Errorf("expected messages %v but got %v", tt.keys, keys)
+			}
+		})
+	}
+}
+
+func TestExpandFiles(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := expandFiles(filepath.Join(dir, "*.json")); err == nil {
+		t.Errorf("expected error when no files match the pattern")
+	}
+	if _, err := expandFiles(" , "); err == nil {
+		t.Errorf("expected error when no files are specified")
+	}
+	files, err := expandFiles("b.json, a.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(files, []string{"b.json", "a.json"}) {
+		t.Errorf("expected files in the order of the list but got %v", files)
+	}
+}
@@ -22,29 +22,29 @@ var (
 	file       string
 	delay      int
 	iterations int
+	ordered    bool
 )
 
 func init() {
 	flag.StringVar(&msgSrvAddr, "message-server", "", "URL to the messages supplying server")
-	flag.StringVar(&file, "msg-file", "/tmp/messages.json", "File with the bmp messages to replay")
+	flag.StringVar(&file, "msg-file", "/tmp/messages.json", "Comma separated list of files or glob patterns of files with the bmp messages to replay, gzip, zstd and bzip2 compressed files are supported, messages of multiple files are merged by timestamps")
 	flag.IntVar(&delay, "delay", 0, "Delay in seconds to add between sending messages")
 	flag.IntVar(&iterations, "iterations", 1, "Number of iterations to replay messages")
+	flag.BoolVar(&ordered, "ordered", false, "When set, messages are published one by one in the order of their timestamps, otherwise they are published concurrently")
 }
 
 func main() {
 	flag.Parse()
 	_ = flag.Set("logtostderr", "true")
 	glog.Infof("kafka server url: %s", msgSrvAddr)
-	// Open messages file
-	f, err := os.Open(file)
+	files, err := expandFiles(file)
 	if err != nil {
-		glog.Errorf("fail to open messages file %s with error: %+v", file, err)
+		glog.Errorf("fail to find messages files with error: %+v", err)
 		os.Exit(1)
 	}
-	defer f.Close()
 
 	// Initializing publisher process
-	publisher, err := kafka.NewKafkaPublisher(msgSrvAddr)
+	publisher, err := kafka.NewKafkaPublisher(msgSrvAddr, nil)
 	if err != nil {
 		glog.Errorf("fail to initialize Kafka publisher with error: %+v", err)
 		os.Exit(1)
@@ -52,23 +52,33 @@ func main() {
 	glog.V(5).Infof("Kafka publisher has been successfully initialized.")
 	defer publisher.Stop()
 
-	msgs, err := loadMessages(f)
+	// Messages files are decoded in parallel and merged by timestamps
+	fms, err := loadFiles(files)
 	if err != nil {
 		glog.Errorf("Failed to load messages with error: %+v", err)
 		os.Exit(1)
 	}
+	msgs := merge(fms)
+	glog.Infof("loaded %d messages from %d files", len(msgs), len(files))
 	records := 0
 	var wg sync.WaitGroup
+	publish := func(msg *filer.MsgOut) {
+		if err := publisher.PublishMessage(msg.Type, msg.Key, msg.Value); err != nil {
+			glog.Errorf("fail to publish message type: %d message key: %s with error: %+v", msg.Type, tools.MessageHex(msg.Key), err)
+		}
+	}
 	for i := 0; i < iterations; i++ {
 		start := time.Now()
 		for e := 0; e < len(msgs); e++ {
-			wg.Add(1)
-			go func(msg *filer.MsgOut) {
-				defer wg.Done()
-				if err := publisher.PublishMessage(msg.Type, msg.Key, msg.Value); err != nil {
-					glog.Errorf("fail to publish message type: %d message key: %s with error: %+v", msg.Type, tools.MessageHex(msg.Key), err)
-				}
-			}(msgs[e])
+			if ordered {
+				publish(msgs[e])
+			} else {
+				wg.Add(1)
+				go func(msg *filer.MsgOut) {
+					defer wg.Done()
+					publish(msg)
+				}(msgs[e])
+			}
 			records++
 			// If delay was specified in the input parameters, wait for n-seconds before sending next message.
 			time.Sleep(time.Second * time.Duration(delay))
@@ -81,7 +91,7 @@ func main() {
 	os.Exit(0)
 }
 
-func loadMessages(f *os.File) ([]*filer.MsgOut, error) {
+func loadMessages(f io.Reader) ([]*filer.MsgOut, error) {
 	msgs := make([]*filer.MsgOut, 0)
 	m := bufio.NewReader(f)
 	done := false
@@ -89,7 +99,7 @@ func loadMessages(f *os.File) ([]*filer.MsgOut, error) {
 		b, err := m.ReadBytes('\n')
 		if err != nil {
 			if err != io.EOF {
-				return nil, fmt.Errorf("fail to read messages file %s with error: %+v", file, err)
+				return nil, err
 			}
 			done = true
 			continue
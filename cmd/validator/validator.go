@@ -9,6 +9,7 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/filer"
 	"github.com/sbezverk/gobmp/pkg/kafka"
 	"github.com/sbezverk/gobmp/pkg/validator"
 )
@@ -23,7 +24,7 @@ var (
 
 func init() {
 	flag.StringVar(&msgSrvAddr, "kafka", "kafka:9092", "kafka server url, default: kafka:9092")
-	flag.StringVar(&msgFile, "msg-file", "./messages.json", "file to read from or to store to processed bmp messages")
+	flag.StringVar(&msgFile, "msg-file", "./messages.json", "file to read from or to store to processed bmp messages, gzip, zstd and bzip2 compressed files are read transparently")
 	flag.IntVar(&timeout, "timeout", 300, "timeout in seconds, default 300, for the test to complete all processing.")
 	flag.BoolVar(&validatorFlag, "validate", false, "when validator is true, incomming messages are validated against stored in the message file, otherwise the messages are stored in the file.")
 	flag.StringVar(&testCase, "test-case", "u4", "test case to validate or to collect messages")
@@ -37,11 +38,15 @@ func main() {
 	var b []byte
 	if validatorFlag {
 		// validator will receive messages from kafka and compare with messages stored in the message file\
-		if f, err = os.Open(msgFile); err != nil {
+		// compressed message file is decompressed transparently
+		r, err := filer.Open(msgFile)
+		if err != nil {
 			glog.Errorf("failed to open message file: %s with error: %+v", msgFile, err)
 			os.Exit(1)
 		}
-		if b, err = io.ReadAll(f); err != nil {
+		b, err = io.ReadAll(r)
+		r.Close()
+		if err != nil {
 			glog.Errorf("failed to read message file: %s with error: %+v", msgFile, err)
 			os.Exit(1)
 		}
@@ -50,9 +55,8 @@ func main() {
 			glog.Errorf("failed to create message file: %s with error: %+v", msgFile, err)
 			os.Exit(1)
 		}
+		defer f.Close()
 	}
-	// If this point is reached f cannot be nil
-	defer f.Close()
 	errCh := make(chan error)
 	stopCh := make(chan struct{})
 
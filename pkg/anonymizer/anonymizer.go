@@ -0,0 +1,291 @@
+package anonymizer
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// addressKeys is a list of json keys carrying IPv4 or IPv6 addresses
+var addressKeys = map[string]bool{
+	"router_ip":            true,
+	"peer_ip":              true,
+	"local_ip":             true,
+	"remote_ip":            true,
+	"remote_bgp_id":        true,
+	"local_bgp_id":         true,
+	"nexthop":              true,
+	"originator_id":        true,
+	"prefix":               true,
+	"router_id":            true,
+	"remote_router_id":     true,
+	"bgp_router_id":        true,
+	"bgp_remote_router_id": true,
+	"igp_router_id":        true,
+	"remote_igp_router_id": true,
+	"local_link_ip":        true,
+	"remote_link_ip":       true,
+	"ip_address":           true,
+	"gw_address":           true,
+	"srv6_sid":             true,
+	"sid":                  true,
+	"bgp_id":               true,
+	"mcast_src":            true,
+	"originator_router":    true,
+	"tunnel_endpoint":      true,
+	"sender":               true,
+	"root_node":            true,
+	"extended_tunnel_id":   true,
+	"source_pe":            true,
+	"bfr_prefix":           true,
+	"node_address":         true,
+	"local_address":        true,
+	"remote_address":       true,
+	"peer_bgp_id":          true,
+}
+
+// addressListKeys is a list of json keys carrying lists of IPv4 or IPv6 addresses
+var addressListKeys = map[string]bool{
+	"srv6_sids": true,
+}
+
+// prefixListKeys is a list of json keys carrying lists of prefixes in prefix/length form
+var prefixListKeys = map[string]bool{
+	"nlri":             true,
+	"withdrawn_routes": true,
+}
+
+// hashKeys is a list of json keys carrying hashes computed over addresses, the hashes are
+// replaced with keyed hashes, so they still can be used for correlation but cannot be brute forced.
+var hashKeys = map[string]bool{
+	"router_hash":      true,
+	"peer_hash":        true,
+	"local_node_hash":  true,
+	"remote_node_hash": true,
+}
+
+// aggregatorKeys is a list of json keys carrying raw Aggregator attributes, the last 4 bytes
+// of the attribute is the address of the aggregating speaker.
+var aggregatorKeys = map[string]bool{
+	"aggregator":     true,
+	"as4_aggregator": true,
+}
+
+// communityKeys is a list of json keys carrying communities or their labels, removed when communities stripping is requested
+var communityKeys = map[string]bool{
+	"community_list":        true,
+	"ext_community_list":    true,
+	"large_community_list":  true,
+	"communities_annotated": true,
+}
+
+// rawKeys is a list of json keys carrying raw BGP messages, they cannot be anonymized and are always removed
+var rawKeys = map[string]bool{
+	"pdu": true,
+}
+
+// AddressAnonymizer is implemented by the anonymizer to translate addresses into addresses found in published messages
+type AddressAnonymizer interface {
+	AnonymizeAddress(s string) string
+}
+
+type anonymizer struct {
+	publisher        pub.Publisher
+	cp               *CryptoPAn
+	hashKey          []byte
+	stripCommunities bool
+}
+
+func (a *anonymizer) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	m, err := a.anonymizeMessage(msg)
+	if err != nil {
+		return fmt.Errorf("failed to anonymize message of type %d with error: %+v", msgType, err)
+	}
+	var hash []byte
+	if len(msgHash) != 0 {
+		hash = []byte(a.anonymizeHash(string(msgHash)))
+	}
+
+	return a.publisher.PublishMessage(msgType, hash, m)
+}
+
+func (a *anonymizer) Stop() {
+	a.publisher.Stop()
+}
+
+func (a *anonymizer) anonymizeMessage(msg []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(msg))
+	// Preserving numbers as they are, decoding them into float64 would lose precision of 64 bits values
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(a.anonymizeValue(v))
+}
+
+func (a *anonymizer) anonymizeValue(v interface{}) interface{} {
+	switch o := v.(type) {
+	case map[string]interface{}:
+		a.anonymizeObject(o)
+	case []interface{}:
+		for i := range o {
+			o[i] = a.anonymizeValue(o[i])
+		}
+	}
+
+	return v
+}
+
+func (a *anonymizer) anonymizeObject(o map[string]interface{}) {
+	for k, v := range o {
+		if rawKeys[k] || (a.stripCommunities && communityKeys[k]) {
+			delete(o, k)
+			continue
+		}
+		if l, ok := v.([]interface{}); ok && addressListKeys[k] {
+			for i := range l {
+				if p, ok := l[i].(string); ok {
+					l[i] = a.anonymizeAddress(p)
+				}
+			}
+			continue
+		}
+		if l, ok := v.([]interface{}); ok && prefixListKeys[k] {
+			for i := range l {
+				if p, ok := l[i].(string); ok {
+					l[i] = a.anonymizePrefix(p)
+				}
+			}
+			continue
+		}
+		s, ok := v.(string)
+		if !ok {
+			o[k] = a.anonymizeValue(v)
+			continue
+		}
+		switch {
+		case addressKeys[k]:
+			o[k] = a.anonymizeAddress(s)
+		case hashKeys[k]:
+			o[k] = a.anonymizeHash(s)
+		case aggregatorKeys[k]:
+			o[k] = a.anonymizeAggregator(s)
+		case k == "cluster_list":
+			o[k] = a.anonymizeList(s)
+		}
+	}
+	// Anonymized prefix carries random host bits, masking them to keep prefix valid
+	p, ok := o["prefix"].(string)
+	if !ok {
+		return
+	}
+	l, ok := o["prefix_len"].(json.Number)
+	if !ok {
+		return
+	}
+	pl, err := l.Int64()
+	if err != nil {
+		return
+	}
+	ip := net.ParseIP(p)
+	if ip == nil {
+		return
+	}
+	bits := 128
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 32
+	}
+	if pl < 0 || pl > int64(bits) {
+		return
+	}
+	o["prefix"] = ip.Mask(net.CIDRMask(int(pl), bits)).String()
+}
+
+// AnonymizeAddress returns the address as anonymized in published messages
+func (a *anonymizer) AnonymizeAddress(s string) string {
+	return a.anonymizeAddress(s)
+}
+
+// anonymizeAddress returns anonymized address, if s is not a valid address, it is returned unchanged
+func (a *anonymizer) anonymizeAddress(s string) string {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return s
+	}
+
+	return a.cp.Anonymize(ip).String()
+}
+
+// anonymizeList anonymizes comma separated list of addresses
+func (a *anonymizer) anonymizeList(s string) string {
+	l := strings.Split(s, ",")
+	for i := range l {
+		l[i] = a.anonymizeAddress(strings.TrimSpace(l[i]))
+	}
+
+	return strings.Join(l, ", ")
+}
+
+// anonymizePrefix returns anonymized prefix in prefix/length form with host bits masked, if s is not a valid
+// prefix, it is returned unchanged
+func (a *anonymizer) anonymizePrefix(s string) string {
+	ip, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return s
+	}
+	l, bits := n.Mask.Size()
+	if ip.To4() != nil {
+		ip = ip.To4()
+	}
+
+	return fmt.Sprintf("%s/%d", a.cp.Anonymize(ip).Mask(net.CIDRMask(l, bits)), l)
+}
+
+func (a *anonymizer) anonymizeHash(s string) string {
+	mac := hmac.New(sha256.New, a.hashKey)
+	mac.Write([]byte(s))
+	h := mac.Sum(nil)
+	// Preserving the length of the original md5 hash
+	return hex.EncodeToString(h[:16])
+}
+
+func (a *anonymizer) anonymizeAggregator(s string) string {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(b) < net.IPv4len {
+		return s
+	}
+	copy(b[len(b)-net.IPv4len:], a.cp.Anonymize(net.IP(b[len(b)-net.IPv4len:])).To4())
+
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// NewAnonymizer returns a publisher anonymizing addresses found in messages before passing them
+// to the wrapped publisher. Addresses are anonymized with prefix-preserving Crypto-PAn using 32 bytes key,
+// when stripCommunities is true, standard, extended and large communities are removed from messages.
+func NewAnonymizer(publisher pub.Publisher, key []byte, stripCommunities bool) (pub.Publisher, error) {
+	cp, err := NewCryptoPAn(key)
+	if err != nil {
+		return nil, err
+	}
+	// Hash key is derived from the anonymization key, so the same key produces the same hashes
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("gobmp hash key"))
+
+	return &anonymizer{
+		publisher:        publisher,
+		cp:               cp,
+		hashKey:          mac.Sum(nil),
+		stripCommunities: stripCommunities,
+	}, nil
+}
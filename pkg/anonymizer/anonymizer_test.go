@@ -0,0 +1,215 @@
+package anonymizer
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"testing"
+)
+
+// Key and addresses are taken from the sample trace distributed with the reference implementation of Crypto-PAn
+var referenceKey = []byte{21, 34, 23, 141, 51, 164, 207, 128, 19, 10, 91, 22, 73, 144, 125, 16, 216, 152, 143, 131, 121, 121, 101, 39, 98, 87, 76, 45, 42, 132, 34, 2}
+
+func TestCryptoPAnReference(t *testing.T) {
+	tests := []struct {
+		orig   string
+		expect string
+	}{
+		{
+			orig:   "128.11.68.132",
+			expect: "135.242.180.132",
+		},
+		{
+			orig:   "129.118.74.4",
+			expect: "134.136.186.123",
+		},
+		{
+			orig:   "130.132.252.244",
+			expect: "133.68.164.234",
+		},
+	}
+	c, err := NewCryptoPAn(referenceKey)
+	if err != nil {
+		t.Fatalf("failed to initialize Crypto-PAn with error: %+v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.orig, func(t *testing.T) {
+			if a := c.Anonymize(net.ParseIP(tt.orig)).String(); a != tt.expect {
+				t.Errorf("expected anonymized address %s but got %s", tt.expect, a)
+			}
+		})
+	}
+}
+
+func TestCryptoPAnPrefixPreserving(t *testing.T) {
+	tests := []struct {
+		name   string
+		a      string
+		b      string
+		common int
+	}{
+		{
+			name:   "ipv4 /24",
+			a:      "10.1.1.1",
+			b:      "10.1.1.200",
+			common: 24,
+		},
+		{
+			name:   "ipv6 /64",
+			a:      "2001:db8:1:2::1",
+			b:      "2001:db8:1:2:ffff::1",
+			common: 64,
+		},
+	}
+	c, err := NewCryptoPAn(referenceKey)
+	if err != nil {
+		t.Fatalf("failed to initialize Crypto-PAn with error: %+v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := c.Anonymize(net.ParseIP(tt.a))
+			b := c.Anonymize(net.ParseIP(tt.b))
+			if a.Equal(net.ParseIP(tt.a)) {
+				t.Errorf("address %s was not anonymized", tt.a)
+			}
+			l := len(a) * 8
+			m := net.CIDRMask(tt.common, l)
+			if !a.Mask(m).Equal(b.Mask(m)) {
+				t.Errorf("anonymized addresses %s and %s do not share /%d prefix", a, b, tt.common)
+			}
+		})
+	}
+}
+
+type testPublisher struct {
+	hash []byte
+	msg  []byte
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.hash = msgHash
+	p.msg = msg
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+func TestAnonymizerPublishMessage(t *testing.T) {
+	tests := []struct {
+		name             string
+		msg              string
+		stripCommunities bool
+		check            func(t *testing.T, m map[string]interface{})
+	}{
+		{
+			name:             "unicast prefix",
+			msg:              `{"router_hash":"0a0b0c","peer_ip":"192.168.1.1","prefix":"10.1.0.0","prefix_len":16,"nexthop":"2001:db8::1","base_attrs":{"community_list":["65000:1"],"cluster_list":"1.1.1.1, 2.2.2.2","med":18446744073709551615}}`,
+			stripCommunities: true,
+			check: func(t *testing.T, m map[string]interface{}) {
+				if m["peer_ip"] == "192.168.1.1" {
+					t.Errorf("peer_ip was not anonymized")
+				}
+				if m["router_hash"] == "0a0b0c" {
+					t.Errorf("router_hash was not anonymized")
+				}
+				_, n, err := net.ParseCIDR(m["prefix"].(string) + "/16")
+				if err != nil || n.IP.String() != m["prefix"] {
+					t.Errorf("anonymized prefix %v has host bits set", m["prefix"])
+				}
+				if ip := net.ParseIP(m["nexthop"].(string)); ip == nil || ip.To4() != nil {
+					t.Errorf("anonymized nexthop %v is not ipv6 address", m["nexthop"])
+				}
+				attrs := m["base_attrs"].(map[string]interface{})
+				if _, ok := attrs["community_list"]; ok {
+					t.Errorf("community_list was not stripped")
+				}
+				if attrs["cluster_list"] == "1.1.1.1, 2.2.2.2" {
+					t.Errorf("cluster_list was not anonymized")
+				}
+				if attrs["med"].(json.Number).String() != "18446744073709551615" {
+					t.Errorf("number was not preserved, got %v", attrs["med"])
+				}
+			},
+		},
+		{
+			name: "communities are kept",
+			msg:  `{"base_attrs":{"community_list":["65000:1"]}}`,
+			check: func(t *testing.T, m map[string]interface{}) {
+				attrs := m["base_attrs"].(map[string]interface{})
+				if _, ok := attrs["community_list"]; !ok {
+					t.Errorf("community_list was stripped")
+				}
+			},
+		},
+		{
+			name: "mirrored update prefixes",
+			msg:  `{"bgp_id":"192.168.1.1","nlri":["10.1.1.0/24"],"withdrawn_routes":["10.2.0.0/16","invalid"]}`,
+			check: func(t *testing.T, m map[string]interface{}) {
+				if m["bgp_id"] == "192.168.1.1" {
+					t.Errorf("bgp_id was not anonymized")
+				}
+				nlri := m["nlri"].([]interface{})
+				if _, n, err := net.ParseCIDR(nlri[0].(string)); err != nil || n.String() != nlri[0] || nlri[0] == "10.1.1.0/24" {
+					t.Errorf("expected nlri prefix to be anonymized with host bits masked but got %v", nlri[0])
+				}
+				if w := m["withdrawn_routes"].([]interface{}); w[0] == "10.2.0.0/16" || w[1] != "invalid" {
+					t.Errorf("expected withdrawn prefix to be anonymized and invalid prefix kept but got %v", w)
+				}
+			},
+		},
+		{
+			name: "srv6 sids",
+			msg:  `{"srv6_sids":["2001:0:5:4:123::"]}`,
+			check: func(t *testing.T, m map[string]interface{}) {
+				sids := m["srv6_sids"].([]interface{})
+				if ip := net.ParseIP(sids[0].(string)); ip == nil || sids[0] == "2001:0:5:4:123::" {
+					t.Errorf("expected srv6 sid to be anonymized but got %v", sids[0])
+				}
+			},
+		},
+		{
+			name: "raw mirrored messages are removed",
+			msg:  `{"messages":[{"bgp_type":"update","pdu":"/////w==","decode_error":"malformed"}]}`,
+			check: func(t *testing.T, m map[string]interface{}) {
+				msg := m["messages"].([]interface{})[0].(map[string]interface{})
+				if _, ok := msg["pdu"]; ok {
+					t.Errorf("pdu was not removed")
+				}
+				if msg["decode_error"] != "malformed" {
+					t.Errorf("expected decode_error to be kept but got %v", msg["decode_error"])
+				}
+			},
+		},
+		{
+			name: "non address value is kept",
+			msg:  `{"igp_router_id":"0000.0000.0001"}`,
+			check: func(t *testing.T, m map[string]interface{}) {
+				if m["igp_router_id"] != "0000.0000.0001" {
+					t.Errorf("expected igp_router_id to be unchanged but got %v", m["igp_router_id"])
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := &testPublisher{}
+			a, err := NewAnonymizer(tp, referenceKey, tt.stripCommunities)
+			if err != nil {
+				t.Fatalf("failed to initialize anonymizer with error: %+v", err)
+			}
+			if err := a.PublishMessage(0, []byte("0a0b0c"), []byte(tt.msg)); err != nil {
+				t.Fatalf("failed to publish message with error: %+v", err)
+			}
+			if string(tp.hash) == "0a0b0c" {
+				t.Errorf("message hash was not anonymized")
+			}
+			var m map[string]interface{}
+			d := json.NewDecoder(bytes.NewReader(tp.msg))
+			d.UseNumber()
+			if err := d.Decode(&m); err != nil {
+				t.Fatalf("failed to unmarshal anonymized message with error: %+v", err)
+			}
+			tt.check(t, m)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package anonymizer
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+	"net"
+)
+
+// CryptoPAnKeyLength defines the length of Crypto-PAn key, first 16 bytes are used as AES key,
+// the last 16 bytes are used to generate the secret pad.
+const CryptoPAnKeyLength = 32
+
+// CryptoPAn implements prefix-preserving IP addresses anonymization as described in
+// "Prefix-Preserving IP Address Anonymization" by J. Xu, J. Fan, M. Ammar and S. Moon.
+// Two addresses sharing a prefix of n bits produce anonymized addresses sharing a prefix of n bits.
+type CryptoPAn struct {
+	block cipher.Block
+	pad   [aes.BlockSize]byte
+}
+
+// Anonymize returns anonymized copy of IPv4 or IPv6 address
+func (c *CryptoPAn) Anonymize(ip net.IP) net.IP {
+	if ip4 := ip.To4(); ip4 != nil {
+		return c.anonymize(ip4)
+	}
+	if ip16 := ip.To16(); ip16 != nil {
+		return c.anonymize(ip16)
+	}
+
+	return ip
+}
+
+func (c *CryptoPAn) anonymize(orig []byte) net.IP {
+	result := make([]byte, len(orig))
+	input := make([]byte, aes.BlockSize)
+	output := make([]byte, aes.BlockSize)
+	for pos := 0; pos < len(orig)*8; pos++ {
+		// Input block carries first pos bits of the original address followed by the bits of the pad
+		copy(input, c.pad[:])
+		for i := 0; i < pos/8; i++ {
+			input[i] = orig[i]
+		}
+		if r := pos % 8; r != 0 {
+			mask := byte(0xff << (8 - r))
+			input[pos/8] = orig[pos/8]&mask | c.pad[pos/8]&^mask
+		}
+		c.block.Encrypt(output, input)
+		// Most significant bit of the encrypted block defines whether the bit at pos gets flipped
+		result[pos/8] |= (output[0] >> 7) << (7 - pos%8)
+	}
+	for i := range result {
+		result[i] ^= orig[i]
+	}
+
+	return net.IP(result)
+}
+
+// NewCryptoPAn returns a new instance of Crypto-PAn anonymizer initialized with 32 bytes key
+func NewCryptoPAn(key []byte) (*CryptoPAn, error) {
+	if len(key) != CryptoPAnKeyLength {
+		return nil, fmt.Errorf("invalid Crypto-PAn key length %d, expected %d", len(key), CryptoPAnKeyLength)
+	}
+	block, err := aes.NewCipher(key[:16])
+	if err != nil {
+		return nil, err
+	}
+	c := &CryptoPAn{
+		block: block,
+	}
+	block.Encrypt(c.pad[:], key[16:])
+
+	return c, nil
+}
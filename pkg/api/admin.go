@@ -0,0 +1,262 @@
+package api
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+	"github.com/sbezverk/gobmp/pkg/kafka"
+	"github.com/sbezverk/gobmp/pkg/limits"
+	"github.com/sbezverk/gobmp/pkg/memory"
+)
+
+const (
+	// AdminSessionsPath defines the path of the BMP sessions admin endpoints
+	AdminSessionsPath = "/api/v1/admin/sessions"
+	// AdminRoutersPath defines the path of the routers admin endpoints
+	AdminRoutersPath = "/api/v1/admin/routers/"
+	// AdminVendorsPath defines the path of the admin endpoint exporting counters per vendor of routers
+	AdminVendorsPath = "/api/v1/admin/vendors"
+	// AdminMemoryPath defines the path of the admin endpoint exporting memory used per subsystem and peer
+	AdminMemoryPath = "/api/v1/admin/memory"
+	// AdminKafkaLagPath defines the path of the admin endpoint exporting lag of Kafka consumer groups
+	AdminKafkaLagPath = "/api/v1/admin/kafka-lag"
+	// AdminJournalsPath defines the path of the journals of raw BMP messages admin endpoints
+	AdminJournalsPath = "/api/v1/admin/journals"
+	// AdminLimitsPath defines the path of the admin endpoint exporting limits of decoded BGP messages
+	AdminLimitsPath = "/api/v1/admin/limits"
+)
+
+// SessionManager defines methods used by admin endpoints to manage BMP sessions
+type SessionManager interface {
+	Sessions() []gobmpsrv.SessionInfo
+	Peers() []gobmpsrv.PeerInfo
+	Routers() []gobmpsrv.RouterInfo
+	CloseSession(id uint64) error
+	PauseRouter(router string, pause bool) error
+	SetHexDump(scope *gobmpsrv.HexDump) error
+	HexDump() *gobmpsrv.HexDump
+	Vendors() []gobmpsrv.VendorInfo
+	MemoryUsage() []memory.Usage
+	Journals() ([]gobmpsrv.JournalInfo, error)
+	ReplayJournal(name string, offset int64) error
+}
+
+// KafkaLag defines methods of the monitor of Kafka consumer groups lag used by the kafka lag endpoint
+type KafkaLag interface {
+	Lag() []kafka.GroupLag
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		glog.Errorf("failed to write API response with error: %+v", err)
+	}
+}
+
+// sessionsHandler serves:
+//
+//	GET  /api/v1/admin/sessions            lists active BMP sessions
+//	POST /api/v1/admin/sessions/{id}/close closes BMP session
+func (srv *server) sessionsHandler(w http.ResponseWriter, r *http.Request) {
+	if srv.sessions == nil {
+		http.Error(w, "sessions management is not available", http.StatusServiceUnavailable)
+		return
+	}
+	p := strings.Trim(strings.TrimPrefix(r.URL.Path, AdminSessionsPath), "/")
+	if p == "" {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeJSON(w, srv.sessions.Sessions())
+		return
+	}
+	parts := strings.Split(p, "/")
+	if len(parts) != 2 || parts[1] != "close" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	id, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		http.Error(w, "invalid session id "+parts[0], http.StatusBadRequest)
+		return
+	}
+	if err := srv.sessions.CloseSession(id); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	glog.Infof("tenant %q closed bmp session %d", tenantFromContext(r.Context()).Name, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// journalsHandler serves:
+//
+//	GET  /api/v1/admin/journals                          lists journals of raw BMP messages
+//	POST /api/v1/admin/journals/{name}/replay[?offset=N] publishes messages of the journal from the offset
+func (srv *server) journalsHandler(w http.ResponseWriter, r *http.Request) {
+	if srv.sessions == nil {
+		http.Error(w, "sessions management is not available", http.StatusServiceUnavailable)
+		return
+	}
+	p := strings.Trim(strings.TrimPrefix(r.URL.Path, AdminJournalsPath), "/")
+	if p == "" {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		l, err := srv.sessions.Journals()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		writeJSON(w, l)
+		return
+	}
+	parts := strings.Split(p, "/")
+	if len(parts) != 2 || parts[1] != "replay" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var offset int64
+	if v := r.URL.Query().Get("offset"); v != "" {
+		var err error
+		if offset, err = strconv.ParseInt(v, 10, 64); err != nil {
+			http.Error(w, "invalid offset "+v, http.StatusBadRequest)
+			return
+		}
+	}
+	if err := srv.sessions.ReplayJournal(parts[0], offset); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	glog.Infof("tenant %q replays journal %s from offset %d", tenantFromContext(r.Context()).Name, parts[0], offset)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// routersHandler serves:
+//
+//	POST /api/v1/admin/routers/{router ip}/pause    pauses publishing of messages received from the router
+//	POST /api/v1/admin/routers/{router ip}/resume   resumes publishing of messages received from the router
+//	POST /api/v1/admin/routers/{router ip}/snapshot republishes routes of the router stored in the RIB
+func (srv *server) routersHandler(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, AdminRoutersPath), "/"), "/")
+	if len(parts) != 2 || (parts[1] != "pause" && parts[1] != "resume" && parts[1] != "snapshot") {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if parts[1] == "snapshot" {
+		srv.snapshotHandler(w, r, parts[0])
+		return
+	}
+	if srv.sessions == nil {
+		http.Error(w, "sessions management is not available", http.StatusServiceUnavailable)
+		return
+	}
+	pause := parts[1] == "pause"
+	if err := srv.sessions.PauseRouter(parts[0], pause); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	glog.Infof("tenant %q set publishing paused for router %s to %t", tenantFromContext(r.Context()).Name, parts[0], pause)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// snapshotHandler republishes routes of the router stored in the RIB through the publisher of the RIB and returns
+// the number of republished routes
+func (srv *server) snapshotHandler(w http.ResponseWriter, r *http.Request, router string) {
+	if srv.rib == nil {
+		http.Error(w, "rib is not available, it requires route-age", http.StatusServiceUnavailable)
+		return
+	}
+	if net.ParseIP(router) == nil {
+		http.Error(w, "invalid router address "+router, http.StatusBadRequest)
+		return
+	}
+	n, err := srv.rib.Snapshot(router)
+	if err != nil {
+		glog.Errorf("failed to publish snapshot of routes of router %s with error: %+v", router, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	glog.Infof("tenant %q republished %d routes of router %s", tenantFromContext(r.Context()).Name, n, router)
+	writeJSON(w, struct {
+		RouterIP string `json:"router_ip"`
+		Routes   int    `json:"routes"`
+	}{RouterIP: router, Routes: n})
+}
+
+// vendorsHandler serves:
+//
+//	GET /api/v1/admin/vendors lists counters of BMP messages, parsing errors and used features per vendor of routers
+func (srv *server) vendorsHandler(w http.ResponseWriter, r *http.Request) {
+	if srv.sessions == nil {
+		http.Error(w, "sessions management is not available", http.StatusServiceUnavailable)
+		return
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, srv.sessions.Vendors())
+}
+
+// memoryHandler serves:
+//
+//	GET /api/v1/admin/memory returns memory used by the collector and estimated memory used per subsystem and peer
+func (srv *server) memoryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var usage []memory.Usage
+	if srv.sessions != nil {
+		usage = append(usage, srv.sessions.MemoryUsage()...)
+	}
+	for _, r := range srv.memory {
+		usage = append(usage, r.MemoryUsage())
+	}
+	writeJSON(w, memory.NewStats(usage))
+}
+
+// kafkaLagHandler serves:
+//
+//	GET /api/v1/admin/kafka-lag returns lag of monitored Kafka consumer groups on gobmp topics
+func (srv *server) kafkaLagHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if srv.kafkaLag == nil {
+		http.Error(w, "kafka consumer groups lag monitoring is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	writeJSON(w, srv.kafkaLag.Lag())
+}
+
+// limitsHandler serves:
+//
+//	GET /api/v1/admin/limits returns limits of values decoded from BGP messages and the number of times they were exceeded
+func (srv *server) limitsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, limits.GetStatus())
+}
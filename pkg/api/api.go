@@ -0,0 +1,246 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+const (
+	// APIKeyHeader defines the http header carrying the API key, alternatively the key can be
+	// passed as a bearer token in Authorization header.
+	APIKeyHeader = "X-API-Key"
+	// StreamPath defines the path of the messages stream endpoint
+	StreamPath = "/api/v1/stream"
+)
+
+// Server defines methods to manage API server. Server also implements pub.Publisher interface,
+// messages published to the server are passed to the wrapped publisher and to the API streams.
+type Server interface {
+	pub.Publisher
+	Start()
+	// SetSessionManager sets the manager of BMP sessions used by admin endpoints,
+	// it must be called before Start.
+	SetSessionManager(m SessionManager)
+	// SetASGraph sets the AS-level graph exposed by the as graph endpoint, it must be called before Start.
+	SetASGraph(g ASGraph)
+	// SetRIB sets the RIB of peers exported by the rib endpoint, it must be called before Start.
+	SetRIB(r RIB)
+	// SetMemoryReporters sets subsystems exposing memory usage by the memory admin endpoint, it must be called
+	// before Start.
+	SetMemoryReporters(r []memory.Reporter)
+	// SetKafkaLag sets the monitor of Kafka consumer groups lag exposed by the kafka lag endpoint, it must be called
+	// before Start.
+	SetKafkaLag(m KafkaLag)
+}
+
+type contextKey int
+
+const tenantContextKey contextKey = iota
+
+type server struct {
+	publisher pub.Publisher
+	tenants   *tenants
+	stream    *streamer
+	listener  net.Listener
+	http      *http.Server
+	sessions  SessionManager
+	graph     ASGraph
+	rib       RIB
+	memory    []memory.Reporter
+	kafkaLag  KafkaLag
+}
+
+func (srv *server) Start() {
+	glog.Infof("Starting gobmp API server on %s", srv.listener.Addr().String())
+	go func() {
+		if err := srv.http.Serve(srv.listener); err != nil && err != http.ErrServerClosed {
+			glog.Errorf("gobmp API server failed with error: %+v", err)
+		}
+	}()
+}
+
+func (srv *server) SetSessionManager(m SessionManager) {
+	srv.sessions = m
+}
+
+func (srv *server) SetASGraph(g ASGraph) {
+	srv.graph = g
+}
+
+func (srv *server) SetRIB(r RIB) {
+	srv.rib = r
+}
+
+func (srv *server) SetMemoryReporters(r []memory.Reporter) {
+	srv.memory = r
+}
+
+func (srv *server) SetKafkaLag(m KafkaLag) {
+	srv.kafkaLag = m
+}
+
+func (srv *server) Stop() {
+	glog.Infof("Stopping gobmp API server")
+	srv.stream.closeAll()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.http.Shutdown(ctx); err != nil {
+		glog.Errorf("failed to shutdown gobmp API server with error: %+v", err)
+	}
+	if srv.publisher != nil {
+		srv.publisher.Stop()
+	}
+}
+
+func (srv *server) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	srv.stream.publish(msgType, msgHash, msg)
+	if srv.publisher == nil {
+		return nil
+	}
+
+	return srv.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+// authenticate returns the tenant identified by the verified client certificate or by the API key of the request
+func (srv *server) authenticate(r *http.Request) (*Tenant, bool) {
+	if r.TLS != nil && len(r.TLS.VerifiedChains) != 0 && len(r.TLS.PeerCertificates) != 0 {
+		if tenant, ok := srv.tenants.lookupCommonName(r.TLS.PeerCertificates[0].Subject.CommonName); ok {
+			return tenant, true
+		}
+	}
+	key := r.Header.Get(APIKeyHeader)
+	if key == "" {
+		key = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	}
+	if key == "" {
+		return nil, false
+	}
+
+	return srv.tenants.lookup(key)
+}
+
+// authorize validates that the request's tenant has at least the role required by the handler
+// and stores the tenant in the request's context
+func (srv *server) authorize(role Role, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tenant, ok := srv.authenticate(r)
+		if !ok {
+			glog.Warningf("rejected unauthenticated request to %s from %s", r.URL.Path, r.RemoteAddr)
+			http.Error(w, "invalid api key or client certificate", http.StatusUnauthorized)
+			return
+		}
+		if tenant.role < role {
+			glog.Warningf("rejected request of tenant %q to %s from %s, insufficient role", tenant.Name, r.URL.Path, r.RemoteAddr)
+			http.Error(w, "insufficient role", http.StatusForbidden)
+			return
+		}
+		next(w, r.WithContext(context.WithValue(r.Context(), tenantContextKey, tenant)))
+	}
+}
+
+func tenantFromContext(ctx context.Context) *Tenant {
+	t, _ := ctx.Value(tenantContextKey).(*Tenant)
+	return t
+}
+
+// NewTLSConfig returns TLS configuration of the API server, when clientCAFile is not empty, the server
+// verifies client certificates against certificate authorities from the file. Clients without
+// certificates can still authenticate by API keys.
+func NewTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load API server certificate with error: %+v", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile == "" {
+		return config, nil
+	}
+	b, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificates found in client CA file %s", clientCAFile)
+	}
+	config.ClientCAs = pool
+	config.ClientAuth = tls.VerifyClientCertIfGiven
+
+	return config, nil
+}
+
+// NewServer instantiates a new instance of API server listening on port, the server authenticates
+// requests by the API keys or client certificates of tenants. When tlsConfig is not nil, the server
+// uses TLS. Messages published to the server are passed to publisher p.
+func NewServer(port int, tenantList []*Tenant, tlsConfig *tls.Config, p pub.Publisher) (Server, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, err
+	}
+	srv, err := NewServerWithListener(listener, tenantList, tlsConfig, p)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return srv, nil
+}
+
+// NewServerWithListener instantiates a new instance of API server accepting connections by the listener,
+// for example the listener inherited from the service manager.
+func NewServerWithListener(listener net.Listener, tenantList []*Tenant, tlsConfig *tls.Config, p pub.Publisher) (Server, error) {
+	ts, err := newTenants(tenantList)
+	if err != nil {
+		return nil, err
+	}
+	if len(ts.byKey) == 0 && len(ts.byCN) == 0 {
+		return nil, fmt.Errorf("no tenants are defined, at least one tenant is required to access the API")
+	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+	srv := &server{
+		publisher: p,
+		tenants:   ts,
+		stream:    newStreamer(),
+		listener:  listener,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(StreamPath, srv.authorize(RoleReadOnly, srv.streamHandler))
+	mux.HandleFunc(PeersPath, srv.authorize(RoleReadOnly, srv.peersHandler))
+	mux.HandleFunc(RoutersPath, srv.authorize(RoleReadOnly, srv.connectedRoutersHandler))
+	mux.HandleFunc(ASGraphPath, srv.authorize(RoleReadOnly, srv.asGraphHandler))
+	mux.HandleFunc(RIBPath, srv.authorize(RoleReadOnly, srv.ribHandler))
+	mux.HandleFunc(MessageTypesPath, srv.authorize(RoleReadOnly, srv.messageTypesHandler))
+	mux.HandleFunc(AdminSessionsPath, srv.authorize(RoleAdmin, srv.sessionsHandler))
+	mux.HandleFunc(AdminSessionsPath+"/", srv.authorize(RoleAdmin, srv.sessionsHandler))
+	mux.HandleFunc(AdminRoutersPath, srv.authorize(RoleAdmin, srv.routersHandler))
+	mux.HandleFunc(AdminVendorsPath, srv.authorize(RoleAdmin, srv.vendorsHandler))
+	mux.HandleFunc(AdminMemoryPath, srv.authorize(RoleAdmin, srv.memoryHandler))
+	mux.HandleFunc(AdminLimitsPath, srv.authorize(RoleAdmin, srv.limitsHandler))
+	mux.HandleFunc(AdminKafkaLagPath, srv.authorize(RoleAdmin, srv.kafkaLagHandler))
+	mux.HandleFunc(AdminJournalsPath, srv.authorize(RoleAdmin, srv.journalsHandler))
+	mux.HandleFunc(AdminJournalsPath+"/", srv.authorize(RoleAdmin, srv.journalsHandler))
+	mux.HandleFunc(AdminLogPath, srv.authorize(RoleAdmin, srv.logHandler))
+	mux.HandleFunc(AdminHexDumpPath, srv.authorize(RoleAdmin, srv.hexDumpHandler))
+	srv.http = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return srv, nil
+}
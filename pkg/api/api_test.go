@@ -0,0 +1,104 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAuthorize(t *testing.T) {
+	ts, err := newTenants([]*Tenant{
+		{Name: "reader", Key: "reader-key"},
+		{Name: "admin", Key: "admin-key", RoleName: "admin"},
+		{Name: "cert-admin", CommonName: "ops.example.com", RoleName: "admin"},
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize tenants with error: %+v", err)
+	}
+	srv := &server{tenants: ts}
+	clientCert := func(cn string) *tls.ConnectionState {
+		c := &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+		return &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{c},
+			VerifiedChains:   [][]*x509.Certificate{{c}},
+		}
+	}
+	tests := []struct {
+		name   string
+		role   Role
+		header map[string]string
+		tls    *tls.ConnectionState
+		status int
+		tenant string
+	}{
+		{
+			name:   "no credentials",
+			role:   RoleReadOnly,
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "invalid key",
+			role:   RoleReadOnly,
+			header: map[string]string{APIKeyHeader: "bogus"},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "reader key on read-only endpoint",
+			role:   RoleReadOnly,
+			header: map[string]string{APIKeyHeader: "reader-key"},
+			status: http.StatusOK,
+			tenant: "reader",
+		},
+		{
+			name:   "reader key on admin endpoint",
+			role:   RoleAdmin,
+			header: map[string]string{APIKeyHeader: "reader-key"},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "admin bearer token on admin endpoint",
+			role:   RoleAdmin,
+			header: map[string]string{"Authorization": "Bearer admin-key"},
+			status: http.StatusOK,
+			tenant: "admin",
+		},
+		{
+			name:   "admin certificate on admin endpoint",
+			role:   RoleAdmin,
+			tls:    clientCert("ops.example.com"),
+			status: http.StatusOK,
+			tenant: "cert-admin",
+		},
+		{
+			name:   "unknown certificate falls back to api key",
+			role:   RoleAdmin,
+			tls:    clientCert("unknown.example.com"),
+			header: map[string]string{APIKeyHeader: "reader-key"},
+			status: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tenant *Tenant
+			h := srv.authorize(tt.role, func(w http.ResponseWriter, r *http.Request) {
+				tenant = tenantFromContext(r.Context())
+			})
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for k, v := range tt.header {
+				r.Header.Set(k, v)
+			}
+			r.TLS = tt.tls
+			w := httptest.NewRecorder()
+			h(w, r)
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d but got %d", tt.status, w.Code)
+			}
+			if tt.tenant != "" && (tenant == nil || tenant.Name != tt.tenant) {
+				t.Errorf("expected tenant %q but got %+v", tt.tenant, tenant)
+			}
+		})
+	}
+}
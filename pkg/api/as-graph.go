@@ -0,0 +1,63 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/sbezverk/gobmp/pkg/asgraph"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// ASGraphPath defines the path of the AS-level graph endpoint
+const ASGraphPath = "/api/v1/as-graph"
+
+// ASGraph defines methods of the AS-level graph used by the API server
+type ASGraph interface {
+	Links() []asgraph.Link
+}
+
+// asGraphHandler serves:
+//
+//	GET /api/v1/as-graph[?asn={asn}] returns links of the AS-level graph observed by routers visible to the tenant,
+//	                                  optionally only links of the AS
+func (srv *server) asGraphHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if srv.graph == nil {
+		http.Error(w, "as graph is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	var asn uint64
+	if v := r.URL.Query().Get("asn"); v != "" {
+		var err error
+		if asn, err = strconv.ParseUint(v, 10, 32); err != nil || asn == 0 {
+			http.Error(w, "invalid asn "+v, http.StatusBadRequest)
+			return
+		}
+	}
+	tenant := tenantFromContext(r.Context())
+	links := make([]asgraph.Link, 0)
+	for _, l := range srv.graph.Links() {
+		if asn != 0 && uint64(l.ASN1) != asn && uint64(l.ASN2) != asn {
+			continue
+		}
+		routers := make([]string, 0, len(l.Routers))
+		for _, router := range l.Routers {
+			if tenant.allowed(bmp.ASGraphMsg, &messageScope{RouterIP: router}) {
+				routers = append(routers, router)
+			}
+		}
+		if len(routers) == 0 {
+			continue
+		}
+		if len(routers) != len(l.Routers) {
+			// The number of paths includes paths received by routers not visible to the tenant
+			l.Paths = 0
+		}
+		l.Routers = routers
+		links = append(links, l)
+	}
+	writeJSON(w, links)
+}
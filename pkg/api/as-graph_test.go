@@ -0,0 +1,96 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/asgraph"
+)
+
+type testASGraph []asgraph.Link
+
+func (g testASGraph) Links() []asgraph.Link {
+	return append([]asgraph.Link(nil), g...)
+}
+
+func TestASGraphHandler(t *testing.T) {
+	ts, err := newTenants([]*Tenant{
+		{Name: "all", Key: "all-key"},
+		{Name: "router", Key: "router-key", Routers: []string{"10.0.0.1"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize tenants with error: %+v", err)
+	}
+	srv := &server{
+		tenants: ts,
+		graph: testASGraph{
+			{ASN1: 65001, ASN2: 65002, Paths: 3, Routers: []string{"10.0.0.1", "10.0.0.2"}},
+			{ASN1: 65002, ASN2: 65003, Paths: 1, Routers: []string{"10.0.0.2"}},
+		},
+	}
+	h := srv.authorize(RoleReadOnly, srv.asGraphHandler)
+	tests := []struct {
+		name   string
+		key    string
+		query  string
+		status int
+		links  []asgraph.Link
+	}{
+		{
+			name:   "all links",
+			key:    "all-key",
+			status: http.StatusOK,
+			links: []asgraph.Link{
+				{ASN1: 65001, ASN2: 65002, Paths: 3, Routers: []string{"10.0.0.1", "10.0.0.2"}},
+				{ASN1: 65002, ASN2: 65003, Paths: 1, Routers: []string{"10.0.0.2"}},
+			},
+		},
+		{
+			name:   "links of as",
+			key:    "all-key",
+			query:  "?asn=65003",
+			status: http.StatusOK,
+			links: []asgraph.Link{
+				{ASN1: 65002, ASN2: 65003, Paths: 1, Routers: []string{"10.0.0.2"}},
+			},
+		},
+		{
+			name:   "links of router",
+			key:    "router-key",
+			status: http.StatusOK,
+			links: []asgraph.Link{
+				{ASN1: 65001, ASN2: 65002, Routers: []string{"10.0.0.1"}},
+			},
+		},
+		{
+			name:   "invalid asn",
+			key:    "all-key",
+			query:  "?asn=as65001",
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, ASGraphPath+tt.query, nil)
+			r.Header.Set(APIKeyHeader, tt.key)
+			w := httptest.NewRecorder()
+			h(w, r)
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d but got %d", tt.status, w.Code)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var links []asgraph.Link
+			if err := json.NewDecoder(w.Body).Decode(&links); err != nil {
+				t.Fatalf("failed to decode json with error: %+v", err)
+			}
+			if !reflect.DeepEqual(links, tt.links) {
+				t.Errorf("expected links %+v but got %+v", tt.links, links)
+			}
+		})
+	}
+}
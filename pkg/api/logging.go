@@ -0,0 +1,116 @@
+package api
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+)
+
+const (
+	// AdminLogPath defines the path of the logging verbosity admin endpoint
+	AdminLogPath = "/api/v1/admin/log"
+	// AdminHexDumpPath defines the path of the hex dump admin endpoint
+	AdminHexDumpPath = "/api/v1/admin/hexdump"
+)
+
+// LogSettings defines logging verbosity settings, Verbosity and VModule use the same
+// syntax as --v and --vmodule flags.
+type LogSettings struct {
+	Verbosity *int    `json:"verbosity,omitempty"`
+	VModule   *string `json:"vmodule,omitempty"`
+}
+
+func currentLogSettings() *LogSettings {
+	ls := &LogSettings{}
+	if f := flag.Lookup("v"); f != nil {
+		if v, err := strconv.Atoi(f.Value.String()); err == nil {
+			ls.Verbosity = &v
+		}
+	}
+	if f := flag.Lookup("vmodule"); f != nil {
+		vm := f.Value.String()
+		ls.VModule = &vm
+	}
+
+	return ls
+}
+
+// logHandler serves:
+//
+//	GET /api/v1/admin/log returns current logging verbosity settings
+//	PUT /api/v1/admin/log changes logging verbosity settings
+func (srv *server) logHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		ls := &LogSettings{}
+		if err := json.NewDecoder(r.Body).Decode(ls); err != nil {
+			http.Error(w, "invalid log settings: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if ls.Verbosity != nil {
+			if err := flag.Set("v", strconv.Itoa(*ls.Verbosity)); err != nil {
+				http.Error(w, "invalid verbosity: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		if ls.VModule != nil {
+			if err := flag.Set("vmodule", *ls.VModule); err != nil {
+				http.Error(w, "invalid vmodule: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		glog.Infof("tenant %q changed logging settings to %s", tenantFromContext(r.Context()).Name, logSettingsString(currentLogSettings()))
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, currentLogSettings())
+}
+
+func logSettingsString(ls *LogSettings) string {
+	b, _ := json.Marshal(ls)
+	return string(b)
+}
+
+// hexDumpHandler serves:
+//
+//	GET    /api/v1/admin/hexdump returns the scope of hex dump logging
+//	PUT    /api/v1/admin/hexdump enables hex dump logging of received BMP messages within the scope
+//	DELETE /api/v1/admin/hexdump disables hex dump logging
+func (srv *server) hexDumpHandler(w http.ResponseWriter, r *http.Request) {
+	if srv.sessions == nil {
+		http.Error(w, "sessions management is not available", http.StatusServiceUnavailable)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		scope := &gobmpsrv.HexDump{}
+		if err := json.NewDecoder(r.Body).Decode(scope); err != nil {
+			http.Error(w, "invalid hex dump scope: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := srv.sessions.SetHexDump(scope); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	case http.MethodDelete:
+		_ = srv.sessions.SetHexDump(nil)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	scope := srv.sessions.HexDump()
+	if scope == nil {
+		http.Error(w, "hex dump logging is disabled", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, scope)
+}
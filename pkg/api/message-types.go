@@ -0,0 +1,107 @@
+package api
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// MessageTypesPath defines the path of the endpoint exporting the registry of message types
+const MessageTypesPath = "/api/v1/message-types"
+
+// MessageTypeInfo defines a type of published messages, Fields lists json fields of messages of the type
+// as described by the schema registered for the type
+type MessageTypeInfo struct {
+	Type   int           `json:"type"`
+	Name   string        `json:"name"`
+	Topic  string        `json:"topic"`
+	Fields []SchemaField `json:"fields,omitempty"`
+}
+
+// SchemaField defines a json field of messages, Type is the json type of the field
+type SchemaField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// jsonType returns the json type of values of the Go type
+func jsonType(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.String:
+		return "string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// Byte slices are marshaled as base64 strings
+			return "string"
+		}
+		return "array"
+	case reflect.Array:
+		return "array"
+	}
+
+	return "object"
+}
+
+// schemaFields returns json fields of the struct, fields of embedded structs without json name are
+// promoted as by encoding/json
+func schemaFields(t reflect.Type) []SchemaField {
+	var fields []SchemaField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			fields = append(fields, schemaFields(ft)...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, SchemaField{Name: name, Type: jsonType(f.Type)})
+	}
+
+	return fields
+}
+
+// messageTypesHandler serves:
+//
+//	GET /api/v1/message-types returns message types visible to the tenant with their default topics and schemas
+func (srv *server) messageTypesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	tenant := tenantFromContext(r.Context())
+	types := make([]MessageTypeInfo, 0)
+	for _, mt := range bmp.MessageTypes() {
+		if !tenant.allowedType(mt.Type) {
+			continue
+		}
+		info := MessageTypeInfo{Type: mt.Type, Name: mt.Name, Topic: mt.Topic}
+		if mt.Schema != nil && mt.Schema.Kind() == reflect.Struct {
+			info.Fields = schemaFields(mt.Schema)
+		}
+		types = append(types, info)
+	}
+	writeJSON(w, types)
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestMessageTypesHandler(t *testing.T) {
+	ts, err := newTenants([]*Tenant{
+		{Name: "all", Key: "all-key"},
+		{Name: "peer", Key: "peer-key", MessageTypes: []string{"peer"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize tenants with error: %+v", err)
+	}
+	srv := &server{tenants: ts}
+	h := srv.authorize(RoleReadOnly, srv.messageTypesHandler)
+	tests := []struct {
+		name  string
+		key   string
+		types int
+	}{
+		{
+			name:  "all types",
+			key:   "all-key",
+			types: len(bmp.MessageTypes()),
+		},
+		{
+			name:  "types of tenant",
+			key:   "peer-key",
+			types: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, MessageTypesPath, nil)
+			r.Header.Set(APIKeyHeader, tt.key)
+			w := httptest.NewRecorder()
+			h(w, r)
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status %d but got %d", http.StatusOK, w.Code)
+			}
+			var types []MessageTypeInfo
+			if err := json.NewDecoder(w.Body).Decode(&types); err != nil {
+				t.Fatalf("failed to decode json with error: %+v", err)
+			}
+			if len(types) != tt.types {
+				t.Fatalf("expected %d message types but got %d", tt.types, len(types))
+			}
+			for _, mt := range types {
+				if mt.Type != bmp.PeerStateChangeMsg {
+					continue
+				}
+				if mt.Topic != "gobmp.parsed.peer" {
+					t.Errorf("expected topic gobmp.parsed.peer but got %s", mt.Topic)
+				}
+				found := false
+				for _, f := range mt.Fields {
+					if f.Name == "remote_asn" && f.Type == "number" {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("expected field remote_asn of type number in fields %+v", mt.Fields)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+)
+
+// PeersPath defines the path of the peer table export endpoint
+const PeersPath = "/api/v1/peers"
+
+var peerCSVHeader = []string{
+	"session_id", "router_ip", "peer_type", "peer_rd", "peer_ip", "peer_asn", "peer_bgp_id", "local_ip", "local_asn",
+	"state", "state_since", "uptime_seconds", "down_reason", "adv_cap", "recv_cap", "cap_mismatch", "adj_rib_in_routes",
+	"loc_rib_routes", "route_monitoring_messages",
+}
+
+func peerCSVRecord(p *gobmpsrv.PeerInfo) []string {
+	return []string{
+		strconv.FormatUint(p.SessionID, 10),
+		p.RouterIP,
+		strconv.Itoa(int(p.PeerType)),
+		p.PeerRD,
+		p.PeerIP,
+		strconv.FormatUint(uint64(p.PeerASN), 10),
+		p.PeerBGPID,
+		p.LocalIP,
+		strconv.FormatUint(uint64(p.LocalASN), 10),
+		p.State,
+		p.StateSince,
+		strconv.FormatInt(p.UptimeSeconds, 10),
+		strconv.Itoa(p.DownReason),
+		strings.Join(p.AdvCapabilities, ";"),
+		strings.Join(p.RcvCapabilities, ";"),
+		strings.Join(p.CapMismatches, ";"),
+		strconv.FormatUint(p.AdjRIBInRoutes, 10),
+		strconv.FormatUint(p.LocRIBRoutes, 10),
+		strconv.FormatUint(p.RouteMonitoring, 10),
+	}
+}
+
+// peersHandler serves:
+//
+//	GET /api/v1/peers returns peers visible to the tenant as json, or as csv when the query parameter
+//	                  "format" is "csv" or the request accepts "text/csv"
+func (srv *server) peersHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if srv.sessions == nil {
+		http.Error(w, "peer table is not available", http.StatusServiceUnavailable)
+		return
+	}
+	tenant := tenantFromContext(r.Context())
+	peers := make([]gobmpsrv.PeerInfo, 0)
+	for _, p := range srv.sessions.Peers() {
+		if tenant.allowed(bmp.PeerStateChangeMsg, &messageScope{RouterIP: p.RouterIP, PeerRD: p.PeerRD}) {
+			peers = append(peers, p)
+		}
+	}
+	format := r.URL.Query().Get("format")
+	if format == "" && strings.Contains(r.Header.Get("Accept"), "text/csv") {
+		format = "csv"
+	}
+	switch format {
+	case "", "json":
+		writeJSON(w, peers)
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		cw := csv.NewWriter(w)
+		_ = cw.Write(peerCSVHeader)
+		for i := range peers {
+			_ = cw.Write(peerCSVRecord(&peers[i]))
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			glog.Errorf("failed to write API response with error: %+v", err)
+		}
+	default:
+		http.Error(w, "unknown format "+format, http.StatusBadRequest)
+	}
+}
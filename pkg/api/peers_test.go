@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+)
+
+type testSessionManager struct {
+	SessionManager
+	peers   []gobmpsrv.PeerInfo
+	routers []gobmpsrv.RouterInfo
+}
+
+func (m *testSessionManager) Peers() []gobmpsrv.PeerInfo {
+	return m.peers
+}
+
+func (m *testSessionManager) Routers() []gobmpsrv.RouterInfo {
+	return m.routers
+}
+
+func TestPeersHandler(t *testing.T) {
+	ts, err := newTenants([]*Tenant{
+		{Name: "all", Key: "all-key"},
+		{Name: "router", Key: "router-key", Routers: []string{"10.0.0.1"}},
+		{Name: "vpn", Key: "vpn-key", VRFs: []string{"100:1"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize tenants with error: %+v", err)
+	}
+	srv := &server{
+		tenants: ts,
+		sessions: &testSessionManager{
+			peers: []gobmpsrv.PeerInfo{
+				{SessionID: 1, RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", State: gobmpsrv.PeerStateUp, RcvCapabilities: []string{"a", "b"}},
+				{SessionID: 2, RouterIP: "10.0.0.2", PeerIP: "192.168.2.1", PeerRD: "100:1", State: gobmpsrv.PeerStateDown},
+			},
+		},
+	}
+	h := srv.authorize(RoleReadOnly, srv.peersHandler)
+	tests := []struct {
+		name   string
+		key    string
+		format string
+		accept string
+		status int
+		peers  []string
+	}{
+		{
+			name:   "all peers",
+			key:    "all-key",
+			status: http.StatusOK,
+			peers:  []string{"192.168.1.1", "192.168.2.1"},
+		},
+		{
+			name:   "peers of router",
+			key:    "router-key",
+			status: http.StatusOK,
+			peers:  []string{"192.168.1.1"},
+		},
+		{
+			name:   "peers of vrf as csv",
+			key:    "vpn-key",
+			format: "csv",
+			status: http.StatusOK,
+			peers:  []string{"192.168.2.1"},
+		},
+		{
+			name:   "csv accepted",
+			key:    "all-key",
+			accept: "text/csv",
+			status: http.StatusOK,
+			peers:  []string{"192.168.1.1", "192.168.2.1"},
+		},
+		{
+			name:   "unknown format",
+			key:    "all-key",
+			format: "xml",
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := PeersPath
+			if tt.format != "" {
+				u += "?format=" + tt.format
+			}
+			r := httptest.NewRequest(http.MethodGet, u, nil)
+			r.Header.Set(APIKeyHeader, tt.key)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+			h(w, r)
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d but got %d", tt.status, w.Code)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var peers []string
+			if w.Header().Get("Content-Type") == "text/csv" {
+				records, err := csv.NewReader(w.Body).ReadAll()
+				if err != nil {
+					t.Fatalf("failed to read csv with error: %+v", err)
+				}
+				if len(records) == 0 || len(records[0]) != len(peerCSVHeader) {
+					t.Fatalf("invalid csv header %v", records)
+				}
+				for _, r := range records[1:] {
+					peers = append(peers, r[4])
+				}
+			} else {
+				var l []gobmpsrv.PeerInfo
+				if err := json.NewDecoder(w.Body).Decode(&l); err != nil {
+					t.Fatalf("failed to decode json with error: %+v", err)
+				}
+				for _, p := range l {
+					peers = append(peers, p.PeerIP)
+				}
+			}
+			if len(peers) != len(tt.peers) {
+				t.Fatalf("expected peers %v but got %v", tt.peers, peers)
+			}
+			for i := range peers {
+				if peers[i] != tt.peers[i] {
+					t.Errorf("expected peers %v but got %v", tt.peers, peers)
+				}
+			}
+		})
+	}
+}
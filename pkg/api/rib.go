@@ -0,0 +1,134 @@
+package api
+
+import (
+	"encoding/csv"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/rib"
+)
+
+// RIBPath defines the path of the peer RIB export endpoint
+const RIBPath = "/api/v1/rib"
+
+// RIB defines methods of the RIB of peers used by the API server
+type RIB interface {
+	Routes(routerIP, peerIP string) []rib.Route
+	Lookup(prefix string, prefixLen int32) []rib.Route
+	Snapshot(routerIP string) (int, error)
+}
+
+var ribCSVHeader = []string{
+	"router_ip", "peer_ip", "peer_rd", "rib_type", "vpn_rd", "prefix", "path_id", "nexthop", "as_path", "communities",
+	"large_communities", "med", "local_pref", "first_seen", "last_changed", "stale",
+}
+
+func ribCSVRecord(r *rib.Route) []string {
+	asPath := make([]string, len(r.ASPath))
+	for i, asn := range r.ASPath {
+		asPath[i] = strconv.FormatUint(uint64(asn), 10)
+	}
+
+	return []string{
+		r.RouterIP,
+		r.PeerIP,
+		r.PeerRD,
+		r.RIBType,
+		r.VPNRD,
+		r.Prefix + "/" + strconv.Itoa(int(r.PrefixLen)),
+		strconv.Itoa(int(r.PathID)),
+		r.Nexthop,
+		strings.Join(asPath, " "),
+		strings.Join(r.Communities, " "),
+		strings.Join(r.LargeCommunities, " "),
+		strconv.FormatUint(uint64(r.MED), 10),
+		strconv.FormatUint(uint64(r.LocalPref), 10),
+		r.FirstSeen.Format(time.RFC3339),
+		r.LastChanged.Format(time.RFC3339),
+		r.Stale,
+	}
+}
+
+// ribHandler serves:
+//
+//	GET /api/v1/rib?router={ip}&peer={ip} returns current routes of the peer of the router visible to the tenant
+//	                                    as json, or as csv when the query parameter "format" is "csv" or
+//	                                    the request accepts "text/csv"
+//	GET /api/v1/rib?prefix={prefix/len}  returns current routes of the prefix of all peers of all routers visible to
+//	                                    the tenant, of the router and the peer when router and peer are specified
+func (srv *server) ribHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if srv.rib == nil {
+		http.Error(w, "rib is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	q := r.URL.Query()
+	router, peer := net.ParseIP(q.Get("router")), net.ParseIP(q.Get("peer"))
+	if (router == nil && q.Get("router") != "") || (peer == nil && q.Get("peer") != "") {
+		http.Error(w, "router and peer query parameters must be ip addresses", http.StatusBadRequest)
+		return
+	}
+	var candidates []rib.Route
+	filename := "rib"
+	if q.Get("prefix") != "" {
+		_, prefix, err := net.ParseCIDR(q.Get("prefix"))
+		if err != nil {
+			http.Error(w, "prefix query parameter must be a prefix and its length", http.StatusBadRequest)
+			return
+		}
+		l, _ := prefix.Mask.Size()
+		candidates = srv.rib.Lookup(prefix.IP.String(), int32(l))
+		filename += "_" + prefix.IP.String() + "_" + strconv.Itoa(l)
+	} else {
+		if router == nil || peer == nil {
+			http.Error(w, "router and peer query parameters, or prefix query parameter, must be specified", http.StatusBadRequest)
+			return
+		}
+		candidates = srv.rib.Routes(router.String(), peer.String())
+	}
+	if router != nil {
+		filename += "_" + router.String()
+	}
+	if peer != nil {
+		filename += "_" + peer.String()
+	}
+	tenant := tenantFromContext(r.Context())
+	routes := make([]rib.Route, 0)
+	for _, rt := range candidates {
+		if router != nil && rt.RouterIP != router.String() || peer != nil && rt.PeerIP != peer.String() {
+			continue
+		}
+		if tenant.allowed(rt.Type, &messageScope{RouterIP: rt.RouterIP, VPNRD: rt.VPNRD, PeerRD: rt.PeerRD}) {
+			routes = append(routes, rt)
+		}
+	}
+	format := q.Get("format")
+	if format == "" && strings.Contains(r.Header.Get("Accept"), "text/csv") {
+		format = "csv"
+	}
+	switch format {
+	case "", "json":
+		writeJSON(w, routes)
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", "attachment; filename=\""+filename+".csv\"")
+		cw := csv.NewWriter(w)
+		_ = cw.Write(ribCSVHeader)
+		for i := range routes {
+			_ = cw.Write(ribCSVRecord(&routes[i]))
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			glog.Errorf("failed to write API response with error: %+v", err)
+		}
+	default:
+		http.Error(w, "unknown format "+format, http.StatusBadRequest)
+	}
+}
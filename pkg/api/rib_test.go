@@ -0,0 +1,299 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pubtest"
+	"github.com/sbezverk/gobmp/pkg/rib"
+)
+
+type testRIB []rib.Route
+
+func (r testRIB) Routes(routerIP, peerIP string) []rib.Route {
+	routes := make([]rib.Route, 0)
+	for _, rt := range r {
+		if rt.RouterIP == routerIP && rt.PeerIP == peerIP {
+			routes = append(routes, rt)
+		}
+	}
+
+	return routes
+}
+
+func (r testRIB) Lookup(prefix string, prefixLen int32) []rib.Route {
+	routes := make([]rib.Route, 0)
+	for _, rt := range r {
+		if rt.Prefix == prefix && rt.PrefixLen == prefixLen {
+			routes = append(routes, rt)
+		}
+	}
+
+	return routes
+}
+
+func (r testRIB) Snapshot(routerIP string) (int, error) {
+	n := 0
+	for _, rt := range r {
+		if rt.RouterIP == routerIP {
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+func TestRIBHandler(t *testing.T) {
+	ts, err := newTenants([]*Tenant{
+		{Name: "all", Key: "all-key"},
+		{Name: "vpn", Key: "vpn-key", VRFs: []string{"100:1"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize tenants with error: %+v", err)
+	}
+	srv := &server{
+		tenants: ts,
+		rib: testRIB{
+			{Type: bmp.UnicastPrefixV4Msg, RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", Prefix: "10.1.1.0", PrefixLen: 24, Nexthop: "192.168.1.1",
+				ASPath: []uint32{65001, 65002}, Communities: []string{"65001:100", "65001:200"}, MED: 10, LocalPref: 100},
+			{Type: bmp.L3VPNV4Msg, RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", VPNRD: "100:1", Prefix: "10.2.0.0", PrefixLen: 16},
+			{Type: bmp.UnicastPrefixV4Msg, RouterIP: "10.0.0.2", PeerIP: "192.168.1.1", Prefix: "10.3.0.0", PrefixLen: 16},
+			{Type: bmp.UnicastPrefixV4Msg, RouterIP: "10.0.0.2", PeerIP: "192.168.1.2", Prefix: "10.1.1.0", PrefixLen: 24},
+			{Type: bmp.L3VPNV4Msg, RouterIP: "10.0.0.2", PeerIP: "192.168.1.2", VPNRD: "100:1", Prefix: "10.1.1.0", PrefixLen: 24},
+		},
+	}
+	h := srv.authorize(RoleReadOnly, srv.ribHandler)
+	tests := []struct {
+		name     string
+		key      string
+		query    string
+		status   int
+		prefixes []string
+	}{
+		{
+			name:     "routes of peer",
+			key:      "all-key",
+			query:    "?router=10.0.0.1&peer=192.168.1.1",
+			status:   http.StatusOK,
+			prefixes: []string{"10.1.1.0", "10.2.0.0"},
+		},
+		{
+			name:     "routes of vrf as csv",
+			key:      "vpn-key",
+			query:    "?router=10.0.0.1&peer=192.168.1.1&format=csv",
+			status:   http.StatusOK,
+			prefixes: []string{"10.2.0.0/16"},
+		},
+		{
+			name:     "routes of prefix",
+			key:      "all-key",
+			query:    "?prefix=10.1.1.0/24",
+			status:   http.StatusOK,
+			prefixes: []string{"10.1.1.0", "10.1.1.0", "10.1.1.0"},
+		},
+		{
+			name:     "routes of prefix of router and vrf",
+			key:      "vpn-key",
+			query:    "?prefix=10.1.1.9/24&router=10.0.0.2",
+			status:   http.StatusOK,
+			prefixes: []string{"10.1.1.0"},
+		},
+		{
+			name:   "invalid prefix",
+			key:    "all-key",
+			query:  "?prefix=10.1.1.0",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "missing peer",
+			key:    "all-key",
+			query:  "?router=10.0.0.1",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid peer",
+			key:    "all-key",
+			query:  "?router=10.0.0.1&peer=peer1",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "unknown format",
+			key:    "all-key",
+			query:  "?router=10.0.0.1&peer=192.168.1.1&format=xml",
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, RIBPath+tt.query, nil)
+			r.Header.Set(APIKeyHeader, tt.key)
+			w := httptest.NewRecorder()
+			h(w, r)
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d but got %d", tt.status, w.Code)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var prefixes []string
+			if w.Header().Get("Content-Type") == "text/csv" {
+				records, err := csv.NewReader(w.Body).ReadAll()
+				if err != nil {
+					t.Fatalf("failed to read csv with error: %+v", err)
+				}
+				if len(records) == 0 || len(records[0]) != len(ribCSVHeader) {
+					t.Fatalf("invalid csv header %v", records)
+				}
+				for _, r := range records[1:] {
+					prefixes = append(prefixes, r[5])
+				}
+			} else {
+				var l []rib.Route
+				if err := json.NewDecoder(w.Body).Decode(&l); err != nil {
+					t.Fatalf("failed to decode json with error: %+v", err)
+				}
+				for _, rt := range l {
+					prefixes = append(prefixes, rt.Prefix)
+				}
+			}
+			if !reflect.DeepEqual(prefixes, tt.prefixes) {
+				t.Errorf("expected prefixes %v but got %v", tt.prefixes, prefixes)
+			}
+		})
+	}
+	// Attributes are rendered as space separated lists
+	record := ribCSVRecord(&srv.rib.(testRIB)[0])
+	if record[8] != "65001 65002" || record[9] != "65001:100 65001:200" || record[11] != "10" || record[12] != "100" {
+		t.Errorf("unexpected csv record %v", record)
+	}
+	// The RIB is not enabled
+	srv.rib = nil
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, RIBPath+"?router=10.0.0.1&peer=192.168.1.1", nil)
+	r.Header.Set(APIKeyHeader, "all-key")
+	h(w, r)
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d but got %d", http.StatusServiceUnavailable, w.Code)
+	}
+}
+
+func TestSnapshotHandler(t *testing.T) {
+	ts, err := newTenants([]*Tenant{
+		{Name: "admin", Key: "admin-key", RoleName: "admin"},
+		{Name: "ro", Key: "ro-key"},
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize tenants with error: %+v", err)
+	}
+	rec := pubtest.NewRecorder()
+	r := rib.NewRIB(rec, false)
+	for _, m := range []string{
+		`{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","prefix":"10.1.1.0","prefix_len":24,"nexthop":"192.168.1.1"}`,
+		`{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.2","prefix":"10.1.2.0","prefix_len":24,"nexthop":"192.168.1.2"}`,
+		`{"action":"add","router_ip":"10.0.0.2","peer_ip":"192.168.1.1","prefix":"10.1.3.0","prefix_len":24,"nexthop":"192.168.1.1"}`,
+	} {
+		if err := r.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(m)); err != nil {
+			t.Fatalf("failed to publish message with error: %+v", err)
+		}
+	}
+	srv := &server{tenants: ts, rib: r}
+	h := srv.authorize(RoleAdmin, srv.routersHandler)
+	tests := []struct {
+		name     string
+		key      string
+		method   string
+		router   string
+		status   int
+		routes   int
+		prefixes []string
+	}{
+		{
+			name:     "routes of router",
+			key:      "admin-key",
+			method:   http.MethodPost,
+			router:   "10.0.0.1",
+			status:   http.StatusOK,
+			routes:   2,
+			prefixes: []string{"10.1.1.0", "10.1.2.0"},
+		},
+		{
+			name:   "router without routes",
+			key:    "admin-key",
+			method: http.MethodPost,
+			router: "10.0.0.9",
+			status: http.StatusOK,
+		},
+		{
+			name:   "invalid router",
+			key:    "admin-key",
+			method: http.MethodPost,
+			router: "router1",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "method not allowed",
+			key:    "admin-key",
+			method: http.MethodGet,
+			router: "10.0.0.1",
+			status: http.StatusMethodNotAllowed,
+		},
+		{
+			name:   "read only tenant",
+			key:    "ro-key",
+			method: http.MethodPost,
+			router: "10.0.0.1",
+			status: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec.Reset()
+			req := httptest.NewRequest(tt.method, AdminRoutersPath+tt.router+"/snapshot", nil)
+			req.Header.Set(APIKeyHeader, tt.key)
+			w := httptest.NewRecorder()
+			h(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d but got %d", tt.status, w.Code)
+			}
+			var prefixes []string
+			msgs, err := rec.Where(bmp.UnicastPrefixV4Msg, "change", rib.ChangeSnapshot)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, m := range msgs {
+				p, _ := m.Field("prefix")
+				prefixes = append(prefixes, p.(string))
+			}
+			if !reflect.DeepEqual(prefixes, tt.prefixes) {
+				t.Errorf("expected republished prefixes %v but got %v", tt.prefixes, prefixes)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var resp struct {
+				RouterIP string `json:"router_ip"`
+				Routes   int    `json:"routes"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode json with error: %+v", err)
+			}
+			if resp.RouterIP != tt.router || resp.Routes != tt.routes {
+				t.Errorf("expected %d routes of router %s but got %+v", tt.routes, tt.router, resp)
+			}
+		})
+	}
+	// The RIB is not enabled
+	srv.rib = nil
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, AdminRoutersPath+"10.0.0.1/snapshot", nil)
+	req.Header.Set(APIKeyHeader, "admin-key")
+	h(w, req)
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d but got %d", http.StatusServiceUnavailable, w.Code)
+	}
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+)
+
+// RoutersPath defines the path of the connected routers endpoint
+const RoutersPath = "/api/v1/routers"
+
+// connectedRoutersHandler serves:
+//
+//	GET /api/v1/routers[?router={ip}] returns routers connected over BMP sessions visible to the tenant with their
+//	                                  peers, uptime of sessions, counters of messages and the last error of sessions
+func (srv *server) connectedRoutersHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if srv.sessions == nil {
+		http.Error(w, "sessions are not available", http.StatusServiceUnavailable)
+		return
+	}
+	var router net.IP
+	if v := r.URL.Query().Get("router"); v != "" {
+		if router = net.ParseIP(v); router == nil {
+			http.Error(w, "router query parameter must be ip address", http.StatusBadRequest)
+			return
+		}
+	}
+	tenant := tenantFromContext(r.Context())
+	routers := make([]gobmpsrv.RouterInfo, 0)
+	for _, ri := range srv.sessions.Routers() {
+		if router != nil && !router.Equal(net.ParseIP(ri.RouterIP)) {
+			continue
+		}
+		// Peers are filtered as peers of the peer table, the router is visible to the tenant of the router and to
+		// tenants of its peers
+		peers := make([]gobmpsrv.PeerInfo, 0, len(ri.Peers))
+		for _, p := range ri.Peers {
+			if tenant.allowed(bmp.PeerStateChangeMsg, &messageScope{RouterIP: p.RouterIP, PeerRD: p.PeerRD}) {
+				peers = append(peers, p)
+			}
+		}
+		if len(peers) == 0 && !tenant.allowed(bmp.PeerStateChangeMsg, &messageScope{RouterIP: ri.RouterIP}) {
+			continue
+		}
+		ri.Peers = peers
+		routers = append(routers, ri)
+	}
+	writeJSON(w, routers)
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+)
+
+func TestConnectedRoutersHandler(t *testing.T) {
+	ts, err := newTenants([]*Tenant{
+		{Name: "all", Key: "all-key"},
+		{Name: "router", Key: "router-key", Routers: []string{"10.0.0.1"}},
+		{Name: "vpn", Key: "vpn-key", VRFs: []string{"100:1"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize tenants with error: %+v", err)
+	}
+	srv := &server{
+		tenants: ts,
+		sessions: &testSessionManager{
+			routers: []gobmpsrv.RouterInfo{
+				{
+					SessionInfo:   gobmpsrv.SessionInfo{ID: 1, RouterIP: "10.0.0.1"},
+					UptimeSeconds: 60,
+					LastError:     "failed to parse route_monitor message",
+					Peers: []gobmpsrv.PeerInfo{
+						{SessionID: 1, RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", RcvCapabilities: []string{"a", "b"}},
+					},
+				},
+				{
+					SessionInfo: gobmpsrv.SessionInfo{ID: 2, RouterIP: "10.0.0.2"},
+					Peers: []gobmpsrv.PeerInfo{
+						{SessionID: 2, RouterIP: "10.0.0.2", PeerIP: "192.168.2.1"},
+						{SessionID: 2, RouterIP: "10.0.0.2", PeerIP: "192.168.2.2", PeerRD: "100:1"},
+					},
+				},
+			},
+		},
+	}
+	h := srv.authorize(RoleReadOnly, srv.connectedRoutersHandler)
+	tests := []struct {
+		name    string
+		key     string
+		query   string
+		status  int
+		routers []string
+		peers   []string
+	}{
+		{
+			name:    "all routers",
+			key:     "all-key",
+			status:  http.StatusOK,
+			routers: []string{"10.0.0.1", "10.0.0.2"},
+			peers:   []string{"192.168.1.1", "192.168.2.1", "192.168.2.2"},
+		},
+		{
+			name:    "router",
+			key:     "all-key",
+			query:   "?router=10.0.0.2",
+			status:  http.StatusOK,
+			routers: []string{"10.0.0.2"},
+			peers:   []string{"192.168.2.1", "192.168.2.2"},
+		},
+		{
+			name:    "routers of tenant",
+			key:     "router-key",
+			status:  http.StatusOK,
+			routers: []string{"10.0.0.1"},
+			peers:   []string{"192.168.1.1"},
+		},
+		{
+			name:    "routers of vrf peers",
+			key:     "vpn-key",
+			status:  http.StatusOK,
+			routers: []string{"10.0.0.2"},
+			peers:   []string{"192.168.2.2"},
+		},
+		{
+			name:   "unknown router",
+			key:    "all-key",
+			query:  "?router=10.0.0.3",
+			status: http.StatusOK,
+		},
+		{
+			name:   "invalid router",
+			key:    "all-key",
+			query:  "?router=router",
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, RoutersPath+tt.query, nil)
+			r.Header.Set(APIKeyHeader, tt.key)
+			w := httptest.NewRecorder()
+			h(w, r)
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d but got %d", tt.status, w.Code)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var l []gobmpsrv.RouterInfo
+			if err := json.NewDecoder(w.Body).Decode(&l); err != nil {
+				t.Fatalf("failed to decode json with error: %+v", err)
+			}
+			var routers, peers []string
+			for _, ri := range l {
+				routers = append(routers, ri.RouterIP)
+				for _, p := range ri.Peers {
+					peers = append(peers, p.PeerIP)
+				}
+			}
+			if !reflect.DeepEqual(routers, tt.routers) || !reflect.DeepEqual(peers, tt.peers) {
+				t.Errorf("expected routers %v with peers %v but got %v with %v", tt.routers, tt.peers, routers, peers)
+			}
+		})
+	}
+}
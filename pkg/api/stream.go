@@ -0,0 +1,158 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/events"
+)
+
+// subscriberQueueLength defines the number of messages buffered for a stream subscriber,
+// when the subscriber does not keep up, messages get dropped.
+const subscriberQueueLength = 4096
+
+// StreamMsg defines the structure of a message sent to stream subscribers
+type StreamMsg struct {
+	Type     int             `json:"type"`
+	TypeName string          `json:"type_name,omitempty"`
+	Key      string          `json:"key,omitempty"`
+	Value    json.RawMessage `json:"value"`
+}
+
+type subscriber struct {
+	tenant   *Tenant
+	msgTypes map[int]bool
+	queue    chan *StreamMsg
+	dropped  uint64
+}
+
+type streamer struct {
+	sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+func newStreamer() *streamer {
+	return &streamer{
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (s *streamer) subscribe(sub *subscriber) {
+	s.Lock()
+	defer s.Unlock()
+	s.subscribers[sub] = struct{}{}
+}
+
+func (s *streamer) unsubscribe(sub *subscriber) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.subscribers, sub)
+}
+
+// closeAll closes queues of all subscribers, terminating their streams
+func (s *streamer) closeAll() {
+	s.Lock()
+	defer s.Unlock()
+	for sub := range s.subscribers {
+		close(sub.queue)
+		delete(s.subscribers, sub)
+	}
+}
+
+// publish sends the message to all subscribers allowed to see it
+func (s *streamer) publish(msgType int, msgHash []byte, msg []byte) {
+	s.RLock()
+	defer s.RUnlock()
+	if len(s.subscribers) == 0 {
+		return
+	}
+	scope := &messageScope{}
+	if err := json.Unmarshal(msg, scope); err != nil {
+		glog.Errorf("failed to recover scope of message type %d with error: %+v", msgType, err)
+		return
+	}
+	m := &StreamMsg{
+		Type:     msgType,
+		TypeName: bmp.MessageTypeName(msgType),
+		Key:      string(msgHash),
+		Value:    json.RawMessage(msg),
+	}
+	for sub := range s.subscribers {
+		if len(sub.msgTypes) != 0 && !sub.msgTypes[msgType] {
+			continue
+		}
+		if !sub.tenant.allowed(msgType, scope) {
+			continue
+		}
+		select {
+		case sub.queue <- m:
+		default:
+			if d := atomic.AddUint64(&sub.dropped, 1); d%subscriberQueueLength == 1 {
+				glog.Warningf("stream subscriber of tenant %q does not keep up, %d messages dropped", sub.tenant.Name, d)
+				events.Report(events.CodeStreamDropped, "", "stream subscriber of tenant %q does not keep up, %d messages dropped", sub.tenant.Name, d)
+			}
+		}
+	}
+}
+
+// streamHandler streams messages visible to the tenant as newline delimited json, the optional
+// query parameter "types" carries a comma separated list of message type names further limiting the stream.
+func (srv *server) streamHandler(w http.ResponseWriter, r *http.Request) {
+	tenant := tenantFromContext(r.Context())
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	sub := &subscriber{
+		tenant:   tenant,
+		msgTypes: make(map[int]bool),
+		queue:    make(chan *StreamMsg, subscriberQueueLength),
+	}
+	if types := r.URL.Query().Get("types"); types != "" {
+		for _, n := range strings.Split(types, ",") {
+			mt, ok := bmp.MessageTypeByName(strings.TrimSpace(n))
+			if !ok {
+				http.Error(w, "unknown message type "+n, http.StatusBadRequest)
+				return
+			}
+			if !tenant.allowedType(mt) {
+				http.Error(w, "message type "+n+" is not allowed", http.StatusForbidden)
+				return
+			}
+			sub.msgTypes[mt] = true
+		}
+	}
+	srv.stream.subscribe(sub)
+	defer srv.stream.unsubscribe(sub)
+	glog.V(5).Infof("tenant %q subscribed to the stream from %s", tenant.Name, r.RemoteAddr)
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	enc := json.NewEncoder(w)
+	for {
+		select {
+		case m, ok := <-sub.queue:
+			if !ok {
+				return
+			}
+			if err := enc.Encode(m); err != nil {
+				glog.V(5).Infof("stream of tenant %q to %s is closed with error: %+v", tenant.Name, r.RemoteAddr, err)
+				return
+			}
+			// Flushing only when the queue is drained to reduce the number of writes under load
+			if len(sub.queue) == 0 {
+				flusher.Flush()
+			}
+		case <-r.Context().Done():
+			glog.V(5).Infof("tenant %q unsubscribed from the stream from %s", tenant.Name, r.RemoteAddr)
+			return
+		}
+	}
+}
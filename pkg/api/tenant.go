@@ -0,0 +1,184 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// Role defines the level of access to the API
+type Role int
+
+const (
+	// RoleReadOnly allows access to messages streams and read-only endpoints
+	RoleReadOnly Role = iota
+	// RoleAdmin additionally allows access to state-changing endpoints
+	RoleAdmin
+)
+
+var roleNames = map[string]Role{
+	"read-only": RoleReadOnly,
+	"admin":     RoleAdmin,
+}
+
+// Tenant defines a consumer of the API identified by the API key or by the Common Name of its TLS client
+// certificate, non empty lists of routers, VRFs and message types limit messages visible to the tenant.
+type Tenant struct {
+	Name string `json:"name"`
+	Key  string `json:"key,omitempty"`
+	// CommonName is the Common Name of the tenant's client certificate, used when the API server requires
+	// client certificates signed by configured certificate authority.
+	CommonName string `json:"common_name,omitempty"`
+	// RoleName is either "read-only" (default) or "admin"
+	RoleName string `json:"role,omitempty"`
+	// Routers is a list of router IPs or router hashes
+	Routers []string `json:"routers,omitempty"`
+	// VRFs is a list of Route Distinguishers, when specified only messages carrying one of
+	// the route distinguishers as vpn_rd or peer_rd are visible to the tenant.
+	VRFs []string `json:"vrfs,omitempty"`
+	// MessageTypes is a list of message type names, for example "peer" or "unicast_prefix_v4"
+	MessageTypes []string `json:"message_types,omitempty"`
+	routers      map[string]bool
+	vrfs         map[string]bool
+	msgTypes     map[int]bool
+	role         Role
+}
+
+// TenantsConfig defines the structure of the tenants configuration file
+type TenantsConfig struct {
+	Tenants []*Tenant `json:"tenants"`
+}
+
+// messageScope carries attributes of a message used to validate whether the message
+// is visible to a tenant.
+type messageScope struct {
+	RouterIP   string `json:"router_ip,omitempty"`
+	RouterHash string `json:"router_hash,omitempty"`
+	VPNRD      string `json:"vpn_rd,omitempty"`
+	PeerRD     string `json:"peer_rd,omitempty"`
+}
+
+func (t *Tenant) init() error {
+	if t.Key == "" && t.CommonName == "" {
+		return fmt.Errorf("tenant %q has neither an api key nor a certificate common name", t.Name)
+	}
+	t.role = RoleReadOnly
+	if t.RoleName != "" {
+		r, ok := roleNames[t.RoleName]
+		if !ok {
+			return fmt.Errorf("tenant %q refers to unknown role %q", t.Name, t.RoleName)
+		}
+		t.role = r
+	}
+	t.routers = make(map[string]bool)
+	for _, r := range t.Routers {
+		if ip := net.ParseIP(r); ip != nil {
+			r = ip.String()
+		}
+		t.routers[r] = true
+	}
+	t.vrfs = make(map[string]bool)
+	for _, v := range t.VRFs {
+		t.vrfs[v] = true
+	}
+	t.msgTypes = make(map[int]bool)
+	for _, n := range t.MessageTypes {
+		mt, ok := bmp.MessageTypeByName(n)
+		if !ok {
+			return fmt.Errorf("tenant %q refers to unknown message type %q", t.Name, n)
+		}
+		t.msgTypes[mt] = true
+	}
+
+	return nil
+}
+
+// allowedType returns true if the tenant has access to messages of type msgType
+func (t *Tenant) allowedType(msgType int) bool {
+	return len(t.msgTypes) == 0 || t.msgTypes[msgType]
+}
+
+// allowed returns true if the message is visible to the tenant
+func (t *Tenant) allowed(msgType int, scope *messageScope) bool {
+	if !t.allowedType(msgType) {
+		return false
+	}
+	if len(t.routers) != 0 {
+		ip := scope.RouterIP
+		if a := net.ParseIP(ip); a != nil {
+			ip = a.String()
+		}
+		if !t.routers[ip] && !t.routers[scope.RouterHash] {
+			return false
+		}
+	}
+	if len(t.vrfs) != 0 {
+		if !t.vrfs[scope.VPNRD] && !t.vrfs[scope.PeerRD] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tenants stores tenants indexed by sha256 of their keys, so the lookup time does not depend
+// on the number of matching characters of the presented key, and by certificates Common Names.
+type tenants struct {
+	byKey map[[sha256.Size]byte]*Tenant
+	byCN  map[string]*Tenant
+}
+
+func (ts *tenants) lookup(key string) (*Tenant, bool) {
+	t, ok := ts.byKey[sha256.Sum256([]byte(key))]
+	return t, ok
+}
+
+func (ts *tenants) lookupCommonName(cn string) (*Tenant, bool) {
+	t, ok := ts.byCN[cn]
+	return t, ok
+}
+
+func newTenants(list []*Tenant) (*tenants, error) {
+	ts := &tenants{
+		byKey: make(map[[sha256.Size]byte]*Tenant),
+		byCN:  make(map[string]*Tenant),
+	}
+	for _, t := range list {
+		if err := t.init(); err != nil {
+			return nil, err
+		}
+		if t.Key != "" {
+			h := sha256.Sum256([]byte(t.Key))
+			if o, ok := ts.byKey[h]; ok {
+				return nil, fmt.Errorf("tenant %q uses the same api key as tenant %q", t.Name, o.Name)
+			}
+			ts.byKey[h] = t
+		}
+		if t.CommonName != "" {
+			if o, ok := ts.byCN[t.CommonName]; ok {
+				return nil, fmt.Errorf("tenant %q uses the same certificate common name as tenant %q", t.Name, o.Name)
+			}
+			ts.byCN[t.CommonName] = t
+		}
+	}
+
+	return ts, nil
+}
+
+// LoadTenants reads tenants definitions from json file
+func LoadTenants(file string) ([]*Tenant, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	c := &TenantsConfig{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tenants file %s with error: %+v", file, err)
+	}
+
+	return c.Tenants, nil
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestTenantAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		tenant  *Tenant
+		msgType int
+		scope   *messageScope
+		allowed bool
+	}{
+		{
+			name:    "no filters",
+			tenant:  &Tenant{Name: "all", Key: "k"},
+			msgType: bmp.UnicastPrefixV4Msg,
+			scope:   &messageScope{RouterIP: "10.0.0.1"},
+			allowed: true,
+		},
+		{
+			name:    "router ip allowed",
+			tenant:  &Tenant{Name: "r", Key: "k", Routers: []string{"10.0.0.1"}},
+			msgType: bmp.PeerStateChangeMsg,
+			scope:   &messageScope{RouterIP: "10.0.0.1"},
+			allowed: true,
+		},
+		{
+			name:    "router hash allowed",
+			tenant:  &Tenant{Name: "r", Key: "k", Routers: []string{"fb5d34c594dff80c59019b6d132185f7"}},
+			msgType: bmp.PeerStateChangeMsg,
+			scope:   &messageScope{RouterIP: "10.0.0.1", RouterHash: "fb5d34c594dff80c59019b6d132185f7"},
+			allowed: true,
+		},
+		{
+			name:    "ipv6 router in different notation",
+			tenant:  &Tenant{Name: "r", Key: "k", Routers: []string{"2001:db8:0::1"}},
+			msgType: bmp.PeerStateChangeMsg,
+			scope:   &messageScope{RouterIP: "2001:db8::1"},
+			allowed: true,
+		},
+		{
+			name:    "router not allowed",
+			tenant:  &Tenant{Name: "r", Key: "k", Routers: []string{"10.0.0.1"}},
+			msgType: bmp.PeerStateChangeMsg,
+			scope:   &messageScope{RouterIP: "10.0.0.2"},
+			allowed: false,
+		},
+		{
+			name:    "vrf allowed",
+			tenant:  &Tenant{Name: "v", Key: "k", VRFs: []string{"100:1"}},
+			msgType: bmp.L3VPNV4Msg,
+			scope:   &messageScope{VPNRD: "100:1"},
+			allowed: true,
+		},
+		{
+			name:    "global table with vrf filter",
+			tenant:  &Tenant{Name: "v", Key: "k", VRFs: []string{"100:1"}},
+			msgType: bmp.UnicastPrefixV4Msg,
+			scope:   &messageScope{},
+			allowed: false,
+		},
+		{
+			name:    "message type not allowed",
+			tenant:  &Tenant{Name: "t", Key: "k", MessageTypes: []string{"peer", "ls_node"}},
+			msgType: bmp.UnicastPrefixV4Msg,
+			scope:   &messageScope{},
+			allowed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.tenant.init(); err != nil {
+				t.Fatalf("failed to initialize tenant with error: %+v", err)
+			}
+			if a := tt.tenant.allowed(tt.msgType, tt.scope); a != tt.allowed {
+				t.Errorf("expected allowed %t but got %t", tt.allowed, a)
+			}
+		})
+	}
+}
+
+func TestNewTenants(t *testing.T) {
+	tests := []struct {
+		name    string
+		tenants []*Tenant
+		fail    bool
+	}{
+		{
+			name:    "valid",
+			tenants: []*Tenant{{Name: "a", Key: "ka"}, {Name: "b", Key: "kb", MessageTypes: []string{"peer"}}},
+		},
+		{
+			name:    "missing key",
+			tenants: []*Tenant{{Name: "a"}},
+			fail:    true,
+		},
+		{
+			name:    "duplicate key",
+			tenants: []*Tenant{{Name: "a", Key: "k"}, {Name: "b", Key: "k"}},
+			fail:    true,
+		},
+		{
+			name:    "unknown message type",
+			tenants: []*Tenant{{Name: "a", Key: "k", MessageTypes: []string{"bogus"}}},
+			fail:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, err := newTenants(tt.tenants)
+			if err != nil && !tt.fail {
+				t.Fatalf("expected to succeed but failed with error: %+v", err)
+			}
+			if err == nil && tt.fail {
+				t.Fatalf("expected to fail but succeeded")
+			}
+			if err != nil {
+				return
+			}
+			for _, tn := range tt.tenants {
+				if l, ok := ts.lookup(tn.Key); !ok || l != tn {
+					t.Errorf("tenant %q is not found by its key", tn.Name)
+				}
+			}
+		})
+	}
+}
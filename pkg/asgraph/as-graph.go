@@ -0,0 +1,399 @@
+package asgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// Graph defines the AS-level adjacency graph built from AS paths of published unicast prefix messages.
+// Graph also implements pub.Publisher interface, messages are passed to the wrapped publisher.
+type Graph interface {
+	pub.Publisher
+	// Links returns links of the graph sorted by AS numbers
+	Links() []Link
+}
+
+// Link defines an adjacency of two ASes, ASN1 is always lower than ASN2. Paths is the number of distinct
+// AS paths carrying the link, counted per router, Routers lists routers which received these paths.
+type Link struct {
+	ASN1    uint32   `json:"as1"`
+	ASN2    uint32   `json:"as2"`
+	Paths   int      `json:"paths,omitempty"`
+	Routers []string `json:"routers,omitempty"`
+}
+
+// Diff defines as_graph message carrying links added to and removed from the graph since the previous message
+type Diff struct {
+	Timestamp string `json:"timestamp"`
+	Added     []Link `json:"added,omitempty"`
+	Removed   []Link `json:"removed,omitempty"`
+}
+
+func init() {
+	if err := bmp.RegisterMessageSchema(bmp.ASGraphMsg, Diff{}); err != nil {
+		panic(err)
+	}
+}
+
+type link struct {
+	asn1 uint32
+	asn2 uint32
+}
+
+type peerKey struct {
+	routerIP string
+	peerType uint8
+	peerRD   string
+	peerIP   string
+}
+
+type routeKey struct {
+	prefix    string
+	prefixLen int32
+	pathID    int32
+	post      bool
+}
+
+// path is an AS path received by a router, routes of the router with the same AS path share it
+type path struct {
+	key    string
+	router string
+	links  []link
+	refs   int
+}
+
+type unicastMsg struct {
+	Action         string `json:"action"`
+	RouterIP       string `json:"router_ip"`
+	PeerIP         string `json:"peer_ip"`
+	PeerType       uint8  `json:"peer_type"`
+	PeerRD         string `json:"peer_rd"`
+	Prefix         string `json:"prefix"`
+	PrefixLen      int32  `json:"prefix_len"`
+	PathID         int32  `json:"path_id"`
+	IsEOR          bool   `json:"is_eor"`
+	IsAdjRIBInPost bool   `json:"is_adj_rib_in_post_policy"`
+	BaseAttributes *struct {
+		ASPath []uint32 `json:"as_path"`
+	} `json:"base_attrs"`
+}
+
+type peerMsg struct {
+	Action   string `json:"action"`
+	RouterIP string `json:"router_ip"`
+	RemoteIP string `json:"remote_ip"`
+	PeerType uint8  `json:"peer_type"`
+	PeerRD   string `json:"peer_rd"`
+}
+
+type graph struct {
+	sync.Mutex
+	publisher pub.Publisher
+	routes    map[peerKey]map[routeKey]*path
+	paths     map[string]*path
+	// links stores the number of paths carrying the link per router
+	links map[link]map[string]int
+	// published stores links as of the last published diff
+	published map[link]bool
+	now       func() time.Time
+	stop      chan struct{}
+}
+
+func (g *graph) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	switch msgType {
+	case bmp.UnicastPrefixMsg, bmp.UnicastPrefixV4Msg, bmp.UnicastPrefixV6Msg:
+		m := &unicastMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode unicast prefix message for as graph with error: %+v", err)
+			break
+		}
+		if !m.IsEOR && m.Prefix != "" {
+			g.updateRoute(m)
+		}
+	case bmp.PeerStateChangeMsg:
+		m := &peerMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode peer message for as graph with error: %+v", err)
+			break
+		}
+		if m.Action != "add" {
+			g.removePeer(peerKey{routerIP: m.RouterIP, peerType: m.PeerType, peerRD: m.PeerRD, peerIP: m.RemoteIP})
+		}
+	}
+
+	return g.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+func (g *graph) Stop() {
+	close(g.stop)
+	g.publisher.Stop()
+}
+
+// pathLinks returns distinct links of AS path, repeated ASes of prepended paths do not form links
+func pathLinks(asPath []uint32) []link {
+	links := make([]link, 0, len(asPath))
+	seen := make(map[link]bool, len(asPath))
+	for i := 1; i < len(asPath); i++ {
+		a, b := asPath[i-1], asPath[i]
+		if a == b || a == 0 || b == 0 {
+			continue
+		}
+		if a > b {
+			a, b = b, a
+		}
+		l := link{asn1: a, asn2: b}
+		if !seen[l] {
+			seen[l] = true
+			links = append(links, l)
+		}
+	}
+
+	return links
+}
+
+func (g *graph) acquire(router string, asPath []uint32) *path {
+	b := make([]byte, 0, len(router)+len(asPath)*6)
+	b = append(b, router...)
+	for _, as := range asPath {
+		b = append(b, ' ')
+		b = strconv.AppendUint(b, uint64(as), 10)
+	}
+	key := string(b)
+	if p, ok := g.paths[key]; ok {
+		p.refs++
+		return p
+	}
+	p := &path{key: key, router: router, links: pathLinks(asPath), refs: 1}
+	for _, l := range p.links {
+		routers, ok := g.links[l]
+		if !ok {
+			routers = make(map[string]int)
+			g.links[l] = routers
+		}
+		routers[router]++
+	}
+	g.paths[key] = p
+
+	return p
+}
+
+func (g *graph) release(p *path) {
+	if p.refs--; p.refs > 0 {
+		return
+	}
+	for _, l := range p.links {
+		routers := g.links[l]
+		if routers[p.router]--; routers[p.router] == 0 {
+			delete(routers, p.router)
+		}
+		if len(routers) == 0 {
+			delete(g.links, l)
+		}
+	}
+	delete(g.paths, p.key)
+}
+
+func (g *graph) updateRoute(m *unicastMsg) {
+	pk := peerKey{routerIP: m.RouterIP, peerType: m.PeerType, peerRD: m.PeerRD, peerIP: m.PeerIP}
+	rk := routeKey{prefix: m.Prefix, prefixLen: m.PrefixLen, pathID: m.PathID, post: m.IsAdjRIBInPost}
+	g.Lock()
+	defer g.Unlock()
+	routes := g.routes[pk]
+	old := routes[rk]
+	if m.Action == "del" {
+		if old != nil {
+			delete(routes, rk)
+			g.release(old)
+			if len(routes) == 0 {
+				delete(g.routes, pk)
+			}
+		}
+		return
+	}
+	if m.BaseAttributes == nil {
+		return
+	}
+	if routes == nil {
+		routes = make(map[routeKey]*path)
+		g.routes[pk] = routes
+	}
+	// Acquiring the new path before releasing the old one, so links of an unchanged path are not removed
+	routes[rk] = g.acquire(m.RouterIP, m.BaseAttributes.ASPath)
+	if old != nil {
+		g.release(old)
+	}
+}
+
+func (g *graph) removePeer(pk peerKey) {
+	g.Lock()
+	defer g.Unlock()
+	for _, p := range g.routes[pk] {
+		g.release(p)
+	}
+	delete(g.routes, pk)
+}
+
+// EvictPeer removes routes of the peer of the router, or of all peers of the router when peerIP is empty,
+// links used only by removed routes are removed from the graph
+func (g *graph) EvictPeer(routerIP, peerRD, peerIP string) int {
+	g.Lock()
+	defer g.Unlock()
+	n := 0
+	for pk, routes := range g.routes {
+		if pk.routerIP != routerIP || (peerIP != "" && (pk.peerRD != peerRD || pk.peerIP != peerIP)) {
+			continue
+		}
+		for _, p := range routes {
+			g.release(p)
+		}
+		n += len(routes)
+		delete(g.routes, pk)
+	}
+
+	return n
+}
+
+func sortLinks(l []Link) {
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].ASN1 != l[j].ASN1 {
+			return l[i].ASN1 < l[j].ASN1
+		}
+		return l[i].ASN2 < l[j].ASN2
+	})
+}
+
+func (g *graph) Links() []Link {
+	g.Lock()
+	defer g.Unlock()
+	links := make([]Link, 0, len(g.links))
+	for l, routers := range g.links {
+		link := Link{ASN1: l.asn1, ASN2: l.asn2, Routers: make([]string, 0, len(routers))}
+		for r, n := range routers {
+			link.Paths += n
+			link.Routers = append(link.Routers, r)
+		}
+		sort.Strings(link.Routers)
+		links = append(links, link)
+	}
+	sortLinks(links)
+
+	return links
+}
+
+// MemoryUsage returns estimated memory used by routes stored per peer of routers, AS paths and links shared
+// by routes are counted once
+func (g *graph) MemoryUsage() memory.Usage {
+	g.Lock()
+	defer g.Unlock()
+	c := memory.NewCounter("as_graph")
+	for pk, routes := range g.routes {
+		for rk := range routes {
+			c.Add(pk.routerIP, pk.peerIP, uint64(unsafe.Sizeof(rk)+unsafe.Sizeof(&path{}))+uint64(len(rk.prefix))+memory.MapEntryOverhead)
+		}
+	}
+	for key, p := range g.paths {
+		c.AddShared(1, uint64(unsafe.Sizeof(key)+unsafe.Sizeof(*p)+uintptr(len(p.links))*unsafe.Sizeof(link{}))+
+			uint64(len(key))+memory.MapEntryOverhead)
+	}
+	for l, routers := range g.links {
+		b := uint64(unsafe.Sizeof(l)) + memory.MapEntryOverhead
+		for r := range routers {
+			b += uint64(unsafe.Sizeof(r)+unsafe.Sizeof(int(0))) + memory.MapEntryOverhead
+		}
+		c.AddShared(1, b)
+	}
+
+	return c.Usage()
+}
+
+// diff returns links added and removed since the previous call
+func (g *graph) diff() *Diff {
+	g.Lock()
+	defer g.Unlock()
+	d := &Diff{
+		Timestamp: g.now().UTC().Format(time.RFC3339),
+	}
+	for l := range g.links {
+		if !g.published[l] {
+			d.Added = append(d.Added, Link{ASN1: l.asn1, ASN2: l.asn2})
+		}
+	}
+	for l := range g.published {
+		if _, ok := g.links[l]; !ok {
+			d.Removed = append(d.Removed, Link{ASN1: l.asn1, ASN2: l.asn2})
+			delete(g.published, l)
+		}
+	}
+	for _, l := range d.Added {
+		g.published[link{asn1: l.ASN1, asn2: l.ASN2}] = true
+	}
+	sortLinks(d.Added)
+	sortLinks(d.Removed)
+
+	return d
+}
+
+func (g *graph) publishDiff() {
+	d := g.diff()
+	if len(d.Added) == 0 && len(d.Removed) == 0 {
+		return
+	}
+	b, err := json.Marshal(d)
+	if err != nil {
+		glog.Errorf("failed to marshal as graph diff with error: %+v", err)
+		return
+	}
+	if err := g.publisher.PublishMessage(bmp.ASGraphMsg, []byte(d.Timestamp), b); err != nil {
+		glog.Errorf("failed to publish as graph diff with error: %+v", err)
+	}
+}
+
+func (g *graph) run(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			g.publishDiff()
+		case <-g.stop:
+			return
+		}
+	}
+}
+
+// NewGraph returns the AS-level graph built from AS paths of messages published to the graph, the messages are
+// passed to publisher. When interval is not 0, links added and removed since the previous diff are published as
+// as_graph message every interval.
+func NewGraph(publisher pub.Publisher, interval time.Duration) (Graph, error) {
+	if interval < 0 {
+		return nil, fmt.Errorf("invalid as graph diff interval %s", interval)
+	}
+	g := newGraph(publisher, time.Now)
+	if interval != 0 {
+		go g.run(interval)
+	}
+
+	return g, nil
+}
+
+func newGraph(publisher pub.Publisher, now func() time.Time) *graph {
+	return &graph{
+		publisher: publisher,
+		routes:    make(map[peerKey]map[routeKey]*path),
+		paths:     make(map[string]*path),
+		links:     make(map[link]map[string]int),
+		published: make(map[link]bool),
+		now:       now,
+		stop:      make(chan struct{}),
+	}
+}
@@ -0,0 +1,162 @@
+package asgraph
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	msgs map[int][][]byte
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.msgs[msgType] = append(p.msgs[msgType], msg)
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+type testMsg struct {
+	msgType int
+	msg     string
+}
+
+func TestPathLinks(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  []uint32
+		links []link
+	}{
+		{
+			name: "empty path",
+		},
+		{
+			name: "single as",
+			path: []uint32{65001},
+		},
+		{
+			name:  "path",
+			path:  []uint32{65003, 65001, 65002},
+			links: []link{{65001, 65003}, {65001, 65002}},
+		},
+		{
+			name:  "prepended path",
+			path:  []uint32{65001, 65001, 65001, 65002, 65002},
+			links: []link{{65001, 65002}},
+		},
+		{
+			name:  "path with loop",
+			path:  []uint32{65001, 65002, 65001, 65003},
+			links: []link{{65001, 65002}, {65001, 65003}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			links := pathLinks(tt.path)
+			if len(links) != len(tt.links) || (len(links) != 0 && !reflect.DeepEqual(links, tt.links)) {
+				t.Errorf("expected links %v but got %v", tt.links, links)
+			}
+		})
+	}
+}
+
+func TestGraph(t *testing.T) {
+	tests := []struct {
+		name    string
+		msgs    []testMsg
+		links   []Link
+		removed []Link
+	}{
+		{
+			name: "routes of two routers",
+			msgs: []testMsg{
+				{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","prefix":"10.1.0.0","prefix_len":16,"base_attrs":{"as_path":[65001,65002]}}`},
+				{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","prefix":"10.2.0.0","prefix_len":16,"base_attrs":{"as_path":[65001,65002]}}`},
+				{bmp.UnicastPrefixV6Msg, `{"action":"add","router_ip":"10.0.0.2","peer_ip":"2001:db8::1","prefix":"2001:db8:1::","prefix_len":48,"base_attrs":{"as_path":[65002,65001,65003]}}`},
+				{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","is_eor":true}`},
+			},
+			links: []Link{
+				{ASN1: 65001, ASN2: 65002, Paths: 2, Routers: []string{"10.0.0.1", "10.0.0.2"}},
+				{ASN1: 65001, ASN2: 65003, Paths: 1, Routers: []string{"10.0.0.2"}},
+			},
+		},
+		{
+			name: "withdrawn route",
+			msgs: []testMsg{
+				{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","prefix":"10.2.0.0","prefix_len":16,"base_attrs":{"as_path":[65001,65003]}}`},
+				{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","prefix":"10.1.0.0","prefix_len":16,"base_attrs":{"as_path":[65001,65002]}}`},
+				{bmp.UnicastPrefixV4Msg, `{"action":"del","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","prefix":"10.2.0.0","prefix_len":16}`},
+			},
+			links: []Link{
+				{ASN1: 65001, ASN2: 65002, Paths: 1, Routers: []string{"10.0.0.1"}},
+			},
+			removed: []Link{{ASN1: 65001, ASN2: 65003}},
+		},
+		{
+			name: "changed path",
+			msgs: []testMsg{
+				{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","prefix":"10.1.0.0","prefix_len":16,"base_attrs":{"as_path":[65001,65002]}}`},
+				{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","prefix":"10.1.0.0","prefix_len":16,"base_attrs":{"as_path":[65001,65002]}}`},
+				{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","prefix":"10.1.0.0","prefix_len":16,"base_attrs":{"as_path":[65001,65003]}}`},
+			},
+			links: []Link{
+				{ASN1: 65001, ASN2: 65003, Paths: 1, Routers: []string{"10.0.0.1"}},
+			},
+			removed: []Link{{ASN1: 65001, ASN2: 65002}},
+		},
+		{
+			name: "peer down",
+			msgs: []testMsg{
+				{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","prefix":"10.1.0.0","prefix_len":16,"base_attrs":{"as_path":[65001,65002]}}`},
+				{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.2","prefix":"10.1.0.0","prefix_len":16,"base_attrs":{"as_path":[65004,65002]}}`},
+				{bmp.PeerStateChangeMsg, `{"action":"down","router_ip":"10.0.0.1","remote_ip":"192.168.1.1"}`},
+			},
+			links: []Link{
+				{ASN1: 65002, ASN2: 65004, Paths: 1, Routers: []string{"10.0.0.1"}},
+			},
+			removed: []Link{{ASN1: 65001, ASN2: 65002}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{msgs: make(map[int][][]byte)}
+			g := newGraph(p, func() time.Time { return time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC) })
+			// Publishing the first message alone, so links removed later are reported by the diff
+			if err := g.PublishMessage(tt.msgs[0].msgType, nil, []byte(tt.msgs[0].msg)); err != nil {
+				t.Fatalf("failed to publish message with error: %+v", err)
+			}
+			g.publishDiff()
+			for _, m := range tt.msgs[1:] {
+				if err := g.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+			}
+			if n := len(p.msgs[bmp.UnicastPrefixV4Msg]) + len(p.msgs[bmp.UnicastPrefixV6Msg]) + len(p.msgs[bmp.PeerStateChangeMsg]); n != len(tt.msgs) {
+				t.Fatalf("expected %d messages passed to the publisher but got %d", len(tt.msgs), n)
+			}
+			if links := g.Links(); !reflect.DeepEqual(links, tt.links) {
+				t.Errorf("expected links %+v but got %+v", tt.links, links)
+			}
+			g.publishDiff()
+			if len(p.msgs[bmp.ASGraphMsg]) != 2 {
+				t.Fatalf("expected 2 as graph messages but got %d", len(p.msgs[bmp.ASGraphMsg]))
+			}
+			d := &Diff{}
+			if err := json.Unmarshal(p.msgs[bmp.ASGraphMsg][1], d); err != nil {
+				t.Fatalf("failed to decode as graph message with error: %+v", err)
+			}
+			if !reflect.DeepEqual(d.Removed, tt.removed) {
+				t.Errorf("expected removed links %+v but got %+v", tt.removed, d.Removed)
+			}
+			// No message is published when the graph has not changed
+			g.publishDiff()
+			if len(p.msgs[bmp.ASGraphMsg]) != 2 {
+				t.Errorf("unexpected as graph message for unchanged graph")
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package asnotation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// Notations of AS numbers in published messages per rfc5396
+const (
+	// ASPlain renders AS numbers as decimal numbers, it is the notation of messages as they are produced
+	ASPlain = "asplain"
+	// ASDot renders AS numbers greater than 65535 as strings of two 16 bits decimal numbers separated by a dot,
+	// smaller AS numbers are rendered as strings of decimal numbers
+	ASDot = "asdot"
+	// Both keeps AS numbers in asplain and adds their asdot form to keys suffixed by "_asdot"
+	Both = "both"
+)
+
+// asKeys is a list of json keys carrying AS numbers or lists of AS numbers
+var asKeys = map[string]bool{
+	"peer_asn":           true,
+	"remote_asn":         true,
+	"local_asn":          true,
+	"local_node_asn":     true,
+	"remote_node_asn":    true,
+	"originator_asn":     true,
+	"origin_as":          true,
+	"member_as":          true,
+	"asn":                true,
+	"as1":                true,
+	"as2":                true,
+	"as_path":            true,
+	"as4_path":           true,
+	"otc":                true,
+	"expected_origin_as": true,
+	"source_as":          true,
+}
+
+// ASDotString returns AS number in asdot notation
+func ASDotString(as uint32) string {
+	if as < 65536 {
+		return strconv.FormatUint(uint64(as), 10)
+	}
+
+	return fmt.Sprintf("%d.%d", as>>16, as&0xffff)
+}
+
+type notation struct {
+	publisher pub.Publisher
+	both      bool
+}
+
+func (n *notation) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	m, err := n.renderMessage(msg)
+	if err != nil {
+		return fmt.Errorf("failed to render AS numbers of message of type %d with error: %+v", msgType, err)
+	}
+
+	return n.publisher.PublishMessage(msgType, msgHash, m)
+}
+
+func (n *notation) Stop() {
+	n.publisher.Stop()
+}
+
+func (n *notation) renderMessage(msg []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(msg))
+	// Preserving numbers as they are, decoding them into float64 would lose precision of 64 bits values
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(n.renderValue(v))
+}
+
+func (n *notation) renderValue(v interface{}) interface{} {
+	switch o := v.(type) {
+	case map[string]interface{}:
+		n.renderObject(o)
+	case []interface{}:
+		for i := range o {
+			o[i] = n.renderValue(o[i])
+		}
+	}
+
+	return v
+}
+
+func (n *notation) renderObject(o map[string]interface{}) {
+	for k, v := range o {
+		if !asKeys[k] {
+			o[k] = n.renderValue(v)
+			continue
+		}
+		d, ok := asDot(v)
+		if !ok {
+			continue
+		}
+		if n.both {
+			o[k+"_asdot"] = d
+			continue
+		}
+		o[k] = d
+	}
+}
+
+// asDot returns asdot form of AS number or list of AS numbers, false is returned if v carries anything else
+func asDot(v interface{}) (interface{}, bool) {
+	switch o := v.(type) {
+	case json.Number:
+		as, ok := asNumber(o)
+		if !ok {
+			return nil, false
+		}
+		return ASDotString(as), true
+	case []interface{}:
+		l := make([]interface{}, len(o))
+		for i := range o {
+			n, ok := o[i].(json.Number)
+			if !ok {
+				return nil, false
+			}
+			as, ok := asNumber(n)
+			if !ok {
+				return nil, false
+			}
+			l[i] = ASDotString(as)
+		}
+		return l, true
+	}
+
+	return nil, false
+}
+
+func asNumber(n json.Number) (uint32, bool) {
+	i, err := n.Int64()
+	if err != nil {
+		return 0, false
+	}
+	// Origin AS is carried as signed 32 bits number, AS numbers greater than 2147483647 are negative
+	if i < 0 && i >= -1<<31 {
+		i += 1 << 32
+	}
+	if i < 0 || i > 1<<32-1 {
+		return 0, false
+	}
+
+	return uint32(i), true
+}
+
+// NewNotation returns a publisher rendering AS numbers of messages in the notation before passing them to
+// the wrapped publisher, the publisher is returned unchanged for asplain.
+func NewNotation(publisher pub.Publisher, n string) (pub.Publisher, error) {
+	switch n {
+	case ASPlain:
+		return publisher, nil
+	case ASDot:
+		return &notation{publisher: publisher}, nil
+	case Both:
+		return &notation{publisher: publisher, both: true}, nil
+	}
+
+	return nil, fmt.Errorf("invalid AS numbers notation %q, supported notations are %q, %q and %q", n, ASPlain, ASDot, Both)
+}
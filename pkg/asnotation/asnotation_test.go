@@ -0,0 +1,86 @@
+package asnotation
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type testPublisher struct {
+	msg []byte
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.msg = msg
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+func TestASDotString(t *testing.T) {
+	tests := []struct {
+		as     uint32
+		expect string
+	}{
+		{as: 65000, expect: "65000"},
+		{as: 65536, expect: "1.0"},
+		{as: 4200000000, expect: "64086.59904"},
+		{as: 4294967295, expect: "65535.65535"},
+	}
+	for _, tt := range tests {
+		if s := ASDotString(tt.as); s != tt.expect {
+			t.Errorf("expected asdot %s of %d but got %s", tt.expect, tt.as, s)
+		}
+	}
+}
+
+func TestNotation(t *testing.T) {
+	msg := `{"peer_asn":4200000000,"origin_as":-94967296,"base_attrs":{"as_path":[65000,4200000000],"med":100},"peer_ip":"10.0.0.1"}`
+	tests := []struct {
+		name     string
+		notation string
+		expect   string
+	}{
+		{
+			name:     "asplain",
+			notation: ASPlain,
+			expect:   msg,
+		},
+		{
+			name:     "asdot",
+			notation: ASDot,
+			expect:   `{"peer_asn":"64086.59904","origin_as":"64086.59904","base_attrs":{"as_path":["65000","64086.59904"],"med":100},"peer_ip":"10.0.0.1"}`,
+		},
+		{
+			name:     "both",
+			notation: Both,
+			expect: `{"peer_asn":4200000000,"peer_asn_asdot":"64086.59904","origin_as":-94967296,"origin_as_asdot":"64086.59904",` +
+				`"base_attrs":{"as_path":[65000,4200000000],"as_path_asdot":["65000","64086.59904"],"med":100},"peer_ip":"10.0.0.1"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{}
+			n, err := NewNotation(p, tt.notation)
+			if err != nil {
+				t.Fatalf("failed to initialize notation with error: %+v", err)
+			}
+			if err := n.PublishMessage(0, nil, []byte(msg)); err != nil {
+				t.Fatalf("failed to publish message with error: %+v", err)
+			}
+			var got, expect interface{}
+			if err := json.Unmarshal(p.msg, &got); err != nil {
+				t.Fatalf("failed to unmarshal published message with error: %+v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.expect), &expect); err != nil {
+				t.Fatalf("failed to unmarshal expected message with error: %+v", err)
+			}
+			if !reflect.DeepEqual(got, expect) {
+				t.Errorf("expected message %s but got %s", tt.expect, string(p.msg))
+			}
+		})
+	}
+	if _, err := NewNotation(&testPublisher{}, "asdot+"); err == nil {
+		t.Errorf("expected invalid notation to fail")
+	}
+}
@@ -0,0 +1,204 @@
+package avro
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testInner struct {
+	Label string `json:"label"`
+}
+
+type testEmbedded struct {
+	Hash string `json:"hash"`
+}
+
+type testMessage struct {
+	testEmbedded
+	Action  string            `json:"action"`
+	ASN     uint32            `json:"peer_asn,omitempty"`
+	Len     int32             `json:"prefix_len"`
+	IPv4    bool              `json:"is_ipv4"`
+	Ratio   float64           `json:"ratio"`
+	Labels  []uint32          `json:"labels,omitempty"`
+	Inner   *testInner        `json:"inner,omitempty"`
+	Tags    map[string]string `json:"tags,omitempty"`
+	Raw     []byte            `json:"raw,omitempty"`
+	Updated time.Time         `json:"updated"`
+	Next    *testMessage      `json:"next,omitempty"`
+	skipped int
+	Ignored string `json:"-"`
+}
+
+func TestSchema(t *testing.T) {
+	s := newSchema(reflect.TypeOf(testMessage{}))
+	var got interface{}
+	if err := json.Unmarshal([]byte(s.String()), &got); err != nil {
+		t.Fatalf("invalid schema %s with error: %+v", s, err)
+	}
+	expect := `{"type":"record","name":"gobmp.avro.testMessage","fields":[` +
+		`{"name":"hash","type":"string"},` +
+		`{"name":"action","type":"string"},` +
+		`{"name":"peer_asn","type":"long"},` +
+		`{"name":"prefix_len","type":"int"},` +
+		`{"name":"is_ipv4","type":"boolean"},` +
+		`{"name":"ratio","type":"double"},` +
+		`{"name":"labels","type":["null",{"type":"array","items":"long"}],"default":null},` +
+		`{"name":"inner","type":["null",{"type":"record","name":"gobmp.avro.testInner","fields":[{"name":"label","type":"string"}]}],"default":null},` +
+		`{"name":"tags","type":["null",{"type":"map","values":"string"}],"default":null},` +
+		`{"name":"raw","type":["null","bytes"],"default":null},` +
+		`{"name":"updated","type":["null","string"],"default":null},` +
+		`{"name":"next","type":["null","gobmp.avro.testMessage"],"default":null}]}`
+	var want interface{}
+	if err := json.Unmarshal([]byte(expect), &want); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected schema %s but got %s", expect, s)
+	}
+}
+
+func TestAppendJSON(t *testing.T) {
+	s := newSchema(reflect.TypeOf(testMessage{}))
+	tests := []struct {
+		name   string
+		input  string
+		expect string
+		fail   bool
+	}{
+		{
+			name:  "missing fields",
+			input: `{"updated":"1"}`,
+			// Empty strings, zero numbers, false and 0.0 followed by nulls and the updated string
+			expect: "0000000000" + "0000000000000000" + "00000000" + "020231" + "00",
+		},
+		{
+			name:  "all fields",
+			input: `{"hash":"h","action":"add","peer_asn":4200000000,"prefix_len":-1,"is_ipv4":true,"ratio":1.5,"labels":[1,2],"inner":{"label":"a"},"tags":{"k":"v"},"raw":"AQI=","updated":"1","next":{"action":"del"}}`,
+			expect: "0268" + "06616464" + "80a8b7a51f" + "01" + "01" + "000000000000f83f" +
+				"02" + "040204" + "00" + "020261" + "02" + "02026b027600" + "02" + "040102" + "020231" +
+				"02" + "00" + "0664656c" + "00" + "00" + "00" + "0000000000000000" + "000000000000",
+		},
+		{name: "string mismatch", input: `{"action":1}`, fail: true},
+		{name: "number mismatch", input: `{"prefix_len":"1"}`, fail: true},
+		{name: "invalid json", input: `{"action":}`, fail: true},
+		{name: "trailing data", input: `{} {}`, fail: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := s.appendJSON(nil, []byte(tt.input))
+			if err != nil && !tt.fail {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err == nil && tt.fail {
+				t.Fatalf("expected error but succeeded")
+			}
+			if got := hex.EncodeToString(b); !tt.fail && got != tt.expect {
+				t.Errorf("expected %s but got %s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestJSONRecord(t *testing.T) {
+	s := jsonRecord("collector_event")
+	if got, expect := s.String(), `{"fields":[{"name":"json","type":"string"}],"name":"gobmp.json.collector_event","type":"record"}`; got != expect {
+		t.Errorf("expected schema %s but got %s", expect, got)
+	}
+	b, err := s.appendJSON([]byte{0}, []byte(`{"a":1}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if got, expect := hex.EncodeToString(b), "000e7b2261223a317d"; got != expect {
+		t.Errorf("expected %s but got %s", expect, got)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	var path, user, password, schema string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		user, password, _ = r.BasicAuth()
+		b, _ := io.ReadAll(r.Body)
+		var req map[string]string
+		json.Unmarshal(b, &req)
+		schema = req["schema"]
+		if schema == "invalid" {
+			http.Error(w, `{"error_code":42201,"message":"Invalid schema"}`, http.StatusUnprocessableEntity)
+			return
+		}
+		w.Write([]byte(`{"id":7}`))
+	}))
+	defer srv.Close()
+	r, err := NewRegistry("http://gobmp:secret@" + srv.Listener.Addr().String() + "/")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	id, err := r.Register("gobmp.parsed.peer-value", `"string"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if id != 7 || path != "/subjects/gobmp.parsed.peer-value/versions" || user != "gobmp" || password != "secret" || schema != `"string"` {
+		t.Errorf("unexpected id %d, path %s, credentials %s:%s or schema %s", id, path, user, password, schema)
+	}
+	if _, err := r.Register("gobmp.parsed.peer-value", "invalid"); err == nil {
+		t.Errorf("expected error of rejected schema but succeeded")
+	}
+	for _, u := range []string{"kafka:8081", "ftp://registry:8081", "http://"} {
+		if _, err := NewRegistry(u); err == nil {
+			t.Errorf("expected error of url %s but succeeded", u)
+		}
+	}
+}
+
+type testRegistry struct {
+	subjects []string
+}
+
+func (r *testRegistry) Register(subject, schema string) (int32, error) {
+	r.subjects = append(r.subjects, subject)
+
+	return 0x01020304, nil
+}
+
+type testPublisher struct {
+	msgs [][]byte
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.msgs = append(p.msgs, msg)
+
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+func TestPublisher(t *testing.T) {
+	r := &testRegistry{}
+	out := &testPublisher{}
+	p := NewPublisher(out, r, "east.")
+	for i := 0; i < 2; i++ {
+		if err := p.PublishMessage(bmp.CollectorEventMsg, nil, []byte(`{}`)); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+	if !reflect.DeepEqual(r.subjects, []string{"east.gobmp.parsed.collector_event-value"}) {
+		t.Errorf("expected schema registered once with the subject of the prefixed topic but got %v", r.subjects)
+	}
+	for _, m := range out.msgs {
+		if got, expect := hex.EncodeToString(m), "0001020304047b7d"; got != expect {
+			t.Errorf("expected message %s but got %s", expect, got)
+		}
+	}
+	if err := p.PublishMessage(-1, nil, []byte(`{}`)); err == nil {
+		t.Errorf("expected error of unknown message type but succeeded")
+	}
+}
@@ -0,0 +1,191 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// appendJSON appends Avro binary encoding of the json message of the schema to out, fields missing in the message
+// are encoded as null when they are nullable and as zero values otherwise
+func (s *schema) appendJSON(out []byte, b []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("invalid json with error: %+v", err)
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid json, data after the top level value")
+	}
+	if s.record != nil && len(s.record.fields) == 1 && s.record.fields[0].json == "" {
+		// Messages of types without Go types are carried as json
+		return appendString(out, string(b)), nil
+	}
+
+	return encode(s, v, out)
+}
+
+func encode(s *schema, v interface{}, out []byte) ([]byte, error) {
+	if s.nullable {
+		// Branch 0 of the union is null
+		if v == nil {
+			return appendLong(out, 0), nil
+		}
+		out = appendLong(out, 1)
+	}
+	switch s.kind {
+	case kindBoolean:
+		b, ok := v.(bool)
+		if !ok && v != nil {
+			return nil, mismatch(s, v)
+		}
+		if b {
+			return append(out, 1), nil
+		}
+		return append(out, 0), nil
+	case kindInt, kindLong:
+		n, err := integer(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendLong(out, n), nil
+	case kindFloat:
+		f, err := float(v)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.AppendUint32(out, math.Float32bits(float32(f))), nil
+	case kindDouble:
+		f, err := float(v)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.AppendUint64(out, math.Float64bits(f)), nil
+	case kindString:
+		str, ok := v.(string)
+		if !ok && v != nil {
+			return nil, mismatch(s, v)
+		}
+		return appendString(out, str), nil
+	case kindBytes:
+		str, ok := v.(string)
+		if !ok && v != nil {
+			return nil, mismatch(s, v)
+		}
+		b, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 bytes with error: %+v", err)
+		}
+		return appendString(out, string(b)), nil
+	case kindJSON:
+		// Values marshaled as json strings, such as times, are carried as they are
+		if str, ok := v.(string); ok {
+			return appendString(out, str), nil
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendString(out, string(b)), nil
+	case kindArray:
+		items, ok := v.([]interface{})
+		if !ok && v != nil {
+			return nil, mismatch(s, v)
+		}
+		if len(items) != 0 {
+			out = appendLong(out, int64(len(items)))
+			for _, item := range items {
+				var err error
+				if out, err = encode(s.items, item, out); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return appendLong(out, 0), nil
+	case kindMap:
+		m, ok := v.(map[string]interface{})
+		if !ok && v != nil {
+			return nil, mismatch(s, v)
+		}
+		if len(m) != 0 {
+			out = appendLong(out, int64(len(m)))
+			for k, item := range m {
+				out = appendString(out, k)
+				var err error
+				if out, err = encode(s.items, item, out); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return appendLong(out, 0), nil
+	case kindRecord:
+		m, ok := v.(map[string]interface{})
+		if !ok && v != nil {
+			return nil, mismatch(s, v)
+		}
+		for _, f := range s.record.fields {
+			var err error
+			if out, err = encode(f.schema, m[f.json], out); err != nil {
+				return nil, fmt.Errorf("invalid field %s with error: %+v", f.json, err)
+			}
+		}
+		return out, nil
+	}
+
+	return nil, fmt.Errorf("unsupported schema %s", s.kind)
+}
+
+// integer returns the integer of the json number, missing numbers are 0 and unsigned integers above the range
+// of long wrap around
+func integer(v interface{}) (int64, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		if v != nil {
+			return 0, fmt.Errorf("%v where a number is expected", v)
+		}
+		return 0, nil
+	}
+	if i, err := n.Int64(); err == nil {
+		return i, nil
+	}
+	u, err := strconv.ParseUint(string(n), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer %s", n)
+	}
+
+	return int64(u), nil
+}
+
+func float(v interface{}) (float64, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		if v != nil {
+			return 0, fmt.Errorf("%v where a number is expected", v)
+		}
+		return 0, nil
+	}
+
+	return n.Float64()
+}
+
+// mismatch returns the error of the json value which does not match the schema
+func mismatch(s *schema, v interface{}) error {
+	return fmt.Errorf("%v where %s is expected", v, s.kind)
+}
+
+// appendLong appends zig-zag encoded variable length integer
+func appendLong(out []byte, n int64) []byte {
+	return binary.AppendUvarint(out, uint64(n<<1)^uint64(n>>63))
+}
+
+func appendString(out []byte, s string) []byte {
+	out = appendLong(out, int64(len(s)))
+
+	return append(out, s...)
+}
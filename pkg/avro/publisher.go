@@ -0,0 +1,88 @@
+package avro
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// magicByte starts messages of Confluent wire format, it is followed by 4 bytes schema ID
+const magicByte = 0
+
+// codec encodes messages of a message type
+type codec struct {
+	schema *schema
+	// header is the magic byte followed by the schema ID
+	header []byte
+}
+
+// publisher converts json messages into Avro of schemas registered with Schema Registry before they are passed
+// to the publisher
+type publisher struct {
+	pub.Publisher
+	registry Registry
+	prefix   string
+	// codecs holds codecs keyed by message types
+	codecs sync.Map
+}
+
+// Schema returns Avro schema in json of messages of the message type, messages of types without registered Go types
+// are records carrying json messages
+func Schema(mt bmp.MessageType) string {
+	return schemaOf(mt).String()
+}
+
+func schemaOf(mt bmp.MessageType) *schema {
+	if mt.Schema == nil {
+		return jsonRecord(mt.Name)
+	}
+
+	return newSchema(mt.Schema)
+}
+
+// codec returns the codec of the message type, its schema is registered when the codec is first used
+func (p *publisher) codec(msgType int) (*codec, error) {
+	if c, ok := p.codecs.Load(msgType); ok {
+		return c.(*codec), nil
+	}
+	mt, ok := bmp.LookupMessageType(msgType)
+	if !ok {
+		return nil, fmt.Errorf("message type %d is not registered", msgType)
+	}
+	s := schemaOf(mt)
+	// Subjects follow topic name strategy of default topics of message types
+	subject := p.prefix + mt.Topic + "-value"
+	id, err := p.registry.Register(subject, s.String())
+	if err != nil {
+		return nil, err
+	}
+	glog.Infof("Avro schema of message type %s has been registered as subject %s with id %d", mt.Name, subject, id)
+	c := &codec{schema: s, header: binary.BigEndian.AppendUint32([]byte{magicByte}, uint32(id))}
+	p.codecs.Store(msgType, c)
+
+	return c, nil
+}
+
+func (p *publisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	c, err := p.codec(msgType)
+	if err != nil {
+		return fmt.Errorf("failed to get Avro schema of a message of type %d with error: %+v", msgType, err)
+	}
+	out := make([]byte, 0, len(c.header)+len(msg)/2)
+	b, err := c.schema.appendJSON(append(out, c.header...), msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode a message of type %d into Avro with error: %+v", msgType, err)
+	}
+
+	return p.Publisher.PublishMessage(msgType, msgHash, b)
+}
+
+// NewPublisher returns a publisher passing messages encoded in Avro to publisher p, schemas are registered
+// with the registry under subjects of topics prefixed by the prefix
+func NewPublisher(p pub.Publisher, registry Registry, prefix string) pub.Publisher {
+	return &publisher{Publisher: p, registry: registry, prefix: prefix}
+}
@@ -0,0 +1,89 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// registryContentType is the content type of Schema Registry requests
+const registryContentType = "application/vnd.schemaregistry.v1+json"
+
+// Registry registers schemas with Confluent Schema Registry
+type Registry interface {
+	// Register registers the schema under the subject and returns the ID of the schema, registering the schema
+	// already registered returns its ID
+	Register(subject, schema string) (int32, error)
+}
+
+type registry struct {
+	url      string
+	user     string
+	password string
+	client   *http.Client
+}
+
+var _ Registry = &registry{}
+
+func (r *registry) Register(subject, schema string) (int32, error) {
+	body, err := json.Marshal(map[string]string{"schema": schema})
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequest(http.MethodPost, r.url+"/subjects/"+url.PathEscape(subject)+"/versions", bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", registryContentType)
+	req.Header.Set("Accept", registryContentType)
+	if r.user != "" {
+		req.SetBasicAuth(r.user, r.password)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to register schema of subject %s with error: %+v", subject, err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read schema registry response with error: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("schema registry rejected schema of subject %s with status %s: %s", subject, resp.Status,
+			strings.TrimSpace(string(b)))
+	}
+	var id struct {
+		ID int32 `json:"id"`
+	}
+	if err := json.Unmarshal(b, &id); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal schema registry response with error: %+v", err)
+	}
+
+	return id.ID, nil
+}
+
+// NewRegistry returns the client of Schema Registry of the URL, credentials of the URL authenticate the client
+// with HTTP basic authentication
+func NewRegistry(registryURL string) (Registry, error) {
+	u, err := url.Parse(registryURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema registry url %s with error: %+v", registryURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("invalid schema registry url %s, the url must be http://host:port or https://host:port", registryURL)
+	}
+	r := &registry{client: &http.Client{Timeout: 10 * time.Second}}
+	if u.User != nil {
+		r.user = u.User.Username()
+		r.password, _ = u.User.Password()
+		u.User = nil
+	}
+	r.url = strings.TrimSuffix(u.String(), "/")
+
+	return r, nil
+}
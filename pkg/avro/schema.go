@@ -0,0 +1,245 @@
+// Package avro encodes json messages as Avro (Apache Avro 1.11 binary encoding) of schemas derived from Go types
+// of message types, schemas are registered with Confluent Schema Registry and messages carry their schema ID in
+// Confluent wire format.
+package avro
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Kinds of schemas, kindJSON is an Avro string carrying the json encoding of values of Go types with custom json
+// marshaling, interfaces and maps which are not keyed by strings, values marshaled as json strings are carried
+// without quotes
+const (
+	kindBoolean = "boolean"
+	kindInt     = "int"
+	kindLong    = "long"
+	kindFloat   = "float"
+	kindDouble  = "double"
+	kindBytes   = "bytes"
+	kindString  = "string"
+	kindRecord  = "record"
+	kindArray   = "array"
+	kindMap     = "map"
+	kindJSON    = "json"
+)
+
+// namespace of records derived from Go types
+const namespace = "gobmp"
+
+var jsonMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// schema is Avro schema of values of a Go type, nullable schemas are unions of null and the schema
+type schema struct {
+	kind     string
+	nullable bool
+	// record is shared by schemas of the same struct, so structs referencing themselves find their fields
+	record *record
+	// items is the schema of items of arrays and values of maps
+	items *schema
+}
+
+// record is the named record of a struct
+type record struct {
+	name   string
+	fields []*field
+}
+
+// field is the field of the record, name is Avro name of the field named json in json messages
+type field struct {
+	name   string
+	json   string
+	schema *schema
+}
+
+// builder derives schemas of records from Go types, records found again are referenced by their names
+type builder struct {
+	records map[reflect.Type]*record
+	// anonymous is the number of records of structs without names
+	anonymous int
+}
+
+// newSchema returns the schema of the Go type of the message
+func newSchema(t reflect.Type) *schema {
+	b := &builder{records: make(map[reflect.Type]*record)}
+	s := b.schema(t)
+	// The message itself is never null
+	s.nullable = false
+
+	return s
+}
+
+func (b *builder) schema(t reflect.Type) *schema {
+	nullable := false
+	for t.Kind() == reflect.Ptr {
+		nullable = true
+		t = t.Elem()
+	}
+	if t.Implements(jsonMarshaler) || reflect.PtrTo(t).Implements(jsonMarshaler) {
+		return &schema{kind: kindJSON, nullable: true}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &schema{kind: kindBoolean, nullable: nullable}
+	case reflect.String:
+		return &schema{kind: kindString, nullable: nullable}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &schema{kind: kindInt, nullable: nullable}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &schema{kind: kindLong, nullable: nullable}
+	case reflect.Float32:
+		return &schema{kind: kindFloat, nullable: nullable}
+	case reflect.Float64:
+		return &schema{kind: kindDouble, nullable: nullable}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// Byte slices are marshaled as base64 strings
+			return &schema{kind: kindBytes, nullable: true}
+		}
+		// Nil slices are marshaled as null
+		return &schema{kind: kindArray, nullable: true, items: b.schema(t.Elem())}
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return &schema{kind: kindJSON, nullable: true}
+		}
+		return &schema{kind: kindMap, nullable: true, items: b.schema(t.Elem())}
+	case reflect.Struct:
+		return b.record(t, nullable)
+	}
+
+	return &schema{kind: kindJSON, nullable: true}
+}
+
+// record returns the schema of the struct, schemas of structs found again share the fields of the first schema
+func (b *builder) record(t reflect.Type, nullable bool) *schema {
+	r, ok := b.records[t]
+	if !ok {
+		name := recordName(t)
+		if t.Name() == "" {
+			b.anonymous++
+			name += strconv.Itoa(b.anonymous)
+		}
+		r = &record{name: name}
+		b.records[t] = r
+		r.fields = b.fields(t)
+	}
+
+	return &schema{kind: kindRecord, nullable: nullable, record: r}
+}
+
+// fields returns fields of the struct as marshaled by encoding/json, fields of embedded structs without json name
+// are promoted
+func (b *builder) fields(t reflect.Type) []*field {
+	var fields []*field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			fields = append(fields, b.fields(ft)...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, &field{name: avroName(name), json: name, schema: b.schema(f.Type)})
+	}
+
+	return fields
+}
+
+// recordName returns the full name of the record of the struct
+func recordName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndexByte(pkg, '/'); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+
+	if t.Name() == "" {
+		return namespace + "." + avroName(pkg) + ".record"
+	}
+
+	return namespace + "." + avroName(pkg) + "." + avroName(t.Name())
+}
+
+// avroName replaces characters not allowed in Avro names by '_'
+func avroName(s string) string {
+	n := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, s)
+	if n == "" || n[0] >= '0' && n[0] <= '9' {
+		n = "_" + n
+	}
+
+	return n
+}
+
+// jsonSchema returns the Avro schema in json, records defined by the schema are added to defined
+func (s *schema) jsonSchema(defined map[string]bool) interface{} {
+	var v interface{}
+	switch s.kind {
+	case kindJSON:
+		v = kindString
+	case kindArray:
+		v = map[string]interface{}{"type": kindArray, "items": s.items.jsonSchema(defined)}
+	case kindMap:
+		v = map[string]interface{}{"type": kindMap, "values": s.items.jsonSchema(defined)}
+	case kindRecord:
+		if defined[s.record.name] {
+			v = s.record.name
+			break
+		}
+		defined[s.record.name] = true
+		fields := make([]interface{}, 0, len(s.record.fields))
+		for _, f := range s.record.fields {
+			af := map[string]interface{}{"name": f.name, "type": f.schema.jsonSchema(defined)}
+			if f.schema.nullable {
+				af["default"] = nil
+			}
+			fields = append(fields, af)
+		}
+		v = map[string]interface{}{"type": kindRecord, "name": s.record.name, "fields": fields}
+	default:
+		v = s.kind
+	}
+	if s.nullable {
+		return []interface{}{"null", v}
+	}
+
+	return v
+}
+
+// String returns Avro schema of the message in json
+func (s *schema) String() string {
+	b, _ := json.Marshal(s.jsonSchema(make(map[string]bool)))
+
+	return string(b)
+}
+
+// jsonRecord returns the schema of messages of types without registered Go types, the json message is carried
+// by the json field
+func jsonRecord(name string) *schema {
+	return &schema{
+		kind: kindRecord,
+		record: &record{
+			name:   namespace + ".json." + avroName(name),
+			fields: []*field{{name: "json", schema: &schema{kind: kindJSON}}},
+		},
+	}
+}
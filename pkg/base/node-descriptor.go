@@ -23,6 +23,18 @@ type NodeDescriptor struct {
 	SubTLV map[uint16]TLV
 }
 
+// GetNodeKey returns a string identifying the node described by Node Descriptor, unlike
+// a hash of the descriptor, the key does not depend on the descriptor being Local or Remote.
+func (nd *NodeDescriptor) GetNodeKey() string {
+	var s string
+	for t := uint16(512); t <= 517; t++ {
+		if tlv, ok := nd.SubTLV[t]; ok {
+			s += fmt.Sprintf("%d:%x;", t, tlv.Value)
+		}
+	}
+	return s
+}
+
 // GetASN returns Autonomous System Number used to uniqely identify BGP-LS domain
 func (nd *NodeDescriptor) GetASN() uint32 {
 	if tlv, ok := nd.SubTLV[512]; ok {
@@ -0,0 +1,418 @@
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"os"
+	"sort"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/radix"
+)
+
+const (
+	// TypeOriginMismatch is the type of the anomaly reporting a baseline prefix originated by an unexpected AS
+	TypeOriginMismatch = "origin_mismatch"
+	// TypeMoreSpecific is the type of the anomaly reporting a prefix not in the baseline, covered by a baseline prefix
+	// and originated by an AS not originating the covering prefix
+	TypeMoreSpecific = "more_specific"
+)
+
+const (
+	// ActionAdd is the action of the event reporting a route deviating from the baseline
+	ActionAdd = "add"
+	// ActionDel is the action of the event reporting a deviating route withdrawn or advertised again by the expected
+	// origin AS
+	ActionDel = "del"
+)
+
+// Anomaly defines origin_anomaly message reporting a route deviating from the learned prefix to origin AS baseline
+type Anomaly struct {
+	Action           string   `json:"action"`
+	Type             string   `json:"type"`
+	RouterIP         string   `json:"router_ip"`
+	PeerIP           string   `json:"peer_ip"`
+	PeerASN          uint32   `json:"peer_asn"`
+	Prefix           string   `json:"prefix"`
+	PrefixLen        int32    `json:"prefix_len"`
+	OriginAS         uint32   `json:"origin_as"`
+	BaselinePrefix   string   `json:"baseline_prefix"`
+	ExpectedOriginAS []uint32 `json:"expected_origin_as"`
+	Timestamp        string   `json:"timestamp"`
+}
+
+func init() {
+	if err := bmp.RegisterMessageSchema(bmp.OriginAnomalyMsg, Anomaly{}); err != nil {
+		panic(err)
+	}
+}
+
+type routeMsg struct {
+	Action           string `json:"action"`
+	RouterIP         string `json:"router_ip"`
+	PeerIP           string `json:"peer_ip"`
+	PeerType         uint8  `json:"peer_type"`
+	PeerRD           string `json:"peer_rd"`
+	PeerASN          uint32 `json:"peer_asn"`
+	Prefix           string `json:"prefix"`
+	PrefixLen        int32  `json:"prefix_len"`
+	PathID           int32  `json:"path_id"`
+	OriginAS         int64  `json:"origin_as"`
+	IsEOR            bool   `json:"is_eor"`
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+}
+
+type peerMsg struct {
+	Action   string `json:"action"`
+	RouterIP string `json:"router_ip"`
+	RemoteIP string `json:"remote_ip"`
+	PeerRD   string `json:"peer_rd"`
+}
+
+type routerPeer struct {
+	routerIP string
+	peerRD   string
+	peerIP   string
+}
+
+// routeKey identifies a route of a BGP peer in a RIB of a router
+type routeKey struct {
+	peerType         uint8
+	prefix           netip.Prefix
+	pathID           int32
+	isAdjRIBInPost   bool
+	isAdjRIBOutPost  bool
+	isLocRIBFiltered bool
+}
+
+// file defines the json file storing the learned baseline
+type file struct {
+	LearnedAt string       `json:"learned_at"`
+	Prefixes  []filePrefix `json:"prefixes"`
+}
+
+type filePrefix struct {
+	Prefix   string   `json:"prefix"`
+	OriginAS []uint32 `json:"origin_as"`
+}
+
+type detector struct {
+	sync.Mutex
+	publisher pub.Publisher
+	// origins stores origin ASes of prefixes seen during learning, sorted
+	origins *radix.Tree[[]uint32]
+	// learning is true until learnUntil
+	learning   bool
+	learnUntil time.Time
+	file       string
+	// anomalies stores routes deviating from the baseline per peer of a router
+	anomalies map[routerPeer]map[routeKey]*Anomaly
+	now       func() time.Time
+}
+
+func (d *detector) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	var events []*Anomaly
+	switch msgType {
+	case bmp.PeerStateChangeMsg:
+		m := &peerMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode peer message for origin baseline with error: %+v", err)
+			break
+		}
+		if m.Action != "add" {
+			events = d.removePeer(routerPeer{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.RemoteIP})
+		}
+	case bmp.UnicastPrefixMsg, bmp.UnicastPrefixV4Msg, bmp.UnicastPrefixV6Msg:
+		m := &routeMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode route message for origin baseline with error: %+v", err)
+			break
+		}
+		if m.IsEOR {
+			break
+		}
+		events = d.update(m)
+	}
+	for _, e := range events {
+		d.publishEvent(e)
+	}
+
+	return d.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+func (d *detector) Stop() {
+	d.publisher.Stop()
+}
+
+// update learns the origin of the route or, once learning is over, checks the route against the baseline and
+// returns events of changes of the route's anomaly
+func (d *detector) update(m *routeMsg) []*Anomaly {
+	addr, err := netip.ParseAddr(m.Prefix)
+	if err != nil {
+		glog.Errorf("invalid prefix %s for origin baseline with error: %+v", m.Prefix, err)
+		return nil
+	}
+	p, err := addr.Prefix(int(m.PrefixLen))
+	if err != nil {
+		glog.Errorf("invalid prefix length %d for origin baseline with error: %+v", m.PrefixLen, err)
+		return nil
+	}
+	d.Lock()
+	defer d.Unlock()
+	if d.learning {
+		if !d.now().Before(d.learnUntil) {
+			d.finishLearning()
+		} else {
+			if m.Action != "del" && m.OriginAS != 0 {
+				d.learn(p, uint32(m.OriginAS))
+			}
+			return nil
+		}
+	}
+	rp := routerPeer{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.PeerIP}
+	rk := routeKey{
+		peerType:         m.PeerType,
+		prefix:           p,
+		pathID:           m.PathID,
+		isAdjRIBInPost:   m.IsAdjRIBInPost,
+		isAdjRIBOutPost:  m.IsAdjRIBOutPost,
+		isLocRIBFiltered: m.IsLocRIBFiltered,
+	}
+	old := d.anomalies[rp][rk]
+	var a *Anomaly
+	if m.Action != "del" && m.OriginAS != 0 {
+		a = d.check(p, uint32(m.OriginAS))
+	}
+	if a != nil && old != nil && a.Type == old.Type && a.OriginAS == old.OriginAS && a.BaselinePrefix == old.BaselinePrefix {
+		return nil
+	}
+	var events []*Anomaly
+	if old != nil {
+		old.Action = ActionDel
+		events = append(events, old)
+		delete(d.anomalies[rp], rk)
+		if len(d.anomalies[rp]) == 0 {
+			delete(d.anomalies, rp)
+		}
+	}
+	if a != nil {
+		a.Action = ActionAdd
+		a.RouterIP = m.RouterIP
+		a.PeerIP = m.PeerIP
+		a.PeerASN = m.PeerASN
+		a.Prefix = m.Prefix
+		a.PrefixLen = m.PrefixLen
+		if d.anomalies[rp] == nil {
+			d.anomalies[rp] = make(map[routeKey]*Anomaly)
+		}
+		d.anomalies[rp][rk] = a
+		c := *a
+		events = append(events, &c)
+	}
+
+	return events
+}
+
+func (d *detector) learn(p netip.Prefix, origin uint32) {
+	origins, _ := d.origins.Get(p)
+	i := sort.Search(len(origins), func(i int) bool { return origins[i] >= origin })
+	if i < len(origins) && origins[i] == origin {
+		return
+	}
+	origins = append(origins, 0)
+	copy(origins[i+1:], origins[i:])
+	origins[i] = origin
+	d.origins.Insert(p, origins)
+}
+
+func (d *detector) finishLearning() {
+	d.learning = false
+	glog.Infof("origin baseline has been learned with %d prefixes", d.origins.Len())
+	if d.file == "" {
+		return
+	}
+	if err := d.save(); err != nil {
+		glog.Errorf("failed to save origin baseline to %s with error: %+v", d.file, err)
+	}
+}
+
+// check returns the anomaly of the prefix originated by the origin AS, the baseline origins of the prefix are expected,
+// or the origins of the most specific baseline prefix covering it, nil is returned if the origin is expected or
+// the prefix is not covered by the baseline
+func (d *detector) check(p netip.Prefix, origin uint32) *Anomaly {
+	bp, origins, ok := d.origins.LongestMatch(p)
+	if !ok {
+		return nil
+	}
+	i := sort.Search(len(origins), func(i int) bool { return origins[i] >= origin })
+	if i < len(origins) && origins[i] == origin {
+		return nil
+	}
+	a := &Anomaly{
+		Type:             TypeOriginMismatch,
+		OriginAS:         origin,
+		BaselinePrefix:   bp.String(),
+		ExpectedOriginAS: origins,
+	}
+	if bp != p {
+		a.Type = TypeMoreSpecific
+	}
+
+	return a
+}
+
+// removePeer removes anomalies of routes of the peer going down and returns their withdrawal events
+func (d *detector) removePeer(rp routerPeer) []*Anomaly {
+	d.Lock()
+	defer d.Unlock()
+	events := make([]*Anomaly, 0, len(d.anomalies[rp]))
+	for _, a := range d.anomalies[rp] {
+		a.Action = ActionDel
+		events = append(events, a)
+	}
+	delete(d.anomalies, rp)
+
+	return events
+}
+
+// EvictPeer removes anomalies of routes of the peer of the router, or of all peers of the router when peerIP
+// is empty, and publishes their withdrawal events
+func (d *detector) EvictPeer(routerIP, peerRD, peerIP string) int {
+	d.Lock()
+	var events []*Anomaly
+	for rp, routes := range d.anomalies {
+		if rp.routerIP != routerIP || (peerIP != "" && (rp.peerRD != peerRD || rp.peerIP != peerIP)) {
+			continue
+		}
+		for _, a := range routes {
+			a.Action = ActionDel
+			events = append(events, a)
+		}
+		delete(d.anomalies, rp)
+	}
+	d.Unlock()
+	for _, e := range events {
+		d.publishEvent(e)
+	}
+
+	return len(events)
+}
+
+func (d *detector) publishEvent(a *Anomaly) {
+	a.Timestamp = d.now().UTC().Format(time.RFC3339)
+	b, err := json.Marshal(a)
+	if err != nil {
+		glog.Errorf("failed to marshal origin anomaly event with error: %+v", err)
+		return
+	}
+	if err := d.publisher.PublishMessage(bmp.OriginAnomalyMsg, []byte(a.RouterIP+a.Prefix), b); err != nil {
+		glog.Errorf("failed to publish origin anomaly event with error: %+v", err)
+	}
+}
+
+// save writes the baseline to the file, the file is replaced only once the baseline is written completely
+func (d *detector) save() error {
+	f := file{LearnedAt: d.now().UTC().Format(time.RFC3339), Prefixes: make([]filePrefix, 0, d.origins.Len())}
+	d.origins.Walk(func(p netip.Prefix, origins []uint32) bool {
+		f.Prefixes = append(f.Prefixes, filePrefix{Prefix: p.String(), OriginAS: origins})
+		return true
+	})
+	b, err := json.Marshal(&f)
+	if err != nil {
+		return err
+	}
+	tmp := d.file + ".tmp"
+	if err := os.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, d.file)
+}
+
+func (d *detector) load() error {
+	b, err := os.ReadFile(d.file)
+	if err != nil {
+		return err
+	}
+	f := file{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return fmt.Errorf("failed to decode origin baseline file %s with error: %+v", d.file, err)
+	}
+	for _, fp := range f.Prefixes {
+		p, err := netip.ParsePrefix(fp.Prefix)
+		if err != nil {
+			return fmt.Errorf("invalid prefix %s in origin baseline file %s with error: %+v", fp.Prefix, d.file, err)
+		}
+		for _, origin := range fp.OriginAS {
+			d.learn(p.Masked(), origin)
+		}
+	}
+
+	return nil
+}
+
+// MemoryUsage returns estimated memory used by the baseline shared by all routers and routes deviating from it
+// per peer of routers
+func (d *detector) MemoryUsage() memory.Usage {
+	d.Lock()
+	defer d.Unlock()
+	c := memory.NewCounter("origin_baseline")
+	for rp, routes := range d.anomalies {
+		for rk, a := range routes {
+			b := uint64(unsafe.Sizeof(rk)+unsafe.Sizeof(a)+unsafe.Sizeof(*a)) + memory.MapEntryOverhead +
+				uint64(len(a.RouterIP)+len(a.PeerIP)+len(a.Prefix)+len(a.BaselinePrefix))
+			c.Add(rp.routerIP, rp.peerIP, b)
+		}
+	}
+	b := d.origins.Size()
+	d.origins.Walk(func(_ netip.Prefix, origins []uint32) bool {
+		b += uint64(uintptr(cap(origins)) * unsafe.Sizeof(uint32(0)))
+		return true
+	})
+	c.AddShared(d.origins.Len(), b)
+
+	return c.Usage()
+}
+
+// NewBaseline returns a publisher learning origin ASes of unicast prefixes during the window and then publishing
+// origin_anomaly messages for routes with an origin AS not seen for the prefix, or for the most specific prefix
+// covering it, during learning. The learned baseline is saved to the file when the file is set, an existing file
+// is loaded in place of learning. Messages are passed to publisher.
+func NewBaseline(publisher pub.Publisher, window time.Duration, baselineFile string) (pub.Publisher, error) {
+	return newBaseline(publisher, window, baselineFile, time.Now)
+}
+
+func newBaseline(publisher pub.Publisher, window time.Duration, baselineFile string, now func() time.Time) (*detector, error) {
+	d := &detector{
+		publisher:  publisher,
+		origins:    radix.New[[]uint32](),
+		learning:   true,
+		learnUntil: now().Add(window),
+		file:       baselineFile,
+		anomalies:  make(map[routerPeer]map[routeKey]*Anomaly),
+		now:        now,
+	}
+	if baselineFile == "" {
+		return d, nil
+	}
+	switch err := d.load(); {
+	case err == nil:
+		d.learning = false
+		glog.Infof("origin baseline with %d prefixes has been loaded from %s", d.origins.Len(), baselineFile)
+	case os.IsNotExist(err):
+		glog.Infof("origin baseline file %s does not exist, learning origin baseline for %s", baselineFile, window)
+	default:
+		return nil, err
+	}
+
+	return d, nil
+}
@@ -0,0 +1,161 @@
+package baseline
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	events []string
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if msgType != bmp.OriginAnomalyMsg {
+		return nil
+	}
+	a := &Anomaly{}
+	if err := json.Unmarshal(msg, a); err != nil {
+		return err
+	}
+	p.events = append(p.events, a.Action+" "+a.Type+" "+a.Prefix+"/"+strconv.Itoa(int(a.PrefixLen))+" "+
+		strconv.Itoa(int(a.OriginAS))+" "+a.BaselinePrefix)
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+type testMsg struct {
+	msgType int
+	msg     string
+}
+
+func unicast(action, prefix string, prefixLen, origin int) testMsg {
+	return testMsg{bmp.UnicastPrefixV4Msg, `{"action":"` + action + `","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","peer_asn":65001,"prefix":"` +
+		prefix + `","prefix_len":` + strconv.Itoa(prefixLen) + `,"origin_as":` + strconv.Itoa(origin) + `}`}
+}
+
+// learned lists routes seen during learning, 10.0.0.0/8 is originated by AS 65001 and AS 65002, 2001:db8::/32
+// by AS 65003
+var learned = []testMsg{
+	unicast("add", "10.0.0.0", 8, 65001),
+	unicast("add", "10.0.0.0", 8, 65002),
+	{bmp.UnicastPrefixV6Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","prefix":"2001:db8::","prefix_len":32,"origin_as":65003}`},
+	unicast("del", "10.0.0.0", 8, 0),
+}
+
+func TestBaseline(t *testing.T) {
+	peerDown := testMsg{bmp.PeerStateChangeMsg, `{"action":"down","router_ip":"10.0.0.1","remote_ip":"192.168.0.1"}`}
+	tests := []struct {
+		name string
+		msgs []testMsg
+		want []string
+	}{
+		{
+			name: "expected origins",
+			msgs: []testMsg{unicast("add", "10.0.0.0", 8, 65002), unicast("add", "10.1.0.0", 16, 65001)},
+		},
+		{
+			name: "origin mismatch withdrawn",
+			msgs: []testMsg{unicast("add", "10.0.0.0", 8, 65009), unicast("add", "10.0.0.0", 8, 65009), unicast("del", "10.0.0.0", 8, 0)},
+			want: []string{"add origin_mismatch 10.0.0.0/8 65009 10.0.0.0/8", "del origin_mismatch 10.0.0.0/8 65009 10.0.0.0/8"},
+		},
+		{
+			name: "origin restored",
+			msgs: []testMsg{unicast("add", "10.0.0.0", 8, 65009), unicast("add", "10.0.0.0", 8, 65001)},
+			want: []string{"add origin_mismatch 10.0.0.0/8 65009 10.0.0.0/8", "del origin_mismatch 10.0.0.0/8 65009 10.0.0.0/8"},
+		},
+		{
+			name: "origin changed",
+			msgs: []testMsg{unicast("add", "10.0.0.0", 8, 65009), unicast("add", "10.0.0.0", 8, 65008)},
+			want: []string{"add origin_mismatch 10.0.0.0/8 65009 10.0.0.0/8", "del origin_mismatch 10.0.0.0/8 65009 10.0.0.0/8",
+				"add origin_mismatch 10.0.0.0/8 65008 10.0.0.0/8"},
+		},
+		{
+			name: "more specific",
+			msgs: []testMsg{unicast("add", "10.1.1.0", 24, 65009)},
+			want: []string{"add more_specific 10.1.1.0/24 65009 10.0.0.0/8"},
+		},
+		{
+			name: "ipv6 more specific",
+			msgs: []testMsg{{bmp.UnicastPrefixV6Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","prefix":"2001:db8:1::","prefix_len":48,"origin_as":65001}`}},
+			want: []string{"add more_specific 2001:db8:1::/48 65001 2001:db8::/32"},
+		},
+		{
+			name: "not in baseline",
+			msgs: []testMsg{unicast("add", "192.168.0.0", 16, 65009)},
+		},
+		{
+			name: "peer down",
+			msgs: []testMsg{unicast("add", "10.1.1.0", 24, 65009), peerDown},
+			want: []string{"add more_specific 10.1.1.0/24 65009 10.0.0.0/8", "del more_specific 10.1.1.0/24 65009 10.0.0.0/8"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{}
+			now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			d, err := newBaseline(p, time.Hour, "", func() time.Time { return now })
+			if err != nil {
+				t.Fatalf("failed to create baseline with error: %+v", err)
+			}
+			for _, m := range learned {
+				if err := d.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+			}
+			now = now.Add(time.Hour)
+			for _, m := range tt.msgs {
+				if err := d.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+			}
+			if !reflect.DeepEqual(p.events, tt.want) {
+				t.Errorf("got events %v, want %v", p.events, tt.want)
+			}
+		})
+	}
+}
+
+func TestBaselineFile(t *testing.T) {
+	f := filepath.Join(t.TempDir(), "baseline.json")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	d, err := newBaseline(&testPublisher{}, time.Hour, f, func() time.Time { return now })
+	if err != nil {
+		t.Fatalf("failed to create baseline with error: %+v", err)
+	}
+	for _, m := range learned {
+		if err := d.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+			t.Fatalf("failed to publish message with error: %+v", err)
+		}
+	}
+	now = now.Add(time.Hour)
+	// The first route after learning saves the baseline
+	m := unicast("add", "10.0.0.0", 8, 65001)
+	if err := d.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+		t.Fatalf("failed to publish message with error: %+v", err)
+	}
+
+	p := &testPublisher{}
+	loaded, err := newBaseline(p, time.Hour, f, func() time.Time { return now })
+	if err != nil {
+		t.Fatalf("failed to load baseline with error: %+v", err)
+	}
+	if loaded.learning {
+		t.Fatalf("loaded baseline is learning")
+	}
+	for _, m := range []testMsg{unicast("add", "10.0.0.0", 8, 65002), unicast("add", "10.0.0.0", 8, 65009)} {
+		if err := loaded.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+			t.Fatalf("failed to publish message with error: %+v", err)
+		}
+	}
+	want := []string{"add origin_mismatch 10.0.0.0/8 65009 10.0.0.0/8"}
+	if !reflect.DeepEqual(p.events, want) {
+		t.Errorf("got events %v, want %v", p.events, want)
+	}
+}
@@ -0,0 +1,77 @@
+package bgp
+
+import (
+	"strconv"
+)
+
+// AddPath defines ADD-PATH state negotiated by a BGP session per AFI/SAFI, RFC 7911 section 5. The state is kept
+// from the local speaker point of view, Receive is set for AFI/SAFI of which NLRI received from the peer carry
+// Path Identifier and Send for AFI/SAFI of which NLRI sent to the peer carry Path Identifier.
+type AddPath struct {
+	modes map[afiSAFI]byte
+}
+
+// NegotiateAddPath returns ADD-PATH state of the session, local is OPEN message sent by the local speaker and remote
+// is OPEN message received from the peer. NLRI received from the peer carry Path Identifier when the local speaker
+// advertises Receive and the peer advertises Send, NLRI sent to the peer when the local speaker advertises Send and
+// the peer advertises Receive, multiple ADD-PATH capabilities of OPEN message are merged.
+func NegotiateAddPath(local, remote *OpenMessage) *AddPath {
+	ap := &AddPath{
+		modes: make(map[afiSAFI]byte),
+	}
+	if local == nil || remote == nil {
+		return ap
+	}
+	rap := remote.addPath()
+	for af, l := range local.addPath() {
+		r := rap[af]
+		mode := byte(0)
+		if l&addPathReceive != 0 && r&addPathSend != 0 {
+			mode |= addPathReceive
+		}
+		if l&addPathSend != 0 && r&addPathReceive != 0 {
+			mode |= addPathSend
+		}
+		if mode != 0 {
+			ap.modes[af] = mode
+		}
+	}
+
+	return ap
+}
+
+// Receive returns a map of NLRI types of which NLRI received from the peer carry Path Identifier
+func (ap *AddPath) Receive() map[int]bool {
+	return ap.nlriTypes(addPathReceive)
+}
+
+// Send returns a map of NLRI types of which NLRI sent to the peer carry Path Identifier
+func (ap *AddPath) Send() map[int]bool {
+	return ap.nlriTypes(addPathSend)
+}
+
+func (ap *AddPath) nlriTypes(direction byte) map[int]bool {
+	m := make(map[int]bool)
+	for af, mode := range ap.modes {
+		if mode&direction == 0 {
+			continue
+		}
+		// AFI/SAFI without NLRI type would share type 0 with all unknown AFI/SAFI
+		if t := NLRIMessageType(af.afi, af.safi); t != 0 {
+			m[t] = true
+		}
+	}
+
+	return m
+}
+
+// Modes returns negotiated ADD-PATH modes "receive", "send" or "send/receive" by AFI/SAFI in afi/safi form,
+// for example "1/1", AFI/SAFI without Path Identifier in either direction are omitted
+func (ap *AddPath) Modes() map[string]string {
+	m := make(map[string]string, len(ap.modes))
+	for af, mode := range ap.modes {
+		m[strconv.Itoa(int(af.afi))+"/"+strconv.Itoa(int(af.safi))] = addPathMode(mode)
+	}
+
+	return m
+}
@@ -0,0 +1,88 @@
+package bgp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func addPathOpen(values ...[]byte) *OpenMessage {
+	o := &OpenMessage{Capabilities: make(Capability)}
+	for _, v := range values {
+		o.Capabilities[69] = append(o.Capabilities[69], &CapabilityData{Value: v})
+	}
+
+	return o
+}
+
+func TestNegotiateAddPath(t *testing.T) {
+	tests := []struct {
+		name          string
+		local         *OpenMessage
+		remote        *OpenMessage
+		expectReceive map[int]bool
+		expectSend    map[int]bool
+		expectModes   map[string]string
+	}{
+		{
+			name:          "send/receive by both speakers",
+			local:         addPathOpen([]byte{0, 1, 1, 3}),
+			remote:        addPathOpen([]byte{0, 1, 1, 3}),
+			expectReceive: map[int]bool{NLRIMessageType(1, 1): true},
+			expectSend:    map[int]bool{NLRIMessageType(1, 1): true},
+			expectModes:   map[string]string{"1/1": "send/receive"},
+		},
+		{
+			name:          "local receive and remote send",
+			local:         addPathOpen([]byte{0, 1, 1, 1, 0, 2, 1, 1}),
+			remote:        addPathOpen([]byte{0, 1, 1, 2, 0, 2, 1, 1}),
+			expectReceive: map[int]bool{NLRIMessageType(1, 1): true},
+			expectSend:    map[int]bool{},
+			expectModes:   map[string]string{"1/1": "receive"},
+		},
+		{
+			name:          "local send and remote send/receive",
+			local:         addPathOpen([]byte{0, 2, 1, 2}),
+			remote:        addPathOpen([]byte{0, 2, 1, 3}),
+			expectReceive: map[int]bool{},
+			expectSend:    map[int]bool{NLRIMessageType(2, 1): true},
+			expectModes:   map[string]string{"2/1": "send"},
+		},
+		{
+			name:          "multiple capabilities",
+			local:         addPathOpen([]byte{0, 1, 1, 3}, []byte{0, 1, 128, 1}),
+			remote:        addPathOpen([]byte{0, 1, 128, 2}, []byte{0, 1, 1, 2}),
+			expectReceive: map[int]bool{NLRIMessageType(1, 1): true, NLRIMessageType(1, 128): true},
+			expectSend:    map[int]bool{},
+			expectModes:   map[string]string{"1/1": "receive", "1/128": "receive"},
+		},
+		{
+			name:          "advertised by local speaker only",
+			local:         addPathOpen([]byte{0, 1, 1, 3}),
+			remote:        addPathOpen(),
+			expectReceive: map[int]bool{},
+			expectSend:    map[int]bool{},
+			expectModes:   map[string]string{},
+		},
+		{
+			name:          "missing open",
+			local:         addPathOpen([]byte{0, 1, 1, 3}),
+			expectReceive: map[int]bool{},
+			expectSend:    map[int]bool{},
+			expectModes:   map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ap := NegotiateAddPath(tt.local, tt.remote)
+			if r := ap.Receive(); !reflect.DeepEqual(r, tt.expectReceive) {
+				t.Errorf("expected receive %+v but got %+v", tt.expectReceive, r)
+			}
+			if s := ap.Send(); !reflect.DeepEqual(s, tt.expectSend) {
+				t.Errorf("expected send %+v but got %+v", tt.expectSend, s)
+			}
+			if m := ap.Modes(); !reflect.DeepEqual(m, tt.expectModes) {
+				t.Errorf("expected modes %+v but got %+v", tt.expectModes, m)
+			}
+		})
+	}
+}
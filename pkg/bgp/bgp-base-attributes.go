@@ -6,11 +6,14 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"net"
 	"reflect"
 	"strconv"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/iana"
+	"github.com/sbezverk/gobmp/pkg/limits"
 	"github.com/sbezverk/tools"
 	"github.com/sbezverk/tools/sort"
 )
@@ -44,6 +47,25 @@ type BaseAttributes struct {
 	LgCommunityList []string `json:"large_community_list,omitempty"`
 	// SecPath
 	// AttrSet
+	// OTC is AS number of Only to Customer attribute, RFC 9234, routes carrying it must not be propagated to
+	// providers, peers and route servers
+	OTC uint32 `json:"otc,omitempty"`
+}
+
+// LLGRStaleCommunity is the well-known LLGR_STALE community marking routes retained as stale by Long-Lived
+// Graceful Restart
+// https://datatracker.ietf.org/doc/html/rfc9494#section-4.6
+const LLGRStaleCommunity = "65535:6"
+
+// IsLLGRStale returns true when communities of the route carry LLGR_STALE community
+func (ba *BaseAttributes) IsLLGRStale() bool {
+	for _, c := range ba.CommunityList {
+		if c == LLGRStaleCommunity {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (ba *BaseAttributes) Equal(oba *BaseAttributes) (bool, []string) {
@@ -114,6 +136,10 @@ func (ba *BaseAttributes) Equal(oba *BaseAttributes) (bool, []string) {
 		equal = false
 		diffs = append(diffs, "large_community_list mismatch")
 	}
+	if ba.OTC != oba.OTC {
+		equal = false
+		diffs = append(diffs, "otc mismatch: "+strconv.Itoa(int(ba.OTC))+" and "+strconv.Itoa(int(oba.OTC)))
+	}
 
 	return equal, diffs
 
@@ -146,6 +172,9 @@ func UnmarshalBGPBaseAttributes(b []byte) (*BaseAttributes, error) {
 		case 2:
 			baseAttr.ASPath = unmarshalAttrASPath(b[p : p+int(l)])
 			baseAttr.ASPathCount = int32(len(baseAttr.ASPath))
+			if err := limits.Check(limits.ASPathLength, len(baseAttr.ASPath)); err != nil {
+				return nil, fmt.Errorf("invalid AS_PATH attribute with error: %+v", err)
+			}
 		case 3:
 			baseAttr.Nexthop = unmarshalAttrNextHop(b[p : p+int(l)])
 		case 4:
@@ -157,16 +186,25 @@ func UnmarshalBGPBaseAttributes(b []byte) (*BaseAttributes, error) {
 		case 7:
 			baseAttr.Aggregator = unmarshalAttrAggregator(b[p : p+int(l)])
 		case 8:
+			if err := limits.Check(limits.Communities, int(l)/4); err != nil {
+				return nil, fmt.Errorf("invalid COMMUNITIES attribute with error: %+v", err)
+			}
 			baseAttr.CommunityList = unmarshalAttrCommunity(b[p : p+int(l)])
 		case 9:
 			baseAttr.OriginatorID = unmarshalAttrOriginatorID(b[p : p+int(l)])
 		case 10:
 			baseAttr.ClusterList = unmarshalAttrClusterList(b[p : p+int(l)])
 		case 16:
+			if err := limits.Check(limits.ExtCommunities, int(l)/8); err != nil {
+				return nil, fmt.Errorf("invalid EXTENDED COMMUNITIES attribute with error: %+v", err)
+			}
 			baseAttr.ExtCommunityList = unmarshalAttrExtCommunity(b[p : p+int(l)])
 		case 17:
 			baseAttr.AS4Path = unmarshalAttrAS4Path(b[p : p+int(l)])
 			baseAttr.AS4PathCount = int32(len(baseAttr.AS4Path))
+			if err := limits.Check(limits.ASPathLength, len(baseAttr.AS4Path)); err != nil {
+				return nil, fmt.Errorf("invalid AS4_PATH attribute with error: %+v", err)
+			}
 		case 18:
 			baseAttr.AS4Aggregator = unmarshalAttrAS4Aggregator(b[p : p+int(l)])
 		case 22:
@@ -175,14 +213,33 @@ func UnmarshalBGPBaseAttributes(b []byte) (*BaseAttributes, error) {
 			copy(baseAttr.TunnelEncapAttr, b[p:p+int(l)])
 		case 24:
 		case 25:
+			// IPv6 Address Specific Extended Communities are reported along with Extended Communities
+			baseAttr.ExtCommunityList = append(baseAttr.ExtCommunityList, unmarshalAttrIPv6ExtCommunity(b[p:p+int(l)])...)
+			if err := limits.Check(limits.ExtCommunities, len(baseAttr.ExtCommunityList)); err != nil {
+				return nil, fmt.Errorf("invalid IPv6 Address Specific Extended Community attribute with error: %+v", err)
+			}
 		case 26:
 		case 27:
 		case 28:
 		case 29:
 		case 32:
+			if err := limits.Check(limits.LargeCommunities, int(l)/12); err != nil {
+				return nil, fmt.Errorf("invalid LARGE_COMMUNITY attribute with error: %+v", err)
+			}
 			baseAttr.LgCommunityList = unmarshalAttrLgCommunity(b[p : p+int(l)])
 		case 33:
+		case OTCAttributeType:
+			otc, ok := unmarshalAttrOTC(b[p : p+int(l)])
+			if !ok {
+				glog.Errorf("invalid length %d of Only to Customer attribute", l)
+				break
+			}
+			baseAttr.OTC = otc
 		case 128:
+		default:
+			if glog.V(6) {
+				glog.Infof("path attribute %d %s is not a base attribute", t, iana.PathAttributeName(t))
+			}
 		}
 		p += int(l)
 	}
@@ -383,6 +440,20 @@ func unmarshalAttrExtCommunity(b []byte) []string {
 	return s
 }
 
+// unmarshalAttrIPv6ExtCommunity returns a slice with all IPv6 Address Specific extended communities found in bgp update
+func unmarshalAttrIPv6ExtCommunity(b []byte) []string {
+	ext, err := UnmarshalBGPIPv6ExtCommunity(b)
+	if err != nil {
+		return nil
+	}
+	s := make([]string, len(ext))
+	for i, c := range ext {
+		s[i] = c.String()
+	}
+
+	return s
+}
+
 // unmarshalAttrLgCommunity returns a slice with all large communities found in bgp update
 func unmarshalAttrLgCommunity(b []byte) []string {
 	lg, err := UnmarshalBGPLgCommunity(b)
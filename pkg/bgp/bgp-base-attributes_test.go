@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/go-test/deep"
+	"github.com/sbezverk/gobmp/pkg/limits"
 )
 
 func TestUnmarshaBaseAttributes(t *testing.T) {
@@ -103,3 +104,39 @@ func TestUnmarshalASPath(t *testing.T) {
 		}
 	}
 }
+
+func TestUnmarshalBaseAttributesLimits(t *testing.T) {
+	defer limits.Set(limits.Default)
+	limits.Set(limits.Limits{MaxCommunities: 2, MaxASPathLength: 2})
+	tests := []struct {
+		name  string
+		input []byte
+		fail  bool
+	}{
+		{
+			name:  "communities within limit",
+			input: []byte{0xc0, 0x08, 0x08, 0xfd, 0xe9, 0x00, 0x64, 0xfd, 0xe9, 0x00, 0xc8},
+		},
+		{
+			name:  "communities exceeding limit",
+			input: []byte{0xc0, 0x08, 0x0c, 0xfd, 0xe9, 0x00, 0x64, 0xfd, 0xe9, 0x00, 0xc8, 0xfd, 0xe9, 0x01, 0x2c},
+			fail:  true,
+		},
+		{
+			name:  "as path exceeding limit",
+			input: []byte{0x40, 0x02, 0x0e, 0x02, 0x03, 0x00, 0x00, 0xfd, 0xe9, 0x00, 0x00, 0xfd, 0xea, 0x00, 0x00, 0xfd, 0xeb},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UnmarshalBGPBaseAttributes(tt.input)
+			if tt.fail && err == nil {
+				t.Fatalf("expected attributes exceeding limits to fail")
+			}
+			if !tt.fail && err != nil {
+				t.Fatalf("failed to unmarshal attributes with error: %+v", err)
+			}
+		})
+	}
+}
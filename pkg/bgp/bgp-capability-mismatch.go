@@ -0,0 +1,144 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"sort"
+	"strconv"
+)
+
+// ADD-PATH Send/Receive field values, RFC 7911 section 4
+const (
+	addPathReceive = 1
+	addPathSend    = 2
+)
+
+type afiSAFI struct {
+	afi  uint16
+	safi uint8
+}
+
+func (a afiSAFI) String() string {
+	return "afi=" + strconv.Itoa(int(a.afi)) + " safi=" + strconv.Itoa(int(a.safi))
+}
+
+// multiprotocol returns AFI/SAFI advertised in Multiprotocol Extensions capabilities
+func (o *OpenMessage) multiprotocol() map[afiSAFI]bool {
+	m := make(map[afiSAFI]bool)
+	for _, c := range o.Capabilities[1] {
+		if len(c.Value) != 4 {
+			continue
+		}
+		m[afiSAFI{afi: binary.BigEndian.Uint16(c.Value[:2]), safi: c.Value[3]}] = true
+	}
+
+	return m
+}
+
+// addPath returns Send/Receive values of ADD-PATH capability per AFI/SAFI
+func (o *OpenMessage) addPath() map[afiSAFI]byte {
+	m := make(map[afiSAFI]byte)
+	for _, c := range o.Capabilities[69] {
+		for p := 0; p+4 <= len(c.Value); p += 4 {
+			m[afiSAFI{afi: binary.BigEndian.Uint16(c.Value[p : p+2]), safi: c.Value[p+2]}] = c.Value[p+3]
+		}
+	}
+
+	return m
+}
+
+func addPathMode(v byte) string {
+	switch v & (addPathReceive | addPathSend) {
+	case addPathReceive:
+		return "receive"
+	case addPathSend:
+		return "send"
+	case addPathReceive | addPathSend:
+		return "send/receive"
+	}
+
+	return "none"
+}
+
+func sortAFISAFI(l []afiSAFI) {
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].afi != l[j].afi {
+			return l[i].afi < l[j].afi
+		}
+		return l[i].safi < l[j].safi
+	})
+}
+
+// CapabilityMismatches compares capabilities of OPEN messages sent by the local and the remote speakers
+// and returns descriptions of notable mismatches: 4-octet AS number, Graceful Restart, Extended Message, BGP Role and
+// Multiprotocol Extensions capabilities advertised by only one speaker, BGP Roles not forming an allowed pair and
+// ADD-PATH direction advertised by one speaker, but not by the other, for example send/receive against receive.
+func CapabilityMismatches(local, remote *OpenMessage) []string {
+	if local == nil || remote == nil {
+		return nil
+	}
+	var mismatches []string
+	side := func(l bool) string {
+		if l {
+			return "local"
+		}
+		return "remote"
+	}
+	for _, c := range []struct {
+		code uint8
+		name string
+	}{
+		{65, "4-octet AS number"},
+		{64, "Graceful Restart"},
+		{ExtendedMessageCapabilityCode, "Extended Message"},
+		{RoleCapabilityCode, "BGP Role"},
+	} {
+		_, l := local.Capabilities[c.code]
+		_, r := remote.Capabilities[c.code]
+		if l != r {
+			mismatches = append(mismatches, c.name+" capability is advertised only by "+side(l)+" speaker")
+		}
+	}
+	if l, ok := local.Role(); ok {
+		if r, ok := remote.Role(); ok && !RolesMatch(l, r) {
+			mismatches = append(mismatches, "BGP Role "+RoleName(l)+" of local speaker does not match BGP Role "+RoleName(r)+" of remote speaker")
+		}
+	}
+	lmp, rmp := local.multiprotocol(), remote.multiprotocol()
+	var afs []afiSAFI
+	for af := range lmp {
+		if !rmp[af] {
+			afs = append(afs, af)
+		}
+	}
+	for af := range rmp {
+		if !lmp[af] {
+			afs = append(afs, af)
+		}
+	}
+	sortAFISAFI(afs)
+	for _, af := range afs {
+		mismatches = append(mismatches, af.String()+" is advertised only by "+side(lmp[af])+" speaker, the address family is not negotiated")
+	}
+	lap, rap := local.addPath(), remote.addPath()
+	afs = afs[:0]
+	for af := range lap {
+		afs = append(afs, af)
+	}
+	for af := range rap {
+		if _, ok := lap[af]; !ok {
+			afs = append(afs, af)
+		}
+	}
+	sortAFISAFI(afs)
+	for _, af := range afs {
+		l, r := lap[af], rap[af]
+		// ADD-PATH is used in a direction when the sender advertises send and the receiver advertises receive
+		if (l&addPathSend != 0) == (r&addPathReceive != 0) && (l&addPathReceive != 0) == (r&addPathSend != 0) {
+			continue
+		}
+		mismatches = append(mismatches, "ADD-PATH for "+af.String()+" is not matched by the peer, local speaker advertises "+addPathMode(l)+
+			", remote speaker advertises "+addPathMode(r))
+	}
+
+	return mismatches
+}
@@ -0,0 +1,88 @@
+package bgp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCapabilityMismatches(t *testing.T) {
+	mp := func(v ...byte) *CapabilityData { return &CapabilityData{Value: v} }
+	tests := []struct {
+		name   string
+		local  Capability
+		remote Capability
+		want   []string
+	}{
+		{
+			name:   "matching capabilities",
+			local:  Capability{65: {mp(0, 0, 0xfd, 0xe9)}, 1: {mp(0, 1, 0, 1)}, 69: {mp(0, 1, 1, 3)}},
+			remote: Capability{65: {mp(0, 0, 0xfd, 0xea)}, 1: {mp(0, 1, 0, 1)}, 69: {mp(0, 1, 1, 3)}},
+		},
+		{
+			name:   "4-octet as and graceful restart one-way",
+			local:  Capability{65: {mp(0, 0, 0xfd, 0xe9)}},
+			remote: Capability{64: {mp(0, 120)}},
+			want: []string{
+				"4-octet AS number capability is advertised only by local speaker",
+				"Graceful Restart capability is advertised only by remote speaker",
+			},
+		},
+		{
+			name:   "matching bgp roles",
+			local:  Capability{9: {mp(RoleProvider)}},
+			remote: Capability{9: {mp(RoleCustomer)}},
+		},
+		{
+			name:   "mismatching bgp roles",
+			local:  Capability{9: {mp(RoleProvider)}},
+			remote: Capability{9: {mp(RolePeer)}},
+			want: []string{
+				"BGP Role provider of local speaker does not match BGP Role peer of remote speaker",
+			},
+		},
+		{
+			name:   "bgp role one-way",
+			local:  Capability{9: {mp(RoleRSClient)}},
+			remote: Capability{},
+			want: []string{
+				"BGP Role capability is advertised only by local speaker",
+			},
+		},
+		{
+			name:   "address family advertised by one side",
+			local:  Capability{1: {mp(0, 1, 0, 1), mp(0, 2, 0, 1)}},
+			remote: Capability{1: {mp(0, 1, 0, 1), mp(0, 1, 0, 128)}},
+			want: []string{
+				"afi=1 safi=128 is advertised only by remote speaker, the address family is not negotiated",
+				"afi=2 safi=1 is advertised only by local speaker, the address family is not negotiated",
+			},
+		},
+		{
+			name:   "asymmetric add-path",
+			local:  Capability{69: {mp(0, 1, 1, 3, 0, 2, 1, 2)}},
+			remote: Capability{69: {mp(0, 1, 1, 1, 0, 2, 1, 1)}},
+			want: []string{
+				"ADD-PATH for afi=1 safi=1 is not matched by the peer, local speaker advertises send/receive, remote speaker advertises receive",
+			},
+		},
+		{
+			name:   "add-path advertised by one side",
+			local:  Capability{},
+			remote: Capability{69: {mp(0, 1, 1, 2)}},
+			want: []string{
+				"ADD-PATH for afi=1 safi=1 is not matched by the peer, local speaker advertises none, remote speaker advertises send",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CapabilityMismatches(&OpenMessage{Capabilities: tt.local}, &OpenMessage{Capabilities: tt.remote})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected mismatches %q but got %q", tt.want, got)
+			}
+		})
+	}
+	if got := CapabilityMismatches(nil, &OpenMessage{}); got != nil {
+		t.Errorf("expected no mismatches without local open message but got %q", got)
+	}
+}
@@ -5,37 +5,14 @@ import (
 	"strconv"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/iana"
 	"github.com/sbezverk/tools"
 )
 
 // BGPCapabilities lists registered and active BGP Capabilities as defined in
-// https://www.iana.org/assignments/capability-codes/capability-codes.xhtml
-var BGPCapabilities = map[uint8]string{
-	1:   "Multiprotocol Extensions for BGP-4",
-	2:   "Route Refresh Capability for BGP-4",
-	3:   "Outbound Route Filtering Capability",
-	4:   "Multiple routes to a destination capability (deprecated)",
-	5:   "Extended Next Hop Encoding",
-	6:   "BGP Extended Message",
-	7:   "BGPsec Capability",
-	8:   "Multiple Labels Capability",
-	9:   "BGP Role (TEMPORARY)",
-	64:  "Graceful Restart Capability",
-	65:  "Support for 4-octet AS number capability",
-	67:  "Support for Dynamic Capability (capability specific)",
-	68:  "Multisession BGP Capability",
-	69:  "ADD-PATH Capability",
-	70:  "Enhanced Route Refresh Capability",
-	71:  "Long-Lived Graceful Restart (LLGR) Capability",
-	72:  "Routing Policy Distribution",
-	73:  "FQDN Capability",
-	128: "Prestandard Route Refresh (deprecated)",
-	129: "Prestandard Outbound Route Filtering (deprecated)",
-	130: "Prestandard Outbound Route Filtering (deprecated)",
-	131: "Prestandard Multisession (deprecated)",
-	184: "Prestandard FQDN (deprecated)",
-	185: "Prestandard OPERATIONAL message (deprecated)",
-}
+// https://www.iana.org/assignments/capability-codes/capability-codes.xhtml, the table is generated
+// from the registry vendored in pkg/iana
+var BGPCapabilities = iana.Capabilities
 
 type CapabilityData struct {
 	Value       []byte `json:"capability_value,omitempty"`
@@ -92,11 +69,7 @@ func UnmarshalBGPCapability(b []byte) (Capability, error) {
 		capData := &CapabilityData{}
 		capData.Value = make([]byte, length)
 		copy(capData.Value, b[p:p+int(length)])
-		d, ok := BGPCapabilities[code]
-		capData.Description = d
-		if !ok {
-			capData.Description = "Unknown capability " + strconv.Itoa(int(code))
-		}
+		capData.Description = iana.CapabilityName(code)
 		switch code {
 		case 1:
 			// According RFC https://tools.ietf.org/html/rfc2858#section-7 Length will always be 4 bytes.
@@ -0,0 +1,52 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	// BGPMessageHeaderLength defines the length of BGP message header, 16 bytes marker, 2 bytes length and 1 byte type
+	BGPMessageHeaderLength = 19
+	// BGPMaxMessageLength defines the maximum length of BGP message, RFC 4271
+	BGPMaxMessageLength = 4096
+	// BGPMaxExtendedMessageLength defines the maximum length of BGP message between speakers which negotiated
+	// Extended Message capability, RFC 8654
+	BGPMaxExtendedMessageLength = 65535
+	// ExtendedMessageCapabilityCode defines the code of Extended Message capability, RFC 8654
+	ExtendedMessageCapabilityCode = 6
+)
+
+// IsExtendedMessageCapable returns true if Open message originated by a bgp speaker supporting
+// Extended Message capability
+func (o *OpenMessage) IsExtendedMessageCapable() bool {
+	if o == nil {
+		return false
+	}
+	_, ok := o.Capabilities[ExtendedMessageCapabilityCode]
+
+	return ok
+}
+
+// NegotiateExtendedMessage returns true when both the local and the remote speakers advertised Extended
+// Message capability, only then messages other than OPEN and KEEPALIVE may exceed 4096 bytes.
+func NegotiateExtendedMessage(local, remote *OpenMessage) bool {
+	return local.IsExtendedMessageCapable() && remote.IsExtendedMessageCapable()
+}
+
+// MessageLength validates BGP message header found at the beginning of the byte slice and returns the length
+// of the message, the length must not exceed max and the bytes available in the slice.
+func MessageLength(b []byte, max int) (int, error) {
+	if len(b) < BGPMessageHeaderLength {
+		return 0, fmt.Errorf("BGP message length %d is less than the header length %d", len(b), BGPMessageHeaderLength)
+	}
+	l := int(binary.BigEndian.Uint16(b[16:18]))
+	if l < BGPMessageHeaderLength || l > max {
+		return 0, fmt.Errorf("invalid BGP message length %d, the length must be between %d and %d", l, BGPMessageHeaderLength, max)
+	}
+	if l > len(b) {
+		return 0, fmt.Errorf("BGP message of length %d is truncated to %d bytes", l, len(b))
+	}
+
+	return l, nil
+}
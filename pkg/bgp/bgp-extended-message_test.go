@@ -0,0 +1,110 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func bgpMessage(length int, size int) []byte {
+	b := make([]byte, size)
+	for i := 0; i < 16; i++ {
+		b[i] = 0xff
+	}
+	binary.BigEndian.PutUint16(b[16:18], uint16(length))
+	b[18] = 2
+
+	return b
+}
+
+func TestMessageLength(t *testing.T) {
+	tests := []struct {
+		name   string
+		b      []byte
+		max    int
+		expect int
+		fail   bool
+	}{
+		{
+			name:   "classic message",
+			b:      bgpMessage(4096, 4096),
+			max:    BGPMaxMessageLength,
+			expect: 4096,
+		},
+		{
+			name: "extended message exceeding classic maximum",
+			b:    bgpMessage(4097, 4097),
+			max:  BGPMaxMessageLength,
+			fail: true,
+		},
+		{
+			name:   "extended message",
+			b:      bgpMessage(65535, 65535),
+			max:    BGPMaxExtendedMessageLength,
+			expect: 65535,
+		},
+		{
+			name:   "message followed by other bytes",
+			b:      bgpMessage(23, 30),
+			max:    BGPMaxMessageLength,
+			expect: 23,
+		},
+		{
+			name: "truncated message",
+			b:    bgpMessage(5000, 4096),
+			max:  BGPMaxExtendedMessageLength,
+			fail: true,
+		},
+		{
+			name: "length less than header",
+			b:    bgpMessage(18, 19),
+			max:  BGPMaxMessageLength,
+			fail: true,
+		},
+		{
+			name: "short header",
+			b:    make([]byte, 18),
+			max:  BGPMaxMessageLength,
+			fail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := MessageLength(tt.b, tt.max)
+			if tt.fail {
+				if err == nil {
+					t.Fatalf("expected validation to fail but got length %d", l)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to validate message length with error: %+v", err)
+			}
+			if l != tt.expect {
+				t.Errorf("expected length %d but got %d", tt.expect, l)
+			}
+		})
+	}
+}
+
+func TestNegotiateExtendedMessage(t *testing.T) {
+	capable := &OpenMessage{Capabilities: Capability{ExtendedMessageCapabilityCode: []*CapabilityData{{}}}}
+	classic := &OpenMessage{Capabilities: make(Capability)}
+	tests := []struct {
+		name   string
+		local  *OpenMessage
+		remote *OpenMessage
+		expect bool
+	}{
+		{name: "advertised by both speakers", local: capable, remote: capable, expect: true},
+		{name: "advertised by local speaker only", local: capable, remote: classic},
+		{name: "advertised by remote speaker only", local: classic, remote: capable},
+		{name: "missing open", local: capable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if n := NegotiateExtendedMessage(tt.local, tt.remote); n != tt.expect {
+				t.Errorf("expected %t but got %t", tt.expect, n)
+			}
+		})
+	}
+}
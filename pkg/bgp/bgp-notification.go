@@ -0,0 +1,83 @@
+package bgp
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/tools"
+)
+
+// BGP message types per rfc4271, Route Refresh message type is defined with Route Refresh message
+const (
+	OpenMessageType         = 1
+	UpdateMessageType       = 2
+	NotificationMessageType = 3
+	KeepaliveMessageType    = 4
+	// BGPMinNotificationMessageLength defines a minimum length of BGP Notification message body
+	BGPMinNotificationMessageLength = 2
+)
+
+var messageTypeNames = map[uint8]string{
+	OpenMessageType:         "open",
+	UpdateMessageType:       "update",
+	NotificationMessageType: "notification",
+	KeepaliveMessageType:    "keepalive",
+	RouteRefreshMessageType: "route_refresh",
+}
+
+// Notification message error codes per rfc4271 and rfc7313
+var notificationErrorCodes = map[uint8]string{
+	1: "message_header_error",
+	2: "open_message_error",
+	3: "update_message_error",
+	4: "hold_timer_expired",
+	5: "fsm_error",
+	6: "cease",
+	7: "route_refresh_message_error",
+}
+
+// MessageTypeName returns the name of BGP message type
+func MessageTypeName(t uint8) string {
+	if n, ok := messageTypeNames[t]; ok {
+		return n
+	}
+
+	return fmt.Sprintf("unknown(%d)", t)
+}
+
+// Notification defines BGP Notification message structure
+type Notification struct {
+	ErrorCode    uint8
+	ErrorSubcode uint8
+	Data         []byte
+}
+
+// GetErrorCode returns the name of the error code of Notification message
+func (n *Notification) GetErrorCode() string {
+	if c, ok := notificationErrorCodes[n.ErrorCode]; ok {
+		return c
+	}
+
+	return fmt.Sprintf("unknown(%d)", n.ErrorCode)
+}
+
+// UnmarshalBGPNotificationMessage validates information passed in a slice of bytes and builds BGP Notification
+// message, b is the message following the BGP message header
+func UnmarshalBGPNotificationMessage(b []byte) (*Notification, error) {
+	if glog.V(6) {
+		glog.Infof("BGP Notification Message Raw: %s", tools.MessageHex(b))
+	}
+	if len(b) < BGPMinNotificationMessageLength {
+		return nil, fmt.Errorf("notification message length %d is less than minimum %d", len(b), BGPMinNotificationMessageLength)
+	}
+	n := &Notification{
+		ErrorCode:    b[0],
+		ErrorSubcode: b[1],
+	}
+	if len(b) > BGPMinNotificationMessageLength {
+		n.Data = make([]byte, len(b)-BGPMinNotificationMessageLength)
+		copy(n.Data, b[BGPMinNotificationMessageLength:])
+	}
+
+	return n, nil
+}
@@ -0,0 +1,60 @@
+package bgp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalBGPNotificationMessage(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		expect *Notification
+		code   string
+		fail   bool
+	}{
+		{
+			name:   "cease administrative shutdown",
+			input:  []byte{0x06, 0x02},
+			expect: &Notification{ErrorCode: 6, ErrorSubcode: 2},
+			code:   "cease",
+		},
+		{
+			name:   "hold timer expired with data",
+			input:  []byte{0x04, 0x00, 0x01, 0x02},
+			expect: &Notification{ErrorCode: 4, Data: []byte{0x01, 0x02}},
+			code:   "hold_timer_expired",
+		},
+		{
+			name:   "unknown error code",
+			input:  []byte{0x0a, 0x01},
+			expect: &Notification{ErrorCode: 10, ErrorSubcode: 1},
+			code:   "unknown(10)",
+		},
+		{
+			name:  "truncated",
+			input: []byte{0x06},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := UnmarshalBGPNotificationMessage(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("expected to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("expected to fail but succeeded")
+			}
+			if !reflect.DeepEqual(n, tt.expect) {
+				t.Errorf("expected notification %+v but got %+v", tt.expect, n)
+			}
+			if c := n.GetErrorCode(); c != tt.code {
+				t.Errorf("expected error code %s but got %s", tt.code, c)
+			}
+		})
+	}
+}
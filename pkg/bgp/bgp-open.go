@@ -122,6 +122,9 @@ func UnmarshalBGPOpenMessage(b []byte) (*OpenMessage, error) {
 	p += 4
 	m.OptParamLen = b[p]
 	p++
+	if p+int(m.OptParamLen) > len(b) {
+		return nil, fmt.Errorf("invalid optional parameters length %d of BGP Open Message of length %d", m.OptParamLen, len(b))
+	}
 	if m.OptParamLen != 0 {
 		if m.OptionalParameters, m.Capabilities, err = UnmarshalBGPTLV(b[p : p+int(m.OptParamLen)]); err != nil {
 			return nil, err
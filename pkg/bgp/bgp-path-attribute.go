@@ -2,8 +2,10 @@ package bgp
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/limits"
 	"github.com/sbezverk/tools"
 )
 
@@ -23,18 +25,27 @@ func UnmarshalBGPPathAttributes(b []byte) ([]PathAttribute, error) {
 	attrs := make([]PathAttribute, 0)
 
 	for p := 0; p < len(b); {
+		if p+3 > len(b) {
+			return nil, fmt.Errorf("not enough bytes to unmarshal path attribute header, only %d bytes remain", len(b)-p)
+		}
 		f := b[p]
 		t := b[p+1]
 		p += 2
 		var l uint16
 		// Checking for Extened
 		if f&0x10 == 0x10 {
+			if p+2 > len(b) {
+				return nil, fmt.Errorf("not enough bytes to unmarshal extended length of path attribute type %d", t)
+			}
 			l = binary.BigEndian.Uint16(b[p : p+2])
 			p += 2
 		} else {
 			l = uint16(b[p])
 			p++
 		}
+		if p+int(l) > len(b) {
+			return nil, fmt.Errorf("invalid length %d of path attribute type %d, only %d bytes remain", l, t, len(b)-p)
+		}
 		pa := PathAttribute{
 			AttributeTypeFlags: f,
 			AttributeType:      t,
@@ -47,5 +58,9 @@ func UnmarshalBGPPathAttributes(b []byte) ([]PathAttribute, error) {
 		p += int(l)
 	}
 
+	if err := limits.Check(limits.Attributes, len(attrs)); err != nil {
+		return nil, fmt.Errorf("invalid path attributes with error: %+v", err)
+	}
+
 	return attrs, nil
 }
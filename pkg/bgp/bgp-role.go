@@ -0,0 +1,82 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"strconv"
+)
+
+const (
+	// RoleCapabilityCode defines the code of BGP Role capability, RFC 9234
+	RoleCapabilityCode = 9
+	// OTCAttributeType defines the type of Only to Customer (OTC) path attribute, RFC 9234
+	OTCAttributeType = 35
+)
+
+// BGP Role values, RFC 9234 section 4.1
+const (
+	RoleProvider = 0
+	RoleRS       = 1
+	RoleRSClient = 2
+	RoleCustomer = 3
+	RolePeer     = 4
+)
+
+// RoleName returns the name of BGP Role value
+func RoleName(role uint8) string {
+	switch role {
+	case RoleProvider:
+		return "provider"
+	case RoleRS:
+		return "rs"
+	case RoleRSClient:
+		return "rs-client"
+	case RoleCustomer:
+		return "customer"
+	case RolePeer:
+		return "peer"
+	}
+
+	return "unknown(" + strconv.Itoa(int(role)) + ")"
+}
+
+// Role returns the value of BGP Role capability of Open message and true, false is returned if the speaker
+// did not advertise the capability or it is malformed
+func (o *OpenMessage) Role() (uint8, bool) {
+	if o == nil {
+		return 0, false
+	}
+	v, ok := o.Capabilities[RoleCapabilityCode]
+	if !ok || len(v) == 0 || len(v[0].Value) != 1 {
+		return 0, false
+	}
+
+	return v[0].Value[0], true
+}
+
+// RolesMatch returns true when roles of the local and the remote speakers are one of allowed pairs, RFC 9234
+// section 4.2: provider and customer, route server and its client or peers
+func RolesMatch(local, remote uint8) bool {
+	switch local {
+	case RoleProvider:
+		return remote == RoleCustomer
+	case RoleCustomer:
+		return remote == RoleProvider
+	case RoleRS:
+		return remote == RoleRSClient
+	case RoleRSClient:
+		return remote == RoleRS
+	case RolePeer:
+		return remote == RolePeer
+	}
+
+	return false
+}
+
+// unmarshalAttrOTC returns AS number of Only to Customer (OTC) attribute, false is returned for malformed attribute
+func unmarshalAttrOTC(b []byte) (uint32, bool) {
+	if len(b) != 4 {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint32(b), true
+}
@@ -0,0 +1,80 @@
+package bgp
+
+import (
+	"testing"
+)
+
+func TestRolesMatch(t *testing.T) {
+	tests := []struct {
+		local  uint8
+		remote uint8
+		expect bool
+	}{
+		{local: RoleProvider, remote: RoleCustomer, expect: true},
+		{local: RoleCustomer, remote: RoleProvider, expect: true},
+		{local: RoleRS, remote: RoleRSClient, expect: true},
+		{local: RoleRSClient, remote: RoleRS, expect: true},
+		{local: RolePeer, remote: RolePeer, expect: true},
+		{local: RoleProvider, remote: RoleProvider},
+		{local: RoleCustomer, remote: RolePeer},
+		{local: RoleRS, remote: RoleRS},
+		{local: 5, remote: 5},
+	}
+	for _, tt := range tests {
+		t.Run(RoleName(tt.local)+"/"+RoleName(tt.remote), func(t *testing.T) {
+			if m := RolesMatch(tt.local, tt.remote); m != tt.expect {
+				t.Errorf("expected %t but got %t", tt.expect, m)
+			}
+		})
+	}
+}
+
+func TestOpenMessageRole(t *testing.T) {
+	tests := []struct {
+		name   string
+		caps   Capability
+		expect uint8
+		ok     bool
+	}{
+		{name: "customer", caps: Capability{9: {{Value: []byte{RoleCustomer}}}}, expect: RoleCustomer, ok: true},
+		{name: "not advertised", caps: Capability{}},
+		{name: "malformed", caps: Capability{9: {{Value: []byte{0, 3}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, ok := (&OpenMessage{Capabilities: tt.caps}).Role()
+			if r != tt.expect || ok != tt.ok {
+				t.Errorf("expected role %d %t but got %d %t", tt.expect, tt.ok, r, ok)
+			}
+		})
+	}
+}
+
+func TestUnmarshalOTC(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		expect uint32
+	}{
+		{
+			name:   "otc of 4 bytes as",
+			input:  []byte{0xc0, 0x23, 0x04, 0x00, 0x01, 0x00, 0x01},
+			expect: 65537,
+		},
+		{
+			name:  "malformed otc",
+			input: []byte{0xc0, 0x23, 0x02, 0xfd, 0xe9},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ba, err := UnmarshalBGPBaseAttributes(tt.input)
+			if err != nil {
+				t.Fatalf("failed to unmarshal attributes with error: %+v", err)
+			}
+			if ba.OTC != tt.expect {
+				t.Errorf("expected otc %d but got %d", tt.expect, ba.OTC)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/tools"
+)
+
+const (
+	// RouteRefreshMessageType defines the type of BGP Route Refresh message per rfc2918
+	RouteRefreshMessageType = 5
+	// BGPMinRouteRefreshMessageLength defines a minimum length of BGP Route Refresh message body
+	BGPMinRouteRefreshMessageLength = 4
+	// AddressPrefixORF defines Address Prefix ORF type per rfc5292
+	AddressPrefixORF = 64
+)
+
+// Route Refresh message subtypes per rfc7313
+var routeRefreshSubtypes = map[uint8]string{
+	0: "refresh",
+	1: "borr",
+	2: "eorr",
+}
+
+// ORF entry actions and matches per rfc5291
+var (
+	orfActions = map[uint8]string{0: "add", 1: "remove", 2: "remove-all"}
+	orfMatches = map[uint8]string{0: "permit", 1: "deny"}
+)
+
+// ORFEntry defines an entry of Outbound Route Filter, Sequence, MinLen, MaxLen and Prefix are set
+// for Address Prefix ORF entries, Value carries entries of other ORF types
+type ORFEntry struct {
+	Action    string `json:"action"`
+	Match     string `json:"match,omitempty"`
+	Sequence  uint32 `json:"sequence,omitempty"`
+	MinLen    uint8  `json:"min_len,omitempty"`
+	MaxLen    uint8  `json:"max_len,omitempty"`
+	Prefix    string `json:"prefix,omitempty"`
+	PrefixLen uint8  `json:"prefix_len,omitempty"`
+	Value     []byte `json:"value,omitempty"`
+}
+
+// ORF defines Outbound Route Filter of a type carried by BGP Route Refresh message per rfc5291
+type ORF struct {
+	// WhenToRefresh is "immediate" or "defer"
+	WhenToRefresh string     `json:"when_to_refresh"`
+	Type          uint8      `json:"orf_type"`
+	Entries       []ORFEntry `json:"entries,omitempty"`
+}
+
+// RouteRefresh defines BGP Route Refresh message structure
+type RouteRefresh struct {
+	AFI     uint16
+	SAFI    uint8
+	Subtype uint8
+	ORFs    []ORF
+}
+
+// GetSubtype returns the name of Route Refresh subtype, "refresh" for the request, "borr" and "eorr" for
+// the beginning and the end of Enhanced Route Refresh
+func (r *RouteRefresh) GetSubtype() string {
+	if s, ok := routeRefreshSubtypes[r.Subtype]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("unknown(%d)", r.Subtype)
+}
+
+// UnmarshalBGPRouteRefreshMessage validates information passed in a slice of bytes and builds BGP Route Refresh
+// message, b is the message following the BGP message header
+func UnmarshalBGPRouteRefreshMessage(b []byte) (*RouteRefresh, error) {
+	if glog.V(6) {
+		glog.Infof("BGP Route Refresh Message Raw: %s", tools.MessageHex(b))
+	}
+	if len(b) < BGPMinRouteRefreshMessageLength {
+		return nil, fmt.Errorf("route refresh message length %d is less than minimum %d", len(b), BGPMinRouteRefreshMessageLength)
+	}
+	r := &RouteRefresh{
+		AFI:     binary.BigEndian.Uint16(b[0:2]),
+		Subtype: b[2],
+		SAFI:    b[3],
+	}
+	for p := BGPMinRouteRefreshMessageLength; p < len(b); {
+		if p+4 > len(b) {
+			return nil, fmt.Errorf("not enough bytes to unmarshal ORF of route refresh message")
+		}
+		orf := ORF{
+			WhenToRefresh: "immediate",
+			Type:          b[p+1],
+		}
+		if b[p] == 2 {
+			orf.WhenToRefresh = "defer"
+		}
+		l := int(binary.BigEndian.Uint16(b[p+2 : p+4]))
+		p += 4
+		if p+l > len(b) {
+			return nil, fmt.Errorf("invalid length %d of ORF type %d, only %d bytes remain", l, orf.Type, len(b)-p)
+		}
+		entries, err := unmarshalORFEntries(orf.Type, r.AFI, b[p:p+l])
+		if err != nil {
+			return nil, err
+		}
+		orf.Entries = entries
+		r.ORFs = append(r.ORFs, orf)
+		p += l
+	}
+
+	return r, nil
+}
+
+func unmarshalORFEntries(t uint8, afi uint16, b []byte) ([]ORFEntry, error) {
+	if t != AddressPrefixORF {
+		// Entries of other ORF types are not delimited by a common header, they are carried as a single value
+		if len(b) == 0 {
+			return nil, nil
+		}
+		v := make([]byte, len(b))
+		copy(v, b)
+		return []ORFEntry{{Action: orfActions[b[0]>>6], Match: orfMatches[(b[0]>>5)&1], Value: v}}, nil
+	}
+	entries := make([]ORFEntry, 0)
+	for p := 0; p < len(b); {
+		e := ORFEntry{
+			Action: orfActions[b[p]>>6],
+			Match:  orfMatches[(b[p]>>5)&1],
+		}
+		if e.Action == "" {
+			return nil, fmt.Errorf("invalid action %d of ORF entry", b[p]>>6)
+		}
+		p++
+		// Remove-all entry carries only the common part
+		if e.Action == "remove-all" {
+			e.Match = ""
+			entries = append(entries, e)
+			continue
+		}
+		if p+7 > len(b) {
+			return nil, fmt.Errorf("not enough bytes to unmarshal Address Prefix ORF entry")
+		}
+		e.Sequence = binary.BigEndian.Uint32(b[p : p+4])
+		e.MinLen = b[p+4]
+		e.MaxLen = b[p+5]
+		e.PrefixLen = b[p+6]
+		p += 7
+		n := (int(e.PrefixLen) + 7) / 8
+		size := 4
+		if afi == 2 {
+			size = 16
+		}
+		if n > size || p+n > len(b) {
+			return nil, fmt.Errorf("invalid prefix length %d of Address Prefix ORF entry", e.PrefixLen)
+		}
+		a := make([]byte, size)
+		copy(a, b[p:p+n])
+		e.Prefix = net.IP(a).String()
+		entries = append(entries, e)
+		p += n
+	}
+
+	return entries, nil
+}
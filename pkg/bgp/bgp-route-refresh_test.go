@@ -0,0 +1,81 @@
+package bgp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalBGPRouteRefreshMessage(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		expect *RouteRefresh
+		fail   bool
+	}{
+		{
+			name:   "route refresh ipv4 unicast",
+			input:  []byte{0x00, 0x01, 0x00, 0x01},
+			expect: &RouteRefresh{AFI: 1, SAFI: 1},
+		},
+		{
+			name:   "end of enhanced route refresh ipv6 unicast",
+			input:  []byte{0x00, 0x02, 0x02, 0x01},
+			expect: &RouteRefresh{AFI: 2, SAFI: 1, Subtype: 2},
+		},
+		{
+			name: "address prefix orf",
+			input: []byte{0x00, 0x01, 0x00, 0x01,
+				// Immediate, Address Prefix ORF of 2 entries
+				0x01, 0x40, 0x00, 0x13,
+				// Add permit 10.0.0.0/8 le 24
+				0x00, 0x00, 0x00, 0x00, 0x0a, 0x08, 0x18, 0x08, 0x0a,
+				// Add deny 192.168.0.0/16
+				0x20, 0x00, 0x00, 0x00, 0x14, 0x00, 0x00, 0x10, 0xc0, 0xa8,
+			},
+			expect: &RouteRefresh{AFI: 1, SAFI: 1, ORFs: []ORF{
+				{
+					WhenToRefresh: "immediate",
+					Type:          AddressPrefixORF,
+					Entries: []ORFEntry{
+						{Action: "add", Match: "permit", Sequence: 10, MinLen: 8, MaxLen: 24, Prefix: "10.0.0.0", PrefixLen: 8},
+						{Action: "add", Match: "deny", Sequence: 20, Prefix: "192.168.0.0", PrefixLen: 16},
+					},
+				},
+			}},
+		},
+		{
+			name:  "deferred remove-all orf",
+			input: []byte{0x00, 0x01, 0x00, 0x80, 0x02, 0x40, 0x00, 0x01, 0x80},
+			expect: &RouteRefresh{AFI: 1, SAFI: 128, ORFs: []ORF{
+				{WhenToRefresh: "defer", Type: AddressPrefixORF, Entries: []ORFEntry{{Action: "remove-all"}}},
+			}},
+		},
+		{
+			name:  "truncated orf",
+			input: []byte{0x00, 0x01, 0x00, 0x01, 0x01, 0x40, 0x00, 0x12, 0x00},
+			fail:  true,
+		},
+		{
+			name:  "short message",
+			input: []byte{0x00, 0x01},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := UnmarshalBGPRouteRefreshMessage(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("supposed to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if !reflect.DeepEqual(r, tt.expect) {
+				t.Errorf("expected route refresh %+v but got %+v", tt.expect, r)
+			}
+		})
+	}
+}
@@ -79,6 +79,17 @@ func (up *Update) GetAttrPrefixSID() (*prefixsid.PSid, error) {
 	return nil, fmt.Errorf("not found")
 }
 
+// GetAttrExtCommunity check for presense of BGP Attribute Extended Communities (16) and instantiates them
+func (up *Update) GetAttrExtCommunity() ([]ExtCommunity, error) {
+	for _, attr := range up.PathAttributes {
+		if attr.AttributeType == 16 {
+			return UnmarshalBGPExtCommunity(attr.Attribute)
+		}
+	}
+	// TODO return new type of errors to be able to check for the code
+	return nil, fmt.Errorf("not found")
+}
+
 // HasPrefixSID check for presense of BGP Attribute Prefix SID (40) and returns true is found
 func (up *Update) HasPrefixSID() bool {
 	for _, attr := range up.PathAttributes {
@@ -114,13 +125,22 @@ func UnmarshalBGPUpdate(b []byte) (*Update, error) {
 	}
 	p := 0
 	u := Update{}
+	if len(b) < 4 {
+		return nil, fmt.Errorf("update message length %d is less than minimum 4", len(b))
+	}
 	u.WithdrawnRoutesLength = binary.BigEndian.Uint16(b[p : p+2])
 	p += 2
+	if p+int(u.WithdrawnRoutesLength)+2 > len(b) {
+		return nil, fmt.Errorf("invalid withdrawn routes length %d of update message of length %d", u.WithdrawnRoutesLength, len(b))
+	}
 	u.WithdrawnRoutes = make([]byte, u.WithdrawnRoutesLength)
 	copy(u.WithdrawnRoutes, b[p:p+int(u.WithdrawnRoutesLength)])
 	p += int(u.WithdrawnRoutesLength)
 	u.TotalPathAttributeLength = binary.BigEndian.Uint16(b[p : p+2])
 	p += 2
+	if p+int(u.TotalPathAttributeLength) > len(b) {
+		return nil, fmt.Errorf("invalid path attributes length %d of update message of length %d", u.TotalPathAttributeLength, len(b))
+	}
 	attrs, err := UnmarshalBGPPathAttributes(b[p : p+int(u.TotalPathAttributeLength)])
 	if err != nil {
 		return nil, err
@@ -70,3 +70,38 @@ func TestUnmarshalBGPUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalBGPUpdateTruncated(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{
+			name:  "missing lengths",
+			input: []byte{0x00},
+		},
+		{
+			name:  "withdrawn routes length exceeds message",
+			input: []byte{0x00, 0x08, 0x18, 0x0a, 0x00, 0x00},
+		},
+		{
+			name:  "path attributes length exceeds message",
+			input: []byte{0x00, 0x00, 0x00, 0x10, 0x40, 0x01, 0x01, 0x00},
+		},
+		{
+			name:  "path attribute length exceeds path attributes",
+			input: []byte{0x00, 0x00, 0x00, 0x04, 0x40, 0x01, 0x04, 0x00},
+		},
+		{
+			name:  "truncated extended length of path attribute",
+			input: []byte{0x00, 0x00, 0x00, 0x03, 0x50, 0x0e, 0x00},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := UnmarshalBGPUpdate(tt.input); err == nil {
+				t.Fatalf("expected to fail but succeeded")
+			}
+		})
+	}
+}
@@ -25,6 +25,8 @@ const (
 	ECPVRFRouteImport = "vri="
 	// ECPFlowSpecRedirIPv4 extended community prefix for Flow-spec Redirect to IPv4 [draft-ietf-idr-flowspec-redirect]
 	ECPFlowSpecRedirIPv4 = "fsr="
+	// ECPFlowSpecRedirIPv6 extended community prefix for Flow-spec Redirect to IPv6 [draft-ietf-idr-flowspec-redirect-ip]
+	ECPFlowSpecRedirIPv6 = "fsr6="
 	// ECPInterAreaP2MPSegmentedNexyHop extended community prefix for Inter-Area P2MP Segmented Next-Hop	[RFC7524]
 	ECPInterAreaP2MPSegmentedNexyHop = "snh="
 	// ECPVRFRecursiveNextHop extended community prefix for VRF-Recursive-Next-Hop-Extended-Community	[Dhananjaya_Rao]
@@ -120,4 +122,13 @@ const (
 	CPFlowspecRedirect = "flowspec-redirect="
 	// CPFlowspecTrafficRemarking defines Flowspec Traffic Remarking Sub type
 	CPFlowspecTrafficRemarking = "flowspec-traffic-remarking="
+	// CPFlowspecRedirectIPv6 defines Flowspec Redirect IPv6 Sub type of IPv6 Address Specific Extended Community [RFC8956]
+	CPFlowspecRedirectIPv6 = "flowspec-redirect-ipv6="
+	// CPFlowspecInterfaceSet defines Flowspec Interface-set Sub type [draft-ietf-idr-flowspec-interfaceset]
+	CPFlowspecInterfaceSet = "flowspec-interface-set="
+
+	// MUP Extended Community Sub Types
+
+	// CPMUPDirectSegment defines Direct-Type Segment Identifier Sub type of MUP Extended Community [draft-ietf-bess-mup-safi]
+	CPMUPDirectSegment = "mup-direct-segment="
 )
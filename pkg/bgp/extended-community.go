@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"strings"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/iana"
 	"github.com/sbezverk/tools"
 )
 
@@ -32,6 +34,16 @@ func (ext *ExtCommunity) IsRouteTarget() bool {
 	return false
 }
 
+// GetRouterMAC returns MAC address carried by EVPN Router's MAC extended community, nil is returned for
+// other extended communities
+func (ext *ExtCommunity) GetRouterMAC() net.HardwareAddr {
+	if ext.Type != 0x06 || ext.SubType == nil || *ext.SubType != 0x03 || len(ext.Value) != 6 {
+		return nil
+	}
+
+	return net.HardwareAddr(ext.Value)
+}
+
 func makeExtCommunity(b []byte) (*ExtCommunity, error) {
 	ext := ExtCommunity{}
 	if len(b) != 8 {
@@ -49,6 +61,10 @@ func makeExtCommunity(b []byte) (*ExtCommunity, error) {
 	case 2:
 		fallthrough
 	case 6:
+		fallthrough
+	case 7:
+		fallthrough
+	case 0xc:
 		st := uint8(b[p])
 		ext.SubType = &st
 		l = 6
@@ -239,6 +255,18 @@ var flowspecSubTypes = map[uint8]string{
 	0x9: CPFlowspecTrafficRemarking,
 }
 
+// FlowSpec Transitive and Non-Transitive Extended Community Sub-Types
+// 0x02               Flow-spec Interface-set [draft-ietf-idr-flowspec-interfaceset]
+var flowspecExtSubTypes = map[uint8]string{
+	0x2: CPFlowspecInterfaceSet,
+}
+
+// MUP Extended Community Sub-Types
+// 0x00               Direct-Type Segment Identifier [draft-ietf-bess-mup-safi]
+var mupSubTypes = map[uint8]string{
+	0x0: CPMUPDirectSegment,
+}
+
 func getSubType(m map[uint8]string, subType uint8) string {
 	if s, ok := m[subType]; ok {
 		return s
@@ -309,6 +337,32 @@ func type8(subType uint8, value []byte) string {
 	return ECPFlowspec + "redirect_to_ip_next_hop"
 }
 
+// FlowSpec Transitive (0x07) and Non-Transitive (0x47) Extended Community
+func type7(subType uint8, value []byte) string {
+	var s string
+	switch subType {
+	case 0x02:
+		// 4 bytes of AS followed by O and I flags and 14 bits of Group Identifier
+		f := binary.BigEndian.Uint16(value[4:])
+		var d string
+		switch f >> 14 {
+		case 0x1:
+			d = "input"
+		case 0x2:
+			d = "output"
+		case 0x3:
+			d = "input-output"
+		default:
+			d = "none"
+		}
+		s = fmt.Sprintf("%d:%d:%s", binary.BigEndian.Uint32(value[0:4]), f&0x3fff, d)
+	default:
+		s = tools.MessageHex(value)
+	}
+
+	return getSubType(flowspecExtSubTypes, subType) + s
+}
+
 // Non-Transitive Two-Octet AS-Specific Extended Community
 func type40(subType uint8, value []byte) string {
 	var s string
@@ -334,11 +388,22 @@ func type80(subType uint8, value []byte) string {
 			s = fmt.Sprintf("AS: %d Rate: %d bps", binary.BigEndian.Uint16(value[:2]), uint32(math.Float32frombits(binary.BigEndian.Uint32(value[2:])))*8)
 		case 0x08:
 			s = fmt.Sprintf("%d:%d", binary.BigEndian.Uint16(value[0:2]), binary.BigEndian.Uint32(value[2:]))
-		case 0x09:
-			fallthrough
 		case 0x07:
-			// TODO (sbezverk) add corresponding transformation actions
-			fallthrough
+			// Traffic-action (RFC 8955 Section 7.3), the least significant byte carries Sample and Terminal bits
+			actions := make([]string, 0)
+			if value[5]&0x02 != 0 {
+				actions = append(actions, "sample")
+			}
+			if value[5]&0x01 != 0 {
+				actions = append(actions, "terminal")
+			}
+			if len(actions) == 0 {
+				actions = append(actions, "none")
+			}
+			s = strings.Join(actions, "-")
+		case 0x09:
+			// Traffic-marking (RFC 8955 Section 7.5), DSCP value is carried in 6 least significant bits
+			s = fmt.Sprintf("dscp:%d", value[5]&0x3f)
 		default:
 			s = tools.MessageHex(value)
 		}
@@ -380,6 +445,18 @@ func type82(subType uint8, value []byte) string {
 	return getSubType(flowspecSubTypes, subType) + s
 }
 
+// MUP Extended Community, Direct-Type Segment Identifier is carried as 2 octets followed by 4 octets value
+func type0c(subType uint8, value []byte) string {
+	var s string
+	switch subType {
+	case 0x0:
+		s = fmt.Sprintf("%d:%d", binary.BigEndian.Uint16(value[0:2]), binary.BigEndian.Uint32(value[2:]))
+	default:
+		s = tools.MessageHex(value)
+	}
+	return getSubType(mupSubTypes, subType) + s
+}
+
 // extComm defines a map with Extended Community as a key, it return a function to process a type specific sub type.
 var extComm = map[uint8]func(uint8, []byte) string{
 	0x0:  type0,
@@ -387,8 +464,11 @@ var extComm = map[uint8]func(uint8, []byte) string{
 	0x2:  type2,
 	0x3:  type3,
 	0x6:  type6,
+	0x7:  type7,
 	0x8:  type8,
+	0xc:  type0c,
 	0x40: type40,
+	0x47: type7,
 	0x80: type80,
 	0x81: type81,
 	0x82: type82,
@@ -406,6 +486,15 @@ func (ext *ExtCommunity) String() string {
 	f := extComm[ext.Type]
 	if f == nil {
 		s = "unknown="
+		if n, ok := iana.ExtCommunityTypes[ext.Type]; ok {
+			s += fmt.Sprintf("Type: %d (%s) ", ext.Type, n)
+			if ext.SubType != nil {
+				// Sub-Type is printed only for types carrying it
+				s += fmt.Sprintf("Subtype: %d ", subType)
+			}
+			s += fmt.Sprintf("Value: %s", tools.MessageHex(ext.Value))
+			return s
+		}
 		s += fmt.Sprintf("Type: %d Subtype: %d Value: %s", ext.Type, subType, tools.MessageHex(ext.Value))
 		return s
 	}
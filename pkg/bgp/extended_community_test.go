@@ -31,6 +31,51 @@ func TestExtendedCommunity(t *testing.T) {
 			input:  []byte{0x06, 0x03, 0x0c, 0x03, 0x00, 0x00, 0x1b, 0x08},
 			expect: "rmac=0C:03:00:00:1B:08",
 		},
+		{
+			name:   "mup direct segment",
+			input:  []byte{0x0c, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64},
+			expect: "mup-direct-segment=65000:100",
+		},
+		{
+			name:   "mup unknown sub type",
+			input:  []byte{0x0c, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+			expect: "Subtype unknown=[ 0x00, 0x00, 0x00, 0x00, 0x00, 0x01 ]",
+		},
+		{
+			name:   "type 0x04 registered by iana without sub type",
+			input:  []byte{0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+			expect: "unknown=Type: 4 (QoS Marking) Value: [ 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01 ]",
+		},
+		{
+			name:   "flowspec interface-set input",
+			input:  []byte{0x07, 0x02, 0x00, 0x00, 0xfd, 0xe8, 0x40, 0x64},
+			expect: "flowspec-interface-set=65000:100:input",
+		},
+		{
+			name:   "flowspec interface-set input and output",
+			input:  []byte{0x07, 0x02, 0x00, 0x00, 0xfd, 0xe8, 0xff, 0xff},
+			expect: "flowspec-interface-set=65000:16383:input-output",
+		},
+		{
+			name:   "non-transitive flowspec interface-set output",
+			input:  []byte{0x47, 0x02, 0x00, 0x00, 0x00, 0x01, 0x80, 0x01},
+			expect: "flowspec-interface-set=1:1:output",
+		},
+		{
+			name:   "flowspec traffic-action sample and terminal",
+			input:  []byte{0x80, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03},
+			expect: "flowspec-traffic-action=sample-terminal",
+		},
+		{
+			name:   "flowspec traffic-action without actions",
+			input:  []byte{0x80, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			expect: "flowspec-traffic-action=none",
+		},
+		{
+			name:   "flowspec traffic-marking",
+			input:  []byte{0x80, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2e},
+			expect: "flowspec-traffic-remarking=dscp:46",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -45,3 +90,98 @@ func TestExtendedCommunity(t *testing.T) {
 		})
 	}
 }
+
+func TestIPv6ExtendedCommunity(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		fail   bool
+		expect []string
+	}{
+		{
+			name: "route target and flowspec redirect to ipv6",
+			input: []byte{
+				0x00, 0x02, 0x20, 0x01, 0x0d, 0xb8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x64,
+				0x00, 0x0c, 0x20, 0x01, 0x0d, 0xb8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x01,
+			},
+			expect: []string{"rt=2001:db8::1:100", "fsr6=2001:db8::2:1"},
+		},
+		{
+			name:   "flowspec rt redirect ipv6",
+			input:  []byte{0x00, 0x0d, 0x20, 0x01, 0x0d, 0xb8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x01, 0x00},
+			expect: []string{"flowspec-redirect-ipv6=2001:db8::1:256"},
+		},
+		{
+			name:   "unknown non-transitive",
+			input:  []byte{0x40, 0x02, 0x20, 0x01, 0x0d, 0xb8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x01},
+			expect: []string{"unknown=IPv6 Type: 64 Subtype: 2 Value: [ 0x20, 0x01, 0x0D, 0xB8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x01 ]"},
+		},
+		{
+			name:  "invalid length",
+			input: []byte{0x00, 0x02, 0x20, 0x01, 0x0d, 0xb8, 0x00, 0x00},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exts, err := UnmarshalBGPIPv6ExtCommunity(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("failed but supposed to succeed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if len(exts) != len(tt.expect) {
+				t.Fatalf("expected %d communities but got %d", len(tt.expect), len(exts))
+			}
+			for i, ext := range exts {
+				if result := ext.String(); result != tt.expect[i] {
+					t.Errorf("Result %s does not match the expected community: %s", result, tt.expect[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGetRouterMAC(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		expect string
+	}{
+		{
+			name:   "router's mac",
+			input:  []byte{0x06, 0x03, 0x0c, 0x03, 0x00, 0x00, 0x1b, 0x08},
+			expect: "0c:03:00:00:1b:08",
+		},
+		{
+			name:  "mac mobility",
+			input: []byte{0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+		},
+		{
+			name:  "route target",
+			input: []byte{0x00, 0x02, 0x00, 0x64, 0x00, 0x00, 0x00, 0x64},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ext, err := makeExtCommunity(tt.input)
+			if err != nil {
+				t.Fatalf("with error: %+v", err)
+			}
+			mac := ext.GetRouterMAC()
+			if tt.expect == "" {
+				if mac != nil {
+					t.Errorf("expected no router's mac but got %s", mac)
+				}
+				return
+			}
+			if mac.String() != tt.expect {
+				t.Errorf("expected router's mac %s but got %s", tt.expect, mac)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/sbezverk/tools"
+)
+
+// IPv6ExtCommunity defines BGP IPv6 Address Specific Extended Community https://tools.ietf.org/html/rfc5701
+type IPv6ExtCommunity struct {
+	Type    uint8
+	SubType uint8
+	// Value carries 16 bytes of Global Administrator IPv6 address followed by 2 bytes of Local Administrator
+	Value []byte
+}
+
+func makeIPv6ExtCommunity(b []byte) (*IPv6ExtCommunity, error) {
+	if len(b) != 20 {
+		return nil, fmt.Errorf("invalid length expected 20 got %d", len(b))
+	}
+	ext := IPv6ExtCommunity{
+		Type:    b[0],
+		SubType: b[1],
+		Value:   make([]byte, 18),
+	}
+	copy(ext.Value, b[2:])
+
+	return &ext, nil
+}
+
+// UnmarshalBGPIPv6ExtCommunity builds a slice of IPv6 Address Specific Extended Communities
+func UnmarshalBGPIPv6ExtCommunity(b []byte) ([]IPv6ExtCommunity, error) {
+	if len(b)%20 != 0 {
+		return nil, fmt.Errorf("invalid length of IPv6 Address Specific Extended Community attribute %d", len(b))
+	}
+	exts := make([]IPv6ExtCommunity, 0)
+	for p := 0; p < len(b); p += 20 {
+		ext, err := makeIPv6ExtCommunity(b[p : p+20])
+		if err != nil {
+			return nil, err
+		}
+		exts = append(exts, *ext)
+	}
+
+	return exts, nil
+}
+
+// Transitive IPv6-Address-Specific Extended Community Sub-Types
+// 0x02	Route Target	[RFC5701]
+// 0x03	Route Origin	[RFC5701]
+// 0x0b	VRF Route Import	[RFC6515]
+// 0x0c	Flow-spec Redirect to IPv6	[draft-ietf-idr-flowspec-redirect-ip]
+// 0x0d	Flow-spec RT Redirect IPv6 Format	[RFC8956]
+// 0x10	Cisco VPN-Distinguisher	[Eric_Rosen]
+// 0x12	Inter-Area P2MP Segmented Next-Hop	[RFC7524]
+var transIPv6SubTypes = map[uint8]string{
+	0x2:  ECPRouteTarget,
+	0x3:  ECPRouteOrigin,
+	0x0b: ECPVRFRouteImport,
+	0x0c: ECPFlowSpecRedirIPv6,
+	0x0d: CPFlowspecRedirectIPv6,
+	0x10: ECPCiscoVPNDistinguisher,
+	0x12: ECPInterAreaP2MPSegmentedNexyHop,
+}
+
+func (ext *IPv6ExtCommunity) String() string {
+	// Only Transitive IPv6-Address-Specific Extended Community Sub-Types are registered
+	if s, ok := transIPv6SubTypes[ext.SubType]; ok && ext.Type == 0x00 {
+		return s + fmt.Sprintf("%s:%d", net.IP(ext.Value[0:16]).String(), binary.BigEndian.Uint16(ext.Value[16:]))
+	}
+
+	return fmt.Sprintf("unknown=IPv6 Type: %d Subtype: %d Value: %s", ext.Type, ext.SubType, tools.MessageHex(ext.Value))
+}
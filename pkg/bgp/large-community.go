@@ -30,9 +30,12 @@ func (lg *LgCommunity) String() string {
 	return fmt.Sprintf("%d:%d:%d", lg.GlobalAdmin, lg.LocalData1, lg.LocalData2)
 }
 
-// UnmarshalBGPLgCommunity builds a slice of Large Communities
+// UnmarshalBGPLgCommunity builds a slice of Large Communities, the length of the attribute must be a multiple of 12
 func UnmarshalBGPLgCommunity(b []byte) ([]LgCommunity, error) {
-	lgs := make([]LgCommunity, 0)
+	if len(b)%12 != 0 {
+		return nil, fmt.Errorf("invalid length %d of large communities attribute, not a multiple of 12", len(b))
+	}
+	lgs := make([]LgCommunity, 0, len(b)/12)
 	for p := 0; p < len(b); {
 		lg, err := makeLgCommunity(b[p : p+12])
 		if err != nil {
@@ -0,0 +1,59 @@
+package bgp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalBGPLgCommunity(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		expect []string
+		fail   bool
+	}{
+		{
+			name: "two large communities",
+			input: []byte{0x00, 0x00, 0x88, 0x38, 0x00, 0x00, 0x00, 0x0a, 0x00, 0x00, 0x00, 0xd3,
+				0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+			expect: []string{"34872:10:211", "4294967295:0:1"},
+		},
+		{
+			name:   "empty attribute",
+			input:  []byte{},
+			expect: []string{},
+		},
+		{
+			name:  "truncated large community",
+			input: []byte{0x00, 0x00, 0x88, 0x38, 0x00, 0x00, 0x00, 0x0a, 0x00, 0x00, 0x00, 0xd3, 0x00, 0x00},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lgs, err := UnmarshalBGPLgCommunity(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("expected to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("expected to fail but succeeded")
+			}
+			s := make([]string, 0, len(lgs))
+			for _, lg := range lgs {
+				s = append(s, lg.String())
+			}
+			if !reflect.DeepEqual(s, tt.expect) {
+				t.Errorf("expected large communities %v but got %v", tt.expect, s)
+			}
+			if l := unmarshalAttrLgCommunity(tt.input); len(l) != len(tt.expect) {
+				t.Errorf("expected %d large communities of base attributes but got %v", len(tt.expect), l)
+			}
+		})
+	}
+	if l := unmarshalAttrLgCommunity([]byte{0x00}); l != nil {
+		t.Errorf("expected malformed attribute to be dropped but got %v", l)
+	}
+}
@@ -1,23 +1,12 @@
 package bgp
 
-import (
-	"github.com/sbezverk/gobmp/pkg/base"
-	"github.com/sbezverk/gobmp/pkg/evpn"
-	"github.com/sbezverk/gobmp/pkg/flowspec"
-	"github.com/sbezverk/gobmp/pkg/ls"
-	"github.com/sbezverk/gobmp/pkg/srpolicy"
-)
-
 // MPNLRI defines a common interface methind for MP Reach and MP Unreach NLRIs
 type MPNLRI interface {
 	GetAFISAFIType() int
-	GetNLRILU() (*base.MPNLRI, error)
-	GetNLRIUnicast() (*base.MPNLRI, error)
-	GetNLRIEVPN() (*evpn.Route, error)
-	GetNLRIL3VPN() (*base.MPNLRI, error)
-	GetNLRI71() (*ls.NLRI71, error)
-	GetNLRI73() (*srpolicy.NLRI73, error)
-	GetFlowspecNLRI() (*flowspec.NLRI, error)
+	GetAFI() uint16
+	GetSAFI() uint8
+	// GetNLRI returns NLRI decoded by the codec registered for the address family
+	GetNLRI() (interface{}, error)
 	GetNextHop() string
 	IsIPv6NLRI() bool
 	IsNextHopIPv6() bool
@@ -72,6 +61,12 @@ func NLRIMessageType(afi uint16, safi uint8) int {
 		// AFI 2 and SAFI 134 FlowSpec VPNv6
 	case afi == 2 && safi == 134:
 		return 27
+		// AFI 1 and SAFI 5 MCAST-VPN IPv4
+	case afi == 1 && safi == 5:
+		return 28
+		// AFI 2 and SAFI 5 MCAST-VPN IPv6
+	case afi == 2 && safi == 5:
+		return 29
 	}
 
 	return 0
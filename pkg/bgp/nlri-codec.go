@@ -0,0 +1,242 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/sbezverk/gobmp/pkg/base"
+	"github.com/sbezverk/gobmp/pkg/evpn"
+	"github.com/sbezverk/gobmp/pkg/flowspec"
+	"github.com/sbezverk/gobmp/pkg/l3vpn"
+	"github.com/sbezverk/gobmp/pkg/ls"
+	"github.com/sbezverk/gobmp/pkg/mvpn"
+	"github.com/sbezverk/gobmp/pkg/srpolicy"
+	"github.com/sbezverk/gobmp/pkg/unicast"
+)
+
+// ErrEmptyNLRI is returned when MP_REACH_NLRI or MP_UNREACH_NLRI attribute carries no NLRI,
+// for example End-of-RIB marker of the address family
+var ErrEmptyNLRI = errors.New("NLRI length is 0")
+
+// NLRIOptions defines the state of the session and the update affecting NLRI encoding
+type NLRIOptions struct {
+	// PathID is true when ADD-PATH is negotiated for the address family and NLRI carry Path Identifier
+	PathID bool
+	// SRv6 is true when the update carries Prefix SID attribute, so labels of VPN NLRI carry SRv6 SID
+	SRv6 bool
+}
+
+// NLRICodec defines decoding and encoding of NLRI of an address family carried in MP_REACH_NLRI
+// and MP_UNREACH_NLRI attributes
+type NLRICodec interface {
+	// Unmarshal decodes NLRI, b is not empty
+	Unmarshal(b []byte, opts *NLRIOptions) (interface{}, error)
+	// Marshal encodes NLRI decoded by Unmarshal
+	Marshal(nlri interface{}, opts *NLRIOptions) ([]byte, error)
+}
+
+// nlriCodecs is the registry of NLRI codecs by AFI and SAFI
+var nlriCodecs = struct {
+	sync.RWMutex
+	codecs map[uint32]NLRICodec
+}{
+	codecs: make(map[uint32]NLRICodec),
+}
+
+func afiSAFIKey(afi uint16, safi uint8) uint32 {
+	return uint32(afi)<<8 | uint32(safi)
+}
+
+// RegisterNLRICodec adds the codec of NLRI of the address family to the registry, NLRI of address families without
+// a codec are not decoded.
+func RegisterNLRICodec(afi uint16, safi uint8, c NLRICodec) error {
+	nlriCodecs.Lock()
+	defer nlriCodecs.Unlock()
+	k := afiSAFIKey(afi, safi)
+	if _, ok := nlriCodecs.codecs[k]; ok {
+		return fmt.Errorf("codec of AFI %d SAFI %d is already registered", afi, safi)
+	}
+	nlriCodecs.codecs[k] = c
+
+	return nil
+}
+
+// LookupNLRICodec returns the codec of NLRI of the address family, second returned value is false
+// if no codec is registered for the address family.
+func LookupNLRICodec(afi uint16, safi uint8) (NLRICodec, bool) {
+	nlriCodecs.RLock()
+	defer nlriCodecs.RUnlock()
+	c, ok := nlriCodecs.codecs[afiSAFIKey(afi, safi)]
+
+	return c, ok
+}
+
+// decodeNLRI decodes NLRI of the address family by the registered codec
+func decodeNLRI(afi uint16, safi uint8, b []byte, opts *NLRIOptions) (interface{}, error) {
+	c, ok := LookupNLRICodec(afi, safi)
+	if !ok {
+		return nil, fmt.Errorf("no codec is registered for AFI %d SAFI %d", afi, safi)
+	}
+	if len(b) == 0 {
+		return nil, ErrEmptyNLRI
+	}
+
+	return c.Unmarshal(b, opts)
+}
+
+func init() {
+	builtin := []struct {
+		afi   uint16
+		safi  uint8
+		codec NLRICodec
+	}{
+		{1, 1, &routesCodec{}},
+		{2, 1, &routesCodec{}},
+		{1, 4, &routesCodec{labeled: true}},
+		{2, 4, &routesCodec{labeled: true}},
+		{1, 128, &routesCodec{labeled: true, vpn: true}},
+		{2, 128, &routesCodec{labeled: true, vpn: true}},
+		{25, 70, &evpnCodec{}},
+		{16388, 71, &lsCodec{}},
+		{1, 73, &srPolicyCodec{}},
+		{2, 73, &srPolicyCodec{}},
+		{1, 133, &flowspecCodec{}},
+		{2, 133, &flowspecCodec{ipv6: true}},
+		{1, 134, &flowspecCodec{vpn: true}},
+		{2, 134, &flowspecCodec{ipv6: true, vpn: true}},
+		{1, 5, &mvpnCodec{}},
+		{2, 5, &mvpnCodec{}},
+	}
+	for _, b := range builtin {
+		if err := RegisterNLRICodec(b.afi, b.safi, b.codec); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// routesCodec is the codec of unicast, labeled unicast (RFC 8277) and MPLS VPN (RFC 4364) NLRI,
+// NLRI are decoded into *base.MPNLRI
+type routesCodec struct {
+	labeled bool
+	vpn     bool
+}
+
+func (c *routesCodec) Unmarshal(b []byte, opts *NLRIOptions) (interface{}, error) {
+	switch {
+	case c.vpn:
+		return l3vpn.UnmarshalL3VPNNLRI(b, opts.PathID, opts.SRv6)
+	case c.labeled:
+		return unicast.UnmarshalLUNLRI(b, opts.PathID)
+	}
+
+	return unicast.UnmarshalUnicastNLRI(b, opts.PathID)
+}
+
+// Marshal encodes routes, routes without labels are encoded with the compatibility field of withdrawn
+// labeled routes, RFC 8277. Prefix lengths of labeled routes are encoded as decoded, rounded to bytes.
+func (c *routesCodec) Marshal(nlri interface{}, opts *NLRIOptions) ([]byte, error) {
+	mp, ok := nlri.(*base.MPNLRI)
+	if !ok {
+		return nil, fmt.Errorf("invalid NLRI type %T", nlri)
+	}
+	if c.vpn && opts.SRv6 {
+		return nil, fmt.Errorf("marshaling of VPN NLRI with SRv6 SID is not supported")
+	}
+	b := make([]byte, 0)
+	for _, r := range mp.NLRI {
+		if opts.PathID {
+			b = binary.BigEndian.AppendUint32(b, r.PathID)
+		}
+		l := int(r.Length)
+		var labels []byte
+		if c.labeled {
+			if len(r.Label) == 0 {
+				labels = []byte{0x80, 0x00, 0x00}
+			}
+			for _, lbl := range r.Label {
+				v := lbl.Value<<4 | uint32(lbl.Exp&0x07)<<1
+				if lbl.BoS {
+					v |= 1
+				}
+				labels = append(labels, byte(v>>16), byte(v>>8), byte(v))
+			}
+		}
+		if c.vpn {
+			if r.RD == nil || len(r.RD.Value) != 6 {
+				return nil, fmt.Errorf("vpn route has invalid route distinguisher")
+			}
+			labels = binary.BigEndian.AppendUint16(labels, r.RD.Type)
+			labels = append(labels, r.RD.Value...)
+		}
+		l += len(labels) * 8
+		if l > 255 || (int(r.Length)+7)/8 > len(r.Prefix) {
+			return nil, fmt.Errorf("invalid route length %d", r.Length)
+		}
+		b = append(b, byte(l))
+		b = append(b, labels...)
+		b = append(b, r.Prefix[:(int(r.Length)+7)/8]...)
+	}
+
+	return b, nil
+}
+
+// evpnCodec is the codec of EVPN NLRI (RFC 7432), NLRI are decoded into *evpn.Route
+type evpnCodec struct{}
+
+func (c *evpnCodec) Unmarshal(b []byte, _ *NLRIOptions) (interface{}, error) {
+	return evpn.UnmarshalEVPNNLRI(b)
+}
+
+func (c *evpnCodec) Marshal(interface{}, *NLRIOptions) ([]byte, error) {
+	return nil, fmt.Errorf("marshaling of EVPN NLRI is not supported")
+}
+
+// lsCodec is the codec of BGP-LS NLRI (RFC 7752), NLRI are decoded into *ls.NLRI71
+type lsCodec struct{}
+
+func (c *lsCodec) Unmarshal(b []byte, _ *NLRIOptions) (interface{}, error) {
+	return ls.UnmarshalLSNLRI71(b)
+}
+
+func (c *lsCodec) Marshal(interface{}, *NLRIOptions) ([]byte, error) {
+	return nil, fmt.Errorf("marshaling of BGP-LS NLRI is not supported")
+}
+
+// srPolicyCodec is the codec of SR Policy NLRI, SAFI 73, NLRI are decoded into *srpolicy.NLRI73
+type srPolicyCodec struct{}
+
+func (c *srPolicyCodec) Unmarshal(b []byte, _ *NLRIOptions) (interface{}, error) {
+	return srpolicy.UnmarshalLSNLRI73(b)
+}
+
+func (c *srPolicyCodec) Marshal(interface{}, *NLRIOptions) ([]byte, error) {
+	return nil, fmt.Errorf("marshaling of SR Policy NLRI is not supported")
+}
+
+// flowspecCodec is the codec of Flow Specification (RFC 8955, RFC 8956) NLRI, SAFI 133, and Flow Specification
+// VPN NLRI, SAFI 134, NLRI are decoded into []*flowspec.NLRI
+type flowspecCodec struct {
+	ipv6 bool
+	vpn  bool
+}
+
+func (c *flowspecCodec) Unmarshal(b []byte, _ *NLRIOptions) (interface{}, error) {
+	return flowspec.UnmarshalFlowspecNLRIs(b, c.ipv6, c.vpn)
+}
+
+func (c *flowspecCodec) Marshal(interface{}, *NLRIOptions) ([]byte, error) {
+	return nil, fmt.Errorf("marshaling of Flowspec NLRI is not supported")
+}
+
+// mvpnCodec is the codec of MCAST-VPN NLRI (RFC 6514, RFC 6515), SAFI 5, NLRI are decoded into []*mvpn.Route
+type mvpnCodec struct{}
+
+func (c *mvpnCodec) Unmarshal(b []byte, opts *NLRIOptions) (interface{}, error) {
+	return mvpn.UnmarshalMVPNNLRI(b, opts.PathID)
+}
+
+func (c *mvpnCodec) Marshal(interface{}, *NLRIOptions) ([]byte, error) {
+	return nil, fmt.Errorf("marshaling of MCAST-VPN NLRI is not supported")
+}
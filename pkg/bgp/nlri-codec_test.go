@@ -0,0 +1,81 @@
+package bgp
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/base"
+)
+
+func TestRoutesCodec(t *testing.T) {
+	tests := []struct {
+		name  string
+		afi   uint16
+		safi  uint8
+		input []byte
+		opts  *NLRIOptions
+	}{
+		{
+			name:  "ipv4 unicast",
+			afi:   1,
+			safi:  1,
+			input: []byte{0x18, 0x0a, 0x00, 0x00, 0x20, 0x01, 0x01, 0x01, 0x01},
+			opts:  &NLRIOptions{},
+		},
+		{
+			name:  "ipv6 unicast with path id",
+			afi:   2,
+			safi:  1,
+			input: []byte{0x00, 0x00, 0x00, 0x01, 0x10, 0x20, 0x01},
+			opts:  &NLRIOptions{PathID: true},
+		},
+		{
+			name:  "ipv4 labeled unicast",
+			afi:   1,
+			safi:  4,
+			input: []byte{0x30, 0x00, 0x06, 0x41, 0x0a, 0x00, 0x00},
+			opts:  &NLRIOptions{},
+		},
+		{
+			name:  "ipv4 vpn",
+			afi:   1,
+			safi:  128,
+			input: []byte{0x70, 0x13, 0x88, 0x11, 0x00, 0x01, 0x01, 0x01, 0x0a, 0x01, 0x00, 0x01, 0x01, 0x64, 0x01},
+			opts:  &NLRIOptions{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, ok := LookupNLRICodec(tt.afi, tt.safi)
+			if !ok {
+				t.Fatalf("no codec for AFI %d SAFI %d", tt.afi, tt.safi)
+			}
+			nlri, err := c.Unmarshal(tt.input, tt.opts)
+			if err != nil {
+				t.Fatalf("failed to unmarshal NLRI with error: %+v", err)
+			}
+			if _, ok := nlri.(*base.MPNLRI); !ok {
+				t.Fatalf("expected *base.MPNLRI but got %T", nlri)
+			}
+			b, err := c.Marshal(nlri, tt.opts)
+			if err != nil {
+				t.Fatalf("failed to marshal NLRI with error: %+v", err)
+			}
+			if !bytes.Equal(b, tt.input) {
+				t.Errorf("expected %x but got %x", tt.input, b)
+			}
+		})
+	}
+}
+
+func TestRegisterNLRICodec(t *testing.T) {
+	if err := RegisterNLRICodec(1, 1, &routesCodec{}); err == nil {
+		t.Errorf("expected error registering duplicate codec")
+	}
+	if _, err := decodeNLRI(1, 1, nil, &NLRIOptions{}); err != ErrEmptyNLRI {
+		t.Errorf("expected ErrEmptyNLRI but got %+v", err)
+	}
+	if _, err := decodeNLRI(1, 85, []byte{0x00}, &NLRIOptions{}); err == nil {
+		t.Errorf("expected error decoding NLRI without codec")
+	}
+}
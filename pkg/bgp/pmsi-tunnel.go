@@ -0,0 +1,191 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/tools"
+)
+
+// PMSITunnelAttributeType defines the type of PMSI Tunnel path attribute, RFC 6514
+const PMSITunnelAttributeType = 22
+
+// PMSI tunnel types, RFC 6514 section 5, RFC 7524 and RFC 8556
+const (
+	PMSITunnelNone               = 0
+	PMSITunnelRSVPTEP2MP         = 1
+	PMSITunnelMLDPP2MP           = 2
+	PMSITunnelPIMSSM             = 3
+	PMSITunnelPIMSM              = 4
+	PMSITunnelBIDIRPIM           = 5
+	PMSITunnelIngressReplication = 6
+	PMSITunnelMLDPMP2MP          = 7
+	PMSITunnelTransport          = 8
+	PMSITunnelBIER               = 11
+)
+
+// PMSITunnelFlagLeafInfoRequired is Leaf Information Required flag of PMSI Tunnel attribute
+const PMSITunnelFlagLeafInfoRequired = 0x01
+
+var pmsiTunnelTypeNames = map[uint8]string{
+	PMSITunnelNone:               "no_tunnel_info",
+	PMSITunnelRSVPTEP2MP:         "rsvp_te_p2mp",
+	PMSITunnelMLDPP2MP:           "mldp_p2mp",
+	PMSITunnelPIMSSM:             "pim_ssm",
+	PMSITunnelPIMSM:              "pim_sm",
+	PMSITunnelBIDIRPIM:           "bidir_pim",
+	PMSITunnelIngressReplication: "ingress_replication",
+	PMSITunnelMLDPMP2MP:          "mldp_mp2mp",
+	PMSITunnelTransport:          "transport_tunnel",
+	PMSITunnelBIER:               "bier",
+}
+
+// PMSITunnelTypeName returns the name of PMSI tunnel type
+func PMSITunnelTypeName(t uint8) string {
+	if n, ok := pmsiTunnelTypeNames[t]; ok {
+		return n
+	}
+
+	return fmt.Sprintf("unknown(%d)", t)
+}
+
+// PMSITunnel defines PMSI Tunnel attribute, the tunnel identifier is decoded into fields of the tunnel type,
+// fields not carried by the tunnel type are not set
+type PMSITunnel struct {
+	Flags            uint8  `json:"flags"`
+	LeafInfoRequired bool   `json:"leaf_info_required,omitempty"`
+	TunnelType       uint8  `json:"tunnel_type"`
+	TunnelTypeName   string `json:"tunnel_type_name"`
+	// Label is the high-order 20 bits of MPLS Label field, VNI of VXLAN tunnels of EVPN is the whole 24 bits
+	// field, RFC 8365
+	Label uint32 `json:"label,omitempty"`
+	VNI   uint32 `json:"vni,omitempty"`
+	// TunnelID is hex of the tunnel identifier as carried by the attribute
+	TunnelID string `json:"tunnel_id,omitempty"`
+	// Endpoint is the unicast tunnel endpoint of Ingress Replication
+	Endpoint string `json:"tunnel_endpoint,omitempty"`
+	// Sender and PGroup are Sender Address and P-Multicast Group of PIM trees
+	Sender string `json:"sender,omitempty"`
+	PGroup string `json:"p_group,omitempty"`
+	// RootNode and Opaque are Root Node Address and hex of Opaque Value of mLDP FEC element
+	RootNode string `json:"root_node,omitempty"`
+	Opaque   string `json:"opaque,omitempty"`
+	// ExtendedTunnelID, RSVPTunnelID and P2MPID are the identifiers of RSVP-TE P2MP LSP SESSION object
+	ExtendedTunnelID string `json:"extended_tunnel_id,omitempty"`
+	RSVPTunnelID     uint16 `json:"rsvp_tunnel_id,omitempty"`
+	P2MPID           uint32 `json:"p2mp_id,omitempty"`
+	// SourcePE and LocalNumber identify Transport Tunnel, RFC 7524
+	SourcePE    string `json:"source_pe,omitempty"`
+	LocalNumber string `json:"local_number,omitempty"`
+	// SubDomainID, BFRID and BFRPrefix identify BIER tunnel, RFC 8556
+	SubDomainID uint8  `json:"sub_domain_id,omitempty"`
+	BFRID       uint16 `json:"bfr_id,omitempty"`
+	BFRPrefix   string `json:"bfr_prefix,omitempty"`
+}
+
+// pmsiAddress returns IPv4 or IPv6 address of b, false is returned for the length of other addresses
+func pmsiAddress(b []byte) (string, bool) {
+	if len(b) != net.IPv4len && len(b) != net.IPv6len {
+		return "", false
+	}
+
+	return net.IP(b).String(), true
+}
+
+// unmarshalTunnelID decodes the tunnel identifier of the tunnel type into fields of the attribute
+func (t *PMSITunnel) unmarshalTunnelID(b []byte) error {
+	var ok bool
+	switch t.TunnelType {
+	case PMSITunnelNone:
+		return nil
+	case PMSITunnelIngressReplication:
+		t.Endpoint, ok = pmsiAddress(b)
+	case PMSITunnelPIMSSM, PMSITunnelPIMSM, PMSITunnelBIDIRPIM:
+		if n := len(b) / 2; len(b)%2 == 0 {
+			if t.Sender, ok = pmsiAddress(b[:n]); ok {
+				t.PGroup, ok = pmsiAddress(b[n:])
+			}
+		}
+	case PMSITunnelRSVPTEP2MP:
+		// Extended Tunnel ID, 2 bytes reserved, Tunnel ID and P2MP ID
+		if len(b) == 12 {
+			t.ExtendedTunnelID, ok = pmsiAddress(b[:4])
+			t.RSVPTunnelID = binary.BigEndian.Uint16(b[6:8])
+			t.P2MPID = binary.BigEndian.Uint32(b[8:12])
+		}
+	case PMSITunnelMLDPP2MP, PMSITunnelMLDPMP2MP:
+		// FEC element type, address family, address length, root node address, opaque length and opaque value
+		if len(b) < 4 || len(b) < 4+int(b[3])+2 {
+			break
+		}
+		p := 4 + int(b[3])
+		if t.RootNode, ok = pmsiAddress(b[4:p]); !ok {
+			break
+		}
+		l := int(binary.BigEndian.Uint16(b[p : p+2]))
+		if ok = p+2+l == len(b); ok {
+			t.Opaque = hex.EncodeToString(b[p+2:])
+		}
+	case PMSITunnelTransport:
+		// Source PE address followed by 4 bytes of Local Number
+		if len(b) == net.IPv4len+4 || len(b) == net.IPv6len+4 {
+			t.SourcePE, ok = pmsiAddress(b[:len(b)-4])
+			t.LocalNumber = hex.EncodeToString(b[len(b)-4:])
+		}
+	case PMSITunnelBIER:
+		if len(b) > 3 {
+			t.SubDomainID = b[0]
+			t.BFRID = binary.BigEndian.Uint16(b[1:3])
+			t.BFRPrefix, ok = pmsiAddress(b[3:])
+		}
+	default:
+		// Identifiers of other tunnel types are carried as hex only
+		return nil
+	}
+	if !ok {
+		return fmt.Errorf("invalid length %d of %s tunnel identifier", len(b), PMSITunnelTypeName(t.TunnelType))
+	}
+
+	return nil
+}
+
+// UnmarshalPMSITunnel instantiates PMSI Tunnel attribute from a slice of bytes
+func UnmarshalPMSITunnel(b []byte) (*PMSITunnel, error) {
+	if glog.V(6) {
+		glog.Infof("PMSI Tunnel Attribute Raw: %s", tools.MessageHex(b))
+	}
+	if len(b) < 5 {
+		return nil, fmt.Errorf("invalid length %d of pmsi tunnel attribute", len(b))
+	}
+	l := uint32(b[2])<<16 | uint32(b[3])<<8 | uint32(b[4])
+	t := &PMSITunnel{
+		Flags:            b[0],
+		LeafInfoRequired: b[0]&PMSITunnelFlagLeafInfoRequired != 0,
+		TunnelType:       b[1],
+		TunnelTypeName:   PMSITunnelTypeName(b[1]),
+		Label:            l >> 4,
+		VNI:              l,
+	}
+	if len(b) > 5 {
+		t.TunnelID = hex.EncodeToString(b[5:])
+	}
+	if err := t.unmarshalTunnelID(b[5:]); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// GetAttrPMSITunnel check for presense of BGP Attribute PMSI Tunnel (22) and instantiates it
+func (up *Update) GetAttrPMSITunnel() (*PMSITunnel, error) {
+	for _, attr := range up.PathAttributes {
+		if attr.AttributeType == PMSITunnelAttributeType {
+			return UnmarshalPMSITunnel(attr.Attribute)
+		}
+	}
+	// TODO return new type of errors to be able to check for the code
+	return nil, fmt.Errorf("not found")
+}
@@ -0,0 +1,128 @@
+package bgp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalPMSITunnel(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		expect *PMSITunnel
+		fail   bool
+	}{
+		{
+			name:  "ingress replication vxlan",
+			input: []byte{0x00, 0x06, 0x00, 0x27, 0x10, 192, 0, 2, 1},
+			expect: &PMSITunnel{
+				TunnelType:     PMSITunnelIngressReplication,
+				TunnelTypeName: "ingress_replication",
+				Label:          0x271,
+				VNI:            10000,
+				TunnelID:       "c0000201",
+				Endpoint:       "192.0.2.1",
+			},
+		},
+		{
+			name:  "pim-ssm tree with leaf information required",
+			input: []byte{0x01, 0x03, 0x00, 0x00, 0x00, 192, 0, 2, 1, 232, 1, 1, 1},
+			expect: &PMSITunnel{
+				Flags:            0x01,
+				LeafInfoRequired: true,
+				TunnelType:       PMSITunnelPIMSSM,
+				TunnelTypeName:   "pim_ssm",
+				TunnelID:         "c0000201e8010101",
+				Sender:           "192.0.2.1",
+				PGroup:           "232.1.1.1",
+			},
+		},
+		{
+			name:  "rsvp-te p2mp lsp",
+			input: []byte{0x00, 0x01, 0x00, 0x3e, 0x81, 192, 0, 2, 1, 0, 0, 0, 10, 0, 0, 0, 20},
+			expect: &PMSITunnel{
+				TunnelType:       PMSITunnelRSVPTEP2MP,
+				TunnelTypeName:   "rsvp_te_p2mp",
+				Label:            1000,
+				VNI:              0x3e81,
+				TunnelID:         "c00002010000000a00000014",
+				ExtendedTunnelID: "192.0.2.1",
+				RSVPTunnelID:     10,
+				P2MPID:           20,
+			},
+		},
+		{
+			name:  "mldp p2mp lsp",
+			input: []byte{0x00, 0x02, 0x00, 0x00, 0x00, 0x06, 0x00, 0x01, 0x04, 192, 0, 2, 1, 0x00, 0x03, 0x01, 0x00, 0x01},
+			expect: &PMSITunnel{
+				TunnelType:     PMSITunnelMLDPP2MP,
+				TunnelTypeName: "mldp_p2mp",
+				TunnelID:       "06000104c00002010003010001",
+				RootNode:       "192.0.2.1",
+				Opaque:         "010001",
+			},
+		},
+		{
+			name:  "bier",
+			input: []byte{0x00, 0x0b, 0x00, 0x00, 0x00, 0x01, 0x00, 0x05, 192, 0, 2, 1},
+			expect: &PMSITunnel{
+				TunnelType:     PMSITunnelBIER,
+				TunnelTypeName: "bier",
+				TunnelID:       "010005c0000201",
+				SubDomainID:    1,
+				BFRID:          5,
+				BFRPrefix:      "192.0.2.1",
+			},
+		},
+		{
+			name:  "no tunnel information",
+			input: []byte{0x01, 0x00, 0x00, 0x00, 0x00},
+			expect: &PMSITunnel{
+				Flags:            0x01,
+				LeafInfoRequired: true,
+				TunnelTypeName:   "no_tunnel_info",
+			},
+		},
+		{
+			name:  "unknown tunnel type",
+			input: []byte{0x00, 0x0c, 0x00, 0x00, 0x00, 0xab},
+			expect: &PMSITunnel{
+				TunnelType:     12,
+				TunnelTypeName: "unknown(12)",
+				TunnelID:       "ab",
+			},
+		},
+		{
+			name:  "truncated attribute",
+			input: []byte{0x00, 0x06, 0x00, 0x00},
+			fail:  true,
+		},
+		{
+			name:  "invalid ingress replication endpoint",
+			input: []byte{0x00, 0x06, 0x00, 0x00, 0x00, 192, 0, 2},
+			fail:  true,
+		},
+		{
+			name:  "invalid mldp opaque length",
+			input: []byte{0x00, 0x02, 0x00, 0x00, 0x00, 0x06, 0x00, 0x01, 0x04, 192, 0, 2, 1, 0x00, 0x05, 0x01},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshalPMSITunnel(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("supposed to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("expected pmsi tunnel %+v but got %+v", *tt.expect, *got)
+			}
+		})
+	}
+}
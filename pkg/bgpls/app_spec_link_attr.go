@@ -1,6 +1,7 @@
 package bgpls
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"github.com/golang/glog"
@@ -8,15 +9,35 @@ import (
 	"github.com/sbezverk/tools"
 )
 
-// https://tools.ietf.org/html/draft-ietf-idr-bgp-ls-app-specific-attr-03#section-2
+// https://www.rfc-editor.org/rfc/rfc9294.html#section-2
 
-// AppSpecLinkAttr defines a structure of Application Specific Link attributes
+// Standard Application Identifier bits of Link Attribute Application Identifiers registry, RFC 8919
+var standardApplications = []string{"rsvp_te", "sr_policy", "lfa", "flex_algo"}
+
+// AppSpecLinkAttr defines a structure of Application Specific Link attributes, link attribute sub-TLVs are
+// decoded into the attributes of the applications, sub-TLVs of other types are carried as sub_tlvs
 type AppSpecLinkAttr struct {
 	SAIBMLen  uint8          `json:"saibm_length"`
 	UDAIBMLen uint8          `json:"udaibm_length"`
 	SAIBM     []byte         `json:"std_app_id_bit_mask,omitempty"`
 	UDAIBM    []byte         `json:"ud_app_id_bit_mask,omitempty"`
 	SubTLV    []*base.SubTLV `json:"sub_tlvs,omitempty"`
+	// Applications are names of standard applications of SAIBM, UserDefinedApplications are bits set in UDAIBM,
+	// the attributes apply to all applications when neither bit mask is carried
+	Applications            []string `json:"applications,omitempty"`
+	UserDefinedApplications []int    `json:"user_defined_applications,omitempty"`
+	AllApplications         bool     `json:"all_applications,omitempty"`
+	AdminGroup              uint32   `json:"admin_group,omitempty"`
+	ExtendedAdminGroup      []uint32 `json:"extended_admin_group,omitempty"`
+	TEDefaultMetric         uint32   `json:"te_default_metric,omitempty"`
+	SRLG                    []uint32 `json:"srlg,omitempty"`
+	UnidirLinkDelay         uint32   `json:"unidir_link_delay,omitempty"`
+	UnidirLinkDelayMinMax   []uint32 `json:"unidir_link_delay_min_max,omitempty"`
+	UnidirDelayVariation    uint32   `json:"unidir_delay_variation,omitempty"`
+	UnidirPacketLoss        uint32   `json:"unidir_packet_loss,omitempty"`
+	UnidirResidualBW        uint32   `json:"unidir_residual_bw,omitempty"`
+	UnidirAvailableBW       uint32   `json:"unidir_available_bw,omitempty"`
+	UnidirBWUtilization     uint32   `json:"unidir_bw_utilization,omitempty"`
 }
 
 // UnmarshalAppSpecLinkAttr builds Application Specific Link Attributes object
@@ -25,7 +46,7 @@ func UnmarshalAppSpecLinkAttr(b []byte) (*AppSpecLinkAttr, error) {
 		glog.Infof("App SpecLink Attr Raw: %s", tools.MessageHex(b))
 	}
 	if len(b) < 4 {
-		return nil, fmt.Errorf("invalid length %d of FlexAlgo definition tlv", len(b))
+		return nil, fmt.Errorf("invalid length %d of application specific link attributes tlv", len(b))
 	}
 	asla := AppSpecLinkAttr{
 		SubTLV: make([]*base.SubTLV, 0),
@@ -43,32 +64,117 @@ func UnmarshalAppSpecLinkAttr(b []byte) (*AppSpecLinkAttr, error) {
 	p++
 	// Skip reserved bytes
 	p += 2
-	// Since SAIBM is optional copy only if it exists
-	if p+int(asla.SAIBMLen) > len(b) {
-		return &asla, nil
-	}
-	asla.SAIBM = make([]byte, asla.SAIBMLen)
-	copy(asla.SAIBM, b[p:p+int(asla.SAIBMLen)])
-	p += int(asla.SAIBMLen)
-	// Since UDAIBM is optional copy only if it exists
-	if p+int(asla.UDAIBMLen) > len(b) {
-		return &asla, nil
-	}
-	asla.UDAIBM = make([]byte, asla.UDAIBMLen)
-	copy(asla.UDAIBM, b[p:p+int(asla.UDAIBMLen)])
-	p += int(asla.UDAIBMLen)
-
+	if p+int(asla.SAIBMLen)+int(asla.UDAIBMLen) > len(b) {
+		return nil, fmt.Errorf("application identifier bit masks of length %d and %d exceed application specific link attributes tlv of length %d",
+			asla.SAIBMLen, asla.UDAIBMLen, len(b))
+	}
+	if asla.SAIBMLen != 0 {
+		asla.SAIBM = make([]byte, asla.SAIBMLen)
+		copy(asla.SAIBM, b[p:p+int(asla.SAIBMLen)])
+		p += int(asla.SAIBMLen)
+	}
+	if asla.UDAIBMLen != 0 {
+		asla.UDAIBM = make([]byte, asla.UDAIBMLen)
+		copy(asla.UDAIBM, b[p:p+int(asla.UDAIBMLen)])
+		p += int(asla.UDAIBMLen)
+	}
+	for i := 0; i < 8*len(asla.SAIBM); i++ {
+		if asla.SAIBM[i/8]&(0x80>>(i%8)) == 0 {
+			continue
+		}
+		if i < len(standardApplications) {
+			asla.Applications = append(asla.Applications, standardApplications[i])
+		} else {
+			asla.Applications = append(asla.Applications, fmt.Sprintf("unknown(%d)", i))
+		}
+	}
+	for i := 0; i < 8*len(asla.UDAIBM); i++ {
+		if asla.UDAIBM[i/8]&(0x80>>(i%8)) != 0 {
+			asla.UserDefinedApplications = append(asla.UserDefinedApplications, i)
+		}
+	}
+	asla.AllApplications = asla.SAIBMLen == 0 && asla.UDAIBMLen == 0
 	if p < len(b) {
 		sstlvs, err := base.UnmarshalSubTLV(b[p:])
 		if err != nil {
 			return nil, err
 		}
-		asla.SubTLV = sstlvs
+		for _, stlv := range sstlvs {
+			ok, err := asla.unmarshalLinkAttr(stlv)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				asla.SubTLV = append(asla.SubTLV, stlv)
+			}
+		}
 	}
 
 	return &asla, nil
 }
 
+// unmarshalLinkAttr decodes link attribute sub-TLV into the attributes of the applications, false is returned for
+// types of sub-TLVs not decoded
+func (asla *AppSpecLinkAttr) unmarshalLinkAttr(stlv *base.SubTLV) (bool, error) {
+	var v *uint32
+	switch stlv.Type {
+	case 1088:
+		v = &asla.AdminGroup
+	case 1092:
+		v = &asla.TEDefaultMetric
+	case 1114:
+		v = &asla.UnidirLinkDelay
+	case 1116:
+		v = &asla.UnidirDelayVariation
+	case 1117:
+		v = &asla.UnidirPacketLoss
+	case 1118:
+		v = &asla.UnidirResidualBW
+	case 1119:
+		v = &asla.UnidirAvailableBW
+	case 1120:
+		v = &asla.UnidirBWUtilization
+	case 1096, 1115, 1173:
+		l, err := uint32List(stlv)
+		if err != nil {
+			return false, err
+		}
+		switch stlv.Type {
+		case 1096:
+			asla.SRLG = l
+		case 1115:
+			if len(l) != 2 {
+				return false, fmt.Errorf("invalid length %d of min/max unidirectional link delay sub tlv", len(stlv.Value))
+			}
+			asla.UnidirLinkDelayMinMax = l
+		case 1173:
+			asla.ExtendedAdminGroup = l
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+	if len(stlv.Value) != 4 {
+		return false, fmt.Errorf("invalid length %d of link attribute sub tlv %d", len(stlv.Value), stlv.Type)
+	}
+	*v = binary.BigEndian.Uint32(stlv.Value)
+
+	return true, nil
+}
+
+// uint32List returns a list of 4 bytes values of sub-TLV
+func uint32List(stlv *base.SubTLV) ([]uint32, error) {
+	if len(stlv.Value)%4 != 0 {
+		return nil, fmt.Errorf("invalid length %d of link attribute sub tlv %d", len(stlv.Value), stlv.Type)
+	}
+	l := make([]uint32, 0, len(stlv.Value)/4)
+	for p := 0; p < len(stlv.Value); p += 4 {
+		l = append(l, binary.BigEndian.Uint32(stlv.Value[p:p+4]))
+	}
+
+	return l, nil
+}
+
 func checkBML(b byte) error {
 	switch b {
 	case 0:
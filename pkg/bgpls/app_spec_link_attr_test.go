@@ -0,0 +1,97 @@
+package bgpls
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/base"
+)
+
+func TestUnmarshalAppSpecLinkAttr(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		expect *AppSpecLinkAttr
+		fail   bool
+	}{
+		{
+			name: "flex algo and sr policy attributes",
+			input: []byte{
+				0x04, 0x00, 0x00, 0x00, 0x50, 0x00, 0x00, 0x00,
+				// TE Default Metric
+				0x04, 0x44, 0x00, 0x04, 0x00, 0x00, 0x00, 0x0a,
+				// Min/Max Unidirectional Link Delay
+				0x04, 0x5b, 0x00, 0x08, 0x00, 0x00, 0x03, 0xe8, 0x00, 0x00, 0x07, 0xd0,
+				// Extended Administrative Group
+				0x04, 0x95, 0x00, 0x08, 0x00, 0x00, 0x00, 0x01, 0x80, 0x00, 0x00, 0x00,
+				// Unknown sub tlv
+				0x04, 0x63, 0x00, 0x01, 0xff,
+			},
+			expect: &AppSpecLinkAttr{
+				SAIBMLen:              4,
+				SAIBM:                 []byte{0x50, 0x00, 0x00, 0x00},
+				SubTLV:                []*base.SubTLV{{Type: 1123, Length: 1, Value: []byte{0xff}}},
+				Applications:          []string{"sr_policy", "flex_algo"},
+				TEDefaultMetric:       10,
+				UnidirLinkDelayMinMax: []uint32{1000, 2000},
+				ExtendedAdminGroup:    []uint32{1, 0x80000000},
+			},
+		},
+		{
+			name: "all applications with user defined bit mask",
+			input: []byte{
+				0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+				// SRLG
+				0x04, 0x48, 0x00, 0x08, 0x00, 0x00, 0x00, 0x64, 0x00, 0x00, 0x00, 0xc8,
+			},
+			expect: &AppSpecLinkAttr{
+				UDAIBMLen:               4,
+				UDAIBM:                  []byte{0x00, 0x00, 0x00, 0x01},
+				SubTLV:                  []*base.SubTLV{},
+				UserDefinedApplications: []int{31},
+				SRLG:                    []uint32{100, 200},
+			},
+		},
+		{
+			name:  "no bit masks",
+			input: []byte{0x00, 0x00, 0x00, 0x00, 0x04, 0x40, 0x00, 0x04, 0x00, 0x00, 0x00, 0x02},
+			expect: &AppSpecLinkAttr{
+				SubTLV:          []*base.SubTLV{},
+				AllApplications: true,
+				AdminGroup:      2,
+			},
+		},
+		{
+			name:  "invalid bit mask length",
+			input: []byte{0x02, 0x00, 0x00, 0x00, 0x80, 0x00},
+			fail:  true,
+		},
+		{
+			name:  "truncated bit mask",
+			input: []byte{0x04, 0x00, 0x00, 0x00, 0x80, 0x00},
+			fail:  true,
+		},
+		{
+			name:  "invalid length of link attribute",
+			input: []byte{0x00, 0x00, 0x00, 0x00, 0x04, 0x44, 0x00, 0x02, 0x00, 0x0a},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := UnmarshalAppSpecLinkAttr(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("supposed to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if !reflect.DeepEqual(result, tt.expect) {
+				t.Errorf("expected %+v and resulted %+v application specific link attributes do not match", *tt.expect, *result)
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/golang/glog"
 	"github.com/sbezverk/gobmp/pkg/base"
@@ -81,11 +84,24 @@ func (ls *NLRI) GetNodeName() string {
 		if tlv.Type != 1026 {
 			continue
 		}
-		return string(tlv.Value)
+		return normalizeNodeName(tlv.Value)
 	}
 	return ""
 }
 
+// normalizeNodeName drops non-printable characters and surrounding white spaces from Node Name,
+// some implementations pad or terminate the name with NUL characters.
+func normalizeNodeName(b []byte) string {
+	s := strings.Map(func(r rune) rune {
+		if r == utf8.RuneError || !unicode.IsPrint(r) {
+			return -1
+		}
+		return r
+	}, string(b))
+
+	return strings.TrimSpace(s)
+}
+
 // GetISISAreaID returns a string IS-IS Area Identifier TLVs
 func (ls *NLRI) GetISISAreaID() string {
 	var s string
@@ -345,6 +361,22 @@ func (ls *NLRI) GetLSSRv6ENDXSID() ([]*srv6.EndXSIDTLV, error) {
 	return endxs, nil
 }
 
+// GetLSSRv6LANENDXSID returns IS-IS and OSPFv3 SRv6 LAN END.X SID TLVs
+func (ls *NLRI) GetLSSRv6LANENDXSID() ([]*srv6.LANEndXSIDTLV, error) {
+	endxs := make([]*srv6.LANEndXSIDTLV, 0)
+	for _, tlv := range ls.LS {
+		if tlv.Type != srv6.ISISLANEndXSIDTLVType && tlv.Type != srv6.OSPFv3LANEndXSIDTLVType {
+			continue
+		}
+		endx, err := srv6.UnmarshalSRv6LANEndXSIDTLV(tlv.Type, tlv.Value)
+		if err != nil {
+			return nil, err
+		}
+		endxs = append(endxs, endx)
+	}
+	return endxs, nil
+}
+
 // GetNodeSRv6CapabilitiesTLV returns string representation of SRv6 Capabilities TLV
 func (ls *NLRI) GetNodeSRv6CapabilitiesTLV() (*srv6.CapabilityTLV, error) {
 	for _, tlv := range ls.LS {
@@ -390,6 +422,10 @@ func (ls *NLRI) GetIGPMetric() uint32 {
 		// 1095 TLV has varaible length
 		// 1, 2 or 3 bytes, depending on the length copying the actual value into the right position.
 		copy(m[4-tlv.Length:], tlv.Value)
+		if tlv.Length == 1 {
+			// IS-IS narrow metric, two most significant bits are ignored
+			m[3] &= 0x3f
+		}
 		return binary.BigEndian.Uint32(m)
 	}
 
@@ -705,17 +741,19 @@ func (ls *NLRI) GetUnidirUtilizedBandwidth() uint32 {
 	return 0
 }
 
-// GetAppSpecLinkAttr returns a slice of Application Specifc Link Attributes
+// GetAppSpecLinkAttr returns a slice of Application Specifc Link Attributes, malformed
+// Application Specific Link Attributes TLVs are skipped
 func (ls *NLRI) GetAppSpecLinkAttr() ([]*AppSpecLinkAttr, error) {
 	aslas := make([]*AppSpecLinkAttr, 0)
-	// It appears Path Attributes can carry multiple entries of SR Adjacency SID
+	// Link carries a TLV for each set of applications sharing the attributes
 	for _, tlv := range ls.LS {
 		if tlv.Type != 1122 {
 			continue
 		}
 		asla, err := UnmarshalAppSpecLinkAttr(tlv.Value)
 		if err != nil {
-			return nil, err
+			glog.Errorf("failed to unmarshal application specific link attributes tlv with error: %+v", err)
+			continue
 		}
 		aslas = append(aslas, asla)
 	}
@@ -0,0 +1,42 @@
+package bgpls
+
+import (
+	"testing"
+)
+
+func TestGetNodeName(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  []byte
+		expect string
+	}{
+		{
+			name:   "plain name",
+			value:  []byte("xrd01"),
+			expect: "xrd01",
+		},
+		{
+			name:   "nul terminated name",
+			value:  []byte{'x', 'r', 'd', '0', '1', 0x00, 0x00},
+			expect: "xrd01",
+		},
+		{
+			name:   "name with surrounding spaces",
+			value:  []byte(" xrd01.lab \n"),
+			expect: "xrd01.lab",
+		},
+		{
+			name:   "name with invalid utf8",
+			value:  []byte{'x', 0xff, 'r', 'd'},
+			expect: "xrd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ls := &NLRI{LS: []TLV{{Type: 1026, Length: uint16(len(tt.value)), Value: tt.value}}}
+			if n := ls.GetNodeName(); n != tt.expect {
+				t.Errorf("expected node name %q but got %q", tt.expect, n)
+			}
+		})
+	}
+}
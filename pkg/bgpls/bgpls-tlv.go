@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/iana"
 	"github.com/sbezverk/tools"
 )
 
@@ -15,6 +16,11 @@ type TLV struct {
 	Value  []byte
 }
 
+// Name returns the name of the TLV type in IANA BGP-LS TLVs registry
+func (tlv *TLV) Name() string {
+	return iana.BGPLSTLVName(tlv.Type)
+}
+
 // UnmarshalBGPLSTLV builds Collection of BGP-LS TLVs
 func UnmarshalBGPLSTLV(b []byte) ([]TLV, error) {
 	if glog.V(6) {
@@ -30,6 +36,9 @@ func UnmarshalBGPLSTLV(b []byte) ([]TLV, error) {
 		lstlv.Value = make([]byte, lstlv.Length)
 		copy(lstlv.Value, b[p:p+int(lstlv.Length)])
 		p += int(lstlv.Length)
+		if glog.V(6) {
+			glog.Infof("BGPLSTLV type %d %s length %d", lstlv.Type, lstlv.Name(), lstlv.Length)
+		}
 		lstlvs = append(lstlvs, lstlv)
 	}
 
@@ -0,0 +1,58 @@
+package bgpls
+
+// Metric types used to qualify IGP and Prefix metrics
+const (
+	MetricTypeISISNarrow        = "isis-narrow"
+	MetricTypeISISWide          = "isis-wide"
+	MetricTypeOSPF              = "ospf"
+	MetricTypeOSPFIntraArea     = "ospf-intra-area"
+	MetricTypeOSPFInterArea     = "ospf-inter-area"
+	MetricTypeOSPFExternalType1 = "ospf-external-type1"
+	MetricTypeOSPFExternalType2 = "ospf-external-type2"
+	MetricTypeOSPFNSSAType1     = "ospf-nssa-type1"
+	MetricTypeOSPFNSSAType2     = "ospf-nssa-type2"
+)
+
+// ISISNarrowMetricMax defines the largest value which can be carried by IS-IS narrow (6 bits) metric
+const ISISNarrowMetricMax = 63
+
+// GetIGPMetricType returns the type of the metric carried in IGP Metric TLV 1095, the type is derived from
+// the length of the TLV, https://tools.ietf.org/html/rfc7752#section-3.3.2.4
+func (ls *NLRI) GetIGPMetricType() string {
+	for _, tlv := range ls.LS {
+		if tlv.Type != 1095 {
+			continue
+		}
+		switch tlv.Length {
+		case 1:
+			return MetricTypeISISNarrow
+		case 2:
+			return MetricTypeOSPF
+		case 3:
+			return MetricTypeISISWide
+		}
+	}
+
+	return ""
+}
+
+// OSPFMetricType returns the metric type matching the value of OSPF Route Type TLV 264,
+// https://tools.ietf.org/html/rfc7752#section-3.2.3.1
+func OSPFMetricType(routeType uint8) string {
+	switch routeType {
+	case 1:
+		return MetricTypeOSPFIntraArea
+	case 2:
+		return MetricTypeOSPFInterArea
+	case 3:
+		return MetricTypeOSPFExternalType1
+	case 4:
+		return MetricTypeOSPFExternalType2
+	case 5:
+		return MetricTypeOSPFNSSAType1
+	case 6:
+		return MetricTypeOSPFNSSAType2
+	}
+
+	return ""
+}
@@ -0,0 +1,50 @@
+package bgpls
+
+import (
+	"testing"
+)
+
+func TestGetIGPMetricType(t *testing.T) {
+	tests := []struct {
+		name       string
+		tlv        TLV
+		metric     uint32
+		metricType string
+	}{
+		{
+			name:       "isis narrow",
+			tlv:        TLV{Type: 1095, Length: 1, Value: []byte{0xca}},
+			metric:     10,
+			metricType: MetricTypeISISNarrow,
+		},
+		{
+			name:       "ospf",
+			tlv:        TLV{Type: 1095, Length: 2, Value: []byte{0x01, 0x00}},
+			metric:     256,
+			metricType: MetricTypeOSPF,
+		},
+		{
+			name:       "isis wide",
+			tlv:        TLV{Type: 1095, Length: 3, Value: []byte{0x01, 0x00, 0x00}},
+			metric:     65536,
+			metricType: MetricTypeISISWide,
+		},
+		{
+			name:       "no igp metric tlv",
+			tlv:        TLV{Type: 1155, Length: 4, Value: []byte{0x00, 0x00, 0x00, 0x0a}},
+			metric:     0,
+			metricType: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ls := &NLRI{LS: []TLV{tt.tlv}}
+			if m := ls.GetIGPMetric(); m != tt.metric {
+				t.Errorf("expected metric %d but got %d", tt.metric, m)
+			}
+			if mt := ls.GetIGPMetricType(); mt != tt.metricType {
+				t.Errorf("expected metric type %q but got %q", tt.metricType, mt)
+			}
+		})
+	}
+}
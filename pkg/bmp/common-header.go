@@ -12,7 +12,7 @@ const (
 	BMP_HEADER_SIZE = 6
 )
 
-// CommonHeader defines BMP message Common Header per rfc7854
+// CommonHeader defines BMP message Common Header per rfc7854, BMP v4 keeps the same Common Header
 type CommonHeader struct {
 	Version       byte
 	MessageLength int32
@@ -25,8 +25,8 @@ func UnmarshalCommonHeader(b []byte) (*CommonHeader, error) {
 		glog.Infof("BMP CommonHeader Raw: %s", tools.MessageHex(b))
 	}
 	ch := &CommonHeader{}
-	if b[0] != 3 {
-		return nil, fmt.Errorf("invalid version in common header, expected 3 found %d", b[0])
+	if b[0] != Version3 && b[0] != Version4 {
+		return nil, fmt.Errorf("invalid version in common header, expected 3 or 4 found %d", b[0])
 	}
 	ch.Version = b[0]
 	ch.MessageLength = int32(binary.BigEndian.Uint32(b[1:5]))
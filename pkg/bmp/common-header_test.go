@@ -20,6 +20,15 @@ func TestCommonHeaderRoundTrip(t *testing.T) {
 			},
 			fail: false,
 		},
+		{
+			name: "Valid BMP v4 Common Header",
+			original: &CommonHeader{
+				Version:       4,
+				MessageLength: 64,
+				MessageType:   0,
+			},
+			fail: false,
+		},
 		{
 			name: "Invalid Common Header",
 			original: &CommonHeader{
@@ -56,4 +56,38 @@ const (
 	FlowspecV4Msg = 164
 	// FlowspecV6Msg defines BMP Route Monitoring message carrying Flowspec NLRI
 	FlowspecV6Msg = 166
+	// IGPAdjacencyMsg defines a message carrying IGP adjacency state changes derived from LS Link NLRI
+	IGPAdjacencyMsg = 17
+	// ReportMsg defines a message carrying a periodic report of peers availability and prefixes churn
+	ReportMsg = 18
+	// ASGraphMsg defines a message carrying links added to and removed from the AS-level graph
+	ASGraphMsg = 19
+	// SRPolicyValidationMsg defines a message carrying changes of SR Policy validation against BGP-LS SIDs
+	SRPolicyValidationMsg = 20
+	// OriginAnomalyMsg defines a message carrying routes deviating from the learned prefix to origin AS baseline
+	OriginAnomalyMsg = 21
+	// PeerStormMsg defines a message summarizing peers of a router gone down within a short window
+	PeerStormMsg = 22
+	// EPEPrefixMsg defines a message combining a unicast route of a peer with egress SIDs of the peer from BGP-LS
+	EPEPrefixMsg = 23
+	// SessionSummaryMsg defines a message summarizing everything seen during a BMP session when the session ends
+	SessionSummaryMsg = 24
+	// CollectorEventMsg defines a message reporting an operational problem of the collector
+	CollectorEventMsg = 25
+	// VerbosityChangeMsg defines a message carrying a switch of a peer between per-prefix and summarized messages
+	VerbosityChangeMsg = 26
+	// ChurnSummaryMsg defines a message summarizing prefix messages of a peer in summarized mode per interval
+	ChurnSummaryMsg = 27
+	// RouteRefreshMsg defines a message of BGP Route Refresh message monitored or mirrored by the router
+	RouteRefreshMsg = 28
+	// MirroredMessageMsg defines a message of BGP message mirrored by the router and decoded
+	MirroredMessageMsg = 29
+	// RouteMirroringMsg defines a message of BMP Route Mirroring message carrying all mirrored BGP messages and information
+	RouteMirroringMsg = 30
+	// RawUpdateMsg defines a message of BGP Update message of Route Monitoring message as received from the router
+	RawUpdateMsg = 31
+	// MVPNMsg defines a message of MCAST-VPN route, AFI 1 and 2 SAFI 5
+	MVPNMsg = 32
+	// RouteStatsMsg defines a message carrying rolling counters of announcements, withdrawals and flaps of routes of peers
+	RouteStatsMsg = 33
 )
@@ -0,0 +1,182 @@
+package bmp
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// TopicPrefix is the prefix of default topics of published messages, the default topic of a message type
+// is the prefix followed by the name of the type.
+const TopicPrefix = "gobmp.parsed."
+
+// MessageType defines a type of published messages
+type MessageType struct {
+	// Type is the type of messages passed to publishers
+	Type int
+	// Name is the name of the type used by API filters, transformation rules and scripts
+	Name string
+	// Topic is the default Kafka topic and NATS subject of messages of the type
+	Topic string
+	// Schema is the Go type marshaled into messages of the type, nil when the schema is not registered
+	Schema reflect.Type
+}
+
+// builtinMessageTypes lists types of messages published by gobmp, schemas are registered by packages
+// defining the messages.
+var builtinMessageTypes = []MessageType{
+	{Type: PeerStateChangeMsg, Name: "peer"},
+	{Type: UnicastPrefixMsg, Name: "unicast_prefix"},
+	{Type: UnicastPrefixV4Msg, Name: "unicast_prefix_v4"},
+	{Type: UnicastPrefixV6Msg, Name: "unicast_prefix_v6"},
+	{Type: LSNodeMsg, Name: "ls_node"},
+	{Type: LSLinkMsg, Name: "ls_link"},
+	{Type: L3VPNMsg, Name: "l3vpn"},
+	{Type: L3VPNV4Msg, Name: "l3vpn_v4"},
+	{Type: L3VPNV6Msg, Name: "l3vpn_v6"},
+	{Type: LSPrefixMsg, Name: "ls_prefix"},
+	{Type: LSSRv6SIDMsg, Name: "ls_srv6_sid"},
+	{Type: EVPNMsg, Name: "evpn"},
+	{Type: SRPolicyMsg, Name: "sr_policy"},
+	{Type: SRPolicyV4Msg, Name: "sr_policy_v4"},
+	{Type: SRPolicyV6Msg, Name: "sr_policy_v6"},
+	{Type: FlowspecMsg, Name: "flowspec"},
+	{Type: FlowspecV4Msg, Name: "flowspec_v4"},
+	{Type: FlowspecV6Msg, Name: "flowspec_v6"},
+	{Type: StatsReportMsg, Name: "statistics"},
+	{Type: IGPAdjacencyMsg, Name: "igp_adjacency"},
+	{Type: ReportMsg, Name: "report"},
+	{Type: ASGraphMsg, Name: "as_graph"},
+	{Type: SRPolicyValidationMsg, Name: "sr_policy_validation"},
+	{Type: OriginAnomalyMsg, Name: "origin_anomaly"},
+	{Type: PeerStormMsg, Name: "peer_storm"},
+	{Type: EPEPrefixMsg, Name: "epe_prefix"},
+	{Type: SessionSummaryMsg, Name: "session_summary"},
+	{Type: CollectorEventMsg, Name: "collector_event"},
+	{Type: VerbosityChangeMsg, Name: "verbosity_change"},
+	{Type: ChurnSummaryMsg, Name: "churn_summary"},
+	{Type: RouteRefreshMsg, Name: "route_refresh"},
+	{Type: MirroredMessageMsg, Name: "mirrored_message"},
+	{Type: RouteMirroringMsg, Name: "route_mirror"},
+	{Type: RawUpdateMsg, Name: "raw_update"},
+	{Type: MVPNMsg, Name: "mvpn"},
+	{Type: RouteStatsMsg, Name: "route_stats"},
+}
+
+// messageTypes is the registry of types of published messages
+var messageTypes = struct {
+	sync.RWMutex
+	byType map[int]*MessageType
+	byName map[string]*MessageType
+}{
+	byType: make(map[int]*MessageType),
+	byName: make(map[string]*MessageType),
+}
+
+func init() {
+	for _, mt := range builtinMessageTypes {
+		if err := RegisterMessageType(mt); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterMessageType adds the type of published messages to the registry, the default topic is used
+// when Topic is not set. Publishers, API filters, transformation rules and scripts learn types from the registry.
+func RegisterMessageType(mt MessageType) error {
+	if mt.Name == "" {
+		return fmt.Errorf("message type %d has no name", mt.Type)
+	}
+	if mt.Topic == "" {
+		mt.Topic = TopicPrefix + mt.Name
+	}
+	messageTypes.Lock()
+	defer messageTypes.Unlock()
+	if o, ok := messageTypes.byType[mt.Type]; ok {
+		return fmt.Errorf("message type %d is already registered with name %s", mt.Type, o.Name)
+	}
+	if o, ok := messageTypes.byName[mt.Name]; ok {
+		return fmt.Errorf("message type name %s is already registered for type %d", mt.Name, o.Type)
+	}
+	messageTypes.byType[mt.Type] = &mt
+	messageTypes.byName[mt.Name] = &mt
+
+	return nil
+}
+
+// RegisterMessageSchema sets the Go type marshaled into messages of the registered type, v is a value
+// or a pointer to a value of the type
+func RegisterMessageSchema(t int, v interface{}) error {
+	messageTypes.Lock()
+	defer messageTypes.Unlock()
+	mt, ok := messageTypes.byType[t]
+	if !ok {
+		return fmt.Errorf("message type %d is not registered", t)
+	}
+	s := reflect.TypeOf(v)
+	for s != nil && s.Kind() == reflect.Ptr {
+		s = s.Elem()
+	}
+	if s == nil {
+		return fmt.Errorf("invalid schema of message type %s", mt.Name)
+	}
+	mt.Schema = s
+
+	return nil
+}
+
+// LookupMessageType returns the registered type of published messages, second returned value
+// is false if the type is not known.
+func LookupMessageType(t int) (MessageType, bool) {
+	messageTypes.RLock()
+	defer messageTypes.RUnlock()
+	mt, ok := messageTypes.byType[t]
+	if !ok {
+		return MessageType{}, false
+	}
+
+	return *mt, true
+}
+
+// MessageTypes returns registered types of published messages ordered by type
+func MessageTypes() []MessageType {
+	messageTypes.RLock()
+	defer messageTypes.RUnlock()
+	l := make([]MessageType, 0, len(messageTypes.byType))
+	for _, mt := range messageTypes.byType {
+		l = append(l, *mt)
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].Type < l[j].Type })
+
+	return l
+}
+
+// MessageTypeName returns the name of published message type, empty string is returned
+// if the type is not known.
+func MessageTypeName(t int) string {
+	mt, _ := LookupMessageType(t)
+
+	return mt.Name
+}
+
+// MessageTypeByName returns the type of published message for the name, second returned value
+// is false if the name is not known.
+func MessageTypeByName(name string) (int, bool) {
+	messageTypes.RLock()
+	defer messageTypes.RUnlock()
+	mt, ok := messageTypes.byName[name]
+	if !ok {
+		return 0, false
+	}
+
+	return mt.Type, true
+}
+
+// MessageTopic returns the topic of messages of the type, second returned value is false
+// if the type is not known.
+func MessageTopic(t int) (string, bool) {
+	mt, ok := LookupMessageType(t)
+
+	return mt.Topic, ok
+}
@@ -0,0 +1,86 @@
+package bmp
+
+import (
+	"testing"
+)
+
+func TestMessageTypes(t *testing.T) {
+	for _, mt := range builtinMessageTypes {
+		if name := MessageTypeName(mt.Type); name != mt.Name {
+			t.Errorf("expected name %s of message type %d but got %s", mt.Name, mt.Type, name)
+		}
+		if typ, ok := MessageTypeByName(mt.Name); !ok || typ != mt.Type {
+			t.Errorf("expected type %d of message type name %s but got %d", mt.Type, mt.Name, typ)
+		}
+		if topic, _ := MessageTopic(mt.Type); topic != TopicPrefix+mt.Name {
+			t.Errorf("expected topic %s of message type %s but got %s", TopicPrefix+mt.Name, mt.Name, topic)
+		}
+	}
+	if _, ok := LookupMessageType(1000); ok {
+		t.Errorf("expected message type 1000 not to be registered")
+	}
+}
+
+func TestRegisterMessageType(t *testing.T) {
+	type testMsg struct {
+		Name string `json:"name"`
+	}
+	tests := []struct {
+		name  string
+		mt    MessageType
+		topic string
+		fail  bool
+	}{
+		{
+			name:  "default topic",
+			mt:    MessageType{Type: 1001, Name: "test_default"},
+			topic: "gobmp.parsed.test_default",
+		},
+		{
+			name:  "topic",
+			mt:    MessageType{Type: 1002, Name: "test_topic", Topic: "test.topic"},
+			topic: "test.topic",
+		},
+		{
+			name: "duplicate type",
+			mt:   MessageType{Type: PeerStateChangeMsg, Name: "test_peer"},
+			fail: true,
+		},
+		{
+			name: "duplicate name",
+			mt:   MessageType{Type: 1003, Name: "peer"},
+			fail: true,
+		},
+		{
+			name: "no name",
+			mt:   MessageType{Type: 1004},
+			fail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterMessageType(tt.mt)
+			if err != nil && !tt.fail {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err == nil && tt.fail {
+				t.Fatalf("expected error but succeeded")
+			}
+			if tt.fail {
+				return
+			}
+			if topic, _ := MessageTopic(tt.mt.Type); topic != tt.topic {
+				t.Errorf("expected topic %s but got %s", tt.topic, topic)
+			}
+			if err := RegisterMessageSchema(tt.mt.Type, &testMsg{}); err != nil {
+				t.Fatalf("failed to register schema with error: %+v", err)
+			}
+			if mt, _ := LookupMessageType(tt.mt.Type); mt.Schema == nil || mt.Schema.Name() != "testMsg" {
+				t.Errorf("expected schema testMsg but got %v", mt.Schema)
+			}
+		})
+	}
+	if err := RegisterMessageSchema(1005, testMsg{}); err == nil {
+		t.Errorf("expected error registering schema of unknown message type")
+	}
+}
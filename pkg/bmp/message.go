@@ -1,8 +1,12 @@
 package bmp
 
+import "context"
+
 // Message defines a message used to transfer BMP messages for further processing
 // for BMP messages which do not carry PerPeerHeader, it will be set to nil.
 type Message struct {
 	PeerHeader *PerPeerHeader
 	Payload    interface{}
+	// Context carries the trace of the BMP message through the pipeline, it is nil when the message is not traced
+	Context context.Context
 }
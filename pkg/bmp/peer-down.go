@@ -7,6 +7,9 @@ import (
 	"github.com/sbezverk/tools"
 )
 
+// PeerDownDeconfigured is the reason of Peer Down message of Loc-RIB instance peer which is de-configured, RFC 9069
+const PeerDownDeconfigured = 6
+
 // PeerDownMessage defines BMPPeerDownMessage per rfc7854
 type PeerDownMessage struct {
 	Reason uint8
@@ -18,13 +21,16 @@ func UnmarshalPeerDownMessage(b []byte) (*PeerDownMessage, error) {
 	if glog.V(6) {
 		glog.Infof("BMP Peer Down Message Raw: %s", tools.MessageHex(b))
 	}
+	if len(b) < 1 {
+		return nil, fmt.Errorf("invalid length %d of Peer Down message", len(b))
+	}
 	pdw := &PeerDownMessage{
 		Data: make([]byte, len(b)-1),
 	}
 	p := 0
 	pdw.Reason = b[p]
 	p++
-	if pdw.Reason < 1 || pdw.Reason > 5 {
+	if pdw.Reason < 1 || pdw.Reason > PeerDownDeconfigured {
 		return nil, fmt.Errorf("invalid reason code %d in Peer Down message", pdw.Reason)
 	}
 	copy(pdw.Data, b[p:])
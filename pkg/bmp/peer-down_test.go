@@ -19,6 +19,14 @@ func TestPeerDownMsg(t *testing.T) {
 				Data:   []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x00, 0x15, 0x03, 0x06, 0x04},
 			},
 		},
+		{
+			name:  "loc-rib instance de-configured",
+			input: []byte{0x06},
+			expect: &PeerDownMessage{
+				Reason: PeerDownDeconfigured,
+				Data:   []byte{},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -31,4 +39,9 @@ func TestPeerDownMsg(t *testing.T) {
 			}
 		})
 	}
+	for _, b := range [][]byte{{}, {0x07}} {
+		if _, err := UnmarshalPeerDownMessage(b); err == nil {
+			t.Errorf("expected Peer Down message %v to fail", b)
+		}
+	}
 }
@@ -2,13 +2,23 @@ package bmp
 
 import (
 	"encoding/binary"
+	"fmt"
 	"net"
+	"strings"
 
 	"github.com/golang/glog"
 	"github.com/sbezverk/gobmp/pkg/bgp"
 	"github.com/sbezverk/tools"
 )
 
+// Peer Up message Information TLV types
+const (
+	// PeerUpStringTLV carries a free-form string, routers commonly send the description of the peer
+	PeerUpStringTLV = 0
+	// PeerUpTableNameTLV carries VRF/Table Name of Loc-RIB instance peer per RFC 9069
+	PeerUpTableNameTLV = 3
+)
+
 // PeerUpMessage defines BMPPeerUpMessage per rfc7854
 type PeerUpMessage struct {
 	LocalAddress     []byte
@@ -27,12 +37,36 @@ func (pum *PeerUpMessage) GetLocalAddressString() string {
 	return net.IP(pum.LocalAddress[12:]).To4().String()
 }
 
+// GetPeerName returns the name of the peer carried by String Information TLVs, routers commonly send
+// the description of the peer, empty string is returned if the message carries no String TLV
+func (pum *PeerUpMessage) GetPeerName() string {
+	var name []string
+	for _, t := range pum.Information {
+		if t.InformationType == PeerUpStringTLV && len(t.Information) != 0 {
+			name = append(name, string(t.Information))
+		}
+	}
+
+	return strings.Join(name, " ")
+}
+
+// GetTableName returns VRF/Table Name of Loc-RIB instance carried by Table Name Information TLV, RFC 9069,
+// empty string is returned if the message carries no Table Name TLV
+func (pum *PeerUpMessage) GetTableName() string {
+	for _, t := range pum.Information {
+		if t.InformationType == PeerUpTableNameTLV {
+			return string(t.Information)
+		}
+	}
+
+	return ""
+}
+
 // UnmarshalPeerUpMessage processes Peer Up message and returns BMPPeerUpMessage object
 func UnmarshalPeerUpMessage(b []byte, isIPv6 bool) (*PeerUpMessage, error) {
 	if glog.V(6) {
 		glog.Infof("BMP Peer Up Message Raw: %s", tools.MessageHex(b))
 	}
-	var err error
 	pu := &PeerUpMessage{
 		LocalAddress:     make([]byte, 16),
 		SentOpen:         &bgp.OpenMessage{},
@@ -40,6 +74,10 @@ func UnmarshalPeerUpMessage(b []byte, isIPv6 bool) (*PeerUpMessage, error) {
 		Information:      make([]InformationalTLV, 0),
 		isRemotePeerIPv6: isIPv6,
 	}
+	// 16 bytes local address + 2 bytes local port + 2 bytes remote port
+	if len(b) < 20 {
+		return nil, fmt.Errorf("peer up message length %d is invalid", len(b))
+	}
 	p := 0
 	copy(pu.LocalAddress, b[:16])
 	p += 16
@@ -47,23 +85,27 @@ func UnmarshalPeerUpMessage(b []byte, isIPv6 bool) (*PeerUpMessage, error) {
 	p += 2
 	pu.RemotePort = binary.BigEndian.Uint16(b[p : p+2])
 	p += 2
-	// Skip first marker 16 bytes
-	p += 16
-	l1 := int16(binary.BigEndian.Uint16(b[p : p+2]))
-	pu.SentOpen, err = bgp.UnmarshalBGPOpenMessage(b[p : p+int(l1-16)])
+	// OPEN messages do not exceed 4096 bytes even when Extended Message capability is negotiated, RFC 8654
+	l1, err := bgp.MessageLength(b[p:], bgp.BGPMaxMessageLength)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sent OPEN message with error: %+v", err)
+	}
+	// Skip the marker
+	pu.SentOpen, err = bgp.UnmarshalBGPOpenMessage(b[p+16 : p+l1])
 	if err != nil {
 		return nil, err
 	}
 	// Moving pointer to the next marker
-	p += int(l1) - 16
-	// Skip second marker
-	p += 16
-	l2 := int16(binary.BigEndian.Uint16(b[p : p+2]))
-	pu.ReceivedOpen, err = bgp.UnmarshalBGPOpenMessage(b[p : p+int(l2-16)])
+	p += l1
+	l2, err := bgp.MessageLength(b[p:], bgp.BGPMaxMessageLength)
+	if err != nil {
+		return nil, fmt.Errorf("invalid received OPEN message with error: %+v", err)
+	}
+	pu.ReceivedOpen, err = bgp.UnmarshalBGPOpenMessage(b[p+16 : p+l2])
 	if err != nil {
 		return nil, err
 	}
-	p += int(l2) - 16
+	p += l2
 	// Last part is optional Informational TLVs
 	if len(b) > int(p) {
 		// Since pointer p does not point to the end of buffer,
@@ -143,3 +143,33 @@ func TestPeerUpMsg(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPeerName(t *testing.T) {
+	pu := &PeerUpMessage{
+		Information: []InformationalTLV{
+			{InformationType: 3, Information: []byte("global")},
+			{InformationType: 0, Information: []byte("transit-ntt")},
+		},
+	}
+	if name := pu.GetPeerName(); name != "transit-ntt" {
+		t.Errorf("expected peer name transit-ntt but got %q", name)
+	}
+	if name := (&PeerUpMessage{}).GetPeerName(); name != "" {
+		t.Errorf("expected no peer name but got %q", name)
+	}
+}
+
+func TestGetTableName(t *testing.T) {
+	pu := &PeerUpMessage{
+		Information: []InformationalTLV{
+			{InformationType: PeerUpStringTLV, Information: []byte("transit-ntt")},
+			{InformationType: PeerUpTableNameTLV, Information: []byte("red")},
+		},
+	}
+	if name := pu.GetTableName(); name != "red" {
+		t.Errorf("expected table name red but got %q", name)
+	}
+	if name := (&PeerUpMessage{}).GetTableName(); name != "" {
+		t.Errorf("expected no table name but got %q", name)
+	}
+}
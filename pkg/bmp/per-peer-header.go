@@ -22,6 +22,15 @@ const (
 	BMP_PEER_HEADER_SIZE = 42
 )
 
+// RIB types of routes reported in rib_type attribute of messages
+const (
+	RIBTypeAdjRIBInPre   = "adj-rib-in-pre"
+	RIBTypeAdjRIBInPost  = "adj-rib-in-post"
+	RIBTypeAdjRIBOutPre  = "adj-rib-out-pre"
+	RIBTypeAdjRIBOutPost = "adj-rib-out-post"
+	RIBTypeLocRIB        = "loc-rib"
+)
+
 type PeerType uint8
 
 const (
@@ -51,6 +60,9 @@ type PerPeerHeader struct {
 	PeerAS            uint32
 	PeerBGPID         []byte
 	PeerTimestamp     []byte
+	// ReceivedAt is the time the message with the header was received by the collector, it is not a part of
+	// the header and it is zero when the time is unknown
+	ReceivedAt time.Time
 }
 
 // Len returns the length of PerPeerHeader structure
@@ -78,13 +90,18 @@ func peerType(b byte) (PeerType, error) {
 	}
 }
 
-func (p *PerPeerHeader) GetPeerTimestamp() string {
+// GetPeerTime returns the time of Peer Timestamp
+func (p *PerPeerHeader) GetPeerTime() time.Time {
 	t := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
 	ts := time.Second * time.Duration(binary.BigEndian.Uint32(p.PeerTimestamp[0:4]))
 	tms := time.Duration(int(binary.BigEndian.Uint32(p.PeerTimestamp[4:8])))
 	t = t.Add(ts)
 	t = t.Add(tms)
-	return t.Format(time.RFC3339Nano)
+	return t
+}
+
+func (p *PerPeerHeader) GetPeerTimestamp() string {
+	return p.GetPeerTime().Format(time.RFC3339Nano)
 }
 
 // GetPeerHash calculates Peer Hash and returns as a hex string
@@ -122,6 +139,18 @@ func (p *PerPeerHeader) IsAdjRIBOutPost() (bool, error) {
 	return false, ErrInvFlagRequestForPeerType
 }
 
+// IsAdjRIBOut returns true if PeerType is 0, 1 or 2 and O flag is set, routes are those the router advertises
+// to the peer rather than receives from it, RFC 8671
+func (p *PerPeerHeader) IsAdjRIBOut() bool {
+	return p.PeerType != PeerType3 && p.flagO
+}
+
+// IsPostPolicy returns true if routes are reported after the policy is applied, L flag is set for PeerType 0, 1
+// and 2 and routes of Loc-RIB of PeerType 3 always are post-policy
+func (p *PerPeerHeader) IsPostPolicy() bool {
+	return p.PeerType == PeerType3 || p.flagL
+}
+
 // IsAdjRIBInPost returns true if PeerType is 0,1 or 2 and L flag is set, otherwise it returns error
 func (p *PerPeerHeader) IsAdjRIBInPost() (bool, error) {
 	if p.PeerType != PeerType3 {
@@ -140,6 +169,23 @@ func (p *PerPeerHeader) IsLocRIBFiltered() (bool, error) {
 	return false, ErrInvFlagRequestForPeerType
 }
 
+// GetRIBType returns the RIB type of routes reported for the peer derived from Peer Type and O and L flags,
+// RFC 8671 and RFC 9069
+func (p *PerPeerHeader) GetRIBType() string {
+	switch {
+	case p.PeerType == PeerType3:
+		return RIBTypeLocRIB
+	case p.flagO && p.flagL:
+		return RIBTypeAdjRIBOutPost
+	case p.flagO:
+		return RIBTypeAdjRIBOutPre
+	case p.flagL:
+		return RIBTypeAdjRIBInPost
+	}
+
+	return RIBTypeAdjRIBInPre
+}
+
 // IsRemotePeerIPv6 returns true if Remote Peer is IPv6 for PeerType is 0,1 or 2, for Peer Type 3 always returns false.
 func (p *PerPeerHeader) IsRemotePeerIPv6() bool {
 	if p.PeerType != PeerType3 {
@@ -150,25 +196,25 @@ func (p *PerPeerHeader) IsRemotePeerIPv6() bool {
 }
 
 // GetPeerDistinguisherString returns string representation of Peer's distinguisher
-// depending on the peer's type.
+// depending on the peer's type. Zero-filled distinguisher of the global instance is "0:0",
+// distinguisher of RD instance peers is the Route Distinguisher, distinguisher of local instance
+// peers and of Loc-RIB instances is the decimal locally defined instance identifier.
 func (p *PerPeerHeader) GetPeerDistinguisherString() string {
 	pd := "0:0"
-	switch p.PeerType {
-	case PeerType0:
-		break
-	case PeerType1:
-		fallthrough
-	case PeerType3:
-		if rd, err := base.MakeRD(p.PeerDistinguisher); err != nil {
-			break
-		} else {
+	if len(p.PeerDistinguisher) != 8 {
+		return pd
+	}
+	id := binary.BigEndian.Uint64(p.PeerDistinguisher)
+	if id == 0 {
+		return pd
+	}
+	if p.PeerType == PeerType1 {
+		if rd, err := base.MakeRD(p.PeerDistinguisher); err == nil {
 			return rd.String()
 		}
-	case PeerType2:
-		return strconv.FormatInt(int64(binary.BigEndian.Uint64(p.PeerDistinguisher)), 10)
 	}
 
-	return pd
+	return strconv.FormatUint(id, 10)
 }
 
 // UnmarshalPerPeerHeader processes Per-Peer header
@@ -0,0 +1,122 @@
+package bmp
+
+import (
+	"testing"
+)
+
+func TestGetRIBType(t *testing.T) {
+	tests := []struct {
+		name   string
+		header *PerPeerHeader
+		expect string
+		// adjRIBOut and postPolicy are expected values of is_adj_rib_out and is_post_policy
+		adjRIBOut  bool
+		postPolicy bool
+	}{
+		{
+			name:       "adj-rib-in pre-policy",
+			header:     &PerPeerHeader{PeerType: PeerType0},
+			expect:     RIBTypeAdjRIBInPre,
+			adjRIBOut:  false,
+			postPolicy: false,
+		},
+		{
+			name:       "adj-rib-in post-policy",
+			header:     &PerPeerHeader{PeerType: PeerType1, flagL: true},
+			expect:     RIBTypeAdjRIBInPost,
+			adjRIBOut:  false,
+			postPolicy: true,
+		},
+		{
+			name:       "adj-rib-out pre-policy",
+			header:     &PerPeerHeader{PeerType: PeerType0, flagO: true},
+			expect:     RIBTypeAdjRIBOutPre,
+			adjRIBOut:  true,
+			postPolicy: false,
+		},
+		{
+			name:       "adj-rib-out post-policy",
+			header:     &PerPeerHeader{PeerType: PeerType2, flagO: true, flagL: true},
+			expect:     RIBTypeAdjRIBOutPost,
+			adjRIBOut:  true,
+			postPolicy: true,
+		},
+		{
+			name:       "loc-rib",
+			header:     &PerPeerHeader{PeerType: PeerType3, flagF: true},
+			expect:     RIBTypeLocRIB,
+			adjRIBOut:  false,
+			postPolicy: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.header.GetRIBType(); got != tt.expect {
+				t.Fatalf("expected rib type %s but got %s", tt.expect, got)
+			}
+			if tt.header.IsAdjRIBOut() != tt.adjRIBOut || tt.header.IsPostPolicy() != tt.postPolicy {
+				t.Errorf("expected adj-rib-out %t and post-policy %t but got %t and %t", tt.adjRIBOut, tt.postPolicy,
+					tt.header.IsAdjRIBOut(), tt.header.IsPostPolicy())
+			}
+		})
+	}
+}
+
+func TestUnmarshalPerPeerHeaderAdjRIBOut(t *testing.T) {
+	b := make([]byte, PerPeerHeaderLength)
+	// Peer Type 0 with L and O flags
+	b[1] = 0x50
+	ph, err := UnmarshalPerPeerHeader(b)
+	if err != nil {
+		t.Fatalf("failed to unmarshal per peer header with error: %+v", err)
+	}
+	if !ph.IsAdjRIBOut() || !ph.IsPostPolicy() || ph.GetRIBType() != RIBTypeAdjRIBOutPost {
+		t.Errorf("expected adj-rib-out post-policy peer header but got rib type %s", ph.GetRIBType())
+	}
+}
+
+func TestGetPeerDistinguisherString(t *testing.T) {
+	tests := []struct {
+		name   string
+		header *PerPeerHeader
+		expect string
+	}{
+		{
+			name:   "global instance",
+			header: &PerPeerHeader{PeerType: PeerType0, PeerDistinguisher: make([]byte, 8)},
+			expect: "0:0",
+		},
+		{
+			name:   "rd instance type 0",
+			header: &PerPeerHeader{PeerType: PeerType1, PeerDistinguisher: []byte{0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64}},
+			expect: "65000:100",
+		},
+		{
+			name:   "rd instance type 1",
+			header: &PerPeerHeader{PeerType: PeerType1, PeerDistinguisher: []byte{0x00, 0x01, 0x0a, 0x00, 0x00, 0x01, 0x00, 0x64}},
+			expect: "10.0.0.1:100",
+		},
+		{
+			name:   "local instance",
+			header: &PerPeerHeader{PeerType: PeerType2, PeerDistinguisher: []byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}},
+			expect: "9223372036854775809",
+		},
+		{
+			name:   "loc-rib of global instance",
+			header: &PerPeerHeader{PeerType: PeerType3, PeerDistinguisher: make([]byte, 8)},
+			expect: "0:0",
+		},
+		{
+			name:   "loc-rib instance",
+			header: &PerPeerHeader{PeerType: PeerType3, PeerDistinguisher: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07}},
+			expect: "7",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.header.GetPeerDistinguisherString(); got != tt.expect {
+				t.Fatalf("expected peer distinguisher %s but got %s", tt.expect, got)
+			}
+		})
+	}
+}
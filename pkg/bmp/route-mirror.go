@@ -0,0 +1,128 @@
+package bmp
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/tools"
+)
+
+// Route Mirroring TLV types per rfc7854
+const (
+	// MirroredBGPMessageTLV carries a BGP PDU mirrored by the router
+	MirroredBGPMessageTLV = 0
+	// MirroredInformationTLV carries the code of information about mirrored messages
+	MirroredInformationTLV = 1
+)
+
+// RouteMirror defines a structure of BMP Route Mirroring message
+type RouteMirror struct {
+	// Messages are BGP PDUs of BGP Message TLVs
+	Messages [][]byte
+	// Information are codes of Information TLVs, 0 for errored PDU and 1 for messages lost
+	Information []uint16
+}
+
+// UnmarshalBMPRouteMirrorMessage builds BMP Route Mirroring object
+func UnmarshalBMPRouteMirrorMessage(b []byte) (*RouteMirror, error) {
+	if glog.V(6) {
+		glog.Infof("BMP Route Mirroring Message Raw: %s length: %d", tools.MessageHex(b), len(b))
+	}
+	tlvs, err := UnmarshalTLV(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal TLVs of route mirroring message with error: %+v", err)
+	}
+	rm := &RouteMirror{}
+	for _, t := range tlvs {
+		switch t.InformationType {
+		case MirroredBGPMessageTLV:
+			rm.Messages = append(rm.Messages, t.Information)
+		case MirroredInformationTLV:
+			if len(t.Information) != 2 {
+				return nil, fmt.Errorf("invalid length %d of route mirroring information tlv", len(t.Information))
+			}
+			rm.Information = append(rm.Information, binary.BigEndian.Uint16(t.Information))
+		}
+	}
+
+	return rm, nil
+}
+
+// Codes of Information TLVs of Route Mirroring messages per rfc7854 and their names
+const (
+	MirroredErroredPDUCode   = 0
+	MirroredMessagesLostCode = 1
+	MirroredErroredPDU       = "errored_pdu"
+	MirroredMessagesLost     = "messages_lost"
+)
+
+// MirroredInformationName returns the name of the code of Route Mirroring Information TLV
+func MirroredInformationName(code uint16) string {
+	switch code {
+	case MirroredErroredPDUCode:
+		return MirroredErroredPDU
+	case MirroredMessagesLostCode:
+		return MirroredMessagesLost
+	}
+
+	return fmt.Sprintf("unknown(%d)", code)
+}
+
+// MessageTypes returns counters of mirrored BGP messages by the name of BGP message type and of Information TLVs,
+// malformed messages are counted as "malformed", the messages are not decoded
+func (rm *RouteMirror) MessageTypes() map[string]uint64 {
+	counters := make(map[string]uint64)
+	for _, m := range rm.Messages {
+		t, _, err := MirroredMessageBody(m)
+		if err != nil {
+			counters["malformed"]++
+			continue
+		}
+		counters[bgp.MessageTypeName(t)]++
+	}
+	for _, code := range rm.Information {
+		switch code {
+		case MirroredErroredPDUCode:
+			counters[MirroredErroredPDU]++
+		case MirroredMessagesLostCode:
+			counters[MirroredMessagesLost]++
+		}
+	}
+
+	return counters
+}
+
+// MirroredMessageBody returns the type of mirrored BGP message and the message following BGP message header
+func MirroredMessageBody(m []byte) (uint8, []byte, error) {
+	// Mirrored messages may exceed 4096 bytes when Extended Message capability is negotiated, RFC 8654
+	l, err := bgp.MessageLength(m, bgp.BGPMaxExtendedMessageLength)
+	if err != nil {
+		return 0, nil, fmt.Errorf("malformed mirrored BGP message with error: %+v", err)
+	}
+
+	return m[18], m[19:l], nil
+}
+
+// GetRouteRefreshes returns BGP Route Refresh messages found among mirrored BGP messages, other BGP messages
+// are skipped
+func (rm *RouteMirror) GetRouteRefreshes() ([]*bgp.RouteRefresh, error) {
+	refreshes := make([]*bgp.RouteRefresh, 0)
+	for _, m := range rm.Messages {
+		t, body, err := MirroredMessageBody(m)
+		if err != nil {
+			return nil, err
+		}
+		if t != bgp.RouteRefreshMessageType {
+			continue
+		}
+		r, err := bgp.UnmarshalBGPRouteRefreshMessage(body)
+		if err != nil {
+			return nil, err
+		}
+		refreshes = append(refreshes, r)
+	}
+
+	return refreshes, nil
+}
@@ -0,0 +1,42 @@
+package bmp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalBMPRouteMirrorMessage(t *testing.T) {
+	marker := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	refresh := append(append([]byte{}, marker...), 0x00, 0x17, 0x05, 0x00, 0x02, 0x01, 0x01)
+	keepalive := append(append([]byte{}, marker...), 0x00, 0x13, 0x04)
+	b := []byte{0x00, 0x01, 0x00, 0x02, 0x00, 0x01}
+	b = append(b, 0x00, 0x00, 0x00, byte(len(keepalive)))
+	b = append(b, keepalive...)
+	b = append(b, 0x00, 0x00, 0x00, byte(len(refresh)))
+	b = append(b, refresh...)
+	rm, err := UnmarshalBMPRouteMirrorMessage(b)
+	if err != nil {
+		t.Fatalf("failed to unmarshal route mirroring message with error: %+v", err)
+	}
+	if len(rm.Messages) != 2 || len(rm.Information) != 1 || rm.Information[0] != 1 {
+		t.Fatalf("expected 2 mirrored messages and messages lost information but got %+v", rm)
+	}
+	refreshes, err := rm.GetRouteRefreshes()
+	if err != nil {
+		t.Fatalf("failed to get route refreshes with error: %+v", err)
+	}
+	if len(refreshes) != 1 || refreshes[0].AFI != 2 || refreshes[0].SAFI != 1 || refreshes[0].GetSubtype() != "borr" {
+		t.Errorf("expected beginning of route refresh of ipv6 unicast but got %+v", refreshes)
+	}
+	counters := map[string]uint64{"keepalive": 1, "route_refresh": 1, MirroredMessagesLost: 1}
+	if c := rm.MessageTypes(); !reflect.DeepEqual(c, counters) {
+		t.Errorf("expected counters %+v but got %+v", counters, c)
+	}
+	if n := MirroredInformationName(rm.Information[0]); n != MirroredMessagesLost {
+		t.Errorf("expected information %s but got %s", MirroredMessagesLost, n)
+	}
+	rm.Messages = append(rm.Messages, marker)
+	if c := rm.MessageTypes(); c["malformed"] != 1 {
+		t.Errorf("expected malformed message to be counted but got %+v", c)
+	}
+}
@@ -11,6 +11,19 @@ import (
 // RouteMonitor defines a structure of BMP Route Monitoring message
 type RouteMonitor struct {
 	Update *bgp.Update
+	// PDU is BGP message of Route Monitoring message as received, including BGP message header
+	PDU []byte
+	// RouteRefresh is set when the message monitors BGP Route Refresh message in place of BGP Update
+	RouteRefresh *bgp.RouteRefresh
+	// TLVs are TLVs of BMP v4 Route Monitoring message other than BGP PDU TLV, nil for BMP v3 message
+	TLVs []TLV
+	// PeerHeaders are Per-Peer Headers of peers of BMP v4 message other than the peer of the message header,
+	// the message applies to each of the peers
+	PeerHeaders []*PerPeerHeader
+	// AddPath maps NLRI types of address families of Stateless Parsing TLVs of BMP v4 message to true when their
+	// NLRI carry Path Identifier, the TLVs take precedence over ADD-PATH state of Peer Up message of the peer.
+	// AddPath is nil when the message carries no Stateless Parsing TLV.
+	AddPath map[int]bool
 }
 
 // UnmarshalBMPRouteMonitorMessage builds BMP Route Monitor object
@@ -18,29 +31,142 @@ func UnmarshalBMPRouteMonitorMessage(b []byte) (*RouteMonitor, error) {
 	if glog.V(6) {
 		glog.Infof("BMP Route Monitor Message Raw: %s length: %d", tools.MessageHex(b), len(b))
 	}
-	rm := RouteMonitor{}
-	// 16 bytes marker + 2 bytes update length + 1 byte of type
-	if len(b) < 19 {
-		return nil, fmt.Errorf("malformed route monitor message")
-	}
-	p := 0
-	// Skip 16 bytes of a marker
-	p += 16
-	// Skip 2 bytes of the update length
-	p += 2
-	// Getting update type, currently only type 2 is processed
+	// BGP Update may exceed 4096 bytes when Extended Message capability is negotiated, RFC 8654
+	l, err := bgp.MessageLength(b, bgp.BGPMaxExtendedMessageLength)
+	if err != nil {
+		return nil, fmt.Errorf("malformed route monitor message with error: %+v", err)
+	}
+	rm := RouteMonitor{PDU: b[:l]}
+	// Skip 16 bytes of a marker and 2 bytes of the update length
+	p := 18
+	// Getting update type, currently only types 2 and 5 are processed
 	t := b[p]
 	p++
 	switch t {
 	case 2:
 		// Update type
-		u, err := bgp.UnmarshalBGPUpdate(b[p:])
+		u, err := bgp.UnmarshalBGPUpdate(b[p:l])
 		if err != nil {
 			return nil, err
 		}
 		rm.Update = u
+	case bgp.RouteRefreshMessageType:
+		r, err := bgp.UnmarshalBGPRouteRefreshMessage(b[p:l])
+		if err != nil {
+			return nil, err
+		}
+		rm.RouteRefresh = r
 	default:
 	}
 
 	return &rm, nil
 }
+
+// UnmarshalBMPv4RouteMonitorMessage builds BMP Route Monitor object of BMP v4 message, the message carries
+// BGP Update PDU in BGP PDU TLV followed or preceded by TLVs applying to its NLRI
+func UnmarshalBMPv4RouteMonitorMessage(b []byte) (*RouteMonitor, error) {
+	tlvs, err := UnmarshalBMPv4TLV(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal TLVs of BMP v4 route monitor message with error: %+v", err)
+	}
+	var pdu []byte
+	var peers []*PerPeerHeader
+	var addPath map[int]bool
+	others := make([]TLV, 0, len(tlvs))
+	for _, t := range tlvs {
+		if !t.Enterprise && t.Type == BGPPDUTLV && pdu == nil {
+			pdu = t.Value
+			continue
+		}
+		if t.isPeerHeadersTLV() {
+			hs, err := t.peerHeaders()
+			if err != nil {
+				return nil, err
+			}
+			peers = append(peers, hs...)
+			continue
+		}
+		if !t.Enterprise && t.Type == StatelessParsingTLV {
+			if addPath == nil {
+				addPath = make(map[int]bool)
+			}
+			if err := t.statelessAddPath(addPath); err != nil {
+				return nil, err
+			}
+		}
+		others = append(others, t)
+	}
+	if pdu == nil {
+		return nil, fmt.Errorf("malformed BMP v4 route monitor message, BGP PDU TLV is missing")
+	}
+	rm, err := UnmarshalBMPRouteMonitorMessage(pdu)
+	if err != nil {
+		return nil, err
+	}
+	rm.TLVs = others
+	rm.PeerHeaders = peers
+	rm.AddPath = addPath
+
+	return rm, nil
+}
+
+// Split returns Route Monitoring messages of each peer of PeerHeaders, the messages share BGP PDU and TLVs of
+// the message, nil is returned when the message applies to a single peer
+func (rm *RouteMonitor) Split() []Message {
+	if len(rm.PeerHeaders) == 0 {
+		return nil
+	}
+	msgs := make([]Message, 0, len(rm.PeerHeaders))
+	for _, h := range rm.PeerHeaders {
+		m := *rm
+		m.PeerHeaders = nil
+		msgs = append(msgs, Message{PeerHeader: h, Payload: &m})
+	}
+
+	return msgs
+}
+
+// NLRITLVs returns TLVs of BMP v4 Route Monitoring message applying to NLRI of the index either directly
+// or through Group TLV listing the index. NLRI are indexed in the order they are carried by BGP Update PDU.
+func (rm *RouteMonitor) NLRITLVs(index int) []TLV {
+	var tlvs []TLV
+	groups := make(map[uint16]bool)
+	for _, t := range rm.TLVs {
+		if t.Enterprise || t.Type != GroupTLV {
+			continue
+		}
+		indexes, err := t.groupIndexes()
+		if err != nil {
+			glog.Errorf("failed to process Group TLV with error: %+v", err)
+			continue
+		}
+		for _, i := range indexes {
+			if int(i) == index {
+				groups[t.Index] = true
+				break
+			}
+		}
+	}
+	for _, t := range rm.TLVs {
+		if !t.Enterprise && (t.Type == GroupTLV || t.Type == VRFTableNameTLV || t.Type == StatelessParsingTLV) {
+			continue
+		}
+		if (t.Group && groups[t.Index]) || (!t.Group && int(t.Index) == index) {
+			tlvs = append(tlvs, t)
+		}
+	}
+
+	return tlvs
+}
+
+// GetTableName returns the name of VRF or table of VRF/Table Name TLV, routes of a message belong to the same
+// table, empty string is returned if the message carries no VRF/Table Name TLV.
+func (rm *RouteMonitor) GetTableName() string {
+	for _, t := range rm.TLVs {
+		if !t.Enterprise && t.Type == VRFTableNameTLV {
+			return string(t.Value)
+		}
+	}
+
+	return ""
+}
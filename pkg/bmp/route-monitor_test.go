@@ -0,0 +1,222 @@
+package bmp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalBMPv4RouteMonitorMessage(t *testing.T) {
+	// BGP Update with ORIGIN, empty AS_PATH and NEXT_HOP attributes and NLRI 10.1.1.0/24 and 10.1.2.0/24
+	pdu := []byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x2d, 0x02, 0x00, 0x00, 0x00, 0x0e,
+		0x40, 0x01, 0x01, 0x00, 0x40, 0x02, 0x00, 0x40, 0x03, 0x04, 0x0a, 0x00, 0x00, 0x01,
+		0x18, 0x0a, 0x01, 0x01, 0x18, 0x0a, 0x01, 0x02,
+	}
+	b := []byte{
+		// VRF/Table Name TLV
+		0x00, 0x01, 0x00, 0x03, 0x00, 0x00, 'r', 'e', 'd',
+		// Group TLV of index 1 with NLRI 0 and 1
+		0x00, 0x02, 0x00, 0x04, 0x80, 0x01, 0x00, 0x00, 0x00, 0x01,
+	}
+	b = append(b, 0x00, 0x04, 0x00, byte(len(pdu)), 0x00, 0x00)
+	b = append(b, pdu...)
+	b = append(b,
+		// TLV of type 10 applying to NLRI of group 1
+		0x00, 0x0a, 0x00, 0x01, 0x80, 0x01, 0x01,
+		// Enterprise specific TLV of type 5 and PEN 9 applying to NLRI 1
+		0x80, 0x05, 0x00, 0x06, 0x00, 0x01, 0x00, 0x00, 0x00, 0x09, 0xab, 0xcd,
+	)
+	rm, err := UnmarshalBMPv4RouteMonitorMessage(b)
+	if err != nil {
+		t.Fatalf("failed to unmarshal BMP v4 route monitor message with error: %+v", err)
+	}
+	if rm.Update == nil || len(rm.Update.NLRI) != 8 {
+		t.Fatalf("expected BGP Update with NLRI of 8 bytes but got %+v", rm.Update)
+	}
+	if !reflect.DeepEqual(rm.PDU, pdu) {
+		t.Errorf("expected PDU of BGP PDU TLV but got %x", rm.PDU)
+	}
+	if name := rm.GetTableName(); name != "red" {
+		t.Errorf("expected table name red but got %q", name)
+	}
+	group := TLV{Type: 10, Index: 1, Group: true, Value: []byte{0x01}}
+	enterprise := TLV{Type: 5, Enterprise: true, PEN: 9, Index: 1, Value: []byte{0xab, 0xcd}}
+	tests := []struct {
+		index  int
+		expect []TLV
+	}{
+		{index: 0, expect: []TLV{group}},
+		{index: 1, expect: []TLV{group, enterprise}},
+		{index: 2},
+	}
+	for _, tt := range tests {
+		if tlvs := rm.NLRITLVs(tt.index); !reflect.DeepEqual(tlvs, tt.expect) {
+			t.Errorf("expected TLVs %+v of NLRI %d but got %+v", tt.expect, tt.index, tlvs)
+		}
+	}
+	// Route Monitoring message without BGP PDU TLV
+	if _, err := UnmarshalBMPv4RouteMonitorMessage(b[:19]); err == nil {
+		t.Errorf("expected message without BGP PDU TLV to fail")
+	}
+	// TLV longer than the message
+	if _, err := UnmarshalBMPv4TLV([]byte{0x00, 0x01, 0x00, 0x08, 0x00, 0x00, 0x01}); err == nil {
+		t.Errorf("expected truncated TLV to fail")
+	}
+}
+
+func TestUnmarshalBMPRouteMonitorExtendedMessage(t *testing.T) {
+	// BGP Update of 65535 bytes with ORIGIN, empty AS_PATH and NEXT_HOP attributes, NLRI 10.0.0.0/8
+	// followed by /24 prefixes
+	pdu := []byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0x02, 0x00, 0x00, 0x00, 0x0e,
+		0x40, 0x01, 0x01, 0x00, 0x40, 0x02, 0x00, 0x40, 0x03, 0x04, 0x0a, 0x00, 0x00, 0x01,
+		0x08, 0x0a,
+	}
+	for i := 0; len(pdu) < 65535; i++ {
+		pdu = append(pdu, 0x18, 0x0a, byte(i>>8), byte(i))
+	}
+	rm, err := UnmarshalBMPRouteMonitorMessage(pdu)
+	if err != nil {
+		t.Fatalf("failed to unmarshal route monitor message with error: %+v", err)
+	}
+	if rm.Update == nil || len(rm.Update.NLRI) != 65535-37 {
+		t.Fatalf("expected BGP Update with NLRI of %d bytes", 65535-37)
+	}
+	if len(rm.PDU) != 65535 {
+		t.Errorf("expected PDU of 65535 bytes but got %d", len(rm.PDU))
+	}
+	// BGP message length exceeding the bytes of Route Monitoring message
+	if _, err := UnmarshalBMPRouteMonitorMessage(pdu[:4096]); err == nil {
+		t.Errorf("expected truncated BGP Update to fail")
+	}
+}
+
+func TestUnmarshalBMPv4RouteMonitorPeerHeaders(t *testing.T) {
+	// BGP Update with ORIGIN, empty AS_PATH and NEXT_HOP attributes and NLRI 10.1.1.0/24
+	pdu := []byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x29, 0x02, 0x00, 0x00, 0x00, 0x0e,
+		0x40, 0x01, 0x01, 0x00, 0x40, 0x02, 0x00, 0x40, 0x03, 0x04, 0x0a, 0x00, 0x00, 0x01,
+		0x18, 0x0a, 0x01, 0x01,
+	}
+	peer := func(ip byte) []byte {
+		h := make([]byte, PerPeerHeaderLength)
+		copy(h[22:26], []byte{192, 168, 1, ip})
+		copy(h[26:30], []byte{0x00, 0x00, 0xfd, 0xe9})
+		copy(h[30:34], []byte{192, 168, 1, ip})
+		return h
+	}
+	headers := append(peer(2), peer(3)...)
+	b := []byte{0x00, 0x04, 0x00, byte(len(pdu)), 0x00, 0x00}
+	b = append(b, pdu...)
+	b = append(b, 0x00, 0x05, 0x00, byte(len(headers)), 0x00, 0x00)
+	b = append(b, headers...)
+	// Peer Headers TLV is disabled by default and processed as any other TLV
+	rm, err := UnmarshalBMPv4RouteMonitorMessage(b)
+	if err != nil {
+		t.Fatalf("failed to unmarshal BMP v4 route monitor message with error: %+v", err)
+	}
+	if len(rm.TLVs) != 1 || rm.PeerHeaders != nil || rm.Split() != nil {
+		t.Errorf("expected TLV of type 5 not carrying Per-Peer Headers but got %+v", rm)
+	}
+	SetPeerHeadersTLV(5)
+	defer SetPeerHeadersTLV(0)
+	rm, err = UnmarshalBMPv4RouteMonitorMessage(b)
+	if err != nil {
+		t.Fatalf("failed to unmarshal BMP v4 route monitor message with error: %+v", err)
+	}
+	if len(rm.TLVs) != 0 {
+		t.Errorf("expected no TLVs applying to NLRI but got %+v", rm.TLVs)
+	}
+	msgs := rm.Split()
+	if len(msgs) != 2 {
+		t.Fatalf("expected messages of 2 peers but got %d", len(msgs))
+	}
+	for i, ip := range []string{"192.168.1.2", "192.168.1.3"} {
+		if addr := msgs[i].PeerHeader.GetPeerAddrString(); addr != ip {
+			t.Errorf("expected peer %s of message %d but got %s", ip, i, addr)
+		}
+		m, ok := msgs[i].Payload.(*RouteMonitor)
+		if !ok || m.Update != rm.Update || m.PeerHeaders != nil {
+			t.Errorf("expected route monitor message sharing BGP Update but got %+v", msgs[i].Payload)
+		}
+	}
+	// Message of a single peer
+	if msgs := (&RouteMonitor{}).Split(); msgs != nil {
+		t.Errorf("expected no messages of a single peer but got %+v", msgs)
+	}
+	// Peer Headers TLV not carrying whole Per-Peer Headers
+	b = append(b[:len(b)-len(headers)-6], 0x00, 0x05, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00)
+	if _, err := UnmarshalBMPv4RouteMonitorMessage(b); err == nil {
+		t.Errorf("expected invalid Peer Headers TLV to fail")
+	}
+}
+
+func TestUnmarshalBMPv4RouteMonitorStatelessParsing(t *testing.T) {
+	// BGP Update with ORIGIN, empty AS_PATH and NEXT_HOP attributes and NLRI with Path Identifier 7 and 10.1.1.0/24
+	pdu := []byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x2d, 0x02, 0x00, 0x00, 0x00, 0x0e,
+		0x40, 0x01, 0x01, 0x00, 0x40, 0x02, 0x00, 0x40, 0x03, 0x04, 0x0a, 0x00, 0x00, 0x01,
+		0x00, 0x00, 0x00, 0x07, 0x18, 0x0a, 0x01, 0x01,
+	}
+	message := func(value []byte) []byte {
+		b := append([]byte{0x00, 0x03, 0x00, byte(len(value)), 0x00, 0x00}, value...)
+		b = append(b, 0x00, 0x04, 0x00, byte(len(pdu)), 0x00, 0x00)
+		return append(b, pdu...)
+	}
+	tests := []struct {
+		name   string
+		value  []byte
+		expect map[int]bool
+		fail   bool
+	}{
+		{
+			name: "add path of ipv4 unicast",
+			// AFI 1 SAFI 1 with A bit and AFI 2 SAFI 1 without it
+			value:  []byte{0x00, 0x01, 0x01, 0x80, 0x00, 0x02, 0x01, 0x00},
+			expect: map[int]bool{1: true, 2: false},
+		},
+		{
+			name: "unknown address family",
+			// AFI 3 SAFI 1 with A bit
+			value:  []byte{0x00, 0x03, 0x01, 0x80},
+			expect: map[int]bool{},
+		},
+		{
+			name:  "truncated address family",
+			value: []byte{0x00, 0x01, 0x01},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm, err := UnmarshalBMPv4RouteMonitorMessage(message(tt.value))
+			if tt.fail {
+				if err == nil {
+					t.Errorf("expected invalid Stateless Parsing TLV to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to unmarshal BMP v4 route monitor message with error: %+v", err)
+			}
+			if !reflect.DeepEqual(rm.AddPath, tt.expect) {
+				t.Errorf("expected add path %+v but got %+v", tt.expect, rm.AddPath)
+			}
+			if tlvs := rm.NLRITLVs(0); tlvs != nil {
+				t.Errorf("expected Stateless Parsing TLV not to apply to NLRI but got %+v", tlvs)
+			}
+		})
+	}
+	// Messages without Stateless Parsing TLV use ADD-PATH state of the peer
+	rm, err := UnmarshalBMPv4RouteMonitorMessage(message(nil)[6:])
+	if err != nil {
+		t.Fatalf("failed to unmarshal BMP v4 route monitor message with error: %+v", err)
+	}
+	if rm.AddPath != nil {
+		t.Errorf("expected no add path of message without Stateless Parsing TLV but got %+v", rm.AddPath)
+	}
+}
@@ -0,0 +1,102 @@
+package bmp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/tools"
+)
+
+// TerminationMessage defines BMP Termination Message per rfc7854
+type TerminationMessage struct {
+	TLV []InformationalTLV
+}
+
+// terminationReasons lists descriptions of Termination Message reason codes per rfc7854
+var terminationReasons = map[uint16]string{
+	0: "administratively closed",
+	1: "unspecified reason",
+	2: "out of resources",
+	3: "redundant connection",
+	4: "permanently administratively closed",
+}
+
+// UnmarshalTerminationMessage processes Termination Message and returns TerminationMessage object
+func UnmarshalTerminationMessage(b []byte) (*TerminationMessage, error) {
+	if glog.V(6) {
+		glog.Infof("BMP Termination Message Raw: %s", tools.MessageHex(b))
+	}
+	tm := &TerminationMessage{
+		TLV: make([]InformationalTLV, 0),
+	}
+	for i := 0; i < len(b); {
+		if i+4 > len(b) {
+			return nil, fmt.Errorf("not enough bytes to unmarshal informational tlv")
+		}
+		// Extracting TLV type 2 bytes
+		t := int16(binary.BigEndian.Uint16(b[i : i+2]))
+		// Extracting TLV length
+		l := int16(binary.BigEndian.Uint16(b[i+2 : i+4]))
+		if l < 0 || int(l) > len(b)-(i+4) {
+			return nil, fmt.Errorf("invalid tlv length %d", l)
+		}
+		v := b[i+4 : i+4+int(l)]
+		switch t {
+		case 0:
+		case 1:
+			if l != 2 {
+				return nil, fmt.Errorf("invalid length %d of reason tlv", l)
+			}
+		default:
+			return nil, fmt.Errorf("invalid tlv type, expected between 0 and 1 found %d", t)
+		}
+		tm.TLV = append(tm.TLV, InformationalTLV{
+			InformationType:   t,
+			InformationLength: l,
+			Information:       v,
+		})
+		i += 4 + int(l)
+	}
+
+	return tm, nil
+}
+
+// GetReason returns the reason code of the Termination Message, second returned value is false
+// if the message does not carry the reason
+func (tm *TerminationMessage) GetReason() (uint16, bool) {
+	for _, tlv := range tm.TLV {
+		if tlv.InformationType == 1 {
+			return binary.BigEndian.Uint16(tlv.Information), true
+		}
+	}
+
+	return 0, false
+}
+
+// GetReasonString returns the description of the reason of the Termination Message, the code is returned
+// for unknown reasons and empty string if the message does not carry the reason
+func (tm *TerminationMessage) GetReasonString() string {
+	r, ok := tm.GetReason()
+	if !ok {
+		return ""
+	}
+	if s, ok := terminationReasons[r]; ok {
+		return s
+	}
+
+	return strconv.Itoa(int(r))
+}
+
+// GetInformation returns strings carried by the Termination Message
+func (tm *TerminationMessage) GetInformation() []string {
+	var l []string
+	for _, tlv := range tm.TLV {
+		if tlv.InformationType == 0 {
+			l = append(l, string(tlv.Information))
+		}
+	}
+
+	return l
+}
@@ -0,0 +1,66 @@
+package bmp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTerminationMsg(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       []byte
+		fail        bool
+		reason      string
+		information []string
+	}{
+		{
+			name:        "reason and string",
+			input:       []byte{0x00, 0x00, 0x00, 0x04, 'b', 'y', 'e', '!', 0x00, 0x01, 0x00, 0x02, 0x00, 0x03},
+			reason:      "redundant connection",
+			information: []string{"bye!"},
+		},
+		{
+			name:   "unknown reason",
+			input:  []byte{0x00, 0x01, 0x00, 0x02, 0x00, 0x0a},
+			reason: "10",
+		},
+		{
+			name: "no tlvs",
+		},
+		{
+			name:  "invalid reason length",
+			input: []byte{0x00, 0x01, 0x00, 0x01, 0x00},
+			fail:  true,
+		},
+		{
+			name:  "invalid tlv type",
+			input: []byte{0x00, 0x02, 0x00, 0x00},
+			fail:  true,
+		},
+		{
+			name:  "truncated tlv",
+			input: []byte{0x00, 0x00, 0x00, 0x04, 'b', 'y'},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm, err := UnmarshalTerminationMessage(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("failed but supposed to succeed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if r := tm.GetReasonString(); r != tt.reason {
+				t.Errorf("expected reason %q but got %q", tt.reason, r)
+			}
+			if i := tm.GetInformation(); !reflect.DeepEqual(i, tt.information) {
+				t.Errorf("expected information %q but got %q", tt.information, i)
+			}
+		})
+	}
+}
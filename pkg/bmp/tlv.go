@@ -0,0 +1,164 @@
+package bmp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/tools"
+)
+
+// BMP versions of Common Header
+const (
+	// Version3 defines BMP version of rfc7854
+	Version3 = 3
+	// Version4 defines BMP version of draft-ietf-grow-bmp-tlv, Route Monitoring messages carry BGP PDU and
+	// optional per NLRI information in TLVs
+	Version4 = 4
+)
+
+// TLV types of BMP v4 Route Monitoring message per draft-ietf-grow-bmp-tlv
+const (
+	// VRFTableNameTLV carries the name of VRF or table of routes of the message
+	VRFTableNameTLV = 1
+	// GroupTLV carries the list of indexes of NLRI the group applies to
+	GroupTLV = 2
+	// StatelessParsingTLV carries AFI, SAFI and Add-Path capability needed to parse BGP PDU without Peer Up state
+	StatelessParsingTLV = 3
+	// BGPPDUTLV carries BGP Update PDU of the message
+	BGPPDUTLV = 4
+)
+
+// peerHeadersTLV is the type of TLV carrying Per-Peer Headers of additional peers of the message, routers
+// compressing peer state send BGP PDU received identically from several peers in one message. The TLV is not
+// defined by draft-ietf-grow-bmp-tlv, its type is configured to match the routers and 0 disables it.
+var peerHeadersTLV uint32
+
+// SetPeerHeadersTLV sets the type of TLV carrying Per-Peer Headers of additional peers of BMP v4 Route
+// Monitoring message, messages carrying the TLV are fanned out into a message per peer, 0 disables the TLV
+// and it is processed as any other TLV
+func SetPeerHeadersTLV(t uint16) {
+	atomic.StoreUint32(&peerHeadersTLV, uint32(t))
+}
+
+// isPeerHeadersTLV returns true when the TLV carries Per-Peer Headers of additional peers
+func (t *TLV) isPeerHeadersTLV() bool {
+	pt := atomic.LoadUint32(&peerHeadersTLV)
+	return pt != 0 && !t.Enterprise && uint32(t.Type) == pt
+}
+
+const (
+	// tlvEnterpriseBit is E bit of the type of enterprise specific TLV, the value starts with Private Enterprise Number
+	tlvEnterpriseBit = 0x8000
+	// tlvGroupBit is G bit of the index of TLV applying to NLRI of a group rather than a single NLRI
+	tlvGroupBit = 0x8000
+	// tlvHeaderLength is the length of Type, Length and Index of TLV
+	tlvHeaderLength = 6
+	// statelessParsingLength is the length of AFI, SAFI and Flags of an address family of Stateless Parsing TLV
+	statelessParsingLength = 4
+	// statelessAddPathBit is A bit of Flags of Stateless Parsing TLV, set when NLRI of the address family carry
+	// Path Identifier
+	statelessAddPathBit = 0x80
+)
+
+// TLV defines TLV of BMP v4 message per draft-ietf-grow-bmp-tlv
+type TLV struct {
+	Type uint16
+	// Enterprise is true for enterprise specific TLV, PEN is its Private Enterprise Number
+	Enterprise bool
+	PEN        uint32
+	// Index is the index of NLRI in BGP Update PDU, starting from 0, the TLV applies to, when Group is true,
+	// it is the index of Group TLV and the TLV applies to all NLRI of the group
+	Index uint16
+	Group bool
+	Value []byte
+}
+
+// UnmarshalBMPv4TLV builds a slice of TLVs of BMP v4 message
+func UnmarshalBMPv4TLV(b []byte) ([]TLV, error) {
+	if glog.V(6) {
+		glog.Infof("BMP v4 TLV Raw: %s", tools.MessageHex(b))
+	}
+	tlvs := make([]TLV, 0)
+	for p := 0; p < len(b); {
+		if p+tlvHeaderLength > len(b) {
+			return nil, fmt.Errorf("not enough bytes to unmarshal BMP v4 TLV")
+		}
+		t := binary.BigEndian.Uint16(b[p : p+2])
+		l := int(binary.BigEndian.Uint16(b[p+2 : p+4]))
+		i := binary.BigEndian.Uint16(b[p+4 : p+6])
+		p += tlvHeaderLength
+		if p+l > len(b) {
+			return nil, fmt.Errorf("invalid BMP v4 TLV length %d, only %d bytes remain", l, len(b)-p)
+		}
+		tlv := TLV{
+			Type:  t &^ tlvEnterpriseBit,
+			Index: i &^ tlvGroupBit,
+			Group: i&tlvGroupBit != 0,
+		}
+		v := b[p : p+l]
+		if t&tlvEnterpriseBit != 0 {
+			if l < 4 {
+				return nil, fmt.Errorf("invalid enterprise specific BMP v4 TLV length %d", l)
+			}
+			tlv.Enterprise = true
+			tlv.PEN = binary.BigEndian.Uint32(v[:4])
+			v = v[4:]
+		}
+		tlv.Value = make([]byte, len(v))
+		copy(tlv.Value, v)
+		tlvs = append(tlvs, tlv)
+		p += l
+	}
+
+	return tlvs, nil
+}
+
+// peerHeaders returns Per-Peer Headers of Peer Headers TLV
+func (t *TLV) peerHeaders() ([]*PerPeerHeader, error) {
+	if len(t.Value) == 0 || len(t.Value)%PerPeerHeaderLength != 0 {
+		return nil, fmt.Errorf("invalid Peer Headers TLV length %d", len(t.Value))
+	}
+	hs := make([]*PerPeerHeader, 0, len(t.Value)/PerPeerHeaderLength)
+	for p := 0; p < len(t.Value); p += PerPeerHeaderLength {
+		h, err := UnmarshalPerPeerHeader(t.Value[p : p+PerPeerHeaderLength])
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal Per-Peer Header of Peer Headers TLV with error: %+v", err)
+		}
+		hs = append(hs, h)
+	}
+
+	return hs, nil
+}
+
+// statelessAddPath adds NLRI types of address families of Stateless Parsing TLV to the map, NLRI types of
+// address families whose NLRI carry Path Identifier are mapped to true. The value is a list of AFI, SAFI and Flags.
+func (t *TLV) statelessAddPath(m map[int]bool) error {
+	if len(t.Value) == 0 || len(t.Value)%statelessParsingLength != 0 {
+		return fmt.Errorf("invalid Stateless Parsing TLV length %d", len(t.Value))
+	}
+	for p := 0; p < len(t.Value); p += statelessParsingLength {
+		nlriType := bgp.NLRIMessageType(binary.BigEndian.Uint16(t.Value[p:p+2]), t.Value[p+2])
+		if nlriType == 0 {
+			continue
+		}
+		m[nlriType] = t.Value[p+3]&statelessAddPathBit != 0
+	}
+
+	return nil
+}
+
+// groupIndexes returns indexes of NLRI of Group TLV
+func (t *TLV) groupIndexes() ([]uint16, error) {
+	if len(t.Value)%2 != 0 {
+		return nil, fmt.Errorf("invalid Group TLV length %d", len(t.Value))
+	}
+	indexes := make([]uint16, len(t.Value)/2)
+	for i := range indexes {
+		indexes[i] = binary.BigEndian.Uint16(t.Value[i*2:])
+	}
+
+	return indexes, nil
+}
@@ -0,0 +1,173 @@
+// Package cbor converts json messages into CBOR (RFC 8949) with the same logical structure, objects become maps
+// keyed by the json field names, json numbers become integers or floating point numbers and strings stay strings.
+package cbor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// CBOR major types
+const (
+	majorUnsigned = 0
+	majorNegative = 1
+	majorText     = 3
+	majorArray    = 4
+	majorMap      = 5
+)
+
+// CBOR simple values and floating point numbers
+const (
+	simpleFalse = 0xf4
+	simpleTrue  = 0xf5
+	simpleNull  = 0xf6
+	float32Head = 0xfa
+	float64Head = 0xfb
+)
+
+// FromJSON returns CBOR encoding of json document b, containers are encoded with definite lengths
+// and keys of maps keep the order of json object fields
+func FromJSON(b []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	out := make([]byte, 0, len(b)/2)
+	out, err := encodeValue(d, out)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid json, data after the top level value")
+	}
+
+	return out, nil
+}
+
+func encodeValue(d *json.Decoder, out []byte) ([]byte, error) {
+	t, err := d.Token()
+	if err != nil {
+		return nil, fmt.Errorf("invalid json with error: %+v", err)
+	}
+	return encodeToken(d, t, out)
+}
+
+func encodeToken(d *json.Decoder, t json.Token, out []byte) ([]byte, error) {
+	switch v := t.(type) {
+	case nil:
+		return append(out, simpleNull), nil
+	case bool:
+		if v {
+			return append(out, simpleTrue), nil
+		}
+		return append(out, simpleFalse), nil
+	case string:
+		return appendText(out, v), nil
+	case json.Number:
+		return appendNumber(out, v)
+	case json.Delim:
+		switch v {
+		case '[':
+			return encodeArray(d, out)
+		case '{':
+			return encodeMap(d, out)
+		}
+	}
+
+	return nil, fmt.Errorf("invalid json token %v", t)
+}
+
+// encodeArray encodes elements of the array following '[' into a separate buffer, as the number of elements
+// is known only after the closing ']'
+func encodeArray(d *json.Decoder, out []byte) ([]byte, error) {
+	var items []byte
+	n := 0
+	for d.More() {
+		var err error
+		if items, err = encodeValue(d, items); err != nil {
+			return nil, err
+		}
+		n++
+	}
+	// Closing ']'
+	if _, err := d.Token(); err != nil {
+		return nil, fmt.Errorf("invalid json with error: %+v", err)
+	}
+	out = appendHead(out, majorArray, uint64(n))
+
+	return append(out, items...), nil
+}
+
+func encodeMap(d *json.Decoder, out []byte) ([]byte, error) {
+	var pairs []byte
+	n := 0
+	for d.More() {
+		t, err := d.Token()
+		if err != nil {
+			return nil, fmt.Errorf("invalid json with error: %+v", err)
+		}
+		k, ok := t.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid json object key %v", t)
+		}
+		pairs = appendText(pairs, k)
+		if pairs, err = encodeValue(d, pairs); err != nil {
+			return nil, err
+		}
+		n++
+	}
+	// Closing '}'
+	if _, err := d.Token(); err != nil {
+		return nil, fmt.Errorf("invalid json with error: %+v", err)
+	}
+	out = appendHead(out, majorMap, uint64(n))
+
+	return append(out, pairs...), nil
+}
+
+// appendHead appends the initial byte of the data item of the major type with the argument in the shortest form
+func appendHead(out []byte, major byte, arg uint64) []byte {
+	m := major << 5
+	switch {
+	case arg < 24:
+		return append(out, m|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(out, m|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(out, m|25), uint16(arg))
+	case arg <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(out, m|26), uint32(arg))
+	}
+
+	return binary.BigEndian.AppendUint64(append(out, m|27), arg)
+}
+
+func appendText(out []byte, s string) []byte {
+	out = appendHead(out, majorText, uint64(len(s)))
+	return append(out, s...)
+}
+
+// appendNumber encodes integers as unsigned or negative integers and other numbers as floating point numbers,
+// single precision is used when it represents the number exactly
+func appendNumber(out []byte, n json.Number) ([]byte, error) {
+	s := n.String()
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return appendHead(out, majorUnsigned, u), nil
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		// Negative integer -1-arg
+		return appendHead(out, majorNegative, uint64(-1-i)), nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid json number %s", s)
+	}
+	if f32 := float32(f); float64(f32) == f {
+		return binary.BigEndian.AppendUint32(append(out, float32Head), math.Float32bits(f32)), nil
+	}
+
+	return binary.BigEndian.AppendUint64(append(out, float64Head), math.Float64bits(f)), nil
+}
@@ -0,0 +1,53 @@
+package cbor
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// Examples of RFC 8949 Appendix A
+func TestFromJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		expect string
+		fail   bool
+	}{
+		{name: "0", input: "0", expect: "00"},
+		{name: "23", input: "23", expect: "17"},
+		{name: "24", input: "24", expect: "1818"},
+		{name: "100", input: "100", expect: "1864"},
+		{name: "1000", input: "1000", expect: "1903e8"},
+		{name: "1000000", input: "1000000", expect: "1a000f4240"},
+		{name: "max uint64", input: "18446744073709551615", expect: "1bffffffffffffffff"},
+		{name: "-1", input: "-1", expect: "20"},
+		{name: "-1000", input: "-1000", expect: "3903e7"},
+		{name: "1.5", input: "1.5", expect: "fa3fc00000"},
+		{name: "1.1", input: "1.1", expect: "fb3ff199999999999a"},
+		{name: "false", input: "false", expect: "f4"},
+		{name: "true", input: "true", expect: "f5"},
+		{name: "null", input: "null", expect: "f6"},
+		{name: "empty string", input: `""`, expect: "60"},
+		{name: "string", input: `"IETF"`, expect: "6449455446"},
+		{name: "empty array", input: "[]", expect: "80"},
+		{name: "nested array", input: "[1,[2,3],[4,5]]", expect: "8301820203820405"},
+		{name: "map", input: `{"a":1,"b":[2,3]}`, expect: "a26161016162820203"},
+		{name: "array of map", input: `["a",{"b":"c"}]`, expect: "826161a161626163"},
+		{name: "invalid json", input: `{"a":}`, fail: true},
+		{name: "trailing data", input: "1 2", fail: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := FromJSON([]byte(tt.input))
+			if err != nil && !tt.fail {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err == nil && tt.fail {
+				t.Fatalf("expected error but succeeded")
+			}
+			if got := hex.EncodeToString(b); got != tt.expect {
+				t.Errorf("expected %s but got %s", tt.expect, got)
+			}
+		})
+	}
+}
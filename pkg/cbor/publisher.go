@@ -0,0 +1,26 @@
+package cbor
+
+import (
+	"fmt"
+
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// publisher converts json messages into CBOR before they are passed to the publisher
+type publisher struct {
+	pub.Publisher
+}
+
+func (p *publisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	b, err := FromJSON(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode a message of type %d into CBOR with error: %+v", msgType, err)
+	}
+
+	return p.Publisher.PublishMessage(msgType, msgHash, b)
+}
+
+// NewPublisher returns a publisher passing messages encoded in CBOR to publisher p
+func NewPublisher(p pub.Publisher) pub.Publisher {
+	return &publisher{Publisher: p}
+}
@@ -0,0 +1,116 @@
+// Package churn decodes announcements and withdrawals of prefixes and state changes of peers from published
+// messages and counts them. Reports, route statistics and churn summaries share its decoding and counters.
+package churn
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// routeMessageTypes lists types of unicast and l3vpn prefix messages counted per prefix
+var routeMessageTypes = map[int]bool{
+	bmp.UnicastPrefixMsg:   true,
+	bmp.UnicastPrefixV4Msg: true,
+	bmp.UnicastPrefixV6Msg: true,
+	bmp.L3VPNMsg:           true,
+	bmp.L3VPNV4Msg:         true,
+	bmp.L3VPNV6Msg:         true,
+}
+
+// IsRoute returns true when messages of the type carry unicast or l3vpn prefixes
+func IsRoute(msgType int) bool {
+	return routeMessageTypes[msgType]
+}
+
+// Prefix carries fields of prefix messages used to count their churn
+type Prefix struct {
+	Action     string `json:"action"`
+	RouterIP   string `json:"router_ip"`
+	RouterHash string `json:"router_hash"`
+	PeerIP     string `json:"peer_ip"`
+	PeerHash   string `json:"peer_hash"`
+	PeerASN    uint32 `json:"peer_asn"`
+	PeerRD     string `json:"peer_rd"`
+	VPNRD      string `json:"vpn_rd"`
+	Prefix     string `json:"prefix"`
+	PrefixLen  int32  `json:"prefix_len"`
+	PathID     int32  `json:"path_id"`
+	IsEOR      bool   `json:"is_eor"`
+}
+
+// Withdrawn returns true when the message withdraws the prefix
+func (p *Prefix) Withdrawn() bool {
+	return p.Action == "del"
+}
+
+// Route returns true when the message announces or withdraws a prefix, End-of-RIB markers do not
+func (p *Prefix) Route() bool {
+	return !p.IsEOR && p.Prefix != ""
+}
+
+// Name returns the prefix with its length
+func (p *Prefix) Name() string {
+	return p.Prefix + "/" + strconv.Itoa(int(p.PrefixLen))
+}
+
+// Peer carries fields of peer messages used to count state changes of peers
+type Peer struct {
+	Action    string `json:"action"`
+	RouterIP  string `json:"router_ip"`
+	RemoteIP  string `json:"remote_ip"`
+	RemoteASN uint32 `json:"remote_asn"`
+	PeerRD    string `json:"peer_rd"`
+}
+
+// Up returns true for Peer Up messages, which carry action "add", Peer Down messages carry action "down"
+func (p *Peer) Up() bool {
+	return p.Action == "add"
+}
+
+// DecodePrefix decodes fields of the prefix message
+func DecodePrefix(msg []byte) (*Prefix, error) {
+	p := &Prefix{}
+	if err := json.Unmarshal(msg, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// DecodePeer decodes fields of the peer message
+func DecodePeer(msg []byte) (*Peer, error) {
+	p := &Peer{}
+	if err := json.Unmarshal(msg, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Counter counts announcements and withdrawals of prefixes
+type Counter struct {
+	Announcements uint64
+	Withdrawals   uint64
+}
+
+// Count counts the announcement or the withdrawal of the prefix
+func (c *Counter) Count(p *Prefix) {
+	if p.Withdrawn() {
+		c.Withdrawals++
+		return
+	}
+	c.Announcements++
+}
+
+// Add adds counters of o
+func (c *Counter) Add(o Counter) {
+	c.Announcements += o.Announcements
+	c.Withdrawals += o.Withdrawals
+}
+
+// Changes returns the number of announcements and withdrawals
+func (c Counter) Changes() uint64 {
+	return c.Announcements + c.Withdrawals
+}
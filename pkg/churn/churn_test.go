@@ -0,0 +1,86 @@
+package churn
+
+import (
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestCounter(t *testing.T) {
+	tests := []struct {
+		name    string
+		msgs    []string
+		routes  int
+		counter Counter
+	}{
+		{
+			name: "announcements and withdrawals",
+			msgs: []string{
+				`{"action":"add","prefix":"10.1.0.0","prefix_len":16}`,
+				`{"action":"del","prefix":"10.1.0.0","prefix_len":16}`,
+				`{"action":"add","prefix":"10.2.0.0","prefix_len":16}`,
+			},
+			routes:  3,
+			counter: Counter{Announcements: 2, Withdrawals: 1},
+		},
+		{
+			name: "end of rib and messages without prefix",
+			msgs: []string{
+				`{"action":"add","is_eor":true}`,
+				`{"action":"add","prefix":"10.1.0.0","prefix_len":16,"is_eor":true}`,
+				`{"action":"del"}`,
+			},
+			counter: Counter{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Counter{}
+			routes := 0
+			for _, msg := range tt.msgs {
+				p, err := DecodePrefix([]byte(msg))
+				if err != nil {
+					t.Fatalf("failed to decode prefix message with error: %+v", err)
+				}
+				if !p.Route() {
+					continue
+				}
+				routes++
+				c.Count(p)
+			}
+			if routes != tt.routes {
+				t.Errorf("expected %d routes but got %d", tt.routes, routes)
+			}
+			if c != tt.counter {
+				t.Errorf("expected counter %+v but got %+v", tt.counter, c)
+			}
+			if c.Changes() != tt.counter.Announcements+tt.counter.Withdrawals {
+				t.Errorf("expected %d changes but got %d", tt.counter.Announcements+tt.counter.Withdrawals, c.Changes())
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	p, err := DecodePrefix([]byte(`{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1","vpn_rd":"65000:1",` +
+		`"prefix":"10.1.0.0","prefix_len":16,"path_id":2}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name() != "10.1.0.0/16" || p.VPNRD != "65000:1" || p.PathID != 2 || p.Withdrawn() {
+		t.Errorf("unexpected prefix %+v", p)
+	}
+	peer, err := DecodePeer([]byte(`{"action":"down","router_ip":"10.0.0.1","remote_ip":"192.168.1.1","remote_asn":65001}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peer.Up() || peer.RemoteASN != 65001 {
+		t.Errorf("unexpected peer %+v", peer)
+	}
+	if _, err := DecodePrefix([]byte(`{`)); err == nil {
+		t.Errorf("expected error decoding truncated message")
+	}
+	if !IsRoute(bmp.L3VPNV4Msg) || IsRoute(bmp.EVPNMsg) {
+		t.Errorf("expected only unicast and l3vpn messages to carry routes")
+	}
+}
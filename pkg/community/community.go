@@ -0,0 +1,171 @@
+package community
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// listKeys is a list of json keys carrying lists of standard, extended and large communities
+var listKeys = []string{"community_list", "ext_community_list", "large_community_list"}
+
+// Dictionary defines the structure of communities dictionary file
+type Dictionary struct {
+	// Communities maps communities as they are found in published messages to their labels, for example
+	// "65000:100", "rt=65000:1" or "65000:1:2". A community can be a pattern where "*" matches any sequence
+	// of characters and "?" matches a single character, exact communities take precedence over patterns.
+	Communities map[string]string `json:"communities"`
+	patterns    []string
+}
+
+func (d *Dictionary) init() error {
+	d.patterns = make([]string, 0)
+	for c := range d.Communities {
+		if !strings.ContainsAny(c, "*?[") {
+			continue
+		}
+		if _, err := path.Match(c, ""); err != nil {
+			return fmt.Errorf("communities dictionary has invalid pattern %q with error: %+v", c, err)
+		}
+		d.patterns = append(d.patterns, c)
+	}
+	// Patterns are matched in a stable order, so a community matching several patterns always gets the same label
+	sort.Strings(d.patterns)
+
+	return nil
+}
+
+// Label returns the label of the community, false is returned if the dictionary has no label for it
+func (d *Dictionary) Label(c string) (string, bool) {
+	if l, ok := d.Communities[c]; ok {
+		return l, true
+	}
+	for _, p := range d.patterns {
+		if ok, _ := path.Match(p, c); ok {
+			return d.Communities[p], true
+		}
+	}
+
+	return "", false
+}
+
+// LoadDictionary reads communities dictionary from json file
+func LoadDictionary(file string) (*Dictionary, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	d := &Dictionary{}
+	if err := json.Unmarshal(b, d); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal communities dictionary file %s with error: %+v", file, err)
+	}
+
+	return d, nil
+}
+
+// Reloader is implemented by publishers of NewAnnotator, Reload replaces the dictionary labeling communities
+// of messages published after it returns
+type Reloader interface {
+	Reload(dict *Dictionary) error
+}
+
+type annotator struct {
+	publisher pub.Publisher
+	dict      atomic.Pointer[Dictionary]
+}
+
+func (a *annotator) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	// Messages without communities are passed unchanged
+	if !bytes.Contains(msg, []byte("community_list")) {
+		return a.publisher.PublishMessage(msgType, msgHash, msg)
+	}
+	d := json.NewDecoder(bytes.NewReader(msg))
+	// Preserving numbers as they are, decoding them into float64 would lose precision of 64 bits values
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return fmt.Errorf("failed to decode message of type %d for communities annotation with error: %+v", msgType, err)
+	}
+	b, err := json.Marshal(a.annotateValue(a.dict.Load(), v))
+	if err != nil {
+		return err
+	}
+
+	return a.publisher.PublishMessage(msgType, msgHash, b)
+}
+
+func (a *annotator) Reload(dict *Dictionary) error {
+	if err := dict.init(); err != nil {
+		return err
+	}
+	a.dict.Store(dict)
+
+	return nil
+}
+
+func (a *annotator) Stop() {
+	a.publisher.Stop()
+}
+
+func (a *annotator) annotateValue(dict *Dictionary, v interface{}) interface{} {
+	switch o := v.(type) {
+	case map[string]interface{}:
+		for k := range o {
+			o[k] = a.annotateValue(dict, o[k])
+		}
+		a.annotateObject(dict, o)
+	case []interface{}:
+		for i := range o {
+			o[i] = a.annotateValue(dict, o[i])
+		}
+	}
+
+	return v
+}
+
+// annotateObject adds communities_annotated with labels of communities found in the object,
+// each label is listed once in the order communities are found
+func (a *annotator) annotateObject(dict *Dictionary, o map[string]interface{}) {
+	labels := make([]interface{}, 0)
+	seen := make(map[string]bool)
+	for _, k := range listKeys {
+		l, ok := o[k].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, c := range l {
+			s, ok := c.(string)
+			if !ok {
+				continue
+			}
+			label, ok := dict.Label(s)
+			if !ok || seen[label] {
+				continue
+			}
+			seen[label] = true
+			labels = append(labels, label)
+		}
+	}
+	if len(labels) != 0 {
+		o["communities_annotated"] = labels
+	}
+}
+
+// NewAnnotator returns a publisher adding communities_annotated with labels of the dictionary to objects of
+// messages carrying community_list, ext_community_list or large_community_list before passing them to
+// the wrapped publisher.
+func NewAnnotator(publisher pub.Publisher, dict *Dictionary) (pub.Publisher, error) {
+	a := &annotator{publisher: publisher}
+	if err := a.Reload(dict); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
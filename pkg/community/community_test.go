@@ -0,0 +1,115 @@
+package community
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type testPublisher struct {
+	msg []byte
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.msg = msg
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+func TestAnnotator(t *testing.T) {
+	dict := &Dictionary{
+		Communities: map[string]string{
+			"65000:100":   "customer-routes",
+			"65000:666":   "blackhole",
+			"65000:2*":    "peer-routes",
+			"65000:200":   "transit-routes",
+			"rt=65000:1":  "vrf-red",
+			"65000:1:*":   "large-region",
+			"65000:1:100": "large-customer",
+		},
+	}
+	tests := []struct {
+		name   string
+		input  string
+		expect string
+	}{
+		{
+			name:   "standard communities",
+			input:  `{"peer_ip":"10.0.0.1","base_attrs":{"community_list":["65000:100","65000:201","65000:300"],"med":100}}`,
+			expect: `{"peer_ip":"10.0.0.1","base_attrs":{"community_list":["65000:100","65000:201","65000:300"],"med":100,"communities_annotated":["customer-routes","peer-routes"]}}`,
+		},
+		{
+			name:   "exact community takes precedence over pattern",
+			input:  `{"base_attrs":{"community_list":["65000:200"]}}`,
+			expect: `{"base_attrs":{"community_list":["65000:200"],"communities_annotated":["transit-routes"]}}`,
+		},
+		{
+			name:   "extended and large communities with repeated label",
+			input:  `{"base_attrs":{"ext_community_list":["rt=65000:1","rt=65000:2"],"large_community_list":["65000:1:100","65000:1:2","65000:1:3"]}}`,
+			expect: `{"base_attrs":{"ext_community_list":["rt=65000:1","rt=65000:2"],"large_community_list":["65000:1:100","65000:1:2","65000:1:3"],"communities_annotated":["vrf-red","large-customer","large-region"]}}`,
+		},
+		{
+			name:   "no labels",
+			input:  `{"base_attrs":{"community_list":["65001:1"]}}`,
+			expect: `{"base_attrs":{"community_list":["65001:1"]}}`,
+		},
+		{
+			name:   "no communities",
+			input:  `{"peer_ip":"10.0.0.1","prefix_len":24}`,
+			expect: `{"peer_ip":"10.0.0.1","prefix_len":24}`,
+		},
+	}
+	p := &testPublisher{}
+	a, err := NewAnnotator(p, dict)
+	if err != nil {
+		t.Fatalf("failed to initialize annotator with error: %+v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := a.PublishMessage(0, nil, []byte(tt.input)); err != nil {
+				t.Fatalf("failed to publish message with error: %+v", err)
+			}
+			var got, expect interface{}
+			if err := json.Unmarshal(p.msg, &got); err != nil {
+				t.Fatalf("failed to unmarshal published message with error: %+v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.expect), &expect); err != nil {
+				t.Fatalf("failed to unmarshal expected message with error: %+v", err)
+			}
+			if !reflect.DeepEqual(got, expect) {
+				t.Errorf("expected message %s but got %s", tt.expect, string(p.msg))
+			}
+		})
+	}
+}
+
+func TestInvalidPattern(t *testing.T) {
+	if _, err := NewAnnotator(&testPublisher{}, &Dictionary{Communities: map[string]string{"65000:[1": "broken"}}); err == nil {
+		t.Errorf("expected invalid pattern to fail")
+	}
+}
+
+func TestReload(t *testing.T) {
+	p := &testPublisher{}
+	a, err := NewAnnotator(p, &Dictionary{Communities: map[string]string{"65000:100": "customer-routes"}})
+	if err != nil {
+		t.Fatalf("failed to initialize annotator with error: %+v", err)
+	}
+	r, ok := a.(Reloader)
+	if !ok {
+		t.Fatalf("expected annotator to implement Reloader")
+	}
+	if err := r.Reload(&Dictionary{Communities: map[string]string{"65000:[1": "broken"}}); err == nil {
+		t.Fatalf("expected invalid pattern to fail")
+	}
+	if err := r.Reload(&Dictionary{Communities: map[string]string{"65000:100": "transit-routes"}}); err != nil {
+		t.Fatalf("failed to reload dictionary with error: %+v", err)
+	}
+	if err := a.PublishMessage(0, nil, []byte(`{"community_list":["65000:100"]}`)); err != nil {
+		t.Fatalf("failed to publish message with error: %+v", err)
+	}
+	if expect := `{"communities_annotated":["transit-routes"],"community_list":["65000:100"]}`; string(p.msg) != expect {
+		t.Errorf("expected message %s but got %s", expect, string(p.msg))
+	}
+}
@@ -0,0 +1,178 @@
+package config
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+	"gopkg.in/yaml.v3"
+)
+
+// ListenersKey is the key of the list of additional listeners of BMP sessions
+const ListenersKey = "listeners"
+
+// Config is the structured configuration of the collector read from YAML file. Keys of nested sections are
+// joined by '-' into names of flags, so "kafka: {server: kafka:9092}" sets --kafka-server, '_' in keys is
+// replaced by '-'. Lists of values are joined by ',' and listeners are listed under the "listeners" key.
+type Config struct {
+	// Values maps names of flags to their values
+	Values map[string]string
+	// Listeners are additional listeners of BMP sessions
+	Listeners []*gobmpsrv.ListenerConfig
+}
+
+// flatten adds values of the section to names of flags prefixed by the section's name
+func (c *Config) flatten(prefix string, section map[string]interface{}) error {
+	for k, v := range section {
+		name := strings.ReplaceAll(strings.ToLower(k), "_", "-")
+		if prefix != "" {
+			name = prefix + "-" + name
+		}
+		switch value := v.(type) {
+		case map[string]interface{}:
+			if err := c.flatten(name, value); err != nil {
+				return err
+			}
+		case []interface{}:
+			l := make([]string, 0, len(value))
+			for _, e := range value {
+				s, err := scalar(e)
+				if err != nil {
+					return fmt.Errorf("invalid value of %s: %+v", name, err)
+				}
+				l = append(l, s)
+			}
+			c.Values[name] = strings.Join(l, ",")
+		default:
+			s, err := scalar(value)
+			if err != nil {
+				return fmt.Errorf("invalid value of %s: %+v", name, err)
+			}
+			c.Values[name] = s
+		}
+	}
+
+	return nil
+}
+
+// scalar returns the string value of the scalar YAML value
+func scalar(v interface{}) (string, error) {
+	switch v.(type) {
+	case map[string]interface{}, map[interface{}]interface{}, []interface{}:
+		return "", fmt.Errorf("nested value %v where a scalar is expected", v)
+	case nil:
+		return "", nil
+	}
+
+	return fmt.Sprint(v), nil
+}
+
+// Apply sets flags of the flag set to values of the configuration, explicit flags set on the command line are
+// not changed, so they override the configuration file
+func (c *Config) Apply(fs *flag.FlagSet, explicit map[string]bool) error {
+	for _, name := range c.names() {
+		if fs.Lookup(name) == nil {
+			return fmt.Errorf("unknown setting %s, settings are named as flags", name)
+		}
+		if explicit[name] {
+			continue
+		}
+		if err := fs.Set(name, c.Values[name]); err != nil {
+			return fmt.Errorf("invalid value %q of setting %s with error: %+v", c.Values[name], name, err)
+		}
+	}
+
+	return nil
+}
+
+// Changed returns names of settings with different values in the configuration and the previous configuration,
+// ListenersKey is returned when listeners differ
+func (c *Config) Changed(prev *Config) []string {
+	var changed []string
+	for name, v := range c.Values {
+		if pv, ok := prev.Values[name]; !ok || pv != v {
+			changed = append(changed, name)
+		}
+	}
+	for name := range prev.Values {
+		if _, ok := c.Values[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	b, _ := json.Marshal(c.Listeners)
+	pb, _ := json.Marshal(prev.Listeners)
+	if string(b) != string(pb) {
+		changed = append(changed, ListenersKey)
+	}
+	sort.Strings(changed)
+
+	return changed
+}
+
+// names returns sorted names of settings of the configuration
+func (c *Config) names() []string {
+	names := make([]string, 0, len(c.Values))
+	for name := range c.Values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Explicit returns names of flags set on the command line, it is called before the configuration is applied,
+// as flags set by Apply are reported as set as well
+func Explicit(fs *flag.FlagSet) map[string]bool {
+	explicit := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+
+	return explicit
+}
+
+// Parse parses the YAML configuration
+func Parse(b []byte) (*Config, error) {
+	var root map[string]interface{}
+	if err := yaml.Unmarshal(b, &root); err != nil {
+		return nil, err
+	}
+	c := &Config{Values: make(map[string]string)}
+	if l, ok := root[ListenersKey]; ok {
+		delete(root, ListenersKey)
+		// Listeners are defined by the same keys as listeners of listeners file
+		j, err := json.Marshal(l)
+		if err != nil {
+			return nil, fmt.Errorf("invalid listeners: %+v", err)
+		}
+		if err := json.Unmarshal(j, &c.Listeners); err != nil {
+			return nil, fmt.Errorf("invalid listeners: %+v", err)
+		}
+		for _, l := range c.Listeners {
+			if err := l.Validate(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := c.flatten("", root); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Load reads the YAML configuration from the file
+func Load(file string) (*Config, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	c, err := Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse configuration file %s with error: %+v", file, err)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,152 @@
+package config
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		values    map[string]string
+		listeners int
+		fail      bool
+	}{
+		{
+			name: "nested sections",
+			input: `
+dump: nats
+nats:
+  server: nats://nats:4222
+  stream: gobmp
+source_port: 5000
+tcp:
+  keepalive: 15s
+  nodelay: true
+v: 3
+`,
+			values: map[string]string{
+				"dump":          "nats",
+				"nats-server":   "nats://nats:4222",
+				"nats-stream":   "gobmp",
+				"source-port":   "5000",
+				"tcp-keepalive": "15s",
+				"tcp-nodelay":   "true",
+				"v":             "3",
+			},
+		},
+		{
+			name: "lists",
+			input: `
+kafka-lag-groups: [topology, rib]
+mirror-parse:
+  - open
+  - update
+`,
+			values: map[string]string{"kafka-lag-groups": "topology,rib", "mirror-parse": "open,update"},
+		},
+		{
+			name: "listeners",
+			input: `
+listeners:
+  - name: oob-east
+    address: 10.1.0.5:5000
+    allowed_prefixes: [10.1.0.0/16]
+    topic_prefix: east.
+  - name: oob-west
+    address: "[2001:db8::5]:5000"
+`,
+			values:    map[string]string{},
+			listeners: 2,
+		},
+		{
+			name: "invalid listener",
+			input: `
+listeners:
+  - name: default
+    address: 10.1.0.5:5000
+`,
+			fail: true,
+		},
+		{
+			name: "nested list",
+			input: `
+kafka-lag-groups: [[topology]]
+`,
+			fail: true,
+		},
+		{
+			name:  "invalid yaml",
+			input: "dump: [nats",
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse([]byte(tt.input))
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("supposed to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if !reflect.DeepEqual(c.Values, tt.values) {
+				t.Errorf("expected values %v but got %v", tt.values, c.Values)
+			}
+			if len(c.Listeners) != tt.listeners {
+				t.Errorf("expected %d listeners but got %d", tt.listeners, len(c.Listeners))
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	fs := flag.NewFlagSet("gobmp", flag.ContinueOnError)
+	dump := fs.String("dump", "", "")
+	port := fs.Int("source-port", 5000, "")
+	server := fs.String("kafka-server", "", "")
+	fs.Int("parser-workers", 0, "")
+	if err := fs.Parse([]string{"--source-port=5001"}); err != nil {
+		t.Fatalf("failed to parse flags with error: %+v", err)
+	}
+	explicit := Explicit(fs)
+	c, err := Parse([]byte("dump: nats\nsource-port: 6000\n"))
+	if err != nil {
+		t.Fatalf("failed to parse configuration with error: %+v", err)
+	}
+	if err := c.Apply(fs, explicit); err != nil {
+		t.Fatalf("failed to apply configuration with error: %+v", err)
+	}
+	if *dump != "nats" || *port != 5001 || *server != "" {
+		t.Errorf("expected flags set on the command line to override the configuration, got dump %q port %d server %q", *dump, *port, *server)
+	}
+	if c, _ := Parse([]byte("kafka: {servers: kafka:9092}\n")); c.Apply(fs, explicit) == nil {
+		t.Errorf("expected unknown setting to fail")
+	}
+	if c, _ := Parse([]byte("parser-workers: five\n")); c.Apply(fs, explicit) == nil {
+		t.Errorf("expected invalid value to fail")
+	}
+}
+
+func TestChanged(t *testing.T) {
+	prev, err := Parse([]byte("dump: nats\nv: 3\ntransform-file: /etc/gobmp/rules.json\nlisteners: [{name: oob, address: ':5001'}]\n"))
+	if err != nil {
+		t.Fatalf("failed to parse configuration with error: %+v", err)
+	}
+	c, err := Parse([]byte("dump: nats\nv: 5\nlisteners: [{name: oob, address: ':5001', allowed_prefixes: [10.0.0.0/8]}]\n"))
+	if err != nil {
+		t.Fatalf("failed to parse configuration with error: %+v", err)
+	}
+	want := []string{ListenersKey, "transform-file", "v"}
+	if changed := c.Changed(prev); !reflect.DeepEqual(changed, want) {
+		t.Errorf("expected changed settings %v but got %v", want, changed)
+	}
+	if changed := c.Changed(c); len(changed) != 0 {
+		t.Errorf("expected no changed settings but got %v", changed)
+	}
+}
@@ -0,0 +1,497 @@
+package dedup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"sort"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+const (
+	// ModeMark tags messages of duplicate routes with "duplicate" key
+	ModeMark = "mark"
+	// ModeSuppress drops messages of duplicate routes
+	ModeSuppress = "suppress"
+)
+
+// duplicateTag is appended to messages of duplicate routes in ModeMark
+var duplicateTag = []byte(`,"duplicate":true}`)
+
+// routerKeys lists keys of route messages which differ between routers reporting the same route,
+// they are excluded from the route's attributes hash
+var routerKeys = map[string]bool{
+	"_key":               true,
+	"_id":                true,
+	"_rev":               true,
+	"action":             true,
+	"sequence":           true,
+	"hash":               true,
+	"router_hash":        true,
+	"router_ip":          true,
+	"peer_hash":          true,
+	"timestamp":          true,
+	"nexthop_unresolved": true,
+	"first_seen":         true,
+	"last_changed":       true,
+	"path_hash":          true,
+	"change":             true,
+}
+
+// medKeys and communityKeys list keys of base_attrs excluded from the route's attributes hash when MED or
+// communities are ignored, base_attr_hash is computed over all attributes and is excluded along with them,
+// communities_annotated carries labels of communities added by the communities dictionary
+var (
+	medKeys       = []string{"med", "base_attr_hash"}
+	communityKeys = []string{"community_list", "large_community_list", "communities_annotated", "base_attr_hash"}
+)
+
+// Config defines the window, the size and the attributes of deduplication
+type Config struct {
+	// Window is the period after the published route was last reported within which the same route reported
+	// by another router is a duplicate, later reports are published, 0 does not limit the period
+	Window time.Duration
+	// CacheSize is the maximum number of routes kept, routes first reported when the cache is full are published
+	// without deduplication, 0 does not limit the number of routes
+	CacheSize int
+	// IgnoreMED excludes MED from attributes of routes, so routes differing by MED only are duplicates
+	IgnoreMED bool
+	// IgnoreCommunities excludes communities and large communities from attributes of routes, extended
+	// communities, carrying route targets, are compared
+	IgnoreCommunities bool
+}
+
+type routeMsg struct {
+	Action           string `json:"action"`
+	RouterIP         string `json:"router_ip"`
+	PeerIP           string `json:"peer_ip"`
+	PeerType         uint8  `json:"peer_type"`
+	PeerRD           string `json:"peer_rd"`
+	PeerASN          uint32 `json:"peer_asn"`
+	VPNRD            string `json:"vpn_rd"`
+	Prefix           string `json:"prefix"`
+	PrefixLen        int32  `json:"prefix_len"`
+	PathID           int32  `json:"path_id"`
+	IsEOR            bool   `json:"is_eor"`
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+}
+
+type peerMsg struct {
+	Action   string `json:"action"`
+	RouterIP string `json:"router_ip"`
+	RemoteIP string `json:"remote_ip"`
+	PeerRD   string `json:"peer_rd"`
+}
+
+// routeKey identifies a route of a BGP peer regardless of the router reporting it
+type routeKey struct {
+	msgType          int
+	peerIP           string
+	peerRD           string
+	peerType         uint8
+	peerASN          uint32
+	vpnRD            string
+	prefix           string
+	prefixLen        int32
+	pathID           int32
+	isAdjRIBInPost   bool
+	isAdjRIBOutPost  bool
+	isLocRIBFiltered bool
+}
+
+type routerPeer struct {
+	routerIP string
+	peerRD   string
+	peerIP   string
+}
+
+// entry is a route reported by a router, msg stores the original message of a duplicate route, so it can be
+// published when the route reported by another router goes away
+type entry struct {
+	hash    uint64
+	primary bool
+	msg     message
+	// seen is the time the route was last reported by the router
+	seen time.Time
+}
+
+type deduplicator struct {
+	sync.Mutex
+	publisher pub.Publisher
+	mode      string
+	config    Config
+	// ignore lists keys of base_attrs excluded from attributes hash
+	ignore map[string]bool
+	// full is true when the cache is full, it is logged once until routes are removed
+	full bool
+	now  func() time.Time
+	// routes stores routers reporting a route
+	routes map[routeKey]map[string]*entry
+	// peers stores routes per peer of a router
+	peers map[routerPeer]map[routeKey]bool
+}
+
+type message struct {
+	msgType int
+	msgHash []byte
+	msg     []byte
+}
+
+func (d *deduplicator) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	var msgs []message
+	switch msgType {
+	case bmp.PeerStateChangeMsg:
+		m := &peerMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode peer message for deduplication with error: %+v", err)
+			break
+		}
+		if m.Action != "add" {
+			msgs = d.removePeer(routerPeer{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.RemoteIP})
+		}
+	case bmp.UnicastPrefixMsg, bmp.UnicastPrefixV4Msg, bmp.UnicastPrefixV6Msg, bmp.L3VPNMsg, bmp.L3VPNV4Msg, bmp.L3VPNV6Msg:
+		m := &routeMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode route message for deduplication with error: %+v", err)
+			break
+		}
+		if m.IsEOR {
+			break
+		}
+		h, err := attributesHash(msg, d.ignore)
+		if err != nil {
+			glog.Errorf("failed to hash route message for deduplication with error: %+v", err)
+			break
+		}
+		var duplicate bool
+		msgs, duplicate = d.update(m, h, message{msgType: msgType, msgHash: msgHash, msg: msg})
+		if !duplicate {
+			break
+		}
+		if d.mode == ModeSuppress {
+			return d.publish(msgs)
+		}
+		msg = tag(msg)
+	}
+	if err := d.publisher.PublishMessage(msgType, msgHash, msg); err != nil {
+		return err
+	}
+
+	return d.publish(msgs)
+}
+
+func (d *deduplicator) Stop() {
+	d.publisher.Stop()
+}
+
+func (d *deduplicator) publish(msgs []message) error {
+	for _, m := range msgs {
+		if err := d.publisher.PublishMessage(m.msgType, m.msgHash, m.msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// update records the route reported by the router and returns true if the same route with the same attributes
+// is already published for another router. Returned messages are messages of duplicate routes which became
+// primary and have to be published.
+func (d *deduplicator) update(m *routeMsg, h uint64, msg message) ([]message, bool) {
+	rk := routeKey{
+		msgType:          msg.msgType,
+		peerIP:           m.PeerIP,
+		peerRD:           m.PeerRD,
+		peerType:         m.PeerType,
+		peerASN:          m.PeerASN,
+		vpnRD:            m.VPNRD,
+		prefix:           m.Prefix,
+		prefixLen:        m.PrefixLen,
+		pathID:           m.PathID,
+		isAdjRIBInPost:   m.IsAdjRIBInPost,
+		isAdjRIBOutPost:  m.IsAdjRIBOutPost,
+		isLocRIBFiltered: m.IsLocRIBFiltered,
+	}
+	rp := routerPeer{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.PeerIP}
+	d.Lock()
+	defer d.Unlock()
+	now := d.now()
+	routers := d.routes[rk]
+	e, ok := routers[m.RouterIP]
+	if m.Action == "del" {
+		if !ok {
+			return nil, false
+		}
+		delete(d.peers[rp], rk)
+		return d.remove(rk, m.RouterIP), !e.primary
+	}
+	var msgs []message
+	// published is true when the router's route is already published as primary, updates of the route
+	// are then published even if they duplicate the route of another router
+	published := ok && e.primary
+	if ok && e.hash != h {
+		// Attributes of the route changed, the previous version is no longer reported by the router
+		msgs = d.remove(rk, m.RouterIP)
+		routers = d.routes[rk]
+		ok = false
+	}
+	if ok {
+		// Refresh of the same route, the duplicate is published when the published route is not reported
+		// by other routers within the window
+		e.seen = now
+		if !e.primary {
+			if d.published(routers, h, now) {
+				e.msg = msg
+			} else {
+				e.primary = true
+				e.msg = message{}
+			}
+		}
+		return msgs, !e.primary
+	}
+	if routers == nil {
+		if d.config.CacheSize > 0 && len(d.routes) >= d.config.CacheSize {
+			if !d.full {
+				glog.Warningf("deduplication cache is full with %d routes, new routes are published without deduplication", len(d.routes))
+				d.full = true
+			}
+			return msgs, false
+		}
+		routers = make(map[string]*entry)
+		d.routes[rk] = routers
+	}
+	if d.peers[rp] == nil {
+		d.peers[rp] = make(map[routeKey]bool)
+	}
+	d.peers[rp][rk] = true
+	e = &entry{hash: h, primary: true, seen: now}
+	if !published && d.published(routers, h, now) {
+		e.primary = false
+		e.msg = msg
+	}
+	routers[m.RouterIP] = e
+
+	return msgs, !e.primary
+}
+
+// published returns true when the route with attributes hash h is published for a router which reported it
+// within the window
+func (d *deduplicator) published(routers map[string]*entry, h uint64, now time.Time) bool {
+	for _, o := range routers {
+		if o.primary && o.hash == h && (d.config.Window == 0 || now.Sub(o.seen) <= d.config.Window) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// remove removes the route reported by the router, when the route was primary and other routers report
+// the same route, the message of one of them is returned for publishing
+func (d *deduplicator) remove(rk routeKey, router string) []message {
+	routers := d.routes[rk]
+	e := routers[router]
+	delete(routers, router)
+	if len(routers) == 0 {
+		delete(d.routes, rk)
+		d.full = false
+		return nil
+	}
+	if !e.primary {
+		return nil
+	}
+	for _, o := range routers {
+		if o.primary && o.hash == e.hash {
+			// The route is still published for another router
+			return nil
+		}
+	}
+	// Routers are sorted for the choice of the new primary route to be predictable
+	names := make([]string, 0, len(routers))
+	for name := range routers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		o := routers[name]
+		if o.primary || o.hash != e.hash {
+			continue
+		}
+		o.primary = true
+		msg := o.msg
+		o.msg = message{}
+		return []message{msg}
+	}
+
+	return nil
+}
+
+func (d *deduplicator) removePeer(rp routerPeer) []message {
+	d.Lock()
+	defer d.Unlock()
+	var msgs []message
+	for rk := range d.peers[rp] {
+		msgs = append(msgs, d.remove(rk, rp.routerIP)...)
+	}
+	delete(d.peers, rp)
+
+	return msgs
+}
+
+// EvictPeer removes routes of the peer of the router, or of all peers of the router when peerIP is empty,
+// duplicates reported by other routers are published in place of removed routes
+func (d *deduplicator) EvictPeer(routerIP, peerRD, peerIP string) int {
+	d.Lock()
+	var rps []routerPeer
+	n := 0
+	for rp, rks := range d.peers {
+		if rp.routerIP == routerIP && (peerIP == "" || rp.peerRD == peerRD && rp.peerIP == peerIP) {
+			rps = append(rps, rp)
+			n += len(rks)
+		}
+	}
+	d.Unlock()
+	var msgs []message
+	for _, rp := range rps {
+		msgs = append(msgs, d.removePeer(rp)...)
+	}
+	if err := d.publish(msgs); err != nil {
+		glog.Errorf("failed to publish duplicates of evicted routes with error: %+v", err)
+	}
+
+	return n
+}
+
+// MemoryUsage returns estimated memory used by routes stored per peer of routers, including messages of
+// duplicate routes
+func (d *deduplicator) MemoryUsage() memory.Usage {
+	d.Lock()
+	defer d.Unlock()
+	c := memory.NewCounter("dedup")
+	for rp, rks := range d.peers {
+		for rk := range rks {
+			e, ok := d.routes[rk][rp.routerIP]
+			if !ok {
+				continue
+			}
+			// The route is stored in both routes and peers maps, keys strings are shared
+			b := uint64(2*unsafe.Sizeof(rk)+unsafe.Sizeof(e)+unsafe.Sizeof(*e)) + 2*memory.MapEntryOverhead +
+				uint64(len(rk.peerIP)+len(rk.peerRD)+len(rk.vpnRD)+len(rk.prefix)+len(e.msg.msgHash)+len(e.msg.msg))
+			c.Add(rp.routerIP, rp.peerIP, b)
+		}
+	}
+
+	return c.Usage()
+}
+
+// attributesHash returns the hash of the route message keys and values excluding keys specific to
+// the reporting router and keys of base_attrs listed in ignore
+func attributesHash(msg []byte, ignore map[string]bool) (uint64, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &m); err != nil {
+		return 0, err
+	}
+	h := fnv.New64a()
+	if err := hashObject(h, m, func(k string) bool { return routerKeys[k] }, ignore); err != nil {
+		return 0, err
+	}
+
+	return h.Sum64(), nil
+}
+
+// hashObject writes sorted keys and values of the object to h skipping excluded keys, base_attrs object
+// is hashed key by key when keys of it are ignored
+func hashObject(h io.Writer, m map[string]json.RawMessage, exclude func(string) bool, ignore map[string]bool) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		if !exclude(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		if k == "base_attrs" && len(ignore) != 0 {
+			var ba map[string]json.RawMessage
+			if err := json.Unmarshal(m[k], &ba); err != nil {
+				return err
+			}
+			if err := hashObject(h, ba, func(k string) bool { return ignore[k] }, nil); err != nil {
+				return err
+			}
+		} else {
+			h.Write(m[k])
+		}
+		h.Write([]byte{0})
+	}
+
+	return nil
+}
+
+// tag returns a copy of json object msg with duplicate key
+func tag(msg []byte) []byte {
+	b := bytes.TrimRight(msg, " \t\r\n")
+	if len(b) < 2 || b[len(b)-1] != '}' {
+		return msg
+	}
+	t := make([]byte, 0, len(b)+len(duplicateTag))
+	t = append(t, b[:len(b)-1]...)
+	if len(b) == 2 {
+		// Empty object
+		return append(t, duplicateTag[1:]...)
+	}
+
+	return append(t, duplicateTag...)
+}
+
+// NewDeduplicator returns a publisher detecting unicast and l3vpn routes of the same BGP peer with the same
+// attributes reported by multiple routers, for example by redundant route reflectors. Messages of the first
+// router reporting a route are passed to publisher, messages of other routers are tagged with "duplicate" key in
+// ModeMark or dropped in ModeSuppress. When the route is withdrawn by the first router, or its attributes change,
+// the message of another router reporting the same route is published. The config limits the window and the
+// number of routes of deduplication and selects attributes compared, nil config compares all attributes without
+// limits.
+func NewDeduplicator(publisher pub.Publisher, mode string, config *Config) (pub.Publisher, error) {
+	if mode != ModeMark && mode != ModeSuppress {
+		return nil, fmt.Errorf("invalid deduplication mode %q, supported modes are %q and %q", mode, ModeMark, ModeSuppress)
+	}
+	if config == nil {
+		config = &Config{}
+	}
+	if config.Window < 0 || config.CacheSize < 0 {
+		return nil, fmt.Errorf("invalid deduplication window %s or cache size %d", config.Window, config.CacheSize)
+	}
+	ignore := make(map[string]bool)
+	if config.IgnoreMED {
+		for _, k := range medKeys {
+			ignore[k] = true
+		}
+	}
+	if config.IgnoreCommunities {
+		for _, k := range communityKeys {
+			ignore[k] = true
+		}
+	}
+
+	return &deduplicator{
+		publisher: publisher,
+		mode:      mode,
+		config:    *config,
+		ignore:    ignore,
+		now:       time.Now,
+		routes:    make(map[routeKey]map[string]*entry),
+		peers:     make(map[routerPeer]map[routeKey]bool),
+	}, nil
+}
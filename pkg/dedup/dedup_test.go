@@ -0,0 +1,262 @@
+package dedup
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	msgs []string
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	m := &struct {
+		Action    string `json:"action"`
+		RouterIP  string `json:"router_ip"`
+		Nexthop   string `json:"nexthop"`
+		Duplicate bool   `json:"duplicate"`
+	}{}
+	if err := json.Unmarshal(msg, m); err != nil {
+		return err
+	}
+	s := m.Action + " " + m.RouterIP
+	if m.Nexthop != "" {
+		s += " " + m.Nexthop
+	}
+	if m.Duplicate {
+		s += " duplicate"
+	}
+	p.msgs = append(p.msgs, s)
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+type testMsg struct {
+	msgType int
+	msg     string
+}
+
+func route(action, router, nexthop string) testMsg {
+	return testMsg{bmp.UnicastPrefixV4Msg, `{"action":"` + action + `","router_ip":"` + router + `","router_hash":"` + router +
+		`","timestamp":"` + router + `","peer_ip":"192.168.0.1","peer_asn":65001,"prefix":"10.1.0.0","prefix_len":16,"nexthop":"` + nexthop + `"}`}
+}
+
+func TestDeduplicator(t *testing.T) {
+	peerDown := testMsg{bmp.PeerStateChangeMsg, `{"action":"down","router_ip":"10.0.0.1","remote_ip":"192.168.0.1"}`}
+	tests := []struct {
+		name string
+		mode string
+		msgs []testMsg
+		want []string
+	}{
+		{
+			name: "duplicate marked",
+			mode: ModeMark,
+			msgs: []testMsg{route("add", "10.0.0.1", "10.9.9.9"), route("add", "10.0.0.2", "10.9.9.9")},
+			want: []string{"add 10.0.0.1 10.9.9.9", "add 10.0.0.2 10.9.9.9 duplicate"},
+		},
+		{
+			name: "duplicate suppressed",
+			mode: ModeSuppress,
+			msgs: []testMsg{route("add", "10.0.0.1", "10.9.9.9"), route("add", "10.0.0.2", "10.9.9.9"), route("add", "10.0.0.2", "10.9.9.9")},
+			want: []string{"add 10.0.0.1 10.9.9.9"},
+		},
+		{
+			name: "different attributes",
+			mode: ModeSuppress,
+			msgs: []testMsg{route("add", "10.0.0.1", "10.9.9.9"), route("add", "10.0.0.2", "10.8.8.8")},
+			want: []string{"add 10.0.0.1 10.9.9.9", "add 10.0.0.2 10.8.8.8"},
+		},
+		{
+			name: "duplicate withdrawn",
+			mode: ModeSuppress,
+			msgs: []testMsg{route("add", "10.0.0.1", "10.9.9.9"), route("add", "10.0.0.2", "10.9.9.9"), route("del", "10.0.0.2", "")},
+			want: []string{"add 10.0.0.1 10.9.9.9"},
+		},
+		{
+			name: "primary withdrawn",
+			mode: ModeSuppress,
+			msgs: []testMsg{route("add", "10.0.0.1", "10.9.9.9"), route("add", "10.0.0.2", "10.9.9.9"), route("del", "10.0.0.1", "")},
+			want: []string{"add 10.0.0.1 10.9.9.9", "del 10.0.0.1", "add 10.0.0.2 10.9.9.9"},
+		},
+		{
+			name: "primary changed",
+			mode: ModeMark,
+			msgs: []testMsg{route("add", "10.0.0.1", "10.9.9.9"), route("add", "10.0.0.2", "10.9.9.9"), route("add", "10.0.0.1", "10.8.8.8"), route("add", "10.0.0.2", "10.8.8.8")},
+			want: []string{"add 10.0.0.1 10.9.9.9", "add 10.0.0.2 10.9.9.9 duplicate", "add 10.0.0.1 10.8.8.8", "add 10.0.0.2 10.9.9.9", "add 10.0.0.2 10.8.8.8"},
+		},
+		{
+			name: "primary peer down",
+			mode: ModeSuppress,
+			msgs: []testMsg{route("add", "10.0.0.1", "10.9.9.9"), route("add", "10.0.0.2", "10.9.9.9"), peerDown, route("add", "10.0.0.2", "10.9.9.9")},
+			want: []string{"add 10.0.0.1 10.9.9.9", "down 10.0.0.1", "add 10.0.0.2 10.9.9.9", "add 10.0.0.2 10.9.9.9"},
+		},
+		{
+			name: "end of rib",
+			mode: ModeSuppress,
+			msgs: []testMsg{{bmp.UnicastPrefixV4Msg, `{"router_ip":"10.0.0.1","is_eor":true}`}, {bmp.UnicastPrefixV4Msg, `{"router_ip":"10.0.0.2","is_eor":true}`}},
+			want: []string{" 10.0.0.1", " 10.0.0.2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{}
+			d, err := NewDeduplicator(p, tt.mode, nil)
+			if err != nil {
+				t.Fatalf("failed to create deduplicator with error: %+v", err)
+			}
+			for _, m := range tt.msgs {
+				if err := d.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+			}
+			if !reflect.DeepEqual(p.msgs, tt.want) {
+				t.Errorf("expected messages %q but got %q", tt.want, p.msgs)
+			}
+		})
+	}
+	if _, err := NewDeduplicator(&testPublisher{}, "drop", nil); err == nil {
+		t.Errorf("expected error for invalid mode")
+	}
+}
+
+// attrsRoute returns the route of the router with base attributes
+func attrsRoute(router, prefix, attrs string) testMsg {
+	return testMsg{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"` + router + `","peer_ip":"192.168.0.1","peer_asn":65001,"prefix":"` +
+		prefix + `","prefix_len":24,"nexthop":"10.9.9.9","base_attrs":` + attrs + `}`}
+}
+
+func TestDeduplicatorConfig(t *testing.T) {
+	med10 := `{"base_attr_hash":"a","med":10,"community_list":["65001:1"]}`
+	med20 := `{"base_attr_hash":"b","med":20,"community_list":["65001:1"]}`
+	comm2 := `{"base_attr_hash":"c","med":10,"community_list":["65001:2"],"communities_annotated":["backup"]}`
+	tests := []struct {
+		name    string
+		config  Config
+		elapsed time.Duration
+		msgs    []testMsg
+		want    []string
+	}{
+		{
+			name: "med compared",
+			msgs: []testMsg{attrsRoute("10.0.0.1", "10.1.0.0", med10), attrsRoute("10.0.0.2", "10.1.0.0", med20)},
+			want: []string{"add 10.0.0.1 10.9.9.9", "add 10.0.0.2 10.9.9.9"},
+		},
+		{
+			name:   "med ignored",
+			config: Config{IgnoreMED: true},
+			msgs:   []testMsg{attrsRoute("10.0.0.1", "10.1.0.0", med10), attrsRoute("10.0.0.2", "10.1.0.0", med20)},
+			want:   []string{"add 10.0.0.1 10.9.9.9"},
+		},
+		{
+			name:   "communities ignored",
+			config: Config{IgnoreCommunities: true},
+			msgs:   []testMsg{attrsRoute("10.0.0.1", "10.1.0.0", med10), attrsRoute("10.0.0.2", "10.1.0.0", comm2)},
+			want:   []string{"add 10.0.0.1 10.9.9.9"},
+		},
+		{
+			name:   "communities ignored med compared",
+			config: Config{IgnoreCommunities: true},
+			msgs:   []testMsg{attrsRoute("10.0.0.1", "10.1.0.0", med10), attrsRoute("10.0.0.2", "10.1.0.0", med20)},
+			want:   []string{"add 10.0.0.1 10.9.9.9", "add 10.0.0.2 10.9.9.9"},
+		},
+		{
+			name:    "within window",
+			config:  Config{Window: time.Minute},
+			elapsed: 30 * time.Second,
+			msgs:    []testMsg{attrsRoute("10.0.0.1", "10.1.0.0", med10), attrsRoute("10.0.0.2", "10.1.0.0", med10)},
+			want:    []string{"add 10.0.0.1 10.9.9.9"},
+		},
+		{
+			name:    "after window",
+			config:  Config{Window: time.Minute},
+			elapsed: 2 * time.Minute,
+			msgs:    []testMsg{attrsRoute("10.0.0.1", "10.1.0.0", med10), attrsRoute("10.0.0.2", "10.1.0.0", med10)},
+			want:    []string{"add 10.0.0.1 10.9.9.9", "add 10.0.0.2 10.9.9.9"},
+		},
+		{
+			name:   "cache full",
+			config: Config{CacheSize: 1},
+			msgs: []testMsg{attrsRoute("10.0.0.1", "10.1.0.0", med10), attrsRoute("10.0.0.1", "10.2.0.0", med10),
+				attrsRoute("10.0.0.2", "10.1.0.0", med10), attrsRoute("10.0.0.2", "10.2.0.0", med10)},
+			want: []string{"add 10.0.0.1 10.9.9.9", "add 10.0.0.1 10.9.9.9", "add 10.0.0.2 10.9.9.9"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{}
+			config := tt.config
+			d, err := NewDeduplicator(p, ModeSuppress, &config)
+			if err != nil {
+				t.Fatalf("failed to create deduplicator with error: %+v", err)
+			}
+			now := time.Unix(1700000000, 0)
+			d.(*deduplicator).now = func() time.Time { return now }
+			for _, m := range tt.msgs {
+				if err := d.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+				now = now.Add(tt.elapsed)
+			}
+			if !reflect.DeepEqual(p.msgs, tt.want) {
+				t.Errorf("expected messages %q but got %q", tt.want, p.msgs)
+			}
+		})
+	}
+	for _, config := range []Config{{Window: -time.Second}, {CacheSize: -1}} {
+		if _, err := NewDeduplicator(&testPublisher{}, ModeMark, &config); err == nil {
+			t.Errorf("expected error for invalid config %+v", config)
+		}
+	}
+}
+
+func TestMemoryUsage(t *testing.T) {
+	p, err := NewDeduplicator(&testPublisher{}, ModeMark, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []testMsg{route("add", "10.0.0.1", "10.9.9.9"), route("add", "10.0.0.2", "10.9.9.9")} {
+		if err := p.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	u := p.(*deduplicator).MemoryUsage()
+	if u.Entries != 2 || len(u.Peers) != 2 {
+		t.Fatalf("expected 2 entries of 2 peers but got %+v", u)
+	}
+	// The duplicate route stores its message to be published when the first router withdraws the route
+	if u.Peers[0].RouterIP != "10.0.0.2" || u.Peers[0].Bytes <= u.Peers[1].Bytes {
+		t.Errorf("expected the duplicate route of 10.0.0.2 to use the most memory but got %+v", u.Peers)
+	}
+	if u.Bytes != u.Peers[0].Bytes+u.Peers[1].Bytes {
+		t.Errorf("expected total %d to be the sum of peers but got %d", u.Peers[0].Bytes+u.Peers[1].Bytes, u.Bytes)
+	}
+}
+
+func TestEvictPeer(t *testing.T) {
+	p := &testPublisher{}
+	d, err := NewDeduplicator(p, ModeSuppress, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []testMsg{route("add", "10.0.0.1", "10.9.9.9"), route("add", "10.0.0.2", "10.9.9.9")} {
+		if err := d.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := d.(*deduplicator).EvictPeer("10.0.0.1", "", ""); n != 1 {
+		t.Errorf("expected 1 evicted route but got %d", n)
+	}
+	want := []string{"add 10.0.0.1 10.9.9.9", "add 10.0.0.2 10.9.9.9"}
+	if !reflect.DeepEqual(p.msgs, want) {
+		t.Errorf("expected messages %q but got %q", want, p.msgs)
+	}
+	if u := d.(*deduplicator).MemoryUsage(); u.Entries != 1 {
+		t.Errorf("expected 1 entry after eviction but got %+v", u)
+	}
+}
@@ -29,6 +29,16 @@ func (p *pubwriter) PublishMessage(msgType int, msgHash []byte, msg []byte) erro
 	return nil
 }
 
+func (p *pubwriter) PublishValue(msgType int, msgHash []byte, v interface{}) error {
+	buf, err := pub.EncodeValue(v)
+	if err != nil {
+		return err
+	}
+	defer pub.ReleaseBuffer(buf)
+
+	return p.PublishMessage(msgType, msgHash, buf.Bytes())
+}
+
 func (p *pubwriter) Stop() {
 	p.output.Printf("gobmp is stopping...")
 }
@@ -0,0 +1,484 @@
+package epe
+
+import (
+	"encoding/json"
+	"net/netip"
+	"sort"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+const (
+	// SIDTypePeerNode is the type of Peer Node SID, the egress over any link to the peer
+	SIDTypePeerNode = "peer_node"
+	// SIDTypePeerAdj is the type of Peer Adjacency SID, the egress over the link to the peer
+	SIDTypePeerAdj = "peer_adj"
+	// SIDTypePeerSet is the type of Peer Set SID, the egress over any peer of the set
+	SIDTypePeerSet = "peer_set"
+)
+
+// Record defines epe_prefix message combining a unicast route of an eBGP peer with egress SIDs of the peer
+// advertised in BGP-LS
+type Record struct {
+	Action     string      `json:"action"`
+	RouterIP   string      `json:"router_ip"`
+	PeerIP     string      `json:"peer_ip"`
+	PeerASN    uint32      `json:"peer_asn,omitempty"`
+	Prefix     string      `json:"prefix"`
+	PrefixLen  int32       `json:"prefix_len"`
+	PathID     int32       `json:"path_id,omitempty"`
+	Nexthop    string      `json:"nexthop,omitempty"`
+	IsIPv4     bool        `json:"is_ipv4"`
+	EgressSIDs []EgressSID `json:"egress_sids,omitempty"`
+	Timestamp  string      `json:"timestamp"`
+}
+
+// EgressSID defines BGP Peering SID of the link to the peer, RFC 9086
+type EgressSID struct {
+	Type   string `json:"type"`
+	SID    uint32 `json:"sid"`
+	Weight uint8  `json:"weight,omitempty"`
+	// LocalIP is the address of the egress router on the link to the peer
+	LocalIP     string `json:"local_ip,omitempty"`
+	BGPRouterID string `json:"bgp_router_id,omitempty"`
+	// RouterIP is the router reporting BGP-LS Link NLRI of the link
+	RouterIP string `json:"router_ip"`
+}
+
+func init() {
+	if err := bmp.RegisterMessageSchema(bmp.EPEPrefixMsg, Record{}); err != nil {
+		panic(err)
+	}
+}
+
+type peerSID struct {
+	SID    uint32 `json:"sid"`
+	Weight uint8  `json:"weight"`
+}
+
+type lsLinkMsg struct {
+	Action        string   `json:"action"`
+	RouterIP      string   `json:"router_ip"`
+	PeerIP        string   `json:"peer_ip"`
+	PeerRD        string   `json:"peer_rd"`
+	LocalLinkIP   string   `json:"local_link_ip"`
+	RemoteLinkIP  string   `json:"remote_link_ip"`
+	RemoteNodeASN uint32   `json:"remote_node_asn"`
+	BGPRouterID   string   `json:"bgp_router_id"`
+	PeerNodeSID   *peerSID `json:"peer_node_sid"`
+	PeerAdjSID    *peerSID `json:"peer_adj_sid"`
+	PeerSetSID    *peerSID `json:"peer_set_sid"`
+}
+
+type routeMsg struct {
+	Action    string `json:"action"`
+	RouterIP  string `json:"router_ip"`
+	PeerIP    string `json:"peer_ip"`
+	PeerRD    string `json:"peer_rd"`
+	PeerType  uint8  `json:"peer_type"`
+	PeerASN   uint32 `json:"peer_asn"`
+	Prefix    string `json:"prefix"`
+	PrefixLen int32  `json:"prefix_len"`
+	PathID    int32  `json:"path_id"`
+	Nexthop   string `json:"nexthop"`
+	IsIPv4    bool   `json:"is_ipv4"`
+	IsEOR     bool   `json:"is_eor"`
+	RIBType   string `json:"rib_type"`
+}
+
+type peerMsg struct {
+	Action   string `json:"action"`
+	RouterIP string `json:"router_ip"`
+	RemoteIP string `json:"remote_ip"`
+	PeerRD   string `json:"peer_rd"`
+}
+
+// feedKey identifies BGP-LS session of a router, or BMP monitored peer of a router
+type feedKey struct {
+	routerIP string
+	peerRD   string
+	peerIP   string
+}
+
+// link stores BGP Peering SIDs of a link to the peer
+type link struct {
+	peerIP  netip.Addr
+	peerASN uint32
+	sids    []EgressSID
+}
+
+// routeKey identifies a unicast route of a BMP monitored peer
+type routeKey struct {
+	peerType  uint8
+	ribType   string
+	prefix    string
+	prefixLen int32
+	pathID    int32
+}
+
+type route struct {
+	record    Record
+	published bool
+}
+
+type joiner struct {
+	sync.Mutex
+	publisher pub.Publisher
+	// links stores links with BGP Peering SIDs per BGP-LS session, keyed by local and remote addresses of the link
+	links map[feedKey]map[[2]string]*link
+	// sids stores egress SIDs of all links to the peer address
+	sids map[netip.Addr][]*link
+	// routes stores unicast routes per peer address and BMP monitored peer of a router
+	routes map[netip.Addr]map[feedKey]map[routeKey]*route
+	now    func() time.Time
+}
+
+func (j *joiner) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	var records []*Record
+	var err error
+	switch msgType {
+	case bmp.LSLinkMsg:
+		m := &lsLinkMsg{}
+		if err = json.Unmarshal(msg, m); err == nil {
+			records = j.updateLink(m)
+		}
+	case bmp.UnicastPrefixMsg, bmp.UnicastPrefixV4Msg, bmp.UnicastPrefixV6Msg:
+		m := &routeMsg{}
+		if err = json.Unmarshal(msg, m); err == nil {
+			records = j.updateRoute(m)
+		}
+	case bmp.PeerStateChangeMsg:
+		m := &peerMsg{}
+		if err = json.Unmarshal(msg, m); err == nil && m.Action != "add" {
+			records = j.removePeer(feedKey{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.RemoteIP})
+		}
+	}
+	if err != nil {
+		glog.Errorf("failed to decode message of type %d for egress peer engineering with error: %+v", msgType, err)
+	}
+	for _, r := range records {
+		j.publishRecord(r)
+	}
+
+	return j.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+func (j *joiner) Stop() {
+	j.publisher.Stop()
+}
+
+func (j *joiner) updateLink(m *lsLinkMsg) []*Record {
+	peer, err := netip.ParseAddr(m.RemoteLinkIP)
+	if err != nil {
+		// Links without remote address are not links to BGP peers
+		return nil
+	}
+	var sids []EgressSID
+	for _, s := range []struct {
+		t   string
+		sid *peerSID
+	}{{SIDTypePeerNode, m.PeerNodeSID}, {SIDTypePeerAdj, m.PeerAdjSID}, {SIDTypePeerSet, m.PeerSetSID}} {
+		if s.sid == nil {
+			continue
+		}
+		sids = append(sids, EgressSID{
+			Type:        s.t,
+			SID:         s.sid.SID,
+			Weight:      s.sid.Weight,
+			LocalIP:     m.LocalLinkIP,
+			BGPRouterID: m.BGPRouterID,
+			RouterIP:    m.RouterIP,
+		})
+	}
+	fk := feedKey{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.PeerIP}
+	lk := [2]string{m.LocalLinkIP, m.RemoteLinkIP}
+	j.Lock()
+	defer j.Unlock()
+	if m.Action == "del" || len(sids) == 0 {
+		if _, ok := j.links[fk][lk]; !ok {
+			return nil
+		}
+		delete(j.links[fk], lk)
+		if len(j.links[fk]) == 0 {
+			delete(j.links, fk)
+		}
+	} else {
+		if l, ok := j.links[fk][lk]; ok && l.peerASN == m.RemoteNodeASN && equal(l.sids, sids) {
+			return nil
+		}
+		if j.links[fk] == nil {
+			j.links[fk] = make(map[[2]string]*link)
+		}
+		j.links[fk][lk] = &link{peerIP: peer, peerASN: m.RemoteNodeASN, sids: sids}
+	}
+
+	return j.rejoin(peer)
+}
+
+func equal(a, b []EgressSID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rejoin rebuilds egress SIDs of the peer address from links of all BGP-LS sessions and returns records of
+// routes of the peer with the new egress SIDs
+func (j *joiner) rejoin(peer netip.Addr) []*Record {
+	var links []*link
+	for _, feed := range j.links {
+		for _, l := range feed {
+			if l.peerIP == peer {
+				links = append(links, l)
+			}
+		}
+	}
+	if len(links) == 0 {
+		delete(j.sids, peer)
+	} else {
+		j.sids[peer] = links
+	}
+	var records []*Record
+	for _, routes := range j.routes[peer] {
+		for _, rt := range routes {
+			if r := j.join(rt); r != nil {
+				records = append(records, r)
+			}
+		}
+	}
+
+	return records
+}
+
+// join sets egress SIDs of the route and returns its record, add when the route has egress SIDs and del when
+// the route lost egress SIDs of its previously published record, nil is returned for routes without egress SIDs
+func (j *joiner) join(rt *route) *Record {
+	rt.record.EgressSIDs = j.egressSIDs(rt.record.PeerIP, rt.record.PeerASN)
+	switch {
+	case len(rt.record.EgressSIDs) != 0:
+		rt.published = true
+		r := rt.record
+		r.Action = "add"
+		return &r
+	case rt.published:
+		rt.published = false
+		r := rt.record
+		r.Action = "del"
+		return &r
+	}
+
+	return nil
+}
+
+func (j *joiner) egressSIDs(peerIP string, peerASN uint32) []EgressSID {
+	peer, err := netip.ParseAddr(peerIP)
+	if err != nil {
+		return nil
+	}
+	var sids []EgressSID
+	for _, l := range j.sids[peer] {
+		if l.peerASN != 0 && peerASN != 0 && l.peerASN != peerASN {
+			continue
+		}
+		sids = append(sids, l.sids...)
+	}
+	sort.SliceStable(sids, func(i, k int) bool {
+		if sids[i].RouterIP != sids[k].RouterIP {
+			return sids[i].RouterIP < sids[k].RouterIP
+		}
+		return sids[i].LocalIP < sids[k].LocalIP
+	})
+
+	return sids
+}
+
+func (j *joiner) updateRoute(m *routeMsg) []*Record {
+	if m.IsEOR || m.RIBType == bmp.RIBTypeLocRIB || m.RIBType == bmp.RIBTypeAdjRIBOutPre || m.RIBType == bmp.RIBTypeAdjRIBOutPost {
+		return nil
+	}
+	peer, err := netip.ParseAddr(m.PeerIP)
+	if err != nil {
+		return nil
+	}
+	fk := feedKey{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.PeerIP}
+	rk := routeKey{peerType: m.PeerType, ribType: m.RIBType, prefix: m.Prefix, prefixLen: m.PrefixLen, pathID: m.PathID}
+	j.Lock()
+	defer j.Unlock()
+	rt, ok := j.routes[peer][fk][rk]
+	if m.Action == "del" {
+		if !ok {
+			return nil
+		}
+		delete(j.routes[peer][fk], rk)
+		if len(j.routes[peer][fk]) == 0 {
+			delete(j.routes[peer], fk)
+		}
+		if len(j.routes[peer]) == 0 {
+			delete(j.routes, peer)
+		}
+		if !rt.published {
+			return nil
+		}
+		r := rt.record
+		r.Action = "del"
+		return []*Record{&r}
+	}
+	if !ok {
+		if j.routes[peer] == nil {
+			j.routes[peer] = make(map[feedKey]map[routeKey]*route)
+		}
+		if j.routes[peer][fk] == nil {
+			j.routes[peer][fk] = make(map[routeKey]*route)
+		}
+		rt = &route{}
+		j.routes[peer][fk][rk] = rt
+	}
+	rt.record = Record{
+		RouterIP:  m.RouterIP,
+		PeerIP:    m.PeerIP,
+		PeerASN:   m.PeerASN,
+		Prefix:    m.Prefix,
+		PrefixLen: m.PrefixLen,
+		PathID:    m.PathID,
+		Nexthop:   m.Nexthop,
+		IsIPv4:    m.IsIPv4,
+	}
+	if r := j.join(rt); r != nil {
+		return []*Record{r}
+	}
+
+	return nil
+}
+
+// removePeer removes routes of the BMP monitored peer and links of the BGP-LS session, and returns records of
+// withdrawn routes and routes which lost egress SIDs of the links
+func (j *joiner) removePeer(fk feedKey) []*Record {
+	j.Lock()
+	defer j.Unlock()
+	var records []*Record
+	if peer, err := netip.ParseAddr(fk.peerIP); err == nil {
+		for _, rt := range j.routes[peer][fk] {
+			if rt.published {
+				r := rt.record
+				r.Action = "del"
+				records = append(records, &r)
+			}
+		}
+		delete(j.routes[peer], fk)
+		if len(j.routes[peer]) == 0 {
+			delete(j.routes, peer)
+		}
+	}
+	links, ok := j.links[fk]
+	if !ok {
+		return records
+	}
+	delete(j.links, fk)
+	peers := make(map[netip.Addr]bool)
+	for _, l := range links {
+		peers[l.peerIP] = true
+	}
+	for peer := range peers {
+		records = append(records, j.rejoin(peer)...)
+	}
+
+	return records
+}
+
+// EvictPeer removes routes of BMP monitored peers and links of BGP-LS sessions of the peer of the router, or of
+// all peers of the router when peerIP is empty, records of removed routes and of routes losing egress SIDs are
+// published
+func (j *joiner) EvictPeer(routerIP, peerRD, peerIP string) int {
+	j.Lock()
+	fks := make(map[feedKey]int)
+	for fk, links := range j.links {
+		if fk.routerIP == routerIP && (peerIP == "" || fk.peerRD == peerRD && fk.peerIP == peerIP) {
+			fks[fk] += len(links)
+		}
+	}
+	for _, peers := range j.routes {
+		for fk, routes := range peers {
+			if fk.routerIP == routerIP && (peerIP == "" || fk.peerRD == peerRD && fk.peerIP == peerIP) {
+				fks[fk] += len(routes)
+			}
+		}
+	}
+	j.Unlock()
+	n := 0
+	for fk, entries := range fks {
+		for _, r := range j.removePeer(fk) {
+			j.publishRecord(r)
+		}
+		n += entries
+	}
+
+	return n
+}
+
+func (j *joiner) publishRecord(r *Record) {
+	r.Timestamp = j.now().UTC().Format(time.RFC3339)
+	b, err := json.Marshal(r)
+	if err != nil {
+		glog.Errorf("failed to marshal epe prefix record with error: %+v", err)
+		return
+	}
+	if err := j.publisher.PublishMessage(bmp.EPEPrefixMsg, []byte(r.PeerIP+r.Prefix), b); err != nil {
+		glog.Errorf("failed to publish epe prefix record with error: %+v", err)
+	}
+}
+
+// MemoryUsage returns estimated memory used by links with BGP Peering SIDs per BGP-LS session and unicast routes
+// per BMP monitored peer of routers
+func (j *joiner) MemoryUsage() memory.Usage {
+	j.Lock()
+	defer j.Unlock()
+	c := memory.NewCounter("epe")
+	for fk, links := range j.links {
+		for lk, l := range links {
+			b := uint64(unsafe.Sizeof(lk)+unsafe.Sizeof(l)+unsafe.Sizeof(*l)) + memory.MapEntryOverhead +
+				uint64(len(lk[0])+len(lk[1])) + uint64(uintptr(cap(l.sids))*unsafe.Sizeof(EgressSID{}))
+			c.Add(fk.routerIP, fk.peerIP, b)
+		}
+	}
+	for _, peers := range j.routes {
+		for fk, routes := range peers {
+			for rk, rt := range routes {
+				b := uint64(unsafe.Sizeof(rk)+unsafe.Sizeof(rt)+unsafe.Sizeof(*rt)) + memory.MapEntryOverhead +
+					uint64(len(rk.prefix)+len(rt.record.Nexthop)) + uint64(uintptr(cap(rt.record.EgressSIDs))*unsafe.Sizeof(EgressSID{}))
+				c.Add(fk.routerIP, fk.peerIP, b)
+			}
+		}
+	}
+
+	return c.Usage()
+}
+
+// NewJoiner returns a publisher joining unicast routes of BMP monitored peers with BGP Peering SIDs of links to
+// the same peers advertised in BGP-LS Link NLRI, RFC 9086. Routes of peers with egress SIDs are published as
+// epe_prefix messages, records are published again when egress SIDs of the peer change. Messages are passed
+// to publisher.
+func NewJoiner(publisher pub.Publisher) pub.Publisher {
+	return newJoiner(publisher, time.Now)
+}
+
+func newJoiner(publisher pub.Publisher, now func() time.Time) *joiner {
+	return &joiner{
+		publisher: publisher,
+		links:     make(map[feedKey]map[[2]string]*link),
+		sids:      make(map[netip.Addr][]*link),
+		routes:    make(map[netip.Addr]map[feedKey]map[routeKey]*route),
+		now:       now,
+	}
+}
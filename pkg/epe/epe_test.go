@@ -0,0 +1,114 @@
+package epe
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	records []string
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if msgType != bmp.EPEPrefixMsg {
+		return nil
+	}
+	r := &Record{}
+	if err := json.Unmarshal(msg, r); err != nil {
+		return err
+	}
+	s := r.Action + " " + r.Prefix + " " + r.PeerIP
+	for _, sid := range r.EgressSIDs {
+		s += " " + sid.Type + "=" + strconv.Itoa(int(sid.SID))
+	}
+	p.records = append(p.records, s)
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+type testMsg struct {
+	msgType int
+	msg     string
+}
+
+// epeLink is the link of egress router 10.0.0.1 to peer 192.168.0.1 of AS 65001 reported over BGP-LS session of
+// router 10.0.0.9
+func epeLink(action string) testMsg {
+	return testMsg{bmp.LSLinkMsg, `{"action":"` + action + `","router_ip":"10.0.0.9","peer_ip":"10.0.0.100","protocol_id":7,` +
+		`"local_link_ip":"192.168.0.2","remote_link_ip":"192.168.0.1","remote_node_asn":65001,"bgp_router_id":"10.0.0.1",` +
+		`"peer_node_sid":{"flags":{"v_flag":true,"l_flag":true},"weight":10,"sid":24001},"peer_adj_sid":{"flags":{"v_flag":true,"l_flag":true},"sid":24002}}`}
+}
+
+func unicast(action, prefix string, peerASN int, ribType string) testMsg {
+	return testMsg{bmp.UnicastPrefixV4Msg, `{"action":"` + action + `","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","peer_asn":` +
+		strconv.Itoa(peerASN) + `,"prefix":"` + prefix + `","prefix_len":24,"nexthop":"192.168.0.1","is_ipv4":true,"rib_type":"` + ribType + `"}`}
+}
+
+func TestJoiner(t *testing.T) {
+	peerDown := testMsg{bmp.PeerStateChangeMsg, `{"action":"del","router_ip":"10.0.0.1","remote_ip":"192.168.0.1"}`}
+	lsDown := testMsg{bmp.PeerStateChangeMsg, `{"action":"del","router_ip":"10.0.0.9","remote_ip":"10.0.0.100"}`}
+	tests := []struct {
+		name string
+		msgs []testMsg
+		want []string
+	}{
+		{
+			name: "link after route",
+			msgs: []testMsg{unicast("add", "1.1.1.0", 65001, bmp.RIBTypeAdjRIBInPre), epeLink("add"), epeLink("add")},
+			want: []string{"add 1.1.1.0 192.168.0.1 peer_node=24001 peer_adj=24002"},
+		},
+		{
+			name: "route after link",
+			msgs: []testMsg{epeLink("add"), unicast("add", "1.1.1.0", 65001, bmp.RIBTypeAdjRIBInPost)},
+			want: []string{"add 1.1.1.0 192.168.0.1 peer_node=24001 peer_adj=24002"},
+		},
+		{
+			name: "route withdrawn",
+			msgs: []testMsg{epeLink("add"), unicast("add", "1.1.1.0", 65001, ""), unicast("del", "1.1.1.0", 0, "")},
+			want: []string{"add 1.1.1.0 192.168.0.1 peer_node=24001 peer_adj=24002", "del 1.1.1.0 192.168.0.1 peer_node=24001 peer_adj=24002"},
+		},
+		{
+			name: "link withdrawn",
+			msgs: []testMsg{epeLink("add"), unicast("add", "1.1.1.0", 65001, ""), epeLink("del"), unicast("add", "1.1.1.0", 65001, "")},
+			want: []string{"add 1.1.1.0 192.168.0.1 peer_node=24001 peer_adj=24002", "del 1.1.1.0 192.168.0.1"},
+		},
+		{
+			name: "bmp peer down",
+			msgs: []testMsg{epeLink("add"), unicast("add", "1.1.1.0", 65001, ""), peerDown},
+			want: []string{"add 1.1.1.0 192.168.0.1 peer_node=24001 peer_adj=24002", "del 1.1.1.0 192.168.0.1 peer_node=24001 peer_adj=24002"},
+		},
+		{
+			name: "bgp-ls session down",
+			msgs: []testMsg{epeLink("add"), unicast("add", "1.1.1.0", 65001, ""), lsDown},
+			want: []string{"add 1.1.1.0 192.168.0.1 peer_node=24001 peer_adj=24002", "del 1.1.1.0 192.168.0.1"},
+		},
+		{
+			name: "different peer asn",
+			msgs: []testMsg{epeLink("add"), unicast("add", "1.1.1.0", 65002, "")},
+		},
+		{
+			name: "adj-rib-out route",
+			msgs: []testMsg{epeLink("add"), unicast("add", "1.1.1.0", 65001, bmp.RIBTypeAdjRIBOutPost)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{}
+			j := newJoiner(p, func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) })
+			for _, m := range tt.msgs {
+				if err := j.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+			}
+			if !reflect.DeepEqual(p.records, tt.want) {
+				t.Errorf("got records %v, want %v", p.records, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// Codes of operational problems reported by collector_event messages
+const (
+	// CodePublishFailed reports messages failed to be published to Kafka, NATS or a file
+	CodePublishFailed = "publish_failed"
+	// CodeParseError reports BMP messages failed to parse
+	CodeParseError = "parse_error"
+	// CodeNLRIDecodeError reports NLRI of MP_REACH_NLRI or MP_UNREACH_NLRI attributes failed to decode
+	CodeNLRIDecodeError = "nlri_decode_error"
+	// CodeUnsupportedAFI reports NLRI of address families without a registered codec, their routes are not published
+	CodeUnsupportedAFI = "unsupported_address_family"
+	// CodeInvalidBMPMessage reports BMP messages with invalid Common Header, the session is closed
+	CodeInvalidBMPMessage = "invalid_bmp_message"
+	// CodeJournalFailed reports a journal failed to be created or written, journaling of the session is stopped
+	CodeJournalFailed = "journal_failed"
+	// CodeStreamDropped reports messages dropped for API stream subscribers not keeping up
+	CodeStreamDropped = "stream_messages_dropped"
+	// CodeScriptFailed reports scripts failed for messages, including scripts exceeding the execution steps limit
+	CodeScriptFailed = "script_failed"
+	// CodeTransformFailed reports transformation rules failed to apply to messages
+	CodeTransformFailed = "transform_failed"
+	// CodeKafkaLag reports Kafka consumer groups with lag exceeding the threshold
+	CodeKafkaLag = "kafka_lag_exceeded"
+	// CodeKafkaStalled reports Kafka consumer groups stalled with lag
+	CodeKafkaStalled = "kafka_consumer_stalled"
+	// CodePublisherFailover reports messages switched to be published to the backup or back to the primary publisher
+	CodePublisherFailover = "publisher_failover"
+	// CodeTLSFailed reports BMP sessions failed TLS handshake or not allowed by Common Name of the router's certificate
+	CodeTLSFailed = "tls_handshake_failed"
+	// CodeConnectFailed reports connections to routers of active BMP sessions failed, the router is reconnected
+	CodeConnectFailed = "active_connect_failed"
+	// CodeSessionRejected reports connections of routers not allowed by the listener, the connection is closed
+	CodeSessionRejected = "session_rejected"
+)
+
+// Categories of operational problems
+const (
+	CategoryPublisher = "publisher"
+	CategoryParse     = "parse"
+	CategoryResource  = "resource"
+	CategoryProcess   = "processing"
+	CategorySession   = "session"
+)
+
+// Severities of operational problems
+const (
+	SeverityWarning = "warning"
+	SeverityError   = "error"
+)
+
+type definition struct {
+	category string
+	severity string
+	action   string
+}
+
+// definitions lists categories, severities and suggested actions of codes
+var definitions = map[string]definition{
+	CodePublishFailed: {CategoryPublisher, SeverityError,
+		"check connectivity to and health of the Kafka or NATS server, or free space of the dump file system"},
+	CodeParseError: {CategoryParse, SeverityError,
+		"capture the router's messages with hex dump over the admin API and report the parse error"},
+	CodeNLRIDecodeError: {CategoryParse, SeverityError,
+		"capture the router's messages with hex dump over the admin API and report the NLRI decoding error"},
+	CodeUnsupportedAFI: {CategoryParse, SeverityWarning,
+		"disable the address family in BMP configuration of the router or register an NLRI codec for it"},
+	CodeInvalidBMPMessage: {CategoryParse, SeverityError,
+		"check that the router sends BMP version 3 to the collector port and no other protocol uses the port"},
+	CodeJournalFailed: {CategoryResource, SeverityError,
+		"check free space and permissions of --journal-dir or lower --journal-retention"},
+	CodeStreamDropped: {CategoryResource, SeverityWarning,
+		"limit the stream of the subscriber by types query parameter or tenant filters, or speed up the subscriber"},
+	CodeScriptFailed: {CategoryProcess, SeverityError,
+		"fix the script or its step limit, messages are published without the script applied"},
+	CodeTransformFailed: {CategoryProcess, SeverityError,
+		"fix the transformation rule, messages are published without the rule applied"},
+	CodeKafkaLag: {CategoryResource, SeverityWarning,
+		"scale out consumers of the group or raise --kafka-lag-threshold"},
+	CodeKafkaStalled: {CategoryResource, SeverityError,
+		"check that consumers of the group are running and committing offsets"},
+	CodePublisherFailover: {CategoryPublisher, SeverityWarning,
+		"check health of the Kafka or NATS server messages were switched from, or raise --failover-latency and --failover-error-rate"},
+	CodeTLSFailed: {CategorySession, SeverityError,
+		"check the router's certificate against --tls-client-ca and Common Names allowed for the router by --tls-routers-file"},
+	CodeConnectFailed: {CategorySession, SeverityError,
+		"check that the router accepts BMP connections from the collector on the address of --active-routers"},
+	CodeSessionRejected: {CategorySession, SeverityWarning,
+		"add the router's address to allowed_prefixes of the listener of --listeners-file or connect the router to another listener"},
+}
+
+// Event defines collector_event message reporting an operational problem of the collector, occurrences of
+// the problem with the same code and router within the interval of the reporter are reported by one event
+type Event struct {
+	Code     string `json:"code"`
+	Category string `json:"category"`
+	Severity string `json:"severity"`
+	// Message describes the last occurrence of the problem
+	Message  string `json:"message"`
+	Action   string `json:"action"`
+	RouterIP string `json:"router_ip,omitempty"`
+	Count    uint64 `json:"count"`
+	// FirstSeen and LastSeen are times of the first and the last occurrence within the interval
+	FirstSeen string `json:"first_seen"`
+	LastSeen  string `json:"last_seen"`
+	Timestamp string `json:"timestamp"`
+}
+
+func init() {
+	if err := bmp.RegisterMessageSchema(bmp.CollectorEventMsg, Event{}); err != nil {
+		panic(err)
+	}
+}
+
+type eventKey struct {
+	code     string
+	routerIP string
+}
+
+// Reporter aggregates occurrences of operational problems and publishes them as collector_event messages
+type Reporter struct {
+	sync.Mutex
+	publisher pub.Publisher
+	interval  time.Duration
+	pending   map[eventKey]*Event
+	now       func() time.Time
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// Report records an occurrence of the problem identified by the code, routerIP is empty for problems not caused
+// by a router
+func (r *Reporter) Report(code, routerIP, msg string) {
+	now := r.now().UTC().Format(time.RFC3339)
+	k := eventKey{code: code, routerIP: routerIP}
+	r.Lock()
+	defer r.Unlock()
+	e, ok := r.pending[k]
+	if !ok {
+		d, ok := definitions[code]
+		if !ok {
+			d = definition{category: CategoryProcess, severity: SeverityError}
+		}
+		e = &Event{
+			Code:      code,
+			Category:  d.category,
+			Severity:  d.severity,
+			Action:    d.action,
+			RouterIP:  routerIP,
+			FirstSeen: now,
+		}
+		r.pending[k] = e
+	}
+	e.Message = msg
+	e.Count++
+	e.LastSeen = now
+}
+
+// flush returns events recorded since the previous flush ordered by the time of the first occurrence
+func (r *Reporter) flush() []*Event {
+	now := r.now().UTC().Format(time.RFC3339)
+	r.Lock()
+	defer r.Unlock()
+	l := make([]*Event, 0, len(r.pending))
+	for _, e := range r.pending {
+		e.Timestamp = now
+		l = append(l, e)
+	}
+	r.pending = make(map[eventKey]*Event)
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].FirstSeen != l[j].FirstSeen {
+			return l[i].FirstSeen < l[j].FirstSeen
+		}
+		if l[i].Code != l[j].Code {
+			return l[i].Code < l[j].Code
+		}
+		return l[i].RouterIP < l[j].RouterIP
+	})
+
+	return l
+}
+
+func (r *Reporter) publish() {
+	for _, e := range r.flush() {
+		b, err := json.Marshal(e)
+		if err != nil {
+			glog.Errorf("failed to marshal collector event with error: %+v", err)
+			continue
+		}
+		// A failure is not reported as an event, it would be published over the same failing publisher
+		if err := r.publisher.PublishMessage(bmp.CollectorEventMsg, []byte(e.Code), b); err != nil {
+			glog.Errorf("failed to publish collector event with error: %+v", err)
+		}
+	}
+}
+
+func (r *Reporter) run() {
+	defer close(r.done)
+	t := time.NewTicker(r.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			r.publish()
+		case <-r.stop:
+			r.publish()
+			return
+		}
+	}
+}
+
+// Stop publishes pending events and stops the reporter, the publisher is not stopped
+func (r *Reporter) Stop() {
+	close(r.stop)
+	<-r.done
+}
+
+// NewReporter returns a reporter publishing operational problems recorded within the interval to the publisher
+// every interval
+func NewReporter(publisher pub.Publisher, interval time.Duration) (*Reporter, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid collector events interval %s", interval)
+	}
+	r := newReporter(publisher, interval, time.Now)
+	go r.run()
+
+	return r, nil
+}
+
+func newReporter(publisher pub.Publisher, interval time.Duration, now func() time.Time) *Reporter {
+	return &Reporter{
+		publisher: publisher,
+		interval:  interval,
+		pending:   make(map[eventKey]*Event),
+		now:       now,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// defaultReporter is the reporter of problems recorded by Report
+var defaultReporter atomic.Pointer[Reporter]
+
+// SetDefault sets the reporter of problems recorded by Report, nil disables reporting
+func SetDefault(r *Reporter) {
+	defaultReporter.Store(r)
+}
+
+// Report records an occurrence of the problem identified by the code with the default reporter, the message
+// is formatted only when the default reporter is set. Problems are still expected to be logged by the caller.
+func Report(code, routerIP, format string, a ...interface{}) {
+	r := defaultReporter.Load()
+	if r == nil {
+		return
+	}
+	r.Report(code, routerIP, fmt.Sprintf(format, a...))
+}
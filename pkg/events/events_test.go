@@ -0,0 +1,112 @@
+package events
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	events []Event
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if msgType != bmp.CollectorEventMsg {
+		return nil
+	}
+	e := Event{}
+	if err := json.Unmarshal(msg, &e); err != nil {
+		return err
+	}
+	p.events = append(p.events, e)
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+func TestReporter(t *testing.T) {
+	now := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	p := &testPublisher{}
+	r := newReporter(p, 10*time.Second, func() time.Time { return now })
+	r.Report(CodeParseError, "10.0.0.1", "failed to parse route_monitor message")
+	now = now.Add(time.Second)
+	r.Report(CodePublishFailed, "", "failed to produce message")
+	r.Report(CodeParseError, "10.0.0.2", "failed to parse peer_up message")
+	now = now.Add(time.Second)
+	r.Report(CodeParseError, "10.0.0.1", "failed to parse statistics_report message")
+	now = now.Add(8 * time.Second)
+	r.publish()
+	want := []Event{
+		{
+			Code:      CodeParseError,
+			Category:  CategoryParse,
+			Severity:  SeverityError,
+			Message:   "failed to parse statistics_report message",
+			Action:    definitions[CodeParseError].action,
+			RouterIP:  "10.0.0.1",
+			Count:     2,
+			FirstSeen: "2026-10-14T00:00:00Z",
+			LastSeen:  "2026-10-14T00:00:02Z",
+			Timestamp: "2026-10-14T00:00:10Z",
+		},
+		{
+			Code:      CodeParseError,
+			Category:  CategoryParse,
+			Severity:  SeverityError,
+			Message:   "failed to parse peer_up message",
+			Action:    definitions[CodeParseError].action,
+			RouterIP:  "10.0.0.2",
+			Count:     1,
+			FirstSeen: "2026-10-14T00:00:01Z",
+			LastSeen:  "2026-10-14T00:00:01Z",
+			Timestamp: "2026-10-14T00:00:10Z",
+		},
+		{
+			Code:      CodePublishFailed,
+			Category:  CategoryPublisher,
+			Severity:  SeverityError,
+			Message:   "failed to produce message",
+			Action:    definitions[CodePublishFailed].action,
+			Count:     1,
+			FirstSeen: "2026-10-14T00:00:01Z",
+			LastSeen:  "2026-10-14T00:00:01Z",
+			Timestamp: "2026-10-14T00:00:10Z",
+		},
+	}
+	if !reflect.DeepEqual(p.events, want) {
+		t.Errorf("expected events %+v but got %+v", want, p.events)
+	}
+	// The next interval starts without events
+	p.events = nil
+	r.publish()
+	if len(p.events) != 0 {
+		t.Errorf("expected no events in the next interval but got %d", len(p.events))
+	}
+}
+
+func TestDefinitions(t *testing.T) {
+	for _, code := range []string{CodePublishFailed, CodeParseError, CodeNLRIDecodeError, CodeUnsupportedAFI, CodeInvalidBMPMessage,
+		CodeJournalFailed, CodeStreamDropped, CodeScriptFailed, CodeTransformFailed, CodeKafkaLag, CodeKafkaStalled} {
+		d, ok := definitions[code]
+		if !ok || d.category == "" || d.severity == "" || d.action == "" {
+			t.Errorf("expected category, severity and action of code %s but got %+v", code, d)
+		}
+	}
+}
+
+func TestDefaultReporter(t *testing.T) {
+	// Problems are not recorded without the default reporter
+	Report(CodeParseError, "10.0.0.1", "failed to parse %s message", "peer_up")
+	p := &testPublisher{}
+	r := newReporter(p, time.Second, time.Now)
+	SetDefault(r)
+	defer SetDefault(nil)
+	Report(CodeParseError, "10.0.0.1", "failed to parse %s message", "peer_up")
+	r.publish()
+	if len(p.events) != 1 || p.events[0].Message != "failed to parse peer_up message" {
+		t.Errorf("expected one event of the default reporter but got %+v", p.events)
+	}
+}
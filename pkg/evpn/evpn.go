@@ -130,6 +130,21 @@ func UnmarshalEVPNNLRI(b []byte) (*Route, error) {
 			if err != nil {
 				return nil, err
 			}
+		case SMETRoute:
+			n.RouteTypeSpec, err = UnmarshalEVPNSMET(b[p : p+l])
+			if err != nil {
+				return nil, err
+			}
+		case MulticastJoinSynchRoute:
+			n.RouteTypeSpec, err = UnmarshalEVPNMulticastJoinSynch(b[p : p+l])
+			if err != nil {
+				return nil, err
+			}
+		case MulticastLeaveSynchRoute:
+			n.RouteTypeSpec, err = UnmarshalEVPNMulticastLeaveSynch(b[p : p+l])
+			if err != nil {
+				return nil, err
+			}
 		default:
 			return nil, fmt.Errorf("unknown route type %d", n.RouteType)
 		}
@@ -268,3 +268,49 @@ func TestUnmarshalEVPNNLRI(t *testing.T) {
 		})
 	}
 }
+
+func TestIPPrefixOverlayIndex(t *testing.T) {
+	zeroESI, _ := MakeESI([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+	esi, _ := MakeESI([]byte{0x00, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11})
+	tests := []struct {
+		name      string
+		prefix    *IPPrefix
+		routerMAC bool
+		expect    string
+	}{
+		{
+			name:   "esi overlay index",
+			prefix: &IPPrefix{ESI: esi, GWIPAddr: []byte{0, 0, 0, 0}},
+			expect: OverlayIndexESI,
+		},
+		{
+			name:      "gateway ip overlay index",
+			prefix:    &IPPrefix{ESI: zeroESI, GWIPAddr: []byte{10, 0, 0, 1}},
+			routerMAC: true,
+			expect:    OverlayIndexGatewayIP,
+		},
+		{
+			name:      "router's mac overlay index",
+			prefix:    &IPPrefix{ESI: zeroESI, GWIPAddr: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+			routerMAC: true,
+			expect:    OverlayIndexRouterMAC,
+		},
+		{
+			name:   "no overlay index",
+			prefix: &IPPrefix{ESI: zeroESI, GWIPAddr: []byte{0, 0, 0, 0}},
+			expect: OverlayIndexNone,
+		},
+		{
+			name:   "both esi and gateway ip",
+			prefix: &IPPrefix{ESI: esi, GWIPAddr: []byte{10, 0, 0, 1}},
+			expect: OverlayIndexInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.prefix.GetOverlayIndex(tt.routerMAC); got != tt.expect {
+				t.Errorf("expected overlay index %s but got %s", tt.expect, got)
+			}
+		})
+	}
+}
@@ -6,6 +6,24 @@ import (
 	"github.com/sbezverk/gobmp/pkg/base"
 )
 
+// Overlay Index models of IP Prefix route per rfc9136
+const (
+	// OverlayIndexESI is the model of IP Prefix route with non-zero ESI resolved by Ethernet A-D per ES routes
+	OverlayIndexESI = "esi"
+	// OverlayIndexGatewayIP is the model of IP Prefix route with non-zero Gateway IP address resolved by
+	// MAC/IP Advertisement routes
+	OverlayIndexGatewayIP = "gateway_ip"
+	// OverlayIndexRouterMAC is the model of IP Prefix route with zero ESI and Gateway IP address carrying
+	// Router's MAC extended community
+	OverlayIndexRouterMAC = "router_mac"
+	// OverlayIndexNone is the model of IP Prefix route without Overlay Index, the interface-less model
+	// without Router's MAC extended community
+	OverlayIndexNone = "none"
+	// OverlayIndexInvalid is reported for IP Prefix route with both non-zero ESI and Gateway IP address,
+	// such route is treated as withdraw per rfc9136
+	OverlayIndexInvalid = "invalid"
+)
+
 // IPPrefix defines a structure of Route type 5
 // (IP Prefix route)
 type IPPrefix struct {
@@ -59,6 +77,35 @@ func (t *IPPrefix) getLabel() []*base.Label {
 	return t.Label
 }
 
+// GetOverlayIndex returns the Overlay Index model chosen by the router for IP Prefix route, routerMAC is true
+// when the route carries Router's MAC extended community
+func (t *IPPrefix) GetOverlayIndex(routerMAC bool) string {
+	esi := t.ESI != nil && !isZero(t.ESI[:])
+	gw := !isZero(t.GWIPAddr)
+	switch {
+	case esi && gw:
+		return OverlayIndexInvalid
+	case esi:
+		return OverlayIndexESI
+	case gw:
+		return OverlayIndexGatewayIP
+	case routerMAC:
+		return OverlayIndexRouterMAC
+	}
+
+	return OverlayIndexNone
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 // UnmarshalEVPNIPPrefix instantiates IP Prefix route type object
 func UnmarshalEVPNIPPrefix(b []byte, length int) (*IPPrefix, error) {
 	var err error
@@ -0,0 +1,218 @@
+package evpn
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/sbezverk/gobmp/pkg/base"
+)
+
+// Multicast route types, RFC 9251
+const (
+	// SMETRoute is Selective Multicast Ethernet Tag route type
+	SMETRoute = 6
+	// MulticastJoinSynchRoute is Multicast Membership Report Synch route type
+	MulticastJoinSynchRoute = 7
+	// MulticastLeaveSynchRoute is Multicast Leave Synch route type
+	MulticastLeaveSynchRoute = 8
+)
+
+// Flags of multicast routes, RFC 9251 section 9
+const (
+	// MulticastFlagIGMPv1 is set when IGMP version 1 is supported
+	MulticastFlagIGMPv1 = 0x01
+	// MulticastFlagIGMPv2 is set when IGMP version 2 is supported
+	MulticastFlagIGMPv2 = 0x02
+	// MulticastFlagIGMPv3 is set when IGMP version 3 is supported
+	MulticastFlagIGMPv3 = 0x04
+	// MulticastFlagExclude is set when the source of IGMPv3 report is excluded, Include when it is not set
+	MulticastFlagExclude = 0x08
+)
+
+// Multicast defines a structure of multicast route types 6, 7 and 8, Selective Multicast Ethernet Tag,
+// Multicast Membership Report Synch and Multicast Leave Synch routes. ESI is nil for route type 6,
+// MaxResponseTime is carried by route type 8 only.
+type Multicast struct {
+	RD               *base.RD
+	ESI              *ESI
+	EthTag           []byte
+	SourceLength     uint8
+	Source           []byte
+	GroupLength      uint8
+	Group            []byte
+	OriginatorLength uint8
+	Originator       []byte
+	MaxResponseTime  uint8
+	Flags            uint8
+}
+
+// GetRouteTypeSpec returns the instance of the multicast route type object
+func (t *Multicast) GetRouteTypeSpec() interface{} {
+	return t
+}
+
+func (t *Multicast) getRD() string {
+	return t.RD.String()
+}
+
+func (t *Multicast) getESI() *ESI {
+	return t.ESI
+}
+
+func (t *Multicast) getTag() []byte {
+	return t.EthTag
+}
+
+func (t *Multicast) getMAC() *MACAddress {
+	return nil
+}
+
+func (t *Multicast) getMACLength() *uint8 {
+	return nil
+}
+
+func (t *Multicast) getIPAddress() []byte {
+	return nil
+}
+
+func (t *Multicast) getIPLength() *uint8 {
+	return nil
+}
+
+func (t *Multicast) getGWAddress() []byte {
+	return nil
+}
+
+func (t *Multicast) getLabel() []*base.Label {
+	return nil
+}
+
+func multicastAddress(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+
+	return net.IP(b).String()
+}
+
+// GetSource returns multicast source address, empty string is returned for (*,G) routes
+func (t *Multicast) GetSource() string {
+	return multicastAddress(t.Source)
+}
+
+// GetGroup returns multicast group address
+func (t *Multicast) GetGroup() string {
+	return multicastAddress(t.Group)
+}
+
+// GetOriginator returns the address of the originator router of the route
+func (t *Multicast) GetOriginator() string {
+	return multicastAddress(t.Originator)
+}
+
+// GetFlags returns names of flags set in the route, IGMP versions supported and exclude for IGMPv3 Exclude mode
+func (t *Multicast) GetFlags() []string {
+	var flags []string
+	for _, f := range []struct {
+		bit  uint8
+		name string
+	}{
+		{MulticastFlagIGMPv1, "igmp_v1"},
+		{MulticastFlagIGMPv2, "igmp_v2"},
+		{MulticastFlagIGMPv3, "igmp_v3"},
+		{MulticastFlagExclude, "exclude"},
+	} {
+		if t.Flags&f.bit != 0 {
+			flags = append(flags, f.name)
+		}
+	}
+
+	return flags
+}
+
+// unmarshalMulticastAddress returns address of length in bits preceding the address, 0, 32 and 128 bits are valid
+func unmarshalMulticastAddress(b []byte, p int, name string) (uint8, []byte, int, error) {
+	if p >= len(b) {
+		return 0, nil, p, fmt.Errorf("not enough bytes to unmarshal %s length", name)
+	}
+	l := b[p]
+	p++
+	if l != 0 && l != 32 && l != 128 {
+		return 0, nil, p, fmt.Errorf("invalid %s length %d", name, l)
+	}
+	n := int(l / 8)
+	if p+n > len(b) {
+		return 0, nil, p, fmt.Errorf("not enough bytes to unmarshal %s of length %d", name, l)
+	}
+	var addr []byte
+	if n != 0 {
+		addr = make([]byte, n)
+		copy(addr, b[p:p+n])
+	}
+
+	return l, addr, p + n, nil
+}
+
+func unmarshalEVPNMulticast(b []byte, routeType uint8) (*Multicast, error) {
+	var err error
+	t := Multicast{}
+	p := 0
+	min := 8 + 4
+	if routeType != SMETRoute {
+		min += 10
+	}
+	if len(b) < min {
+		return nil, fmt.Errorf("invalid length %d of evpn route type %d", len(b), routeType)
+	}
+	if t.RD, err = base.MakeRD(b[p : p+8]); err != nil {
+		return nil, err
+	}
+	p += 8
+	if routeType != SMETRoute {
+		if t.ESI, err = MakeESI(b[p : p+10]); err != nil {
+			return nil, err
+		}
+		p += 10
+	}
+	t.EthTag = make([]byte, 4)
+	copy(t.EthTag, b[p:p+4])
+	p += 4
+	if t.SourceLength, t.Source, p, err = unmarshalMulticastAddress(b, p, "multicast source"); err != nil {
+		return nil, err
+	}
+	if t.GroupLength, t.Group, p, err = unmarshalMulticastAddress(b, p, "multicast group"); err != nil {
+		return nil, err
+	}
+	if t.OriginatorLength, t.Originator, p, err = unmarshalMulticastAddress(b, p, "originator router"); err != nil {
+		return nil, err
+	}
+	if routeType == MulticastLeaveSynchRoute {
+		// Skip 4 bytes of reserved field
+		if p+5 > len(b) {
+			return nil, fmt.Errorf("not enough bytes to unmarshal maximum response time of evpn route type %d", routeType)
+		}
+		p += 4
+		t.MaxResponseTime = b[p]
+		p++
+	}
+	if p < len(b) {
+		t.Flags = b[p]
+	}
+
+	return &t, nil
+}
+
+// UnmarshalEVPNSMET instantiates new instance of Selective Multicast Ethernet Tag route type object
+func UnmarshalEVPNSMET(b []byte) (*Multicast, error) {
+	return unmarshalEVPNMulticast(b, SMETRoute)
+}
+
+// UnmarshalEVPNMulticastJoinSynch instantiates new instance of Multicast Membership Report Synch route type object
+func UnmarshalEVPNMulticastJoinSynch(b []byte) (*Multicast, error) {
+	return unmarshalEVPNMulticast(b, MulticastJoinSynchRoute)
+}
+
+// UnmarshalEVPNMulticastLeaveSynch instantiates new instance of Multicast Leave Synch route type object
+func UnmarshalEVPNMulticastLeaveSynch(b []byte) (*Multicast, error) {
+	return unmarshalEVPNMulticast(b, MulticastLeaveSynchRoute)
+}
@@ -0,0 +1,110 @@
+package evpn
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalEVPNMulticast(t *testing.T) {
+	rd := []byte{0x00, 0x00, 0x00, 0xc8, 0x00, 0x00, 0x00, 0x32}
+	esi := []byte{0x00, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11}
+	tag := []byte{0x00, 0x00, 0x00, 0x64}
+	nlri := func(routeType byte, parts ...[]byte) []byte {
+		var v []byte
+		for _, p := range parts {
+			v = append(v, p...)
+		}
+		return append([]byte{routeType, byte(len(v))}, v...)
+	}
+	tests := []struct {
+		name          string
+		input         []byte
+		expectESI     bool
+		expectSource  string
+		expectGroup   string
+		expectOrig    string
+		expectFlags   []string
+		expectMaxResp uint8
+		fail          bool
+	}{
+		{
+			name:        "smet (*,G) route",
+			input:       nlri(SMETRoute, rd, tag, []byte{0}, []byte{32, 239, 1, 1, 1}, []byte{32, 10, 0, 0, 1}, []byte{0x03}),
+			expectGroup: "239.1.1.1",
+			expectOrig:  "10.0.0.1",
+			expectFlags: []string{"igmp_v1", "igmp_v2"},
+		},
+		{
+			name: "join synch (S,G) route",
+			input: nlri(MulticastJoinSynchRoute, rd, esi, tag, []byte{32, 192, 168, 1, 10}, []byte{32, 232, 1, 1, 1},
+				[]byte{32, 10, 0, 0, 2}, []byte{0x0c}),
+			expectESI:    true,
+			expectSource: "192.168.1.10",
+			expectGroup:  "232.1.1.1",
+			expectOrig:   "10.0.0.2",
+			expectFlags:  []string{"igmp_v3", "exclude"},
+		},
+		{
+			name: "leave synch ipv6 route",
+			input: nlri(MulticastLeaveSynchRoute, rd, esi, tag, []byte{0},
+				[]byte{128, 0xff, 0x0e, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+				[]byte{128, 0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2},
+				[]byte{0, 0, 0, 0}, []byte{10}, []byte{0x04}),
+			expectESI:     true,
+			expectGroup:   "ff0e::1",
+			expectOrig:    "2001:db8::2",
+			expectFlags:   []string{"igmp_v3"},
+			expectMaxResp: 10,
+		},
+		{
+			name:  "invalid group length",
+			input: nlri(SMETRoute, rd, tag, []byte{0}, []byte{24, 239, 1, 1}, []byte{32, 10, 0, 0, 1}),
+			fail:  true,
+		},
+		{
+			name:  "truncated originator",
+			input: nlri(SMETRoute, rd, tag, []byte{0}, []byte{32, 239, 1, 1, 1}, []byte{32, 10, 0}),
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := UnmarshalEVPNNLRI(tt.input)
+			if tt.fail {
+				if err == nil {
+					t.Fatalf("expected route to fail to unmarshal")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to unmarshal route with error: %+v", err)
+			}
+			if len(r.Route) != 1 {
+				t.Fatalf("expected 1 route but got %d", len(r.Route))
+			}
+			n := r.Route[0]
+			m, ok := n.RouteTypeSpec.(*Multicast)
+			if !ok {
+				t.Fatalf("expected multicast route but got %T", n.RouteTypeSpec)
+			}
+			if n.GetEVPNRD() != "200:50" {
+				t.Errorf("unexpected rd %s", n.GetEVPNRD())
+			}
+			if (n.GetEVPNESI() != nil) != tt.expectESI {
+				t.Errorf("unexpected esi %v", n.GetEVPNESI())
+			}
+			if !reflect.DeepEqual(n.GetEVPNTAG(), tag) {
+				t.Errorf("unexpected ethernet tag %v", n.GetEVPNTAG())
+			}
+			if m.GetSource() != tt.expectSource || m.GetGroup() != tt.expectGroup || m.GetOriginator() != tt.expectOrig {
+				t.Errorf("unexpected source %q group %q originator %q", m.GetSource(), m.GetGroup(), m.GetOriginator())
+			}
+			if !reflect.DeepEqual(m.GetFlags(), tt.expectFlags) {
+				t.Errorf("expected flags %v but got %v", tt.expectFlags, m.GetFlags())
+			}
+			if m.MaxResponseTime != tt.expectMaxResp {
+				t.Errorf("expected maximum response time %d but got %d", tt.expectMaxResp, m.MaxResponseTime)
+			}
+		})
+	}
+}
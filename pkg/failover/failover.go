@@ -0,0 +1,217 @@
+package failover
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/events"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// Indexes of publishers
+const (
+	primary = 0
+	backup  = 1
+)
+
+var names = [2]string{"primary", "backup"}
+
+// Config defines thresholds of delivery of messages above which the active publisher is degraded and messages are
+// switched to the other publisher
+type Config struct {
+	// Latency is the average delivery latency within Window above which the publisher is degraded, 0 disables
+	// the latency threshold
+	Latency time.Duration
+	// ErrorRate is the fraction, between 0 and 1, of messages failed to deliver within Window above which
+	// the publisher is degraded, 0 disables the error rate threshold
+	ErrorRate float64
+	// Window is the period deliveries are measured over before thresholds are checked, 0 selects 10 seconds
+	Window time.Duration
+	// MinMessages is the number of messages delivered within Window required to check thresholds, so a single
+	// slow message of an idle publisher does not switch messages, 0 selects 10 messages
+	MinMessages int
+	// Failback is the period after which messages are switched back from the backup to the primary publisher,
+	// 0 keeps messages on the backup publisher until it is degraded
+	Failback time.Duration
+}
+
+// deliveries defines deliveries of messages of a publisher within the window
+type deliveries struct {
+	count   int
+	failed  int
+	latency time.Duration
+}
+
+type failover struct {
+	sync.Mutex
+	publishers [2]pub.Publisher
+	config     Config
+	// active is the index of the publisher messages are published to
+	active   int32
+	stats    [2]deliveries
+	switched time.Time
+	now      func() time.Time
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func (f *failover) record(i int, latency time.Duration, err error) {
+	f.Lock()
+	defer f.Unlock()
+	s := &f.stats[i]
+	s.count++
+	s.latency += latency
+	if err != nil {
+		s.failed++
+	}
+}
+
+// publish publishes the message with the active publisher, the latency and the error of publishers delivering
+// messages synchronously are recorded here
+func (f *failover) publish(fn func(p pub.Publisher) error) error {
+	i := int(atomic.LoadInt32(&f.active))
+	p := f.publishers[i]
+	if _, ok := p.(pub.DeliveryReporter); ok {
+		return fn(p)
+	}
+	start := f.now()
+	err := fn(p)
+	f.record(i, f.now().Sub(start), err)
+
+	return err
+}
+
+func (f *failover) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	return f.publish(func(p pub.Publisher) error {
+		return p.PublishMessage(msgType, msgHash, msg)
+	})
+}
+
+// PublishValue passes the message to the active publisher, so it keeps encoding messages into its own buffers
+func (f *failover) PublishValue(msgType int, msgHash []byte, v interface{}) error {
+	return f.publish(func(p pub.Publisher) error {
+		return pub.PublishValue(p, msgType, msgHash, v)
+	})
+}
+
+// Healthy returns the error of the check of the publisher messages are published to
+func (f *failover) Healthy() error {
+	i := int(atomic.LoadInt32(&f.active))
+	if err := pub.Healthy(f.publishers[i]); err != nil {
+		return fmt.Errorf("%s publisher is not healthy with error: %+v", names[i], err)
+	}
+	return nil
+}
+
+// degraded returns the reason the deliveries cross thresholds, empty string is returned for healthy deliveries
+func (f *failover) degraded(s deliveries) string {
+	if s.count == 0 || s.count < f.config.MinMessages {
+		return ""
+	}
+	if f.config.ErrorRate > 0 {
+		if rate := float64(s.failed) / float64(s.count); rate > f.config.ErrorRate {
+			return fmt.Sprintf("%d of %d messages failed to deliver, error rate %.3f exceeds %.3f", s.failed, s.count, rate, f.config.ErrorRate)
+		}
+	}
+	if f.config.Latency > 0 {
+		if latency := s.latency / time.Duration(s.count); latency > f.config.Latency {
+			return fmt.Sprintf("average delivery latency %s of %d messages exceeds %s", latency, s.count, f.config.Latency)
+		}
+	}
+
+	return ""
+}
+
+// check checks deliveries of the active publisher within the window and switches messages to the other publisher
+// when the active one is degraded, or back to the primary publisher when it is time to fail back
+func (f *failover) check() {
+	f.Lock()
+	defer f.Unlock()
+	now := f.now()
+	active := int(atomic.LoadInt32(&f.active))
+	s := f.stats[active]
+	f.stats = [2]deliveries{}
+	reason := f.degraded(s)
+	switch {
+	case reason != "":
+	case active == backup && f.config.Failback > 0 && now.Sub(f.switched) >= f.config.Failback:
+		reason = fmt.Sprintf("failback after %s on the backup publisher", f.config.Failback)
+	default:
+		return
+	}
+	next := 1 - active
+	atomic.StoreInt32(&f.active, int32(next))
+	f.switched = now
+	glog.Warningf("messages are switched from the %s to the %s publisher, %s", names[active], names[next], reason)
+	events.Report(events.CodePublisherFailover, "", "messages are switched from the %s to the %s publisher, %s", names[active], names[next], reason)
+}
+
+func (f *failover) run() {
+	defer close(f.done)
+	t := time.NewTicker(f.config.Window)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			f.check()
+		case <-f.stop:
+			return
+		}
+	}
+}
+
+// Stop stops checking deliveries and stops both publishers
+func (f *failover) Stop() {
+	close(f.stop)
+	<-f.done
+	f.publishers[primary].Stop()
+	f.publishers[backup].Stop()
+}
+
+// NewFailover returns a publisher passing messages to the primary publisher and switching them to the backup
+// publisher when delivery latency or error rate of the primary publisher crosses thresholds of the config,
+// switches are logged and reported as publisher_failover collector events. Deliveries of publishers implementing
+// pub.DeliveryReporter are reported by the publishers, of other publishers measured by PublishMessage calls.
+func NewFailover(primaryPublisher, backupPublisher pub.Publisher, config *Config) (pub.Publisher, error) {
+	if config == nil {
+		config = &Config{}
+	}
+	if config.Latency < 0 || config.ErrorRate < 0 || config.ErrorRate > 1 || config.Window < 0 ||
+		config.MinMessages < 0 || config.Failback < 0 {
+		return nil, fmt.Errorf("invalid failover thresholds %+v", *config)
+	}
+	f := newFailover(primaryPublisher, backupPublisher, *config, time.Now)
+	go f.run()
+
+	return f, nil
+}
+
+func newFailover(primaryPublisher, backupPublisher pub.Publisher, config Config, now func() time.Time) *failover {
+	if config.Window == 0 {
+		config.Window = 10 * time.Second
+	}
+	if config.MinMessages == 0 {
+		config.MinMessages = 10
+	}
+	f := &failover{
+		publishers: [2]pub.Publisher{primaryPublisher, backupPublisher},
+		config:     config,
+		switched:   now(),
+		now:        now,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	for i, p := range f.publishers {
+		if r, ok := p.(pub.DeliveryReporter); ok {
+			i := i
+			r.ReportDeliveries(func(latency time.Duration, err error) {
+				f.record(i, latency, err)
+			})
+		}
+	}
+
+	return f
+}
@@ -0,0 +1,149 @@
+package failover
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pubtest"
+)
+
+type clock struct {
+	t time.Time
+}
+
+func (c *clock) now() time.Time {
+	return c.t
+}
+
+// slowPublisher advances the clock by delay for every published message and fails messages when err is set
+type slowPublisher struct {
+	*pubtest.Recorder
+	clock *clock
+	delay time.Duration
+	err   error
+}
+
+func (p *slowPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.clock.t = p.clock.t.Add(p.delay)
+	if p.err != nil {
+		return p.err
+	}
+
+	return p.Recorder.PublishMessage(msgType, msgHash, msg)
+}
+
+// asyncPublisher reports deliveries of messages with latency and err
+type asyncPublisher struct {
+	*pubtest.Recorder
+	latency time.Duration
+	err     error
+	report  func(time.Duration, error)
+}
+
+func (p *asyncPublisher) ReportDeliveries(f func(time.Duration, error)) {
+	p.report = f
+}
+
+func (p *asyncPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.report(p.latency, p.err)
+
+	return p.Recorder.PublishMessage(msgType, msgHash, msg)
+}
+
+func TestFailover(t *testing.T) {
+	config := Config{
+		Latency:     100 * time.Millisecond,
+		ErrorRate:   0.1,
+		MinMessages: 5,
+		Failback:    time.Minute,
+	}
+	tests := []struct {
+		name   string
+		delay  time.Duration
+		err    error
+		count  int
+		expect int
+	}{
+		{name: "healthy primary", delay: 10 * time.Millisecond, count: 10, expect: primary},
+		{name: "slow primary", delay: 200 * time.Millisecond, count: 10, expect: backup},
+		{name: "failing primary", delay: time.Millisecond, err: errors.New("broker is not available"), count: 10, expect: backup},
+		{name: "too few messages to check", delay: time.Second, count: 4, expect: primary},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &clock{t: time.Unix(1700000000, 0)}
+			p := &slowPublisher{Recorder: pubtest.NewRecorder(), clock: c, delay: tt.delay, err: tt.err}
+			b := pubtest.NewRecorder()
+			f := newFailover(p, b, config, c.now)
+			for i := 0; i < tt.count; i++ {
+				_ = f.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(`{}`))
+			}
+			f.check()
+			if int(f.active) != tt.expect {
+				t.Fatalf("expected %s publisher to be active but got %s", names[tt.expect], names[f.active])
+			}
+			b.Reset()
+			if err := f.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(`{}`)); tt.expect == backup && err != nil {
+				t.Fatalf("failed to publish message to the backup publisher with error: %+v", err)
+			}
+			if got := len(b.Messages()); (tt.expect == backup) != (got == 1) {
+				t.Errorf("unexpected %d messages published to the backup publisher", got)
+			}
+		})
+	}
+}
+
+func TestFailoverFailback(t *testing.T) {
+	c := &clock{t: time.Unix(1700000000, 0)}
+	p := &asyncPublisher{Recorder: pubtest.NewRecorder(), latency: time.Second}
+	b := pubtest.NewRecorder()
+	f := newFailover(p, b, Config{Latency: 100 * time.Millisecond, MinMessages: 1, Failback: time.Minute}, c.now)
+	_ = f.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(`{}`))
+	f.check()
+	if f.active != backup {
+		t.Fatalf("expected the backup publisher to be active after slow deliveries of the primary publisher")
+	}
+	c.t = c.t.Add(30 * time.Second)
+	f.check()
+	if f.active != backup {
+		t.Fatalf("expected the backup publisher to stay active before failback")
+	}
+	c.t = c.t.Add(30 * time.Second)
+	f.check()
+	if f.active != primary {
+		t.Fatalf("expected the primary publisher to be active after failback")
+	}
+}
+
+// unhealthyPublisher fails health checks with err
+type unhealthyPublisher struct {
+	*pubtest.Recorder
+	err error
+}
+
+func (p *unhealthyPublisher) Healthy() error {
+	return p.err
+}
+
+func TestFailoverHealthy(t *testing.T) {
+	c := &clock{t: time.Unix(1700000000, 0)}
+	p := &unhealthyPublisher{Recorder: pubtest.NewRecorder(), err: errors.New("broker is not reachable")}
+	f := newFailover(p, pubtest.NewRecorder(), Config{ErrorRate: 0.5, MinMessages: 1}, c.now)
+	if err := f.Healthy(); err == nil {
+		t.Fatalf("expected failover to the primary publisher failing health checks to fail")
+	}
+	f.active = backup
+	if err := f.Healthy(); err != nil {
+		t.Errorf("expected failover to the backup publisher to be healthy but failed with error: %+v", err)
+	}
+}
+
+func TestNewFailoverInvalid(t *testing.T) {
+	for _, config := range []Config{{ErrorRate: 2}, {Latency: -time.Second}, {Window: -time.Second}} {
+		if _, err := NewFailover(pubtest.NewRecorder(), pubtest.NewRecorder(), &config); err == nil {
+			t.Errorf("expected failover with config %+v to fail", config)
+		}
+	}
+}
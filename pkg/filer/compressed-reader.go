@@ -0,0 +1,92 @@
+package filer
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+var (
+	gzipMagic  = []byte{0x1f, 0x8b}
+	zstdMagic  = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	bzip2Magic = []byte("BZh")
+)
+
+type reader struct {
+	io.Reader
+	closers []func() error
+}
+
+func (r *reader) Close() error {
+	var err error
+	for _, c := range r.closers {
+		if e := c(); e != nil && err == nil {
+			err = e
+		}
+	}
+
+	return err
+}
+
+// Open opens the file for reading, gzip, zstd and bzip2 compressed files are detected by their magic numbers
+// and decompressed transparently, other files are read as is.
+func Open(file string) (io.ReadCloser, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	r, err := newReader(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to open file %s with error: %+v", file, err)
+	}
+	r.closers = append(r.closers, f.Close)
+
+	return r, nil
+}
+
+// NewReader returns a reader decompressing gzip, zstd or bzip2 compressed stream, other streams are returned as is.
+// Closing the reader does not close the underlying stream.
+func NewReader(in io.Reader) (io.ReadCloser, error) {
+	return newReader(in)
+}
+
+func newReader(in io.Reader) (*reader, error) {
+	br := bufio.NewReader(in)
+	magic, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	r := &reader{}
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		r.Reader = zr
+		r.closers = append(r.closers, zr.Close)
+	case bytes.HasPrefix(magic, zstdMagic):
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		r.Reader = zr
+		r.closers = append(r.closers, func() error {
+			zr.Close()
+			return nil
+		})
+	case bytes.HasPrefix(magic, bzip2Magic):
+		r.Reader = bzip2.NewReader(br)
+	default:
+		r.Reader = br
+	}
+
+	return r, nil
+}
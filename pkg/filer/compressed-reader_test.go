@@ -0,0 +1,150 @@
+package filer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const content = "bmp messages\n"
+
+func gzipped(t *testing.T, b []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func zstded(t *testing.T, b []byte) []byte {
+	var buf bytes.Buffer
+	w, err := zstd.NewWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestOpen(t *testing.T) {
+	// bzip2 compressed content, the standard library has no bzip2 writer
+	bzipped, err := hex.DecodeString("425a68393141592653594d46d938000001d1800010400032824800200031064c410d036a02a6773b1af1772453850904d46d9380")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		file    func(t *testing.T) []byte
+		want    string
+		openErr bool
+		readErr bool
+	}{
+		{
+			name: "gzip",
+			file: func(t *testing.T) []byte { return gzipped(t, []byte(content)) },
+			want: content,
+		},
+		{
+			name: "zstd",
+			file: func(t *testing.T) []byte { return zstded(t, []byte(content)) },
+			want: content,
+		},
+		{
+			name: "bzip2",
+			file: func(t *testing.T) []byte { return bzipped },
+			want: content,
+		},
+		{
+			name: "plain",
+			file: func(t *testing.T) []byte { return []byte(content) },
+			want: content,
+		},
+		{
+			name: "plain shorter than magic numbers",
+			file: func(t *testing.T) []byte { return []byte{0x1f} },
+			want: "\x1f",
+		},
+		{
+			name: "empty",
+			file: func(t *testing.T) []byte { return nil },
+			want: "",
+		},
+		{
+			name:    "gzip truncated header",
+			file:    func(t *testing.T) []byte { return gzipped(t, []byte(content))[:4] },
+			openErr: true,
+		},
+		{
+			name: "gzip truncated stream",
+			file: func(t *testing.T) []byte {
+				b := gzipped(t, []byte(content))
+				return b[:len(b)-4]
+			},
+			readErr: true,
+		},
+		{
+			name: "zstd truncated stream",
+			file: func(t *testing.T) []byte {
+				b := zstded(t, []byte(content))
+				return b[:len(b)-4]
+			},
+			readErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "messages")
+			if err := os.WriteFile(file, tt.file(t), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			r, err := Open(file)
+			if tt.openErr {
+				if err == nil {
+					r.Close()
+					t.Fatalf("expected error opening file")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to open file with error: %+v", err)
+			}
+			defer r.Close()
+			b, err := io.ReadAll(r)
+			if tt.readErr {
+				if err == nil {
+					t.Fatalf("expected error reading file but got %q", b)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to read file with error: %+v", err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("expected %q but got %q", tt.want, b)
+			}
+		})
+	}
+}
+
+func TestOpenMissingFile(t *testing.T) {
+	if _, err := Open(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Errorf("expected error opening missing file")
+	}
+}
@@ -0,0 +1,280 @@
+package filer
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+const (
+	jsonlSuffix = ".jsonl"
+	gzipSuffix  = ".gz"
+	// jsonlTimeFormat is the format of the time files are opened carried by their names
+	jsonlTimeFormat = "20060102T150405Z"
+	// flushInterval is the period between flushes of buffered messages to files, files rotated by time are
+	// rotated on flushes
+	flushInterval = time.Second
+)
+
+// RotatingConfig defines the publisher writing newline-delimited json messages of each message type to its own
+// files in Dir, files are named after the message type and the time they are opened, for example
+// unicast_prefix_v4_20261014T120000Z.jsonl. A file is rotated when MaxSize bytes of messages are written to it or
+// when it was opened Interval ago, 0 MaxSize or Interval disables the rotation. Files are gzip compressed when Gzip
+// is set and oldest files of a message type are removed when the type has more than MaxFiles files, 0 MaxFiles
+// keeps all files.
+type RotatingConfig struct {
+	Dir      string
+	MaxSize  int64
+	Interval time.Duration
+	MaxFiles int
+	Gzip     bool
+}
+
+// jsonlFile is the file messages of a message type are written to
+type jsonlFile struct {
+	name   string
+	file   *os.File
+	gz     *gzip.Writer
+	w      *bufio.Writer
+	size   int64
+	opened time.Time
+}
+
+func (f *jsonlFile) flush() error {
+	if err := f.w.Flush(); err != nil {
+		return err
+	}
+	if f.gz != nil {
+		return f.gz.Flush()
+	}
+
+	return nil
+}
+
+func (f *jsonlFile) close() error {
+	err := f.w.Flush()
+	if f.gz != nil {
+		if e := f.gz.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	if e := f.file.Close(); e != nil && err == nil {
+		err = e
+	}
+
+	return err
+}
+
+// jsonlName is the name of a file of a message type ordered by the time the file is opened and the sequence number
+// of files opened within the second
+type jsonlName struct {
+	name   string
+	opened string
+	seq    int
+}
+
+type rotatingFiler struct {
+	sync.Mutex
+	config *RotatingConfig
+	// files holds files being written keyed by names of message types
+	files map[string]*jsonlFile
+	// last holds names of files last opened keyed by names of message types
+	last   map[string]jsonlName
+	stop   chan struct{}
+	done   chan struct{}
+	closed bool
+}
+
+func (p *rotatingFiler) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	name := bmp.MessageTypeName(msgType)
+	if name == "" {
+		name = "type_" + strconv.Itoa(msgType)
+	}
+	p.Lock()
+	defer p.Unlock()
+	if p.closed {
+		return fmt.Errorf("file publisher is stopped")
+	}
+	f, err := p.file(name, time.Now())
+	if err != nil {
+		return err
+	}
+	if _, err := f.w.Write(msg); err != nil {
+		return fmt.Errorf("failed to write message to %s with error: %+v", f.name, err)
+	}
+	if err := f.w.WriteByte('\n'); err != nil {
+		return fmt.Errorf("failed to write message to %s with error: %+v", f.name, err)
+	}
+	f.size += int64(len(msg)) + 1
+	if p.config.MaxSize > 0 && f.size >= p.config.MaxSize {
+		p.rotate(name, f)
+	}
+
+	return nil
+}
+
+// file returns the file of the message type, the file is opened when the type has no file
+func (p *rotatingFiler) file(name string, now time.Time) (*jsonlFile, error) {
+	if f, ok := p.files[name]; ok {
+		return f, nil
+	}
+	suffix := jsonlSuffix
+	if p.config.Gzip {
+		suffix += gzipSuffix
+	}
+	opened := now.UTC().Format(jsonlTimeFormat)
+	// Files rotated within a second get sequence numbers, numbers of removed files are not reused
+	seq := 0
+	if l, ok := p.last[name]; ok && l.opened == opened {
+		seq = l.seq + 1
+	}
+	var file *os.File
+	var fn string
+	var err error
+	for ; ; seq++ {
+		fn = filepath.Join(p.config.Dir, name+"_"+opened)
+		if seq != 0 {
+			fn += "." + strconv.Itoa(seq)
+		}
+		fn += suffix
+		if file, err = os.OpenFile(fn, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644); !os.IsExist(err) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file of message type %s with error: %+v", name, err)
+	}
+	p.last[name] = jsonlName{opened: opened, seq: seq}
+	f := &jsonlFile{name: fn, file: file, opened: now}
+	var w io.Writer = file
+	if p.config.Gzip {
+		f.gz = gzip.NewWriter(file)
+		w = f.gz
+	}
+	f.w = bufio.NewWriter(w)
+	p.files[name] = f
+	p.prune(name)
+
+	return f, nil
+}
+
+// rotate closes the file of the message type, the next message of the type opens a new file
+func (p *rotatingFiler) rotate(name string, f *jsonlFile) {
+	delete(p.files, name)
+	if err := f.close(); err != nil {
+		glog.Errorf("failed to close file %s with error: %+v", f.name, err)
+	}
+}
+
+// prune removes oldest files of the message type beyond MaxFiles
+func (p *rotatingFiler) prune(name string) {
+	if p.config.MaxFiles <= 0 {
+		return
+	}
+	entries, err := os.ReadDir(p.config.Dir)
+	if err != nil {
+		glog.Errorf("failed to read directory %s with error: %+v", p.config.Dir, err)
+		return
+	}
+	var files []jsonlName
+	for _, e := range entries {
+		n := e.Name()
+		if e.IsDir() || !strings.HasPrefix(n, name+"_") {
+			continue
+		}
+		// Names of other types may start with the name, such as unicast_prefix_v4 of unicast_prefix
+		parts := strings.Split(n[len(name)+1:], ".")
+		if _, err := time.Parse(jsonlTimeFormat, parts[0]); err != nil {
+			continue
+		}
+		f := jsonlName{name: n, opened: parts[0]}
+		if len(parts) > 1 {
+			f.seq, _ = strconv.Atoi(parts[1])
+		}
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].opened != files[j].opened {
+			return files[i].opened < files[j].opened
+		}
+		return files[i].seq < files[j].seq
+	})
+	for len(files) > p.config.MaxFiles {
+		if err := os.Remove(filepath.Join(p.config.Dir, files[0].name)); err != nil {
+			glog.Errorf("failed to remove file %s with error: %+v", files[0].name, err)
+		}
+		files = files[1:]
+	}
+}
+
+// flush writes buffered messages to files and rotates files opened more than Interval ago
+func (p *rotatingFiler) flush(now time.Time) {
+	p.Lock()
+	defer p.Unlock()
+	for name, f := range p.files {
+		if p.config.Interval > 0 && now.Sub(f.opened) >= p.config.Interval {
+			p.rotate(name, f)
+			continue
+		}
+		if err := f.flush(); err != nil {
+			glog.Errorf("failed to write messages to %s with error: %+v", f.name, err)
+		}
+	}
+}
+
+func (p *rotatingFiler) run() {
+	defer close(p.done)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			p.flush(now)
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+func (p *rotatingFiler) Stop() {
+	close(p.stop)
+	<-p.done
+	p.Lock()
+	defer p.Unlock()
+	for name, f := range p.files {
+		p.rotate(name, f)
+	}
+	p.closed = true
+}
+
+// NewRotatingFiler returns the publisher writing messages of each message type to rotating files of the config
+func NewRotatingFiler(config *RotatingConfig) (pub.Publisher, error) {
+	if config.MaxSize < 0 || config.Interval < 0 || config.MaxFiles < 0 {
+		return nil, fmt.Errorf("invalid rotation of files, size, interval and number of files cannot be negative")
+	}
+	if err := os.MkdirAll(config.Dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory %s with error: %+v", config.Dir, err)
+	}
+	p := &rotatingFiler{
+		config: config,
+		files:  make(map[string]*jsonlFile),
+		last:   make(map[string]jsonlName),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go p.run()
+
+	return p, nil
+}
@@ -0,0 +1,436 @@
+package flap
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/churn"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// defaultTopPrefixes is the number of the most flapping prefixes included in route_stats messages by default
+const defaultTopPrefixes = 10
+
+// Config defines rolling statistics of routes of peers
+type Config struct {
+	// Interval is the period between route_stats messages, messages are published at multiples of Interval
+	Interval time.Duration
+	// Window is the period counters of messages cover, a multiple of Interval
+	Window time.Duration
+	// TopPrefixes is the number of the most flapping prefixes included in messages
+	TopPrefixes int
+}
+
+// RouteStats defines route_stats message carrying counters of announcements, withdrawals and flaps of routes
+// of peers and of the most flapping prefixes during the window between Start and End
+type RouteStats struct {
+	Start       string         `json:"start"`
+	End         string         `json:"end"`
+	Peers       []*PeerStats   `json:"peers"`
+	TopPrefixes []*PrefixStats `json:"top_flap_prefixes"`
+}
+
+func init() {
+	if err := bmp.RegisterMessageSchema(bmp.RouteStatsMsg, RouteStats{}); err != nil {
+		panic(err)
+	}
+}
+
+// PeerStats defines counters of routes of a peer during the window, a flap is an announcement of a prefix the peer
+// withdrew less than the window before. FlappingPrefixes is the number of prefixes of the peer which flapped, PeerDowns
+// is the number of times the peer went down.
+type PeerStats struct {
+	RouterIP         string `json:"router_ip"`
+	PeerIP           string `json:"peer_ip"`
+	PeerRD           string `json:"peer_rd,omitempty"`
+	PeerASN          uint32 `json:"peer_asn,omitempty"`
+	Announcements    uint64 `json:"announcements"`
+	Withdrawals      uint64 `json:"withdrawals"`
+	Flaps            uint64 `json:"flaps"`
+	FlappingPrefixes int    `json:"flapping_prefixes"`
+	PeerDowns        uint64 `json:"peer_downs"`
+}
+
+// PrefixStats defines counters of a prefix of a peer during the window
+type PrefixStats struct {
+	RouterIP      string `json:"router_ip"`
+	PeerIP        string `json:"peer_ip"`
+	PeerRD        string `json:"peer_rd,omitempty"`
+	Prefix        string `json:"prefix"`
+	VPNRD         string `json:"vpn_rd,omitempty"`
+	PathID        int32  `json:"path_id,omitempty"`
+	Announcements uint64 `json:"announcements"`
+	Withdrawals   uint64 `json:"withdrawals"`
+	Flaps         uint64 `json:"flaps"`
+}
+
+type peerKey struct {
+	routerIP string
+	peerIP   string
+	peerRD   string
+}
+
+type prefixKey struct {
+	vpnRD     string
+	prefix    string
+	prefixLen int32
+	pathID    int32
+}
+
+// bucket holds counters of messages received during the interval of the index, intervals are numbered from
+// the Unix epoch
+type bucket struct {
+	index int64
+	churn.Counter
+	flaps uint64
+	downs uint64
+}
+
+// buckets holds buckets of intervals with messages ordered by their index
+type buckets []bucket
+
+// current returns the bucket of the interval of the index, it is added when the last bucket is of an earlier interval
+func (b *buckets) current(index int64) *bucket {
+	if n := len(*b); n == 0 || (*b)[n-1].index != index {
+		*b = append(*b, bucket{index: index})
+	}
+
+	return &(*b)[len(*b)-1]
+}
+
+// expire removes buckets of intervals before the index
+func (b *buckets) expire(index int64) {
+	i := 0
+	for i < len(*b) && (*b)[i].index < index {
+		i++
+	}
+	if i > 0 {
+		*b = append((*b)[:0], (*b)[i:]...)
+	}
+}
+
+// sum returns the sum of counters of buckets of intervals from the index first up to the index end
+func (b buckets) sum(first, end int64) bucket {
+	s := bucket{}
+	for _, c := range b {
+		if c.index < first || c.index >= end {
+			continue
+		}
+		s.Add(c.Counter)
+		s.flaps += c.flaps
+		s.downs += c.downs
+	}
+
+	return s
+}
+
+type prefix struct {
+	buckets buckets
+	// withdrawn is true when the last message of the prefix withdrew it
+	withdrawn bool
+}
+
+type peer struct {
+	asn      uint32
+	buckets  buckets
+	prefixes map[prefixKey]*prefix
+}
+
+type statistics struct {
+	sync.Mutex
+	publisher pub.Publisher
+	config    *Config
+	peers     map[peerKey]*peer
+	now       func() time.Time
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+func (s *statistics) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	switch {
+	case msgType == bmp.PeerStateChangeMsg:
+		m, err := churn.DecodePeer(msg)
+		if err != nil {
+			glog.Errorf("failed to decode peer message for route statistics with error: %+v", err)
+			break
+		}
+		if !m.Up() {
+			s.peerDown(m)
+		}
+	case churn.IsRoute(msgType):
+		m, err := churn.DecodePrefix(msg)
+		if err != nil {
+			glog.Errorf("failed to decode prefix message for route statistics with error: %+v", err)
+			break
+		}
+		if m.Route() {
+			s.prefixChange(m)
+		}
+	}
+
+	return s.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+// Stop stops publishing of route_stats messages before stopping publisher
+func (s *statistics) Stop() {
+	close(s.stop)
+	<-s.done
+	s.publisher.Stop()
+}
+
+// index returns the index of the interval of the time
+func (s *statistics) index(t time.Time) int64 {
+	return t.UnixNano() / int64(s.config.Interval)
+}
+
+// peer returns the peer of the key, the peer is added when it is not known, it is called with the lock held
+func (s *statistics) peer(k peerKey) *peer {
+	p, ok := s.peers[k]
+	if !ok {
+		p = &peer{prefixes: make(map[prefixKey]*prefix)}
+		s.peers[k] = p
+	}
+
+	return p
+}
+
+func (s *statistics) peerDown(m *churn.Peer) {
+	i := s.index(s.now())
+	s.Lock()
+	defer s.Unlock()
+	p := s.peer(peerKey{routerIP: m.RouterIP, peerIP: m.RemoteIP, peerRD: m.PeerRD})
+	if m.RemoteASN != 0 {
+		p.asn = m.RemoteASN
+	}
+	p.buckets.current(i).downs++
+}
+
+func (s *statistics) prefixChange(m *churn.Prefix) {
+	i := s.index(s.now())
+	s.Lock()
+	defer s.Unlock()
+	p := s.peer(peerKey{routerIP: m.RouterIP, peerIP: m.PeerIP, peerRD: m.PeerRD})
+	if m.PeerASN != 0 {
+		p.asn = m.PeerASN
+	}
+	k := prefixKey{vpnRD: m.VPNRD, prefix: m.Prefix, prefixLen: m.PrefixLen, pathID: m.PathID}
+	px, ok := p.prefixes[k]
+	if !ok {
+		px = &prefix{}
+		p.prefixes[k] = px
+	}
+	pb, b := p.buckets.current(i), px.buckets.current(i)
+	pb.Count(m)
+	b.Count(m)
+	if m.Withdrawn() {
+		px.withdrawn = true
+		return
+	}
+	// The prefix withdrawn earlier in the window is announced again
+	if px.withdrawn {
+		pb.flaps++
+		b.flaps++
+		px.withdrawn = false
+	}
+}
+
+// stats returns counters of the window ending at the end and removes buckets of intervals before the window
+func (s *statistics) stats(end time.Time) *RouteStats {
+	last := s.index(end)
+	first := last - int64(s.config.Window/s.config.Interval)
+	rs := &RouteStats{
+		Start:       end.Add(-s.config.Window).UTC().Format(time.RFC3339),
+		End:         end.UTC().Format(time.RFC3339),
+		Peers:       make([]*PeerStats, 0),
+		TopPrefixes: make([]*PrefixStats, 0, s.config.TopPrefixes),
+	}
+	var prefixes []*PrefixStats
+	s.Lock()
+	for k, p := range s.peers {
+		p.buckets.expire(first)
+		ps := &PeerStats{RouterIP: k.routerIP, PeerIP: k.peerIP, PeerRD: k.peerRD, PeerASN: p.asn}
+		for pk, px := range p.prefixes {
+			px.buckets.expire(first)
+			if len(px.buckets) == 0 {
+				delete(p.prefixes, pk)
+				continue
+			}
+			c := px.buckets.sum(first, last)
+			if c.flaps != 0 {
+				ps.FlappingPrefixes++
+			}
+			if c.Changes() == 0 {
+				continue
+			}
+			prefixes = append(prefixes, &PrefixStats{
+				RouterIP:      k.routerIP,
+				PeerIP:        k.peerIP,
+				PeerRD:        k.peerRD,
+				Prefix:        pk.prefix + "/" + strconv.Itoa(int(pk.prefixLen)),
+				VPNRD:         pk.vpnRD,
+				PathID:        pk.pathID,
+				Announcements: c.Announcements,
+				Withdrawals:   c.Withdrawals,
+				Flaps:         c.flaps,
+			})
+		}
+		if len(p.buckets) == 0 {
+			delete(s.peers, k)
+			continue
+		}
+		c := p.buckets.sum(first, last)
+		if c == (bucket{}) {
+			continue
+		}
+		ps.Announcements, ps.Withdrawals = c.Announcements, c.Withdrawals
+		ps.Flaps, ps.PeerDowns = c.flaps, c.downs
+		rs.Peers = append(rs.Peers, ps)
+	}
+	s.Unlock()
+	// Peers and prefixes with the most flaps are first, followed by those with the most announcements and withdrawals
+	sort.Slice(rs.Peers, func(i, j int) bool {
+		a, b := rs.Peers[i], rs.Peers[j]
+		if a.Flaps != b.Flaps {
+			return a.Flaps > b.Flaps
+		}
+		if a.Announcements+a.Withdrawals != b.Announcements+b.Withdrawals {
+			return a.Announcements+a.Withdrawals > b.Announcements+b.Withdrawals
+		}
+		if a.RouterIP != b.RouterIP {
+			return a.RouterIP < b.RouterIP
+		}
+		if a.PeerRD != b.PeerRD {
+			return a.PeerRD < b.PeerRD
+		}
+		return a.PeerIP < b.PeerIP
+	})
+	sort.Slice(prefixes, func(i, j int) bool {
+		a, b := prefixes[i], prefixes[j]
+		if a.Flaps != b.Flaps {
+			return a.Flaps > b.Flaps
+		}
+		if a.Announcements+a.Withdrawals != b.Announcements+b.Withdrawals {
+			return a.Announcements+a.Withdrawals > b.Announcements+b.Withdrawals
+		}
+		if a.RouterIP != b.RouterIP {
+			return a.RouterIP < b.RouterIP
+		}
+		if a.PeerIP != b.PeerIP {
+			return a.PeerIP < b.PeerIP
+		}
+		if a.VPNRD != b.VPNRD {
+			return a.VPNRD < b.VPNRD
+		}
+		if a.Prefix != b.Prefix {
+			return a.Prefix < b.Prefix
+		}
+		return a.PathID < b.PathID
+	})
+	if len(prefixes) > s.config.TopPrefixes {
+		prefixes = prefixes[:s.config.TopPrefixes]
+	}
+	rs.TopPrefixes = append(rs.TopPrefixes, prefixes...)
+
+	return rs
+}
+
+func (s *statistics) publish(end time.Time) {
+	rs := s.stats(end)
+	b, err := json.Marshal(rs)
+	if err != nil {
+		glog.Errorf("failed to marshal route statistics with error: %+v", err)
+		return
+	}
+	if err := s.publisher.PublishMessage(bmp.RouteStatsMsg, []byte(rs.End), b); err != nil {
+		glog.Errorf("failed to publish route statistics with error: %+v", err)
+	}
+}
+
+// run publishes route_stats messages at multiples of the interval until it is stopped
+func (s *statistics) run() {
+	defer close(s.done)
+	for {
+		now := s.now()
+		next := now.Truncate(s.config.Interval).Add(s.config.Interval)
+		t := time.NewTimer(next.Sub(now))
+		select {
+		case <-t.C:
+			s.publish(next)
+		case <-s.stop:
+			t.Stop()
+			return
+		}
+	}
+}
+
+// EvictPeer removes counters of the peer of the router, or of all peers of the router when peerIP is empty
+func (s *statistics) EvictPeer(routerIP, peerRD, peerIP string) int {
+	s.Lock()
+	defer s.Unlock()
+	n := 0
+	for k, p := range s.peers {
+		if k.routerIP == routerIP && (peerIP == "" || k.peerRD == peerRD && k.peerIP == peerIP) {
+			n += len(p.prefixes) + 1
+			delete(s.peers, k)
+		}
+	}
+
+	return n
+}
+
+// MemoryUsage returns estimated memory used by counters of peers and of their prefixes within the window
+func (s *statistics) MemoryUsage() memory.Usage {
+	s.Lock()
+	defer s.Unlock()
+	c := memory.NewCounter("route_stats")
+	for k, p := range s.peers {
+		c.Add(k.routerIP, k.peerIP, uint64(unsafe.Sizeof(k)+unsafe.Sizeof(p)+unsafe.Sizeof(*p))+memory.MapEntryOverhead+
+			uint64(len(k.routerIP)+len(k.peerIP)+len(k.peerRD))+uint64(uintptr(cap(p.buckets))*unsafe.Sizeof(bucket{})))
+		for pk, px := range p.prefixes {
+			c.Add(k.routerIP, k.peerIP, uint64(unsafe.Sizeof(pk)+unsafe.Sizeof(px)+unsafe.Sizeof(*px))+memory.MapEntryOverhead+
+				uint64(len(pk.vpnRD)+len(pk.prefix))+uint64(uintptr(cap(px.buckets))*unsafe.Sizeof(bucket{})))
+		}
+	}
+
+	return c.Usage()
+}
+
+// NewStatistics returns a publisher counting announcements, withdrawals and flaps of unicast and l3vpn routes per
+// peer and per prefix over the rolling window, and Peer Down messages of peers. Counters of the window are published
+// every interval in route_stats message with peers and the most flapping prefixes ordered by their flaps, messages
+// are passed to publisher as usual.
+func NewStatistics(publisher pub.Publisher, config *Config) (pub.Publisher, error) {
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("invalid route statistics interval %s", config.Interval)
+	}
+	if config.Window < config.Interval || config.Window%config.Interval != 0 {
+		return nil, fmt.Errorf("invalid route statistics window %s, the window must be a multiple of the interval %s",
+			config.Window, config.Interval)
+	}
+	if config.TopPrefixes <= 0 {
+		config.TopPrefixes = defaultTopPrefixes
+	}
+	s := newStatistics(publisher, config, time.Now)
+	go s.run()
+
+	return s, nil
+}
+
+func newStatistics(publisher pub.Publisher, config *Config, now func() time.Time) *statistics {
+	return &statistics{
+		publisher: publisher,
+		config:    config,
+		peers:     make(map[peerKey]*peer),
+		now:       now,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
@@ -0,0 +1,184 @@
+package flap
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	stats []*RouteStats
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if msgType != bmp.RouteStatsMsg {
+		return nil
+	}
+	rs := &RouteStats{}
+	if err := json.Unmarshal(msg, rs); err != nil {
+		return err
+	}
+	p.stats = append(p.stats, rs)
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+type testEvent struct {
+	at      time.Duration
+	msgType int
+	msg     string
+}
+
+func route(action, peer, prefix string) string {
+	return `{"action":"` + action + `","router_ip":"10.0.0.1","peer_ip":"` + peer + `","peer_asn":65001,"prefix":"` + prefix +
+		`","prefix_len":16}`
+}
+
+func TestStatistics(t *testing.T) {
+	start := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		events   []testEvent
+		end      time.Duration
+		top      int
+		peers    []*PeerStats
+		prefixes []*PrefixStats
+	}{
+		{
+			name: "flaps",
+			events: []testEvent{
+				{at: time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("add", "192.168.1.1", "10.1.0.0")},
+				{at: time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("add", "192.168.1.1", "10.2.0.0")},
+				{at: 2 * time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("del", "192.168.1.1", "10.1.0.0")},
+				{at: 3 * time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("add", "192.168.1.1", "10.1.0.0")},
+				{at: 4 * time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("del", "192.168.1.1", "10.1.0.0")},
+				{at: 5 * time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("add", "192.168.1.1", "10.1.0.0")},
+				{at: 5 * time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("add", "192.168.1.2", "10.1.0.0")},
+				{at: 5 * time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("add", "192.168.1.2", "10.1.0.0")},
+				{at: 5 * time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.2","is_eor":true}`},
+				{at: 6 * time.Second, msgType: bmp.PeerStateChangeMsg, msg: `{"action":"down","router_ip":"10.0.0.1","remote_ip":"192.168.1.3","remote_asn":65003}`},
+			},
+			end: time.Minute,
+			top: 2,
+			peers: []*PeerStats{
+				{RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", PeerASN: 65001, Announcements: 4, Withdrawals: 2, Flaps: 2, FlappingPrefixes: 1},
+				{RouterIP: "10.0.0.1", PeerIP: "192.168.1.2", PeerASN: 65001, Announcements: 2},
+				{RouterIP: "10.0.0.1", PeerIP: "192.168.1.3", PeerASN: 65003, PeerDowns: 1},
+			},
+			prefixes: []*PrefixStats{
+				{RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", Prefix: "10.1.0.0/16", Announcements: 3, Withdrawals: 2, Flaps: 2},
+				{RouterIP: "10.0.0.1", PeerIP: "192.168.1.2", Prefix: "10.1.0.0/16", Announcements: 2},
+			},
+		},
+		{
+			name: "rolling window",
+			events: []testEvent{
+				{at: time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("del", "192.168.1.1", "10.1.0.0")},
+				{at: time.Minute + time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("add", "192.168.1.1", "10.1.0.0")},
+				{at: time.Minute + time.Second, msgType: bmp.L3VPNV4Msg, msg: `{"action":"del","router_ip":"10.0.0.1","peer_ip":"192.168.1.1",` +
+					`"vpn_rd":"100:1","prefix":"10.1.0.0","prefix_len":16}`},
+				{at: 2*time.Minute + time.Second, msgType: bmp.L3VPNV4Msg, msg: `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.1.1",` +
+					`"vpn_rd":"100:1","prefix":"10.1.0.0","prefix_len":16}`},
+				// Withdrawn before the window of the announcement, the announcement is not a flap
+				{at: 2*time.Minute + time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("del", "192.168.1.1", "10.2.0.0")},
+				{at: 5*time.Minute + time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("add", "192.168.1.1", "10.2.0.0")},
+			},
+			end: 6 * time.Minute,
+			peers: []*PeerStats{
+				{RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", PeerASN: 65001, Announcements: 1},
+			},
+			prefixes: []*PrefixStats{
+				{RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", Prefix: "10.2.0.0/16", Announcements: 1},
+			},
+		},
+		{
+			name: "flaps within the window",
+			events: []testEvent{
+				{at: time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("del", "192.168.1.1", "10.1.0.0")},
+				{at: time.Minute + time.Second, msgType: bmp.UnicastPrefixV4Msg, msg: route("add", "192.168.1.1", "10.1.0.0")},
+			},
+			end: 2 * time.Minute,
+			peers: []*PeerStats{
+				{RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", PeerASN: 65001, Announcements: 1, Withdrawals: 1, Flaps: 1, FlappingPrefixes: 1},
+			},
+			prefixes: []*PrefixStats{
+				{RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", Prefix: "10.1.0.0/16", Announcements: 1, Withdrawals: 1, Flaps: 1},
+			},
+		},
+		{
+			name:     "no messages",
+			end:      time.Minute,
+			peers:    []*PeerStats{},
+			prefixes: []*PrefixStats{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{}
+			now := start
+			s := newStatistics(p, &Config{Interval: time.Minute, Window: 2 * time.Minute, TopPrefixes: tt.top}, func() time.Time {
+				return now
+			})
+			if tt.top == 0 {
+				s.config.TopPrefixes = defaultTopPrefixes
+			}
+			next := start.Add(time.Minute)
+			for _, e := range tt.events {
+				now = start.Add(e.at)
+				// Messages are published at multiples of the interval
+				for ; !next.After(now); next = next.Add(time.Minute) {
+					s.publish(next)
+				}
+				if err := s.PublishMessage(e.msgType, nil, []byte(e.msg)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+			}
+			now = start.Add(tt.end)
+			rs := s.stats(now)
+			if rs.Start != now.Add(-2*time.Minute).Format(time.RFC3339) || rs.End != now.Format(time.RFC3339) {
+				t.Errorf("got window from %s to %s", rs.Start, rs.End)
+			}
+			if !reflect.DeepEqual(rs.Peers, tt.peers) {
+				t.Errorf("got peers %s, want %s", marshal(rs.Peers), marshal(tt.peers))
+			}
+			if !reflect.DeepEqual(rs.TopPrefixes, tt.prefixes) {
+				t.Errorf("got prefixes %s, want %s", marshal(rs.TopPrefixes), marshal(tt.prefixes))
+			}
+		})
+	}
+}
+
+func marshal(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func TestExpire(t *testing.T) {
+	now := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	p := &testPublisher{}
+	s := newStatistics(p, &Config{Interval: time.Minute, Window: 2 * time.Minute, TopPrefixes: 10}, func() time.Time {
+		return now
+	})
+	if err := s.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(route("add", "192.168.1.1", "10.1.0.0"))); err != nil {
+		t.Fatal(err)
+	}
+	if n := s.MemoryUsage().Entries; n != 2 {
+		t.Errorf("expected 2 entries of the peer and the prefix but got %d", n)
+	}
+	for i := 1; i <= 3; i++ {
+		s.publish(now.Add(time.Duration(i) * time.Minute))
+	}
+	if len(p.stats) != 3 || len(p.stats[0].Peers) != 1 || len(p.stats[1].Peers) != 1 || len(p.stats[2].Peers) != 0 {
+		t.Errorf("expected the peer in the first 2 messages but got %s", marshal(p.stats))
+	}
+	if n := s.MemoryUsage().Entries; n != 0 {
+		t.Errorf("expected no entries after the window but got %d", n)
+	}
+	if _, err := NewStatistics(p, &Config{Interval: time.Minute, Window: 90 * time.Second}); err == nil {
+		t.Errorf("expected error of the window not a multiple of the interval")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/base"
 	"github.com/sbezverk/tools"
 )
 
@@ -19,7 +20,9 @@ type Spec interface {
 
 // NLRI defines Flowspec NLRI structure
 type NLRI struct {
-	Length   uint16
+	Length uint16
+	// RD is Route Distinguisher of VPN Flowspec NLRI, SAFI 134
+	RD       string
 	Spec     []Spec
 	SpecHash string
 }
@@ -57,68 +60,84 @@ const (
 	Type11 SpecType = 11
 	// Type12 defines Flowspec Specification type for Fragment
 	Type12 SpecType = 12
+	// Type13 defines Flowspec Specification type for IPv6 Flow Label [RFC8956]
+	Type13 SpecType = 13
 )
 
-// UnmarshalFlowspecNLRI creates an instance of Flowspec NLRI from a slice of bytes
+// UnmarshalFlowspecNLRI creates an instance of IPv4 Flowspec NLRI from a slice of bytes carrying a single NLRI
 func UnmarshalFlowspecNLRI(b []byte) (*NLRI, error) {
 	if glog.V(5) {
 		glog.Infof("Flowspec NLRI Raw: %s", tools.MessageHex(b))
 	}
+	fs, l, err := unmarshalNLRI(b, false, false)
+	if err != nil {
+		return nil, err
+	}
+	if l != len(b) {
+		return nil, fmt.Errorf("invalid length encoded length %d does not match with slice length %d", fs.Length, len(b))
+	}
+
+	return fs, nil
+}
+
+// UnmarshalFlowspecNLRIs creates instances of Flowspec NLRI from a slice of bytes carrying NLRI of MP_REACH_NLRI
+// or MP_UNREACH_NLRI attribute, ipv6 selects IPv6 Flowspec of RFC 8956 and vpn selects VPN Flowspec, SAFI 134,
+// with NLRI starting with Route Distinguisher
+func UnmarshalFlowspecNLRIs(b []byte, ipv6, vpn bool) ([]*NLRI, error) {
+	if glog.V(5) {
+		glog.Infof("Flowspec NLRIs Raw: %s ipv6: %t vpn: %t", tools.MessageHex(b), ipv6, vpn)
+	}
+	nlris := make([]*NLRI, 0)
+	for p := 0; p < len(b); {
+		fs, l, err := unmarshalNLRI(b[p:], ipv6, vpn)
+		if err != nil {
+			return nil, err
+		}
+		nlris = append(nlris, fs)
+		p += l
+	}
+
+	return nlris, nil
+}
+
+// unmarshalNLRI unmarshals the Flowspec NLRI b starts with and returns it with the number of bytes it occupies
+func unmarshalNLRI(b []byte, ipv6, vpn bool) (*NLRI, int, error) {
 	if len(b) == 0 {
-		return nil, fmt.Errorf("NLRI length is 0")
+		return nil, 0, fmt.Errorf("NLRI length is 0")
 	}
 	fs := &NLRI{}
 	p := 0
 	if b[p]&0xf0 == 0xf0 {
-		// NLRI length is encoded into 2 bytes
-		binary.BigEndian.PutUint16(b[p:p+2], fs.Length)
+		// NLRI length is encoded into 2 bytes, the 4 most significant bits are set
+		if len(b) < 2 {
+			return nil, 0, fmt.Errorf("not enough bytes to unmarshal Flowspec NLRI length")
+		}
+		fs.Length = binary.BigEndian.Uint16(b[p:p+2]) & 0x0fff
 		p += 2
 	} else {
 		// Otherwise it is encoded in the single byte
 		fs.Length = uint16(b[p])
 		p++
 	}
-	if p+int(fs.Length) != len(b) {
-		return nil, fmt.Errorf("invalid length encoded length %d does not match with slice length %d", fs.Length, len(b))
+	end := p + int(fs.Length)
+	if end > len(b) {
+		return nil, 0, fmt.Errorf("invalid length encoded length %d does not match with slice length %d", fs.Length, len(b))
 	}
-	for p < len(b) {
-		t := b[p]
-		l := 0
-		var spec Spec
-		var err error
-		switch SpecType(t) {
-		case Type1:
-			fallthrough
-		case Type2:
-			spec, l, err = makePrefixSpec(b[p:])
-			if err != nil {
-				return nil, err
-			}
-		case Type3:
-			fallthrough
-		case Type4:
-			fallthrough
-		case Type5:
-			fallthrough
-		case Type6:
-			fallthrough
-		case Type7:
-			fallthrough
-		case Type8:
-			fallthrough
-		case Type10:
-			fallthrough
-		case Type11:
-			spec, l, err = makeGenericSpec(b[p:])
-			if err != nil {
-				return nil, err
-			}
-		case Type9:
-			fallthrough
-		case Type12:
-			return nil, fmt.Errorf("not implemented Flowspec type: %+v", t)
-		default:
-			return nil, fmt.Errorf("unknown Flowspec type: %+v", t)
+	if vpn {
+		if p+8 > end {
+			return nil, 0, fmt.Errorf("not enough bytes to unmarshal Route Distinguisher of VPN Flowspec NLRI")
+		}
+		rd, err := base.MakeRD(b[p : p+8])
+		if err != nil {
+			return nil, 0, err
+		}
+		fs.RD = rd.String()
+		p += 8
+	}
+	for p < end {
+		spec, l, err := unmarshalSpec(b[p:end], ipv6)
+		if err != nil {
+			return nil, 0, err
 		}
 		fs.Spec = append(fs.Spec, spec)
 		p += l
@@ -127,22 +146,38 @@ func UnmarshalFlowspecNLRI(b []byte) (*NLRI, error) {
 	// Calculating hash of all recovered spec
 	sp, err := json.Marshal(fs.Spec)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	s := md5.Sum(sp)
 	fs.SpecHash = hex.EncodeToString(s[:])
 
-	return fs, nil
+	return fs, end, nil
 }
 
-// Operator defines a data structure representing Flowspec operator byte
+func unmarshalSpec(b []byte, ipv6 bool) (Spec, int, error) {
+	switch SpecType(b[0]) {
+	case Type1, Type2:
+		return makePrefixSpec(b, ipv6)
+	case Type3, Type4, Type5, Type6, Type7, Type8, Type10, Type11, Type13:
+		return makeGenericSpec(b)
+	case Type9, Type12:
+		return makeBitmaskSpec(b)
+	}
+
+	return nil, 0, fmt.Errorf("unknown Flowspec type: %+v", b[0])
+}
+
+// Operator defines a data structure representing Flowspec operator byte, LTBit, GTBit and EQBit are bits of
+// numeric operators, NotBit and MatchBit are bits of bitmask operators of TCP flags and Fragment types
 type Operator struct {
-	EOLBit bool
-	ANDBit bool
-	Length uint8
-	LTBit  bool
-	GTBit  bool
-	EQBit  bool
+	EOLBit   bool
+	ANDBit   bool
+	Length   uint8
+	LTBit    bool
+	GTBit    bool
+	EQBit    bool
+	NotBit   bool
+	MatchBit bool
 }
 
 // UnmarshalFlowspecOperator creates an instance of Operator object from a byte
@@ -169,22 +204,39 @@ func UnmarshalFlowspecOperator(b byte) (*Operator, error) {
 	return o, nil
 }
 
+// UnmarshalFlowspecBitmaskOperator creates an instance of bitmask Operator object from a byte
+func UnmarshalFlowspecBitmaskOperator(b byte) (*Operator, error) {
+	o := &Operator{
+		EOLBit:   b&0x80 == 0x80,
+		ANDBit:   b&0x40 == 0x40,
+		Length:   1 << ((b & 0x30) >> 4),
+		NotBit:   b&0x02 == 0x02,
+		MatchBit: b&0x01 == 0x01,
+	}
+
+	return o, nil
+}
+
 // MarshalJSON returns a binary representation of Flowspec Operator structure
 func (o *Operator) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		EOLBit bool  `json:"end_of_list_bit,omitempty"`
-		ANDBit bool  `json:"and_bit,omitempty"`
-		Length uint8 `json:"value_length,omitempty"`
-		LTBit  bool  `json:"less_than,omitempty"`
-		GTBit  bool  `json:"greater_than,omitempty"`
-		EQBit  bool  `json:"equal,omitempty"`
+		EOLBit   bool  `json:"end_of_list_bit,omitempty"`
+		ANDBit   bool  `json:"and_bit,omitempty"`
+		Length   uint8 `json:"value_length,omitempty"`
+		LTBit    bool  `json:"less_than,omitempty"`
+		GTBit    bool  `json:"greater_than,omitempty"`
+		EQBit    bool  `json:"equal,omitempty"`
+		NotBit   bool  `json:"not,omitempty"`
+		MatchBit bool  `json:"match,omitempty"`
 	}{
-		EOLBit: o.EOLBit,
-		ANDBit: o.ANDBit,
-		Length: o.Length,
-		LTBit:  o.LTBit,
-		GTBit:  o.GTBit,
-		EQBit:  o.EQBit,
+		EOLBit:   o.EOLBit,
+		ANDBit:   o.ANDBit,
+		Length:   o.Length,
+		LTBit:    o.LTBit,
+		GTBit:    o.GTBit,
+		EQBit:    o.EQBit,
+		NotBit:   o.NotBit,
+		MatchBit: o.MatchBit,
 	})
 
 }
@@ -200,27 +252,46 @@ func (o *Operator) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// PrefixSpec defines a structure of Flowspec Type 1 and Type 2 (Destination/Source Prefix) spec.
+// PrefixSpec defines a structure of Flowspec Type 1 and Type 2 (Destination/Source Prefix) spec. IPv6 prefix
+// of RFC 8956 carries Offset, the number of bits skipped before Prefix, Prefix carries PrefixLength - Offset bits.
 type PrefixSpec struct {
 	SpecType     uint8  `json:"type"`
 	PrefixLength uint8  `json:"prefix_len"`
+	Offset       uint8  `json:"offset,omitempty"`
 	Prefix       []byte `json:"prefix"`
+	ipv6         bool
 }
 
-func makePrefixSpec(b []byte) (Spec, int, error) {
-	s := &PrefixSpec{}
+func makePrefixSpec(b []byte, ipv6 bool) (Spec, int, error) {
+	s := &PrefixSpec{ipv6: ipv6}
 	p := 0
+	if len(b) < 2 {
+		return nil, 0, fmt.Errorf("not enough bytes to unmarshal Flowspec prefix spec")
+	}
 	s.SpecType = b[p]
 	p++
 	s.PrefixLength = b[p]
-	l := int(s.PrefixLength / 8)
-	if b[p]%8 != 0 {
-		l++
-	}
 	p++
+	bits, maxLength := int(s.PrefixLength), 32
+	if ipv6 {
+		if p >= len(b) {
+			return nil, 0, fmt.Errorf("not enough bytes to unmarshal offset of Flowspec IPv6 prefix spec")
+		}
+		s.Offset = b[p]
+		p++
+		bits -= int(s.Offset)
+		maxLength = 128
+	}
+	if int(s.PrefixLength) > maxLength || bits < 0 {
+		return nil, 0, fmt.Errorf("invalid length %d and offset %d of Flowspec prefix spec", s.PrefixLength, s.Offset)
+	}
+	l := (bits + 7) / 8
+	if p+l > len(b) {
+		return nil, 0, fmt.Errorf("not enough bytes to unmarshal Flowspec prefix of length %d", s.PrefixLength)
+	}
 	s.Prefix = make([]byte, l)
 	copy(s.Prefix, b[p:p+l])
-	p += int(l)
+	p += l
 
 	return s, p, nil
 }
@@ -241,10 +312,12 @@ func (t *PrefixSpec) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
 		SpecType     uint8  `json:"type"`
 		PrefixLength uint8  `json:"prefix_len"`
+		Offset       uint8  `json:"offset,omitempty"`
 		Prefix       []byte `json:"prefix"`
 	}{
 		SpecType:     t.SpecType,
 		PrefixLength: t.PrefixLength,
+		Offset:       t.Offset,
 		Prefix:       t.Prefix,
 	})
 }
@@ -277,15 +350,24 @@ func (o *OpVal) MarshalJSON() ([]byte, error) {
 	})
 }
 
-// UnmarshalOpVal creates a slice of Operator/Value pairs
+// UnmarshalOpVal creates a slice of Operator/Value pairs of numeric operators
 func UnmarshalOpVal(b []byte) ([]*OpVal, error) {
+	return unmarshalOpVal(b, UnmarshalFlowspecOperator)
+}
+
+// UnmarshalBitmaskOpVal creates a slice of Operator/Value pairs of bitmask operators
+func UnmarshalBitmaskOpVal(b []byte) ([]*OpVal, error) {
+	return unmarshalOpVal(b, UnmarshalFlowspecBitmaskOperator)
+}
+
+func unmarshalOpVal(b []byte, operator func(byte) (*Operator, error)) ([]*OpVal, error) {
 	opvals := make([]*OpVal, 0)
 	p := 0
 	// Skip type
 	p++
 	eol := false
 	for !eol && p < len(b) {
-		o, err := UnmarshalFlowspecOperator(b[p])
+		o, err := operator(b[p])
 		if err != nil {
 			return nil, err
 		}
@@ -308,19 +390,28 @@ func UnmarshalOpVal(b []byte) ([]*OpVal, error) {
 	return opvals, nil
 }
 
-// GenericSpec defines a structure of Flowspec Types (3,4,5,6,7,8,10,11) specs.
+// GenericSpec defines a structure of Flowspec Types (3,4,5,6,7,8,10,11,13) specs with numeric operators and
+// of Types (9,12) specs with bitmask operators.
 type GenericSpec struct {
 	SpecType uint8    `json:"type,omitempty"`
 	OpVal    []*OpVal `json:"op_val_pairs,omitempty"`
 }
 
 func makeGenericSpec(b []byte) (Spec, int, error) {
+	return makeOpValSpec(b, UnmarshalOpVal)
+}
+
+func makeBitmaskSpec(b []byte) (Spec, int, error) {
+	return makeOpValSpec(b, UnmarshalBitmaskOpVal)
+}
+
+func makeOpValSpec(b []byte, opVal func([]byte) ([]*OpVal, error)) (Spec, int, error) {
 	s := &GenericSpec{}
 	var err error
 	p := 0
 	s.SpecType = b[p]
 	p++
-	s.OpVal, err = UnmarshalOpVal(b)
+	s.OpVal, err = opVal(b)
 	if err != nil {
 		return nil, 0, err
 	}
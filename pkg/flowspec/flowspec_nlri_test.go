@@ -68,3 +68,95 @@ func TestUnmarshalFlowspecNLRI(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalFlowspecNLRIs(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		ipv6   bool
+		vpn    bool
+		rd     string
+		expect []map[string]string
+		fail   bool
+	}{
+		{
+			name: "ipv4 prefix, ports, tcp flags and fragment",
+			input: []byte{
+				0x0f, 0x01, 0x18, 0x0a, 0x00, 0x01, 0x05, 0x13, 0x04, 0x00, 0x55, 0x08, 0x00, 0x91, 0x1f, 0x90,
+				0x06, 0x09, 0x81, 0x12, 0x0c, 0x82, 0x01,
+			},
+			expect: []map[string]string{
+				{
+					"destination_prefix": "10.0.1.0/24",
+					"destination_port":   ">=1024&<=2048 || =8080",
+				},
+				{
+					"tcp_flags": "=syn|ack",
+					"fragment":  "!dont-fragment",
+				},
+			},
+		},
+		{
+			name:  "ipv6 prefix with offset and flow label, two bytes length",
+			input: []byte{0xf0, 0x0a, 0x01, 0x40, 0x20, 0x00, 0x01, 0x00, 0x02, 0x0d, 0x81, 0x05},
+			ipv6:  true,
+			expect: []map[string]string{
+				{
+					"destination_prefix": "0:0:1:2::/64 offset 32",
+					"flow_label":         "=5",
+				},
+			},
+		},
+		{
+			name:  "vpn protocol",
+			input: []byte{0x0b, 0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64, 0x03, 0x81, 0x06},
+			vpn:   true,
+			rd:    "65000:100",
+			expect: []map[string]string{
+				{
+					"protocol": "=6",
+				},
+			},
+		},
+		{
+			name:  "truncated prefix",
+			input: []byte{0x03, 0x01, 0x18, 0x0a},
+			fail:  true,
+		},
+		{
+			name:  "length exceeding nlri",
+			input: []byte{0x05, 0x01, 0x08, 0x0a},
+			fail:  true,
+		},
+		{
+			name:  "unknown type",
+			input: []byte{0x03, 0x0e, 0x81, 0x01},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshalFlowspecNLRIs(tt.input, tt.ipv6, tt.vpn)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("expected to fail but succeeded")
+			}
+			if len(got) != len(tt.expect) {
+				t.Fatalf("expected %d NLRI but got %d", len(tt.expect), len(got))
+			}
+			for i, fs := range got {
+				if fs.RD != tt.rd {
+					t.Errorf("expected rd %s but got %s", tt.rd, fs.RD)
+				}
+				if !reflect.DeepEqual(tt.expect[i], fs.Rules()) {
+					t.Errorf("expected rules %+v but got %+v", tt.expect[i], fs.Rules())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package flowspec
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// componentNames maps Flowspec Specification types to names of rules
+var componentNames = map[SpecType]string{
+	Type1:  "destination_prefix",
+	Type2:  "source_prefix",
+	Type3:  "protocol",
+	Type4:  "port",
+	Type5:  "destination_port",
+	Type6:  "source_port",
+	Type7:  "icmp_type",
+	Type8:  "icmp_code",
+	Type9:  "tcp_flags",
+	Type10: "packet_length",
+	Type11: "dscp",
+	Type12: "fragment",
+	Type13: "flow_label",
+}
+
+// ComponentName returns the name of Flowspec Specification type
+func ComponentName(t SpecType) string {
+	if n, ok := componentNames[t]; ok {
+		return n
+	}
+
+	return fmt.Sprintf("unknown(%d)", t)
+}
+
+// Bits of TCP flags and Fragment bitmask values
+var (
+	tcpFlags      = []string{"fin", "syn", "rst", "psh", "ack", "urg", "ece", "cwr"}
+	fragmentFlags = []string{"dont-fragment", "is-fragment", "first-fragment", "last-fragment"}
+)
+
+// Rules returns rules of the NLRI by names of their components, prefixes are in prefix/length form, IPv6 prefixes
+// with offset are followed by the offset, for example "2001:db8::/64 offset 32", operator and value pairs are
+// expressions such as ">=1024&<=2048 || =8080", bits of TCP flags and Fragment values are named, for example "=syn|ack".
+func (fs *NLRI) Rules() map[string]string {
+	rules := make(map[string]string, len(fs.Spec))
+	for _, spec := range fs.Spec {
+		switch s := spec.(type) {
+		case *PrefixSpec:
+			rules[ComponentName(SpecType(s.SpecType))] = s.String()
+		case *GenericSpec:
+			rules[ComponentName(SpecType(s.SpecType))] = s.String()
+		}
+	}
+
+	return rules
+}
+
+// String returns the prefix of the spec in prefix/length form
+func (t *PrefixSpec) String() string {
+	size := 4
+	if t.ipv6 {
+		size = 16
+	}
+	a := make([]byte, size)
+	// Prefix bits are placed after Offset bits, Offset of IPv4 prefixes is always 0
+	bits := int(t.PrefixLength) - int(t.Offset)
+	for i := 0; i < bits && i/8 < len(t.Prefix); i++ {
+		if t.Prefix[i/8]&(0x80>>uint(i%8)) == 0 {
+			continue
+		}
+		pos := int(t.Offset) + i
+		if pos/8 < size {
+			a[pos/8] |= 0x80 >> uint(pos%8)
+		}
+	}
+	s := fmt.Sprintf("%s/%d", net.IP(a).String(), t.PrefixLength)
+	if t.Offset != 0 {
+		s += fmt.Sprintf(" offset %d", t.Offset)
+	}
+
+	return s
+}
+
+// String returns operator and value pairs of the spec as an expression, pairs with AND bit set are joined to
+// the previous pair by "&", other pairs by " || "
+func (t *GenericSpec) String() string {
+	var sb strings.Builder
+	for i, ov := range t.OpVal {
+		if ov == nil || ov.Op == nil {
+			continue
+		}
+		if i > 0 {
+			if ov.Op.ANDBit {
+				sb.WriteString("&")
+			} else {
+				sb.WriteString(" || ")
+			}
+		}
+		v := uint64(0)
+		for _, b := range ov.Val {
+			v = v<<8 | uint64(b)
+		}
+		switch SpecType(t.SpecType) {
+		case Type9:
+			sb.WriteString(bitmaskOperator(ov.Op) + bitNames(v, tcpFlags))
+		case Type12:
+			sb.WriteString(bitmaskOperator(ov.Op) + bitNames(v, fragmentFlags))
+		default:
+			op := numericOperator(ov.Op)
+			sb.WriteString(op)
+			if op != "true" && op != "false" {
+				sb.WriteString(fmt.Sprintf("%d", v))
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+func numericOperator(o *Operator) string {
+	switch {
+	case o.LTBit && o.GTBit && o.EQBit:
+		return "true"
+	case o.LTBit && o.GTBit:
+		return "!="
+	case o.LTBit && o.EQBit:
+		return "<="
+	case o.GTBit && o.EQBit:
+		return ">="
+	case o.LTBit:
+		return "<"
+	case o.GTBit:
+		return ">"
+	case o.EQBit:
+		return "="
+	}
+
+	return "false"
+}
+
+// bitmaskOperator returns "=" when all bits must match, "!=" when not all bits must match, "!" when none of bits
+// must be set and an empty string when any of bits must be set
+func bitmaskOperator(o *Operator) string {
+	switch {
+	case o.MatchBit && o.NotBit:
+		return "!="
+	case o.MatchBit:
+		return "="
+	case o.NotBit:
+		return "!"
+	}
+
+	return ""
+}
+
+// bitNames returns names of bits set in v joined by "|", names start from the least significant bit, bits
+// without a name are rendered as a hex value
+func bitNames(v uint64, names []string) string {
+	s := make([]string, 0)
+	for i, n := range names {
+		if v&(1<<uint(i)) != 0 {
+			s = append(s, n)
+			v &^= 1 << uint(i)
+		}
+	}
+	if v != 0 || len(s) == 0 {
+		s = append(s, fmt.Sprintf("0x%x", v))
+	}
+
+	return strings.Join(s, "|")
+}
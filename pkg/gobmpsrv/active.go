@@ -0,0 +1,116 @@
+package gobmpsrv
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/events"
+)
+
+// ActiveConfig defines routers or BMP senders the collector connects to, in addition to BMP sessions accepted
+// by the listener, for devices and proxies which only accept connections from collectors
+type ActiveConfig struct {
+	// Routers lists addresses of routers in host:port form
+	Routers []string
+	// MinBackoff is the delay before the router is reconnected, the delay is doubled for every failed connection
+	// up to MaxBackoff, 0 selects 1 second
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay before the router is reconnected, 0 selects 1 minute
+	MaxBackoff time.Duration
+	// DialTimeout is the timeout of connections to routers, 0 selects 10 seconds
+	DialTimeout time.Duration
+}
+
+// Validate returns error if addresses of routers are invalid or periods are negative
+func (c *ActiveConfig) Validate() error {
+	if c.MinBackoff < 0 || c.MaxBackoff < 0 || c.DialTimeout < 0 {
+		return fmt.Errorf("invalid backoff %s to %s or dial timeout %s of active sessions", c.MinBackoff, c.MaxBackoff, c.DialTimeout)
+	}
+	if c.MinBackoff != 0 && c.MaxBackoff != 0 && c.MinBackoff > c.MaxBackoff {
+		return fmt.Errorf("minimum backoff %s of active sessions exceeds maximum backoff %s", c.MinBackoff, c.MaxBackoff)
+	}
+	seen := make(map[string]bool)
+	for _, r := range c.Routers {
+		host, port, err := net.SplitHostPort(r)
+		if err != nil || host == "" || port == "" {
+			return fmt.Errorf("invalid address %q of active session router, the address must be host:port", r)
+		}
+		if seen[r] {
+			return fmt.Errorf("duplicate address %s of active session router", r)
+		}
+		seen[r] = true
+	}
+
+	return nil
+}
+
+// withDefaults returns the configuration with periods which are not set replaced by defaults
+func (c *ActiveConfig) withDefaults() *ActiveConfig {
+	if c == nil {
+		return nil
+	}
+	d := *c
+	if d.MinBackoff == 0 {
+		d.MinBackoff = time.Second
+	}
+	if d.MaxBackoff == 0 {
+		d.MaxBackoff = time.Minute
+	}
+	if d.MinBackoff > d.MaxBackoff {
+		d.MinBackoff = d.MaxBackoff
+	}
+	if d.DialTimeout == 0 {
+		d.DialTimeout = 10 * time.Second
+	}
+
+	return &d
+}
+
+// activeListener is the configuration of sessions connected to routers, TLS applies only to accepted sessions
+var activeListener = &ListenerConfig{Name: ActiveListener}
+
+// connect connects to the router and runs BMP session over the connection, the router is reconnected with
+// exponential backoff once the connection fails or the session is closed, until the server is stopped.
+// The backoff is reset by sessions lasting longer than the maximum backoff.
+func (srv *bmpServer) connect(addr string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-srv.stop
+		cancel()
+	}()
+	dialer := net.Dialer{
+		Timeout:   srv.active.DialTimeout,
+		KeepAlive: srv.socketOptions.KeepAlive,
+		Control:   control(srv.socketOptions),
+	}
+	backoff := srv.active.MinBackoff
+	for {
+		conn, err := dialer.DialContext(ctx, "tcp", addr)
+		if err == nil {
+			glog.Infof("connected to router %s, starting bmp session", addr)
+			setSessionOptions(conn, srv.socketOptions)
+			start := time.Now()
+			srv.bmpWorker(conn, activeListener)
+			if time.Since(start) > srv.active.MaxBackoff {
+				backoff = srv.active.MinBackoff
+			}
+		} else if ctx.Err() == nil {
+			glog.Errorf("failed to connect to router %s with error: %+v", addr, err)
+			host, _, _ := net.SplitHostPort(addr)
+			events.Report(events.CodeConnectFailed, host, "failed to connect to router %s with error: %+v", addr, err)
+		}
+		glog.V(5).Infof("reconnecting to router %s in %s", addr, backoff)
+		select {
+		case <-time.After(backoff):
+		case <-srv.stop:
+			return
+		}
+		if backoff *= 2; backoff > srv.active.MaxBackoff {
+			backoff = srv.active.MaxBackoff
+		}
+	}
+}
@@ -1,21 +1,60 @@
 package gobmpsrv
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"io"
 	"net"
+	"os"
+	"sync/atomic"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/events"
+	"github.com/sbezverk/gobmp/pkg/memory"
 	"github.com/sbezverk/gobmp/pkg/message"
 	"github.com/sbezverk/gobmp/pkg/parser"
 	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/tracing"
 )
 
 // BMPServer defines methods to manage BMP Server
 type BMPServer interface {
 	Start()
 	Stop()
+	// Sessions returns the list of active BMP sessions
+	Sessions() []SessionInfo
+	// Peers returns the list of BGP peers monitored over active BMP sessions
+	Peers() []PeerInfo
+	// Routers returns the list of routers connected over active BMP sessions with their BGP peers
+	Routers() []RouterInfo
+	// CloseSession closes BMP session with the id
+	CloseSession(id uint64) error
+	// PauseRouter pauses or resumes publishing of messages received from the router
+	PauseRouter(router string, pause bool) error
+	// SetHexDump enables logging of received BMP messages in hexadecimal format within the scope,
+	// nil scope disables it.
+	SetHexDump(scope *HexDump) error
+	// HexDump returns the scope of hex dump logging, nil is returned when it is disabled
+	HexDump() *HexDump
+	// Vendors returns counters of BMP sessions per vendor of routers fingerprinted from sysDescr
+	Vendors() []VendorInfo
+	// Journals returns journals of raw BMP messages of active and closed sessions
+	Journals() ([]JournalInfo, error)
+	// ReplayJournal publishes messages of the journal starting from the message at the offset
+	ReplayJournal(name string, offset int64) error
+	// MemoryUsage returns estimated memory used by peer tables and BGP-LS topology of active BMP sessions
+	MemoryUsage() []memory.Usage
+	// Listening returns the error when BMP sessions are not accepted, because the server is not started
+	// or its listener failed
+	Listening() error
+	// TopicPrefix returns the topic prefix of the listener of the router's session, the router is identified
+	// by the address of the session or the local address of its Peer Up messages
+	TopicPrefix(router string) string
+	// SetAllowedPrefixes replaces prefixes of routers allowed to connect to the listener, established sessions
+	// are not affected
+	SetAllowedPrefixes(listener string, prefixes []string) error
 }
 
 type bmpServer struct {
@@ -24,14 +63,40 @@ type bmpServer struct {
 	publisher       pub.Publisher
 	sourcePort      int
 	destinationPort int
-	incoming        net.Listener
+	listeners       []*listener
 	stop            chan struct{}
+	sessions        *sessions
+	vendors         *vendorStats
+	hexDump         atomic.Pointer[hexDump]
+	captureDir      string
+	socketOptions   *SocketOptions
+	timestamps      *message.TimestampConfig
+	mirror          *message.MirrorConfig
+	rawUpdates      bool
+	journal         *JournalConfig
+	workers         *WorkerConfig
+	active          *ActiveConfig
+	started         atomic.Bool
 }
 
 func (srv *bmpServer) Start() {
 	// Starting bmp server server
-	glog.Infof("Starting gobmp server on %s, intercept mode: %t\n", srv.incoming.Addr().String(), srv.intercept)
-	go srv.server()
+	for _, l := range srv.listeners {
+		glog.Infof("Starting gobmp server listener %s on %s, intercept mode: %t\n", l.config.Name, l.incoming.Addr().String(), srv.intercept)
+	}
+	srv.started.Store(true)
+	for _, l := range srv.listeners {
+		go srv.server(l)
+	}
+	if srv.journal != nil && srv.journal.Retention > 0 {
+		go srv.cleanupJournals()
+	}
+	if srv.active != nil {
+		for _, addr := range srv.active.Routers {
+			glog.Infof("Starting active bmp session to router %s", addr)
+			go srv.connect(addr)
+		}
+	}
 }
 
 func (srv *bmpServer) Stop() {
@@ -42,20 +107,157 @@ func (srv *bmpServer) Stop() {
 	close(srv.stop)
 }
 
-func (srv *bmpServer) server() {
+func (srv *bmpServer) Sessions() []SessionInfo {
+	return srv.sessions.list()
+}
+
+func (srv *bmpServer) Peers() []PeerInfo {
+	return srv.sessions.peers()
+}
+
+func (srv *bmpServer) Routers() []RouterInfo {
+	return srv.sessions.connectedRouters()
+}
+
+func (srv *bmpServer) Vendors() []VendorInfo {
+	return srv.vendors.list()
+}
+
+func (srv *bmpServer) MemoryUsage() []memory.Usage {
+	return srv.sessions.memoryUsage()
+}
+
+func (srv *bmpServer) Listening() error {
+	for _, l := range srv.listeners {
+		if err, ok := l.err.Load().(error); ok {
+			return err
+		}
+	}
+	if !srv.started.Load() {
+		return fmt.Errorf("gobmp server is not started")
+	}
+	return nil
+}
+
+func (srv *bmpServer) TopicPrefix(router string) string {
+	return srv.sessions.topicPrefix(router)
+}
+
+func (srv *bmpServer) SetAllowedPrefixes(name string, prefixes []string) error {
+	for _, l := range srv.listeners {
+		if l.config.Name != name {
+			continue
+		}
+		allowed, err := parseAllowed(name, prefixes)
+		if err != nil {
+			return err
+		}
+		glog.Infof("setting allowed prefixes of listener %s to %v", name, prefixes)
+		l.allowed.Store(&allowed)
+		return nil
+	}
+
+	return fmt.Errorf("listener %s is not found", name)
+}
+
+func (srv *bmpServer) CloseSession(id uint64) error {
+	glog.Infof("closing bmp session %d by request", id)
+	return srv.sessions.close(id)
+}
+
+func (srv *bmpServer) PauseRouter(router string, pause bool) error {
+	glog.Infof("setting publishing paused for router %s to %t by request", router, pause)
+	return srv.sessions.pause(router, pause)
+}
+
+func (srv *bmpServer) SetHexDump(scope *HexDump) error {
+	if scope == nil {
+		glog.Infof("hex dump logging is disabled by request")
+		srv.stopHexDump(srv.hexDump.Load())
+		return nil
+	}
+	hd, err := newHexDump(scope, srv.captureDir)
+	if err != nil {
+		return err
+	}
+	glog.Infof("hex dump logging is enabled by request for routers: %v peers: %v message types: %v", scope.Routers, scope.Peers, scope.MessageTypes)
+	srv.stopHexDump(srv.hexDump.Swap(hd))
+	if !hd.deadline.IsZero() {
+		// Completing hex dump on time even if no more messages are received
+		time.AfterFunc(time.Until(hd.deadline), func() { srv.stopHexDump(hd) })
+	}
+
+	return nil
+}
+
+func (srv *bmpServer) HexDump() *HexDump {
+	hd := srv.hexDump.Load()
+	if hd == nil {
+		return nil
+	}
+
+	return hd.status()
+}
+
+// stopHexDump completes hex dump and disables it, unless it has already been replaced
+func (srv *bmpServer) stopHexDump(hd *hexDump) {
+	if hd == nil {
+		return
+	}
+	hd.Lock()
+	hd.finish()
+	hd.Unlock()
+	srv.hexDump.CompareAndSwap(hd, nil)
+}
+
+func (srv *bmpServer) server(l *listener) {
 	for {
-		client, err := srv.incoming.Accept()
+		client, err := l.incoming.Accept()
 		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				// The listener does not recover, so the collector is reported not healthy
+				glog.Errorf("listener %s on %s is closed, no more client connections are accepted", l.config.Name, l.incoming.Addr().String())
+				l.err.Store(fmt.Errorf("listener %s on %s is closed", l.config.Name, l.incoming.Addr().String()))
+				return
+			}
 			glog.Errorf("fail to accept client connection with error: %+v", err)
 			continue
 		}
-		glog.V(5).Infof("client %+v accepted, calling bmpWorker", client.RemoteAddr())
-		go srv.bmpWorker(client)
+		if !l.allow(client.RemoteAddr()) {
+			host, _, _ := net.SplitHostPort(client.RemoteAddr().String())
+			glog.Errorf("client %+v is not allowed to connect to listener %s, closing the connection", client.RemoteAddr(), l.config.Name)
+			events.Report(events.CodeSessionRejected, host, "router is not allowed to connect to listener %s", l.config.Name)
+			client.Close()
+			continue
+		}
+		glog.V(5).Infof("client %+v accepted by listener %s, calling bmpWorker", client.RemoteAddr(), l.config.Name)
+		setSessionOptions(client, srv.socketOptions)
+		go srv.bmpWorker(client, l.config)
 	}
 }
 
-func (srv *bmpServer) bmpWorker(client net.Conn) {
-	defer client.Close()
+// bmpWorker runs BMP session over the client connection of the listener, TLS handshake is completed first
+// when TLS of the listener is not nil
+func (srv *bmpServer) bmpWorker(client net.Conn, l *ListenerConfig) {
+	defer func() { client.Close() }()
+	if l.TLS != nil {
+		tc, err := l.TLS.handshake(client)
+		if err != nil {
+			host, _, _ := net.SplitHostPort(client.RemoteAddr().String())
+			glog.Errorf("failed to establish tls session with client %+v with error: %+v", client.RemoteAddr(), err)
+			events.Report(events.CodeTLSFailed, host, "failed to establish tls session with error: %+v", err)
+			return
+		}
+		client = tc
+	}
+	s := srv.sessions.add(client, l)
+	defer srv.sessions.remove(s)
+	// The summary is published once the parser and the producer of the session are stopped
+	defer srv.publishSummary(s)
+	if j := srv.openJournal(s); j != nil {
+		s.journal.Store(j)
+		defer j.close()
+	}
 	var server net.Conn
 	var err error
 	if srv.intercept {
@@ -68,59 +270,161 @@ func (srv *bmpServer) bmpWorker(client net.Conn) {
 		glog.V(5).Infof("connection to destination server %v established, start intercepting", server.RemoteAddr())
 	}
 	var producerQueue chan bmp.Message
-	prod := message.NewProducer(srv.publisher, srv.splitAF)
+	prod := message.NewProducer(&sessionPublisher{Publisher: srv.publisher, s: s}, srv.splitAF, srv.timestamps, srv.mirror, srv.rawUpdates)
+	s.producer.Store(prod)
 	prodStop := make(chan struct{})
-	producerQueue = make(chan bmp.Message)
+	producerQueue = make(chan bmp.Message, srv.workers.QueueDepth)
 	// Starting messages producer per client with dedicated work queue
 	go prod.Producer(producerQueue, prodStop)
 
-	parserQueue := make(chan []byte)
+	parserQueue := make(chan parser.Request, srv.workers.QueueDepth)
 	parsStop := make(chan struct{})
+	parsedQueue := make(chan bmp.Message, srv.workers.QueueDepth)
 	// Starting parser per client with dedicated work queue
-	go parser.Parser(parserQueue, producerQueue, parsStop)
+	go parser.ParserWithRequests(parserQueue, parsedQueue, parsStop, srv.workers.ParserWorkers, func(msgType byte, err error) {
+		e := fmt.Sprintf("failed to parse %s message with error: %+v", bmpMessageTypeName(msgType), err)
+		srv.vendors.parseError(s.vendor(), msgType)
+		s.stats.parseError(msgType, e)
+		events.Report(events.CodeParseError, s.routerIP, "%s", e)
+	})
+	// Parsed messages update the session's peer table before they are passed to the producer
+	go func() {
+		for {
+			select {
+			case msg := <-parsedQueue:
+				s.peers.update(s, &msg)
+				if m, ok := msg.Payload.(*bmp.PeerUpMessage); ok && s.topicPrefix != "" {
+					srv.sessions.addRouter(m.GetLocalAddressString(), s)
+				}
+				srv.vendors.parsed(s.vendor(), &msg)
+				select {
+				case producerQueue <- msg:
+				case <-prodStop:
+					return
+				}
+			case <-prodStop:
+				return
+			}
+		}
+	}()
 	defer func() {
 		glog.V(5).Infof("all done with client %+v", client.RemoteAddr())
 		close(parsStop)
 		close(prodStop)
 	}()
+	rb := newRingBuffer(client)
+	var headerMsg [bmp.CommonHeaderLength]byte
 	for {
-		headerMsg := make([]byte, bmp.CommonHeaderLength)
-		if _, err := io.ReadAtLeast(client, headerMsg, bmp.CommonHeaderLength); err != nil {
+		if err := rb.peek(headerMsg[:]); err != nil {
+			if s.closed.Load() {
+				glog.Infof("session %d with client %+v is closed by request", s.id, client.RemoteAddr())
+				return
+			}
 			glog.Errorf("fail to read from client %+v with error: %+v", client.RemoteAddr(), err)
 			return
 		}
 		// Recovering common header first
-		header, err := bmp.UnmarshalCommonHeader(headerMsg[:bmp.CommonHeaderLength])
+		header, err := bmp.UnmarshalCommonHeader(headerMsg[:])
 		if err != nil {
-			glog.Errorf("fail to recover BMP message Common Header with error: %+v", err)
+			e := fmt.Sprintf("fail to recover BMP message Common Header with error: %+v", err)
+			glog.Error(e)
+			s.stats.invalidMessage(e)
+			events.Report(events.CodeInvalidBMPMessage, s.routerIP, "%s", e)
+			rb.discard(bmp.CommonHeaderLength)
 			continue
 		}
-		// Allocating space for the message body
-		msg := make([]byte, int(header.MessageLength)-bmp.CommonHeaderLength)
-		if _, err := io.ReadFull(client, msg); err != nil {
-			glog.Errorf("fail to read from client %+v with error: %+v", client.RemoteAddr(), err)
+		// The trace of the message starts once its Common Header is received and the span ends when the message is
+		// queued to the parser, so it covers reading of the message and waiting for the parser
+		ctx, span := tracing.Start(context.Background(), "bmp.receive", tracing.KindServer)
+		if span != nil {
+			span.SetAttributes(tracing.String("bmp.router", s.routerIP), tracing.Int("bmp.session", int(s.id)),
+				tracing.Int("bmp.message_type", int(header.MessageType)), tracing.Int("bmp.length", int(header.MessageLength)))
+		}
+		if header.MessageLength < bmp.CommonHeaderLength {
+			span.End()
+			glog.Errorf("invalid BMP message length %d received from client %+v", header.MessageLength, client.RemoteAddr())
+			events.Report(events.CodeInvalidBMPMessage, s.routerIP, "invalid BMP message length %d received from client %+v", header.MessageLength, client.RemoteAddr())
 			return
 		}
-
+		// Allocating space for the complete message, the message is copied out of the receive buffer once
 		fullMsg := make([]byte, int(header.MessageLength))
-		copy(fullMsg, headerMsg)
-		copy(fullMsg[bmp.CommonHeaderLength:], msg)
+		if err := rb.read(fullMsg); err != nil {
+			span.SetError(err)
+			span.End()
+			glog.Errorf("fail to read from client %+v with error: %+v", client.RemoteAddr(), err)
+			return
+		}
 		// Sending information to the server only in intercept mode
 		if srv.intercept {
 			if _, err := server.Write(fullMsg); err != nil {
+				span.SetError(err)
+				span.End()
 				glog.Errorf("fail to write to server %+v with error: %+v", server.RemoteAddr(), err)
 				return
 			}
 		}
-		parserQueue <- fullMsg
+		s.received.Add(1)
+		s.journal.Load().write(fullMsg)
+		s.stats.bmpMessage(header.MessageType)
+		switch header.MessageType {
+		case bmp.InitiationMsg:
+			srv.initiation(s, fullMsg[bmp.CommonHeaderLength:])
+		case bmp.TerminationMsg:
+			s.stats.terminated(fullMsg[bmp.CommonHeaderLength:])
+		}
+		srv.vendors.message(s.vendor(), header.MessageType)
+		if hd := srv.hexDump.Load(); hd != nil && hd.dump(s, header.MessageType, fullMsg) {
+			srv.stopHexDump(hd)
+		}
+		parserQueue <- parser.Request{Context: ctx, Message: fullMsg}
+		span.End()
+	}
+}
+
+// initiation fingerprints the vendor of the session's router from sysDescr of Initiation message
+func (srv *bmpServer) initiation(s *session, b []byte) {
+	sysDescr, sysName, err := initiationInfo(b)
+	if err != nil {
+		// The error is reported by the parser
+		return
 	}
+	i := &initiation{sysName: sysName, sysDescr: sysDescr, vendor: fingerprint(sysDescr)}
+	s.initiation.Store(i)
+	srv.vendors.session(i.vendor, sysDescr)
+	glog.V(5).Infof("router %s of session %d is fingerprinted as %s, sysDescr: %q", s.routerIP, s.id, i.vendor, sysDescr)
 }
 
-// NewBMPServer instantiates a new instance of BMP Server
-func NewBMPServer(sPort, dPort int, intercept bool, p pub.Publisher, splitAF bool) (BMPServer, error) {
-	incoming, err := net.Listen("tcp", fmt.Sprintf(":%d", sPort))
+// NewBMPServer instantiates a new instance of BMP Server, captureDir is the directory where
+// hex dump files are created, hex dump to files is disabled when captureDir is empty.
+// Socket options are applied to the listener and BMP sessions, nil opts selects DefaultSocketOptions.
+// ts selects the source of messages timestamps, nil ts selects timestamps of Per-Peer Headers.
+// mirror selects types of BGP messages of Route Mirroring messages which are decoded.
+// When rawUpdates is true, BGP Update messages of Route Monitoring messages are published as received as well.
+// When journal is not nil, raw BMP messages of sessions are written to the journal.
+// workers sizes the pipeline of sessions, nil workers and sizes which are not set select DefaultWorkerConfig.
+// When active is not nil, the server connects to routers of active, in addition to accepting BMP sessions.
+// listeners are additional listeners of BMP sessions, accepted in addition to sessions on the source port.
+func NewBMPServer(sPort, dPort int, intercept bool, p pub.Publisher, splitAF bool, captureDir string, opts *SocketOptions, ts *message.TimestampConfig, mirror *message.MirrorConfig, rawUpdates bool, journal *JournalConfig, workers *WorkerConfig, active *ActiveConfig, listeners []*ListenerConfig) (BMPServer, error) {
+	if opts == nil {
+		opts = DefaultSocketOptions()
+	}
+	if workers != nil {
+		if err := workers.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if active != nil {
+		if err := active.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if journal != nil {
+		if fi, err := os.Stat(journal.Dir); err != nil || !fi.IsDir() {
+			return nil, fmt.Errorf("journal directory %s does not exist", journal.Dir)
+		}
+	}
+	ls, err := newListeners(sPort, opts, listeners)
 	if err != nil {
-		glog.Errorf("fail to setup listener on port %d with error: %+v", sPort, err)
 		return nil, err
 	}
 	bmp := bmpServer{
@@ -129,8 +433,18 @@ func NewBMPServer(sPort, dPort int, intercept bool, p pub.Publisher, splitAF boo
 		destinationPort: dPort,
 		intercept:       intercept,
 		publisher:       p,
-		incoming:        incoming,
+		listeners:       ls,
 		splitAF:         splitAF,
+		sessions:        newSessions(),
+		vendors:         newVendorStats(),
+		captureDir:      captureDir,
+		socketOptions:   opts,
+		timestamps:      ts,
+		mirror:          mirror,
+		rawUpdates:      rawUpdates,
+		journal:         journal,
+		workers:         workers.withDefaults(),
+		active:          active.withDefaults(),
 	}
 
 	return &bmp, nil
@@ -0,0 +1,205 @@
+package gobmpsrv
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/tools"
+)
+
+// bmpMessageTypeNames maps BMP message types to the names used to scope hex dump
+var bmpMessageTypeNames = map[string]byte{
+	"route_monitor":     bmp.RouteMonitorMsg,
+	"statistics_report": bmp.StatsReportMsg,
+	"peer_down":         bmp.PeerDownMsg,
+	"peer_up":           bmp.PeerUpMsg,
+	"initiation":        bmp.InitiationMsg,
+	"termination":       bmp.TerminationMsg,
+	"route_mirror":      bmp.RouteMirrorMsg,
+}
+
+// HexDump defines the scope of logging received BMP messages in hexadecimal format, empty
+// lists of routers, peers and message types match all routers, peers and message types.
+// When MaxMessages or Duration is specified, hex dump gets disabled after logging MaxMessages
+// messages or after Duration, whichever comes first. When File is specified, messages are written
+// to the file in the capture directory instead of the log.
+type HexDump struct {
+	Routers      []string `json:"routers,omitempty"`
+	Peers        []string `json:"peers,omitempty"`
+	MessageTypes []string `json:"message_types,omitempty"`
+	MaxMessages  uint64   `json:"max_messages,omitempty"`
+	// Duration uses Go duration syntax, for example "30s" or "5m"
+	Duration string `json:"duration,omitempty"`
+	File     string `json:"file,omitempty"`
+	// Captured and Expires report the state of the running hex dump
+	Captured uint64 `json:"captured"`
+	Expires  string `json:"expires,omitempty"`
+	routers  map[string]bool
+	peers    map[string]bool
+	msgTypes map[byte]bool
+}
+
+func (h *HexDump) init() error {
+	h.routers = make(map[string]bool)
+	for _, r := range h.Routers {
+		ip := net.ParseIP(r)
+		if ip == nil {
+			return fmt.Errorf("invalid router address %q", r)
+		}
+		h.routers[ip.String()] = true
+	}
+	h.peers = make(map[string]bool)
+	for _, p := range h.Peers {
+		ip := net.ParseIP(p)
+		if ip == nil {
+			return fmt.Errorf("invalid peer address %q", p)
+		}
+		h.peers[ip.String()] = true
+	}
+	h.msgTypes = make(map[byte]bool)
+	for _, n := range h.MessageTypes {
+		t, ok := bmpMessageTypeNames[n]
+		if !ok {
+			return fmt.Errorf("unknown bmp message type %q", n)
+		}
+		h.msgTypes[t] = true
+	}
+
+	return nil
+}
+
+// match returns true if the message falls into hex dump scope, peer is empty for messages without Per Peer Header
+func (h *HexDump) match(router, peer string, msgType byte) bool {
+	if len(h.routers) != 0 && !h.routers[router] {
+		return false
+	}
+	if len(h.peers) != 0 && !h.peers[peer] {
+		return false
+	}
+	if len(h.msgTypes) != 0 && !h.msgTypes[msgType] {
+		return false
+	}
+
+	return true
+}
+
+// hexDump keeps the state of the running hex dump
+type hexDump struct {
+	sync.Mutex
+	scope    *HexDump
+	captured uint64
+	deadline time.Time
+	file     *os.File
+	done     bool
+}
+
+func newHexDump(scope *HexDump, captureDir string) (*hexDump, error) {
+	if err := scope.init(); err != nil {
+		return nil, err
+	}
+	hd := &hexDump{
+		scope: scope,
+	}
+	if scope.Duration != "" {
+		d, err := time.ParseDuration(scope.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration %q", scope.Duration)
+		}
+		hd.deadline = time.Now().Add(d)
+	}
+	if scope.File != "" {
+		// Files are only created in the capture directory
+		if captureDir == "" {
+			return nil, fmt.Errorf("capture directory is not configured, hex dump to file is not available")
+		}
+		if scope.File != filepath.Base(scope.File) || strings.HasPrefix(scope.File, ".") {
+			return nil, fmt.Errorf("invalid file name %q", scope.File)
+		}
+		f, err := os.OpenFile(filepath.Join(captureDir, scope.File), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+		if err != nil {
+			return nil, err
+		}
+		hd.file = f
+	}
+
+	return hd, nil
+}
+
+// dump logs the message when message falls into hex dump scope, it returns true when hex dump is complete
+// and must be disabled.
+func (hd *hexDump) dump(s *session, msgType byte, msg []byte) bool {
+	peer := ""
+	switch msgType {
+	case bmp.RouteMonitorMsg, bmp.StatsReportMsg, bmp.PeerDownMsg, bmp.PeerUpMsg, bmp.RouteMirrorMsg:
+		if len(msg) >= bmp.CommonHeaderLength+bmp.PerPeerHeaderLength {
+			if ph, err := bmp.UnmarshalPerPeerHeader(msg[bmp.CommonHeaderLength : bmp.CommonHeaderLength+bmp.PerPeerHeaderLength]); err == nil {
+				peer = ph.GetPeerAddrString()
+			}
+		}
+	}
+	hd.Lock()
+	defer hd.Unlock()
+	if hd.done {
+		return true
+	}
+	if !hd.deadline.IsZero() && time.Now().After(hd.deadline) {
+		hd.finish()
+		return true
+	}
+	if !hd.scope.match(s.routerIP, peer, msgType) {
+		return false
+	}
+	hd.captured++
+	if peer == "" {
+		peer = "-"
+	}
+	if hd.file != nil {
+		if _, err := fmt.Fprintf(hd.file, "%s router %s peer %s session %d type %d %s\n",
+			time.Now().UTC().Format(time.RFC3339Nano), s.routerIP, peer, s.id, msgType, hex.EncodeToString(msg)); err != nil {
+			glog.Errorf("failed to write hex dump to %s with error: %+v", hd.file.Name(), err)
+			hd.finish()
+			return true
+		}
+	} else {
+		glog.Infof("router %s peer %s session %d bmp message type %d content: %s", s.routerIP, peer, s.id, msgType, tools.MessageHex(msg))
+	}
+	if hd.scope.MaxMessages != 0 && hd.captured >= hd.scope.MaxMessages {
+		hd.finish()
+		return true
+	}
+
+	return false
+}
+
+// finish completes hex dump, must be called with the lock held
+func (hd *hexDump) finish() {
+	if hd.done {
+		return
+	}
+	hd.done = true
+	if hd.file != nil {
+		hd.file.Close()
+	}
+	glog.Infof("hex dump is complete, %d messages captured", hd.captured)
+}
+
+// status returns the copy of hex dump scope with its current state
+func (hd *hexDump) status() *HexDump {
+	hd.Lock()
+	defer hd.Unlock()
+	s := *hd.scope
+	s.Captured = hd.captured
+	if !hd.deadline.IsZero() {
+		s.Expires = hd.deadline.UTC().Format(time.RFC3339)
+	}
+
+	return &s
+}
@@ -0,0 +1,310 @@
+package gobmpsrv
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/events"
+	"github.com/sbezverk/gobmp/pkg/parser"
+)
+
+const (
+	journalSuffix     = ".bmp"
+	journalTimeFormat = "20060102T150405Z"
+	// journalCleanupInterval is the period between removals of journals older than the retention
+	journalCleanupInterval = 10 * time.Minute
+)
+
+// JournalConfig defines the journal of raw BMP messages, messages of each BMP session are written to a file in Dir
+// before they are parsed. Journals of closed sessions are removed when they were last written more than Retention
+// ago, 0 Retention keeps journals until they are removed by the operator.
+type JournalConfig struct {
+	Dir       string
+	Retention time.Duration
+}
+
+// JournalInfo defines a journal of raw BMP messages of a session, the journal's file carries BMP messages as received
+// from the router. Offsets are byte offsets of messages in the file, Size is the offset of the next message.
+type JournalInfo struct {
+	Name      string `json:"name"`
+	SessionID uint64 `json:"session_id"`
+	RouterIP  string `json:"router_ip"`
+	Started   string `json:"started"`
+	Size      int64  `json:"size"`
+	Active    bool   `json:"active"`
+}
+
+// journal is the writer of the journal of a session
+type journal struct {
+	sync.Mutex
+	name   string
+	file   *os.File
+	offset int64
+	failed bool
+}
+
+// journalName returns the name of the journal of the session, the name is ordered by the start of the session
+// and carries the session id and the router address, ':' of IPv6 addresses is replaced by '-'
+func journalName(s *session) string {
+	return s.connectedSince.UTC().Format(journalTimeFormat) + "_" + strconv.FormatUint(s.id, 10) + "_" +
+		strings.ReplaceAll(s.routerIP, ":", "-") + journalSuffix
+}
+
+// parseJournalName returns information carried by the name of a journal
+func parseJournalName(name string) (*JournalInfo, error) {
+	parts := strings.SplitN(strings.TrimSuffix(name, journalSuffix), "_", 3)
+	if !strings.HasSuffix(name, journalSuffix) || len(parts) != 3 {
+		return nil, fmt.Errorf("invalid journal name %q", name)
+	}
+	started, err := time.Parse(journalTimeFormat, parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid journal name %q", name)
+	}
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid journal name %q", name)
+	}
+
+	return &JournalInfo{
+		Name:      name,
+		SessionID: id,
+		RouterIP:  strings.ReplaceAll(parts[2], "-", ":"),
+		Started:   started.Format(time.RFC3339),
+	}, nil
+}
+
+func (srv *bmpServer) openJournal(s *session) *journal {
+	if srv.journal == nil {
+		return nil
+	}
+	j := &journal{name: journalName(s)}
+	f, err := os.OpenFile(filepath.Join(srv.journal.Dir, j.name), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		glog.Errorf("failed to create journal of session %d with error: %+v", s.id, err)
+		events.Report(events.CodeJournalFailed, s.routerIP, "failed to create journal of session %d with error: %+v", s.id, err)
+		return nil
+	}
+	j.file = f
+	glog.V(5).Infof("journal %s of session %d is created", j.name, s.id)
+
+	return j
+}
+
+// write appends the message to the journal, the journal stops being written after a failure
+func (j *journal) write(b []byte) {
+	if j == nil {
+		return
+	}
+	j.Lock()
+	defer j.Unlock()
+	if j.failed {
+		return
+	}
+	n, err := j.file.Write(b)
+	j.offset += int64(n)
+	if err != nil {
+		j.failed = true
+		glog.Errorf("failed to write journal %s, journaling of the session is stopped, with error: %+v", j.name, err)
+		events.Report(events.CodeJournalFailed, "", "failed to write journal %s, journaling of the session is stopped, with error: %+v", j.name, err)
+	}
+}
+
+func (j *journal) size() int64 {
+	j.Lock()
+	defer j.Unlock()
+	return j.offset
+}
+
+func (j *journal) close() {
+	if j == nil {
+		return
+	}
+	j.Lock()
+	defer j.Unlock()
+	if err := j.file.Close(); err != nil {
+		glog.Errorf("failed to close journal %s with error: %+v", j.name, err)
+	}
+}
+
+// journals returns journals of active sessions by name
+func (ss *sessions) journals() map[string]*journal {
+	ss.Lock()
+	defer ss.Unlock()
+	m := make(map[string]*journal)
+	for _, s := range ss.sessions {
+		if j := s.journal.Load(); j != nil {
+			m[j.name] = j
+		}
+	}
+
+	return m
+}
+
+func (srv *bmpServer) Journals() ([]JournalInfo, error) {
+	if srv.journal == nil {
+		return nil, fmt.Errorf("journal is not enabled")
+	}
+	entries, err := os.ReadDir(srv.journal.Dir)
+	if err != nil {
+		return nil, err
+	}
+	active := srv.sessions.journals()
+	l := make([]JournalInfo, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), journalSuffix) {
+			continue
+		}
+		info, err := parseJournalName(e.Name())
+		if err != nil {
+			continue
+		}
+		if j, ok := active[info.Name]; ok {
+			info.Active = true
+			info.Size = j.size()
+		} else if fi, err := e.Info(); err == nil {
+			info.Size = fi.Size()
+		}
+		l = append(l, *info)
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].Name < l[j].Name })
+
+	return l, nil
+}
+
+// journalFile opens the journal for replay and returns the size of its complete messages
+func (srv *bmpServer) journalFile(name string) (*os.File, int64, error) {
+	if srv.journal == nil {
+		return nil, 0, fmt.Errorf("journal is not enabled")
+	}
+	if _, err := parseJournalName(name); err != nil || filepath.Base(name) != name {
+		return nil, 0, fmt.Errorf("invalid journal name %q", name)
+	}
+	f, err := os.Open(filepath.Join(srv.journal.Dir, name))
+	if err != nil {
+		return nil, 0, err
+	}
+	// The journal of an active session is replayed up to the last completely written message
+	size := int64(-1)
+	if j, ok := srv.sessions.journals()[name]; ok {
+		size = j.size()
+	} else if fi, err := f.Stat(); err == nil {
+		size = fi.Size()
+	}
+	if size < 0 {
+		f.Close()
+		return nil, 0, fmt.Errorf("failed to get size of journal %s", name)
+	}
+
+	return f, size, nil
+}
+
+// readJournalMessage reads BMP message at the offset of the journal
+func readJournalMessage(r io.ReaderAt, offset int64) ([]byte, error) {
+	var h [bmp.CommonHeaderLength]byte
+	if _, err := r.ReadAt(h[:], offset); err != nil {
+		return nil, err
+	}
+	header, err := bmp.UnmarshalCommonHeader(h[:])
+	if err != nil {
+		return nil, err
+	}
+	if header.MessageLength < bmp.CommonHeaderLength {
+		return nil, fmt.Errorf("invalid BMP message length %d", header.MessageLength)
+	}
+	b := make([]byte, int(header.MessageLength))
+	if _, err := r.ReadAt(b, offset); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (srv *bmpServer) ReplayJournal(name string, offset int64) error {
+	f, size, err := srv.journalFile(name)
+	if err != nil {
+		return err
+	}
+	if offset < 0 || offset >= size {
+		f.Close()
+		return fmt.Errorf("offset %d is out of journal %s of size %d", offset, name, size)
+	}
+	// The offset has to point to the beginning of a message
+	if _, err := readJournalMessage(f, offset); err != nil {
+		f.Close()
+		return fmt.Errorf("offset %d of journal %s is not the beginning of a BMP message", offset, name)
+	}
+	glog.Infof("replaying journal %s from offset %d to offset %d", name, offset, size)
+	go srv.replay(f, name, offset, size)
+
+	return nil
+}
+
+// replay parses messages of the journal between offsets and publishes them, the messages are processed
+// by the dedicated producer of a replay stream, as if they were received over a new session
+func (srv *bmpServer) replay(f *os.File, name string, offset, size int64) {
+	defer f.Close()
+	s := newReplayStream(name, srv.publisher, &ReplayConfig{
+		SplitAF:    srv.splitAF,
+		Timestamps: srv.timestamps,
+		Mirror:     srv.mirror,
+		RawUpdates: srv.rawUpdates,
+	})
+	// Closing the stream waits for parsed messages to be published
+	defer s.close()
+	for offset < size {
+		b, err := readJournalMessage(f, offset)
+		if err != nil {
+			glog.Errorf("failed to read journal %s at offset %d, replay is stopped, with error: %+v", name, offset, err)
+			return
+		}
+		// Messages are produced one by one, so they are published in the order of the journal
+		parser.Parse(b, s.queue, nil)
+		offset += int64(len(b))
+		s.n++
+	}
+}
+
+// cleanupJournals periodically removes journals of closed sessions last written more than the retention ago
+func (srv *bmpServer) cleanupJournals() {
+	t := time.NewTicker(journalCleanupInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+		case <-srv.stop:
+			return
+		}
+		entries, err := os.ReadDir(srv.journal.Dir)
+		if err != nil {
+			glog.Errorf("failed to list journals with error: %+v", err)
+			continue
+		}
+		active := srv.sessions.journals()
+		for _, e := range entries {
+			if _, ok := active[e.Name()]; ok {
+				continue
+			}
+			if _, err := parseJournalName(e.Name()); err != nil {
+				continue
+			}
+			fi, err := e.Info()
+			if err != nil || time.Since(fi.ModTime()) < srv.journal.Retention {
+				continue
+			}
+			if err := os.Remove(filepath.Join(srv.journal.Dir, e.Name())); err != nil {
+				glog.Errorf("failed to remove journal %s with error: %+v", e.Name(), err)
+				continue
+			}
+			glog.V(5).Infof("journal %s is removed after retention of %s", e.Name(), srv.journal.Retention)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package gobmpsrv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pubtest"
+)
+
+// peerDownMessage returns Peer Down message of the IPv4 peer of the AS, the peer closed the session without
+// Notification
+func peerDownMessage(as uint32) []byte {
+	ph := make([]byte, bmp.PerPeerHeaderLength)
+	copy(ph[22:26], []byte{192, 168, 1, byte(as)})
+	binary.BigEndian.PutUint32(ph[26:30], as)
+	copy(ph[30:34], []byte{192, 168, 1, byte(as)})
+
+	return bmpMessage(bmp.PeerDownMsg, append(ph, 4))
+}
+
+func TestJournalName(t *testing.T) {
+	tests := []struct {
+		name    string
+		session *session
+		journal string
+	}{
+		{
+			name:    "ipv4",
+			session: &session{id: 7, routerIP: "10.0.0.1", connectedSince: time.Date(2026, 10, 14, 10, 1, 2, 0, time.UTC)},
+			journal: "20261014T100102Z_7_10.0.0.1.bmp",
+		},
+		{
+			name:    "ipv6",
+			session: &session{id: 12, routerIP: "2001:db8::1", connectedSince: time.Date(2026, 10, 14, 10, 1, 2, 0, time.UTC)},
+			journal: "20261014T100102Z_12_2001-db8--1.bmp",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := journalName(tt.session)
+			if name != tt.journal {
+				t.Fatalf("expected journal %s but got %s", tt.journal, name)
+			}
+			info, err := parseJournalName(name)
+			if err != nil {
+				t.Fatalf("failed to parse journal name with error: %+v", err)
+			}
+			want := &JournalInfo{Name: name, SessionID: tt.session.id, RouterIP: tt.session.routerIP, Started: "2026-10-14T10:01:02Z"}
+			if !reflect.DeepEqual(info, want) {
+				t.Errorf("expected journal %+v but got %+v", want, info)
+			}
+		})
+	}
+	for _, name := range []string{"20261014T100102Z_7_10.0.0.1.log", "20261014T100102Z_7.bmp", "20261014_7_10.0.0.1.bmp", "20261014T100102Z_x_10.0.0.1.bmp"} {
+		if _, err := parseJournalName(name); err == nil {
+			t.Errorf("expected error parsing invalid journal name %s", name)
+		}
+	}
+}
+
+func TestReplayJournal(t *testing.T) {
+	dir := t.TempDir()
+	rec := pubtest.NewRecorder()
+	srv := &bmpServer{
+		publisher: rec,
+		sessions:  newSessions(),
+		journal:   &JournalConfig{Dir: dir},
+		workers:   (&WorkerConfig{}).withDefaults(),
+	}
+	s := &session{id: 1, routerIP: "10.0.0.1", connectedSince: time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)}
+	j := srv.openJournal(s)
+	if j == nil {
+		t.Fatalf("failed to open journal")
+	}
+	defer j.close()
+	// Offsets of messages of the journal, the last offset is the size of the journal
+	offsets := []int64{0}
+	for _, as := range []uint32{1, 2, 3} {
+		j.write(peerDownMessage(as))
+		offsets = append(offsets, j.size())
+	}
+	if offsets[1] != int64(len(peerDownMessage(1))) || offsets[3] != 3*offsets[1] {
+		t.Fatalf("unexpected offsets of journal messages %v", offsets)
+	}
+	// The message being written by the active session is not replayed
+	f, err := os.OpenFile(filepath.Join(dir, j.name), os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write(peerDownMessage(4)[:10]); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	s.journal.Store(j)
+	srv.sessions.sessions[s.id] = s
+
+	l, err := srv.Journals()
+	if err != nil {
+		t.Fatalf("failed to list journals with error: %+v", err)
+	}
+	if len(l) != 1 || l[0].Name != j.name || !l[0].Active || l[0].Size != offsets[3] {
+		t.Fatalf("expected active journal %s of size %d but got %+v", j.name, offsets[3], l)
+	}
+
+	tests := []struct {
+		name   string
+		offset int64
+		asns   []string
+		fail   bool
+	}{
+		{
+			name:   "from the beginning",
+			offset: 0,
+			asns:   []string{"1", "2", "3"},
+		},
+		{
+			name:   "resume from the offset of the second message",
+			offset: offsets[1],
+			asns:   []string{"2", "3"},
+		},
+		{
+			name:   "resume from the offset of the last message",
+			offset: offsets[2],
+			asns:   []string{"3"},
+		},
+		{
+			name:   "offset within a message",
+			offset: offsets[1] + 1,
+			fail:   true,
+		},
+		{
+			name:   "offset of the message being written",
+			offset: offsets[3],
+			fail:   true,
+		},
+		{
+			name:   "negative offset",
+			offset: -1,
+			fail:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec.Reset()
+			err := srv.ReplayJournal(j.name, tt.offset)
+			if tt.fail {
+				if err == nil {
+					t.Fatalf("expected error replaying journal from offset %d", tt.offset)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to replay journal with error: %+v", err)
+			}
+			msgs, err := rec.WaitFor(len(tt.asns), 5*time.Second, bmp.PeerStateChangeMsg)
+			if err != nil {
+				t.Fatalf("replayed messages are not published with error: %+v", err)
+			}
+			// Waiting for messages published after the expected ones
+			time.Sleep(50 * time.Millisecond)
+			if n := rec.Count(bmp.PeerStateChangeMsg); n != len(tt.asns) {
+				t.Fatalf("expected %d replayed messages but got %d", len(tt.asns), n)
+			}
+			asns := make([]string, 0, len(msgs))
+			for _, m := range msgs {
+				asn, _ := m.Field("remote_asn")
+				asns = append(asns, fmt.Sprint(asn))
+			}
+			if !reflect.DeepEqual(asns, tt.asns) {
+				t.Errorf("expected messages of peers of AS %v but got %v", tt.asns, asns)
+			}
+		})
+	}
+	if err := srv.ReplayJournal("../"+j.name, 0); err == nil {
+		t.Errorf("expected error replaying journal outside of the journal directory")
+	}
+}
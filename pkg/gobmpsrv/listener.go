@@ -0,0 +1,195 @@
+package gobmpsrv
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"regexp"
+	"sync/atomic"
+
+	"github.com/golang/glog"
+)
+
+// DefaultListener is the name of the listener on the source port, ActiveListener is the name reported for
+// sessions connected by the collector to routers of ActiveConfig, QUICListener is the name of the experimental
+// listener of BMP sessions over QUIC
+const (
+	DefaultListener = "default"
+	ActiveListener  = "active"
+	QUICListener    = "quic"
+)
+
+var (
+	listenerNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	topicPrefixPattern  = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+)
+
+// ListenerConfig defines an additional listener of BMP sessions, so one collector serves segregated management
+// networks. Socket options other than TLS are shared by all listeners.
+type ListenerConfig struct {
+	// Name identifies the listener in sessions of the admin API
+	Name string `json:"name"`
+	// Address is host:port the listener is bound to, the listener is bound to all addresses when host is empty
+	Address string `json:"address"`
+	// AllowedPrefixes lists addresses or prefixes in CIDR notation of routers allowed to connect to the listener,
+	// routers of all addresses are allowed when AllowedPrefixes is empty
+	AllowedPrefixes []string `json:"allowed_prefixes,omitempty"`
+	// TLS terminates TLS of sessions of the listener, sessions are not encrypted when TLS is nil
+	TLS *TLSConfig `json:"tls,omitempty"`
+	// TopicPrefix is prepended to topics of messages of routers connected to the listener
+	TopicPrefix string `json:"topic_prefix,omitempty"`
+	allowed     []netip.Prefix
+}
+
+// Validate returns error if the name, the address, allowed prefixes or the topic prefix of the listener are invalid
+func (l *ListenerConfig) Validate() error {
+	if !listenerNamePattern.MatchString(l.Name) || l.Name == DefaultListener || l.Name == ActiveListener || l.Name == QUICListener {
+		return fmt.Errorf("invalid listener name %q, the name must consist of letters, digits, '_' and '-' and must not be %q, %q or %q",
+			l.Name, DefaultListener, ActiveListener, QUICListener)
+	}
+	if _, port, err := net.SplitHostPort(l.Address); err != nil || port == "" {
+		return fmt.Errorf("invalid address %q of listener %s, the address must be host:port", l.Address, l.Name)
+	}
+	if l.TopicPrefix != "" && !topicPrefixPattern.MatchString(l.TopicPrefix) {
+		return fmt.Errorf("invalid topic prefix %q of listener %s, the prefix must consist of letters, digits, '.', '_' and '-'",
+			l.TopicPrefix, l.Name)
+	}
+	allowed, err := parseAllowed(l.Name, l.AllowedPrefixes)
+	if err != nil {
+		return err
+	}
+	l.allowed = allowed
+
+	return nil
+}
+
+// parseAllowed returns allowed prefixes of the listener
+func parseAllowed(name string, prefixes []string) ([]netip.Prefix, error) {
+	allowed := make([]netip.Prefix, 0, len(prefixes))
+	for _, s := range prefixes {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			addr, aerr := netip.ParseAddr(s)
+			if aerr != nil {
+				return nil, fmt.Errorf("invalid allowed prefix %q of listener %s", s, name)
+			}
+			p = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		allowed = append(allowed, p.Masked())
+	}
+
+	return allowed, nil
+}
+
+// allow returns true if the router is allowed to connect to the listener
+func (l *listener) allow(addr net.Addr) bool {
+	allowed := *l.allowed.Load()
+	if len(allowed) == 0 {
+		return true
+	}
+	var ip netip.Addr
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		ip, _ = netip.AddrFromSlice(a.IP)
+	case *net.UDPAddr:
+		ip, _ = netip.AddrFromSlice(a.IP)
+	default:
+		return false
+	}
+	ip = ip.Unmap()
+	for _, p := range allowed {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LoadListeners reads configurations of additional listeners from json file
+func LoadListeners(file string) ([]*ListenerConfig, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var listeners []*ListenerConfig
+	if err := json.Unmarshal(b, &listeners); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal listeners file %s with error: %+v", file, err)
+	}
+	for _, l := range listeners {
+		if err := l.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return listeners, nil
+}
+
+// listener accepts BMP sessions of the listener's configuration
+type listener struct {
+	config   *ListenerConfig
+	incoming net.Listener
+	// allowed holds allowed prefixes of the configuration, replaced when they are reloaded
+	allowed atomic.Pointer[[]netip.Prefix]
+	// err stores the error of the listener once it failed
+	err atomic.Value
+}
+
+func newListener(config *ListenerConfig, incoming net.Listener) *listener {
+	l := &listener{config: config, incoming: incoming}
+	l.allowed.Store(&config.allowed)
+
+	return l
+}
+
+// newListeners returns the listener on the source port followed by additional listeners, listeners already
+// created are closed when a listener fails to be created
+func newListeners(sPort int, opts *SocketOptions, configs []*ListenerConfig) ([]*listener, error) {
+	names := map[string]bool{DefaultListener: true, ActiveListener: true, QUICListener: true}
+	for _, c := range configs {
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+		if names[c.Name] {
+			return nil, fmt.Errorf("duplicate listener name %s", c.Name)
+		}
+		names[c.Name] = true
+	}
+	incoming, err := listen(fmt.Sprintf(":%d", sPort), opts)
+	if err != nil {
+		glog.Errorf("fail to setup listener on port %d with error: %+v", sPort, err)
+		return nil, err
+	}
+	listeners := []*listener{newListener(&ListenerConfig{Name: DefaultListener, TLS: opts.TLS}, incoming)}
+	for _, c := range configs {
+		// Inherited listener is the listener of the source port
+		lo := *opts
+		lo.Listener = nil
+		lo.TLS = c.TLS
+		incoming, err := listen(c.Address, &lo)
+		if err != nil {
+			glog.Errorf("fail to setup listener %s on %s with error: %+v", c.Name, c.Address, err)
+			for _, l := range listeners {
+				l.incoming.Close()
+			}
+			return nil, err
+		}
+		listeners = append(listeners, newListener(c, incoming))
+	}
+	if opts.QUICAddress != "" {
+		incoming, err := listenQUIC(opts.QUICAddress, opts)
+		if err != nil {
+			glog.Errorf("fail to setup quic listener on %s with error: %+v", opts.QUICAddress, err)
+			for _, l := range listeners {
+				l.incoming.Close()
+			}
+			return nil, err
+		}
+		// TLS of QUIC sessions is completed by the QUIC listener
+		listeners = append(listeners, newListener(&ListenerConfig{Name: QUICListener}, incoming))
+	}
+
+	return listeners, nil
+}
@@ -0,0 +1,146 @@
+package gobmpsrv
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pubtest"
+)
+
+// bmpMessage returns BMP message of the type with the body
+func bmpMessage(msgType byte, body []byte) []byte {
+	b := make([]byte, bmp.CommonHeaderLength, bmp.CommonHeaderLength+len(body))
+	b[0] = 3
+	binary.BigEndian.PutUint32(b[1:], uint32(bmp.CommonHeaderLength+len(body)))
+	b[5] = msgType
+
+	return append(b, body...)
+}
+
+func TestListenerAllow(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		addr    net.Addr
+		allow   bool
+	}{
+		{
+			name:  "all routers allowed without prefixes",
+			addr:  &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 50000},
+			allow: true,
+		},
+		{
+			name:    "address of allowed prefix",
+			allowed: []string{"192.0.2.0/24", "2001:db8::/32"},
+			addr:    &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 50000},
+			allow:   true,
+		},
+		{
+			name:    "ipv4 mapped address of allowed prefix",
+			allowed: []string{"192.0.2.0/24"},
+			addr:    &net.TCPAddr{IP: net.ParseIP("::ffff:192.0.2.1"), Port: 50000},
+			allow:   true,
+		},
+		{
+			name:    "ipv6 address of allowed prefix",
+			allowed: []string{"192.0.2.0/24", "2001:db8::/32"},
+			addr:    &net.TCPAddr{IP: net.ParseIP("2001:db8::1"), Port: 50000},
+			allow:   true,
+		},
+		{
+			name:    "allowed address",
+			allowed: []string{"192.0.2.1"},
+			addr:    &net.UDPAddr{IP: net.ParseIP("192.0.2.1"), Port: 50000},
+			allow:   true,
+		},
+		{
+			name:    "address out of allowed prefixes",
+			allowed: []string{"192.0.2.0/24", "2001:db8::/32"},
+			addr:    &net.TCPAddr{IP: net.ParseIP("198.51.100.1"), Port: 50000},
+		},
+		{
+			name:    "address next to allowed address",
+			allowed: []string{"192.0.2.1"},
+			addr:    &net.TCPAddr{IP: net.ParseIP("192.0.2.2"), Port: 50000},
+		},
+		{
+			name:    "address of unknown network",
+			allowed: []string{"192.0.2.0/24"},
+			addr:    &net.UnixAddr{Name: "/tmp/bmp.sock", Net: "unix"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &ListenerConfig{Name: "mgmt", Address: "127.0.0.1:0", AllowedPrefixes: tt.allowed}
+			if err := c.Validate(); err != nil {
+				t.Fatalf("failed to validate listener with error: %+v", err)
+			}
+			if allow := newListener(c, nil).allow(tt.addr); allow != tt.allow {
+				t.Errorf("expected allow %t of %s but got %t", tt.allow, tt.addr, allow)
+			}
+		})
+	}
+	c := &ListenerConfig{Name: "mgmt", Address: "127.0.0.1:0", AllowedPrefixes: []string{"192.0.2.0/33"}}
+	if err := c.Validate(); err == nil {
+		t.Errorf("expected error validating invalid allowed prefix")
+	}
+}
+
+func TestListenerAllowedPrefixes(t *testing.T) {
+	rec := pubtest.NewRecorder()
+	listeners := []*ListenerConfig{{Name: "mgmt", Address: "127.0.0.1:0", AllowedPrefixes: []string{"127.0.0.2/32"}}}
+	s, err := NewBMPServer(0, 0, false, rec, false, "", nil, nil, nil, false, nil, nil, nil, listeners)
+	if err != nil {
+		t.Fatalf("failed to start bmp server with error: %+v", err)
+	}
+	srv := s.(*bmpServer)
+	srv.Start()
+	defer func() {
+		for _, l := range srv.listeners {
+			l.incoming.Close()
+		}
+		srv.Stop()
+	}()
+	var addr string
+	for _, l := range srv.listeners {
+		if l.config.Name == "mgmt" {
+			addr = l.incoming.Addr().String()
+		}
+	}
+	dial := func(local string) net.Conn {
+		d := &net.Dialer{LocalAddr: &net.TCPAddr{IP: net.ParseIP(local)}, Timeout: 5 * time.Second}
+		c, err := d.Dial("tcp", addr)
+		if err != nil {
+			t.Skipf("failed to connect from %s, loopback addresses other than 127.0.0.1 are not available: %+v", local, err)
+		}
+		return c
+	}
+
+	// The router of the denied address is disconnected right away
+	denied := dial("127.0.0.3")
+	defer denied.Close()
+	denied.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := denied.Read(make([]byte, 1)); err != io.EOF {
+		t.Errorf("expected connection from denied address to be closed but got %v", err)
+	}
+
+	// The router of the allowed address establishes BMP session
+	allowed := dial("127.0.0.2")
+	defer allowed.Close()
+	if _, err := allowed.Write(bmpMessage(bmp.InitiationMsg, []byte{0, 2, 0, 3, 'p', 'e', '1'})); err != nil {
+		t.Fatal(err)
+	}
+	var sessions []SessionInfo
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if sessions = srv.Sessions(); len(sessions) == 1 && sessions[0].SysName != "" {
+			break
+		}
+	}
+	if len(sessions) != 1 || sessions[0].Listener != "mgmt" || sessions[0].RouterIP != "127.0.0.2" || sessions[0].SysName != "pe1" {
+		t.Fatalf("expected only session of router 127.0.0.2 named pe1 of listener mgmt but got %+v", sessions)
+	}
+}
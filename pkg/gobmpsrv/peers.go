@@ -0,0 +1,233 @@
+package gobmpsrv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"sort"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+)
+
+const (
+	// PeerStateUp is the state of a peer after Peer Up message is received
+	PeerStateUp = "up"
+	// PeerStateDown is the state of a peer after Peer Down message is received
+	PeerStateDown = "down"
+	// PeerStateUnknown is the state of a peer seen in other messages before Peer Up message is received
+	PeerStateUnknown = "unknown"
+)
+
+// Statistics Report TLV types carrying the number of routes, RFC 7854 section 4.8
+const (
+	statAdjRIBInRoutes = 7
+	statLocRIBRoutes   = 8
+)
+
+// PeerInfo defines information about a BGP peer monitored over a BMP session. Routes counts are the counts
+// reported by the router in the latest Statistics Report message. TableName is VRF/Table Name of Loc-RIB
+// instance peers. MirroredMessages counts BGP messages of Route Mirroring messages by BGP message type.
+type PeerInfo struct {
+	SessionID        uint64            `json:"session_id"`
+	RouterIP         string            `json:"router_ip"`
+	PeerType         uint8             `json:"peer_type"`
+	PeerRD           string            `json:"peer_rd,omitempty"`
+	PeerIP           string            `json:"peer_ip"`
+	PeerASN          uint32            `json:"peer_asn"`
+	PeerBGPID        string            `json:"peer_bgp_id"`
+	TableName        string            `json:"table_name,omitempty"`
+	LocalIP          string            `json:"local_ip,omitempty"`
+	LocalASN         uint32            `json:"local_asn,omitempty"`
+	State            string            `json:"state"`
+	StateSince       string            `json:"state_since"`
+	UptimeSeconds    int64             `json:"uptime_seconds"`
+	DownReason       int               `json:"down_reason,omitempty"`
+	AdvCapabilities  []string          `json:"adv_cap,omitempty"`
+	RcvCapabilities  []string          `json:"recv_cap,omitempty"`
+	CapMismatches    []string          `json:"cap_mismatch,omitempty"`
+	AdjRIBInRoutes   uint64            `json:"adj_rib_in_routes"`
+	LocRIBRoutes     uint64            `json:"loc_rib_routes"`
+	RouteMonitoring  uint64            `json:"route_monitoring_messages"`
+	MirroredMessages map[string]uint64 `json:"mirrored_messages,omitempty"`
+}
+
+type peerKey struct {
+	peerType uint8
+	rd       string
+	addr     string
+}
+
+type peer struct {
+	info  PeerInfo
+	since time.Time
+}
+
+// peerTable keeps track of peers monitored over a BMP session
+type peerTable struct {
+	sync.Mutex
+	peers map[peerKey]*peer
+}
+
+func newPeerTable() *peerTable {
+	return &peerTable{
+		peers: make(map[peerKey]*peer),
+	}
+}
+
+// capabilities returns sorted descriptions of BGP capabilities
+func capabilities(caps bgp.Capability) []string {
+	var l []string
+	for _, c := range caps {
+		for _, d := range c {
+			l = append(l, d.Description)
+		}
+	}
+	sort.Strings(l)
+
+	return l
+}
+
+// update updates the peer table with the parsed BMP message
+func (pt *peerTable) update(s *session, msg *bmp.Message) {
+	ph := msg.PeerHeader
+	if ph == nil {
+		return
+	}
+	k := peerKey{
+		peerType: uint8(ph.PeerType),
+		rd:       ph.GetPeerDistinguisherString(),
+		addr:     ph.GetPeerAddrString(),
+	}
+	pt.Lock()
+	defer pt.Unlock()
+	p, ok := pt.peers[k]
+	if !ok {
+		p = &peer{
+			info: PeerInfo{
+				SessionID: s.id,
+				RouterIP:  s.routerIP,
+				PeerType:  k.peerType,
+				PeerRD:    k.rd,
+				PeerIP:    k.addr,
+				State:     PeerStateUnknown,
+			},
+			since: time.Now(),
+		}
+		pt.peers[k] = p
+	}
+	p.info.PeerASN = ph.PeerAS
+	p.info.PeerBGPID = ph.GetPeerBGPIDString()
+	switch m := msg.Payload.(type) {
+	case *bmp.PeerUpMessage:
+		p.info.State = PeerStateUp
+		p.since = time.Now()
+		p.info.DownReason = 0
+		p.info.LocalIP = m.GetLocalAddressString()
+		p.info.TableName = m.GetTableName()
+		if m.SentOpen != nil {
+			p.info.LocalASN = uint32(m.SentOpen.MyAS)
+			if asn, ok := m.SentOpen.Is4BytesASCapable(); ok {
+				p.info.LocalASN = asn
+			}
+			p.info.AdvCapabilities = capabilities(m.SentOpen.GetCapabilities())
+		}
+		if m.ReceivedOpen != nil {
+			p.info.RcvCapabilities = capabilities(m.ReceivedOpen.GetCapabilities())
+		}
+		p.info.CapMismatches = bgp.CapabilityMismatches(m.SentOpen, m.ReceivedOpen)
+	case *bmp.PeerDownMessage:
+		p.info.State = PeerStateDown
+		p.since = time.Now()
+		p.info.DownReason = int(m.Reason)
+	case *bmp.StatsReport:
+		for _, tlv := range m.StatsTLV {
+			if len(tlv.Information) != 8 {
+				continue
+			}
+			switch tlv.InformationType {
+			case statAdjRIBInRoutes:
+				p.info.AdjRIBInRoutes = binary.BigEndian.Uint64(tlv.Information)
+			case statLocRIBRoutes:
+				p.info.LocRIBRoutes = binary.BigEndian.Uint64(tlv.Information)
+			}
+		}
+	case *bmp.RouteMonitor:
+		p.info.RouteMonitoring++
+	case *bmp.RouteMirror:
+		if p.info.MirroredMessages == nil {
+			p.info.MirroredMessages = make(map[string]uint64)
+		}
+		for t, n := range m.MessageTypes() {
+			p.info.MirroredMessages[t] += n
+		}
+	}
+}
+
+func (pt *peerTable) list() []PeerInfo {
+	pt.Lock()
+	defer pt.Unlock()
+	now := time.Now()
+	l := make([]PeerInfo, 0, len(pt.peers))
+	for _, p := range pt.peers {
+		i := p.info
+		i.StateSince = p.since.UTC().Format(time.RFC3339)
+		if p.info.MirroredMessages != nil {
+			i.MirroredMessages = make(map[string]uint64, len(p.info.MirroredMessages))
+			for t, n := range p.info.MirroredMessages {
+				i.MirroredMessages[t] = n
+			}
+		}
+		if i.State == PeerStateUp {
+			i.UptimeSeconds = int64(now.Sub(p.since) / time.Second)
+		}
+		l = append(l, i)
+	}
+
+	return l
+}
+
+// memoryUsage adds estimated memory used by peers of the table to the counter
+func (pt *peerTable) memoryUsage(c *memory.Counter) {
+	pt.Lock()
+	defer pt.Unlock()
+	for k, p := range pt.peers {
+		b := uint64(unsafe.Sizeof(k)+unsafe.Sizeof(p)+unsafe.Sizeof(*p)) + memory.MapEntryOverhead
+		b += uint64(len(k.rd) + len(k.addr) + len(p.info.RouterIP) + len(p.info.PeerRD) + len(p.info.PeerIP) +
+			len(p.info.PeerBGPID) + len(p.info.LocalIP) + len(p.info.TableName))
+		for _, l := range [][]string{p.info.AdvCapabilities, p.info.RcvCapabilities, p.info.CapMismatches} {
+			b += uint64(uintptr(cap(l)) * unsafe.Sizeof(""))
+			for _, s := range l {
+				b += uint64(len(s))
+			}
+		}
+		for t := range p.info.MirroredMessages {
+			b += uint64(unsafe.Sizeof(t)+unsafe.Sizeof(uint64(0))) + memory.MapEntryOverhead + uint64(len(t))
+		}
+		c.Add(p.info.RouterIP, p.info.PeerIP, b)
+	}
+}
+
+// sortPeers sorts peers by session, peer distinguisher and peer address
+func sortPeers(l []PeerInfo) {
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].SessionID != l[j].SessionID {
+			return l[i].SessionID < l[j].SessionID
+		}
+		if l[i].PeerRD != l[j].PeerRD {
+			return l[i].PeerRD < l[j].PeerRD
+		}
+		a, b := net.ParseIP(l[i].PeerIP), net.ParseIP(l[j].PeerIP)
+		if a != nil && b != nil {
+			if c := bytes.Compare(a.To16(), b.To16()); c != 0 {
+				return c < 0
+			}
+		}
+
+		return l[i].PeerType < l[j].PeerType
+	})
+}
@@ -0,0 +1,208 @@
+//go:build go1.25
+
+package gobmpsrv
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/internal/quic"
+	"github.com/sbezverk/gobmp/pkg/events"
+)
+
+// QUICALPN is the ALPN protocol routers negotiate for BMP sessions over QUIC
+const QUICALPN = "bmp"
+
+// quicListener accepts BMP sessions over QUIC, the session of a QUIC connection is the first bidirectional stream
+// opened by the router, routers cannot open other streams
+type quicListener struct {
+	endpoint *quic.Endpoint
+	tls      *TLSConfig
+	sessions chan net.Conn
+	ctx      context.Context
+	cancel   context.CancelFunc
+	once     sync.Once
+}
+
+func listenQUIC(address string, opts *SocketOptions) (net.Listener, error) {
+	if opts.TLS == nil {
+		return nil, fmt.Errorf("bmp sessions over quic require tls")
+	}
+	if opts.TLS.config == nil {
+		if err := opts.TLS.init(); err != nil {
+			return nil, err
+		}
+	}
+	tc := opts.TLS.config.Clone()
+	tc.MinVersion = tls.VersionTLS13
+	tc.NextProtos = []string{QUICALPN}
+	config := &quic.Config{
+		TLSConfig:            tc,
+		MaxBidiRemoteStreams: 1,
+		MaxUniRemoteStreams:  -1,
+		HandshakeTimeout:     opts.TLS.HandshakeTimeout,
+	}
+	if opts.KeepAlive > 0 {
+		config.KeepAlivePeriod = opts.KeepAlive
+	} else if opts.KeepAlive == 0 {
+		config.KeepAlivePeriod = 15 * time.Second
+	}
+	e, err := quic.Listen("udp", address, config)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &quicListener{
+		endpoint: e,
+		tls:      opts.TLS,
+		sessions: make(chan net.Conn),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+	go l.accept()
+
+	return l, nil
+}
+
+// accept accepts QUIC connections until the listener is closed
+func (l *quicListener) accept() {
+	for {
+		c, err := l.endpoint.Accept(l.ctx)
+		if err != nil {
+			if l.ctx.Err() == nil {
+				glog.Errorf("quic listener on %s failed with error: %+v", l.endpoint.LocalAddr(), err)
+				l.Close()
+			}
+			return
+		}
+		go l.session(c)
+	}
+}
+
+// session waits for the router to open the stream of the BMP session and passes the session to Accept
+func (l *quicListener) session(c *quic.Conn) {
+	ctx, cancel := context.WithTimeout(l.ctx, l.tls.HandshakeTimeout)
+	defer cancel()
+	s, err := c.AcceptStream(ctx)
+	if err == nil {
+		if vErr := l.tls.verify(c.RemoteAddr().Addr().Unmap(), commonName(&quicConn{conn: c})); vErr != nil {
+			err = vErr
+		}
+	}
+	if err != nil {
+		glog.Errorf("failed to establish quic session with client %s with error: %+v", c.RemoteAddr(), err)
+		events.Report(events.CodeTLSFailed, c.RemoteAddr().Addr().Unmap().String(), "failed to establish quic session with error: %+v", err)
+		c.Abort(err)
+		return
+	}
+	select {
+	case l.sessions <- &quicConn{conn: c, stream: s}:
+	case <-l.ctx.Done():
+		c.Abort(nil)
+	}
+}
+
+func (l *quicListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.sessions:
+		return c, nil
+	case <-l.ctx.Done():
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *quicListener) Close() error {
+	var err error
+	l.once.Do(func() {
+		l.cancel()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		err = l.endpoint.Close(ctx)
+	})
+
+	return err
+}
+
+func (l *quicListener) Addr() net.Addr {
+	return net.UDPAddrFromAddrPort(l.endpoint.LocalAddr())
+}
+
+// quicConn is the BMP session over the stream of a QUIC connection
+type quicConn struct {
+	conn   *quic.Conn
+	stream *quic.Stream
+	// mu protects cancel functions of contexts of read and write deadlines
+	mu          sync.Mutex
+	cancelRead  context.CancelFunc
+	cancelWrite context.CancelFunc
+}
+
+func (c *quicConn) Read(b []byte) (int, error) {
+	return c.stream.Read(b)
+}
+
+// Write sends data right away, the stream buffers written data otherwise
+func (c *quicConn) Write(b []byte) (int, error) {
+	n, err := c.stream.Write(b)
+	if err != nil {
+		return n, err
+	}
+
+	return n, c.stream.Flush()
+}
+
+// Close closes the QUIC connection without waiting for the router to acknowledge it
+func (c *quicConn) Close() error {
+	c.conn.Abort(nil)
+	return nil
+}
+
+func (c *quicConn) LocalAddr() net.Addr {
+	return net.UDPAddrFromAddrPort(c.conn.LocalAddr())
+}
+
+func (c *quicConn) RemoteAddr() net.Addr {
+	return net.UDPAddrFromAddrPort(c.conn.RemoteAddr())
+}
+
+func (c *quicConn) ConnectionState() tls.ConnectionState {
+	return c.conn.ConnectionState()
+}
+
+func (c *quicConn) SetDeadline(t time.Time) error {
+	c.SetReadDeadline(t)
+	return c.SetWriteDeadline(t)
+}
+
+func (c *quicConn) SetReadDeadline(t time.Time) error {
+	c.stream.SetReadContext(c.deadline(t, &c.cancelRead))
+	return nil
+}
+
+func (c *quicConn) SetWriteDeadline(t time.Time) error {
+	c.stream.SetWriteContext(c.deadline(t, &c.cancelWrite))
+	return nil
+}
+
+// deadline returns the context expiring at t replacing the context of the previous deadline, the context does
+// not expire when t is zero
+func (c *quicConn) deadline(t time.Time, cancel *context.CancelFunc) context.Context {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if *cancel != nil {
+		(*cancel)()
+		*cancel = nil
+	}
+	if t.IsZero() {
+		return context.Background()
+	}
+	ctx, cf := context.WithDeadline(context.Background(), t)
+	*cancel = cf
+
+	return ctx
+}
@@ -0,0 +1,12 @@
+//go:build !go1.25
+
+package gobmpsrv
+
+import (
+	"fmt"
+	"net"
+)
+
+func listenQUIC(address string, opts *SocketOptions) (net.Listener, error) {
+	return nil, fmt.Errorf("bmp sessions over quic require gobmp built with go1.25 or later")
+}
@@ -0,0 +1,135 @@
+//go:build go1.25
+
+package gobmpsrv
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/internal/quic"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pubtest"
+)
+
+// writeCertificate writes self-signed certificate and its private key to files of the directory
+func writeCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "gobmp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kb, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, keyFile := filepath.Join(dir, "gobmp.crt"), filepath.Join(dir, "gobmp.key")
+	if err := os.WriteFile(cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kb}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return cert, keyFile
+}
+
+func TestQUICListener(t *testing.T) {
+	cert, key := writeCertificate(t, t.TempDir())
+	opts := DefaultSocketOptions()
+	opts.TLS = &TLSConfig{CertFile: cert, KeyFile: key}
+	opts.QUICAddress = "127.0.0.1:0"
+	rec := pubtest.NewRecorder()
+	s, err := NewBMPServer(0, 0, false, rec, false, "", opts, nil, nil, false, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to start bmp server with error: %+v", err)
+	}
+	srv := s.(*bmpServer)
+	srv.Start()
+	defer func() {
+		for _, l := range srv.listeners {
+			l.incoming.Close()
+		}
+		srv.Stop()
+	}()
+	var addr string
+	for _, l := range srv.listeners {
+		if l.config.Name == QUICListener {
+			addr = l.incoming.Addr().String()
+		}
+	}
+	if addr == "" {
+		t.Fatalf("quic listener is not started")
+	}
+
+	client, err := quic.Listen("udp", "127.0.0.1:0", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close(context.Background())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := client.Dial(ctx, "udp", addr, &quic.Config{
+		TLSConfig: &tls.Config{InsecureSkipVerify: true, NextProtos: []string{QUICALPN}, MinVersion: tls.VersionTLS13},
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to quic listener with error: %+v", err)
+	}
+	defer conn.Abort(nil)
+	stream, err := conn.NewStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Initiation message with sysName TLV
+	if _, err := stream.Write(bmpMessage(bmp.InitiationMsg, []byte{0, 2, 0, 3, 'p', 'e', '1'})); err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	var info SessionInfo
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if l := srv.Sessions(); len(l) == 1 && l[0].SysName != "" {
+			info = l[0]
+			break
+		}
+	}
+	if info.Listener != QUICListener || info.SysName != "pe1" || info.RouterIP != "127.0.0.1" {
+		t.Fatalf("expected session of router 127.0.0.1 named pe1 of quic listener but got %+v", info)
+	}
+	// Termination message ends the session
+	if _, err := stream.Write(bmpMessage(bmp.TerminationMsg, []byte{0, 1, 0, 2, 0, 0})); err != nil {
+		t.Fatal(err)
+	}
+	stream.CloseWrite()
+	if _, err := rec.WaitFor(1, 5*time.Second, bmp.SessionSummaryMsg); err != nil {
+		t.Fatalf("session summary of quic session is not published")
+	}
+	ms, err := rec.Where(bmp.SessionSummaryMsg, "closed_by", ClosedByRouter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ms) != 1 {
+		t.Errorf("expected the session closed by the router but got %d sessions closed by the router", len(ms))
+	}
+}
@@ -0,0 +1,47 @@
+package gobmpsrv
+
+import (
+	"io"
+	"net"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// vectoredReader returns the function reading from the connection's socket into multiple slices with
+// a single readv system call, nil is returned if the connection does not expose its socket.
+func vectoredReader(conn net.Conn) func([][]byte) (int, error) {
+	sc, ok := conn.(syscall.Conn)
+	if !ok {
+		return nil
+	}
+	rc, err := sc.SyscallConn()
+	if err != nil {
+		return nil
+	}
+
+	return func(segs [][]byte) (int, error) {
+		var n int
+		var err error
+		// Read waits for the socket to become readable while the function returns false
+		if rerr := rc.Read(func(fd uintptr) bool {
+			for {
+				n, err = unix.Readv(int(fd), segs)
+				if err != unix.EINTR {
+					break
+				}
+			}
+			return err != unix.EAGAIN
+		}); rerr != nil {
+			return 0, rerr
+		}
+		if err != nil {
+			return 0, err
+		}
+		if n == 0 {
+			return 0, io.EOF
+		}
+
+		return n, nil
+	}
+}
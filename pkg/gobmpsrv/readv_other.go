@@ -0,0 +1,10 @@
+//go:build !linux
+
+package gobmpsrv
+
+import "net"
+
+// vectoredReader returns nil, vectored reads are only used on Linux
+func vectoredReader(conn net.Conn) func([][]byte) (int, error) {
+	return nil
+}
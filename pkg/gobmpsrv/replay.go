@@ -0,0 +1,240 @@
+package gobmpsrv
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/filer"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/parser"
+	"github.com/sbezverk/gobmp/pkg/pcap"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// maxReplayMessage is the maximum length of BMP messages accepted when the stream is resynchronized after
+// bytes missing from a capture
+const maxReplayMessage = 1 << 24
+
+// ReplayConfig defines the replay of raw BMP messages of File, the file is either a journal of a BMP session or
+// a pcap or pcapng capture, optionally compressed. TCP streams to or from Port are replayed from captures, 0 Port
+// replays all TCP streams. SplitAF, Timestamps, Mirror and RawUpdates are options of producers of replayed messages,
+// as the options of BMP sessions.
+type ReplayConfig struct {
+	File       string
+	Port       int
+	SplitAF    bool
+	Timestamps *message.TimestampConfig
+	Mirror     *message.MirrorConfig
+	RawUpdates bool
+}
+
+// replayStream frames BMP messages of a byte stream, either of a journal or of a TCP stream of a capture, and
+// publishes them by a dedicated producer, as if they were received over a BMP session
+type replayStream struct {
+	name  string
+	queue chan bmp.Message
+	done  chan struct{}
+	buf   []byte
+	// resync is set when bytes of the stream are missing, the stream is resumed at the next valid BMP header
+	resync bool
+	// skipped is the number of bytes skipped while the stream is resynchronized
+	skipped int
+	n       int
+}
+
+func newReplayStream(name string, p pub.Publisher, config *ReplayConfig) *replayStream {
+	s := &replayStream{
+		name:  name,
+		queue: make(chan bmp.Message),
+		done:  make(chan struct{}),
+	}
+	prod := message.NewProducer(p, config.SplitAF, config.Timestamps, config.Mirror, config.RawUpdates)
+	go func() {
+		defer close(s.done)
+		// Messages are produced one by one, so they are published in the order of the stream
+		for msg := range s.queue {
+			prod.Produce(msg)
+		}
+	}()
+
+	return s
+}
+
+// data parses complete BMP messages of the stream, lost is true when bytes preceding b are missing
+func (s *replayStream) data(b []byte, lost bool) {
+	if lost {
+		if len(s.buf) != 0 || s.n != 0 {
+			glog.Warningf("bytes of stream %s are missing, %d bytes of incomplete BMP message are dropped", s.name, len(s.buf))
+		}
+		s.buf, s.resync = s.buf[:0], true
+	}
+	s.buf = append(s.buf, b...)
+	for {
+		if s.resync {
+			i, confirmed := resyncOffset(s.buf)
+			switch {
+			case i < 0:
+				// The header may start in the last bytes of the stream
+				if n := len(s.buf) - bmp.CommonHeaderLength + 1; n > 0 {
+					s.skipped += n
+					s.buf = append(s.buf[:0], s.buf[n:]...)
+				}
+				return
+			case !confirmed:
+				s.skipped += i
+				s.buf = s.buf[i:]
+				return
+			}
+			s.skipped += i
+			if s.skipped != 0 {
+				glog.Warningf("%d bytes of stream %s are skipped to the next BMP message", s.skipped, s.name)
+			}
+			s.buf, s.resync, s.skipped = s.buf[i:], false, 0
+		}
+		if len(s.buf) < bmp.CommonHeaderLength {
+			return
+		}
+		header, err := bmp.UnmarshalCommonHeader(s.buf[:bmp.CommonHeaderLength])
+		if err == nil && header.MessageLength < bmp.CommonHeaderLength {
+			err = fmt.Errorf("invalid BMP message length %d", header.MessageLength)
+		}
+		if err != nil {
+			glog.Errorf("failed to parse BMP header of stream %s with error: %+v", s.name, err)
+			s.buf, s.resync = s.buf[1:], true
+			continue
+		}
+		l := int(header.MessageLength)
+		if len(s.buf) < l {
+			return
+		}
+		msg := make([]byte, l)
+		copy(msg, s.buf)
+		parser.Parse(msg, s.queue, nil)
+		s.buf = s.buf[l:]
+		s.n++
+	}
+}
+
+// close waits for messages of the stream to be published and returns the number of replayed BMP messages
+func (s *replayStream) close() int {
+	switch {
+	case s.resync && len(s.buf) >= bmp.CommonHeaderLength && validHeader(s.buf) == len(s.buf):
+		// The last message of the stream is not followed by a header confirming it
+		parser.Parse(s.buf, s.queue, nil)
+		s.n++
+	case len(s.buf) != 0 && !s.resync:
+		glog.Warningf("stream %s ends with %d bytes of incomplete BMP message", s.name, len(s.buf))
+	}
+	close(s.queue)
+	<-s.done
+	glog.Infof("replay of stream %s is completed, %d messages are replayed", s.name, s.n)
+
+	return s.n
+}
+
+// resyncOffset returns the offset of the first valid BMP header of b confirmed by the valid header of the following
+// message, as bytes of BMP messages may look like a header. When no header is confirmed, the offset of the first
+// header which needs more bytes of the stream to be confirmed is returned. -1 is returned when b has no such header.
+func resyncOffset(b []byte) (int, bool) {
+	unconfirmed := -1
+	for i := 0; i+bmp.CommonHeaderLength <= len(b); i++ {
+		l := validHeader(b[i:])
+		if l == 0 {
+			continue
+		}
+		if i+l+bmp.CommonHeaderLength > len(b) {
+			if unconfirmed < 0 {
+				unconfirmed = i
+			}
+			continue
+		}
+		if validHeader(b[i+l:]) != 0 {
+			return i, true
+		}
+	}
+
+	return unconfirmed, false
+}
+
+// validHeader returns the length of BMP message of the valid header at the beginning of b, 0 is returned when
+// the header is not valid
+func validHeader(b []byte) int {
+	if b[0] != bmp.Version3 && b[0] != bmp.Version4 {
+		return 0
+	}
+	header, err := bmp.UnmarshalCommonHeader(b[:bmp.CommonHeaderLength])
+	if err != nil || header.MessageLength < bmp.CommonHeaderLength || header.MessageLength > maxReplayMessage {
+		return 0
+	}
+
+	return int(header.MessageLength)
+}
+
+// captureStreams replays TCP streams of a capture, each stream by its own producer
+type captureStreams struct {
+	publisher pub.Publisher
+	config    *ReplayConfig
+	streams   map[pcap.Flow]*replayStream
+	n         int
+}
+
+func (c *captureStreams) Data(flow pcap.Flow, data []byte, lost bool) {
+	s, ok := c.streams[flow]
+	if !ok {
+		s = newReplayStream(flow.String(), c.publisher, c.config)
+		c.streams[flow] = s
+	}
+	s.data(data, lost)
+}
+
+func (c *captureStreams) Close(flow pcap.Flow) {
+	// Streams without data, such as streams of the collector's side of sessions, are not replayed
+	if s, ok := c.streams[flow]; ok {
+		c.n += s.close()
+		delete(c.streams, flow)
+	}
+}
+
+// Replay publishes BMP messages of the file of the config to the publisher and returns the number of replayed
+// BMP messages, Replay returns once all messages are published.
+func Replay(p pub.Publisher, config *ReplayConfig) (int, error) {
+	if config.Port < 0 || config.Port > 65535 {
+		return 0, fmt.Errorf("invalid port %d of replayed TCP streams", config.Port)
+	}
+	f, err := filer.Open(config.File)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open replay file %s with error: %+v", config.File, err)
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	magic, err := r.Peek(4)
+	if err != nil && err != io.EOF {
+		return 0, fmt.Errorf("failed to read replay file %s with error: %+v", config.File, err)
+	}
+	if pcap.IsCapture(magic) {
+		glog.Infof("replaying TCP streams of capture %s", config.File)
+		c := &captureStreams{publisher: p, config: config, streams: make(map[pcap.Flow]*replayStream)}
+		if err := pcap.ReadStreams(r, uint16(config.Port), c); err != nil {
+			return c.n, fmt.Errorf("failed to read capture %s with error: %+v", config.File, err)
+		}
+		return c.n, nil
+	}
+	glog.Infof("replaying BMP messages of %s", config.File)
+	s := newReplayStream(config.File, p, config)
+	b := make([]byte, 64<<10)
+	for {
+		n, err := r.Read(b)
+		s.data(b[:n], false)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return s.close(), fmt.Errorf("failed to read replay file %s with error: %+v", config.File, err)
+		}
+	}
+
+	return s.close(), nil
+}
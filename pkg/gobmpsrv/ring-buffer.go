@@ -0,0 +1,112 @@
+package gobmpsrv
+
+import (
+	"io"
+	"net"
+)
+
+// ringBufferSize defines the size of per session receive buffer, it fits many Route Monitoring messages,
+// so a single read from the socket delivers a burst of messages during table dumps.
+const ringBufferSize = 256 * 1024
+
+// ringBuffer reads BMP messages from the connection into the pre-allocated buffer, messages are copied
+// out of the buffer once, into the slice owned by the caller.
+type ringBuffer struct {
+	conn net.Conn
+	// readv reads into multiple slices with a single call, it is nil when vectored reads are not available
+	readv func([][]byte) (int, error)
+	buf   []byte
+	// head is the offset of the first unread byte, n is the number of unread bytes
+	head int
+	n    int
+}
+
+func newRingBuffer(conn net.Conn) *ringBuffer {
+	return &ringBuffer{
+		conn:  conn,
+		readv: vectoredReader(conn),
+		buf:   make([]byte, ringBufferSize),
+	}
+}
+
+// fill reads from the connection into the free space of the buffer
+func (r *ringBuffer) fill() error {
+	if r.n == 0 {
+		// Keeping the free space contiguous when the buffer is empty
+		r.head = 0
+	}
+	tail := (r.head + r.n) % len(r.buf)
+	var segs [][]byte
+	if tail >= r.head {
+		// Free space wraps around the end of the buffer
+		segs = append(segs, r.buf[tail:])
+		if r.head > 0 {
+			segs = append(segs, r.buf[:r.head])
+		}
+	} else {
+		segs = append(segs, r.buf[tail:r.head])
+	}
+	var n int
+	var err error
+	if len(segs) > 1 && r.readv != nil {
+		n, err = r.readv(segs)
+	} else {
+		n, err = r.conn.Read(segs[0])
+	}
+	r.n += n
+	if n > 0 {
+		return nil
+	}
+
+	return err
+}
+
+// copyOut copies len(p) bytes from the head of the buffer into p, when consume is true the bytes are
+// removed from the buffer.
+func (r *ringBuffer) copyOut(p []byte, consume bool) {
+	c := copy(p, r.buf[r.head:])
+	if c < len(p) {
+		copy(p[c:], r.buf)
+	}
+	if consume {
+		r.head = (r.head + len(p)) % len(r.buf)
+		r.n -= len(p)
+	}
+}
+
+// peek copies len(p) bytes into p without removing them from the buffer, len(p) must not exceed the buffer size
+func (r *ringBuffer) peek(p []byte) error {
+	for r.n < len(p) {
+		if err := r.fill(); err != nil {
+			return err
+		}
+	}
+	r.copyOut(p, false)
+
+	return nil
+}
+
+// read reads exactly len(p) bytes into p
+func (r *ringBuffer) read(p []byte) error {
+	if len(p) > len(r.buf) {
+		// The message does not fit into the buffer, the buffered part is copied and the rest is read directly
+		k := r.n
+		r.copyOut(p[:k], true)
+		_, err := io.ReadFull(r.conn, p[k:])
+		return err
+	}
+	for r.n < len(p) {
+		if err := r.fill(); err != nil {
+			return err
+		}
+	}
+	r.copyOut(p, true)
+
+	return nil
+}
+
+// discard removes n bytes from the buffer, n must not exceed the number of buffered bytes
+func (r *ringBuffer) discard(n int) {
+	r.head = (r.head + n) % len(r.buf)
+	r.n -= n
+}
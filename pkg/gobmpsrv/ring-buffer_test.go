@@ -0,0 +1,182 @@
+package gobmpsrv
+
+import (
+	"io"
+	"net"
+	"testing"
+)
+
+// chunkConn returns data of the stream in chunks of up to max bytes per read
+type chunkConn struct {
+	net.Conn
+	data  []byte
+	max   int
+	reads int
+}
+
+func (c *chunkConn) Read(b []byte) (int, error) {
+	if len(c.data) == 0 {
+		return 0, io.EOF
+	}
+	c.reads++
+	n := len(b)
+	if n > c.max {
+		n = c.max
+	}
+	n = copy(b, c.data[:minInt(n, len(c.data))])
+	c.data = c.data[n:]
+
+	return n, nil
+}
+
+// readv reads into the segments with a single call, up to max bytes in total
+func (c *chunkConn) readv(segs [][]byte) (int, error) {
+	if len(c.data) == 0 {
+		return 0, io.EOF
+	}
+	c.reads++
+	n := 0
+	for _, s := range segs {
+		k := copy(s, c.data[:minInt(c.max-n, len(c.data))])
+		c.data = c.data[k:]
+		n += k
+		if n == c.max || len(c.data) == 0 {
+			break
+		}
+	}
+
+	return n, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+type ringOp struct {
+	// op is "read", "peek" or "discard"
+	op   string
+	n    int
+	want string
+	err  error
+}
+
+func TestRingBuffer(t *testing.T) {
+	const stream = "0123456789abcdefghijklmnopqrstuvwxyz"
+	tests := []struct {
+		name string
+		size int
+		max  int
+		ops  []ringOp
+		// reads is the number of reads from the connection, it is not checked when 0
+		reads int
+	}{
+		{
+			name: "wraparound",
+			size: 8,
+			max:  8,
+			ops: []ringOp{
+				{op: "read", n: 5, want: "01234"},
+				{op: "read", n: 5, want: "56789"},
+				{op: "peek", n: 6, want: "abcdef"},
+				{op: "read", n: 7, want: "abcdefg"},
+				{op: "read", n: 8, want: "hijklmno"},
+				{op: "read", n: 3, want: "pqr"},
+			},
+		},
+		{
+			name: "partial reads of the connection",
+			size: 8,
+			max:  1,
+			ops: []ringOp{
+				{op: "read", n: 6, want: "012345"},
+				{op: "peek", n: 2, want: "67"},
+				{op: "read", n: 4, want: "6789"},
+			},
+			reads: 10,
+		},
+		{
+			name: "burst of messages read at once",
+			size: 32,
+			max:  32,
+			ops: []ringOp{
+				{op: "read", n: 6, want: "012345"},
+				{op: "read", n: 6, want: "6789ab"},
+				{op: "read", n: 6, want: "cdefgh"},
+			},
+			reads: 1,
+		},
+		{
+			name: "discard",
+			size: 8,
+			max:  8,
+			ops: []ringOp{
+				{op: "peek", n: 6, want: "012345"},
+				{op: "discard", n: 6},
+				{op: "peek", n: 4, want: "6789"},
+				{op: "discard", n: 2},
+				{op: "read", n: 6, want: "89abcd"},
+			},
+		},
+		{
+			name: "message larger than the buffer",
+			size: 8,
+			max:  8,
+			ops: []ringOp{
+				{op: "read", n: 2, want: "01"},
+				{op: "read", n: 12, want: "23456789abcd"},
+				{op: "read", n: 4, want: "efgh"},
+			},
+		},
+		{
+			name: "end of stream",
+			size: 8,
+			max:  8,
+			ops: []ringOp{
+				{op: "read", n: 30, want: stream[:30]},
+				{op: "peek", n: 7, err: io.EOF},
+				{op: "read", n: 6, want: stream[30:]},
+				{op: "read", n: 1, err: io.EOF},
+			},
+		},
+	}
+	for _, vectored := range []bool{false, true} {
+		for _, tt := range tests {
+			name := tt.name
+			if vectored {
+				name += " vectored"
+			}
+			t.Run(name, func(t *testing.T) {
+				c := &chunkConn{data: []byte(stream), max: tt.max}
+				r := &ringBuffer{conn: c, buf: make([]byte, tt.size)}
+				if vectored {
+					r.readv = c.readv
+				}
+				for i, op := range tt.ops {
+					p := make([]byte, op.n)
+					var err error
+					switch op.op {
+					case "read":
+						err = r.read(p)
+					case "peek":
+						err = r.peek(p)
+					case "discard":
+						r.discard(op.n)
+						continue
+					}
+					if err != op.err {
+						t.Fatalf("%s %d of operation %d: expected error %v but got %v", op.op, op.n, i, op.err, err)
+					}
+					if err == nil && string(p) != op.want {
+						t.Fatalf("%s %d of operation %d: expected %q but got %q", op.op, op.n, i, op.want, p)
+					}
+				}
+				if tt.reads != 0 && c.reads != tt.reads {
+					t.Errorf("expected %d reads from the connection but got %d", tt.reads, c.reads)
+				}
+			})
+		}
+	}
+}
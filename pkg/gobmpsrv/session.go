@@ -0,0 +1,372 @@
+package gobmpsrv
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/events"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// SessionInfo defines information about a BMP session
+type SessionInfo struct {
+	ID                uint64 `json:"id"`
+	RemoteAddress     string `json:"remote_address"`
+	Listener          string `json:"listener"`
+	RouterIP          string `json:"router_ip"`
+	SysName           string `json:"sys_name,omitempty"`
+	SysDescr          string `json:"sys_descr,omitempty"`
+	Vendor            string `json:"vendor"`
+	ConnectedSince    string `json:"connected_since"`
+	MessagesReceived  uint64 `json:"messages_received"`
+	MessagesPublished uint64 `json:"messages_published"`
+	MessagesDiscarded uint64 `json:"messages_discarded"`
+	Paused            bool   `json:"paused"`
+	TLSCommonName     string `json:"tls_common_name,omitempty"`
+	Journal           string `json:"journal,omitempty"`
+	JournalOffset     int64  `json:"journal_offset,omitempty"`
+}
+
+// RouterInfo defines information about a router connected over a BMP session, its BGP peers, counters of messages
+// of the session and the last error of parsing messages received from the router
+type RouterInfo struct {
+	SessionInfo
+	UptimeSeconds int64 `json:"uptime_seconds"`
+	// BMPMessages counts received BMP messages per BMP message type
+	BMPMessages map[string]uint64 `json:"bmp_messages"`
+	// PublishedMessages counts published messages per type of published messages
+	PublishedMessages map[string]uint64 `json:"published_messages"`
+	// ParseErrors counts BMP messages failed to parse per BMP message type
+	ParseErrors map[string]uint64 `json:"parse_errors,omitempty"`
+	LastError   string            `json:"last_error,omitempty"`
+	LastErrorAt string            `json:"last_error_at,omitempty"`
+	Peers       []PeerInfo        `json:"peers"`
+}
+
+type session struct {
+	id             uint64
+	conn           net.Conn
+	routerIP       string
+	listener       string
+	topicPrefix    string
+	tlsCommonName  string
+	connectedSince time.Time
+	received       atomic.Uint64
+	published      atomic.Uint64
+	discarded      atomic.Uint64
+	paused         atomic.Bool
+	// closed is set when the session is closed by request, not by the router
+	closed     atomic.Bool
+	peers      *peerTable
+	initiation atomic.Pointer[initiation]
+	// producer stores message.Producer of the session
+	producer atomic.Value
+	journal  atomic.Pointer[journal]
+	stats    *sessionStats
+}
+
+// initiation defines information received from the router in Initiation message
+type initiation struct {
+	sysName  string
+	sysDescr string
+	vendor   string
+}
+
+// vendor returns the vendor of the session's router, VendorUnknown is returned until Initiation message is received
+func (s *session) vendor() string {
+	if i := s.initiation.Load(); i != nil {
+		return i.vendor
+	}
+
+	return VendorUnknown
+}
+
+func (s *session) info() SessionInfo {
+	var i initiation
+	if p := s.initiation.Load(); p != nil {
+		i = *p
+	}
+	info := SessionInfo{
+		ID:                s.id,
+		RemoteAddress:     s.conn.RemoteAddr().String(),
+		Listener:          s.listener,
+		RouterIP:          s.routerIP,
+		SysName:           i.sysName,
+		SysDescr:          i.sysDescr,
+		Vendor:            s.vendor(),
+		ConnectedSince:    s.connectedSince.UTC().Format(time.RFC3339),
+		MessagesReceived:  s.received.Load(),
+		MessagesPublished: s.published.Load(),
+		MessagesDiscarded: s.discarded.Load(),
+		Paused:            s.paused.Load(),
+		TLSCommonName:     s.tlsCommonName,
+	}
+	if j := s.journal.Load(); j != nil {
+		info.Journal = j.name
+		info.JournalOffset = j.size()
+	}
+
+	return info
+}
+
+// router returns information about the session's router at now
+func (s *session) router(now time.Time) RouterInfo {
+	ri := RouterInfo{
+		SessionInfo:   s.info(),
+		UptimeSeconds: int64(now.Sub(s.connectedSince) / time.Second),
+		Peers:         s.peers.list(),
+	}
+	ri.BMPMessages, ri.PublishedMessages, ri.ParseErrors = s.stats.counters()
+	s.stats.Lock()
+	if s.stats.lastError != "" {
+		ri.LastError = s.stats.lastError
+		ri.LastErrorAt = s.stats.lastErrorAt.UTC().Format(time.RFC3339)
+	}
+	s.stats.Unlock()
+	sortPeers(ri.Peers)
+
+	return ri
+}
+
+// sessionPublisher passes messages of the session to the publisher unless publishing
+// for the session's router is paused.
+type sessionPublisher struct {
+	pub.Publisher
+	s *session
+}
+
+func (p *sessionPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if p.s.paused.Load() {
+		p.s.discarded.Add(1)
+		return nil
+	}
+	p.s.published.Add(1)
+	p.s.stats.publishedMessage(msgType)
+	if err := p.Publisher.PublishMessage(msgType, msgHash, msg); err != nil {
+		events.Report(events.CodePublishFailed, p.s.routerIP, "failed to publish message of type %d with error: %+v", msgType, err)
+		return err
+	}
+
+	return nil
+}
+
+// PublishValue passes the message to the publisher before it is marshaled, so publishers implementing
+// pub.ValuePublisher encode it into their buffers
+func (p *sessionPublisher) PublishValue(msgType int, msgHash []byte, v interface{}) error {
+	if p.s.paused.Load() {
+		p.s.discarded.Add(1)
+		return nil
+	}
+	p.s.published.Add(1)
+	p.s.stats.publishedMessage(msgType)
+	if err := pub.PublishValue(p.Publisher, msgType, msgHash, v); err != nil {
+		events.Report(events.CodePublishFailed, p.s.routerIP, "failed to publish message of type %d with error: %+v", msgType, err)
+		return err
+	}
+
+	return nil
+}
+
+// sessions keeps track of active BMP sessions and of routers with paused publishing,
+// paused state of a router survives reconnection of the router.
+type sessions struct {
+	sync.Mutex
+	lastID   uint64
+	sessions map[uint64]*session
+	paused   map[string]bool
+	// routers maps addresses of routers of sessions of listeners with topic prefixes to the sessions,
+	// the addresses of sessions and local addresses of their Peer Up messages are mapped
+	routersMu sync.RWMutex
+	routers   map[string]*session
+}
+
+func newSessions() *sessions {
+	return &sessions{
+		sessions: make(map[uint64]*session),
+		paused:   make(map[string]bool),
+		routers:  make(map[string]*session),
+	}
+}
+
+func (ss *sessions) add(conn net.Conn, l *ListenerConfig) *session {
+	ss.Lock()
+	defer ss.Unlock()
+	ss.lastID++
+	s := &session{
+		id:             ss.lastID,
+		conn:           conn,
+		listener:       l.Name,
+		topicPrefix:    l.TopicPrefix,
+		tlsCommonName:  commonName(conn),
+		connectedSince: time.Now(),
+		peers:          newPeerTable(),
+		stats:          newSessionStats(),
+	}
+	if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		s.routerIP = addr.IP.String()
+	} else if host, _, err := net.SplitHostPort(conn.RemoteAddr().String()); err == nil {
+		s.routerIP = host
+	}
+	s.paused.Store(ss.paused[s.routerIP])
+	ss.sessions[s.id] = s
+	ss.addRouter(s.routerIP, s)
+
+	return s
+}
+
+func (ss *sessions) remove(s *session) {
+	ss.Lock()
+	defer ss.Unlock()
+	delete(ss.sessions, s.id)
+	if s.topicPrefix == "" {
+		return
+	}
+	ss.routersMu.Lock()
+	defer ss.routersMu.Unlock()
+	for router, rs := range ss.routers {
+		if rs == s {
+			delete(ss.routers, router)
+		}
+	}
+}
+
+// addRouter maps the router's address to the session of the listener with topic prefix, the address
+// stays mapped to the session it was mapped to first
+func (ss *sessions) addRouter(router string, s *session) {
+	if s.topicPrefix == "" || router == "" {
+		return
+	}
+	ss.routersMu.Lock()
+	defer ss.routersMu.Unlock()
+	if rs, ok := ss.routers[router]; ok {
+		if rs != s && rs.topicPrefix != s.topicPrefix {
+			glog.Warningf("router %s of session %d of listener %s is already mapped to session %d of listener %s",
+				router, s.id, s.listener, rs.id, rs.listener)
+		}
+		return
+	}
+	ss.routers[router] = s
+}
+
+// topicPrefix returns the topic prefix of the listener of the router's session, empty string is returned
+// for routers of listeners without topic prefixes
+func (ss *sessions) topicPrefix(router string) string {
+	ss.routersMu.RLock()
+	defer ss.routersMu.RUnlock()
+	if s, ok := ss.routers[router]; ok {
+		return s.topicPrefix
+	}
+
+	return ""
+}
+
+func (ss *sessions) list() []SessionInfo {
+	ss.Lock()
+	defer ss.Unlock()
+	l := make([]SessionInfo, 0, len(ss.sessions))
+	for _, s := range ss.sessions {
+		l = append(l, s.info())
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].ID < l[j].ID })
+
+	return l
+}
+
+// peers returns peers monitored over active sessions
+func (ss *sessions) peers() []PeerInfo {
+	ss.Lock()
+	l := make([]*session, 0, len(ss.sessions))
+	for _, s := range ss.sessions {
+		l = append(l, s)
+	}
+	ss.Unlock()
+	var peers []PeerInfo
+	for _, s := range l {
+		peers = append(peers, s.peers.list()...)
+	}
+	sortPeers(peers)
+
+	return peers
+}
+
+// connectedRouters returns routers connected over active sessions
+func (ss *sessions) connectedRouters() []RouterInfo {
+	ss.Lock()
+	l := make([]*session, 0, len(ss.sessions))
+	for _, s := range ss.sessions {
+		l = append(l, s)
+	}
+	ss.Unlock()
+	sort.Slice(l, func(i, j int) bool { return l[i].id < l[j].id })
+	now := time.Now()
+	routers := make([]RouterInfo, 0, len(l))
+	for _, s := range l {
+		routers = append(routers, s.router(now))
+	}
+
+	return routers
+}
+
+// memoryUsage returns estimated memory used by peer tables and BGP-LS topology of active sessions
+func (ss *sessions) memoryUsage() []memory.Usage {
+	ss.Lock()
+	l := make([]*session, 0, len(ss.sessions))
+	for _, s := range ss.sessions {
+		l = append(l, s)
+	}
+	ss.Unlock()
+	peers, topology := memory.NewCounter("peer_tables"), memory.NewCounter("topology")
+	for _, s := range l {
+		s.peers.memoryUsage(peers)
+		prod, ok := s.producer.Load().(message.Producer)
+		if !ok {
+			continue
+		}
+		if n, b := prod.TopologyUsage(); n != 0 {
+			topology.AddSession(s.routerIP, n, b)
+		}
+	}
+
+	return []memory.Usage{peers.Usage(), topology.Usage()}
+}
+
+func (ss *sessions) close(id uint64) error {
+	ss.Lock()
+	s, ok := ss.sessions[id]
+	ss.Unlock()
+	if !ok {
+		return fmt.Errorf("session %d is not found", id)
+	}
+	s.closed.Store(true)
+
+	return s.conn.Close()
+}
+
+func (ss *sessions) pause(router string, pause bool) error {
+	ip := net.ParseIP(router)
+	if ip == nil {
+		return fmt.Errorf("invalid router address %q", router)
+	}
+	router = ip.String()
+	ss.Lock()
+	defer ss.Unlock()
+	if pause {
+		ss.paused[router] = true
+	} else {
+		delete(ss.paused, router)
+	}
+	for _, s := range ss.sessions {
+		if s.routerIP == router {
+			s.paused.Store(pause)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package gobmpsrv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// SocketOptions defines TCP socket options of the listener and BMP sessions
+type SocketOptions struct {
+	// ReceiveBuffer is the size of socket receive buffer (SO_RCVBUF) in bytes, 0 keeps the kernel default
+	ReceiveBuffer int
+	// KeepAlive is the period between TCP keepalive probes, 0 uses the default of 15 seconds,
+	// a negative value disables keepalive.
+	KeepAlive time.Duration
+	// NoDelay sets TCP_NODELAY on BMP sessions
+	NoDelay bool
+	// ReusePort sets SO_REUSEPORT on the listener, so multiple processes can listen on the same port
+	ReusePort bool
+	// DSCP marks packets sent to routers with the Differentiated Services Code Point, 0 keeps the default marking
+	DSCP int
+	// Auth lists keys authenticating BMP sessions of routers
+	Auth []*TCPAuth
+	// Listener is the listener inherited from the service manager, when not nil, BMP sessions are accepted
+	// by Listener instead of the listener bound to the source port
+	Listener net.Listener
+	// TLS terminates TLS of BMP sessions, sessions are not encrypted when TLS is nil
+	TLS *TLSConfig
+	// QUICAddress is host:port of the experimental listener of BMP sessions over QUIC, a session is the single
+	// bidirectional stream of a QUIC connection. QUIC sessions are secured by TLS, so TLS is required. The listener
+	// is not started when QUICAddress is empty.
+	QUICAddress string
+}
+
+// maxTCPKeyLength is the maximum length of TCP MD5 and TCP-AO keys supported by Linux
+const maxTCPKeyLength = 80
+
+// TCPAuth defines the key authenticating BMP sessions of routers with addresses covered by the prefix,
+// either TCP MD5 Signature (RFC 2385) or TCP Authentication Option (RFC 5925) is used.
+type TCPAuth struct {
+	// Prefix is the address or the prefix in CIDR notation of routers
+	Prefix string    `json:"prefix"`
+	MD5Key string    `json:"md5_key,omitempty"`
+	AO     *TCPAOKey `json:"ao,omitempty"`
+	prefix netip.Prefix
+}
+
+// TCPAOKey defines TCP Authentication Option Master Key Tuple
+type TCPAOKey struct {
+	Key string `json:"key"`
+	// Algorithm is the name of the kernel crypto algorithm, "hmac(sha1)" (default), "cmac(aes128)" or "hmac(sha256)"
+	Algorithm string `json:"algorithm,omitempty"`
+	SendID    uint8  `json:"send_id"`
+	RecvID    uint8  `json:"recv_id"`
+	// MACLength is the length of the message authentication code, 0 selects the default of 12 bytes
+	MACLength uint8 `json:"mac_length,omitempty"`
+}
+
+func (a *TCPAuth) init() error {
+	p, err := netip.ParsePrefix(a.Prefix)
+	if err != nil {
+		addr, aerr := netip.ParseAddr(a.Prefix)
+		if aerr != nil {
+			return fmt.Errorf("invalid prefix %q", a.Prefix)
+		}
+		p = netip.PrefixFrom(addr, addr.BitLen())
+	}
+	a.prefix = p.Masked()
+	switch {
+	case a.MD5Key != "" && a.AO != nil:
+		return fmt.Errorf("prefix %s has both md5 and ao keys", a.Prefix)
+	case a.MD5Key != "":
+		if len(a.MD5Key) > maxTCPKeyLength {
+			return fmt.Errorf("md5 key of prefix %s is longer than %d bytes", a.Prefix, maxTCPKeyLength)
+		}
+	case a.AO != nil:
+		if a.AO.Key == "" || len(a.AO.Key) > maxTCPKeyLength {
+			return fmt.Errorf("ao key of prefix %s must be 1 to %d bytes long", a.Prefix, maxTCPKeyLength)
+		}
+		if a.AO.Algorithm == "" {
+			a.AO.Algorithm = "hmac(sha1)"
+		}
+	default:
+		return fmt.Errorf("prefix %s has neither md5 nor ao key", a.Prefix)
+	}
+
+	return nil
+}
+
+// LoadTCPAuth reads keys authenticating BMP sessions from json file
+func LoadTCPAuth(file string) ([]*TCPAuth, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var auth []*TCPAuth
+	if err := json.Unmarshal(b, &auth); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tcp authentication file %s with error: %+v", file, err)
+	}
+	for _, a := range auth {
+		if err := a.init(); err != nil {
+			return nil, err
+		}
+	}
+
+	return auth, nil
+}
+
+// DefaultSocketOptions returns socket options matching Go defaults
+func DefaultSocketOptions() *SocketOptions {
+	return &SocketOptions{
+		NoDelay: true,
+	}
+}
+
+func listen(address string, opts *SocketOptions) (net.Listener, error) {
+	if opts.DSCP < 0 || opts.DSCP > 63 {
+		return nil, fmt.Errorf("invalid dscp value %d", opts.DSCP)
+	}
+	for _, a := range opts.Auth {
+		if !a.prefix.IsValid() {
+			if err := a.init(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if opts.TLS != nil {
+		if err := opts.TLS.init(); err != nil {
+			return nil, err
+		}
+	}
+	if opts.Listener != nil {
+		return inheritedListener(opts)
+	}
+	lc := net.ListenConfig{
+		KeepAlive: opts.KeepAlive,
+		Control:   control(opts),
+	}
+
+	return lc.Listen(context.Background(), "tcp", address)
+}
+
+// inheritedListener applies socket options to the inherited listener, options are applied to the bound socket,
+// so the receive buffer size of sessions is not inherited from the listener and it is set on accepted sessions.
+func inheritedListener(opts *SocketOptions) (net.Listener, error) {
+	sc, ok := opts.Listener.(syscall.Conn)
+	if !ok {
+		return nil, fmt.Errorf("inherited listener %s is not a tcp listener", opts.Listener.Addr())
+	}
+	rc, err := sc.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+	if err := control(opts)("tcp", opts.Listener.Addr().String(), rc); err != nil {
+		return nil, fmt.Errorf("failed to apply socket options to inherited listener with error: %+v", err)
+	}
+
+	return opts.Listener, nil
+}
+
+// setSessionOptions applies socket options to the accepted BMP session
+func setSessionOptions(client net.Conn, opts *SocketOptions) {
+	tc, ok := client.(*net.TCPConn)
+	if !ok {
+		return
+	}
+	if err := tc.SetNoDelay(opts.NoDelay); err != nil {
+		glog.Warningf("failed to set TCP_NODELAY for client %+v with error: %+v", client.RemoteAddr(), err)
+	}
+	if opts.Listener != nil {
+		// Keepalive of sessions accepted by the inherited listener is not configured by the listen config
+		if err := setKeepAlive(tc, opts.KeepAlive); err != nil {
+			glog.Warningf("failed to set keepalive for client %+v with error: %+v", client.RemoteAddr(), err)
+		}
+	}
+	if opts.ReceiveBuffer > 0 {
+		if err := tc.SetReadBuffer(opts.ReceiveBuffer); err != nil {
+			glog.Warningf("failed to set receive buffer size for client %+v with error: %+v", client.RemoteAddr(), err)
+		}
+	}
+}
+
+func setKeepAlive(tc *net.TCPConn, period time.Duration) error {
+	if period < 0 {
+		return tc.SetKeepAlive(false)
+	}
+	if period == 0 {
+		period = 15 * time.Second
+	}
+	if err := tc.SetKeepAlive(true); err != nil {
+		return err
+	}
+
+	return tc.SetKeepAlivePeriod(period)
+}
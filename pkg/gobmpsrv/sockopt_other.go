@@ -0,0 +1,26 @@
+//go:build !(linux || darwin || dragonfly || freebsd || netbsd || openbsd)
+
+package gobmpsrv
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// control returns the function validating socket options of the listener, SO_REUSEPORT, DSCP marking and
+// sessions authentication are not available on this platform, the receive buffer size is only set on
+// accepted sessions.
+func control(opts *SocketOptions) func(string, string, syscall.RawConn) error {
+	return func(_, _ string, _ syscall.RawConn) error {
+		switch {
+		case opts.ReusePort:
+			return fmt.Errorf("SO_REUSEPORT is not supported on this platform")
+		case opts.DSCP != 0:
+			return fmt.Errorf("dscp marking is not supported on this platform")
+		case len(opts.Auth) != 0:
+			return fmt.Errorf("tcp authentication is not supported on this platform")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,64 @@
+//go:build linux || darwin || dragonfly || freebsd || netbsd || openbsd
+
+package gobmpsrv
+
+import (
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// control returns the function setting socket options of the listener before it is bound, and of connections
+// to routers of active sessions before they are connected
+func control(opts *SocketOptions) func(string, string, syscall.RawConn) error {
+	return func(_, _ string, c syscall.RawConn) error {
+		var serr error
+		if err := c.Control(func(fd uintptr) {
+			serr = setListenerOptions(int(fd), opts)
+		}); err != nil {
+			return err
+		}
+
+		return serr
+	}
+}
+
+// setListenerOptions sets socket options of the listener, accepted sockets inherit them from the listener
+func setListenerOptions(fd int, opts *SocketOptions) error {
+	if opts.ReusePort {
+		if err := unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_REUSEPORT, 1); err != nil {
+			return err
+		}
+	}
+	if opts.ReceiveBuffer > 0 {
+		// Setting the buffer size before the handshake, so the negotiated window scale matches the buffer size
+		if err := unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_RCVBUF, opts.ReceiveBuffer); err != nil {
+			return err
+		}
+	}
+	if opts.DSCP == 0 && len(opts.Auth) == 0 {
+		return nil
+	}
+	sa, err := unix.Getsockname(fd)
+	if err != nil {
+		return err
+	}
+	_, inet6 := sa.(*unix.SockaddrInet6)
+	if opts.DSCP != 0 {
+		tos := opts.DSCP << 2
+		if inet6 {
+			if err := unix.SetsockoptInt(fd, unix.IPPROTO_IPV6, unix.IPV6_TCLASS, tos); err != nil {
+				return err
+			}
+		}
+		// IPv4 sessions accepted by IPv6 socket are marked according to IP_TOS
+		if err := unix.SetsockoptInt(fd, unix.IPPROTO_IP, unix.IP_TOS, tos); err != nil && !inet6 {
+			return err
+		}
+	}
+	if len(opts.Auth) != 0 {
+		return setAuth(fd, inet6, opts.Auth)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package gobmpsrv
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+const (
+	// ClosedByRouter is the reason of the end of the session when the router sent Termination message
+	ClosedByRouter = "router"
+	// ClosedByRequest is the reason of the end of the session closed over the admin API
+	ClosedByRequest = "request"
+	// ClosedByConnection is the reason of the end of the session when the connection was lost or failed
+	ClosedByConnection = "connection"
+)
+
+// prefixMessageTypes lists types of published messages carrying announcements and withdrawals of prefixes
+var prefixMessageTypes = []int{
+	bmp.UnicastPrefixMsg, bmp.UnicastPrefixV4Msg, bmp.UnicastPrefixV6Msg,
+	bmp.L3VPNMsg, bmp.L3VPNV4Msg, bmp.L3VPNV6Msg,
+	bmp.EVPNMsg,
+	bmp.SRPolicyMsg, bmp.SRPolicyV4Msg, bmp.SRPolicyV6Msg,
+	bmp.FlowspecMsg, bmp.FlowspecV4Msg, bmp.FlowspecV6Msg,
+}
+
+// SessionSummary defines session_summary message published when a BMP session ends, it summarizes everything
+// seen during the session
+type SessionSummary struct {
+	SessionInfo
+	End             string `json:"end"`
+	DurationSeconds int64  `json:"duration_seconds"`
+	// ClosedBy is ClosedByRouter, ClosedByRequest or ClosedByConnection
+	ClosedBy string `json:"closed_by"`
+	// TerminationReason and TerminationInfo are carried by Termination message of the router
+	TerminationReason string   `json:"termination_reason,omitempty"`
+	TerminationInfo   []string `json:"termination_info,omitempty"`
+	// BMPMessages counts received BMP messages per BMP message type
+	BMPMessages map[string]uint64 `json:"bmp_messages"`
+	// PublishedMessages counts published messages per type of published messages
+	PublishedMessages map[string]uint64 `json:"published_messages"`
+	// ParseErrors counts BMP messages failed to parse per BMP message type
+	ParseErrors map[string]uint64 `json:"parse_errors,omitempty"`
+	Errors      uint64            `json:"errors"`
+	Peers       int               `json:"peers"`
+	PeersUp     int               `json:"peers_up"`
+	// Prefixes is the number of announcements and withdrawals of prefixes published
+	Prefixes  uint64 `json:"prefixes"`
+	Timestamp string `json:"timestamp"`
+}
+
+func init() {
+	if err := bmp.RegisterMessageSchema(bmp.SessionSummaryMsg, SessionSummary{}); err != nil {
+		panic(err)
+	}
+}
+
+// sessionStats counts messages of the session reported by session_summary message
+type sessionStats struct {
+	sync.Mutex
+	bmp         map[byte]uint64
+	published   map[int]uint64
+	parseErrors map[byte]uint64
+	termination *bmp.TerminationMessage
+	// lastError and lastErrorAt describe the last error of parsing BMP messages received over the session
+	lastError   string
+	lastErrorAt time.Time
+}
+
+func newSessionStats() *sessionStats {
+	return &sessionStats{
+		bmp:         make(map[byte]uint64),
+		published:   make(map[int]uint64),
+		parseErrors: make(map[byte]uint64),
+	}
+}
+
+func (st *sessionStats) bmpMessage(msgType byte) {
+	st.Lock()
+	defer st.Unlock()
+	st.bmp[msgType]++
+}
+
+func (st *sessionStats) publishedMessage(msgType int) {
+	st.Lock()
+	defer st.Unlock()
+	st.published[msgType]++
+}
+
+func (st *sessionStats) parseError(msgType byte, err string) {
+	st.Lock()
+	defer st.Unlock()
+	st.parseErrors[msgType]++
+	st.failed(err)
+}
+
+// failed stores the last error of the session, the caller holds the lock
+func (st *sessionStats) failed(err string) {
+	st.lastError = err
+	st.lastErrorAt = time.Now()
+}
+
+// invalidMessage stores the error of BMP message failed to recover before it is parsed
+func (st *sessionStats) invalidMessage(err string) {
+	st.Lock()
+	defer st.Unlock()
+	st.failed(err)
+}
+
+// counters returns received BMP messages, published messages and parse errors counted per names of their types
+func (st *sessionStats) counters() (map[string]uint64, map[string]uint64, map[string]uint64) {
+	received, published, parseErrors := make(map[string]uint64), make(map[string]uint64), make(map[string]uint64)
+	st.Lock()
+	defer st.Unlock()
+	for t, n := range st.bmp {
+		received[bmpMessageTypeName(t)] += n
+	}
+	for t, n := range st.published {
+		name := bmp.MessageTypeName(t)
+		if name == "" {
+			name = "unknown"
+		}
+		published[name] += n
+	}
+	for t, n := range st.parseErrors {
+		parseErrors[bmpMessageTypeName(t)] += n
+	}
+
+	return received, published, parseErrors
+}
+
+// terminated stores Termination message received from the router
+func (st *sessionStats) terminated(b []byte) {
+	tm, err := bmp.UnmarshalTerminationMessage(b)
+	if err != nil {
+		glog.Errorf("failed to unmarshal Termination message with error: %+v", err)
+		return
+	}
+	st.Lock()
+	defer st.Unlock()
+	st.termination = tm
+}
+
+// summary returns the summary of the session ending at end
+func (s *session) summary(end time.Time) *SessionSummary {
+	sum := &SessionSummary{
+		SessionInfo:     s.info(),
+		End:             end.UTC().Format(time.RFC3339),
+		DurationSeconds: int64(end.Sub(s.connectedSince) / time.Second),
+		ClosedBy:        ClosedByConnection,
+		Timestamp:       end.UTC().Format(time.RFC3339),
+	}
+	sum.BMPMessages, sum.PublishedMessages, sum.ParseErrors = s.stats.counters()
+	for _, n := range sum.ParseErrors {
+		sum.Errors += n
+	}
+	for _, p := range s.peers.list() {
+		sum.Peers++
+		if p.State == PeerStateUp {
+			sum.PeersUp++
+		}
+	}
+	st := s.stats
+	st.Lock()
+	defer st.Unlock()
+	for _, t := range prefixMessageTypes {
+		sum.Prefixes += st.published[t]
+	}
+	switch {
+	case s.closed.Load():
+		sum.ClosedBy = ClosedByRequest
+	case st.termination != nil:
+		sum.ClosedBy = ClosedByRouter
+		sum.TerminationReason = st.termination.GetReasonString()
+		sum.TerminationInfo = st.termination.GetInformation()
+	}
+
+	return sum
+}
+
+// publishSummary publishes session_summary message of the ended session
+func (srv *bmpServer) publishSummary(s *session) {
+	sum := s.summary(time.Now())
+	b, err := json.Marshal(sum)
+	if err != nil {
+		glog.Errorf("failed to marshal summary of session %d with error: %+v", s.id, err)
+		return
+	}
+	hash := fmt.Sprintf("%x", md5.Sum([]byte(s.routerIP)))
+	if err := srv.publisher.PublishMessage(bmp.SessionSummaryMsg, []byte(hash), b); err != nil {
+		glog.Errorf("failed to publish summary of session %d with error: %+v", s.id, err)
+	}
+}
@@ -0,0 +1,109 @@
+package gobmpsrv
+
+import (
+	"fmt"
+	"net/netip"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// tcpAOAddKey is TCP_AO_ADD_KEY socket option available since Linux 6.7
+const tcpAOAddKey = 38
+
+// tcpAOAdd mirrors struct tcp_ao_add of linux/tcp.h
+type tcpAOAdd struct {
+	addr    unix.SockaddrStorage
+	algName [64]byte
+	ifindex int32
+	// flags carries set_current and set_rnext bits, they are not allowed on listening sockets
+	flags     uint32
+	reserved2 uint16
+	prefix    uint8
+	sndid     uint8
+	rcvid     uint8
+	maclen    uint8
+	keyflags  uint8
+	keylen    uint8
+	key       [maxTCPKeyLength]byte
+}
+
+// Size of struct tcp_ao_add must match the kernel's
+var _ [288]byte = [unsafe.Sizeof(tcpAOAdd{})]byte{}
+
+// putSockaddr stores the address of the prefix into sockaddr storage matching the socket family, IPv4 addresses
+// are stored as IPv4-mapped IPv6 addresses for IPv6 sockets, the kernel keeps IPv4 prefix length for them.
+func putSockaddr(ss *unix.SockaddrStorage, inet6 bool, addr netip.Addr) error {
+	if !inet6 {
+		if !addr.Is4() {
+			return fmt.Errorf("ipv6 address %s can not be used with ipv4 socket", addr)
+		}
+		sa := (*unix.RawSockaddrInet4)(unsafe.Pointer(ss))
+		sa.Family = unix.AF_INET
+		sa.Addr = addr.As4()
+		return nil
+	}
+	sa := (*unix.RawSockaddrInet6)(unsafe.Pointer(ss))
+	sa.Family = unix.AF_INET6
+	sa.Addr = addr.As16()
+
+	return nil
+}
+
+func setMD5(fd int, inet6 bool, p netip.Prefix, key string) error {
+	sig := unix.TCPMD5Sig{
+		Flags:     unix.TCP_MD5SIG_FLAG_PREFIX,
+		Prefixlen: uint8(p.Bits()),
+		Keylen:    uint16(len(key)),
+	}
+	copy(sig.Key[:], key)
+	if err := putSockaddr(&sig.Addr, inet6, p.Addr()); err != nil {
+		return err
+	}
+
+	return unix.SetsockoptTCPMD5Sig(fd, unix.IPPROTO_TCP, unix.TCP_MD5SIG_EXT, &sig)
+}
+
+func setAO(fd int, inet6 bool, p netip.Prefix, key *TCPAOKey) error {
+	if len(key.Algorithm) >= 64 {
+		return fmt.Errorf("invalid algorithm name %q", key.Algorithm)
+	}
+	cmd := tcpAOAdd{
+		prefix: uint8(p.Bits()),
+		sndid:  key.SendID,
+		rcvid:  key.RecvID,
+		maclen: key.MACLength,
+		keylen: uint8(len(key.Key)),
+	}
+	copy(cmd.algName[:], key.Algorithm)
+	copy(cmd.key[:], key.Key)
+	if err := putSockaddr(&cmd.addr, inet6, p.Addr()); err != nil {
+		return err
+	}
+	b := unsafe.Slice((*byte)(unsafe.Pointer(&cmd)), unsafe.Sizeof(cmd))
+	if err := unix.SetsockoptString(fd, unix.IPPROTO_TCP, tcpAOAddKey, string(b)); err != nil {
+		if err == unix.ENOPROTOOPT {
+			return fmt.Errorf("tcp-ao is not supported by the kernel")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// setAuth installs TCP MD5 and TCP-AO keys on the listener, accepted sessions inherit keys matching routers' addresses
+func setAuth(fd int, inet6 bool, auth []*TCPAuth) error {
+	for _, a := range auth {
+		if a.MD5Key != "" {
+			if err := setMD5(fd, inet6, a.prefix, a.MD5Key); err != nil {
+				return fmt.Errorf("failed to set tcp md5 key for %s with error: %+v", a.prefix, err)
+			}
+			continue
+		}
+		if err := setAO(fd, inet6, a.prefix, a.AO); err != nil {
+			return fmt.Errorf("failed to set tcp-ao key for %s with error: %+v", a.prefix, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+//go:build !linux && (darwin || dragonfly || freebsd || netbsd || openbsd)
+
+package gobmpsrv
+
+import "fmt"
+
+// setAuth returns an error, on BSD systems TCP MD5 keys are managed as security associations, outside
+// of the socket API.
+func setAuth(fd int, inet6 bool, auth []*TCPAuth) error {
+	return fmt.Errorf("tcp authentication is not supported on this platform")
+}
@@ -0,0 +1,187 @@
+package gobmpsrv
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"time"
+)
+
+// defaultTLSHandshakeTimeout is the time routers have to complete TLS handshake
+const defaultTLSHandshakeTimeout = 10 * time.Second
+
+// TLSConfig defines TLS termination of BMP sessions
+type TLSConfig struct {
+	// CertFile and KeyFile are PEM files of the server certificate and its private key
+	CertFile string `json:"cert_file"`
+	KeyFile  string `json:"key_file"`
+	// ClientCAFile is PEM file of CA certificates verifying certificates of routers, when set, routers must
+	// present certificates signed by the CAs, otherwise certificates of routers are not requested
+	ClientCAFile string `json:"client_ca_file,omitempty"`
+	// Routers lists Common Names of certificates allowed for routers, ClientCAFile is required when Routers is set
+	Routers []*TLSRouter `json:"routers,omitempty"`
+	// HandshakeTimeout is the time routers have to complete TLS handshake, 0 selects 10 seconds
+	HandshakeTimeout time.Duration `json:"-"`
+	config           *tls.Config
+}
+
+// TLSRouter defines Common Names of certificates allowed for routers with addresses covered by the prefix,
+// routers not covered by any prefix are allowed with any certificate verified by the client CAs. When prefixes
+// of several entries cover the router's address, the longest prefix applies.
+type TLSRouter struct {
+	// Prefix is the address or the prefix in CIDR notation of routers
+	Prefix      string   `json:"prefix"`
+	CommonNames []string `json:"common_names"`
+	prefix      netip.Prefix
+}
+
+func (r *TLSRouter) init() error {
+	p, err := netip.ParsePrefix(r.Prefix)
+	if err != nil {
+		addr, aerr := netip.ParseAddr(r.Prefix)
+		if aerr != nil {
+			return fmt.Errorf("invalid prefix %q", r.Prefix)
+		}
+		p = netip.PrefixFrom(addr, addr.BitLen())
+	}
+	r.prefix = p.Masked()
+	if len(r.CommonNames) == 0 {
+		return fmt.Errorf("prefix %s has no common names", r.Prefix)
+	}
+
+	return nil
+}
+
+// LoadTLSRouters reads Common Names of certificates allowed for routers from json file
+func LoadTLSRouters(file string) ([]*TLSRouter, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var routers []*TLSRouter
+	if err := json.Unmarshal(b, &routers); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tls routers file %s with error: %+v", file, err)
+	}
+	for _, r := range routers {
+		if err := r.init(); err != nil {
+			return nil, err
+		}
+	}
+
+	return routers, nil
+}
+
+// init loads certificates of the configuration
+func (c *TLSConfig) init() error {
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load BMP server certificate with error: %+v", err)
+	}
+	if c.HandshakeTimeout < 0 {
+		return fmt.Errorf("invalid tls handshake timeout %s", c.HandshakeTimeout)
+	}
+	if c.HandshakeTimeout == 0 {
+		c.HandshakeTimeout = defaultTLSHandshakeTimeout
+	}
+	for _, r := range c.Routers {
+		if !r.prefix.IsValid() {
+			if err := r.init(); err != nil {
+				return err
+			}
+		}
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if c.ClientCAFile == "" {
+		if len(c.Routers) != 0 {
+			return fmt.Errorf("common names of routers certificates require client CA certificates")
+		}
+		c.config = config
+		return nil
+	}
+	b, err := os.ReadFile(c.ClientCAFile)
+	if err != nil {
+		return err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return fmt.Errorf("no certificates found in client CA file %s", c.ClientCAFile)
+	}
+	config.ClientCAs = pool
+	config.ClientAuth = tls.RequireAndVerifyClientCert
+	c.config = config
+
+	return nil
+}
+
+// commonNames returns Common Names allowed for the router, nil is returned when any Common Name is allowed
+func (c *TLSConfig) commonNames(addr netip.Addr) []string {
+	var match *TLSRouter
+	for _, r := range c.Routers {
+		if r.prefix.Contains(addr) && (match == nil || r.prefix.Bits() > match.prefix.Bits()) {
+			match = r
+		}
+	}
+	if match == nil {
+		return nil
+	}
+
+	return match.CommonNames
+}
+
+// handshake completes TLS handshake with the router and checks that the Common Name of its certificate is allowed
+func (c *TLSConfig) handshake(conn net.Conn) (*tls.Conn, error) {
+	tc := tls.Server(conn, c.config)
+	ctx, cancel := context.WithTimeout(context.Background(), c.HandshakeTimeout)
+	defer cancel()
+	if err := tc.HandshakeContext(ctx); err != nil {
+		return nil, err
+	}
+	var addr netip.Addr
+	if ta, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		addr, _ = netip.AddrFromSlice(ta.IP)
+		addr = addr.Unmap()
+	}
+	if err := c.verify(addr, commonName(tc)); err != nil {
+		return nil, err
+	}
+
+	return tc, nil
+}
+
+// verify returns error if the Common Name of the certificate of the router is not allowed
+func (c *TLSConfig) verify(addr netip.Addr, cn string) error {
+	names := c.commonNames(addr)
+	if names == nil {
+		return nil
+	}
+	for _, name := range names {
+		if name == cn {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("common name %q of the certificate is not allowed for router %s", cn, addr)
+}
+
+// commonName returns the Common Name of the certificate of the TLS session's peer, empty string is returned
+// for sessions which are not TLS sessions or when the peer did not present a certificate
+func commonName(conn net.Conn) string {
+	tc, ok := conn.(interface{ ConnectionState() tls.ConnectionState })
+	if !ok {
+		return ""
+	}
+	certs := tc.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return ""
+	}
+
+	return certs[0].Subject.CommonName
+}
@@ -0,0 +1,195 @@
+package gobmpsrv
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// VendorUnknown is the vendor of routers which did not send Initiation message or whose sysDescr is not recognized
+const VendorUnknown = "unknown"
+
+// vendorFingerprints lists substrings of lower case sysDescr identifying router vendors and operating systems,
+// more specific fingerprints go first
+var vendorFingerprints = []struct {
+	vendor   string
+	patterns []string
+}{
+	{"cisco-iosxr", []string{"ios xr", "ios-xr", "iosxr"}},
+	{"cisco-iosxe", []string{"ios xe", "ios-xe", "iosxe"}},
+	{"cisco-nxos", []string{"nx-os", "nxos"}},
+	{"cisco", []string{"cisco"}},
+	{"juniper", []string{"junos", "juniper"}},
+	{"arista", []string{"arista"}},
+	{"nokia", []string{"timos", "sr os", "nokia"}},
+	{"huawei", []string{"huawei", "vrp"}},
+	{"frr", []string{"frrouting", "frr"}},
+	{"bird", []string{"bird"}},
+	{"gobgp", []string{"gobgp"}},
+	{"openbgpd", []string{"openbgpd"}},
+}
+
+// bmpMessageTypes maps BMP message types to their names
+var bmpMessageTypes = make(map[byte]string)
+
+func init() {
+	for name, t := range bmpMessageTypeNames {
+		bmpMessageTypes[t] = name
+	}
+}
+
+func bmpMessageTypeName(t byte) string {
+	if name, ok := bmpMessageTypes[t]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// fingerprint returns the vendor of the router with the sysDescr
+func fingerprint(sysDescr string) string {
+	d := strings.ToLower(sysDescr)
+	for _, f := range vendorFingerprints {
+		for _, p := range f.patterns {
+			if strings.Contains(d, p) {
+				return f.vendor
+			}
+		}
+	}
+
+	return VendorUnknown
+}
+
+// initiationInfo returns sysDescr and sysName of Initiation message
+func initiationInfo(b []byte) (string, string, error) {
+	im, err := bmp.UnmarshalInitiationMessage(b)
+	if err != nil {
+		return "", "", err
+	}
+	var sysDescr, sysName string
+	for _, tlv := range im.TLV {
+		switch tlv.InformationType {
+		case 1:
+			sysDescr = string(tlv.Information)
+		case 2:
+			sysName = string(tlv.Information)
+		}
+	}
+
+	return sysDescr, sysName, nil
+}
+
+// VendorInfo defines counters of BMP sessions of routers of a vendor, counters cover sessions since the start
+// of the collector. Messages and ParseErrors are counted per BMP message type, RIBTypes counts parsed messages
+// per RIB type of the peer and Capabilities counts capabilities advertised by routers in Peer Up messages.
+type VendorInfo struct {
+	Vendor       string            `json:"vendor"`
+	SysDescrs    []string          `json:"sys_descr,omitempty"`
+	Sessions     uint64            `json:"sessions"`
+	Messages     map[string]uint64 `json:"messages,omitempty"`
+	ParseErrors  map[string]uint64 `json:"parse_errors,omitempty"`
+	RIBTypes     map[string]uint64 `json:"rib_types,omitempty"`
+	Capabilities map[string]uint64 `json:"capabilities,omitempty"`
+}
+
+type vendorStats struct {
+	sync.Mutex
+	vendors map[string]*VendorInfo
+}
+
+func newVendorStats() *vendorStats {
+	return &vendorStats{
+		vendors: make(map[string]*VendorInfo),
+	}
+}
+
+func (vs *vendorStats) get(vendor string) *VendorInfo {
+	v, ok := vs.vendors[vendor]
+	if !ok {
+		v = &VendorInfo{
+			Vendor:       vendor,
+			Messages:     make(map[string]uint64),
+			ParseErrors:  make(map[string]uint64),
+			RIBTypes:     make(map[string]uint64),
+			Capabilities: make(map[string]uint64),
+		}
+		vs.vendors[vendor] = v
+	}
+
+	return v
+}
+
+// session records the session of a router which sent Initiation message with the sysDescr
+func (vs *vendorStats) session(vendor, sysDescr string) {
+	vs.Lock()
+	defer vs.Unlock()
+	v := vs.get(vendor)
+	v.Sessions++
+	if sysDescr == "" {
+		return
+	}
+	for _, d := range v.SysDescrs {
+		if d == sysDescr {
+			return
+		}
+	}
+	v.SysDescrs = append(v.SysDescrs, sysDescr)
+	sort.Strings(v.SysDescrs)
+}
+
+func (vs *vendorStats) message(vendor string, msgType byte) {
+	vs.Lock()
+	defer vs.Unlock()
+	vs.get(vendor).Messages[bmpMessageTypeName(msgType)]++
+}
+
+func (vs *vendorStats) parseError(vendor string, msgType byte) {
+	vs.Lock()
+	defer vs.Unlock()
+	vs.get(vendor).ParseErrors[bmpMessageTypeName(msgType)]++
+}
+
+// parsed records features used in the parsed message
+func (vs *vendorStats) parsed(vendor string, msg *bmp.Message) {
+	if msg.PeerHeader == nil {
+		return
+	}
+	vs.Lock()
+	defer vs.Unlock()
+	v := vs.get(vendor)
+	v.RIBTypes[msg.PeerHeader.GetRIBType()]++
+	if m, ok := msg.Payload.(*bmp.PeerUpMessage); ok && m.SentOpen != nil {
+		for _, c := range capabilities(m.SentOpen.GetCapabilities()) {
+			v.Capabilities[c]++
+		}
+	}
+}
+
+func (vs *vendorStats) list() []VendorInfo {
+	vs.Lock()
+	defer vs.Unlock()
+	l := make([]VendorInfo, 0, len(vs.vendors))
+	for _, v := range vs.vendors {
+		c := *v
+		c.SysDescrs = append([]string(nil), v.SysDescrs...)
+		c.Messages = copyCounters(v.Messages)
+		c.ParseErrors = copyCounters(v.ParseErrors)
+		c.RIBTypes = copyCounters(v.RIBTypes)
+		c.Capabilities = copyCounters(v.Capabilities)
+		l = append(l, c)
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].Vendor < l[j].Vendor })
+
+	return l
+}
+
+func copyCounters(m map[string]uint64) map[string]uint64 {
+	c := make(map[string]uint64, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+
+	return c
+}
@@ -0,0 +1,55 @@
+package gobmpsrv
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// WorkerConfig defines sizing of the pipeline of BMP sessions, every session is read, parsed and produced
+// by its own set of goroutines connected by queues
+type WorkerConfig struct {
+	// ParserWorkers is the maximum number of BMP messages of a session parsed concurrently, 0 selects GOMAXPROCS
+	ParserWorkers int
+	// QueueDepth is the capacity of queues between the reader, the parser and the producer of a session,
+	// 0 selects 64 messages per GOMAXPROCS
+	QueueDepth int
+}
+
+// DefaultWorkerConfig returns sizing of sessions pipeline derived from GOMAXPROCS
+func DefaultWorkerConfig() *WorkerConfig {
+	procs := runtime.GOMAXPROCS(0)
+
+	return &WorkerConfig{
+		ParserWorkers: procs,
+		QueueDepth:    64 * procs,
+	}
+}
+
+// Validate returns error if sizes are negative
+func (c *WorkerConfig) Validate() error {
+	if c.ParserWorkers < 0 {
+		return fmt.Errorf("invalid number of parser workers %d", c.ParserWorkers)
+	}
+	if c.QueueDepth < 0 {
+		return fmt.Errorf("invalid queue depth %d", c.QueueDepth)
+	}
+
+	return nil
+}
+
+// withDefaults returns the configuration with sizes not set replaced by defaults
+func (c *WorkerConfig) withDefaults() *WorkerConfig {
+	d := DefaultWorkerConfig()
+	if c == nil {
+		return d
+	}
+	w := *c
+	if w.ParserWorkers == 0 {
+		w.ParserWorkers = d.ParserWorkers
+	}
+	if w.QueueDepth == 0 {
+		w.QueueDepth = d.QueueDepth
+	}
+
+	return &w
+}
@@ -0,0 +1,209 @@
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// Paths of health endpoints
+const (
+	// LivenessPath reports whether the collector is alive, the collector is restarted when it fails
+	LivenessPath = "/healthz"
+	// ReadinessPath reports whether the collector is ready to receive BMP sessions
+	ReadinessPath = "/readyz"
+)
+
+// Statuses of checks and reports
+const (
+	StatusOK     = "ok"
+	StatusFailed = "failed"
+)
+
+// Check is the result of a check of a component of the collector
+type Check struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the response of health endpoints
+type Report struct {
+	Status string  `json:"status"`
+	Checks []Check `json:"checks"`
+	// Sessions is the number of active BMP sessions
+	Sessions int `json:"sessions"`
+	// Routers is the number of active BMP sessions per router
+	Routers map[string]int `json:"routers"`
+	// Timestamp is the time of the checks in RFC 3339 format
+	Timestamp string `json:"timestamp"`
+}
+
+type check struct {
+	name string
+	f    func() error
+}
+
+// Checker runs checks of components of the collector requested by health endpoints, liveness checks are
+// also part of readiness checks
+type Checker struct {
+	sync.Mutex
+	liveness  []check
+	readiness []check
+	routers   func() []string
+	timeout   time.Duration
+	started   atomic.Bool
+	stopping  atomic.Bool
+	now       func() time.Time
+}
+
+// AddLiveness adds the check failing liveness and readiness of the collector when f returns an error
+func (c *Checker) AddLiveness(name string, f func() error) {
+	c.Lock()
+	defer c.Unlock()
+	c.liveness = append(c.liveness, check{name: name, f: f})
+}
+
+// AddReadiness adds the check failing readiness of the collector when f returns an error
+func (c *Checker) AddReadiness(name string, f func() error) {
+	c.Lock()
+	defer c.Unlock()
+	c.readiness = append(c.readiness, check{name: name, f: f})
+}
+
+// SetRouters sets the function returning routers of active BMP sessions, a router is listed once per session
+func (c *Checker) SetRouters(f func() []string) {
+	c.Lock()
+	defer c.Unlock()
+	c.routers = f
+}
+
+// Started marks the collector started, readiness fails until the collector is started
+func (c *Checker) Started() {
+	c.started.Store(true)
+}
+
+// Stopping fails readiness of the collector, so no new BMP sessions are sent to the collector while it stops
+func (c *Checker) Stopping() {
+	c.stopping.Store(true)
+}
+
+// run runs checks concurrently, checks not completed within the timeout fail
+func (c *Checker) run(checks []check) []Check {
+	results := make([]Check, len(checks))
+	var wg sync.WaitGroup
+	for i, ch := range checks {
+		wg.Add(1)
+		go func(i int, ch check) {
+			defer wg.Done()
+			done := make(chan error, 1)
+			go func() { done <- ch.f() }()
+			results[i] = Check{Name: ch.name, Status: StatusOK}
+			select {
+			case err := <-done:
+				if err != nil {
+					results[i].Status = StatusFailed
+					results[i].Error = err.Error()
+				}
+			case <-time.After(c.timeout):
+				results[i].Status = StatusFailed
+				results[i].Error = fmt.Sprintf("check did not complete within %s", c.timeout)
+			}
+		}(i, ch)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (c *Checker) report(ready bool) *Report {
+	c.Lock()
+	checks := append([]check{}, c.liveness...)
+	if ready {
+		checks = append(checks, c.readiness...)
+	}
+	routers := c.routers
+	c.Unlock()
+	r := &Report{
+		Status:    StatusOK,
+		Checks:    c.run(checks),
+		Routers:   make(map[string]int),
+		Timestamp: c.now().UTC().Format(time.RFC3339),
+	}
+	if ready && !c.started.Load() {
+		r.Checks = append(r.Checks, Check{Name: "startup", Status: StatusFailed, Error: "collector is starting"})
+	}
+	if ready && c.stopping.Load() {
+		r.Checks = append(r.Checks, Check{Name: "shutdown", Status: StatusFailed, Error: "collector is stopping"})
+	}
+	for _, ch := range r.Checks {
+		if ch.Status != StatusOK {
+			r.Status = StatusFailed
+		}
+	}
+	if routers != nil {
+		for _, router := range routers() {
+			r.Routers[router]++
+			r.Sessions++
+		}
+	}
+
+	return r
+}
+
+// Liveness returns the report of liveness checks
+func (c *Checker) Liveness() *Report {
+	return c.report(false)
+}
+
+// Readiness returns the report of liveness and readiness checks
+func (c *Checker) Readiness() *Report {
+	return c.report(true)
+}
+
+func (c *Checker) handler(report func() *Report) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		r := report()
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		if r.Status != StatusOK {
+			glog.V(5).Infof("health check %s failed: %+v", req.URL.Path, r.Checks)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if req.Method == http.MethodHead {
+			return
+		}
+		if err := json.NewEncoder(w).Encode(r); err != nil {
+			glog.Errorf("failed to encode health report with error: %+v", err)
+		}
+	}
+}
+
+// Register registers handlers of LivenessPath and ReadinessPath, endpoints respond with 503 status when
+// any of their checks fails
+func (c *Checker) Register(mux *http.ServeMux) {
+	mux.HandleFunc(LivenessPath, c.handler(c.Liveness))
+	mux.HandleFunc(ReadinessPath, c.handler(c.Readiness))
+}
+
+// NewChecker instantiates a new instance of a checker, checks not completed within the timeout fail, 0 timeout
+// selects 2 seconds
+func NewChecker(timeout time.Duration) (*Checker, error) {
+	if timeout < 0 {
+		return nil, fmt.Errorf("invalid timeout %s of health checks", timeout)
+	}
+	if timeout == 0 {
+		timeout = 2 * time.Second
+	}
+
+	return &Checker{timeout: timeout, now: time.Now}, nil
+}
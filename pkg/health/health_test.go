@@ -0,0 +1,141 @@
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestChecker(t *testing.T) {
+	now := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	var kafkaErr error
+	tests := []struct {
+		name     string
+		started  bool
+		stopping bool
+		kafkaErr error
+		path     string
+		status   int
+		checks   []Check
+	}{
+		{
+			name:   "liveness while starting",
+			path:   LivenessPath,
+			status: http.StatusOK,
+			checks: []Check{{Name: "bmp_listener", Status: StatusOK}},
+		},
+		{
+			name:   "readiness while starting",
+			path:   ReadinessPath,
+			status: http.StatusServiceUnavailable,
+			checks: []Check{
+				{Name: "bmp_listener", Status: StatusOK},
+				{Name: "publisher", Status: StatusOK},
+				{Name: "startup", Status: StatusFailed, Error: "collector is starting"},
+			},
+		},
+		{
+			name:    "ready",
+			started: true,
+			path:    ReadinessPath,
+			status:  http.StatusOK,
+			checks:  []Check{{Name: "bmp_listener", Status: StatusOK}, {Name: "publisher", Status: StatusOK}},
+		},
+		{
+			name:     "kafka is not reachable",
+			started:  true,
+			kafkaErr: errors.New("broker is not reachable"),
+			path:     ReadinessPath,
+			status:   http.StatusServiceUnavailable,
+			checks: []Check{
+				{Name: "bmp_listener", Status: StatusOK},
+				{Name: "publisher", Status: StatusFailed, Error: "broker is not reachable"},
+			},
+		},
+		{
+			name:     "alive while kafka is not reachable",
+			started:  true,
+			kafkaErr: errors.New("broker is not reachable"),
+			path:     LivenessPath,
+			status:   http.StatusOK,
+			checks:   []Check{{Name: "bmp_listener", Status: StatusOK}},
+		},
+		{
+			name:     "stopping",
+			started:  true,
+			stopping: true,
+			path:     ReadinessPath,
+			status:   http.StatusServiceUnavailable,
+			checks: []Check{
+				{Name: "bmp_listener", Status: StatusOK},
+				{Name: "publisher", Status: StatusOK},
+				{Name: "shutdown", Status: StatusFailed, Error: "collector is stopping"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewChecker(0)
+			if err != nil {
+				t.Fatalf("failed to create checker with error: %+v", err)
+			}
+			c.now = func() time.Time { return now }
+			kafkaErr = tt.kafkaErr
+			c.AddLiveness("bmp_listener", func() error { return nil })
+			c.AddReadiness("publisher", func() error { return kafkaErr })
+			c.SetRouters(func() []string { return []string{"192.0.2.1", "192.0.2.2", "192.0.2.1"} })
+			if tt.started {
+				c.Started()
+			}
+			if tt.stopping {
+				c.Stopping()
+			}
+			mux := http.NewServeMux()
+			c.Register(mux)
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if w.Code != tt.status {
+				t.Errorf("expected status %d but got %d", tt.status, w.Code)
+			}
+			r := &Report{}
+			if err := json.Unmarshal(w.Body.Bytes(), r); err != nil {
+				t.Fatalf("failed to unmarshal report %s with error: %+v", w.Body.String(), err)
+			}
+			status := StatusOK
+			if tt.status != http.StatusOK {
+				status = StatusFailed
+			}
+			want := &Report{
+				Status:    status,
+				Checks:    tt.checks,
+				Sessions:  3,
+				Routers:   map[string]int{"192.0.2.1": 2, "192.0.2.2": 1},
+				Timestamp: "2026-10-14T00:00:00Z",
+			}
+			if !reflect.DeepEqual(r, want) {
+				t.Errorf("expected report %+v but got %+v", *want, *r)
+			}
+		})
+	}
+}
+
+func TestCheckerTimeout(t *testing.T) {
+	c, err := NewChecker(10 * time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to create checker with error: %+v", err)
+	}
+	block := make(chan struct{})
+	defer close(block)
+	c.AddLiveness("bmp_listener", func() error { <-block; return nil })
+	r := c.Liveness()
+	if r.Status != StatusFailed || len(r.Checks) != 1 || r.Checks[0].Status != StatusFailed {
+		t.Errorf("expected check not completed within the timeout to fail but got %+v", r.Checks)
+	}
+	if _, err := NewChecker(-time.Second); err == nil {
+		t.Errorf("expected negative timeout to fail")
+	}
+}
@@ -0,0 +1,162 @@
+//go:build ignore
+
+// gen converts IANA registries vendored in registries directory into Go tables of tables.go,
+// run by go generate in pkg/iana. Registries are refreshed by replacing the CSV files with
+// the CSV exports of the registries published by IANA and regenerating the tables.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// registry defines a vendored IANA registry and the table generated from it
+type registry struct {
+	file    string
+	table   string
+	keyType string
+	bits    int
+	doc     string
+	url     string
+}
+
+var registries = []registry{
+	{
+		file:    "capability-codes.csv",
+		table:   "Capabilities",
+		keyType: "uint8",
+		bits:    8,
+		doc:     "Capabilities maps BGP Capability Codes to their names",
+		url:     "https://www.iana.org/assignments/capability-codes/capability-codes.xhtml",
+	},
+	{
+		file:    "bgp-path-attributes.csv",
+		table:   "PathAttributes",
+		keyType: "uint8",
+		bits:    8,
+		doc:     "PathAttributes maps BGP Path Attribute type codes to their names",
+		url:     "https://www.iana.org/assignments/bgp-parameters/bgp-parameters.xhtml",
+	},
+	{
+		file:    "bgp-ls-tlvs.csv",
+		table:   "BGPLSTLVs",
+		keyType: "uint16",
+		bits:    16,
+		doc:     "BGPLSTLVs maps BGP-LS Node Descriptor, Link Descriptor, Prefix Descriptor and Attribute TLV code points to their names",
+		url:     "https://www.iana.org/assignments/bgp-ls-parameters/bgp-ls-parameters.xhtml",
+	},
+	{
+		file:    "bgp-extended-community-types.csv",
+		table:   "ExtCommunityTypes",
+		keyType: "uint8",
+		bits:    8,
+		doc:     "ExtCommunityTypes maps BGP Transitive and Non-Transitive Extended Community Types to their names",
+		url:     "https://www.iana.org/assignments/bgp-extended-communities/bgp-extended-communities.xhtml",
+	},
+}
+
+type entry struct {
+	value     uint64
+	hex       bool
+	name      string
+	reference string
+}
+
+// skipped returns true for entries without a code point to name, ranges and unassigned or reserved values
+func skipped(value, name string) bool {
+	return strings.Contains(value, "-") || name == "" || strings.HasPrefix(name, "Unassigned") ||
+		strings.HasPrefix(name, "Reserved")
+}
+
+// readRegistry reads the registry's CSV, the first column carries values and the second their names,
+// the third column carries the reference when present
+func readRegistry(dir string, r registry) ([]entry, error) {
+	f, err := os.Open(filepath.Join(dir, r.file))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	cr := csv.NewReader(f)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s with error: %+v", r.file, err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("registry %s is empty", r.file)
+	}
+	entries := make([]entry, 0, len(records))
+	seen := make(map[uint64]bool)
+	// The first record is the header
+	for i, rec := range records[1:] {
+		if len(rec) < 2 {
+			return nil, fmt.Errorf("%s:%d: expected at least 2 columns but got %d", r.file, i+2, len(rec))
+		}
+		value, name := strings.TrimSpace(rec[0]), strings.Join(strings.Fields(rec[1]), " ")
+		if skipped(value, name) {
+			continue
+		}
+		v, err := strconv.ParseUint(value, 0, r.bits)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid value %q", r.file, i+2, value)
+		}
+		if seen[v] {
+			return nil, fmt.Errorf("%s:%d: duplicate value %q", r.file, i+2, value)
+		}
+		seen[v] = true
+		e := entry{value: v, hex: strings.HasPrefix(value, "0x"), name: name}
+		if len(rec) > 2 {
+			e.reference = strings.TrimSpace(rec[2])
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].value < entries[j].value })
+
+	return entries, nil
+}
+
+func main() {
+	dir := flag.String("registries", "registries", "directory of vendored IANA registries")
+	out := flag.String("out", "tables.go", "generated file")
+	flag.Parse()
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen.go from IANA registries; DO NOT EDIT.\n\npackage iana\n")
+	for _, r := range registries {
+		entries, err := readRegistry(*dir, r)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Fprintf(&b, "\n// %s, %s\n// %s\nvar %s = map[%s]string{\n", r.doc, r.file, r.url, r.table, r.keyType)
+		for _, e := range entries {
+			if e.hex {
+				fmt.Fprintf(&b, "0x%02x: %q,", e.value, e.name)
+			} else {
+				fmt.Fprintf(&b, "%d: %q,", e.value, e.name)
+			}
+			if e.reference != "" {
+				fmt.Fprintf(&b, " // %s", e.reference)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("}\n")
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gen: failed to format generated tables with error: %+v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,41 @@
+// Package iana carries names of code points of IANA registries used by BGP and BGP-LS decoders.
+// Tables are generated from the registries vendored in registries directory, new code points
+// get names by updating the vendored registries and running go generate.
+package iana
+
+import "strconv"
+
+//go:generate go run gen.go
+
+// CapabilityName returns the name of BGP Capability code
+func CapabilityName(code uint8) string {
+	if n, ok := Capabilities[code]; ok {
+		return n
+	}
+	return "Unknown capability " + strconv.Itoa(int(code))
+}
+
+// PathAttributeName returns the name of BGP Path Attribute type
+func PathAttributeName(t uint8) string {
+	if n, ok := PathAttributes[t]; ok {
+		return n
+	}
+	return "Unknown attribute " + strconv.Itoa(int(t))
+}
+
+// BGPLSTLVName returns the name of BGP-LS TLV type
+func BGPLSTLVName(t uint16) string {
+	if n, ok := BGPLSTLVs[t]; ok {
+		return n
+	}
+	return "Unknown TLV " + strconv.Itoa(int(t))
+}
+
+// ExtCommunityTypeName returns the name of BGP Extended Community type, the high-order octet
+// of the extended community
+func ExtCommunityTypeName(t uint8) string {
+	if n, ok := ExtCommunityTypes[t]; ok {
+		return n
+	}
+	return "Unknown extended community type " + strconv.Itoa(int(t))
+}
@@ -0,0 +1,81 @@
+package iana
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		name   string
+		got    string
+		expect string
+	}{
+		{
+			name:   "capability",
+			got:    CapabilityName(69),
+			expect: "ADD-PATH Capability",
+		},
+		{
+			name:   "unknown capability",
+			got:    CapabilityName(200),
+			expect: "Unknown capability 200",
+		},
+		{
+			name:   "path attribute",
+			got:    PathAttributeName(29),
+			expect: "BGP-LS Attribute",
+		},
+		{
+			name:   "reserved path attribute",
+			got:    PathAttributeName(0),
+			expect: "Unknown attribute 0",
+		},
+		{
+			name:   "bgp-ls tlv",
+			got:    BGPLSTLVName(1026),
+			expect: "Node Name",
+		},
+		{
+			name:   "extended community type",
+			got:    ExtCommunityTypeName(0x06),
+			expect: "EVPN",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expect {
+				t.Errorf("expected name %q but got %q", tt.expect, tt.got)
+			}
+		})
+	}
+}
+
+// TestGenerated checks that tables.go is generated from the vendored registries
+func TestGenerated(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping generation of tables in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skipf("go tool is not available: %+v", err)
+	}
+	out := filepath.Join(t.TempDir(), "tables.go")
+	if b, err := exec.Command(goTool, "run", "gen.go", "-out", out).CombinedOutput(); err != nil {
+		t.Fatalf("failed to generate tables with error: %+v, output: %s", err, b)
+	}
+	generated, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := os.ReadFile("tables.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(generated, current) {
+		t.Errorf("tables.go is out of date, run go generate ./pkg/iana")
+	}
+}
@@ -0,0 +1,197 @@
+// Code generated by gen.go from IANA registries; DO NOT EDIT.
+
+package iana
+
+// Capabilities maps BGP Capability Codes to their names, capability-codes.csv
+// https://www.iana.org/assignments/capability-codes/capability-codes.xhtml
+var Capabilities = map[uint8]string{
+	1:   "Multiprotocol Extensions for BGP-4",                       // [RFC2858]
+	2:   "Route Refresh Capability for BGP-4",                       // [RFC2918]
+	3:   "Outbound Route Filtering Capability",                      // [RFC5291]
+	4:   "Multiple routes to a destination capability (deprecated)", // [RFC8277]
+	5:   "Extended Next Hop Encoding",                               // [RFC8950]
+	6:   "BGP Extended Message",                                     // [RFC8654]
+	7:   "BGPsec Capability",                                        // [RFC8205]
+	8:   "Multiple Labels Capability",                               // [RFC8277]
+	9:   "BGP Role",                                                 // [RFC9234]
+	64:  "Graceful Restart Capability",                              // [RFC4724]
+	65:  "Support for 4-octet AS number capability",                 // [RFC6793]
+	66:  "Deprecated (2003-03-06)",
+	67:  "Support for Dynamic Capability (capability specific)", // [draft-ietf-idr-dynamic-cap]
+	68:  "Multisession BGP Capability",                          // [draft-ietf-idr-bgp-multisession]
+	69:  "ADD-PATH Capability",                                  // [RFC7911]
+	70:  "Enhanced Route Refresh Capability",                    // [RFC7313]
+	71:  "Long-Lived Graceful Restart (LLGR) Capability",        // [RFC9494]
+	72:  "Routing Policy Distribution",                          // [draft-ietf-idr-rpd]
+	73:  "FQDN Capability",                                      // [draft-walton-bgp-hostname-capability]
+	74:  "BFD Capability (TEMPORARY)",                           // [draft-ietf-idr-bgp-bfd-strict-mode]
+	75:  "Software Version Capability (TEMPORARY)",              // [draft-abraitis-bgp-version-capability]
+	76:  "Paths-Limit Capability (TEMPORARY)",                   // [draft-ietf-idr-add-paths-guidance]
+	128: "Prestandard Route Refresh (deprecated)",               // [RFC8810]
+	129: "Prestandard Outbound Route Filtering (deprecated)",    // [RFC8810]
+	130: "Prestandard Outbound Route Filtering (deprecated)",    // [RFC8810]
+	131: "Prestandard Multisession (deprecated)",                // [RFC8810]
+	184: "Prestandard FQDN (deprecated)",                        // [RFC8810]
+	185: "Prestandard OPERATIONAL message (deprecated)",         // [RFC8810]
+}
+
+// PathAttributes maps BGP Path Attribute type codes to their names, bgp-path-attributes.csv
+// https://www.iana.org/assignments/bgp-parameters/bgp-parameters.xhtml
+var PathAttributes = map[uint8]string{
+	1:   "ORIGIN",                                                   // [RFC4271]
+	2:   "AS_PATH",                                                  // [RFC4271]
+	3:   "NEXT_HOP",                                                 // [RFC4271]
+	4:   "MULTI_EXIT_DISC",                                          // [RFC4271]
+	5:   "LOCAL_PREF",                                               // [RFC4271]
+	6:   "ATOMIC_AGGREGATE",                                         // [RFC4271]
+	7:   "AGGREGATOR",                                               // [RFC4271]
+	8:   "COMMUNITIES",                                              // [RFC1997]
+	9:   "ORIGINATOR_ID",                                            // [RFC4456]
+	10:  "CLUSTER_LIST",                                             // [RFC4456]
+	11:  "DPA (deprecated)",                                         // [RFC6938]
+	12:  "ADVERTISER (historic) (deprecated)",                       // [RFC1863][RFC4223][RFC6938]
+	13:  "RCID_PATH / CLUSTER_ID (historic) (deprecated)",           // [RFC1863][RFC4223][RFC6938]
+	14:  "MP_REACH_NLRI",                                            // [RFC4760]
+	15:  "MP_UNREACH_NLRI",                                          // [RFC4760]
+	16:  "EXTENDED COMMUNITIES",                                     // [RFC4360]
+	17:  "AS4_PATH",                                                 // [RFC6793]
+	18:  "AS4_AGGREGATOR",                                           // [RFC6793]
+	19:  "SAFI Specific Attribute (SSA) (deprecated)",               // [draft-kapoor-nalawade-idr-bgp-ssa]
+	20:  "Connector Attribute (deprecated)",                         // [RFC6037]
+	21:  "AS_PATHLIMIT (deprecated)",                                // [draft-ietf-idr-as-pathlimit]
+	22:  "PMSI_TUNNEL",                                              // [RFC6514]
+	23:  "Tunnel Encapsulation",                                     // [RFC9012]
+	24:  "Traffic Engineering",                                      // [RFC5543]
+	25:  "IPv6 Address Specific Extended Community",                 // [RFC5701]
+	26:  "AIGP",                                                     // [RFC7311]
+	27:  "PE Distinguisher Labels",                                  // [RFC6514]
+	28:  "BGP Entropy Label Capability Attribute (deprecated)",      // [RFC6790][RFC7447]
+	29:  "BGP-LS Attribute",                                         // [RFC9552]
+	30:  "Deprecated",                                               // [RFC8093]
+	31:  "Deprecated",                                               // [RFC8093]
+	32:  "LARGE_COMMUNITY",                                          // [RFC8092]
+	33:  "BGPsec_Path",                                              // [RFC8205]
+	34:  "BGP Community Container Attribute (TEMPORARY)",            // [draft-ietf-idr-wide-bgp-communities]
+	35:  "Only to Customer (OTC)",                                   // [RFC9234]
+	36:  "BGP Domain Path (D-PATH) (TEMPORARY)",                     // [draft-ietf-bess-evpn-ipvpn-interworking]
+	37:  "SFP attribute",                                            // [RFC9015]
+	38:  "BFD Discriminator",                                        // [RFC9026]
+	39:  "BGP Next Hop Dependent Characteristics (NHC) (TEMPORARY)", // [draft-ietf-idr-entropy-label]
+	40:  "BGP Prefix-SID",                                           // [RFC8669]
+	128: "ATTR_SET",                                                 // [RFC6368]
+	129: "Deprecated",                                               // [RFC8093]
+	241: "Deprecated",                                               // [RFC8093]
+	242: "Deprecated",                                               // [RFC8093]
+	243: "Deprecated",                                               // [RFC8093]
+}
+
+// BGPLSTLVs maps BGP-LS Node Descriptor, Link Descriptor, Prefix Descriptor and Attribute TLV code points to their names, bgp-ls-tlvs.csv
+// https://www.iana.org/assignments/bgp-ls-parameters/bgp-ls-parameters.xhtml
+var BGPLSTLVs = map[uint16]string{
+	256:  "Local Node Descriptors",
+	257:  "Remote Node Descriptors",
+	258:  "Link Local/Remote Identifiers", // 22/4
+	259:  "IPv4 interface address",        // 22/6
+	260:  "IPv4 neighbor address",         // 22/8
+	261:  "IPv6 interface address",        // 22/12
+	262:  "IPv6 neighbor address",         // 22/13
+	263:  "Multi-Topology Identifier",
+	264:  "OSPF Route Type",
+	265:  "IP Reachability Information",
+	266:  "Node MSD", // 242/23
+	267:  "Link MSD", // 22/15
+	512:  "Autonomous System",
+	513:  "BGP-LS Identifier (deprecated)",
+	514:  "OSPF Area-ID",
+	515:  "IGP Router-ID",
+	516:  "BGP Router-ID",
+	517:  "BGP Confederation Member",
+	518:  "SRv6 SID Information",
+	1024: "Node Flag Bits",
+	1025: "Opaque Node Attribute",
+	1026: "Node Name", // 137
+	1027: "IS-IS Area Identifier",
+	1028: "IPv4 Router-ID of Local Node",   // 134/--
+	1029: "IPv6 Router-ID of Local Node",   // 140/--
+	1030: "IPv4 Router-ID of Remote Node",  // 134/--
+	1031: "IPv6 Router-ID of Remote Node",  // 140/--
+	1034: "SR Capabilities",                // 242/2
+	1035: "SR Algorithm",                   // 242/19
+	1036: "SR Local Block",                 // 242/22
+	1037: "SRMS Preference",                // 242/24
+	1038: "SRv6 Capabilities",              // 242/25
+	1039: "Flexible Algorithm Definition",  // 242/26
+	1088: "Administrative group (color)",   // 22/3
+	1089: "Maximum link bandwidth",         // 22/9
+	1090: "Max. reservable link bandwidth", // 22/10
+	1091: "Unreserved bandwidth",           // 22/11
+	1092: "TE Default Metric",              // 22/18
+	1093: "Link Protection Type",           // 22/20
+	1094: "MPLS Protocol Mask",
+	1095: "IGP Metric",
+	1096: "Shared Risk Link Group",
+	1097: "Opaque Link Attribute",
+	1098: "Link Name",
+	1099: "Adjacency SID",     // 22/31
+	1100: "LAN Adjacency SID", // 22/32
+	1101: "PeerNode SID",
+	1102: "PeerAdj SID",
+	1103: "PeerSet SID",
+	1105: "RTM Capability",
+	1106: "SRv6 End.X SID",           // 22/43
+	1107: "IS-IS SRv6 LAN End.X SID", // 22/44
+	1108: "OSPFv3 SRv6 LAN End.X SID",
+	1114: "Unidirectional Link Delay",          // 22/33
+	1115: "Min/Max Unidirectional Link Delay",  // 22/34
+	1116: "Unidirectional Delay Variation",     // 22/35
+	1117: "Unidirectional Link Loss",           // 22/36
+	1118: "Unidirectional Residual Bandwidth",  // 22/37
+	1119: "Unidirectional Available Bandwidth", // 22/38
+	1120: "Unidirectional Utilized Bandwidth",  // 22/39
+	1122: "Application-Specific Link Attributes",
+	1152: "IGP Flags",
+	1153: "IGP Route Tag",
+	1154: "IGP Extended Route Tag",
+	1155: "Prefix Metric",
+	1156: "OSPF Forwarding Address",
+	1157: "Opaque Prefix Attribute",
+	1158: "Prefix SID", // 135/3
+	1159: "Range",
+	1161: "SID/Label",
+	1170: "Prefix Attribute Flags",
+	1171: "Source Router Identifier",
+	1172: "L2 Bundle Member Attributes",   // 25/--
+	1173: "Extended Administrative Group", // 22/41
+	1174: "Source OSPF Router-ID",
+	1250: "SRv6 Endpoint Behavior",
+	1251: "SRv6 BGP Peer Node SID",
+	1252: "SRv6 SID Structure",
+}
+
+// ExtCommunityTypes maps BGP Transitive and Non-Transitive Extended Community Types to their names, bgp-extended-community-types.csv
+// https://www.iana.org/assignments/bgp-extended-communities/bgp-extended-communities.xhtml
+var ExtCommunityTypes = map[uint8]string{
+	0x00: "Transitive Two-Octet AS-Specific Extended Community",           // [RFC7153]
+	0x01: "Transitive IPv4-Address-Specific Extended Community",           // [RFC7153]
+	0x02: "Transitive Four-Octet AS-Specific Extended Community",          // [RFC7153]
+	0x03: "Transitive Opaque Extended Community",                          // [RFC7153]
+	0x04: "QoS Marking",                                                   // [Thomas_Martin_Knoll]
+	0x05: "CoS Capability",                                                // [Thomas_Martin_Knoll]
+	0x06: "EVPN",                                                          // [RFC7153]
+	0x07: "FlowSpec Transitive Extended Communities",                      // [RFC9184]
+	0x08: "Flow spec redirect/mirror to IP next-hop",                      // [draft-simpson-idr-flowspec-redirect]
+	0x09: "FlowSpec Redirect/Mirror to Indirection ID",                    // [draft-ietf-idr-flowspec-path-redirect]
+	0x0a: "Transitive Transport Class",                                    // [draft-ietf-idr-bgp-ct]
+	0x0b: "SFC (Service Function Chaining)",                               // [RFC9015]
+	0x0c: "Transitive MUP Extended Community",                             // [draft-ietf-bess-mup-safi]
+	0x40: "Non-Transitive Two-Octet AS-Specific Extended Community",       // [RFC7153]
+	0x41: "Non-Transitive IPv4-Address-Specific Extended Community",       // [RFC7153]
+	0x42: "Non-Transitive Four-Octet AS-Specific Extended Community",      // [RFC7153]
+	0x43: "Non-Transitive Opaque Extended Community",                      // [RFC7153]
+	0x44: "QoS Marking",                                                   // [Thomas_Martin_Knoll]
+	0x47: "FlowSpec Non-Transitive Extended Communities",                  // [RFC9184]
+	0x4a: "Non-Transitive Transport Class",                                // [draft-ietf-idr-bgp-ct]
+	0x80: "Generic Transitive Experimental Use Extended Community",        // [RFC7153]
+	0x81: "Generic Transitive Experimental Use Extended Community Part 2", // [RFC7674]
+	0x82: "Generic Transitive Experimental Use Extended Community Part 3", // [RFC7674]
+}
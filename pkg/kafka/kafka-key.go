@@ -0,0 +1,104 @@
+package kafka
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// Partition key strategies, KeyHash keeps the key passed by the caller, other strategies key messages by their
+// fields, so messages of a router, a peer, a prefix or a route distinguisher keep their order in one partition
+const (
+	KeyHash   = "hash"
+	KeyRouter = "router_hash"
+	KeyPeer   = "peer_ip"
+	KeyPrefix = "prefix"
+	KeyRD     = "rd"
+)
+
+// AllMessageTypes selects the partition key strategy of message types without their own strategy
+const AllMessageTypes = "*"
+
+// partitionKeys holds partition key strategies keyed by names of message types
+type partitionKeys map[string]string
+
+// newPartitionKeys returns strategies of message types, names of message types without address family suffix
+// select the strategy of both address families, for example "unicast_prefix" of "unicast_prefix_v4"
+func newPartitionKeys(keys map[string]string) (partitionKeys, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	known := make(map[string]bool)
+	for _, mt := range bmp.MessageTypes() {
+		known[mt.Name] = true
+	}
+	pk := make(partitionKeys, len(keys))
+	for name, s := range keys {
+		if name != AllMessageTypes && !known[name] {
+			return nil, fmt.Errorf("unknown message type %q of partition key", name)
+		}
+		switch s {
+		case KeyHash, KeyRouter, KeyPeer, KeyPrefix, KeyRD:
+		default:
+			return nil, fmt.Errorf("unknown partition key %q of message type %s, supported keys are %s, %s, %s, %s and %s",
+				s, name, KeyHash, KeyRouter, KeyPeer, KeyPrefix, KeyRD)
+		}
+		pk[name] = s
+	}
+
+	return pk, nil
+}
+
+// strategy returns the partition key strategy of the message type
+func (pk partitionKeys) strategy(name string) string {
+	if s, ok := pk[name]; ok {
+		return s
+	}
+	if s, ok := pk[strings.TrimSuffix(strings.TrimSuffix(name, "_v4"), "_v6")]; ok {
+		return s
+	}
+	if s, ok := pk[AllMessageTypes]; ok {
+		return s
+	}
+
+	return KeyHash
+}
+
+// key returns the partition key of the json message of the message type, the key passed by the caller is
+// returned when the message lacks fields of the strategy
+func (pk partitionKeys) key(mt bmp.MessageType, key []byte, msg []byte) []byte {
+	if pk == nil {
+		return key
+	}
+	switch pk.strategy(mt.Name) {
+	case KeyRouter:
+		if v, ok := messageField(msg, "router_hash"); ok {
+			return []byte(v)
+		}
+	case KeyPeer:
+		if v, ok := messageField(msg, "peer_ip"); ok {
+			return []byte(v)
+		}
+	case KeyPrefix:
+		prefix, ok := messageField(msg, "prefix")
+		if !ok {
+			return key
+		}
+		k := prefix
+		if l, ok := messageField(msg, "prefix_len"); ok {
+			k += "/" + l
+		}
+		// Prefixes of different VPNs are different routes
+		if rd, ok := messageField(msg, "vpn_rd"); ok {
+			k = rd + ":" + k
+		}
+		return []byte(k)
+	case KeyRD:
+		if v, ok := messageField(msg, "vpn_rd"); ok {
+			return []byte(v)
+		}
+	}
+
+	return key
+}
@@ -0,0 +1,181 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestNewPartitionKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		keys map[string]string
+		fail bool
+	}{
+		{
+			name: "message types and all message types",
+			keys: map[string]string{"unicast_prefix": KeyPrefix, "peer": KeyPeer, AllMessageTypes: KeyRouter},
+		},
+		{
+			name: "unknown message type",
+			keys: map[string]string{"unicast_route": KeyPrefix},
+			fail: true,
+		},
+		{
+			name: "unknown partition key",
+			keys: map[string]string{"peer": "peer_asn"},
+			fail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pk, err := newPartitionKeys(tt.keys)
+			if tt.fail {
+				if err == nil {
+					t.Fatalf("expected error but got %+v", pk)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to create partition keys with error: %+v", err)
+			}
+			if len(pk) != len(tt.keys) {
+				t.Errorf("expected %d partition keys but got %d", len(tt.keys), len(pk))
+			}
+		})
+	}
+	if pk, err := newPartitionKeys(nil); err != nil || pk != nil {
+		t.Errorf("expected no partition keys without strategies but got %+v with error %v", pk, err)
+	}
+}
+
+func TestPartitionKey(t *testing.T) {
+	unicast, _ := bmp.LookupMessageType(bmp.UnicastPrefixV4Msg)
+	l3vpn, _ := bmp.LookupMessageType(bmp.L3VPNV4Msg)
+	peer, _ := bmp.LookupMessageType(bmp.PeerStateChangeMsg)
+	const route = `{"router_hash":"a1b2","peer_ip":"192.168.1.1","prefix":"10.1.0.0","prefix_len":16}`
+	const vpnRoute = `{"router_hash":"a1b2","peer_ip":"192.168.1.1","vpn_rd":"65000:1","prefix":"10.1.0.0","prefix_len":16}`
+	tests := []struct {
+		name string
+		keys map[string]string
+		mt   bmp.MessageType
+		msg  string
+		key  string
+	}{
+		{
+			name: "no strategies keep the key",
+			mt:   unicast,
+			msg:  route,
+			key:  "hash",
+		},
+		{
+			name: "router of the message type",
+			keys: map[string]string{"unicast_prefix_v4": KeyRouter},
+			mt:   unicast,
+			msg:  route,
+			key:  "a1b2",
+		},
+		{
+			name: "peer of the message type without address family",
+			keys: map[string]string{"unicast_prefix": KeyPeer},
+			mt:   unicast,
+			msg:  route,
+			key:  "192.168.1.1",
+		},
+		{
+			name: "message type overrides message type without address family",
+			keys: map[string]string{"unicast_prefix": KeyPeer, "unicast_prefix_v4": KeyRouter},
+			mt:   unicast,
+			msg:  route,
+			key:  "a1b2",
+		},
+		{
+			name: "all message types",
+			keys: map[string]string{AllMessageTypes: KeyRouter, "peer": KeyHash},
+			mt:   l3vpn,
+			msg:  vpnRoute,
+			key:  "a1b2",
+		},
+		{
+			name: "hash of the message type overrides all message types",
+			keys: map[string]string{AllMessageTypes: KeyRouter, "peer": KeyHash},
+			mt:   peer,
+			msg:  `{"router_hash":"a1b2","remote_ip":"192.168.1.1"}`,
+			key:  "hash",
+		},
+		{
+			name: "message type without strategy",
+			keys: map[string]string{"peer": KeyPeer},
+			mt:   unicast,
+			msg:  route,
+			key:  "hash",
+		},
+		{
+			name: "prefix",
+			keys: map[string]string{"unicast_prefix": KeyPrefix},
+			mt:   unicast,
+			msg:  route,
+			key:  "10.1.0.0/16",
+		},
+		{
+			name: "prefix of vpn",
+			keys: map[string]string{"l3vpn": KeyPrefix},
+			mt:   l3vpn,
+			msg:  vpnRoute,
+			key:  "65000:1:10.1.0.0/16",
+		},
+		{
+			name: "prefix without length",
+			keys: map[string]string{"unicast_prefix": KeyPrefix},
+			mt:   unicast,
+			msg:  `{"prefix":"10.1.0.0"}`,
+			key:  "10.1.0.0",
+		},
+		{
+			name: "route distinguisher",
+			keys: map[string]string{"l3vpn": KeyRD},
+			mt:   l3vpn,
+			msg:  vpnRoute,
+			key:  "65000:1",
+		},
+		{
+			name: "missing router hash keeps the key",
+			keys: map[string]string{"unicast_prefix": KeyRouter},
+			mt:   unicast,
+			msg:  `{"peer_ip":"192.168.1.1"}`,
+			key:  "hash",
+		},
+		{
+			name: "missing peer keeps the key",
+			keys: map[string]string{"peer": KeyPeer},
+			mt:   peer,
+			msg:  `{"remote_ip":"192.168.1.1"}`,
+			key:  "hash",
+		},
+		{
+			name: "missing prefix keeps the key",
+			keys: map[string]string{"unicast_prefix": KeyPrefix},
+			mt:   unicast,
+			msg:  `{"is_eor":true}`,
+			key:  "hash",
+		},
+		{
+			name: "missing route distinguisher keeps the key",
+			keys: map[string]string{"unicast_prefix": KeyRD},
+			mt:   unicast,
+			msg:  route,
+			key:  "hash",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pk, err := newPartitionKeys(tt.keys)
+			if err != nil {
+				t.Fatalf("failed to create partition keys with error: %+v", err)
+			}
+			if key := pk.key(tt.mt, []byte("hash"), []byte(tt.msg)); string(key) != tt.key {
+				t.Errorf("expected key %q but got %q", tt.key, key)
+			}
+		})
+	}
+}
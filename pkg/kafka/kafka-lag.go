@@ -0,0 +1,277 @@
+package kafka
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/events"
+)
+
+// LagConfig defines monitoring of lag of consumer groups reading gobmp topics. Lag is polled every Interval,
+// a warning is logged when the lag of a group exceeds Threshold messages, 0 disables it, or when committed offsets
+// of a group with lag do not advance between polls. Security applies to connections to brokers as for publishers.
+type LagConfig struct {
+	Groups    []string
+	Interval  time.Duration
+	Threshold int64
+	Security  *SecurityConfig
+}
+
+// GroupLag defines the lag of a consumer group, the number of messages produced to gobmp topics and not yet
+// committed by the group. Only partitions with offsets committed by the group are included. The group is stalled
+// when it has lag and its committed offsets did not advance since the previous poll.
+type GroupLag struct {
+	Group      string         `json:"group"`
+	Lag        int64          `json:"lag"`
+	Stalled    bool           `json:"stalled"`
+	Updated    string         `json:"updated,omitempty"`
+	Error      string         `json:"error,omitempty"`
+	Partitions []PartitionLag `json:"partitions,omitempty"`
+}
+
+// PartitionLag defines the lag of a consumer group on a partition of a topic
+type PartitionLag struct {
+	Topic         string `json:"topic"`
+	Partition     int32  `json:"partition"`
+	Committed     int64  `json:"committed_offset"`
+	HighWatermark int64  `json:"high_watermark"`
+	Lag           int64  `json:"lag"`
+}
+
+// LagMonitor defines methods of the monitor of consumer groups lag
+type LagMonitor interface {
+	// Lag returns the lag of monitored consumer groups as of the last poll
+	Lag() []GroupLag
+	Stop()
+}
+
+type lagMonitor struct {
+	sync.Mutex
+	client sarama.Client
+	admin  sarama.ClusterAdmin
+	config *LagConfig
+	groups map[string]*GroupLag
+	// committed stores offsets committed by groups per topic partition as of the last poll
+	committed map[string]map[string]int64
+	stop      chan struct{}
+}
+
+func (m *lagMonitor) Lag() []GroupLag {
+	m.Lock()
+	defer m.Unlock()
+	l := make([]GroupLag, 0, len(m.groups))
+	for _, g := range m.groups {
+		c := *g
+		c.Partitions = append([]PartitionLag(nil), g.Partitions...)
+		l = append(l, c)
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].Group < l[j].Group })
+
+	return l
+}
+
+func (m *lagMonitor) Stop() {
+	close(m.stop)
+	m.admin.Close()
+}
+
+// highWatermarks returns offsets of the next messages to be produced to partitions of gobmp topics
+func (m *lagMonitor) highWatermarks() (map[string]map[int32]int64, error) {
+	topics := topicNames("")
+	if err := m.client.RefreshMetadata(topics...); err != nil {
+		return nil, err
+	}
+	hw := make(map[string]map[int32]int64, len(topics))
+	for _, t := range topics {
+		partitions, err := m.client.Partitions(t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get partitions of topic %s with error: %+v", t, err)
+		}
+		hw[t] = make(map[int32]int64, len(partitions))
+		for _, p := range partitions {
+			o, err := m.client.GetOffset(t, p, sarama.OffsetNewest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get offset of topic %s partition %d with error: %+v", t, p, err)
+			}
+			hw[t][p] = o
+		}
+	}
+
+	return hw, nil
+}
+
+// groupLag returns the lag of the group on partitions with high watermarks and offsets committed by the group
+func (m *lagMonitor) groupLag(group string, hw map[string]map[int32]int64) (*GroupLag, map[string]int64, error) {
+	tps := make(map[string][]int32, len(hw))
+	for t, partitions := range hw {
+		for p := range partitions {
+			tps[t] = append(tps[t], p)
+		}
+	}
+	resp, err := m.admin.ListConsumerGroupOffsets(group, tps)
+	if err != nil {
+		return nil, nil, err
+	}
+	g := &GroupLag{Group: group}
+	committed := make(map[string]int64)
+	for t, blocks := range resp.Blocks {
+		for p, b := range blocks {
+			if b == nil || b.Err != sarama.ErrNoError || b.Offset < 0 {
+				// The group does not consume the partition
+				continue
+			}
+			pl := PartitionLag{Topic: t, Partition: p, Committed: b.Offset, HighWatermark: hw[t][p]}
+			if pl.HighWatermark > pl.Committed {
+				pl.Lag = pl.HighWatermark - pl.Committed
+			}
+			g.Lag += pl.Lag
+			g.Partitions = append(g.Partitions, pl)
+			committed[t+"/"+strconv.Itoa(int(p))] = b.Offset
+		}
+	}
+	sort.Slice(g.Partitions, func(i, j int) bool {
+		if g.Partitions[i].Topic != g.Partitions[j].Topic {
+			return g.Partitions[i].Topic < g.Partitions[j].Topic
+		}
+		return g.Partitions[i].Partition < g.Partitions[j].Partition
+	})
+
+	return g, committed, nil
+}
+
+// advanced returns true if any committed offset advanced since the previous poll
+func advanced(prev, cur map[string]int64) bool {
+	if prev == nil {
+		return true
+	}
+	for k, o := range cur {
+		if po, ok := prev[k]; !ok || o > po {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *lagMonitor) poll() {
+	hw, err := m.highWatermarks()
+	if err != nil {
+		glog.Errorf("failed to get offsets of gobmp topics for consumer groups lag with error: %+v", err)
+	}
+	for _, group := range m.config.Groups {
+		m.update(group, hw, err)
+	}
+}
+
+// update updates the lag of the group, when the lag cannot be retrieved the last known lag is kept with the error
+func (m *lagMonitor) update(group string, hw map[string]map[int32]int64, err error) {
+	var g *GroupLag
+	var committed map[string]int64
+	if err == nil {
+		if g, committed, err = m.groupLag(group, hw); err != nil {
+			glog.Errorf("failed to get lag of consumer group %s with error: %+v", group, err)
+		}
+	}
+	m.Lock()
+	prev := m.groups[group]
+	if err != nil {
+		g = &GroupLag{Group: group}
+		if prev != nil {
+			*g = *prev
+		}
+		g.Error = err.Error()
+		m.groups[group] = g
+		m.Unlock()
+		return
+	}
+	g.Updated = time.Now().UTC().Format(time.RFC3339)
+	g.Stalled = g.Lag > 0 && !advanced(m.committed[group], committed)
+	m.groups[group] = g
+	m.committed[group] = committed
+	m.Unlock()
+	m.alert(prev, g)
+}
+
+// alert logs changes of the group's state, exceeding the lag threshold and stalling or recovering
+func (m *lagMonitor) alert(prev, g *GroupLag) {
+	var wasStalled, wasOver bool
+	if prev != nil {
+		wasStalled, wasOver = prev.Stalled, m.config.Threshold != 0 && prev.Lag > m.config.Threshold
+	}
+	over := m.config.Threshold != 0 && g.Lag > m.config.Threshold
+	switch {
+	case g.Stalled && !wasStalled:
+		glog.Warningf("consumer group %s stalled, committed offsets did not advance for %s with lag of %d messages", g.Group, m.config.Interval, g.Lag)
+		events.Report(events.CodeKafkaStalled, "", "consumer group %s stalled, committed offsets did not advance for %s with lag of %d messages", g.Group, m.config.Interval, g.Lag)
+	case !g.Stalled && wasStalled:
+		glog.Infof("consumer group %s resumed consuming with lag of %d messages", g.Group, g.Lag)
+	}
+	switch {
+	case over && !wasOver:
+		glog.Warningf("lag of consumer group %s is %d messages, exceeding the threshold of %d messages", g.Group, g.Lag, m.config.Threshold)
+		events.Report(events.CodeKafkaLag, "", "lag of consumer group %s is %d messages, exceeding the threshold of %d messages", g.Group, g.Lag, m.config.Threshold)
+	case !over && wasOver:
+		glog.Infof("lag of consumer group %s is %d messages, below the threshold of %d messages", g.Group, g.Lag, m.config.Threshold)
+	}
+}
+
+func (m *lagMonitor) run() {
+	m.poll()
+	t := time.NewTicker(m.config.Interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			m.poll()
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+// NewLagMonitor returns the monitor of lag of consumer groups reading gobmp topics from Kafka server kafkaSrv
+func NewLagMonitor(kafkaSrv string, config *LagConfig) (LagMonitor, error) {
+	if len(config.Groups) == 0 {
+		return nil, fmt.Errorf("no consumer groups to monitor")
+	}
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("invalid consumer groups lag interval %s", config.Interval)
+	}
+	if config.Threshold < 0 {
+		return nil, fmt.Errorf("invalid consumer groups lag threshold %d", config.Threshold)
+	}
+	if err := validator(kafkaSrv); err != nil {
+		return nil, err
+	}
+	c := sarama.NewConfig()
+	c.ClientID = "gobmp-lag-monitor"
+	c.Version = sarama.V1_1_0_0
+	if err := config.Security.apply(c); err != nil {
+		return nil, fmt.Errorf("failed to setup Kafka security with error: %+v", err)
+	}
+	client, err := sarama.NewClient([]string{kafkaSrv}, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to kafka server %s with error: %+v", kafkaSrv, err)
+	}
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to create kafka cluster admin with error: %+v", err)
+	}
+	m := &lagMonitor{
+		client:    client,
+		admin:     admin,
+		config:    config,
+		groups:    make(map[string]*GroupLag),
+		committed: make(map[string]map[string]int64),
+		stop:      make(chan struct{}),
+	}
+	go m.run()
+
+	return m, nil
+}
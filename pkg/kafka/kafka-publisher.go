@@ -1,42 +1,64 @@
 package kafka
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"math"
 	"math/rand"
 	"net"
 	"os"
+	"runtime"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/golang/glog"
 	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/events"
 	"github.com/sbezverk/gobmp/pkg/pub"
 )
 
-// Define constants for each topic name
+// Define constants for each topic name, topics of published messages are the default topics of
+// message types registered in pkg/bmp
 const (
-	PeerTopic              = "gobmp.parsed.peer"
-	UnicastMessageTopic    = "gobmp.parsed.unicast_prefix"
-	UnicastMessageV4Topic  = "gobmp.parsed.unicast_prefix_v4"
-	UnicastMessageV6Topic  = "gobmp.parsed.unicast_prefix_v6"
-	LSNodeMessageTopic     = "gobmp.parsed.ls_node"
-	LSLinkMessageTopic     = "gobmp.parsed.ls_link"
-	L3vpnMessageTopic      = "gobmp.parsed.l3vpn"
-	L3vpnMessageV4Topic    = "gobmp.parsed.l3vpn_v4"
-	L3vpnMessageV6Topic    = "gobmp.parsed.l3vpn_v6"
-	LSPrefixMessageTopic   = "gobmp.parsed.ls_prefix"
-	LSSRv6SIDMessageTopic  = "gobmp.parsed.ls_srv6_sid"
-	EVPNMessageTopic       = "gobmp.parsed.evpn"
-	SRPolicyMessageTopic   = "gobmp.parsed.sr_policy"
-	SRPolicyMessageV4Topic = "gobmp.parsed.sr_policy_v4"
-	SRPolicyMessageV6Topic = "gobmp.parsed.sr_policy_v6"
-	FlowspecMessageTopic   = "gobmp.parsed.flowspec"
-	FlowspecMessageV4Topic = "gobmp.parsed.flowspec_v4"
-	FlowspecMessageV6Topic = "gobmp.parsed.flowspec_v6"
-	StatsMessageTopic      = "gobmp.parsed.statistics"
+	PeerTopic               = "gobmp.parsed.peer"
+	UnicastMessageTopic     = "gobmp.parsed.unicast_prefix"
+	UnicastMessageV4Topic   = "gobmp.parsed.unicast_prefix_v4"
+	UnicastMessageV6Topic   = "gobmp.parsed.unicast_prefix_v6"
+	LSNodeMessageTopic      = "gobmp.parsed.ls_node"
+	LSLinkMessageTopic      = "gobmp.parsed.ls_link"
+	L3vpnMessageTopic       = "gobmp.parsed.l3vpn"
+	L3vpnMessageV4Topic     = "gobmp.parsed.l3vpn_v4"
+	L3vpnMessageV6Topic     = "gobmp.parsed.l3vpn_v6"
+	LSPrefixMessageTopic    = "gobmp.parsed.ls_prefix"
+	LSSRv6SIDMessageTopic   = "gobmp.parsed.ls_srv6_sid"
+	EVPNMessageTopic        = "gobmp.parsed.evpn"
+	SRPolicyMessageTopic    = "gobmp.parsed.sr_policy"
+	SRPolicyMessageV4Topic  = "gobmp.parsed.sr_policy_v4"
+	SRPolicyMessageV6Topic  = "gobmp.parsed.sr_policy_v6"
+	FlowspecMessageTopic    = "gobmp.parsed.flowspec"
+	FlowspecMessageV4Topic  = "gobmp.parsed.flowspec_v4"
+	FlowspecMessageV6Topic  = "gobmp.parsed.flowspec_v6"
+	StatsMessageTopic       = "gobmp.parsed.statistics"
+	IGPAdjacencyTopic       = "gobmp.parsed.igp_adjacency"
+	ReportTopic             = "gobmp.parsed.report"
+	ASGraphTopic            = "gobmp.parsed.as_graph"
+	SRPolicyValidationTopic = "gobmp.parsed.sr_policy_validation"
+	OriginAnomalyTopic      = "gobmp.parsed.origin_anomaly"
+	PeerStormTopic          = "gobmp.parsed.peer_storm"
+	EPEPrefixTopic          = "gobmp.parsed.epe_prefix"
+	SessionSummaryTopic     = "gobmp.parsed.session_summary"
+	CollectorEventTopic     = "gobmp.parsed.collector_event"
+	VerbosityChangeTopic    = "gobmp.parsed.verbosity_change"
+	ChurnSummaryTopic       = "gobmp.parsed.churn_summary"
+	RouteRefreshTopic       = "gobmp.parsed.route_refresh"
+	MirroredMessageTopic    = "gobmp.parsed.mirrored_message"
+	RouteMirrorTopic        = "gobmp.parsed.route_mirror"
+	RawUpdateTopic          = "gobmp.parsed.raw_update"
+	MVPNMessageTopic        = "gobmp.parsed.mvpn"
 )
 
 var (
@@ -46,82 +68,134 @@ var (
 	topicRetention = "900000"
 )
 
-var (
-	// topics defines a list of topic to initialize and connect,
-	// initialization is done as a part of NewKafkaPublisher func.
-	topicNames = []string{
-		PeerTopic,
-		UnicastMessageTopic,
-		UnicastMessageV4Topic,
-		UnicastMessageV6Topic,
-		LSNodeMessageTopic,
-		LSLinkMessageTopic,
-		L3vpnMessageTopic,
-		L3vpnMessageV4Topic,
-		L3vpnMessageV6Topic,
-		LSPrefixMessageTopic,
-		LSSRv6SIDMessageTopic,
-		EVPNMessageTopic,
-		SRPolicyMessageTopic,
-		SRPolicyMessageV4Topic,
-		SRPolicyMessageV6Topic,
-		FlowspecMessageTopic,
-		FlowspecMessageV4Topic,
-		FlowspecMessageV6Topic,
-		StatsMessageTopic,
+// topicNames returns topics of registered message types prefixed by the prefix to initialize and connect,
+// initialization is done as a part of NewKafkaPublisher func.
+func topicNames(prefix string) []string {
+	mts := bmp.MessageTypes()
+	topics := make([]string, 0, len(mts))
+	for _, mt := range mts {
+		topics = append(topics, prefix+mt.Topic)
 	}
-)
+
+	return topics
+}
+
+// PublisherConfig defines sizing and security of Kafka producer
+type PublisherConfig struct {
+	// BufferSize is the number of messages buffered by the producer before publishing blocks, 0 selects
+	// 256 messages per GOMAXPROCS
+	BufferSize int
+	// MaxInFlight is the number of produce requests sent to a broker without waiting for responses, 0 selects 5
+	MaxInFlight int
+	// TopicPrefix is prepended to topics of published messages
+	TopicPrefix string
+	// TopicTemplate renders topics of json messages from placeholders {topic}, the default topic of the message
+	// type, {type}, the name of the message type, and fields {router_hash}, {router_ip}, {peer_hash}, {peer_ip}
+	// and {peer_asn} of messages, messages without a field of the template are published to the default topic.
+	// Topics of the template are created when messages are first published to them. Default topics are used
+	// when TopicTemplate is empty.
+	TopicTemplate string
+	// PartitionKeys maps names of message types to partition key strategies of their json messages, KeyHash,
+	// KeyRouter, KeyPeer, KeyPrefix or KeyRD. Names without address family suffix apply to both address families
+	// and AllMessageTypes applies to message types not listed. Messages are keyed by KeyHash when PartitionKeys is
+	// empty or the message lacks fields of the strategy.
+	PartitionKeys map[string]string
+	// Partitions is the number of partitions of topics created by the publisher, 0 selects 1 partition, existing
+	// topics keep their partitions
+	Partitions int32
+	// Security authenticates and encrypts connections to brokers, connections are neither authenticated nor
+	// encrypted when Security is nil
+	Security *SecurityConfig
+}
+
+// DefaultPublisherConfig returns sizing of Kafka producer derived from GOMAXPROCS
+func DefaultPublisherConfig() *PublisherConfig {
+	return &PublisherConfig{
+		BufferSize:  256 * runtime.GOMAXPROCS(0),
+		MaxInFlight: 5,
+	}
+}
 
 type publisher struct {
 	broker   *sarama.Broker
 	config   *sarama.Config
 	producer sarama.AsyncProducer
 	stopCh   chan struct{}
+	prefix   string
+	template *topicTemplate
+	keys     partitionKeys
+	// partitions is the number of partitions of created topics
+	partitions int32
+	// topics holds topics of the template known to exist
+	topics sync.Map
+	// deliveries holds the function of ReportDeliveries
+	deliveries atomic.Value
+	// health serializes checks of the connection to the broker
+	health sync.Mutex
 }
 
 func (p *publisher) PublishMessage(t int, key []byte, msg []byte) error {
-	switch t {
-	case bmp.PeerStateChangeMsg:
-		return p.produceMessage(PeerTopic, key, msg)
-	case bmp.UnicastPrefixMsg:
-		return p.produceMessage(UnicastMessageTopic, key, msg)
-	case bmp.UnicastPrefixV4Msg:
-		return p.produceMessage(UnicastMessageV4Topic, key, msg)
-	case bmp.UnicastPrefixV6Msg:
-		return p.produceMessage(UnicastMessageV6Topic, key, msg)
-	case bmp.LSNodeMsg:
-		return p.produceMessage(LSNodeMessageTopic, key, msg)
-	case bmp.LSLinkMsg:
-		return p.produceMessage(LSLinkMessageTopic, key, msg)
-	case bmp.L3VPNMsg:
-		return p.produceMessage(L3vpnMessageTopic, key, msg)
-	case bmp.L3VPNV4Msg:
-		return p.produceMessage(L3vpnMessageV4Topic, key, msg)
-	case bmp.L3VPNV6Msg:
-		return p.produceMessage(L3vpnMessageV6Topic, key, msg)
-	case bmp.LSPrefixMsg:
-		return p.produceMessage(LSPrefixMessageTopic, key, msg)
-	case bmp.LSSRv6SIDMsg:
-		return p.produceMessage(LSSRv6SIDMessageTopic, key, msg)
-	case bmp.EVPNMsg:
-		return p.produceMessage(EVPNMessageTopic, key, msg)
-	case bmp.SRPolicyMsg:
-		return p.produceMessage(SRPolicyMessageTopic, key, msg)
-	case bmp.SRPolicyV4Msg:
-		return p.produceMessage(SRPolicyMessageV4Topic, key, msg)
-	case bmp.SRPolicyV6Msg:
-		return p.produceMessage(SRPolicyMessageV6Topic, key, msg)
-	case bmp.FlowspecMsg:
-		return p.produceMessage(FlowspecMessageTopic, key, msg)
-	case bmp.FlowspecV4Msg:
-		return p.produceMessage(FlowspecMessageV4Topic, key, msg)
-	case bmp.FlowspecV6Msg:
-		return p.produceMessage(FlowspecMessageV6Topic, key, msg)
-	case bmp.StatsReportMsg:
-		return p.produceMessage(StatsMessageTopic, key, msg)
-	}
-
-	return fmt.Errorf("not implemented")
+	topic, key, err := p.target(t, key, msg)
+	if err != nil {
+		return err
+	}
+
+	return p.produceMessage(topic, key, msg)
+}
+
+// target returns the topic and the partition key of the message of the type, topics of the template are created
+// when they are first used
+func (p *publisher) target(t int, key []byte, msg []byte) (string, []byte, error) {
+	mt, ok := bmp.LookupMessageType(t)
+	if !ok {
+		return "", nil, fmt.Errorf("not implemented")
+	}
+	key = p.keys.key(mt, key, msg)
+	if p.template == nil {
+		return p.prefix + mt.Topic, key, nil
+	}
+	topic, ok := p.template.render(mt, msg)
+	if !ok {
+		return p.prefix + mt.Topic, key, nil
+	}
+	topic = p.prefix + topic
+	if _, ok := p.topics.Load(topic); ok {
+		return topic, key, nil
+	}
+	if err := ensureTopic(p.broker, topicCreateTimeout, topic, p.partitions); err != nil {
+		return "", nil, fmt.Errorf("failed to ensure topic %s with error: %+v", topic, err)
+	}
+	glog.V(5).Infof("topic %s of topic template has been created", topic)
+	p.topics.Store(topic, true)
+
+	return topic, key, nil
+}
+
+// PublishValue encodes the message into a pooled buffer passed to the producer as the message value,
+// the buffer is reused once the producer returns the message as sent or failed.
+func (p *publisher) PublishValue(t int, key []byte, v interface{}) error {
+	if _, ok := bmp.LookupMessageType(t); !ok {
+		return fmt.Errorf("not implemented")
+	}
+	buf, err := pub.EncodeValue(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode a message of type %d with error: %+v", t, err)
+	}
+	topic, key, err := p.target(t, key, buf.Bytes())
+	if err != nil {
+		pub.ReleaseBuffer(buf)
+		return err
+	}
+	p.producer.Input() <- &sarama.ProducerMessage{
+		Topic:    topic,
+		Key:      sarama.ByteEncoder(key),
+		Value:    sarama.ByteEncoder(buf.Bytes()),
+		Metadata: buf,
+		// The timestamp the producer sets otherwise is set here, so delivery latency is measured from it
+		Timestamp: time.Now(),
+	}
+
+	return nil
 }
 
 func (p *publisher) produceMessage(topic string, key []byte, msg []byte) error {
@@ -130,9 +204,49 @@ func (p *publisher) produceMessage(topic string, key []byte, msg []byte) error {
 	k = key
 	m = msg
 	p.producer.Input() <- &sarama.ProducerMessage{
-		Topic: topic,
-		Key:   k,
-		Value: m,
+		Topic:     topic,
+		Key:       k,
+		Value:     m,
+		Timestamp: time.Now(),
+	}
+
+	return nil
+}
+
+// releaseMessage returns the buffer of the message published by PublishValue for reuse
+func releaseMessage(msg *sarama.ProducerMessage) {
+	if buf, ok := msg.Metadata.(*bytes.Buffer); ok {
+		pub.ReleaseBuffer(buf)
+	}
+}
+
+// ReportDeliveries sets the function called with the latency and the error of delivery of every message, latency
+// is measured from the time the message is passed to the producer until the broker acknowledges it
+func (p *publisher) ReportDeliveries(f func(latency time.Duration, err error)) {
+	p.deliveries.Store(f)
+}
+
+// reportDelivery calls the function of ReportDeliveries, if set, for the message
+func (p *publisher) reportDelivery(msg *sarama.ProducerMessage, err error) {
+	if f, ok := p.deliveries.Load().(func(time.Duration, error)); ok && f != nil {
+		f(time.Since(msg.Timestamp), err)
+	}
+}
+
+// Healthy requests metadata of the peer topic from the broker, the connection to the broker is reopened
+// when it is lost
+func (p *publisher) Healthy() error {
+	p.health.Lock()
+	defer p.health.Unlock()
+	if ok, _ := p.broker.Connected(); !ok {
+		if err := p.broker.Open(p.config); err != nil && err != sarama.ErrAlreadyConnected {
+			return fmt.Errorf("failed to connect to Kafka broker %s with error: %+v", p.broker.Addr(), err)
+		}
+	}
+	if _, err := p.broker.GetMetadata(&sarama.MetadataRequest{Topics: []string{p.prefix + PeerTopic}}); err != nil {
+		// The connection is closed, so it is reopened by the next check
+		p.broker.Close()
+		return fmt.Errorf("failed to get metadata from Kafka broker %s with error: %+v", p.broker.Addr(), err)
 	}
 
 	return nil
@@ -143,9 +257,24 @@ func (p *publisher) Stop() {
 	p.broker.Close()
 }
 
-// NewKafkaPublisher instantiates a new instance of a Kafka publisher
-func NewKafkaPublisher(kafkaSrv string) (pub.Publisher, error) {
+// NewKafkaPublisher instantiates a new instance of a Kafka publisher, nil pc and sizes which are not set
+// select DefaultPublisherConfig
+func NewKafkaPublisher(kafkaSrv string, pc *PublisherConfig) (pub.Publisher, error) {
 	glog.Infof("Initializing Kafka producer client")
+	d := DefaultPublisherConfig()
+	if pc == nil {
+		pc = d
+	}
+	if pc.BufferSize < 0 || pc.MaxInFlight < 0 {
+		return nil, fmt.Errorf("invalid Kafka producer buffer size %d or max in flight requests %d", pc.BufferSize, pc.MaxInFlight)
+	}
+	if pc.Partitions < 0 {
+		return nil, fmt.Errorf("invalid number %d of Kafka topic partitions", pc.Partitions)
+	}
+	partitions := pc.Partitions
+	if partitions == 0 {
+		partitions = 1
+	}
 	if err := validator(kafkaSrv); err != nil {
 		glog.Errorf("Failed to validate Kafka server address %s with error: %+v", kafkaSrv, err)
 		return nil, err
@@ -159,6 +288,28 @@ func NewKafkaPublisher(kafkaSrv string) (pub.Publisher, error) {
 	config.Producer.Return.Errors = true
 	config.Admin.Retry.Max = 100
 	config.Version = sarama.V1_1_0_0
+	config.ChannelBufferSize = d.BufferSize
+	if pc.BufferSize != 0 {
+		config.ChannelBufferSize = pc.BufferSize
+	}
+	config.Net.MaxOpenRequests = d.MaxInFlight
+	if pc.MaxInFlight != 0 {
+		config.Net.MaxOpenRequests = pc.MaxInFlight
+	}
+	if err := pc.Security.apply(config); err != nil {
+		return nil, fmt.Errorf("failed to setup Kafka security with error: %+v", err)
+	}
+	var template *topicTemplate
+	if pc.TopicTemplate != "" {
+		var err error
+		if template, err = parseTopicTemplate(pc.TopicTemplate); err != nil {
+			return nil, err
+		}
+	}
+	keys, err := newPartitionKeys(pc.PartitionKeys)
+	if err != nil {
+		return nil, err
+	}
 
 	br := sarama.NewBroker(kafkaSrv)
 
@@ -168,8 +319,8 @@ func NewKafkaPublisher(kafkaSrv string) (pub.Publisher, error) {
 	}
 	glog.V(5).Infof("Connected to broker: %s id: %d\n", br.Addr(), br.ID())
 
-	for _, t := range topicNames {
-		if err := ensureTopic(br, topicCreateTimeout, t); err != nil {
+	for _, t := range topicNames(pc.TopicPrefix) {
+		if err := ensureTopic(br, topicCreateTimeout, t, partitions); err != nil {
 			glog.Errorf("New Kafka publisher failed to ensure requested topics with error: %+v", err)
 			return nil, err
 		}
@@ -181,12 +332,29 @@ func NewKafkaPublisher(kafkaSrv string) (pub.Publisher, error) {
 	}
 	glog.V(5).Infof("Initialized Kafka Async producer")
 	stopCh := make(chan struct{})
+	p := &publisher{
+		stopCh:     stopCh,
+		broker:     br,
+		config:     config,
+		producer:   producer,
+		prefix:     pc.TopicPrefix,
+		template:   template,
+		keys:       keys,
+		partitions: partitions,
+	}
 	go func(producer sarama.AsyncProducer, stopCh <-chan struct{}) {
 		for {
 			select {
-			case <-producer.Successes():
+			case msg := <-producer.Successes():
+				p.reportDelivery(msg, nil)
+				releaseMessage(msg)
 			case err := <-producer.Errors():
 				glog.Errorf("failed to produce message with error: %+v", *err)
+				if err.Msg.Topic != pc.TopicPrefix+CollectorEventTopic {
+					events.Report(events.CodePublishFailed, "", "failed to produce message to topic %s with error: %+v", err.Msg.Topic, err.Err)
+				}
+				p.reportDelivery(err.Msg, err.Err)
+				releaseMessage(err.Msg)
 			case <-stopCh:
 				producer.Close()
 				return
@@ -194,12 +362,7 @@ func NewKafkaPublisher(kafkaSrv string) (pub.Publisher, error) {
 		}
 	}(producer, stopCh)
 
-	return &publisher{
-		stopCh:   stopCh,
-		broker:   br,
-		config:   config,
-		producer: producer,
-	}, nil
+	return p, nil
 }
 
 func validator(addr string) error {
@@ -224,11 +387,11 @@ func validator(addr string) error {
 	return nil
 }
 
-func ensureTopic(br *sarama.Broker, timeout time.Duration, topicName string) error {
+func ensureTopic(br *sarama.Broker, timeout time.Duration, topicName string, partitions int32) error {
 	topic := &sarama.CreateTopicsRequest{
 		TopicDetails: map[string]*sarama.TopicDetail{
 			topicName: {
-				NumPartitions:     1,
+				NumPartitions:     partitions,
 				ReplicationFactor: 1,
 				ConfigEntries: map[string]*string{
 					"retention.ms": &topicRetention,
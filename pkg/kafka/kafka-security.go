@@ -0,0 +1,245 @@
+package kafka
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// SecurityConfig defines authentication and encryption of connections to Kafka brokers
+type SecurityConfig struct {
+	// SASLMechanism is "PLAIN", "SCRAM-SHA-256" or "SCRAM-SHA-512", SASL authentication is disabled when empty
+	SASLMechanism string
+	// SASLUser and SASLPassword are credentials of SASL authentication
+	SASLUser     string
+	SASLPassword string
+	// TLS encrypts connections to brokers, TLS is enabled as well when any of the files is set
+	TLS bool
+	// CAFile is the file of PEM encoded CA certificates verifying certificates of brokers, system roots verify
+	// brokers when CAFile is empty
+	CAFile string
+	// CertFile and KeyFile are PEM encoded client certificate and private key presented to brokers
+	CertFile string
+	KeyFile  string
+}
+
+// tlsEnabled returns true if connections to brokers are encrypted
+func (s *SecurityConfig) tlsEnabled() bool {
+	return s.TLS || s.CAFile != "" || s.CertFile != "" || s.KeyFile != ""
+}
+
+// Validate returns error if the SASL mechanism is unknown, SASL credentials are missing or only one of client
+// certificate and private key is set
+func (s *SecurityConfig) Validate() error {
+	switch s.SASLMechanism {
+	case "":
+		if s.SASLUser != "" || s.SASLPassword != "" {
+			return fmt.Errorf("SASL credentials are set without SASL mechanism")
+		}
+	case sarama.SASLTypePlaintext, sarama.SASLTypeSCRAMSHA256, sarama.SASLTypeSCRAMSHA512:
+		if s.SASLUser == "" || s.SASLPassword == "" {
+			return fmt.Errorf("SASL mechanism %s requires user and password", s.SASLMechanism)
+		}
+	default:
+		return fmt.Errorf("unsupported SASL mechanism %q, supported mechanisms are %s, %s and %s", s.SASLMechanism,
+			sarama.SASLTypePlaintext, sarama.SASLTypeSCRAMSHA256, sarama.SASLTypeSCRAMSHA512)
+	}
+	if (s.CertFile == "") != (s.KeyFile == "") {
+		return fmt.Errorf("client certificate and private key must be set together")
+	}
+
+	return nil
+}
+
+// apply sets SASL and TLS of the sarama configuration, nil s leaves connections unauthenticated and unencrypted
+func (s *SecurityConfig) apply(c *sarama.Config) error {
+	if s == nil {
+		return nil
+	}
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	if s.SASLMechanism != "" {
+		c.Net.SASL.Enable = true
+		c.Net.SASL.Mechanism = sarama.SASLMechanism(s.SASLMechanism)
+		c.Net.SASL.Version = sarama.SASLHandshakeV1
+		c.Net.SASL.User = s.SASLUser
+		c.Net.SASL.Password = s.SASLPassword
+		switch s.SASLMechanism {
+		case sarama.SASLTypeSCRAMSHA256:
+			c.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient { return &scramClient{hash: sha256.New} }
+		case sarama.SASLTypeSCRAMSHA512:
+			c.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient { return &scramClient{hash: sha512.New} }
+		}
+	}
+	if !s.tlsEnabled() {
+		return nil
+	}
+	tc := &tls.Config{MinVersion: tls.VersionTLS12}
+	if s.CAFile != "" {
+		b, err := os.ReadFile(s.CAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read Kafka CA file %s with error: %+v", s.CAFile, err)
+		}
+		tc.RootCAs = x509.NewCertPool()
+		if !tc.RootCAs.AppendCertsFromPEM(b) {
+			return fmt.Errorf("no certificates found in Kafka CA file %s", s.CAFile)
+		}
+	}
+	if s.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(s.CertFile, s.KeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load Kafka client certificate with error: %+v", err)
+		}
+		tc.Certificates = []tls.Certificate{cert}
+	}
+	c.Net.TLS.Enable = true
+	c.Net.TLS.Config = tc
+
+	return nil
+}
+
+// scramClient runs SCRAM exchange of RFC 5802 with the broker, user names and passwords are used as they are,
+// without SASLprep normalization
+type scramClient struct {
+	hash func() hash.Hash
+	// user, password and authzID are set by Begin
+	user     string
+	password string
+	authzID  string
+	// nonce is the client nonce of the exchange
+	nonce string
+	// step is the number of messages sent to the broker
+	step int
+	// clientFirst is the client first message without GS2 header
+	clientFirst string
+	// serverSignature is the signature expected in the server final message
+	serverSignature []byte
+	done            bool
+}
+
+var _ sarama.SCRAMClient = &scramClient{}
+
+func (c *scramClient) Begin(user, password, authzID string) error {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Errorf("failed to generate SCRAM nonce with error: %+v", err)
+	}
+	c.user, c.password, c.authzID = user, password, authzID
+	c.nonce = base64.RawStdEncoding.EncodeToString(b)
+	c.step = 0
+	c.done = false
+
+	return nil
+}
+
+// gs2Header returns GS2 header of the exchange without channel binding
+func (c *scramClient) gs2Header() string {
+	if c.authzID == "" {
+		return "n,,"
+	}
+
+	return "n,a=" + scramEscape(c.authzID) + ","
+}
+
+func (c *scramClient) Step(challenge string) (string, error) {
+	c.step++
+	switch c.step {
+	case 1:
+		c.clientFirst = "n=" + scramEscape(c.user) + ",r=" + c.nonce
+		return c.gs2Header() + c.clientFirst, nil
+	case 2:
+		return c.clientFinal(challenge)
+	case 3:
+		c.done = true
+		return "", c.verifyServerFinal(challenge)
+	}
+
+	return "", fmt.Errorf("unexpected SCRAM challenge after the exchange is over")
+}
+
+func (c *scramClient) Done() bool {
+	return c.done
+}
+
+// clientFinal returns the client final message with the proof of the password for the server first message
+func (c *scramClient) clientFinal(serverFirst string) (string, error) {
+	attrs := scramAttributes(serverFirst)
+	nonce, salt64, iter := attrs["r"], attrs["s"], attrs["i"]
+	if !strings.HasPrefix(nonce, c.nonce) || len(nonce) == len(c.nonce) {
+		return "", fmt.Errorf("invalid SCRAM server nonce")
+	}
+	salt, err := base64.StdEncoding.DecodeString(salt64)
+	if err != nil {
+		return "", fmt.Errorf("invalid SCRAM salt with error: %+v", err)
+	}
+	iterations, err := strconv.Atoi(iter)
+	if err != nil || iterations <= 0 {
+		return "", fmt.Errorf("invalid SCRAM iteration count %q", iter)
+	}
+	salted := pbkdf2.Key([]byte(c.password), salt, iterations, c.hash().Size(), c.hash)
+	clientKey := c.hmac(salted, "Client Key")
+	h := c.hash()
+	h.Write(clientKey)
+	storedKey := h.Sum(nil)
+	withoutProof := "c=" + base64.StdEncoding.EncodeToString([]byte(c.gs2Header())) + ",r=" + nonce
+	authMessage := c.clientFirst + "," + serverFirst + "," + withoutProof
+	proof := c.hmac(storedKey, authMessage)
+	for i := range proof {
+		proof[i] ^= clientKey[i]
+	}
+	c.serverSignature = c.hmac(c.hmac(salted, "Server Key"), authMessage)
+
+	return withoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof), nil
+}
+
+// verifyServerFinal returns error if the server final message reports an error or does not carry the expected
+// server signature
+func (c *scramClient) verifyServerFinal(serverFinal string) error {
+	attrs := scramAttributes(serverFinal)
+	if e, ok := attrs["e"]; ok {
+		return fmt.Errorf("SCRAM authentication failed with error: %s", e)
+	}
+	v, err := base64.StdEncoding.DecodeString(attrs["v"])
+	if err != nil || !hmac.Equal(v, c.serverSignature) {
+		return fmt.Errorf("invalid SCRAM server signature")
+	}
+
+	return nil
+}
+
+func (c *scramClient) hmac(key []byte, msg string) []byte {
+	m := hmac.New(c.hash, key)
+	m.Write([]byte(msg))
+
+	return m.Sum(nil)
+}
+
+// scramAttributes returns values of comma separated attributes of SCRAM message keyed by attribute names
+func scramAttributes(msg string) map[string]string {
+	attrs := make(map[string]string)
+	for _, a := range strings.Split(msg, ",") {
+		if len(a) > 2 && a[1] == '=' {
+			attrs[a[:1]] = a[2:]
+		}
+	}
+
+	return attrs
+}
+
+// scramEscape escapes ',' and '=' of SCRAM user names
+func scramEscape(s string) string {
+	return strings.NewReplacer("=", "=3D", ",", "=2C").Replace(s)
+}
@@ -0,0 +1,144 @@
+package kafka
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// Placeholders of topic templates, {topic} is the default topic of the message type, {type} is the name of the
+// message type, other placeholders are fields of messages
+const (
+	topicPlaceholder = "topic"
+	typePlaceholder  = "type"
+)
+
+// templateFields lists fields of messages available to topic templates
+var templateFields = map[string]bool{
+	"router_hash": true,
+	"router_ip":   true,
+	"peer_hash":   true,
+	"peer_ip":     true,
+	"peer_asn":    true,
+}
+
+// templatePart is either a literal part of the template or a placeholder
+type templatePart struct {
+	literal     string
+	placeholder string
+}
+
+// topicTemplate renders topics of messages from their type and fields, so messages are sharded by router or peer
+type topicTemplate struct {
+	parts []templatePart
+}
+
+// parseTopicTemplate returns the template of topics, placeholders are enclosed in braces, for example
+// "{type}.{router_hash}"
+func parseTopicTemplate(s string) (*topicTemplate, error) {
+	t := &topicTemplate{}
+	for rest := s; rest != ""; {
+		i := strings.IndexByte(rest, '{')
+		if i < 0 {
+			i = len(rest)
+		}
+		if lit := rest[:i]; lit != "" {
+			if strings.ContainsRune(lit, '}') || !validTopic(lit) {
+				return nil, fmt.Errorf("invalid topic template %q, topics consist of letters, digits, '.', '_' and '-'", s)
+			}
+			t.parts = append(t.parts, templatePart{literal: lit})
+		}
+		if rest = rest[i:]; rest == "" {
+			break
+		}
+		j := strings.IndexByte(rest, '}')
+		if j < 0 {
+			return nil, fmt.Errorf("unterminated placeholder in topic template %q", s)
+		}
+		p := rest[1:j]
+		if p != topicPlaceholder && p != typePlaceholder && !templateFields[p] {
+			return nil, fmt.Errorf("unknown placeholder {%s} in topic template %q", p, s)
+		}
+		t.parts = append(t.parts, templatePart{placeholder: p})
+		rest = rest[j+1:]
+	}
+	if len(t.parts) == 0 {
+		return nil, fmt.Errorf("empty topic template")
+	}
+
+	return t, nil
+}
+
+// render returns the topic of the json message of the message type, false is returned when a field of the
+// template is missing in the message
+func (t *topicTemplate) render(mt bmp.MessageType, msg []byte) (string, bool) {
+	var b strings.Builder
+	for _, p := range t.parts {
+		switch p.placeholder {
+		case "":
+			b.WriteString(p.literal)
+		case topicPlaceholder:
+			b.WriteString(mt.Topic)
+		case typePlaceholder:
+			b.WriteString(mt.Name)
+		default:
+			v, ok := messageField(msg, p.placeholder)
+			if !ok {
+				return "", false
+			}
+			b.WriteString(topicSafe(v))
+		}
+	}
+
+	return b.String(), true
+}
+
+// messageField returns the string or number value of the first occurrence of the field in the json message
+func messageField(msg []byte, field string) (string, bool) {
+	key := `"` + field + `":`
+	i := bytes.Index(msg, []byte(key))
+	if i < 0 {
+		return "", false
+	}
+	v := msg[i+len(key):]
+	if len(v) != 0 && v[0] == '"' {
+		j := bytes.IndexByte(v[1:], '"')
+		if j <= 0 {
+			return "", false
+		}
+		return string(v[1 : j+1]), true
+	}
+	j := bytes.IndexAny(v, ",}")
+	if j <= 0 {
+		return "", false
+	}
+
+	return string(v[:j]), true
+}
+
+// validTopic returns true if the string consists of characters allowed in Kafka topics
+func validTopic(s string) bool {
+	for _, r := range s {
+		if !topicRune(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func topicRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '_' || r == '-'
+}
+
+// topicSafe replaces characters not allowed in Kafka topics, such as ':' of IPv6 addresses, by '_'
+func topicSafe(s string) string {
+	return strings.Map(func(r rune) rune {
+		if topicRune(r) {
+			return r
+		}
+		return '_'
+	}, s)
+}
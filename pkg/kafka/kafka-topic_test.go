@@ -0,0 +1,162 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestParseTopicTemplate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		parts []templatePart
+		fail  bool
+	}{
+		{
+			name:  "type and router hash",
+			input: "{type}.{router_hash}",
+			parts: []templatePart{{placeholder: "type"}, {literal: "."}, {placeholder: "router_hash"}},
+		},
+		{
+			name:  "literal prefix and default topic",
+			input: "bmp-{topic}_{peer_asn}",
+			parts: []templatePart{{literal: "bmp-"}, {placeholder: "topic"}, {literal: "_"}, {placeholder: "peer_asn"}},
+		},
+		{
+			name:  "literal only",
+			input: "gobmp.all",
+			parts: []templatePart{{literal: "gobmp.all"}},
+		},
+		{
+			name:  "unknown placeholder",
+			input: "{type}.{prefix}",
+			fail:  true,
+		},
+		{
+			name:  "empty placeholder",
+			input: "{type}.{}",
+			fail:  true,
+		},
+		{
+			name:  "unterminated placeholder",
+			input: "{type}.{router_ip",
+			fail:  true,
+		},
+		{
+			name:  "unopened placeholder",
+			input: "{type}.router_ip}",
+			fail:  true,
+		},
+		{
+			name:  "invalid literal",
+			input: "gobmp/{type}",
+			fail:  true,
+		},
+		{
+			name:  "empty",
+			input: "",
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := parseTopicTemplate(tt.input)
+			if tt.fail {
+				if err == nil {
+					t.Fatalf("expected error parsing template %q but got %+v", tt.input, tmpl.parts)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to parse template %q with error: %+v", tt.input, err)
+			}
+			if len(tmpl.parts) != len(tt.parts) {
+				t.Fatalf("expected parts %+v but got %+v", tt.parts, tmpl.parts)
+			}
+			for i := range tt.parts {
+				if tmpl.parts[i] != tt.parts[i] {
+					t.Errorf("expected parts %+v but got %+v", tt.parts, tmpl.parts)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestRenderTopicTemplate(t *testing.T) {
+	unicast, _ := bmp.LookupMessageType(bmp.UnicastPrefixV4Msg)
+	peer, _ := bmp.LookupMessageType(bmp.PeerStateChangeMsg)
+	tests := []struct {
+		name     string
+		template string
+		mt       bmp.MessageType
+		msg      string
+		topic    string
+		missing  bool
+	}{
+		{
+			name:     "type and router hash",
+			template: "{type}.{router_hash}",
+			mt:       unicast,
+			msg:      `{"action":"add","router_hash":"a1b2","router_ip":"10.0.0.1","prefix":"10.1.0.0"}`,
+			topic:    "unicast_prefix_v4.a1b2",
+		},
+		{
+			name:     "default topic and peer asn number",
+			template: "{topic}.{peer_asn}",
+			mt:       peer,
+			msg:      `{"action":"add","peer_asn":65001}`,
+			topic:    "gobmp.parsed.peer.65001",
+		},
+		{
+			name:     "ipv6 peer ip made topic safe",
+			template: "{type}.{peer_ip}",
+			mt:       unicast,
+			msg:      `{"peer_ip":"2001:db8::1","router_ip":"10.0.0.1"}`,
+			topic:    "unicast_prefix_v4.2001_db8__1",
+		},
+		{
+			name:     "missing field",
+			template: "{type}.{router_hash}",
+			mt:       unicast,
+			msg:      `{"action":"add","router_ip":"10.0.0.1"}`,
+			missing:  true,
+		},
+		{
+			name:     "empty field",
+			template: "{type}.{router_hash}",
+			mt:       unicast,
+			msg:      `{"router_hash":"","router_ip":"10.0.0.1"}`,
+			missing:  true,
+		},
+		{
+			name:     "message without fields of the template",
+			template: "{topic}",
+			mt:       peer,
+			msg:      `{}`,
+			topic:    "gobmp.parsed.peer",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := parseTopicTemplate(tt.template)
+			if err != nil {
+				t.Fatalf("failed to parse template %q with error: %+v", tt.template, err)
+			}
+			topic, ok := tmpl.render(tt.mt, []byte(tt.msg))
+			if tt.missing {
+				if ok {
+					t.Fatalf("expected missing field but got topic %q", topic)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("failed to render topic of message %s", tt.msg)
+			}
+			if topic != tt.topic {
+				t.Errorf("expected topic %q but got %q", tt.topic, topic)
+			}
+		})
+	}
+}
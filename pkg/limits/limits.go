@@ -0,0 +1,164 @@
+package limits
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync/atomic"
+)
+
+// Names of limits, used as json keys of limits file and counters of exceeded limits
+const (
+	// Attributes limits the number of path attributes of BGP Update
+	Attributes = "max_attributes"
+	// ASPathLength limits the number of AS numbers of AS_PATH attribute
+	ASPathLength = "max_as_path_length"
+	// Communities limits the number of communities of COMMUNITIES attribute
+	Communities = "max_communities"
+	// ExtCommunities limits the number of extended communities of EXTENDED COMMUNITIES attribute
+	ExtCommunities = "max_extended_communities"
+	// LargeCommunities limits the number of large communities of LARGE_COMMUNITY attribute
+	LargeCommunities = "max_large_communities"
+	// SegmentLists limits the number of Segment Lists of SR Policy candidate path
+	SegmentLists = "max_segment_lists"
+	// SegmentListLength limits the number of segments of SR Policy Segment List
+	SegmentListLength = "max_segment_list_length"
+)
+
+// Limits defines limits of values decoded from BGP messages, they protect the collector from messages built to
+// exhaust its memory or CPU. A message exceeding a limit fails to parse and the limit's counter is incremented.
+type Limits struct {
+	MaxAttributes        int `json:"max_attributes,omitempty"`
+	MaxASPathLength      int `json:"max_as_path_length,omitempty"`
+	MaxCommunities       int `json:"max_communities,omitempty"`
+	MaxExtCommunities    int `json:"max_extended_communities,omitempty"`
+	MaxLargeCommunities  int `json:"max_large_communities,omitempty"`
+	MaxSegmentLists      int `json:"max_segment_lists,omitempty"`
+	MaxSegmentListLength int `json:"max_segment_list_length,omitempty"`
+}
+
+// Default defines limits used when no limits are set, they are well above the values found in real networks
+var Default = Limits{
+	MaxAttributes:        128,
+	MaxASPathLength:      1024,
+	MaxCommunities:       4096,
+	MaxExtCommunities:    2048,
+	MaxLargeCommunities:  1024,
+	MaxSegmentLists:      64,
+	MaxSegmentListLength: 64,
+}
+
+var (
+	current atomic.Value
+	// exceeded maps names of limits to counters of values exceeding them, the map is not modified after init
+	exceeded = map[string]*uint64{}
+)
+
+func init() {
+	for _, name := range []string{
+		Attributes, ASPathLength, Communities, ExtCommunities, LargeCommunities, SegmentLists, SegmentListLength,
+	} {
+		exceeded[name] = new(uint64)
+	}
+	current.Store(Default)
+}
+
+func (l Limits) max(name string) int {
+	switch name {
+	case Attributes:
+		return l.MaxAttributes
+	case ASPathLength:
+		return l.MaxASPathLength
+	case Communities:
+		return l.MaxCommunities
+	case ExtCommunities:
+		return l.MaxExtCommunities
+	case LargeCommunities:
+		return l.MaxLargeCommunities
+	case SegmentLists:
+		return l.MaxSegmentLists
+	case SegmentListLength:
+		return l.MaxSegmentListLength
+	}
+
+	return 0
+}
+
+// withDefaults returns the limits with limits not set, or set to a negative value, replaced by the default
+func (l Limits) withDefaults() Limits {
+	set := func(v *int, d int) {
+		if *v <= 0 {
+			*v = d
+		}
+	}
+	set(&l.MaxAttributes, Default.MaxAttributes)
+	set(&l.MaxASPathLength, Default.MaxASPathLength)
+	set(&l.MaxCommunities, Default.MaxCommunities)
+	set(&l.MaxExtCommunities, Default.MaxExtCommunities)
+	set(&l.MaxLargeCommunities, Default.MaxLargeCommunities)
+	set(&l.MaxSegmentLists, Default.MaxSegmentLists)
+	set(&l.MaxSegmentListLength, Default.MaxSegmentListLength)
+
+	return l
+}
+
+// Get returns the limits in use
+func Get() Limits {
+	return current.Load().(Limits)
+}
+
+// Set replaces the limits in use, limits which are not set keep their defaults
+func Set(l Limits) {
+	current.Store(l.withDefaults())
+}
+
+// Load reads limits from json file, limits missing in the file keep their defaults
+func Load(file string) (Limits, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return Limits{}, err
+	}
+	l := Limits{}
+	if err := json.Unmarshal(b, &l); err != nil {
+		return Limits{}, fmt.Errorf("failed to unmarshal limits file %s with error: %+v", file, err)
+	}
+
+	return l.withDefaults(), nil
+}
+
+// Check returns error when the value exceeds the limit of the name and increments the limit's counter
+func Check(name string, value int) error {
+	max := Get().max(name)
+	if max == 0 || value <= max {
+		return nil
+	}
+	if c, ok := exceeded[name]; ok {
+		atomic.AddUint64(c, 1)
+	}
+
+	return fmt.Errorf("%d exceeds %s limit of %d", value, name, max)
+}
+
+// Exceeded returns the number of times values exceeded each limit
+func Exceeded() map[string]uint64 {
+	m := make(map[string]uint64, len(exceeded))
+	for name, c := range exceeded {
+		m[name] = atomic.LoadUint64(c)
+	}
+
+	return m
+}
+
+// Status defines limits in use and the number of times values exceeded them
+type Status struct {
+	Limits   Limits            `json:"limits"`
+	Exceeded map[string]uint64 `json:"exceeded"`
+}
+
+// GetStatus returns limits in use and counters of exceeded limits
+func GetStatus() *Status {
+	return &Status{
+		Limits:   Get(),
+		Exceeded: Exceeded(),
+	}
+}
@@ -0,0 +1,65 @@
+package limits
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	defer Set(Default)
+	Set(Limits{MaxCommunities: 2})
+	if l := Get(); l.MaxCommunities != 2 || l.MaxAttributes != Default.MaxAttributes {
+		t.Fatalf("expected communities limit 2 and default attributes limit but got %+v", l)
+	}
+	before := Exceeded()[Communities]
+	tests := []struct {
+		name  string
+		limit string
+		value int
+		fail  bool
+	}{
+		{name: "below limit", limit: Communities, value: 1},
+		{name: "at limit", limit: Communities, value: 2},
+		{name: "above limit", limit: Communities, value: 3, fail: true},
+		{name: "default limit", limit: Attributes, value: Default.MaxAttributes},
+		{name: "unknown limit", limit: "max_unknown", value: 1000000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(tt.limit, tt.value)
+			if tt.fail && err == nil {
+				t.Fatalf("expected value %d to exceed %s limit", tt.value, tt.limit)
+			}
+			if !tt.fail && err != nil {
+				t.Fatalf("expected value %d not to exceed %s limit but got error: %+v", tt.value, tt.limit, err)
+			}
+		})
+	}
+	if n := Exceeded()[Communities] - before; n != 1 {
+		t.Errorf("expected communities limit to be exceeded once but got %d", n)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "limits.json")
+	if err := os.WriteFile(file, []byte(`{"max_as_path_length": 4096, "max_segment_list_length": 128}`), 0644); err != nil {
+		t.Fatalf("failed to write limits file with error: %+v", err)
+	}
+	l, err := Load(file)
+	if err != nil {
+		t.Fatalf("failed to load limits with error: %+v", err)
+	}
+	expect := Default
+	expect.MaxASPathLength = 4096
+	expect.MaxSegmentListLength = 128
+	if l != expect {
+		t.Errorf("expected limits %+v but got %+v", expect, l)
+	}
+	if err := os.WriteFile(file, []byte(`{"max_as_path_length": "many"}`), 0644); err != nil {
+		t.Fatalf("failed to write limits file with error: %+v", err)
+	}
+	if _, err := Load(file); err == nil {
+		t.Errorf("expected invalid limits file to fail")
+	}
+}
@@ -0,0 +1,127 @@
+package memory
+
+import (
+	"runtime"
+	"sort"
+	"time"
+)
+
+// MapEntryOverhead is the approximate overhead of a map entry on top of the sizes of its key and value,
+// it accounts for buckets metadata and unused slots of Go maps.
+const MapEntryOverhead = 16
+
+// Usage defines estimated memory used by state stored by a subsystem of the collector. Estimates count sizes
+// of stored keys and values and are meant to compare subsystems and peers with each other, they do not account
+// for the Go runtime overhead. Peers breaks the usage down per peer of a router, or per router for state stored
+// per BMP session. Evicted counts entries removed by the state retention since the start of the collector.
+type Usage struct {
+	Subsystem string      `json:"subsystem"`
+	Entries   int         `json:"entries"`
+	Bytes     uint64      `json:"bytes"`
+	Evicted   uint64      `json:"evicted,omitempty"`
+	Peers     []PeerUsage `json:"peers,omitempty"`
+}
+
+// PeerUsage defines estimated memory used by state of a peer of a router
+type PeerUsage struct {
+	RouterIP string `json:"router_ip"`
+	PeerIP   string `json:"peer_ip,omitempty"`
+	Entries  int    `json:"entries"`
+	Bytes    uint64 `json:"bytes"`
+}
+
+// Reporter is implemented by publishers storing state, MemoryUsage is safe to call concurrently with publishing
+type Reporter interface {
+	MemoryUsage() Usage
+}
+
+// Evicter is implemented by publishers storing state per peer of a router, EvictPeer removes state of the peer
+// of the route distinguisher instance of the router, or of all peers of the router when peerIP is empty, and returns
+// the number of removed entries
+type Evicter interface {
+	EvictPeer(routerIP, peerRD, peerIP string) int
+}
+
+// Expirer is implemented by publishers storing state not bound to peers, Expire removes entries last updated
+// before the time and returns the number of removed entries
+type Expirer interface {
+	Expire(before time.Time) int
+}
+
+// Stats defines memory used by the collector process as reported by the Go runtime and estimated memory used
+// by state of subsystems
+type Stats struct {
+	HeapAlloc  uint64  `json:"heap_alloc"`
+	HeapInuse  uint64  `json:"heap_inuse"`
+	Sys        uint64  `json:"sys"`
+	NumGC      uint32  `json:"num_gc"`
+	Subsystems []Usage `json:"subsystems"`
+}
+
+// NewStats returns memory stats of the process with usage of the subsystems sorted by subsystem name
+func NewStats(usage []Usage) *Stats {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	sort.Slice(usage, func(i, j int) bool { return usage[i].Subsystem < usage[j].Subsystem })
+
+	return &Stats{
+		HeapAlloc:  ms.HeapAlloc,
+		HeapInuse:  ms.HeapInuse,
+		Sys:        ms.Sys,
+		NumGC:      ms.NumGC,
+		Subsystems: usage,
+	}
+}
+
+// Counter accumulates estimated memory used by entries of a subsystem per peer
+type Counter struct {
+	usage Usage
+	peers map[[2]string]int
+}
+
+// NewCounter returns a counter of the subsystem
+func NewCounter(subsystem string) *Counter {
+	return &Counter{
+		usage: Usage{Subsystem: subsystem},
+		peers: make(map[[2]string]int),
+	}
+}
+
+// Add adds an entry of the peer of the router
+func (c *Counter) Add(routerIP, peerIP string, bytes uint64) {
+	c.add(routerIP, peerIP, 1, bytes)
+}
+
+// AddSession adds entries stored per BMP session of the router
+func (c *Counter) AddSession(routerIP string, entries int, bytes uint64) {
+	c.add(routerIP, "", entries, bytes)
+}
+
+func (c *Counter) add(routerIP, peerIP string, entries int, bytes uint64) {
+	c.usage.Entries += entries
+	c.usage.Bytes += bytes
+	k := [2]string{routerIP, peerIP}
+	i, ok := c.peers[k]
+	if !ok {
+		i = len(c.usage.Peers)
+		c.peers[k] = i
+		c.usage.Peers = append(c.usage.Peers, PeerUsage{RouterIP: routerIP, PeerIP: peerIP})
+	}
+	c.usage.Peers[i].Entries += entries
+	c.usage.Peers[i].Bytes += bytes
+}
+
+// AddShared adds entries which are not stored per peer, for example state shared by routes of multiple peers
+func (c *Counter) AddShared(entries int, bytes uint64) {
+	c.usage.Entries += entries
+	c.usage.Bytes += bytes
+}
+
+// Usage returns the accumulated usage with peers sorted by estimated memory, largest first, it is called once
+// all entries are added
+func (c *Counter) Usage() Usage {
+	u := c.usage
+	sort.SliceStable(u.Peers, func(i, j int) bool { return u.Peers[i].Bytes > u.Peers[j].Bytes })
+
+	return u
+}
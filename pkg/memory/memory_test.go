@@ -0,0 +1,28 @@
+package memory
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	c := NewCounter("test")
+	c.Add("10.0.0.1", "192.168.0.1", 10)
+	c.Add("10.0.0.2", "192.168.0.1", 50)
+	c.Add("10.0.0.1", "192.168.0.1", 20)
+	c.AddSession("10.0.0.1", 3, 40)
+	c.AddShared(2, 100)
+	want := Usage{
+		Subsystem: "test",
+		Entries:   8,
+		Bytes:     220,
+		Peers: []PeerUsage{
+			{RouterIP: "10.0.0.2", PeerIP: "192.168.0.1", Entries: 1, Bytes: 50},
+			{RouterIP: "10.0.0.1", Entries: 3, Bytes: 40},
+			{RouterIP: "10.0.0.1", PeerIP: "192.168.0.1", Entries: 2, Bytes: 30},
+		},
+	}
+	if got := c.Usage(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected usage %+v but got %+v", want, got)
+	}
+}
@@ -0,0 +1,85 @@
+package message
+
+import (
+	"sync"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// addPathSession stores NLRI types of which NLRI carry Path Identifier in updates received from and sent to the peer
+type addPathSession struct {
+	receive map[int]bool
+	send    map[int]bool
+}
+
+// addPathSessions stores ADD-PATH state negotiated by BGP sessions of peers of the BMP session by Peer Hash, the state
+// is learned from OPEN messages of Peer Up messages and removed by Peer Down messages.
+type addPathSessions struct {
+	sync.RWMutex
+	sessions map[string]*addPathSession
+}
+
+func newAddPathSessions() *addPathSessions {
+	return &addPathSessions{
+		sessions: make(map[string]*addPathSession),
+	}
+}
+
+// set stores ADD-PATH state of the session of the peer, nil state removes the session
+func (s *addPathSessions) set(peer string, ap *bgp.AddPath) {
+	s.Lock()
+	defer s.Unlock()
+	if ap == nil {
+		delete(s.sessions, peer)
+		return
+	}
+	s.sessions[peer] = &addPathSession{
+		receive: ap.Receive(),
+		send:    ap.Send(),
+	}
+}
+
+func (s *addPathSessions) get(peer string) *addPathSession {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.sessions[peer]
+}
+
+// addPath returns NLRI types of which NLRI of routes reported for the peer carry Path Identifier, routes of Adj-RIB-Out
+// are sent to the peer, routes of Adj-RIB-In and Loc-RIB are received from it. nil is returned for peers without
+// ADD-PATH state, for example when Peer Up message of the peer was not received.
+func (p *producer) addPath(ph *bmp.PerPeerHeader) map[int]bool {
+	if p.addPathSessions == nil || ph == nil {
+		return nil
+	}
+	s := p.addPathSessions.get(ph.GetPeerHash())
+	if s == nil {
+		return nil
+	}
+	if ph.IsAdjRIBOut() {
+		return s.send
+	}
+
+	return s.receive
+}
+
+// routeMonitorAddPath returns NLRI types of which NLRI of routes of the Route Monitoring message carry Path
+// Identifier, Stateless Parsing TLVs of BMP v4 message override ADD-PATH state of the peer for their address
+// families, so routes are parsed without Peer Up message of the peer.
+func (p *producer) routeMonitorAddPath(ph *bmp.PerPeerHeader, rm *bmp.RouteMonitor) map[int]bool {
+	state := p.addPath(ph)
+	if rm == nil || rm.AddPath == nil {
+		return state
+	}
+	m := make(map[int]bool, len(state)+len(rm.AddPath))
+	for t, ok := range state {
+		m[t] = ok
+	}
+	for t, ok := range rm.AddPath {
+		m[t] = ok
+	}
+
+	return m
+}
@@ -0,0 +1,114 @@
+package message
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestAddPathSessions(t *testing.T) {
+	pub := &testPublisher{msgs: make(map[int][]string)}
+	p := NewProducer(pub, false, nil, nil, false).(*producer)
+	peer := func(addr byte) *bmp.PerPeerHeader {
+		ph := &bmp.PerPeerHeader{
+			PeerDistinguisher: make([]byte, 8),
+			PeerAddress:       make([]byte, 16),
+			PeerAS:            65001,
+			PeerBGPID:         []byte{192, 168, 1, addr},
+			PeerTimestamp:     make([]byte, 8),
+		}
+		ph.PeerAddress[15] = addr
+		return ph
+	}
+	open := func(addPath ...byte) *bgp.OpenMessage {
+		o := &bgp.OpenMessage{MyAS: 65000, BGPID: []byte{10, 0, 0, 1}, Capabilities: make(bgp.Capability)}
+		if len(addPath) != 0 {
+			o.Capabilities[69] = []*bgp.CapabilityData{{Value: addPath}}
+		}
+		return o
+	}
+	addPathPeer, peer2 := peer(1), peer(2)
+	p.producePeerMessage(peerUP, bmp.Message{
+		PeerHeader: addPathPeer,
+		Payload: &bmp.PeerUpMessage{
+			LocalAddress: make([]byte, 16),
+			SentOpen:     open(0, 1, 1, 1),
+			ReceivedOpen: open(0, 1, 1, 2),
+		},
+	})
+	p.producePeerMessage(peerUP, bmp.Message{
+		PeerHeader: peer2,
+		Payload: &bmp.PeerUpMessage{
+			LocalAddress: make([]byte, 16),
+			SentOpen:     open(0, 1, 1, 1),
+			ReceivedOpen: open(),
+		},
+	})
+	peers := pub.msgs[bmp.PeerStateChangeMsg]
+	if len(peers) != 2 {
+		t.Fatalf("expected 2 peer messages but got %d", len(peers))
+	}
+	m := &PeerStateChange{}
+	if err := json.Unmarshal([]byte(peers[0]), m); err != nil {
+		t.Fatalf("failed to unmarshal peer message with error: %+v", err)
+	}
+	if m.AddPath["1/1"] != "receive" {
+		t.Errorf("expected add path receive for 1/1 but got %+v", m.AddPath)
+	}
+
+	tests := []struct {
+		name         string
+		ph           *bmp.PerPeerHeader
+		rm           *bmp.RouteMonitor
+		nlri         []byte
+		expectPathID int32
+	}{
+		{
+			name: "add path peer",
+			ph:   addPathPeer,
+			// Path Identifier 7, 10.1.1.0/24
+			nlri:         []byte{0, 0, 0, 7, 0x18, 0x0a, 0x01, 0x01},
+			expectPathID: 7,
+		},
+		{
+			name: "peer without add path",
+			ph:   peer2,
+			// 10.1.1.0/24
+			nlri: []byte{0x18, 0x0a, 0x01, 0x01},
+		},
+		{
+			name: "stateless parsing tlv of peer without add path",
+			ph:   peer2,
+			rm:   &bmp.RouteMonitor{AddPath: map[int]bool{bgp.NLRIMessageType(1, 1): true}},
+			// Path Identifier 7, 10.1.1.0/24
+			nlri:         []byte{0, 0, 0, 7, 0x18, 0x0a, 0x01, 0x01},
+			expectPathID: 7,
+		},
+		{
+			name: "stateless parsing tlv overriding add path state",
+			ph:   addPathPeer,
+			rm:   &bmp.RouteMonitor{AddPath: map[int]bool{bgp.NLRIMessageType(1, 1): false}},
+			// 10.1.1.0/24
+			nlri: []byte{0x18, 0x0a, 0x01, 0x01},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			update := &bgp.Update{NLRI: tt.nlri, BaseAttributes: &bgp.BaseAttributes{}}
+			prfxs, err := p.nlri(AddPrefix, tt.ph, update, p.routeMonitorAddPath(tt.ph, tt.rm))
+			if err != nil {
+				t.Fatalf("failed to produce unicast prefixes with error: %+v", err)
+			}
+			if len(prfxs) != 1 || prfxs[0].Prefix != "10.1.1.0" || prfxs[0].PrefixLen != 24 || prfxs[0].PathID != tt.expectPathID {
+				t.Errorf("expected prefix 10.1.1.0/24 with path id %d but got %+v", tt.expectPathID, prfxs)
+			}
+		})
+	}
+
+	p.producePeerMessage(peerDown, bmp.Message{PeerHeader: addPathPeer, Payload: &bmp.PeerDownMessage{Reason: bmp.PeerDownDeconfigured}})
+	if ap := p.addPath(addPathPeer); ap != nil {
+		t.Errorf("expected add path state of the peer to be removed but got %+v", ap)
+	}
+}
@@ -11,12 +11,12 @@ import (
 )
 
 // nlri process base nlri information found and bgp update message and returns
-// a slice of UnicatPrefix.
+// a slice of UnicatPrefix, addPath is NLRI types of which NLRI carry Path Identifier.
 // Used Only by Legacy IPv4 Unicast
-func (p *producer) nlri(op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]*UnicastPrefix, error) {
+func (p *producer) nlri(op int, ph *bmp.PerPeerHeader, update *bgp.Update, addPath map[int]bool) ([]*UnicastPrefix, error) {
 	var operation string
 	var routes []base.Route
-	pathID := p.addPathCapable[bgp.NLRIMessageType(1, 1)]
+	pathID := addPath[bgp.NLRIMessageType(1, 1)]
 	switch op {
 	case 0:
 		operation = "add"
@@ -41,29 +41,42 @@ func (p *producer) nlri(op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]*U
 		glog.Infof("><SB> Suspected EoR message for Unicast ipv4")
 		return []*UnicastPrefix{
 			{
-				Action:     operation,
-				RouterHash: p.speakerHash,
-				RouterIP:   p.speakerIP,
-				PeerHash:   ph.GetPeerHash(),
-				PeerASN:    ph.PeerAS,
-				Timestamp:  ph.GetPeerTimestamp(),
-				PeerType:   uint8(ph.PeerType),
-				IsEOR:      true,
+				Action:             operation,
+				RouterHash:         p.speakerID().hash,
+				RouterIP:           p.speakerID().ip,
+				PeerHash:           ph.GetPeerHash(),
+				PeerASN:            ph.PeerAS,
+				Timestamp:          p.timestamp(ph),
+				CollectorTimestamp: p.collectorTimestamp(ph),
+				PeerType:           uint8(ph.PeerType),
+				PeerRD:             ph.GetPeerDistinguisherString(),
+				IsEOR:              true,
+				IsAdjRIBOut:        ph.IsAdjRIBOut(),
+				IsPostPolicy:       ph.IsPostPolicy(),
+				RIBType:            ph.GetRIBType(),
+				TableName:          p.locRIBTable(ph),
 			},
 		}, nil
 	}
+	// Routes over SRv6 carry SRv6 L3 Service of BGP Attribute 40 (Prefix SID), RFC 9252 section 5
+	psid, err := update.GetAttrPrefixSID()
+	if err != nil || op != 0 || psid.SRv6L3Service == nil {
+		psid = nil
+	}
 	for _, pr := range routes {
 		prfx := &UnicastPrefix{
-			Action:         operation,
-			RouterHash:     p.speakerHash,
-			RouterIP:       p.speakerIP,
-			PeerHash:       ph.GetPeerHash(),
-			PeerASN:        ph.PeerAS,
-			Timestamp:      ph.GetPeerTimestamp(),
-			PeerType:       uint8(ph.PeerType),
-			PrefixLen:      int32(pr.Length),
-			PathID:         int32(pr.PathID),
-			BaseAttributes: update.BaseAttributes,
+			Action:             operation,
+			RouterHash:         p.speakerID().hash,
+			RouterIP:           p.speakerID().ip,
+			PeerHash:           ph.GetPeerHash(),
+			PeerASN:            ph.PeerAS,
+			Timestamp:          p.timestamp(ph),
+			CollectorTimestamp: p.collectorTimestamp(ph),
+			PeerType:           uint8(ph.PeerType),
+			PeerRD:             ph.GetPeerDistinguisherString(),
+			PrefixLen:          int32(pr.Length),
+			PathID:             int32(pr.PathID),
+			BaseAttributes:     update.BaseAttributes,
 		}
 		if ases := update.BaseAttributes.ASPath; len(ases) != 0 {
 			// Last element in AS_PATH would be the AS of the origin
@@ -82,9 +95,18 @@ func (p *producer) nlri(op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]*U
 		if f, err := ph.IsAdjRIBOutPost(); err == nil {
 			prfx.IsAdjRIBOutPost = f
 		}
+		prfx.IsAdjRIBOut = ph.IsAdjRIBOut()
+		prfx.IsPostPolicy = ph.IsPostPolicy()
 		if f, err := ph.IsLocRIBFiltered(); err == nil {
 			prfx.IsLocRIBFiltered = f
 		}
+		prfx.RIBType = ph.GetRIBType()
+		prfx.TableName = p.locRIBTable(ph)
+		prfx.IsLLGRStale = update.BaseAttributes.IsLLGRStale()
+		if psid != nil {
+			prfx.PrefixSID = psid
+			unicastSRv6SIDs(prfx, psid, nil)
+		}
 
 		prfxs = append(prfxs, prfx)
 	}
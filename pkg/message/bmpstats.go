@@ -26,12 +26,16 @@ func (p *producer) produceStatsMessage(msg bmp.Message) {
 	}
 
 	m := Stats{
-		RemoteASN:  msg.PeerHeader.PeerAS,
-		PeerRD:     msg.PeerHeader.GetPeerDistinguisherString(),
-		Timestamp:  msg.PeerHeader.GetPeerTimestamp(),
-		RouterHash: p.speakerHash,
-		RouterIP:   p.speakerIP,
-		PeerType:   uint8(msg.PeerHeader.PeerType),
+		RemoteASN:          msg.PeerHeader.PeerAS,
+		PeerRD:             msg.PeerHeader.GetPeerDistinguisherString(),
+		Timestamp:          p.timestamp(msg.PeerHeader),
+		CollectorTimestamp: p.collectorTimestamp(msg.PeerHeader),
+		RouterHash:         p.speakerID().hash,
+		RouterIP:           p.speakerID().ip,
+		PeerType:           uint8(msg.PeerHeader.PeerType),
+		IsAdjRIBOut:        msg.PeerHeader.IsAdjRIBOut(),
+		IsPostPolicy:       msg.PeerHeader.IsPostPolicy(),
+		RIBType:            msg.PeerHeader.GetRIBType(),
 	}
 	m.RemoteIP = msg.PeerHeader.GetPeerAddrString()
 	m.RemoteBGPID = msg.PeerHeader.GetPeerBGPIDString()
@@ -61,7 +65,7 @@ func (p *producer) produceStatsMessage(msg bmp.Message) {
 			glog.Warningf("unprocessed stats type:%v", tlv.InformationType)
 		}
 	}
-	if err := p.marshalAndPublish(&m, bmp.StatsReportMsg, []byte(m.RouterHash), false); err != nil {
+	if err := p.marshalAndPublish(msg.Context, &m, bmp.StatsReportMsg, []byte(m.RouterHash), false); err != nil {
 		glog.Errorf("failed to process peer Stats Report message with error: %+v", err)
 		return
 	}
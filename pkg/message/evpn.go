@@ -7,18 +7,17 @@ import (
 	"github.com/golang/glog"
 	"github.com/sbezverk/gobmp/pkg/bgp"
 	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/evpn"
+	"github.com/sbezverk/gobmp/pkg/prefixsid"
+	"github.com/sbezverk/gobmp/pkg/srv6"
 )
 
 // evpn process MP_REACH_NLRI AFI 25 SAFI 70 update message and returns
 // EVPN prefix object.
-func (p *producer) evpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]EVPNPrefix, error) {
+func (p *producer) evpn(route *evpn.Route, nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]EVPNPrefix, error) {
 	if glog.V(6) {
 		glog.Infof("All attributes in evpn update: %+v", update.GetAllAttributeID())
 	}
-	evpn, err := nlri.GetNLRIEVPN()
-	if err != nil {
-		return nil, err
-	}
 	prfxs := make([]EVPNPrefix, 0)
 	var operation string
 	switch op {
@@ -30,17 +29,32 @@ func (p *producer) evpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *
 		return nil, fmt.Errorf("unknown operation %d", op)
 	}
 
-	for _, e := range evpn.Route {
+	var routerMAC string
+	if exts, err := update.GetAttrExtCommunity(); err == nil {
+		for _, ext := range exts {
+			if mac := ext.GetRouterMAC(); mac != nil {
+				routerMAC = mac.String()
+				break
+			}
+		}
+	}
+	psid, _ := update.GetAttrPrefixSID()
+	pmsi, _ := update.GetAttrPMSITunnel()
+	for _, e := range route.Route {
 		prfx := EVPNPrefix{
-			Action:         operation,
-			PeerType:       uint8(ph.PeerType),
-			RouterHash:     p.speakerHash,
-			RouterIP:       p.speakerIP,
-			PeerHash:       ph.GetPeerHash(),
-			PeerASN:        ph.PeerAS,
-			Timestamp:      ph.GetPeerTimestamp(),
-			Nexthop:        nlri.GetNextHop(),
-			BaseAttributes: update.BaseAttributes,
+			Action:             operation,
+			PeerType:           uint8(ph.PeerType),
+			PeerRD:             ph.GetPeerDistinguisherString(),
+			RouterHash:         p.speakerID().hash,
+			RouterIP:           p.speakerID().ip,
+			PeerHash:           ph.GetPeerHash(),
+			PeerASN:            ph.PeerAS,
+			Timestamp:          p.timestamp(ph),
+			CollectorTimestamp: p.collectorTimestamp(ph),
+			Nexthop:            nlri.GetNextHop(),
+			BaseAttributes:     update.BaseAttributes,
+			RouterMAC:          routerMAC,
+			PMSITunnel:         pmsi,
 		}
 		if ases := update.BaseAttributes.ASPath; len(ases) != 0 {
 			// Last element in AS_PATH would be the AS of the origin
@@ -68,6 +82,17 @@ func (p *producer) evpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *
 				}
 			}
 			prfx.EthTag = e.GetEVPNTAG()
+			// ESI and Gateway IP address of withdrawn routes are ignored, the model is reported for announcements
+			if t, ok := e.RouteTypeSpec.(*evpn.IPPrefix); ok && op == 0 {
+				prfx.OverlayIndex = t.GetOverlayIndex(routerMAC != "")
+			}
+			if t, ok := e.RouteTypeSpec.(*evpn.Multicast); ok {
+				prfx.MulticastSource = t.GetSource()
+				prfx.MulticastGroup = t.GetGroup()
+				prfx.Originator = t.GetOriginator()
+				prfx.MulticastFlags = t.GetFlags()
+				prfx.MaxResponseTime = t.MaxResponseTime
+			}
 			if ip := e.GetEVPNIPLength(); ip != nil {
 				prfx.IPLength = *ip
 				gw := e.GetEVPNGWAddr()
@@ -106,18 +131,98 @@ func (p *producer) evpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *
 				prfx.Labels = append(prfx.Labels, l.Value)
 				prfx.RawLabels = append(prfx.RawLabels, l.GetRawValue())
 			}
+			if psid != nil {
+				sids, err := evpnSRv6SIDs(psid, prfx.RouteType, prfx.RawLabels)
+				if err != nil {
+					glog.Errorf("failed to derive SRv6 SIDs of evpn route type %d with error: %+v", prfx.RouteType, err)
+				} else if len(sids) != 0 {
+					prfx.SRv6SIDs = serviceSIDStrings(sids)
+					prfx.SRv6ServiceSIDs = sids
+				}
+			}
 			if f, err := ph.IsAdjRIBInPost(); err == nil {
 				prfx.IsAdjRIBInPost = f
 			}
 			if f, err := ph.IsAdjRIBOutPost(); err == nil {
 				prfx.IsAdjRIBOutPost = f
 			}
+			prfx.IsAdjRIBOut = ph.IsAdjRIBOut()
+			prfx.IsPostPolicy = ph.IsPostPolicy()
 			if f, err := ph.IsLocRIBFiltered(); err == nil {
 				prfx.IsLocRIBFiltered = f
 			}
+			prfx.RIBType = ph.GetRIBType()
 		}
 		prfxs = append(prfxs, prfx)
 	}
 
 	return prfxs, nil
 }
+
+// evpnSRv6SIDs returns SIDs of SRv6 services of EVPN route with bits transposed into its 24 bits labels restored,
+// MPLS Label 1 of routes of types 1 and 2 carries L2 service, MPLS Label 2 of type 2 and MPLS Label of type 5
+// carry L3 service
+// https://datatracker.ietf.org/doc/html/rfc9252#section-6
+func evpnSRv6SIDs(psid *prefixsid.PSid, routeType uint8, labels []uint32) ([]*srv6.ServiceSID, error) {
+	sids := make([]*srv6.ServiceSID, 0)
+	add := func(svc srv6.Service, label uint32) error {
+		s, err := svc.ServiceSIDs(&label)
+		if err != nil {
+			return err
+		}
+		sids = append(sids, s...)
+		return nil
+	}
+	switch routeType {
+	case 1, 2:
+		if psid.SRv6L2Service != nil && len(labels) > 0 {
+			if err := add(psid.SRv6L2Service, labels[0]); err != nil {
+				return nil, err
+			}
+		}
+		if psid.SRv6L3Service != nil && routeType == 2 && len(labels) > 1 {
+			if err := add(psid.SRv6L3Service, labels[1]); err != nil {
+				return nil, err
+			}
+		}
+	case 5:
+		if psid.SRv6L3Service != nil && len(labels) > 0 {
+			if err := add(psid.SRv6L3Service, labels[0]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sids, nil
+}
+
+// serviceSIDStrings returns SIDs of SRv6 service SIDs
+func serviceSIDStrings(sids []*srv6.ServiceSID) []string {
+	s := make([]string, 0, len(sids))
+	for _, sid := range sids {
+		s = append(s, sid.SID)
+	}
+
+	return s
+}
+
+// unicastSRv6SIDs sets SIDs of SRv6 L3 Service of prefix_sid of unicast prefix, bits transposed into the labels of
+// labeled unicast are restored, SIDs of routes without labels are published as advertised
+func unicastSRv6SIDs(prfx *UnicastPrefix, psid *prefixsid.PSid, labels []uint32) {
+	if psid.SRv6L3Service == nil {
+		return
+	}
+	var label *uint32
+	if len(labels) != 0 {
+		label = &labels[0]
+	}
+	sids, err := psid.SRv6L3Service.ServiceSIDs(label)
+	if err != nil {
+		glog.Errorf("failed to derive SRv6 SIDs of prefix %s/%d with error: %+v", prfx.Prefix, prfx.PrefixLen, err)
+		return
+	}
+	if len(sids) != 0 {
+		prfx.SRv6SIDs = serviceSIDStrings(sids)
+		prfx.SRv6ServiceSIDs = sids
+	}
+}
@@ -1,6 +1,7 @@
 package message
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 
@@ -10,8 +11,8 @@ import (
 	"github.com/sbezverk/gobmp/pkg/flowspec"
 )
 
-// unicast process nlri 14 afi 1/2 safi 1 messages and generates UnicastPrefix messages
-func (p *producer) flowspec(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]*Flowspec, error) {
+// flowspec process nlri 14 afi 1/2 safi 133/134 messages and generates Flowspec messages
+func (p *producer) flowspec(fsnlri *flowspec.NLRI, nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]*Flowspec, error) {
 	var operation string
 	switch op {
 	case 0:
@@ -22,18 +23,17 @@ func (p *producer) flowspec(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, upda
 		return nil, fmt.Errorf("unknown operation %d", op)
 	}
 
-	fsnlri, err := nlri.GetFlowspecNLRI()
-	if err != nil {
-		return nil, err
-	}
 	fs := &Flowspec{
-		Action:         operation,
-		RouterIP:       p.speakerIP,
-		PeerType:       uint8(ph.PeerType),
-		PeerASN:        ph.PeerAS,
-		Timestamp:      ph.GetPeerTimestamp(),
-		BaseAttributes: update.BaseAttributes,
-		SpecHash:       fsnlri.GetSpecHash(),
+		Action:             operation,
+		RouterIP:           p.speakerID().ip,
+		PeerType:           uint8(ph.PeerType),
+		PeerRD:             ph.GetPeerDistinguisherString(),
+		PeerASN:            ph.PeerAS,
+		Timestamp:          p.timestamp(ph),
+		CollectorTimestamp: p.collectorTimestamp(ph),
+		BaseAttributes:     update.BaseAttributes,
+		VPNRD:              fsnlri.RD,
+		SpecHash:           fsnlri.GetSpecHash(),
 	}
 
 	if ases := update.BaseAttributes.ASPath; len(ases) != 0 {
@@ -43,6 +43,7 @@ func (p *producer) flowspec(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, upda
 
 	fs.Nexthop = nlri.GetNextHop()
 	fs.Spec = fsnlri.Spec
+	fs.Rules = fsnlri.Rules()
 	fs.PeerIP = ph.GetPeerAddrString()
 	fs.IsIPv4 = !nlri.IsIPv6NLRI()
 	fs.IsNexthopIPv4 = !nlri.IsNextHopIPv6()
@@ -52,9 +53,12 @@ func (p *producer) flowspec(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, upda
 	if f, err := ph.IsAdjRIBOutPost(); err == nil {
 		fs.IsAdjRIBOutPost = f
 	}
+	fs.IsAdjRIBOut = ph.IsAdjRIBOut()
+	fs.IsPostPolicy = ph.IsPostPolicy()
 	if f, err := ph.IsLocRIBFiltered(); err == nil {
 		fs.IsLocRIBFiltered = f
 	}
+	fs.RIBType = ph.GetRIBType()
 
 	return []*Flowspec{fs}, nil
 }
@@ -73,26 +77,30 @@ func (fs *Flowspec) UnmarshalJSON(b []byte) error {
 	if err := json.Unmarshal(objmap["spec_hash"], &o.SpecHash); err != nil {
 		return err
 	}
-	if err := json.Unmarshal(objmap["base_attrs"], &o.BaseAttributes); err != nil {
-		return err
-	}
 	if err := json.Unmarshal(objmap["is_ipv4"], &o.IsIPv4); err != nil {
 		return err
 	}
 	if err := json.Unmarshal(objmap["is_nexthop_ipv4"], &o.IsNexthopIPv4); err != nil {
 		return err
 	}
-	if err := json.Unmarshal(objmap["nexthop"], &o.Nexthop); err != nil {
-		return err
-	}
-	if err := json.Unmarshal(objmap["peer_asn"], &o.PeerASN); err != nil {
-		return err
-	}
-	if err := json.Unmarshal(objmap["router_ip"], &o.RouterIP); err != nil {
-		return err
-	}
-	if err := json.Unmarshal(objmap["timestamp"], &o.Timestamp); err != nil {
-		return err
+	// Keys marshaled with omitempty are optional, nexthop is empty when Flowspec NLRI carry no next hop
+	optional := map[string]interface{}{
+		"base_attrs": &o.BaseAttributes,
+		"nexthop":    &o.Nexthop,
+		"peer_asn":   &o.PeerASN,
+		"router_ip":  &o.RouterIP,
+		"timestamp":  &o.Timestamp,
+		"vpn_rd":     &o.VPNRD,
+		"rules":      &o.Rules,
+	}
+	for k, v := range optional {
+		f, ok := objmap[k]
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(f, v); err != nil {
+			return err
+		}
 	}
 	if s, ok := objmap["spec"]; ok {
 		var specs []map[string]interface{}
@@ -101,7 +109,11 @@ func (fs *Flowspec) UnmarshalJSON(b []byte) error {
 		}
 		o.Spec = make([]flowspec.Spec, 0)
 		for _, spec := range specs {
-			switch flowspec.SpecType(spec["type"].(float64)) {
+			t, ok := spec["type"].(float64)
+			if !ok {
+				return fmt.Errorf("invalid type of flowspec spec %+v", spec["type"])
+			}
+			switch flowspec.SpecType(t) {
 			case flowspec.Type1:
 				fallthrough
 			case flowspec.Type2:
@@ -110,14 +122,15 @@ func (fs *Flowspec) UnmarshalJSON(b []byte) error {
 					return err
 				}
 				o.Spec = append(o.Spec, s)
-			case flowspec.Type3:
+			case flowspec.Type3, flowspec.Type4, flowspec.Type5, flowspec.Type6, flowspec.Type7, flowspec.Type8,
+				flowspec.Type9, flowspec.Type10, flowspec.Type11, flowspec.Type12, flowspec.Type13:
 				s, err := makeGenericSpec(spec)
 				if err != nil {
 					return err
 				}
 				o.Spec = append(o.Spec, s)
 			default:
-				glog.Errorf("Unknown type: %+v", spec["type"].(flowspec.SpecType))
+				glog.Errorf("Unknown type: %+v", t)
 			}
 		}
 	}
@@ -134,9 +147,15 @@ func makePrefixSpec(spec map[string]interface{}) (flowspec.Spec, error) {
 	if p, ok := spec["prefix_len"]; ok {
 		s.PrefixLength = uint8(p.(float64))
 	}
+	if p, ok := spec["offset"]; ok {
+		s.Offset = uint8(p.(float64))
+	}
 	if p, ok := spec["prefix"]; ok {
-		s.Prefix = make([]byte, len(p.(string)))
-		copy(s.Prefix, []byte(p.(string)))
+		b, err := base64.StdEncoding.DecodeString(p.(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode prefix of flowspec spec with error: %+v", err)
+		}
+		s.Prefix = b
 	}
 
 	return s, nil
@@ -148,7 +167,8 @@ func makeGenericSpec(spec map[string]interface{}) (flowspec.Spec, error) {
 	if p, ok := spec["type"]; ok {
 		s.SpecType = uint8(p.(float64))
 	}
-	if s.OpVal, err = makeOpValPair(spec["op_val_pairs"].([]interface{})); err != nil {
+	ovp, _ := spec["op_val_pairs"].([]interface{})
+	if s.OpVal, err = makeOpValPair(ovp); err != nil {
 		return nil, err
 	}
 
@@ -160,8 +180,11 @@ func makeOpValPair(src []interface{}) ([]*flowspec.OpVal, error) {
 	for i, s := range src {
 		o := &flowspec.OpVal{}
 		if p, ok := s.(map[string]interface{})["value"]; ok {
-			o.Val = make([]byte, len(p.(string)))
-			copy(o.Val, []byte(p.(string)))
+			b, err := base64.StdEncoding.DecodeString(p.(string))
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode value of flowspec spec with error: %+v", err)
+			}
+			o.Val = b
 		}
 		if p, ok := s.(map[string]interface{})["operator"]; ok {
 			op := &flowspec.Operator{}
@@ -183,6 +206,12 @@ func makeOpValPair(src []interface{}) ([]*flowspec.OpVal, error) {
 			if e, ok := p.(map[string]interface{})["equal"]; ok {
 				op.EQBit = e.(bool)
 			}
+			if e, ok := p.(map[string]interface{})["not"]; ok {
+				op.NotBit = e.(bool)
+			}
+			if e, ok := p.(map[string]interface{})["match"]; ok {
+				op.MatchBit = e.(bool)
+			}
 			o.Op = op
 		}
 		ovp[i] = o
@@ -0,0 +1,50 @@
+package message
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestFlowspecMessage(t *testing.T) {
+	// AFI 1 SAFI 134, two VPN Flowspec NLRI with Route Distinguisher 65000:100
+	mp, err := bgp.UnmarshalMPReachNLRI([]byte{
+		0x00, 0x01, 0x86, 0x00, 0x00,
+		0x10, 0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64, 0x01, 0x18, 0x0a, 0x00, 0x01, 0x03, 0x81, 0x06,
+		0x0f, 0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64, 0x05, 0x13, 0x04, 0x00, 0xd5, 0x08, 0x00,
+	}, false, map[int]bool{})
+	if err != nil {
+		t.Fatalf("failed to unmarshal MP_REACH_NLRI with error: %+v", err)
+	}
+	pub := &testPublisher{msgs: make(map[int][]string)}
+	p := &producer{publisher: pub}
+	p.speaker.Store(&speaker{hash: "hash"})
+	ph := &bmp.PerPeerHeader{PeerAddress: make([]byte, 16), PeerTimestamp: make([]byte, 8), PeerDistinguisher: make([]byte, 8)}
+	p.processMPUpdate(nil, mp, AddPrefix, ph, &bgp.Update{BaseAttributes: &bgp.BaseAttributes{}}, nil)
+	got := pub.msgs[bmp.FlowspecMsg]
+	if len(got) != 2 {
+		t.Fatalf("expected 2 flowspec messages but got %v", got)
+	}
+	expect := []map[string]string{
+		{"destination_prefix": "10.0.1.0/24", "protocol": "=6"},
+		{"destination_port": ">=1024&<=2048"},
+	}
+	for i, m := range got {
+		fs := &Flowspec{}
+		if err := json.Unmarshal([]byte(m), fs); err != nil {
+			t.Fatalf("failed to unmarshal flowspec message with error: %+v", err)
+		}
+		if fs.VPNRD != "65000:100" {
+			t.Errorf("expected vpn_rd 65000:100 but got %s", fs.VPNRD)
+		}
+		if !reflect.DeepEqual(fs.Rules, expect[i]) {
+			t.Errorf("expected rules %+v but got %+v", expect[i], fs.Rules)
+		}
+		if len(fs.Spec) != len(expect[i]) {
+			t.Errorf("expected %d specs but got %d", len(expect[i]), len(fs.Spec))
+		}
+	}
+}
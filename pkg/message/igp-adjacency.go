@@ -0,0 +1,145 @@
+package message
+
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+
+	"github.com/sbezverk/gobmp/pkg/memory"
+)
+
+const (
+	adjForward uint8 = 1 << iota
+	adjReverse
+)
+
+type adjacency struct {
+	// directions is a bit mask of LS Link directions currently advertised
+	directions uint8
+	up         bool
+	upSince    string
+}
+
+// adjacencyTracker correlates both directions of LS Link NLRI and keeps track of IGP adjacencies state.
+type adjacencyTracker struct {
+	sync.Mutex
+	adjs map[string]*adjacency
+}
+
+func newAdjacencyTracker() *adjacencyTracker {
+	return &adjacencyTracker{
+		adjs: make(map[string]*adjacency),
+	}
+}
+
+// linkKey builds a key identifying a single direction of the link, when reverse is true
+// the key of the opposite direction is built. Node keys have to be used instead of node hashes
+// as the hashes of the same node differ when they are computed from Local and Remote Node Descriptors.
+func linkKey(link *LSLink, localNode, remoteNode string, reverse bool) string {
+	mtid := uint16(0)
+	if link.MTID != nil {
+		mtid = link.MTID.MTID
+	}
+	localIP, remoteIP := link.LocalLinkIP, link.RemoteLinkIP
+	localID, remoteID := link.LocalLinkID, link.RemoteLinkID
+	if reverse {
+		localNode, remoteNode = remoteNode, localNode
+		localIP, remoteIP = remoteIP, localIP
+		localID, remoteID = remoteID, localID
+	}
+
+	return fmt.Sprintf("%d_%d_%d_%s_%s_%s_%d_%s_%d", link.DomainID, link.ProtocolID, mtid, localNode, remoteNode, localIP, localID, remoteIP, remoteID)
+}
+
+// size returns the number of tracked adjacencies and estimated memory used by them
+func (t *adjacencyTracker) size() (int, uint64) {
+	t.Lock()
+	defer t.Unlock()
+	var b uint64
+	for key, adj := range t.adjs {
+		b += uint64(unsafe.Sizeof(key)+unsafe.Sizeof(adj)+unsafe.Sizeof(*adj)) + uint64(len(key)+len(adj.upSince)) + memory.MapEntryOverhead
+	}
+
+	return len(t.adjs), b
+}
+
+// update processes LS Link message and returns IGPAdjacency message when the state of the adjacency
+// has changed, otherwise nil is returned. An adjacency is considered up when both directions of the link
+// are advertised and down when either direction gets withdrawn. localNode and remoteNode are the keys
+// of link's Local and Remote Node Descriptors.
+func (t *adjacencyTracker) update(link *LSLink, localNode, remoteNode string) *IGPAdjacency {
+	fwd := linkKey(link, localNode, remoteNode, false)
+	rev := linkKey(link, localNode, remoteNode, true)
+	key, dir := fwd, adjForward
+	switch {
+	case fwd == rev:
+		dir = adjForward | adjReverse
+	case rev < fwd:
+		key, dir = rev, adjReverse
+	}
+	t.Lock()
+	defer t.Unlock()
+	adj, ok := t.adjs[key]
+	switch link.Action {
+	case "add":
+		if !ok {
+			adj = &adjacency{}
+			t.adjs[key] = adj
+		}
+		adj.directions |= dir
+		if adj.up || adj.directions != adjForward|adjReverse {
+			return nil
+		}
+		adj.up = true
+		adj.upSince = link.Timestamp
+		msg := newIGPAdjacency("up", link)
+		msg.Reason = "both directions of the link are advertised"
+		return msg
+	case "del":
+		if !ok {
+			return nil
+		}
+		adj.directions &^= dir
+		if adj.directions == 0 {
+			delete(t.adjs, key)
+		}
+		if !adj.up {
+			return nil
+		}
+		adj.up = false
+		msg := newIGPAdjacency("down", link)
+		msg.UpSince = adj.upSince
+		msg.Reason = fmt.Sprintf("link from %s to %s is withdrawn", link.IGPRouterID, link.RemoteIGPRouterID)
+		return msg
+	}
+
+	return nil
+}
+
+func newIGPAdjacency(action string, link *LSLink) *IGPAdjacency {
+	return &IGPAdjacency{
+		Action:             action,
+		RouterHash:         link.RouterHash,
+		RouterIP:           link.RouterIP,
+		DomainID:           link.DomainID,
+		PeerHash:           link.PeerHash,
+		PeerIP:             link.PeerIP,
+		PeerASN:            link.PeerASN,
+		Timestamp:          link.Timestamp,
+		CollectorTimestamp: link.CollectorTimestamp,
+		Protocol:           link.Protocol,
+		ProtocolID:         link.ProtocolID,
+		AreaID:             link.AreaID,
+		MTID:               link.MTID,
+		LocalNodeHash:      link.LocalNodeHash,
+		RemoteNodeHash:     link.RemoteNodeHash,
+		LocalNodeName:      link.LocalNodeName,
+		RemoteNodeName:     link.RemoteNodeName,
+		IGPRouterID:        link.IGPRouterID,
+		RemoteIGPRouterID:  link.RemoteIGPRouterID,
+		LocalLinkIP:        link.LocalLinkIP,
+		RemoteLinkIP:       link.RemoteLinkIP,
+		LocalLinkID:        link.LocalLinkID,
+		RemoteLinkID:       link.RemoteLinkID,
+	}
+}
@@ -0,0 +1,92 @@
+package message
+
+import (
+	"testing"
+)
+
+func TestAdjacencyTrackerUpdate(t *testing.T) {
+	ab := &LSLink{
+		DomainID:          0,
+		LocalNodeHash:     "a_local",
+		RemoteNodeHash:    "b_remote",
+		LocalLinkIP:       "10.0.0.1",
+		RemoteLinkIP:      "10.0.0.2",
+		IGPRouterID:       "0000.0000.0001",
+		RemoteIGPRouterID: "0000.0000.0002",
+		Timestamp:         "t1",
+	}
+	ba := &LSLink{
+		DomainID:          0,
+		LocalNodeHash:     "b_local",
+		RemoteNodeHash:    "a_remote",
+		LocalLinkIP:       "10.0.0.2",
+		RemoteLinkIP:      "10.0.0.1",
+		IGPRouterID:       "0000.0000.0002",
+		RemoteIGPRouterID: "0000.0000.0001",
+		Timestamp:         "t2",
+	}
+	withAction := func(l *LSLink, action string, ts string) *LSLink {
+		n := *l
+		n.Action = action
+		n.Timestamp = ts
+		return &n
+	}
+	tests := []struct {
+		name   string
+		input  []*LSLink
+		expect []string
+	}{
+		{
+			name:   "single direction does not bring adjacency up",
+			input:  []*LSLink{withAction(ab, "add", "t1")},
+			expect: []string{""},
+		},
+		{
+			name:   "both directions bring adjacency up",
+			input:  []*LSLink{withAction(ab, "add", "t1"), withAction(ba, "add", "t2")},
+			expect: []string{"", "up"},
+		},
+		{
+			name:   "repeated advertisement does not generate event",
+			input:  []*LSLink{withAction(ab, "add", "t1"), withAction(ba, "add", "t2"), withAction(ab, "add", "t3")},
+			expect: []string{"", "up", ""},
+		},
+		{
+			name:   "withdraw of either direction brings adjacency down",
+			input:  []*LSLink{withAction(ab, "add", "t1"), withAction(ba, "add", "t2"), withAction(ba, "del", "t3"), withAction(ab, "del", "t4")},
+			expect: []string{"", "up", "down", ""},
+		},
+		{
+			name:   "withdraw of unknown link",
+			input:  []*LSLink{withAction(ab, "del", "t1")},
+			expect: []string{""},
+		},
+		{
+			name:   "adjacency comes back up",
+			input:  []*LSLink{withAction(ab, "add", "t1"), withAction(ba, "add", "t2"), withAction(ab, "del", "t3"), withAction(ab, "add", "t4")},
+			expect: []string{"", "up", "down", "up"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := newAdjacencyTracker()
+			for i, link := range tt.input {
+				local, remote := "a", "b"
+				if link.LocalNodeHash == "b_local" {
+					local, remote = "b", "a"
+				}
+				adj := tracker.update(link, local, remote)
+				action := ""
+				if adj != nil {
+					action = adj.Action
+				}
+				if action != tt.expect[i] {
+					t.Fatalf("update %d: expected action %q but got %q", i, tt.expect[i], action)
+				}
+				if action == "down" && adj.UpSince != "t2" {
+					t.Errorf("expected up_since t2 but got %q", adj.UpSince)
+				}
+			}
+		})
+	}
+}
@@ -4,18 +4,15 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/base"
 	"github.com/sbezverk/gobmp/pkg/bgp"
 	"github.com/sbezverk/gobmp/pkg/bmp"
 )
 
 // l3vpn process MP_REACH_NLRI AFI 1/2 SAFI 128 update message and returns
 // L3VPN prefix object.
-func (p *producer) l3vpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]L3VPNPrefix, error) {
-	nlril3vpn, err := nlri.GetNLRIL3VPN()
-	if err != nil {
-		return nil, err
-	}
-
+func (p *producer) l3vpn(nlril3vpn *base.MPNLRI, nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]L3VPNPrefix, error) {
 	var operation string
 	switch op {
 	case 0:
@@ -28,17 +25,19 @@ func (p *producer) l3vpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update
 	prfxs := make([]L3VPNPrefix, 0)
 	for _, e := range nlril3vpn.NLRI {
 		prfx := L3VPNPrefix{
-			Action:         operation,
-			RouterHash:     p.speakerHash,
-			RouterIP:       p.speakerIP,
-			PeerType:       uint8(ph.PeerType),
-			PeerHash:       ph.GetPeerHash(),
-			PeerASN:        ph.PeerAS,
-			Timestamp:      ph.GetPeerTimestamp(),
-			Nexthop:        nlri.GetNextHop(),
-			PrefixLen:      int32(e.Length),
-			PathID:         int32(e.PathID),
-			BaseAttributes: update.BaseAttributes,
+			Action:             operation,
+			RouterHash:         p.speakerID().hash,
+			RouterIP:           p.speakerID().ip,
+			PeerType:           uint8(ph.PeerType),
+			PeerRD:             ph.GetPeerDistinguisherString(),
+			PeerHash:           ph.GetPeerHash(),
+			PeerASN:            ph.PeerAS,
+			Timestamp:          p.timestamp(ph),
+			CollectorTimestamp: p.collectorTimestamp(ph),
+			Nexthop:            nlri.GetNextHop(),
+			PrefixLen:          int32(e.Length),
+			PathID:             int32(e.PathID),
+			BaseAttributes:     update.BaseAttributes,
 		}
 
 		if ases := update.BaseAttributes.ASPath; len(ases) != 0 {
@@ -66,17 +65,31 @@ func (p *producer) l3vpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update
 		if f, err := ph.IsAdjRIBOutPost(); err == nil {
 			prfx.IsAdjRIBOutPost = f
 		}
+		prfx.IsAdjRIBOut = ph.IsAdjRIBOut()
+		prfx.IsPostPolicy = ph.IsPostPolicy()
 		if f, err := ph.IsLocRIBFiltered(); err == nil {
 			prfx.IsLocRIBFiltered = f
 		}
+		prfx.RIBType = ph.GetRIBType()
 		prfx.Labels = make([]uint32, 0)
 		for _, l := range e.Label {
 			prfx.Labels = append(prfx.Labels, l.Value)
 		}
+		prfx.IsLLGRStale = update.BaseAttributes.IsLLGRStale()
 		prfx.VPNRD = e.RD.String()
 		prfx.VPNRDType = e.RD.Type
 		if psid, err := update.GetAttrPrefixSID(); err == nil {
 			prfx.PrefixSID = psid
+			if psid.SRv6L3Service != nil && len(e.Label) != 0 {
+				// Labels of NLRI carrying SRv6 services hold the 24 bits MPLS Label field
+				sids, err := psid.SRv6L3Service.ServiceSIDs(&e.Label[0].Value)
+				if err != nil {
+					glog.Errorf("failed to derive SRv6 SIDs of prefix %s/%d with error: %+v", prfx.Prefix, prfx.PrefixLen, err)
+				} else {
+					prfx.SRv6SIDs = serviceSIDStrings(sids)
+					prfx.SRv6ServiceSIDs = sids
+				}
+			}
 		}
 		prfxs = append(prfxs, prfx)
 	}
@@ -0,0 +1,87 @@
+package message
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestL3VPNSRv6SIDs(t *testing.T) {
+	// Prefix SID with SRv6 L3 Service, SID 2001:0:5:4:: with 16 bits of Function transposed at offset 64
+	psid := []byte{0x05, 0x00, 0x22, 0x00, 0x01, 0x00, 0x1e, 0x00, 0x20, 0x01, 0x00, 0x00, 0x00, 0x05, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x13, 0x00, 0x01, 0x00, 0x06, 0x28, 0x18, 0x10, 0x00, 0x10, 0x40}
+	// AFI 1 SAFI 128, 10.1.1.0/24 with RD 65000:100 and MPLS Label field 0x012340
+	mp, err := bgp.UnmarshalMPReachNLRI([]byte{
+		0x00, 0x01, 0x80, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00, 0x00, 0x01, 0x00,
+		0x70, 0x01, 0x23, 0x40, 0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64, 0x0a, 0x01, 0x01,
+	}, true, map[int]bool{})
+	if err != nil {
+		t.Fatalf("failed to unmarshal MP_REACH_NLRI with error: %+v", err)
+	}
+	update := &bgp.Update{
+		BaseAttributes: &bgp.BaseAttributes{},
+		PathAttributes: []bgp.PathAttribute{{AttributeType: 40, Attribute: psid}},
+	}
+	pub := &testPublisher{msgs: make(map[int][]string)}
+	p := &producer{publisher: pub}
+	p.speaker.Store(&speaker{hash: "hash"})
+	ph := &bmp.PerPeerHeader{PeerAddress: make([]byte, 16), PeerTimestamp: make([]byte, 8), PeerDistinguisher: make([]byte, 8)}
+	p.processMPUpdate(nil, mp, AddPrefix, ph, update, nil)
+	got := pub.msgs[bmp.L3VPNMsg]
+	if len(got) != 1 {
+		t.Fatalf("expected 1 l3vpn message but got %v", got)
+	}
+	prfx := &L3VPNPrefix{}
+	if err := json.Unmarshal([]byte(got[0]), prfx); err != nil {
+		t.Fatalf("failed to unmarshal l3vpn message with error: %+v", err)
+	}
+	if expect := []string{"2001:0:5:4:123::"}; !reflect.DeepEqual(prfx.SRv6SIDs, expect) {
+		t.Errorf("expected srv6_sids %v but got %v", expect, prfx.SRv6SIDs)
+	}
+	if len(prfx.SRv6ServiceSIDs) != 1 || prfx.SRv6ServiceSIDs[0].SID != "2001:0:5:4:123::" || prfx.SRv6ServiceSIDs[0].EndpointBehavior != 19 ||
+		prfx.SRv6ServiceSIDs[0].SIDStructureSubSubTLV == nil || prfx.SRv6ServiceSIDs[0].TranspositionOffset != 64 {
+		t.Errorf("expected srv6_service_sids of transposed sid with sid structure but got %+v", prfx.SRv6ServiceSIDs)
+	}
+}
+
+func TestUnicastSRv6SIDs(t *testing.T) {
+	// Prefix SID with SRv6 L3 Service, SID 2001:0:5:4:: with End.DT6 behavior and no transposition
+	psid := []byte{0x05, 0x00, 0x22, 0x00, 0x01, 0x00, 0x1e, 0x00, 0x20, 0x01, 0x00, 0x00, 0x00, 0x05, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x12, 0x00, 0x01, 0x00, 0x06, 0x28, 0x18, 0x10, 0x00, 0x00, 0x00}
+	// AFI 2 SAFI 1, 2001:db8:1::/48 with next hop 2001:db8::1
+	mp, err := bgp.UnmarshalMPReachNLRI([]byte{
+		0x00, 0x02, 0x01, 0x10, 0x20, 0x01, 0x0d, 0xb8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00,
+		0x30, 0x20, 0x01, 0x0d, 0xb8, 0x00, 0x01,
+	}, true, map[int]bool{})
+	if err != nil {
+		t.Fatalf("failed to unmarshal MP_REACH_NLRI with error: %+v", err)
+	}
+	update := &bgp.Update{
+		BaseAttributes: &bgp.BaseAttributes{},
+		PathAttributes: []bgp.PathAttribute{{AttributeType: 40, Attribute: psid}},
+	}
+	pub := &testPublisher{msgs: make(map[int][]string)}
+	p := &producer{publisher: pub}
+	p.speaker.Store(&speaker{hash: "hash"})
+	ph := &bmp.PerPeerHeader{PeerAddress: make([]byte, 16), PeerTimestamp: make([]byte, 8), PeerDistinguisher: make([]byte, 8)}
+	p.processMPUpdate(nil, mp, AddPrefix, ph, update, nil)
+	got := pub.msgs[bmp.UnicastPrefixMsg]
+	if len(got) != 1 {
+		t.Fatalf("expected 1 unicast_prefix message but got %v", pub.msgs)
+	}
+	prfx := &UnicastPrefix{}
+	if err := json.Unmarshal([]byte(got[0]), prfx); err != nil {
+		t.Fatalf("failed to unmarshal unicast message with error: %+v", err)
+	}
+	if expect := []string{"2001:0:5:4::"}; !reflect.DeepEqual(prfx.SRv6SIDs, expect) {
+		t.Errorf("expected srv6_sids %v but got %v", expect, prfx.SRv6SIDs)
+	}
+	if len(prfx.SRv6ServiceSIDs) != 1 || prfx.SRv6ServiceSIDs[0].EndpointBehavior != 18 || prfx.SRv6ServiceSIDs[0].SIDStructureSubSubTLV == nil ||
+		prfx.SRv6ServiceSIDs[0].LocalBlockLength != 40 {
+		t.Errorf("expected srv6_service_sids with sid structure but got %+v", prfx.SRv6ServiceSIDs)
+	}
+	if prfx.PrefixSID == nil || prfx.PrefixSID.SRv6L3Service == nil {
+		t.Errorf("expected prefix_sid with srv6_l3_service but got %+v", prfx.PrefixSID)
+	}
+}
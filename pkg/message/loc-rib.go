@@ -0,0 +1,48 @@
+package message
+
+import (
+	"sync"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// locRIBTables stores VRF/Table Names of Loc-RIB instance peers of the session by Peer Distinguisher of the instance,
+// RFC 9069, names are learned from Peer Up messages and used as table_name of routes of the instances.
+type locRIBTables struct {
+	sync.RWMutex
+	tables map[string]string
+}
+
+func newLocRIBTables() *locRIBTables {
+	return &locRIBTables{
+		tables: make(map[string]string),
+	}
+}
+
+// set stores the name of the table of Loc-RIB instance, empty name removes the instance
+func (t *locRIBTables) set(pd string, name string) {
+	t.Lock()
+	defer t.Unlock()
+	if name == "" {
+		delete(t.tables, pd)
+		return
+	}
+	t.tables[pd] = name
+}
+
+func (t *locRIBTables) get(pd string) string {
+	t.RLock()
+	defer t.RUnlock()
+
+	return t.tables[pd]
+}
+
+// locRIBTable returns VRF/Table Name of Loc-RIB instance peer, empty string is returned for other peer types
+// and for instances whose Peer Up message did not carry the name
+func (p *producer) locRIBTable(ph *bmp.PerPeerHeader) string {
+	if ph.PeerType != bmp.PeerType3 || p.locRIB == nil {
+		return ""
+	}
+
+	return p.locRIB.get(ph.GetPeerDistinguisherString())
+}
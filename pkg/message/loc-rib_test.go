@@ -0,0 +1,70 @@
+package message
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestLocRIBInstancePeer(t *testing.T) {
+	pub := &testPublisher{msgs: make(map[int][]string)}
+	p := NewProducer(pub, false, nil, nil, false).(*producer)
+	ph := &bmp.PerPeerHeader{
+		PeerType:          bmp.PeerType3,
+		PeerDistinguisher: []byte{0, 0, 0, 0, 0, 0, 0, 2},
+		PeerAddress:       make([]byte, 16),
+		PeerAS:            65000,
+		PeerBGPID:         []byte{10, 0, 0, 1},
+		PeerTimestamp:     make([]byte, 8),
+	}
+	open := &bgp.OpenMessage{MyAS: 65000, BGPID: []byte{10, 0, 0, 1}}
+	p.producePeerMessage(peerUP, bmp.Message{
+		PeerHeader: ph,
+		Payload: &bmp.PeerUpMessage{
+			LocalAddress: make([]byte, 16),
+			SentOpen:     open,
+			ReceivedOpen: open,
+			Information:  []bmp.InformationalTLV{{InformationType: bmp.PeerUpTableNameTLV, Information: []byte("red")}},
+		},
+	})
+	peers := pub.msgs[bmp.PeerStateChangeMsg]
+	if len(peers) != 1 {
+		t.Fatalf("expected 1 peer message but got %d", len(peers))
+	}
+	m := &PeerStateChange{}
+	if err := json.Unmarshal([]byte(peers[0]), m); err != nil {
+		t.Fatalf("failed to unmarshal peer message with error: %+v", err)
+	}
+	// The router without peers carrying the local address is identified by its BGP Identifier
+	if m.TableName != "red" || m.RIBType != bmp.RIBTypeLocRIB || m.PeerRD != "2" || m.RouterIP != "10.0.0.1" {
+		t.Errorf("unexpected peer message of Loc-RIB instance %s", peers[0])
+	}
+
+	// 10.1.1.0/24 with empty base attributes
+	update := &bgp.Update{NLRI: []byte{0x18, 0x0a, 0x01, 0x01}, BaseAttributes: &bgp.BaseAttributes{}}
+	prfxs, err := p.nlri(AddPrefix, ph, update, p.addPath(ph))
+	if err != nil {
+		t.Fatalf("failed to produce unicast prefixes with error: %+v", err)
+	}
+	if len(prfxs) != 1 || prfxs[0].TableName != "red" || prfxs[0].RouterIP != "10.0.0.1" {
+		t.Errorf("expected prefix of table red but got %+v", prfxs)
+	}
+
+	p.producePeerMessage(peerDown, bmp.Message{PeerHeader: ph, Payload: &bmp.PeerDownMessage{Reason: bmp.PeerDownDeconfigured}})
+	peers = pub.msgs[bmp.PeerStateChangeMsg]
+	if len(peers) != 2 {
+		t.Fatalf("expected 2 peer messages but got %d", len(peers))
+	}
+	m = &PeerStateChange{}
+	if err := json.Unmarshal([]byte(peers[1]), m); err != nil {
+		t.Fatalf("failed to unmarshal peer message with error: %+v", err)
+	}
+	if m.TableName != "red" || m.BMPReason != bmp.PeerDownDeconfigured {
+		t.Errorf("unexpected peer down message of Loc-RIB instance %s", peers[1])
+	}
+	if table := p.locRIBTable(ph); table != "" {
+		t.Errorf("expected table of de-configured instance to be removed but got %q", table)
+	}
+}
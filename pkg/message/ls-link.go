@@ -21,14 +21,16 @@ func (p *producer) lsLink(link *base.LinkNLRI, nextHop string, op int, ph *bmp.P
 		return nil, fmt.Errorf("unknown operation %d", op)
 	}
 	msg := LSLink{
-		Action:     operation,
-		RouterHash: p.speakerHash,
-		RouterIP:   p.speakerIP,
-		PeerType:   uint8(ph.PeerType),
-		PeerHash:   ph.GetPeerHash(),
-		PeerASN:    ph.PeerAS,
-		Timestamp:  ph.GetPeerTimestamp(),
-		DomainID:   link.GetIdentifier(),
+		Action:             operation,
+		RouterHash:         p.speakerID().hash,
+		RouterIP:           p.speakerID().ip,
+		PeerType:           uint8(ph.PeerType),
+		PeerRD:             ph.GetPeerDistinguisherString(),
+		PeerHash:           ph.GetPeerHash(),
+		PeerASN:            ph.PeerAS,
+		Timestamp:          p.timestamp(ph),
+		CollectorTimestamp: p.collectorTimestamp(ph),
+		DomainID:           link.GetIdentifier(),
 	}
 	if f, err := ph.IsAdjRIBInPost(); err == nil {
 		msg.IsAdjRIBInPost = f
@@ -36,9 +38,12 @@ func (p *producer) lsLink(link *base.LinkNLRI, nextHop string, op int, ph *bmp.P
 	if f, err := ph.IsAdjRIBOutPost(); err == nil {
 		msg.IsAdjRIBOutPost = f
 	}
+	msg.IsAdjRIBOut = ph.IsAdjRIBOut()
+	msg.IsPostPolicy = ph.IsPostPolicy()
 	if f, err := ph.IsLocRIBFiltered(); err == nil {
 		msg.IsLocRIBFiltered = f
 	}
+	msg.RIBType = ph.GetRIBType()
 	msg.Nexthop = nextHop
 	msg.PeerIP = ph.GetPeerAddrString()
 	msg.Protocol = link.GetLinkProtocolID()
@@ -57,6 +62,9 @@ func (p *producer) lsLink(link *base.LinkNLRI, nextHop string, op int, ph *bmp.P
 	}
 	msg.LocalNodeHash = link.LocalNodeHash
 	msg.RemoteNodeHash = link.RemoteNodeHash
+	localNodeKey := topologyNodeKey(link.ProtocolID, msg.DomainID, link.LocalNode)
+	msg.LocalNodeName = p.topology.nodeName(localNodeKey)
+	msg.RemoteNodeName = p.topology.nodeName(topologyNodeKey(link.ProtocolID, msg.DomainID, link.RemoteNode))
 	msg.LocalNodeASN = link.GetLocalASN()
 	msg.RemoteNodeASN = link.GetRemoteASN()
 	msg.RemoteIGPRouterID = link.GetRemoteIGPRouterID()
@@ -103,6 +111,8 @@ func (p *producer) lsLink(link *base.LinkNLRI, nextHop string, op int, ph *bmp.P
 			}
 		}
 		msg.IGPMetric = lslink.GetIGPMetric()
+		msg.MetricType = lslink.GetIGPMetricType()
+		p.topology.learnMetricStyle(localNodeKey, msg.MetricType)
 		msg.TEDefaultMetric = lslink.GetTEDefaultMetric()
 		msg.AdminGroup = lslink.GetAdminGroup()
 		msg.MaxLinkBW = lslink.GetMaxLinkBandwidth()
@@ -120,6 +130,9 @@ func (p *producer) lsLink(link *base.LinkNLRI, nextHop string, op int, ph *bmp.P
 		if sid, err := lslink.GetLSSRv6ENDXSID(); err == nil {
 			msg.SRv6ENDXSID = sid
 		}
+		if sid, err := lslink.GetLSSRv6LANENDXSID(); err == nil && len(sid) != 0 {
+			msg.SRv6LANENDXSID = sid
+		}
 		if aslas, err := lslink.GetAppSpecLinkAttr(); err == nil {
 			msg.AppSpecLinkAttr = aslas
 		}
@@ -19,14 +19,16 @@ func (p *producer) lsNode(node *base.NodeNLRI, nextHop string, op int, ph *bmp.P
 		return nil, fmt.Errorf("unknown operation %d", op)
 	}
 	msg := LSNode{
-		Action:     operation,
-		RouterHash: p.speakerHash,
-		RouterIP:   p.speakerIP,
-		PeerType:   uint8(ph.PeerType),
-		PeerHash:   ph.GetPeerHash(),
-		PeerASN:    ph.PeerAS,
-		Timestamp:  ph.GetPeerTimestamp(),
-		DomainID:   node.GetIdentifier(),
+		Action:             operation,
+		RouterHash:         p.speakerID().hash,
+		RouterIP:           p.speakerID().ip,
+		PeerType:           uint8(ph.PeerType),
+		PeerRD:             ph.GetPeerDistinguisherString(),
+		PeerHash:           ph.GetPeerHash(),
+		PeerASN:            ph.PeerAS,
+		Timestamp:          p.timestamp(ph),
+		CollectorTimestamp: p.collectorTimestamp(ph),
+		DomainID:           node.GetIdentifier(),
 	}
 	if f, err := ph.IsAdjRIBInPost(); err == nil {
 		msg.IsAdjRIBInPost = f
@@ -34,9 +36,12 @@ func (p *producer) lsNode(node *base.NodeNLRI, nextHop string, op int, ph *bmp.P
 	if f, err := ph.IsAdjRIBOutPost(); err == nil {
 		msg.IsAdjRIBOutPost = f
 	}
+	msg.IsAdjRIBOut = ph.IsAdjRIBOut()
+	msg.IsPostPolicy = ph.IsPostPolicy()
 	if f, err := ph.IsLocRIBFiltered(); err == nil {
 		msg.IsLocRIBFiltered = f
 	}
+	msg.RIBType = ph.GetRIBType()
 	msg.PeerIP = ph.GetPeerAddrString()
 	msg.Protocol = node.GetNodeProtocolID()
 	msg.ProtocolID = node.ProtocolID
@@ -83,6 +88,7 @@ func (p *producer) lsNode(node *base.NodeNLRI, nextHop string, op int, ph *bmp.P
 			msg.FlexAlgoDefinition = fad
 		}
 	}
+	p.topology.updateNode(topologyNodeKey(node.ProtocolID, msg.DomainID, node.LocalNode), &msg)
 
 	return &msg, nil
 }
@@ -20,14 +20,16 @@ func (p *producer) lsPrefix(prfx *base.PrefixNLRI, nextHop string, op int, ph *b
 		return nil, fmt.Errorf("unknown operation %d", op)
 	}
 	msg := LSPrefix{
-		Action:     operation,
-		RouterHash: p.speakerHash,
-		RouterIP:   p.speakerIP,
-		PeerType:   uint8(ph.PeerType),
-		PeerHash:   ph.GetPeerHash(),
-		PeerASN:    ph.PeerAS,
-		Timestamp:  ph.GetPeerTimestamp(),
-		DomainID:   prfx.GetIdentifier(),
+		Action:             operation,
+		RouterHash:         p.speakerID().hash,
+		RouterIP:           p.speakerID().ip,
+		PeerType:           uint8(ph.PeerType),
+		PeerRD:             ph.GetPeerDistinguisherString(),
+		PeerHash:           ph.GetPeerHash(),
+		PeerASN:            ph.PeerAS,
+		Timestamp:          p.timestamp(ph),
+		CollectorTimestamp: p.collectorTimestamp(ph),
+		DomainID:           prfx.GetIdentifier(),
 	}
 	if f, err := ph.IsAdjRIBInPost(); err == nil {
 		msg.IsAdjRIBInPost = f
@@ -35,15 +37,20 @@ func (p *producer) lsPrefix(prfx *base.PrefixNLRI, nextHop string, op int, ph *b
 	if f, err := ph.IsAdjRIBOutPost(); err == nil {
 		msg.IsAdjRIBOutPost = f
 	}
+	msg.IsAdjRIBOut = ph.IsAdjRIBOut()
+	msg.IsPostPolicy = ph.IsPostPolicy()
 	if f, err := ph.IsLocRIBFiltered(); err == nil {
 		msg.IsLocRIBFiltered = f
 	}
+	msg.RIBType = ph.GetRIBType()
 	msg.Nexthop = nextHop
 	msg.PeerIP = ph.GetPeerAddrString()
 	msg.ProtocolID = prfx.ProtocolID
 	msg.Protocol = prfx.GetPrefixProtocolID()
 	msg.LSID = prfx.GetPrefixLSID()
 	msg.LocalNodeHash = prfx.LocalNodeHash
+	localNodeKey := topologyNodeKey(prfx.ProtocolID, msg.DomainID, prfx.LocalNode)
+	msg.LocalNodeName = p.topology.nodeName(localNodeKey)
 	msg.IGPRouterID = prfx.GetLocalIGPRouterID()
 	msg.MTID = prfx.Prefix.GetPrefixMTID()
 	route := prfx.Prefix.GetPrefixIPReachability(ipv4)
@@ -93,6 +100,7 @@ func (p *producer) lsPrefix(prfx *base.PrefixNLRI, nextHop string, op int, ph *b
 		if loc, err := lsprefix.GetLSSRv6Locator(); err == nil {
 			msg.SRv6Locator = loc
 		}
+		msg.MetricType = p.topology.prefixMetricType(localNodeKey, &msg)
 	}
 
 	return &msg, nil
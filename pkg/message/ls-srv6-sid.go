@@ -19,14 +19,16 @@ func (p *producer) lsSRv6SID(nlri6 *srv6.SIDNLRI, nextHop string, op int, ph *bm
 		return nil, fmt.Errorf("unknown operation %d", op)
 	}
 	msg := LSSRv6SID{
-		Action:     operation,
-		RouterHash: p.speakerHash,
-		RouterIP:   p.speakerIP,
-		PeerType:   uint8(ph.PeerType),
-		PeerHash:   ph.GetPeerHash(),
-		PeerASN:    ph.PeerAS,
-		Timestamp:  ph.GetPeerTimestamp(),
-		DomainID:   nlri6.GetIdentifier(),
+		Action:             operation,
+		RouterHash:         p.speakerID().hash,
+		RouterIP:           p.speakerID().ip,
+		PeerType:           uint8(ph.PeerType),
+		PeerRD:             ph.GetPeerDistinguisherString(),
+		PeerHash:           ph.GetPeerHash(),
+		PeerASN:            ph.PeerAS,
+		Timestamp:          p.timestamp(ph),
+		CollectorTimestamp: p.collectorTimestamp(ph),
+		DomainID:           nlri6.GetIdentifier(),
 	}
 	if f, err := ph.IsAdjRIBInPost(); err == nil {
 		msg.IsAdjRIBInPost = f
@@ -34,14 +36,18 @@ func (p *producer) lsSRv6SID(nlri6 *srv6.SIDNLRI, nextHop string, op int, ph *bm
 	if f, err := ph.IsAdjRIBOutPost(); err == nil {
 		msg.IsAdjRIBOutPost = f
 	}
+	msg.IsAdjRIBOut = ph.IsAdjRIBOut()
+	msg.IsPostPolicy = ph.IsPostPolicy()
 	if f, err := ph.IsLocRIBFiltered(); err == nil {
 		msg.IsLocRIBFiltered = f
 	}
+	msg.RIBType = ph.GetRIBType()
 	msg.Nexthop = nextHop
 	msg.PeerIP = ph.GetPeerAddrString()
 	msg.ProtocolID = nlri6.ProtocolID
 	msg.Protocol = nlri6.GetSRv6SIDProtocolID()
 	msg.LocalNodeHash = nlri6.LocalNodeHash
+	msg.LocalNodeName = p.topology.nodeName(topologyNodeKey(nlri6.ProtocolID, msg.DomainID, nlri6.LocalNode))
 	msg.LSID = nlri6.GetSRv6SIDLSID()
 	msg.IGPRouterID = nlri6.GetSRv6SIDIGPRouterID()
 	msg.LocalNodeASN = nlri6.GetSRv6SIDASN()
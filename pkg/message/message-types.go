@@ -0,0 +1,66 @@
+package message
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// messageSchemas maps types of messages produced from BMP messages to the structures marshaled into them
+var messageSchemas = map[int]interface{}{
+	bmp.PeerStateChangeMsg: PeerStateChange{},
+	bmp.UnicastPrefixMsg:   UnicastPrefix{},
+	bmp.UnicastPrefixV4Msg: UnicastPrefix{},
+	bmp.UnicastPrefixV6Msg: UnicastPrefix{},
+	bmp.LSNodeMsg:          LSNode{},
+	bmp.LSLinkMsg:          LSLink{},
+	bmp.L3VPNMsg:           L3VPNPrefix{},
+	bmp.L3VPNV4Msg:         L3VPNPrefix{},
+	bmp.L3VPNV6Msg:         L3VPNPrefix{},
+	bmp.LSPrefixMsg:        LSPrefix{},
+	bmp.LSSRv6SIDMsg:       LSSRv6SID{},
+	bmp.EVPNMsg:            EVPNPrefix{},
+	bmp.SRPolicyMsg:        SRPolicy{},
+	bmp.SRPolicyV4Msg:      SRPolicy{},
+	bmp.SRPolicyV6Msg:      SRPolicy{},
+	bmp.FlowspecMsg:        Flowspec{},
+	bmp.FlowspecV4Msg:      Flowspec{},
+	bmp.FlowspecV6Msg:      Flowspec{},
+	bmp.StatsReportMsg:     Stats{},
+	bmp.IGPAdjacencyMsg:    IGPAdjacency{},
+	bmp.RouteRefreshMsg:    RouteRefresh{},
+	bmp.MirroredMessageMsg: MirroredMessage{},
+	bmp.RouteMirroringMsg:  RouteMirror{},
+	bmp.RawUpdateMsg:       RawUpdate{},
+	bmp.MVPNMsg:            MVPN{},
+}
+
+func init() {
+	for t, s := range messageSchemas {
+		if err := bmp.RegisterMessageSchema(t, s); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// checkSchema returns error if the message type is not registered or the message does not match
+// the schema of the type
+func checkSchema(msg interface{}, msgType int) error {
+	mt, ok := bmp.LookupMessageType(msgType)
+	if !ok {
+		return fmt.Errorf("message type %d is not registered", msgType)
+	}
+	if mt.Schema == nil {
+		return nil
+	}
+	t := reflect.TypeOf(msg)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t != mt.Schema {
+		return fmt.Errorf("message %s does not match schema %s of message type %s", t, mt.Schema, mt.Name)
+	}
+
+	return nil
+}
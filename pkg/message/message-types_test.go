@@ -0,0 +1,51 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestCheckSchema(t *testing.T) {
+	u := &UnicastPrefix{}
+	tests := []struct {
+		name    string
+		msg     interface{}
+		msgType int
+		fail    bool
+	}{
+		{
+			name:    "matching schema",
+			msg:     u,
+			msgType: bmp.UnicastPrefixV4Msg,
+		},
+		{
+			name:    "pointer to pointer",
+			msg:     &u,
+			msgType: bmp.UnicastPrefixMsg,
+		},
+		{
+			name:    "mismatching schema",
+			msg:     &LSNode{},
+			msgType: bmp.UnicastPrefixV6Msg,
+			fail:    true,
+		},
+		{
+			name:    "unknown type",
+			msg:     u,
+			msgType: 1000,
+			fail:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSchema(tt.msg, tt.msgType)
+			if err != nil && !tt.fail {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err == nil && tt.fail {
+				t.Fatalf("expected error but succeeded")
+			}
+		})
+	}
+}
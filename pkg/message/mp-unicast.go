@@ -10,8 +10,7 @@ import (
 )
 
 // unicast process nlri 14 afi 1/2 safi 1 messages and generates UnicastPrefix messages
-func (p *producer) unicast(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *bgp.Update, label bool) ([]*UnicastPrefix, error) {
-	var err error
+func (p *producer) unicast(u *base.MPNLRI, nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *bgp.Update, label bool) ([]*UnicastPrefix, error) {
 	var operation string
 	switch op {
 	case 0:
@@ -23,45 +22,41 @@ func (p *producer) unicast(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, updat
 	}
 
 	prfxs := make([]*UnicastPrefix, 0)
-	var u *base.MPNLRI
-	if label {
-		u, err = nlri.GetNLRILU()
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		u, err = nlri.GetNLRIUnicast()
-		if err != nil {
-			return nil, err
-		}
-	}
 	// Check if Update carries any routes, if update comes with 0 routes, it is EoR message
 	if len(u.NLRI) == 0 {
 		return []*UnicastPrefix{
 			{
-				Action:     operation,
-				RouterHash: p.speakerHash,
-				RouterIP:   p.speakerIP,
-				PeerHash:   ph.GetPeerHash(),
-				PeerASN:    ph.PeerAS,
-				Timestamp:  ph.GetPeerTimestamp(),
-				PeerType:   uint8(ph.PeerType),
-				IsEOR:      true,
+				Action:             operation,
+				RouterHash:         p.speakerID().hash,
+				RouterIP:           p.speakerID().ip,
+				PeerHash:           ph.GetPeerHash(),
+				PeerASN:            ph.PeerAS,
+				Timestamp:          p.timestamp(ph),
+				CollectorTimestamp: p.collectorTimestamp(ph),
+				PeerType:           uint8(ph.PeerType),
+				PeerRD:             ph.GetPeerDistinguisherString(),
+				IsEOR:              true,
+				IsAdjRIBOut:        ph.IsAdjRIBOut(),
+				IsPostPolicy:       ph.IsPostPolicy(),
+				RIBType:            ph.GetRIBType(),
+				TableName:          p.locRIBTable(ph),
 			},
 		}, nil
 	}
 	for _, e := range u.NLRI {
 		prfx := &UnicastPrefix{
-			Action:         operation,
-			RouterHash:     p.speakerHash,
-			RouterIP:       p.speakerIP,
-			PeerType:       uint8(ph.PeerType),
-			PeerHash:       ph.GetPeerHash(),
-			PeerASN:        ph.PeerAS,
-			Timestamp:      ph.GetPeerTimestamp(),
-			PrefixLen:      int32(e.Length),
-			PathID:         int32(e.PathID),
-			BaseAttributes: update.BaseAttributes,
+			Action:             operation,
+			RouterHash:         p.speakerID().hash,
+			RouterIP:           p.speakerID().ip,
+			PeerType:           uint8(ph.PeerType),
+			PeerRD:             ph.GetPeerDistinguisherString(),
+			PeerHash:           ph.GetPeerHash(),
+			PeerASN:            ph.PeerAS,
+			Timestamp:          p.timestamp(ph),
+			CollectorTimestamp: p.collectorTimestamp(ph),
+			PrefixLen:          int32(e.Length),
+			PathID:             int32(e.PathID),
+			BaseAttributes:     update.BaseAttributes,
 		}
 		if f, err := ph.IsAdjRIBInPost(); err == nil {
 			prfx.IsAdjRIBInPost = f
@@ -69,9 +64,14 @@ func (p *producer) unicast(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, updat
 		if f, err := ph.IsAdjRIBOutPost(); err == nil {
 			prfx.IsAdjRIBOutPost = f
 		}
+		prfx.IsAdjRIBOut = ph.IsAdjRIBOut()
+		prfx.IsPostPolicy = ph.IsPostPolicy()
 		if f, err := ph.IsLocRIBFiltered(); err == nil {
 			prfx.IsLocRIBFiltered = f
 		}
+		prfx.RIBType = ph.GetRIBType()
+		prfx.TableName = p.locRIBTable(ph)
+		prfx.IsLLGRStale = update.BaseAttributes.IsLLGRStale()
 		if ases := update.BaseAttributes.ASPath; len(ases) != 0 {
 			// Last element in AS_PATH would be the AS of the origin
 			prfx.OriginAS = int32(ases[len(ases)-1])
@@ -100,7 +100,12 @@ func (p *producer) unicast(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, updat
 			// Some Label Unicast may carry BGP Attribute 40 (Prefix SID)
 			if psid, err := update.GetAttrPrefixSID(); err == nil {
 				prfx.PrefixSID = psid
+				unicastSRv6SIDs(prfx, psid, prfx.Labels)
 			}
+		} else if psid, err := update.GetAttrPrefixSID(); op == 0 && err == nil && psid.SRv6L3Service != nil {
+			// Routes over SRv6 carry SRv6 L3 Service of BGP Attribute 40 (Prefix SID), RFC 9252 section 5
+			prfx.PrefixSID = psid
+			unicastSRv6SIDs(prfx, psid, nil)
 		}
 		prfxs = append(prfxs, prfx)
 	}
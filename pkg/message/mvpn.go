@@ -0,0 +1,82 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/mvpn"
+)
+
+// mvpn process nlri 14 afi 1/2 safi 5 messages and generates MCAST-VPN messages
+func (p *producer) mvpn(routes []*mvpn.Route, nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]*MVPN, error) {
+	var operation string
+	switch op {
+	case 0:
+		operation = "add"
+	case 1:
+		operation = "del"
+	default:
+		return nil, fmt.Errorf("unknown operation %d", op)
+	}
+	pmsi, _ := update.GetAttrPMSITunnel()
+	msgs := make([]*MVPN, 0, len(routes))
+	for _, r := range routes {
+		m := &MVPN{
+			Action:             operation,
+			RouterHash:         p.speakerID().hash,
+			RouterIP:           p.speakerID().ip,
+			BaseAttributes:     update.BaseAttributes,
+			PeerHash:           ph.GetPeerHash(),
+			PeerIP:             ph.GetPeerAddrString(),
+			PeerType:           uint8(ph.PeerType),
+			PeerRD:             ph.GetPeerDistinguisherString(),
+			PeerASN:            ph.PeerAS,
+			Timestamp:          p.timestamp(ph),
+			CollectorTimestamp: p.collectorTimestamp(ph),
+			IsIPv4:             !nlri.IsIPv6NLRI(),
+			Nexthop:            nlri.GetNextHop(),
+			IsNexthopIPv4:      !nlri.IsNextHopIPv6(),
+			PathID:             int32(r.PathID),
+			RouteType:          r.Type,
+			RouteTypeName:      mvpn.RouteTypeName(r.Type),
+			VPNRD:              r.GetRD(),
+			SourceAS:           r.SourceAS,
+			MulticastSource:    r.GetSource(),
+			MulticastGroup:     r.GetGroup(),
+			Originator:         r.GetOriginatorIP(),
+			PMSITunnel:         pmsi,
+		}
+		if update.BaseAttributes != nil {
+			if ases := update.BaseAttributes.ASPath; len(ases) != 0 {
+				// Last element in AS_PATH would be the AS of the origin
+				m.OriginAS = int32(ases[len(ases)-1])
+			}
+		}
+		if k := r.RouteKey; k != nil {
+			m.RouteKey = &MVPNRouteKey{
+				RouteType:       k.Type,
+				RouteTypeName:   mvpn.RouteTypeName(k.Type),
+				VPNRD:           k.GetRD(),
+				MulticastSource: k.GetSource(),
+				MulticastGroup:  k.GetGroup(),
+				Originator:      k.GetOriginatorIP(),
+			}
+		}
+		if f, err := ph.IsAdjRIBInPost(); err == nil {
+			m.IsAdjRIBInPost = f
+		}
+		if f, err := ph.IsAdjRIBOutPost(); err == nil {
+			m.IsAdjRIBOutPost = f
+		}
+		m.IsAdjRIBOut = ph.IsAdjRIBOut()
+		m.IsPostPolicy = ph.IsPostPolicy()
+		if f, err := ph.IsLocRIBFiltered(); err == nil {
+			m.IsLocRIBFiltered = f
+		}
+		m.RIBType = ph.GetRIBType()
+		msgs = append(msgs, m)
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,57 @@
+package message
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestMVPNMessage(t *testing.T) {
+	// AFI 1 SAFI 5, next hop 10.0.0.1, S-PMSI A-D route and Leaf A-D route responding to it
+	mp, err := bgp.UnmarshalMPReachNLRI([]byte{
+		0x00, 0x01, 0x05, 0x04, 0x0a, 0x00, 0x00, 0x01, 0x00,
+		0x03, 0x16, 0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64, 0x20, 0xc0, 0xa8, 0x01, 0x01, 0x20, 0xe8, 0x01, 0x01, 0x01,
+		0x0a, 0x00, 0x00, 0x01,
+		0x04, 0x1c, 0x03, 0x16, 0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64, 0x20, 0xc0, 0xa8, 0x01, 0x01, 0x20, 0xe8, 0x01,
+		0x01, 0x01, 0x0a, 0x00, 0x00, 0x01, 0x0a, 0x00, 0x00, 0x02,
+	}, false, map[int]bool{})
+	if err != nil {
+		t.Fatalf("failed to unmarshal MP_REACH_NLRI with error: %+v", err)
+	}
+	pub := &testPublisher{msgs: make(map[int][]string)}
+	p := &producer{publisher: pub}
+	p.speaker.Store(&speaker{hash: "hash"})
+	ph := &bmp.PerPeerHeader{PeerAddress: make([]byte, 16), PeerTimestamp: make([]byte, 8), PeerDistinguisher: make([]byte, 8)}
+	// PMSI Tunnel attribute of PIM-SSM tree, sender 10.0.0.1 and P-multicast group 232.0.0.1
+	pmsi := bgp.PathAttribute{AttributeTypeFlags: 0xc0, AttributeType: bgp.PMSITunnelAttributeType, AttributeLength: 13,
+		Attribute: []byte{0x00, 0x03, 0x00, 0x00, 0x00, 0x0a, 0x00, 0x00, 0x01, 0xe8, 0x00, 0x00, 0x01}}
+	p.processMPUpdate(nil, mp, AddPrefix, ph, &bgp.Update{BaseAttributes: &bgp.BaseAttributes{}, PathAttributes: []bgp.PathAttribute{pmsi}}, nil)
+	got := pub.msgs[bmp.MVPNMsg]
+	if len(got) != 2 {
+		t.Fatalf("expected 2 mvpn messages but got %v", got)
+	}
+	msgs := make([]MVPN, len(got))
+	for i, m := range got {
+		if err := json.Unmarshal([]byte(m), &msgs[i]); err != nil {
+			t.Fatalf("failed to unmarshal mvpn message with error: %+v", err)
+		}
+	}
+	s := msgs[0]
+	if s.RouteTypeName != "s_pmsi_ad" || s.VPNRD != "65000:100" || s.MulticastSource != "192.168.1.1" ||
+		s.MulticastGroup != "232.1.1.1" || s.Originator != "10.0.0.1" || s.Nexthop != "10.0.0.1" || !s.IsIPv4 {
+		t.Errorf("unexpected s-pmsi a-d message %+v", s)
+	}
+	if s.PMSITunnel == nil || s.PMSITunnel.TunnelTypeName != "pim_ssm" || s.PMSITunnel.Sender != "10.0.0.1" ||
+		s.PMSITunnel.PGroup != "232.0.0.1" {
+		t.Errorf("unexpected pmsi tunnel of s-pmsi a-d message %+v", s.PMSITunnel)
+	}
+	l := msgs[1]
+	if l.RouteTypeName != "leaf_ad" || l.VPNRD != "" || l.Originator != "10.0.0.2" || l.RouteKey == nil {
+		t.Fatalf("unexpected leaf a-d message %+v", l)
+	}
+	if l.RouteKey.RouteTypeName != "s_pmsi_ad" || l.RouteKey.VPNRD != "65000:100" || l.RouteKey.MulticastGroup != "232.1.1.1" {
+		t.Errorf("unexpected route key of leaf a-d message %+v", l.RouteKey)
+	}
+}
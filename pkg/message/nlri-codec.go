@@ -0,0 +1,321 @@
+package message
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/base"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/evpn"
+	"github.com/sbezverk/gobmp/pkg/flowspec"
+	"github.com/sbezverk/gobmp/pkg/ls"
+	"github.com/sbezverk/gobmp/pkg/mvpn"
+	"github.com/sbezverk/gobmp/pkg/srpolicy"
+	"github.com/sbezverk/gobmp/pkg/srv6"
+)
+
+// NLRIMessage defines a message produced from NLRI, Type is the message type in the message type registry
+// and Key is the key the message is published with
+type NLRIMessage struct {
+	Type  int
+	Key   []byte
+	Value interface{}
+}
+
+// NLRIContext defines the update carrying NLRI converted into messages
+type NLRIContext struct {
+	// Operation is AddPrefix for NLRI of MP_REACH_NLRI and DelPrefix for NLRI of MP_UNREACH_NLRI
+	Operation  int
+	PeerHeader *bmp.PerPeerHeader
+	Update     *bgp.Update
+	MPNLRI     bgp.MPNLRI
+	// RouteMonitor is the Route Monitoring message carrying the update, TLVs of BMP v4 message apply to NLRI
+	// in the order of MPNLRI
+	RouteMonitor *bmp.RouteMonitor
+	// RouterIP and RouterHash identify the router of the BMP session
+	RouterIP   string
+	RouterHash string
+	// SplitAF is true when messages of IPv4 and IPv6 address families are published to separate topics
+	SplitAF bool
+}
+
+// NLRICodec defines decoding and encoding of NLRI of an address family and the conversion of decoded NLRI
+// into messages. Types of produced messages have to be registered with bmp.RegisterMessageType.
+type NLRICodec interface {
+	bgp.NLRICodec
+	// ToMessage returns messages produced from NLRI decoded by Unmarshal
+	ToMessage(nlri interface{}, ctx *NLRIContext) ([]NLRIMessage, error)
+}
+
+// toMessageFunc converts decoded NLRI into messages, builtin address families use the state of the producer
+type toMessageFunc func(p *producer, nlri interface{}, ctx *NLRIContext) ([]NLRIMessage, error)
+
+// toMessages is the registry of conversions of NLRI into messages by AFI and SAFI
+var toMessages = struct {
+	sync.RWMutex
+	funcs map[uint32]toMessageFunc
+}{
+	funcs: make(map[uint32]toMessageFunc),
+}
+
+func afiSAFIKey(afi uint16, safi uint8) uint32 {
+	return uint32(afi)<<8 | uint32(safi)
+}
+
+// RegisterNLRICodec registers the codec of NLRI of the address family, MP_REACH_NLRI and MP_UNREACH_NLRI
+// attributes of the address family are decoded by the codec and their NLRI are published as messages
+// returned by ToMessage.
+func RegisterNLRICodec(afi uint16, safi uint8, c NLRICodec) error {
+	if err := bgp.RegisterNLRICodec(afi, safi, c); err != nil {
+		return err
+	}
+	registerToMessage(afi, safi, func(_ *producer, nlri interface{}, ctx *NLRIContext) ([]NLRIMessage, error) {
+		return c.ToMessage(nlri, ctx)
+	})
+
+	return nil
+}
+
+func registerToMessage(afi uint16, safi uint8, f toMessageFunc) {
+	toMessages.Lock()
+	defer toMessages.Unlock()
+	toMessages.funcs[afiSAFIKey(afi, safi)] = f
+}
+
+func lookupToMessage(afi uint16, safi uint8) (toMessageFunc, bool) {
+	toMessages.RLock()
+	defer toMessages.RUnlock()
+	f, ok := toMessages.funcs[afiSAFIKey(afi, safi)]
+
+	return f, ok
+}
+
+func init() {
+	// Codecs of builtin address families are registered by bgp package
+	for _, afi := range []uint16{1, 2} {
+		registerToMessage(afi, 1, unicastToMessage(false))
+		registerToMessage(afi, 4, unicastToMessage(true))
+		registerToMessage(afi, 128, l3vpnToMessage)
+		registerToMessage(afi, 73, srpolicyToMessage)
+		registerToMessage(afi, 133, flowspecToMessage)
+		registerToMessage(afi, 134, flowspecToMessage)
+		registerToMessage(afi, 5, mvpnToMessage)
+	}
+	registerToMessage(25, 70, evpnToMessage)
+	registerToMessage(16388, 71, lsToMessage)
+}
+
+// splitTopic returns the type of messages of the address family when IPv4 and IPv6 messages are published
+// to separate topics
+func splitTopic(ctx *NLRIContext, ipv4 bool, all, v4, v6 int) int {
+	switch {
+	case !ctx.SplitAF:
+		return all
+	case ipv4:
+		return v4
+	}
+	return v6
+}
+
+func unicastToMessage(labeled bool) toMessageFunc {
+	return func(p *producer, nlri interface{}, ctx *NLRIContext) ([]NLRIMessage, error) {
+		u, ok := nlri.(*base.MPNLRI)
+		if !ok {
+			return nil, fmt.Errorf("invalid unicast NLRI type %T", nlri)
+		}
+		prfxs, err := p.unicast(u, ctx.MPNLRI, ctx.Operation, ctx.PeerHeader, ctx.Update, labeled)
+		if err != nil {
+			return nil, err
+		}
+		addRouteMonitorTLVs(prfxs, ctx.RouteMonitor)
+		msgs := make([]NLRIMessage, 0, len(prfxs))
+		for _, m := range prfxs {
+			t := splitTopic(ctx, m.IsIPv4, bmp.UnicastPrefixMsg, bmp.UnicastPrefixV4Msg, bmp.UnicastPrefixV6Msg)
+			msgs = append(msgs, NLRIMessage{Type: t, Key: []byte(m.RouterHash), Value: m})
+		}
+		return msgs, nil
+	}
+}
+
+func l3vpnToMessage(p *producer, nlri interface{}, ctx *NLRIContext) ([]NLRIMessage, error) {
+	u, ok := nlri.(*base.MPNLRI)
+	if !ok {
+		return nil, fmt.Errorf("invalid l3vpn NLRI type %T", nlri)
+	}
+	prfxs, err := p.l3vpn(u, ctx.MPNLRI, ctx.Operation, ctx.PeerHeader, ctx.Update)
+	if err != nil {
+		return nil, err
+	}
+	table := routeMonitorTable(ctx.RouteMonitor)
+	msgs := make([]NLRIMessage, 0, len(prfxs))
+	for i := range prfxs {
+		m := &prfxs[i]
+		m.TableName, m.TLVs = table, routeMonitorTLVs(ctx.RouteMonitor, i)
+		t := splitTopic(ctx, m.IsIPv4, bmp.L3VPNMsg, bmp.L3VPNV4Msg, bmp.L3VPNV6Msg)
+		msgs = append(msgs, NLRIMessage{Type: t, Key: []byte(m.RouterHash), Value: m})
+	}
+	return msgs, nil
+}
+
+func evpnToMessage(p *producer, nlri interface{}, ctx *NLRIContext) ([]NLRIMessage, error) {
+	r, ok := nlri.(*evpn.Route)
+	if !ok {
+		return nil, fmt.Errorf("invalid evpn NLRI type %T", nlri)
+	}
+	prfxs, err := p.evpn(r, ctx.MPNLRI, ctx.Operation, ctx.PeerHeader, ctx.Update)
+	if err != nil {
+		return nil, err
+	}
+	table := routeMonitorTable(ctx.RouteMonitor)
+	msgs := make([]NLRIMessage, 0, len(prfxs))
+	for i := range prfxs {
+		m := &prfxs[i]
+		m.TableName, m.TLVs = table, routeMonitorTLVs(ctx.RouteMonitor, i)
+		msgs = append(msgs, NLRIMessage{Type: bmp.EVPNMsg, Key: []byte(m.RouterHash), Value: m})
+	}
+	return msgs, nil
+}
+
+func srpolicyToMessage(p *producer, nlri interface{}, ctx *NLRIContext) ([]NLRIMessage, error) {
+	sr, ok := nlri.(*srpolicy.NLRI73)
+	if !ok {
+		return nil, fmt.Errorf("invalid srpolicy NLRI type %T", nlri)
+	}
+	policies, err := p.srpolicy(sr, ctx.MPNLRI, ctx.Operation, ctx.PeerHeader, ctx.Update)
+	if err != nil {
+		return nil, err
+	}
+	// SR Policy NLRI is the only NLRI of the update
+	table := routeMonitorTable(ctx.RouteMonitor)
+	msgs := make([]NLRIMessage, 0, len(policies))
+	for _, m := range policies {
+		m.TableName, m.TLVs = table, routeMonitorTLVs(ctx.RouteMonitor, 0)
+		t := splitTopic(ctx, m.IsIPv4, bmp.SRPolicyMsg, bmp.SRPolicyV4Msg, bmp.SRPolicyV6Msg)
+		msgs = append(msgs, NLRIMessage{Type: t, Key: []byte(m.RouterHash), Value: m})
+	}
+	return msgs, nil
+}
+
+func flowspecToMessage(p *producer, nlri interface{}, ctx *NLRIContext) ([]NLRIMessage, error) {
+	var fss []*flowspec.NLRI
+	switch fs := nlri.(type) {
+	case []*flowspec.NLRI:
+		fss = fs
+	case *flowspec.NLRI:
+		fss = []*flowspec.NLRI{fs}
+	default:
+		return nil, fmt.Errorf("invalid flowspec NLRI type %T", nlri)
+	}
+	table := routeMonitorTable(ctx.RouteMonitor)
+	msgs := make([]NLRIMessage, 0, len(fss))
+	for i, fs := range fss {
+		specs, err := p.flowspec(fs, ctx.MPNLRI, ctx.Operation, ctx.PeerHeader, ctx.Update)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range specs {
+			m.TableName, m.TLVs = table, routeMonitorTLVs(ctx.RouteMonitor, i)
+			t := splitTopic(ctx, m.IsIPv4, bmp.FlowspecMsg, bmp.FlowspecV4Msg, bmp.FlowspecV6Msg)
+			msgs = append(msgs, NLRIMessage{Type: t, Key: []byte(m.SpecHash), Value: m})
+		}
+	}
+	return msgs, nil
+}
+
+func mvpnToMessage(p *producer, nlri interface{}, ctx *NLRIContext) ([]NLRIMessage, error) {
+	routes, ok := nlri.([]*mvpn.Route)
+	if !ok {
+		return nil, fmt.Errorf("invalid mvpn NLRI type %T", nlri)
+	}
+	mvpns, err := p.mvpn(routes, ctx.MPNLRI, ctx.Operation, ctx.PeerHeader, ctx.Update)
+	if err != nil {
+		return nil, err
+	}
+	table := routeMonitorTable(ctx.RouteMonitor)
+	msgs := make([]NLRIMessage, 0, len(mvpns))
+	for i, m := range mvpns {
+		m.TableName, m.TLVs = table, routeMonitorTLVs(ctx.RouteMonitor, i)
+		msgs = append(msgs, NLRIMessage{Type: bmp.MVPNMsg, Key: []byte(m.RouterHash), Value: m})
+	}
+	return msgs, nil
+}
+
+// lsToMessage produces messages of BGP-LS NLRI sub types, NLRI of sub types failing to be converted are skipped
+func lsToMessage(p *producer, nlri interface{}, ctx *NLRIContext) ([]NLRIMessage, error) {
+	lsnlri, ok := nlri.(*ls.NLRI71)
+	if !ok {
+		return nil, fmt.Errorf("invalid ls NLRI type %T", nlri)
+	}
+	nh, op, ph, update := ctx.MPNLRI.GetNextHop(), ctx.Operation, ctx.PeerHeader, ctx.Update
+	table := routeMonitorTable(ctx.RouteMonitor)
+	msgs := make([]NLRIMessage, 0, len(lsnlri.NLRI))
+	for i, e := range lsnlri.NLRI {
+		// ipv4Flag used to differentiate between IPv4 and IPv6 Prefix NLRI messages
+		ipv4Flag := false
+		switch e.Type {
+		case 1:
+			n, ok := e.LS.(*base.NodeNLRI)
+			if !ok {
+				glog.Errorf("failed to produce ls_node message, invalid NLRI type %T", e.LS)
+				continue
+			}
+			msg, err := p.lsNode(n, nh, op, ph, update, ph.IsRemotePeerIPv6())
+			if err != nil {
+				glog.Errorf("failed to produce ls_node message with error: %+v", err)
+				continue
+			}
+			msg.TableName, msg.TLVs = table, routeMonitorTLVs(ctx.RouteMonitor, i)
+			msgs = append(msgs, NLRIMessage{Type: bmp.LSNodeMsg, Key: []byte(msg.RouterHash), Value: msg})
+		case 2:
+			l, ok := e.LS.(*base.LinkNLRI)
+			if !ok {
+				glog.Errorf("failed to produce ls_link message, invalid NLRI type %T", e.LS)
+				continue
+			}
+			msg, err := p.lsLink(l, nh, op, ph, update, ph.IsRemotePeerIPv6())
+			if err != nil {
+				glog.Errorf("failed to produce ls_link message with error: %+v", err)
+				continue
+			}
+			msg.TableName, msg.TLVs = table, routeMonitorTLVs(ctx.RouteMonitor, i)
+			msgs = append(msgs, NLRIMessage{Type: bmp.LSLinkMsg, Key: []byte(msg.RouterHash), Value: msg})
+			if adj := p.adjacencies.update(msg, l.LocalNode.GetNodeKey(), l.RemoteNode.GetNodeKey()); adj != nil {
+				msgs = append(msgs, NLRIMessage{Type: bmp.IGPAdjacencyMsg, Key: []byte(adj.RouterHash), Value: adj})
+			}
+		case 3:
+			ipv4Flag = true
+			fallthrough
+		case 4:
+			prfx, ok := e.LS.(*base.PrefixNLRI)
+			if !ok {
+				glog.Errorf("failed to produce ls_prefix message, invalid NLRI type %T", e.LS)
+				continue
+			}
+			msg, err := p.lsPrefix(prfx, nh, op, ph, update, ipv4Flag)
+			if err != nil {
+				glog.Errorf("failed to produce ls_prefix message with error: %+v", err)
+				continue
+			}
+			msg.TableName, msg.TLVs = table, routeMonitorTLVs(ctx.RouteMonitor, i)
+			msgs = append(msgs, NLRIMessage{Type: bmp.LSPrefixMsg, Key: []byte(msg.RouterHash), Value: msg})
+		case 6:
+			s, ok := e.LS.(*srv6.SIDNLRI)
+			if !ok {
+				glog.Errorf("failed to produce ls_srv6_sid message, invalid NLRI type %T", e.LS)
+				continue
+			}
+			msg, err := p.lsSRv6SID(s, nh, op, ph, update)
+			if err != nil {
+				glog.Errorf("failed to produce ls_srv6_sid message with error: %+v", err)
+				continue
+			}
+			msg.TableName, msg.TLVs = table, routeMonitorTLVs(ctx.RouteMonitor, i)
+			msgs = append(msgs, NLRIMessage{Type: bmp.LSSRv6SIDMsg, Key: []byte(msg.RouterHash), Value: msg})
+		default:
+			glog.Warningf("Unknown NLRI 71 Sub type %d", e.Type)
+		}
+	}
+	return msgs, nil
+}
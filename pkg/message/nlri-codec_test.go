@@ -0,0 +1,71 @@
+package message
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+const testNLRIMsg = 1000
+
+type testNLRI struct {
+	Value []byte `json:"value"`
+}
+
+type testNLRICodec struct{}
+
+func (c *testNLRICodec) Unmarshal(b []byte, _ *bgp.NLRIOptions) (interface{}, error) {
+	return &testNLRI{Value: b}, nil
+}
+
+func (c *testNLRICodec) Marshal(nlri interface{}, _ *bgp.NLRIOptions) ([]byte, error) {
+	n, ok := nlri.(*testNLRI)
+	if !ok {
+		return nil, fmt.Errorf("invalid NLRI type %T", nlri)
+	}
+	return n.Value, nil
+}
+
+func (c *testNLRICodec) ToMessage(nlri interface{}, ctx *NLRIContext) ([]NLRIMessage, error) {
+	return []NLRIMessage{{Type: testNLRIMsg, Key: []byte(ctx.RouterHash), Value: nlri}}, nil
+}
+
+type testPublisher struct {
+	msgs map[int][]string
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.msgs[msgType] = append(p.msgs[msgType], string(msg))
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+func TestRegisterNLRICodec(t *testing.T) {
+	if err := bmp.RegisterMessageType(bmp.MessageType{Type: testNLRIMsg, Name: "test_nlri"}); err != nil {
+		t.Fatalf("failed to register message type with error: %+v", err)
+	}
+	if err := bmp.RegisterMessageSchema(testNLRIMsg, testNLRI{}); err != nil {
+		t.Fatalf("failed to register message schema with error: %+v", err)
+	}
+	// AFI 1 SAFI 85, BGP-MUP
+	if err := RegisterNLRICodec(1, 85, &testNLRICodec{}); err != nil {
+		t.Fatalf("failed to register codec with error: %+v", err)
+	}
+	if err := RegisterNLRICodec(1, 1, &testNLRICodec{}); err == nil {
+		t.Errorf("expected error registering codec of builtin address family")
+	}
+	mp, err := bgp.UnmarshalMPReachNLRI([]byte{0x00, 0x01, 0x55, 0x04, 0x0a, 0x00, 0x00, 0x01, 0x00, 0x01, 0x02}, false, map[int]bool{})
+	if err != nil {
+		t.Fatalf("failed to unmarshal MP_REACH_NLRI with error: %+v", err)
+	}
+	pub := &testPublisher{msgs: make(map[int][]string)}
+	p := &producer{publisher: pub}
+	p.speaker.Store(&speaker{hash: "hash"})
+	p.processMPUpdate(nil, mp, AddPrefix, &bmp.PerPeerHeader{}, nil, nil)
+	if got := pub.msgs[testNLRIMsg]; len(got) != 1 || got[0] != `{"value":"AQI="}` {
+		t.Errorf("expected one test_nlri message but got %v", got)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bgp"
 	"github.com/sbezverk/gobmp/pkg/bmp"
 )
 
@@ -27,15 +28,16 @@ func (p *producer) producePeerMessage(op int, msg bmp.Message) {
 			return
 		}
 		m = PeerStateChange{
-			Action:         action,
-			RemoteASN:      msg.PeerHeader.PeerAS,
-			PeerType:       uint8(msg.PeerHeader.PeerType),
-			PeerRD:         msg.PeerHeader.GetPeerDistinguisherString(),
-			RemotePort:     int(peerUpMsg.RemotePort),
-			Timestamp:      msg.PeerHeader.GetPeerTimestamp(),
-			LocalPort:      int(peerUpMsg.LocalPort),
-			AdvHolddown:    int(peerUpMsg.SentOpen.HoldTime),
-			RemoteHolddown: int(peerUpMsg.ReceivedOpen.HoldTime),
+			Action:             action,
+			RemoteASN:          msg.PeerHeader.PeerAS,
+			PeerType:           uint8(msg.PeerHeader.PeerType),
+			PeerRD:             msg.PeerHeader.GetPeerDistinguisherString(),
+			RemotePort:         int(peerUpMsg.RemotePort),
+			Timestamp:          p.timestamp(msg.PeerHeader),
+			CollectorTimestamp: p.collectorTimestamp(msg.PeerHeader),
+			LocalPort:          int(peerUpMsg.LocalPort),
+			AdvHolddown:        int(peerUpMsg.SentOpen.HoldTime),
+			RemoteHolddown:     int(peerUpMsg.ReceivedOpen.HoldTime),
 		}
 		if f, err := msg.PeerHeader.IsAdjRIBInPost(); err == nil {
 			m.IsAdjRIBInPost = f
@@ -43,44 +45,64 @@ func (p *producer) producePeerMessage(op int, msg bmp.Message) {
 		if f, err := msg.PeerHeader.IsAdjRIBOutPost(); err == nil {
 			m.IsAdjRIBOutPost = f
 		}
+		m.IsAdjRIBOut = msg.PeerHeader.IsAdjRIBOut()
+		m.IsPostPolicy = msg.PeerHeader.IsPostPolicy()
 		if f, err := msg.PeerHeader.IsLocRIBFiltered(); err == nil {
 			m.IsLocRIBFiltered = f
 		}
 		m.RemoteIP = msg.PeerHeader.GetPeerAddrString()
 		m.RemoteBGPID = msg.PeerHeader.GetPeerBGPIDString()
+		m.Name = peerUpMsg.GetPeerName()
 		m.LocalBGPID = net.IP(peerUpMsg.SentOpen.BGPID).To4().String()
 		m.IsIPv4 = !msg.PeerHeader.IsRemotePeerIPv6()
 		m.LocalIP = peerUpMsg.GetLocalAddressString()
+		m.RIBType = msg.PeerHeader.GetRIBType()
 		// Saving local bgp speaker identities.
-		p.speakerIP = m.LocalIP
-		p.speakerHash = fmt.Sprintf("%x", md5.Sum([]byte(p.speakerIP)))
-		m.RouterIP = p.speakerIP
-		m.RouterHash = p.speakerHash
+		speakerIP := p.speakerID().ip
+		switch {
+		case msg.PeerHeader.PeerType != bmp.PeerType3 || !net.ParseIP(m.LocalIP).IsUnspecified():
+			speakerIP = m.LocalIP
+		case speakerIP == "":
+			// Loc-RIB instance peers carry zero-filled Local Address, the router is identified by its BGP Identifier
+			// until a peer with the local address comes up
+			speakerIP = m.LocalBGPID
+		}
+		if msg.PeerHeader.PeerType == bmp.PeerType3 {
+			m.TableName = peerUpMsg.GetTableName()
+			p.locRIB.set(m.PeerRD, m.TableName)
+		}
+		s := &speaker{ip: speakerIP, hash: fmt.Sprintf("%x", md5.Sum([]byte(speakerIP)))}
+		p.speaker.Store(s)
+		m.RouterIP = s.ip
+		m.RouterHash = s.hash
 
 		m.LocalASN = uint32(peerUpMsg.SentOpen.MyAS)
 		if lasn, ok := peerUpMsg.SentOpen.Is4BytesASCapable(); ok {
 			// Local BGP speaker is 4 bytes AS capable
 			m.LocalASN = lasn
 		}
-		// Check if local router advertises AddPath Send/Receive for any AFI/SAFI,
-		// if map comes back empty no further AddPath Capability is needed
-		if lAddPath := peerUpMsg.SentOpen.AddPathCapability(); len(lAddPath) != 0 {
-			// Check if remote router advertises AddPath Send/Receive for any AFI/SAFI,
-			// if map comes back empty no further AddPath Capability is needed
-			if rAddPath := peerUpMsg.ReceivedOpen.AddPathCapability(); len(rAddPath) != 0 {
-				for k := range lAddPath {
-					// Enable AddPath only for AFI/SAFI types existing in both local and remote maps
-					if capable, ok := rAddPath[k]; ok {
-						// AFI/SAFI type exists in both maps, which means both peers support Send/Receive of AddPath
-						p.addPathCapable[k] = capable
-					}
-				}
-			}
+		// Path Identifiers of NLRI are negotiated per AFI/SAFI and direction of the session of the peer
+		addPath := bgp.NegotiateAddPath(peerUpMsg.SentOpen, peerUpMsg.ReceivedOpen)
+		p.addPathSessions.set(msg.PeerHeader.GetPeerHash(), addPath)
+		m.AddPath = addPath.Modes()
+		m.ExtendedMessage = bgp.NegotiateExtendedMessage(peerUpMsg.SentOpen, peerUpMsg.ReceivedOpen)
+		lrole, lok := peerUpMsg.SentOpen.Role()
+		if lok {
+			m.LocalRole = bgp.RoleName(lrole)
+		}
+		rrole, rok := peerUpMsg.ReceivedOpen.Role()
+		if rok {
+			m.RemoteRole = bgp.RoleName(rrole)
 		}
+		m.RoleMismatch = lok && rok && !bgp.RolesMatch(lrole, rrole)
 		m.AdvCapabilities = peerUpMsg.SentOpen.GetCapabilities()
 		m.RcvCapabilities = peerUpMsg.ReceivedOpen.GetCapabilities()
+		m.CapMismatches = bgp.CapabilityMismatches(peerUpMsg.SentOpen, peerUpMsg.ReceivedOpen)
+		for _, mismatch := range m.CapMismatches {
+			glog.Warningf("router %s peer %s capabilities mismatch: %s", m.RouterIP, m.RemoteIP, mismatch)
+		}
 		if glog.V(6) {
-			glog.Infof("producer for speaker ip: %s add path: %+v", p.speakerIP, p.addPathCapable)
+			glog.Infof("producer for speaker ip: %s peer: %s add path: %+v", m.RouterIP, m.RemoteIP, m.AddPath)
 		}
 	} else {
 		peerDownMsg, ok := msg.Payload.(*bmp.PeerDownMessage)
@@ -89,15 +111,24 @@ func (p *producer) producePeerMessage(op int, msg bmp.Message) {
 			return
 		}
 		m = PeerStateChange{
-			Action:     "down",
-			RouterIP:   p.speakerIP,
-			PeerType:   uint8(msg.PeerHeader.PeerType),
-			RouterHash: p.speakerHash,
-			BMPReason:  int(peerDownMsg.Reason),
-			RemoteASN:  msg.PeerHeader.PeerAS,
-			PeerRD:     msg.PeerHeader.GetPeerDistinguisherString(),
-			Timestamp:  msg.PeerHeader.GetPeerTimestamp(),
+			Action:             "down",
+			RouterIP:           p.speakerID().ip,
+			PeerType:           uint8(msg.PeerHeader.PeerType),
+			RouterHash:         p.speakerID().hash,
+			BMPReason:          int(peerDownMsg.Reason),
+			RemoteASN:          msg.PeerHeader.PeerAS,
+			PeerRD:             msg.PeerHeader.GetPeerDistinguisherString(),
+			Timestamp:          p.timestamp(msg.PeerHeader),
+			CollectorTimestamp: p.collectorTimestamp(msg.PeerHeader),
+			IsAdjRIBOut:        msg.PeerHeader.IsAdjRIBOut(),
+			IsPostPolicy:       msg.PeerHeader.IsPostPolicy(),
+			RIBType:            msg.PeerHeader.GetRIBType(),
+			TableName:          p.locRIBTable(msg.PeerHeader),
+		}
+		if msg.PeerHeader.PeerType == bmp.PeerType3 {
+			p.locRIB.set(m.PeerRD, "")
 		}
+		p.addPathSessions.set(msg.PeerHeader.GetPeerHash(), nil)
 		m.RemoteIP = msg.PeerHeader.GetPeerAddrString()
 		m.RemoteBGPID = msg.PeerHeader.GetPeerBGPIDString()
 		m.IsIPv4 = !msg.PeerHeader.IsRemotePeerIPv6()
@@ -105,7 +136,7 @@ func (p *producer) producePeerMessage(op int, msg bmp.Message) {
 		copy(m.InfoData, peerDownMsg.Data)
 
 	}
-	if err := p.marshalAndPublish(&m, bmp.PeerStateChangeMsg, []byte(m.RouterHash), false); err != nil {
+	if err := p.marshalAndPublish(msg.Context, &m, bmp.PeerStateChangeMsg, []byte(m.RouterHash), false); err != nil {
 		glog.Errorf("failed to process peer message with error: %+v", err)
 		return
 	}
@@ -1,9 +1,13 @@
 package message
 
 import (
+	"fmt"
+	"sync/atomic"
+
 	"github.com/golang/glog"
 	"github.com/sbezverk/gobmp/pkg/bmp"
 	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/tracing"
 )
 
 const (
@@ -14,15 +18,40 @@ const (
 // Producer defines methods to act as a message producer
 type Producer interface {
 	Producer(queue chan bmp.Message, stop chan struct{})
+	// Produce publishes messages of the BMP message before it returns, messages passed to Produce one by one are
+	// published in their order
+	Produce(msg bmp.Message)
+	// TopologyUsage returns the number of BGP-LS nodes and IGP adjacencies stored for the session and
+	// estimated memory used by them
+	TopologyUsage() (int, uint64)
+}
+
+// speaker is the identity of the local BGP speaker carried by messages of the session
+type speaker struct {
+	ip   string
+	hash string
 }
 
 type producer struct {
-	publisher      pub.Publisher
-	speakerIP      string
-	speakerHash    string
-	addPathCapable map[int]bool
+	publisher pub.Publisher
+	// speaker is set by Peer Up messages, which are produced concurrently with other messages of the session
+	speaker atomic.Pointer[speaker]
 	// If splitAF is set to true, ipv4 and ipv6 messages will go into separate topics
 	splitAF bool
+	// adjacencies correlates LS Links to generate IGP adjacency state changes
+	adjacencies *adjacencyTracker
+	// topology keeps attributes of BGP-LS nodes reported over the session
+	topology *topology
+	// timestamps selects the source of messages timestamps and tracks clock skew of peers
+	timestamps *timestamps
+	// mirrorParse stores types of mirrored BGP messages which are decoded
+	mirrorParse map[uint8]bool
+	// locRIB stores table names of Loc-RIB instance peers
+	locRIB *locRIBTables
+	// addPathSessions stores ADD-PATH state negotiated by BGP sessions of peers
+	addPathSessions *addPathSessions
+	// If rawUpdates is set to true, BGP Update messages of Route Monitoring messages are published as received
+	rawUpdates bool
 }
 
 // Producer dispatches kafka workers upon request received from the channel
@@ -38,7 +67,43 @@ func (p *producer) Producer(queue chan bmp.Message, stop chan struct{}) {
 	}
 }
 
+func (p *producer) Produce(msg bmp.Message) {
+	p.producingWorker(msg)
+}
+
+func (p *producer) TopologyUsage() (int, uint64) {
+	nodes, nb := p.topology.size()
+	adjs, ab := p.adjacencies.size()
+
+	return nodes + adjs, nb + ab
+}
+
 func (p *producer) producingWorker(msg bmp.Message) {
+	ctx, span := tracing.Start(msg.Context, "bmp.produce", tracing.KindInternal)
+	if span == nil {
+		p.produce(msg)
+		return
+	}
+	defer span.End()
+	span.SetAttributes(tracing.String("bmp.payload", fmt.Sprintf("%T", msg.Payload)))
+	if msg.PeerHeader != nil {
+		span.SetAttributes(tracing.String("bmp.peer", msg.PeerHeader.GetPeerAddrString()))
+	}
+	// Messages published for the BMP message are traced as children of the produce span
+	msg.Context = ctx
+	p.produce(msg)
+}
+
+// speakerID returns identity of the local BGP speaker, it is empty until the first Peer Up message of the session
+func (p *producer) speakerID() speaker {
+	if s := p.speaker.Load(); s != nil {
+		return *s
+	}
+	return speaker{}
+}
+
+func (p *producer) produce(msg bmp.Message) {
+	p.checkSkew(msg.PeerHeader)
 	switch obj := msg.Payload.(type) {
 	case *bmp.PeerUpMessage:
 		p.producePeerMessage(peerUP, msg)
@@ -48,16 +113,27 @@ func (p *producer) producingWorker(msg bmp.Message) {
 		p.produceRouteMonitorMessage(msg)
 	case *bmp.StatsReport:
 		p.produceStatsMessage(msg)
+	case *bmp.RouteMirror:
+		p.produceRouteMirrorMessage(msg)
 	default:
 		glog.Warningf("got Unknown message %T to push to the producer, ignoring it...", obj)
 	}
 }
 
-// NewProducer instantiates a new instance of a producer with Publisher interface
-func NewProducer(publisher pub.Publisher, splitAF bool) Producer {
+// NewProducer instantiates a new instance of a producer with Publisher interface, ts selects the source
+// of messages timestamps, nil ts selects timestamps of Per-Peer Headers. mirror selects types of mirrored
+// BGP messages which are decoded, nil mirror decodes only Route Refresh messages. When rawUpdates is true, BGP Update
+// messages of Route Monitoring messages are published as received in raw_update messages as well.
+func NewProducer(publisher pub.Publisher, splitAF bool, ts *TimestampConfig, mirror *MirrorConfig, rawUpdates bool) Producer {
 	return &producer{
-		publisher:      publisher,
-		splitAF:        splitAF,
-		addPathCapable: make(map[int]bool),
+		publisher:       publisher,
+		splitAF:         splitAF,
+		adjacencies:     newAdjacencyTracker(),
+		topology:        newTopology(),
+		timestamps:      newTimestamps(ts),
+		mirrorParse:     newMirrorParse(mirror),
+		locRIB:          newLocRIBTables(),
+		addPathSessions: newAddPathSessions(),
+		rawUpdates:      rawUpdates,
 	}
 }
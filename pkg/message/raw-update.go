@@ -0,0 +1,36 @@
+package message
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// produceRawUpdateMessage produces a raw_update message of BGP Update message of Route Monitoring message as received,
+// the message is published in addition to messages of decoded routes of the update
+func (p *producer) produceRawUpdateMessage(trace context.Context, ph *bmp.PerPeerHeader, rm *bmp.RouteMonitor) {
+	if len(rm.PDU) == 0 {
+		return
+	}
+	m := &RawUpdate{
+		RouterHash:         p.speakerID().hash,
+		RouterIP:           p.speakerID().ip,
+		PeerHash:           ph.GetPeerHash(),
+		PeerIP:             ph.GetPeerAddrString(),
+		PeerType:           uint8(ph.PeerType),
+		PeerRD:             ph.GetPeerDistinguisherString(),
+		PeerASN:            ph.PeerAS,
+		Timestamp:          p.timestamp(ph),
+		CollectorTimestamp: p.collectorTimestamp(ph),
+		RIBType:            ph.GetRIBType(),
+		TableName:          rm.GetTableName(),
+		PDU:                rm.PDU,
+	}
+	if m.TableName == "" {
+		m.TableName = p.locRIBTable(ph)
+	}
+	if err := p.marshalAndPublish(trace, m, bmp.RawUpdateMsg, []byte(m.RouterHash), false); err != nil {
+		glog.Errorf("failed to process raw BGP Update message with error: %+v", err)
+	}
+}
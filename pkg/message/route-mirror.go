@@ -0,0 +1,246 @@
+package message
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/base"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// mirrorParseTypes maps names of BGP message types which can be decoded to the types
+var mirrorParseTypes = map[string]uint8{
+	"open":         bgp.OpenMessageType,
+	"update":       bgp.UpdateMessageType,
+	"notification": bgp.NotificationMessageType,
+	"keepalive":    bgp.KeepaliveMessageType,
+}
+
+// MirrorConfig selects types of BGP messages carried by Route Mirroring messages which are decoded and published
+// as mirrored_message messages, BGP messages of other types are only counted, route_mirror messages carry their types
+// and lengths. Route Refresh messages are always decoded and published as route_refresh messages.
+type MirrorConfig struct {
+	// Parse lists "open", "update", "notification" or "keepalive"
+	Parse []string
+}
+
+// Validate returns error if a type of BGP messages to decode is not supported
+func (c *MirrorConfig) Validate() error {
+	for _, t := range c.Parse {
+		if _, ok := mirrorParseTypes[t]; !ok {
+			return fmt.Errorf("invalid type of mirrored BGP messages %q, supported types are \"open\", \"update\", \"notification\" and \"keepalive\"", t)
+		}
+	}
+
+	return nil
+}
+
+func newMirrorParse(c *MirrorConfig) map[uint8]bool {
+	parse := make(map[uint8]bool)
+	if c == nil {
+		return parse
+	}
+	for _, t := range c.Parse {
+		parse[mirrorParseTypes[t]] = true
+	}
+
+	return parse
+}
+
+// produceRouteMirrorMessage produces a route_mirror message of BMP Route Mirroring message, route_refresh messages
+// of BGP Route Refresh messages mirrored by the router and mirrored_message messages of BGP messages of types selected
+// by MirrorConfig
+func (p *producer) produceRouteMirrorMessage(msg bmp.Message) {
+	if msg.PeerHeader == nil {
+		glog.Errorf("perPeerHeader is missing, cannot construct Route Mirroring message")
+		return
+	}
+	mirrorMsg, ok := msg.Payload.(*bmp.RouteMirror)
+	if !ok {
+		glog.Errorf("got invalid Payload type in bmp.Message")
+		return
+	}
+	ph := msg.PeerHeader
+	rm := &RouteMirror{
+		RouterHash:         p.speakerID().hash,
+		RouterIP:           p.speakerID().ip,
+		PeerHash:           ph.GetPeerHash(),
+		PeerIP:             ph.GetPeerAddrString(),
+		PeerType:           uint8(ph.PeerType),
+		PeerRD:             ph.GetPeerDistinguisherString(),
+		PeerASN:            ph.PeerAS,
+		Timestamp:          p.timestamp(ph),
+		CollectorTimestamp: p.collectorTimestamp(ph),
+		IsAdjRIBOut:        ph.IsAdjRIBOut(),
+		IsPostPolicy:       ph.IsPostPolicy(),
+		RIBType:            ph.GetRIBType(),
+	}
+	errored := false
+	for _, code := range mirrorMsg.Information {
+		switch code {
+		case bmp.MirroredErroredPDUCode:
+			errored = true
+		case bmp.MirroredMessagesLostCode:
+			glog.Warningf("router %s lost mirrored messages of peer %s", p.speakerID().ip, ph.GetPeerAddrString())
+		}
+		rm.Information = append(rm.Information, bmp.MirroredInformationName(code))
+	}
+	types := make([]uint8, 0, len(mirrorMsg.Messages))
+	addPath := p.addPath(ph)
+	for _, b := range mirrorMsg.Messages {
+		t, m := p.mirroredBGPMessage(b, addPath)
+		// Errored PDUs are kept for troubleshooting even when they could be decoded
+		if errored && m.PDU == nil {
+			m.PDU = b
+		}
+		types = append(types, t)
+		rm.Messages = append(rm.Messages, m)
+	}
+	if err := p.marshalAndPublish(msg.Context, rm, bmp.RouteMirroringMsg, []byte(rm.RouterHash), false); err != nil {
+		glog.Errorf("failed to process Route Mirroring message with error: %+v", err)
+	}
+	refreshes, err := mirrorMsg.GetRouteRefreshes()
+	if err != nil {
+		glog.Errorf("failed to process mirrored BGP messages with error: %+v", err)
+	}
+	for _, r := range refreshes {
+		p.produceRouteRefreshMessage(msg.Context, ph, r, RouteRefreshMirrored)
+	}
+	for i, m := range rm.Messages {
+		if !p.mirrorParse[types[i]] {
+			continue
+		}
+		if m.DecodeError != "" {
+			glog.Errorf("failed to decode mirrored BGP %s message with error: %s", m.BGPType, m.DecodeError)
+			continue
+		}
+		mm := &MirroredMessage{
+			RouterHash:         rm.RouterHash,
+			RouterIP:           rm.RouterIP,
+			PeerHash:           rm.PeerHash,
+			PeerIP:             rm.PeerIP,
+			PeerType:           rm.PeerType,
+			PeerRD:             rm.PeerRD,
+			PeerASN:            rm.PeerASN,
+			Timestamp:          rm.Timestamp,
+			CollectorTimestamp: rm.CollectorTimestamp,
+			MirroredBGPMessage: *m,
+			IsAdjRIBOut:        rm.IsAdjRIBOut,
+			IsPostPolicy:       rm.IsPostPolicy,
+			RIBType:            rm.RIBType,
+		}
+		mm.PDU = nil
+		if err := p.marshalAndPublish(msg.Context, mm, bmp.MirroredMessageMsg, []byte(mm.RouterHash), false); err != nil {
+			glog.Errorf("failed to process mirrored BGP message with error: %+v", err)
+		}
+	}
+}
+
+// mirroredBGPMessage decodes mirrored BGP message pdu of a type selected by MirrorConfig or of Route Refresh and
+// returns its type, messages of other types are not decoded. The message which could not be decoded carries the pdu
+// and the reason, addPath selects NLRI types of which NLRI carry Path Identifier
+func (p *producer) mirroredBGPMessage(pdu []byte, addPath map[int]bool) (uint8, *MirroredBGPMessage) {
+	t, b, err := bmp.MirroredMessageBody(pdu)
+	if err != nil {
+		return 0, &MirroredBGPMessage{BGPType: "malformed", Length: len(pdu), PDU: pdu, DecodeError: err.Error()}
+	}
+	m := &MirroredBGPMessage{
+		BGPType: bgp.MessageTypeName(t),
+		Length:  len(b) + 19,
+	}
+	if !p.mirrorParse[t] && t != bgp.RouteRefreshMessageType {
+		return t, m
+	}
+	if err := p.decodeMirroredMessage(m, t, pdu, b, addPath); err != nil {
+		return t, &MirroredBGPMessage{BGPType: m.BGPType, Length: m.Length, PDU: pdu, DecodeError: err.Error()}
+	}
+
+	return t, m
+}
+
+// decodeMirroredMessage decodes mirrored BGP message pdu of type t into m, b is the message following BGP message
+// header
+func (p *producer) decodeMirroredMessage(m *MirroredBGPMessage, t uint8, pdu, b []byte, addPath map[int]bool) error {
+	switch t {
+	case bgp.OpenMessageType:
+		// Open message is unmarshaled starting from BGP message length following the marker
+		o, err := bgp.UnmarshalBGPOpenMessage(pdu[16 : 19+len(b)])
+		if err != nil {
+			return err
+		}
+		m.Version = o.Version
+		m.MyASN = uint32(o.MyAS)
+		if asn, ok := o.Is4BytesASCapable(); ok {
+			m.MyASN = asn
+		}
+		m.HoldTime = int(o.HoldTime)
+		m.BGPID = net.IP(o.BGPID).String()
+		m.Capabilities = o.GetCapabilities()
+	case bgp.UpdateMessageType:
+		u, err := bgp.UnmarshalBGPUpdate(b)
+		if err != nil {
+			return err
+		}
+		m.BaseAttributes = u.BaseAttributes
+		pathID := addPath[bgp.NLRIMessageType(1, 1)]
+		if m.NLRI, err = ipv4Prefixes(u.NLRI, pathID); err != nil {
+			return err
+		}
+		if m.WithdrawnRoutes, err = ipv4Prefixes(u.WithdrawnRoutes, pathID); err != nil {
+			return err
+		}
+		for _, a := range u.PathAttributes {
+			if (a.AttributeType == bgp.MP_REACH_NLRI || a.AttributeType == bgp.MP_UNREACH_NLRI) && len(a.Attribute) >= 3 {
+				m.MPAddressFamilies = append(m.MPAddressFamilies, fmt.Sprintf("%d/%d", uint16(a.Attribute[0])<<8|uint16(a.Attribute[1]), a.Attribute[2]))
+			}
+		}
+	case bgp.NotificationMessageType:
+		n, err := bgp.UnmarshalBGPNotificationMessage(b)
+		if err != nil {
+			return err
+		}
+		m.ErrorCode = n.ErrorCode
+		m.ErrorSubcode = n.ErrorSubcode
+		m.Error = n.GetErrorCode()
+		m.Data = n.Data
+	case bgp.KeepaliveMessageType:
+		if len(b) != 0 {
+			return fmt.Errorf("invalid length %d of keepalive message body", len(b))
+		}
+	case bgp.RouteRefreshMessageType:
+		r, err := bgp.UnmarshalBGPRouteRefreshMessage(b)
+		if err != nil {
+			return err
+		}
+		m.AFI = r.AFI
+		m.SAFI = r.SAFI
+		m.Subtype = r.GetSubtype()
+		m.ORFs = r.ORFs
+	default:
+		return fmt.Errorf("unsupported type %d of mirrored BGP message", t)
+	}
+
+	return nil
+}
+
+// ipv4Prefixes returns IPv4 prefixes of NLRI or Withdrawn Routes of BGP Update message in prefix/length form
+func ipv4Prefixes(b []byte, pathID bool) ([]string, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	routes, err := base.UnmarshalRoutes(b, pathID)
+	if err != nil {
+		return nil, err
+	}
+	prefixes := make([]string, 0, len(routes))
+	for _, r := range routes {
+		a := make([]byte, 4)
+		copy(a, r.Prefix)
+		prefixes = append(prefixes, net.IP(a).String()+"/"+strconv.Itoa(int(r.Length)))
+	}
+
+	return prefixes, nil
+}
@@ -0,0 +1,132 @@
+package message
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestProduceRouteMirrorMessage(t *testing.T) {
+	marker := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	// BGP Update withdrawing 10.2.0.0/16 and announcing 10.1.1.0/24 with ORIGIN, empty AS_PATH and NEXT_HOP attributes
+	update := append(append([]byte{}, marker...), 0x00, 0x2c, 0x02, 0x00, 0x03, 0x10, 0x0a, 0x02, 0x00, 0x0e,
+		0x40, 0x01, 0x01, 0x00, 0x40, 0x02, 0x00, 0x40, 0x03, 0x04, 0x0a, 0x00, 0x00, 0x01,
+		0x18, 0x0a, 0x01, 0x01)
+	// Cease with subcode Administrative Shutdown
+	notification := append(append([]byte{}, marker...), 0x00, 0x15, 0x03, 0x06, 0x02)
+	keepalive := append(append([]byte{}, marker...), 0x00, 0x13, 0x04)
+	msg := bmp.Message{
+		PeerHeader: &bmp.PerPeerHeader{PeerAddress: make([]byte, 16), PeerTimestamp: make([]byte, 8)},
+		Payload:    &bmp.RouteMirror{Messages: [][]byte{update, notification, keepalive}},
+	}
+	tests := []struct {
+		name   string
+		parse  []string
+		expect []string
+	}{
+		{
+			name: "counting only",
+		},
+		{
+			name:   "update and notification",
+			parse:  []string{"update", "notification"},
+			expect: []string{"update", "notification"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pub := &testPublisher{msgs: make(map[int][]string)}
+			p := NewProducer(pub, false, nil, &MirrorConfig{Parse: tt.parse}, false).(*producer)
+			p.produceRouteMirrorMessage(msg)
+			var types []string
+			for _, s := range pub.msgs[bmp.MirroredMessageMsg] {
+				m := &MirroredMessage{}
+				if err := json.Unmarshal([]byte(s), m); err != nil {
+					t.Fatalf("failed to unmarshal mirrored message with error: %+v", err)
+				}
+				types = append(types, m.BGPType)
+				switch m.BGPType {
+				case "update":
+					if !reflect.DeepEqual(m.NLRI, []string{"10.1.1.0/24"}) ||
+						!reflect.DeepEqual(m.WithdrawnRoutes, []string{"10.2.0.0/16"}) ||
+						m.BaseAttributes == nil || m.BaseAttributes.Nexthop != "10.0.0.1" || m.Length != len(update) {
+						t.Errorf("unexpected mirrored update %s", s)
+					}
+				case "notification":
+					if m.Error != "cease" || m.ErrorSubcode != 2 {
+						t.Errorf("unexpected mirrored notification %s", s)
+					}
+				}
+			}
+			if !reflect.DeepEqual(types, tt.expect) {
+				t.Errorf("expected mirrored messages %v but got %v", tt.expect, types)
+			}
+			// Messages of types which are not decoded carry only their types and lengths
+			rm := &RouteMirror{}
+			if err := json.Unmarshal([]byte(pub.msgs[bmp.RouteMirroringMsg][0]), rm); err != nil {
+				t.Fatalf("failed to unmarshal route mirror message with error: %+v", err)
+			}
+			for _, m := range rm.Messages {
+				decoded := m.NLRI != nil || m.Error != ""
+				if want := m.BGPType != "keepalive" && len(tt.parse) != 0; decoded != want {
+					t.Errorf("expected %s message decoded %t but got %+v", m.BGPType, want, m)
+				}
+				if m.Length == 0 {
+					t.Errorf("expected length of %s message", m.BGPType)
+				}
+			}
+		})
+	}
+	if err := (&MirrorConfig{Parse: []string{"route_refresh"}}).Validate(); err == nil {
+		t.Errorf("expected route_refresh type to fail validation")
+	}
+}
+
+func TestRouteMirrorMessage(t *testing.T) {
+	marker := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	// Beginning of Route Refresh of IPv6 unicast
+	refresh := append(append([]byte{}, marker...), 0x00, 0x17, 0x05, 0x00, 0x02, 0x01, 0x01)
+	// Update with attributes length exceeding the message
+	errored := append(append([]byte{}, marker...), 0x00, 0x17, 0x02, 0x00, 0x00, 0x00, 0x10)
+	msg := bmp.Message{
+		PeerHeader: &bmp.PerPeerHeader{PeerAddress: make([]byte, 16), PeerTimestamp: make([]byte, 8)},
+		Payload: &bmp.RouteMirror{
+			Messages:    [][]byte{refresh, errored, marker},
+			Information: []uint16{bmp.MirroredErroredPDUCode},
+		},
+	}
+	pub := &testPublisher{msgs: make(map[int][]string)}
+	p := NewProducer(pub, false, nil, &MirrorConfig{Parse: []string{"update"}}, false).(*producer)
+	p.produceRouteMirrorMessage(msg)
+	if len(pub.msgs[bmp.RouteMirroringMsg]) != 1 {
+		t.Fatalf("expected 1 route mirror message but got %d", len(pub.msgs[bmp.RouteMirroringMsg]))
+	}
+	rm := &RouteMirror{}
+	if err := json.Unmarshal([]byte(pub.msgs[bmp.RouteMirroringMsg][0]), rm); err != nil {
+		t.Fatalf("failed to unmarshal route mirror message with error: %+v", err)
+	}
+	if !reflect.DeepEqual(rm.Information, []string{bmp.MirroredErroredPDU}) {
+		t.Errorf("expected errored pdu information but got %v", rm.Information)
+	}
+	if len(rm.Messages) != 3 {
+		t.Fatalf("expected 3 mirrored BGP messages but got %d", len(rm.Messages))
+	}
+	if m := rm.Messages[0]; m.BGPType != "route_refresh" || m.AFI != 2 || m.SAFI != 1 || m.Subtype != "borr" ||
+		m.DecodeError != "" || !reflect.DeepEqual(m.PDU, refresh) {
+		t.Errorf("expected decoded route refresh carrying errored pdu but got %+v", m)
+	}
+	if m := rm.Messages[1]; m.BGPType != "update" || m.DecodeError == "" || !reflect.DeepEqual(m.PDU, errored) {
+		t.Errorf("expected undecodable update carrying pdu but got %+v", m)
+	}
+	if m := rm.Messages[2]; m.BGPType != "malformed" || m.DecodeError == "" || m.Length != len(marker) {
+		t.Errorf("expected malformed message but got %+v", m)
+	}
+	if n := len(pub.msgs[bmp.MirroredMessageMsg]); n != 0 {
+		t.Errorf("expected undecodable update not to be published as mirrored message but got %d messages", n)
+	}
+	if n := len(pub.msgs[bmp.RouteRefreshMsg]); n != 0 {
+		t.Errorf("expected no route refresh messages of batch with malformed message but got %d", n)
+	}
+}
@@ -1,12 +1,17 @@
 package message
 
 import (
+	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
 	"github.com/golang/glog"
 	"github.com/sbezverk/gobmp/pkg/bgp"
 	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/events"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/tracing"
 )
 
 const (
@@ -30,9 +35,17 @@ func (p *producer) produceRouteMonitorMessage(msg bmp.Message) {
 		glog.Errorf("route monitor message is nil")
 		return
 	}
+	if routeMonitorMsg.RouteRefresh != nil {
+		p.produceRouteRefreshMessage(msg.Context, msg.PeerHeader, routeMonitorMsg.RouteRefresh, RouteRefreshMonitored)
+		return
+	}
 	if routeMonitorMsg.Update == nil {
 		return
 	}
+	if p.rawUpdates {
+		p.produceRawUpdateMessage(msg.Context, msg.PeerHeader, routeMonitorMsg)
+	}
+	addPath := p.routeMonitorAddPath(msg.PeerHeader, routeMonitorMsg)
 	attrType := uint8(0)
 	index := 0
 	if len(routeMonitorMsg.Update.PathAttributes) != 0 {
@@ -42,19 +55,24 @@ func (p *producer) produceRouteMonitorMessage(msg bmp.Message) {
 	// Using first attribute type to select which nlri processor to call
 	switch attrType {
 	case 14:
-		nlri, err := bgp.UnmarshalMPReachNLRI(routeMonitorMsg.Update.PathAttributes[index].Attribute, routeMonitorMsg.Update.HasPrefixSID(), p.addPathCapable)
+		nlri, err := bgp.UnmarshalMPReachNLRI(routeMonitorMsg.Update.PathAttributes[index].Attribute, routeMonitorMsg.Update.HasPrefixSID(), addPath)
 		if err != nil {
 			glog.Errorf("failed to process MP_REACH_NLRI with error: %+v", err)
+			events.Report(events.CodeNLRIDecodeError, p.speakerID().ip, "failed to process MP_REACH_NLRI with error: %+v", err)
+			return
 		}
-		p.processMPUpdate(nlri, AddPrefix, msg.PeerHeader, routeMonitorMsg.Update)
+		p.processMPUpdate(msg.Context, nlri, AddPrefix, msg.PeerHeader, routeMonitorMsg.Update, routeMonitorMsg)
 	case 15:
 		// MP_UNREACH_NLRI
-		nlri, err := bgp.UnmarshalMPUnReachNLRI(routeMonitorMsg.Update.PathAttributes[index].Attribute, p.addPathCapable)
+		nlri, err := bgp.UnmarshalMPUnReachNLRI(routeMonitorMsg.Update.PathAttributes[index].Attribute, addPath)
 		if err != nil {
 			glog.Errorf("failed to process MP_UNREACH_NLRI with error: %+v", err)
+			events.Report(events.CodeNLRIDecodeError, p.speakerID().ip, "failed to process MP_UNREACH_NLRI with error: %+v", err)
+			return
 		}
-		p.processMPUpdate(nlri, DelPrefix, msg.PeerHeader, routeMonitorMsg.Update)
+		p.processMPUpdate(msg.Context, nlri, DelPrefix, msg.PeerHeader, routeMonitorMsg.Update, routeMonitorMsg)
 	default:
+		trace := msg.Context
 		t := bmp.UnicastPrefixMsg
 		if p.splitAF {
 			t = bmp.UnicastPrefixV4Msg
@@ -62,22 +80,24 @@ func (p *producer) produceRouteMonitorMessage(msg bmp.Message) {
 		// Original BGP's NLRI messages processing
 		msgs := make([]*UnicastPrefix, 0)
 		if routeMonitorMsg.Update.WithdrawnRoutesLength != 0 {
-			msg, err := p.nlri(DelPrefix, msg.PeerHeader, routeMonitorMsg.Update)
+			msg, err := p.nlri(DelPrefix, msg.PeerHeader, routeMonitorMsg.Update, addPath)
 			if err != nil {
 				glog.Errorf("failed to produce original NLRI Withdraw message with error: %+v", err)
 				return
 			}
 			msgs = append(msgs, msg...)
 		}
-		msg, err := p.nlri(AddPrefix, msg.PeerHeader, routeMonitorMsg.Update)
+		msg, err := p.nlri(AddPrefix, msg.PeerHeader, routeMonitorMsg.Update, addPath)
 		if err != nil {
 			glog.Errorf("failed to produce original NLRI Withdraw message with error: %+v", err)
 			return
 		}
 		msgs = append(msgs, msg...)
+		// Withdrawn routes precede NLRI in BGP Update, so NLRI are indexed in the order of collected messages
+		addRouteMonitorTLVs(msgs, routeMonitorMsg)
 		// Loop through and publish all collected messages
 		for _, m := range msgs {
-			if err := p.marshalAndPublish(&m, t, []byte(m.RouterHash), false); err != nil {
+			if err := p.marshalAndPublish(trace, &m, t, []byte(m.RouterHash), false); err != nil {
 				glog.Errorf("failed to process Unicast Prefix message with error: %+v", err)
 				return
 			}
@@ -85,16 +105,70 @@ func (p *producer) produceRouteMonitorMessage(msg bmp.Message) {
 	}
 }
 
-func (p *producer) marshalAndPublish(msg interface{}, msgType int, hash []byte, debug bool) error {
-	j, err := json.Marshal(msg)
-	if err != nil {
-		return fmt.Errorf("failed to marshal a message of type %d with error: %+v", msgType, err)
+// addRouteMonitorTLVs sets the table name and TLVs applying to the route of BMP v4 Route Monitoring message
+// to unicast prefixes, prefixes are in the order of NLRI of BGP Update carried by the message
+func addRouteMonitorTLVs(prfxs []*UnicastPrefix, rm *bmp.RouteMonitor) {
+	if rm == nil || len(rm.TLVs) == 0 {
+		return
 	}
-	if err := p.publisher.PublishMessage(msgType, hash, j); err != nil {
-		return fmt.Errorf("failed to push a message of type %d to kafka with error: %+v", msgType, err)
+	table := rm.GetTableName()
+	index := 0
+	for _, prfx := range prfxs {
+		prfx.TableName = table
+		// End-of-RIB markers do not carry NLRI
+		if prfx.IsEOR {
+			continue
+		}
+		prfx.TLVs = routeMonitorTLVs(rm, index)
+		index++
+	}
+}
+
+// routeMonitorTable returns VRF/Table Name of VRF/Table Name TLV of BMP v4 Route Monitoring message, empty for
+// BMP v3 messages
+func routeMonitorTable(rm *bmp.RouteMonitor) string {
+	if rm == nil {
+		return ""
+	}
+
+	return rm.GetTableName()
+}
+
+// routeMonitorTLVs returns TLVs of BMP v4 Route Monitoring message applying to NLRI of the index, NLRI are indexed
+// in the order they are carried by BGP Update PDU
+func routeMonitorTLVs(rm *bmp.RouteMonitor, index int) []RouteMonitorTLV {
+	if rm == nil {
+		return nil
+	}
+	var tlvs []RouteMonitorTLV
+	for _, t := range rm.NLRITLVs(index) {
+		tlvs = append(tlvs, RouteMonitorTLV{
+			Type:  t.Type,
+			PEN:   t.PEN,
+			Value: hex.EncodeToString(t.Value),
+		})
+	}
+
+	return tlvs
+}
+
+// marshalAndPublish is the single point where messages produced from BMP messages are passed to the publisher,
+// the message is checked against the schema of its type in the message type registry. Publishing is traced as a child
+// of the span of trace.
+func (p *producer) marshalAndPublish(trace context.Context, msg interface{}, msgType int, hash []byte, debug bool) error {
+	if err := checkSchema(msg, msgType); err != nil {
+		return err
 	}
 	if debug {
+		j, err := json.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal a message of type %d with error: %+v", msgType, err)
+		}
 		glog.Infof("message of type: %+v json: %s", msgType, string(j))
 	}
+	// The message is encoded by the publisher, directly into its buffers when it supports it
+	if err := pub.PublishValue(tracing.NewPublisher(trace, p.publisher), msgType, hash, msg); err != nil {
+		return fmt.Errorf("failed to push a message of type %d to kafka with error: %+v", msgType, err)
+	}
 	return nil
 }
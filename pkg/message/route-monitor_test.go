@@ -0,0 +1,205 @@
+package message
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+type testValuePublisher struct {
+	testPublisher
+	values []interface{}
+}
+
+func (p *testValuePublisher) PublishValue(msgType int, msgHash []byte, v interface{}) error {
+	p.values = append(p.values, v)
+	return nil
+}
+
+func TestMarshalAndPublish(t *testing.T) {
+	msg := &UnicastPrefix{Prefix: "10.0.0.0", PrefixLen: 8}
+	tests := []struct {
+		name      string
+		publisher pub.Publisher
+		values    int
+		messages  int
+	}{
+		{
+			name:      "marshaled by producer",
+			publisher: &testPublisher{msgs: make(map[int][]string)},
+			messages:  1,
+		},
+		{
+			name:      "encoded by publisher",
+			publisher: &testValuePublisher{testPublisher: testPublisher{msgs: make(map[int][]string)}},
+			values:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &producer{publisher: tt.publisher}
+			if err := p.marshalAndPublish(nil, msg, bmp.UnicastPrefixMsg, nil, false); err != nil {
+				t.Fatalf("failed to publish message with error: %+v", err)
+			}
+			if err := p.marshalAndPublish(nil, &LSNode{}, bmp.UnicastPrefixMsg, nil, false); err == nil {
+				t.Errorf("expected error publishing message not matching the schema")
+			}
+			var values, messages int
+			switch tp := tt.publisher.(type) {
+			case *testValuePublisher:
+				values, messages = len(tp.values), len(tp.msgs[bmp.UnicastPrefixMsg])
+			case *testPublisher:
+				messages = len(tp.msgs[bmp.UnicastPrefixMsg])
+			}
+			if values != tt.values || messages != tt.messages {
+				t.Errorf("expected %d values and %d messages but got %d and %d", tt.values, tt.messages, values, messages)
+			}
+		})
+	}
+}
+
+func TestAddRouteMonitorTLVs(t *testing.T) {
+	rm := &bmp.RouteMonitor{
+		TLVs: []bmp.TLV{
+			{Type: bmp.VRFTableNameTLV, Value: []byte("red")},
+			{Type: 10, Index: 1, Value: []byte{0x01, 0x02}},
+			{Type: 5, Enterprise: true, PEN: 9, Index: 0, Value: []byte{0xab}},
+		},
+	}
+	prfxs := []*UnicastPrefix{{Prefix: "10.1.1.0"}, {Prefix: "10.1.2.0"}}
+	addRouteMonitorTLVs(prfxs, rm)
+	if prfxs[0].TableName != "red" || prfxs[1].TableName != "red" {
+		t.Errorf("expected table name red but got %q and %q", prfxs[0].TableName, prfxs[1].TableName)
+	}
+	if len(prfxs[0].TLVs) != 1 || prfxs[0].TLVs[0] != (RouteMonitorTLV{Type: 5, PEN: 9, Value: "ab"}) {
+		t.Errorf("unexpected TLVs %+v of the first prefix", prfxs[0].TLVs)
+	}
+	if len(prfxs[1].TLVs) != 1 || prfxs[1].TLVs[0] != (RouteMonitorTLV{Type: 10, Value: "0102"}) {
+		t.Errorf("unexpected TLVs %+v of the second prefix", prfxs[1].TLVs)
+	}
+	// Prefixes of BMP v3 messages are unchanged
+	v3 := []*UnicastPrefix{{Prefix: "10.1.1.0"}}
+	addRouteMonitorTLVs(v3, &bmp.RouteMonitor{})
+	if v3[0].TableName != "" || v3[0].TLVs != nil {
+		t.Errorf("expected prefix of BMP v3 message without TLVs but got %+v", v3[0])
+	}
+}
+
+func TestRouteMonitorTLVsOfMPNLRI(t *testing.T) {
+	// AFI 1 SAFI 5, next hop 10.0.0.1, S-PMSI A-D route and Leaf A-D route responding to it
+	mp, err := bgp.UnmarshalMPReachNLRI([]byte{
+		0x00, 0x01, 0x05, 0x04, 0x0a, 0x00, 0x00, 0x01, 0x00,
+		0x03, 0x16, 0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64, 0x20, 0xc0, 0xa8, 0x01, 0x01, 0x20, 0xe8, 0x01, 0x01, 0x01,
+		0x0a, 0x00, 0x00, 0x01,
+		0x04, 0x1c, 0x03, 0x16, 0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64, 0x20, 0xc0, 0xa8, 0x01, 0x01, 0x20, 0xe8, 0x01,
+		0x01, 0x01, 0x0a, 0x00, 0x00, 0x01, 0x0a, 0x00, 0x00, 0x02,
+	}, false, map[int]bool{})
+	if err != nil {
+		t.Fatalf("failed to unmarshal MP_REACH_NLRI with error: %+v", err)
+	}
+	rm := &bmp.RouteMonitor{
+		TLVs: []bmp.TLV{
+			{Type: bmp.VRFTableNameTLV, Value: []byte("red")},
+			{Type: 10, Index: 1, Value: []byte{0x01}},
+		},
+	}
+	pub := &testPublisher{msgs: make(map[int][]string)}
+	p := &producer{publisher: pub}
+	p.speaker.Store(&speaker{hash: "hash"})
+	ph := &bmp.PerPeerHeader{PeerAddress: make([]byte, 16), PeerTimestamp: make([]byte, 8), PeerDistinguisher: make([]byte, 8)}
+	p.processMPUpdate(nil, mp, AddPrefix, ph, &bgp.Update{BaseAttributes: &bgp.BaseAttributes{}}, rm)
+	got := pub.msgs[bmp.MVPNMsg]
+	if len(got) != 2 {
+		t.Fatalf("expected 2 mvpn messages but got %v", got)
+	}
+	tests := []struct {
+		name   string
+		expect []RouteMonitorTLV
+	}{
+		{
+			name: "s-pmsi a-d route",
+		},
+		{
+			name:   "leaf a-d route",
+			expect: []RouteMonitorTLV{{Type: 10, Value: "01"}},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m MVPN
+			if err := json.Unmarshal([]byte(got[i]), &m); err != nil {
+				t.Fatalf("failed to unmarshal mvpn message with error: %+v", err)
+			}
+			if m.TableName != "red" {
+				t.Errorf("expected table name red but got %q", m.TableName)
+			}
+			if !reflect.DeepEqual(m.TLVs, tt.expect) {
+				t.Errorf("expected TLVs %+v but got %+v", tt.expect, m.TLVs)
+			}
+		})
+	}
+}
+
+func TestRawUpdateMessage(t *testing.T) {
+	// BGP Update with ORIGIN, empty AS_PATH and NEXT_HOP attributes and NLRI 10.1.1.0/24
+	pdu := []byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x29, 0x02, 0x00, 0x00, 0x00, 0x0e,
+		0x40, 0x01, 0x01, 0x00, 0x40, 0x02, 0x00, 0x40, 0x03, 0x04, 0x0a, 0x00, 0x00, 0x01,
+		0x18, 0x0a, 0x01, 0x01,
+	}
+	ph := &bmp.PerPeerHeader{
+		PeerDistinguisher: make([]byte, 8),
+		PeerAddress:       make([]byte, 16),
+		PeerAS:            65001,
+		PeerBGPID:         []byte{192, 168, 1, 1},
+		PeerTimestamp:     make([]byte, 8),
+	}
+	tests := []struct {
+		name       string
+		rawUpdates bool
+		expectRaw  int
+	}{
+		{
+			name:       "raw updates enabled",
+			rawUpdates: true,
+			expectRaw:  1,
+		},
+		{
+			name: "raw updates disabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm, err := bmp.UnmarshalBMPRouteMonitorMessage(pdu)
+			if err != nil {
+				t.Fatalf("failed to unmarshal route monitor message with error: %+v", err)
+			}
+			pub := &testPublisher{msgs: make(map[int][]string)}
+			p := NewProducer(pub, true, nil, nil, tt.rawUpdates).(*producer)
+			p.produceRouteMonitorMessage(bmp.Message{PeerHeader: ph, Payload: rm})
+			if n := len(pub.msgs[bmp.UnicastPrefixV4Msg]); n != 1 {
+				t.Errorf("expected 1 unicast prefix message but got %d", n)
+			}
+			raws := pub.msgs[bmp.RawUpdateMsg]
+			if len(raws) != tt.expectRaw {
+				t.Fatalf("expected %d raw update messages but got %d", tt.expectRaw, len(raws))
+			}
+			if tt.expectRaw == 0 {
+				return
+			}
+			m := &RawUpdate{}
+			if err := json.Unmarshal([]byte(raws[0]), m); err != nil {
+				t.Fatalf("failed to unmarshal raw update message with error: %+v", err)
+			}
+			if !bytes.Equal(m.PDU, pdu) || m.PeerIP != "0.0.0.0" || m.PeerASN != 65001 || m.PeerHash != ph.GetPeerHash() {
+				t.Errorf("unexpected raw update message %s", raws[0])
+			}
+		})
+	}
+}
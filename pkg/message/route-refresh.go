@@ -0,0 +1,42 @@
+package message
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// Sources of route_refresh messages
+const (
+	// RouteRefreshMonitored is the source of Route Refresh carried by BMP Route Monitoring message
+	RouteRefreshMonitored = "route_monitor"
+	// RouteRefreshMirrored is the source of Route Refresh carried by BMP Route Mirroring message
+	RouteRefreshMirrored = "route_mirror"
+)
+
+func (p *producer) produceRouteRefreshMessage(trace context.Context, ph *bmp.PerPeerHeader, r *bgp.RouteRefresh, source string) {
+	m := RouteRefresh{
+		RouterHash:         p.speakerID().hash,
+		RouterIP:           p.speakerID().ip,
+		PeerHash:           ph.GetPeerHash(),
+		PeerIP:             ph.GetPeerAddrString(),
+		PeerType:           uint8(ph.PeerType),
+		PeerRD:             ph.GetPeerDistinguisherString(),
+		PeerASN:            ph.PeerAS,
+		Timestamp:          p.timestamp(ph),
+		CollectorTimestamp: p.collectorTimestamp(ph),
+		AFI:                r.AFI,
+		SAFI:               r.SAFI,
+		Subtype:            r.GetSubtype(),
+		ORFs:               r.ORFs,
+		Source:             source,
+		IsAdjRIBOut:        ph.IsAdjRIBOut(),
+		IsPostPolicy:       ph.IsPostPolicy(),
+		RIBType:            ph.GetRIBType(),
+	}
+	if err := p.marshalAndPublish(trace, &m, bmp.RouteRefreshMsg, []byte(m.RouterHash), false); err != nil {
+		glog.Errorf("failed to process Route Refresh message with error: %+v", err)
+	}
+}
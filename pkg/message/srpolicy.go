@@ -10,11 +10,7 @@ import (
 
 // evpn process MP_REACH_NLRI AFI 25 SAFI 70 update message and returns
 // EVPN prefix object.
-func (p *producer) srpolicy(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]*SRPolicy, error) {
-	sr, err := nlri.GetNLRI73()
-	if err != nil {
-		return nil, err
-	}
+func (p *producer) srpolicy(sr *srpolicy.NLRI73, nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *bgp.Update) ([]*SRPolicy, error) {
 	var operation string
 	switch op {
 	case 0:
@@ -25,15 +21,17 @@ func (p *producer) srpolicy(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, upda
 		return nil, fmt.Errorf("unknown operation %d", op)
 	}
 	prfx := SRPolicy{
-		Action:         operation,
-		RouterHash:     p.speakerHash,
-		RouterIP:       p.speakerIP,
-		PeerType:       uint8(ph.PeerType),
-		PeerHash:       ph.GetPeerHash(),
-		PeerASN:        ph.PeerAS,
-		Timestamp:      ph.GetPeerTimestamp(),
-		Nexthop:        nlri.GetNextHop(),
-		BaseAttributes: update.BaseAttributes,
+		Action:             operation,
+		RouterHash:         p.speakerID().hash,
+		RouterIP:           p.speakerID().ip,
+		PeerType:           uint8(ph.PeerType),
+		PeerRD:             ph.GetPeerDistinguisherString(),
+		PeerHash:           ph.GetPeerHash(),
+		PeerASN:            ph.PeerAS,
+		Timestamp:          p.timestamp(ph),
+		CollectorTimestamp: p.collectorTimestamp(ph),
+		Nexthop:            nlri.GetNextHop(),
+		BaseAttributes:     update.BaseAttributes,
 	}
 	if f, err := ph.IsAdjRIBInPost(); err == nil {
 		prfx.IsAdjRIBInPost = f
@@ -41,9 +39,12 @@ func (p *producer) srpolicy(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, upda
 	if f, err := ph.IsAdjRIBOutPost(); err == nil {
 		prfx.IsAdjRIBOutPost = f
 	}
+	prfx.IsAdjRIBOut = ph.IsAdjRIBOut()
+	prfx.IsPostPolicy = ph.IsPostPolicy()
 	if f, err := ph.IsLocRIBFiltered(); err == nil {
 		prfx.IsLocRIBFiltered = f
 	}
+	prfx.RIBType = ph.GetRIBType()
 	if ases := update.BaseAttributes.ASPath; len(ases) != 0 {
 		// Last element in AS_PATH would be the AS of the origin
 		prfx.OriginAS = int32(ases[len(ases)-1])
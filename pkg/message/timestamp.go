@@ -0,0 +1,128 @@
+package message
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+const (
+	// TimestampPeer selects Peer Timestamp of Per-Peer Header as the timestamp of messages
+	TimestampPeer = "peer"
+	// TimestampCollector selects the time the BMP message was received by the collector as the timestamp of messages
+	TimestampCollector = "collector"
+	// TimestampBoth selects Peer Timestamp of Per-Peer Header as the timestamp of messages and adds the time
+	// the BMP message was received by the collector as collector_timestamp
+	TimestampBoth = "both"
+)
+
+// TimestampConfig defines the source of messages timestamps, when MaxSkew is not 0, a warning is logged when
+// Peer Timestamp of a peer differs from the time the message was received by more than MaxSkew. When ZeroCollector
+// is true, Peer Timestamp of 0, meaning the time is not available, RFC 7854, is replaced by the receive time.
+type TimestampConfig struct {
+	Source        string
+	MaxSkew       time.Duration
+	ZeroCollector bool
+}
+
+// Validate returns error if the timestamp source is not supported
+func (c *TimestampConfig) Validate() error {
+	switch c.Source {
+	case TimestampPeer, TimestampCollector, TimestampBoth:
+	default:
+		return fmt.Errorf("invalid timestamp source %q, supported sources are %q, %q and %q", c.Source,
+			TimestampPeer, TimestampCollector, TimestampBoth)
+	}
+	if c.MaxSkew < 0 {
+		return fmt.Errorf("invalid maximum timestamp skew %s", c.MaxSkew)
+	}
+
+	return nil
+}
+
+type timestamps struct {
+	sync.Mutex
+	source        string
+	maxSkew       time.Duration
+	zeroCollector bool
+	// skewed stores peers with Peer Timestamp skewed by more than maxSkew
+	skewed map[string]bool
+}
+
+func newTimestamps(c *TimestampConfig) *timestamps {
+	if c == nil {
+		c = &TimestampConfig{Source: TimestampPeer}
+	}
+	return &timestamps{
+		source:        c.Source,
+		maxSkew:       c.MaxSkew,
+		zeroCollector: c.ZeroCollector,
+		skewed:        make(map[string]bool),
+	}
+}
+
+func (p *producer) timestampSource() string {
+	if p.timestamps == nil {
+		return TimestampPeer
+	}
+
+	return p.timestamps.source
+}
+
+// timestamp returns the timestamp of messages produced from BMP message with the Per-Peer Header,
+// Peer Timestamp of 0 is kept unless it is replaced by the receive time as configured
+func (p *producer) timestamp(ph *bmp.PerPeerHeader) string {
+	if ph.ReceivedAt.IsZero() {
+		return ph.GetPeerTimestamp()
+	}
+	if p.timestampSource() == TimestampCollector ||
+		p.timestamps != nil && p.timestamps.zeroCollector && ph.GetPeerTime().Unix() == 0 {
+		return ph.ReceivedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	return ph.GetPeerTimestamp()
+}
+
+// collectorTimestamp returns the time the BMP message with the Per-Peer Header was received when
+// both timestamps are selected
+func (p *producer) collectorTimestamp(ph *bmp.PerPeerHeader) string {
+	if p.timestampSource() != TimestampBoth || ph.ReceivedAt.IsZero() {
+		return ""
+	}
+
+	return ph.ReceivedAt.UTC().Format(time.RFC3339Nano)
+}
+
+// checkSkew logs when Peer Timestamp of the peer starts or stops differing from the receive time by more than
+// the maximum skew
+func (p *producer) checkSkew(ph *bmp.PerPeerHeader) {
+	if p.timestamps == nil || p.timestamps.maxSkew == 0 || ph == nil || ph.ReceivedAt.IsZero() {
+		return
+	}
+	pt := ph.GetPeerTime()
+	if pt.Unix() == 0 {
+		return
+	}
+	skew := pt.Sub(ph.ReceivedAt)
+	if skew < 0 {
+		skew = -skew
+	}
+	skewed := skew > p.timestamps.maxSkew
+	peer := ph.GetPeerDistinguisherString() + " " + ph.GetPeerAddrString()
+	p.timestamps.Lock()
+	defer p.timestamps.Unlock()
+	if p.timestamps.skewed[peer] == skewed {
+		return
+	}
+	if skewed {
+		p.timestamps.skewed[peer] = true
+		glog.Warningf("router %s peer %s timestamp %s is skewed by %s from the collector receive time %s",
+			p.speakerID().ip, ph.GetPeerAddrString(), pt.Format(time.RFC3339Nano), skew, ph.ReceivedAt.UTC().Format(time.RFC3339Nano))
+		return
+	}
+	delete(p.timestamps.skewed, peer)
+	glog.Infof("router %s peer %s timestamp is no longer skewed from the collector receive time", p.speakerID().ip, ph.GetPeerAddrString())
+}
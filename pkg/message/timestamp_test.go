@@ -0,0 +1,113 @@
+package message
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestTimestamp(t *testing.T) {
+	received := time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)
+	header := func(peer time.Time, received time.Time) *bmp.PerPeerHeader {
+		ts := make([]byte, 8)
+		if !peer.IsZero() {
+			binary.BigEndian.PutUint32(ts, uint32(peer.Unix()))
+		}
+		return &bmp.PerPeerHeader{PeerTimestamp: ts, ReceivedAt: received}
+	}
+	tests := []struct {
+		name      string
+		config    *TimestampConfig
+		header    *bmp.PerPeerHeader
+		timestamp string
+		collector string
+	}{
+		{
+			name:      "default",
+			header:    header(received.Add(-time.Hour), received),
+			timestamp: "2026-10-14T09:00:00Z",
+		},
+		{
+			name:      "peer",
+			config:    &TimestampConfig{Source: TimestampPeer},
+			header:    header(received.Add(-time.Hour), received),
+			timestamp: "2026-10-14T09:00:00Z",
+		},
+		{
+			name:      "collector",
+			config:    &TimestampConfig{Source: TimestampCollector},
+			header:    header(received.Add(-time.Hour), received),
+			timestamp: "2026-10-14T10:00:00Z",
+		},
+		{
+			name:      "both",
+			config:    &TimestampConfig{Source: TimestampBoth},
+			header:    header(received.Add(-time.Hour), received),
+			timestamp: "2026-10-14T09:00:00Z",
+			collector: "2026-10-14T10:00:00Z",
+		},
+		{
+			name:      "peer timestamp not available",
+			config:    &TimestampConfig{Source: TimestampPeer},
+			header:    header(time.Time{}, received),
+			timestamp: "1970-01-01T00:00:00Z",
+		},
+		{
+			name:      "peer timestamp not available by default",
+			header:    header(time.Time{}, received),
+			timestamp: "1970-01-01T00:00:00Z",
+		},
+		{
+			name:      "peer timestamp not available replaced by the receive time",
+			config:    &TimestampConfig{Source: TimestampPeer, ZeroCollector: true},
+			header:    header(time.Time{}, received),
+			timestamp: "2026-10-14T10:00:00Z",
+		},
+		{
+			name:      "both with peer timestamp not available replaced by the receive time",
+			config:    &TimestampConfig{Source: TimestampBoth, ZeroCollector: true},
+			header:    header(time.Time{}, received),
+			timestamp: "2026-10-14T10:00:00Z",
+			collector: "2026-10-14T10:00:00Z",
+		},
+		{
+			name:      "receive time not available",
+			config:    &TimestampConfig{Source: TimestampBoth},
+			header:    header(received.Add(-time.Hour), time.Time{}),
+			timestamp: "2026-10-14T09:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &producer{timestamps: newTimestamps(tt.config)}
+			if tt.config == nil {
+				p.timestamps = nil
+			}
+			if got := p.timestamp(tt.header); got != tt.timestamp {
+				t.Errorf("expected timestamp %s but got %s", tt.timestamp, got)
+			}
+			if got := p.collectorTimestamp(tt.header); got != tt.collector {
+				t.Errorf("expected collector timestamp %q but got %q", tt.collector, got)
+			}
+		})
+	}
+}
+
+func TestCheckSkew(t *testing.T) {
+	received := time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)
+	p := &producer{timestamps: newTimestamps(&TimestampConfig{Source: TimestampPeer, MaxSkew: time.Minute})}
+	for _, s := range []struct {
+		skew   time.Duration
+		skewed bool
+	}{{time.Second, false}, {-time.Hour, true}, {time.Hour, true}, {0, false}} {
+		ts := make([]byte, 8)
+		binary.BigEndian.PutUint32(ts, uint32(received.Add(s.skew).Unix()))
+		ph := &bmp.PerPeerHeader{PeerAddress: make([]byte, 16), PeerTimestamp: ts, ReceivedAt: received}
+		p.checkSkew(ph)
+		if skewed := len(p.timestamps.skewed) != 0; skewed != s.skewed {
+			t.Errorf("skew %s: expected skewed %t but got %t", s.skew, s.skewed, skewed)
+		}
+	}
+}
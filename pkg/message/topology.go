@@ -0,0 +1,118 @@
+package message
+
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+
+	"github.com/sbezverk/gobmp/pkg/base"
+	"github.com/sbezverk/gobmp/pkg/bgpls"
+	"github.com/sbezverk/gobmp/pkg/memory"
+)
+
+type topologyNode struct {
+	name string
+	// metricStyle is IS-IS metric style (narrow or wide) learned from IGP Metric TLV of the node's links
+	metricStyle string
+}
+
+// topology stores the attributes of BGP-LS nodes learned from LS Node and LS Link NLRIs, the attributes
+// are used to enrich the messages of other NLRI types referring to the same nodes.
+type topology struct {
+	sync.RWMutex
+	nodes map[string]*topologyNode
+}
+
+func newTopology() *topology {
+	return &topology{
+		nodes: make(map[string]*topologyNode),
+	}
+}
+
+// topologyNodeKey builds the key of the node described by the Node Descriptor in the given BGP-LS domain.
+func topologyNodeKey(protocolID base.ProtoID, domainID int64, nd *base.NodeDescriptor) string {
+	if nd == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d_%d_%s", protocolID, domainID, nd.GetNodeKey())
+}
+
+func (t *topology) getNode(key string) *topologyNode {
+	n, ok := t.nodes[key]
+	if !ok {
+		n = &topologyNode{}
+		t.nodes[key] = n
+	}
+	return n
+}
+
+// updateNode stores the name of the node from LS Node message, when the node gets withdrawn
+// all known attributes of the node are removed.
+func (t *topology) updateNode(key string, node *LSNode) {
+	if key == "" {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	if node.Action == "del" {
+		delete(t.nodes, key)
+		return
+	}
+	t.getNode(key).name = node.Name
+}
+
+// size returns the number of nodes and estimated memory used by them
+func (t *topology) size() (int, uint64) {
+	t.RLock()
+	defer t.RUnlock()
+	var b uint64
+	for key, n := range t.nodes {
+		b += uint64(unsafe.Sizeof(key)+unsafe.Sizeof(n)+unsafe.Sizeof(*n)) + uint64(len(key)+len(n.name)+len(n.metricStyle)) + memory.MapEntryOverhead
+	}
+
+	return len(t.nodes), b
+}
+
+// nodeName returns the name of the node, empty string is returned if the node's name is not known.
+func (t *topology) nodeName(key string) string {
+	t.RLock()
+	defer t.RUnlock()
+	if n, ok := t.nodes[key]; ok {
+		return n.name
+	}
+	return ""
+}
+
+func (t *topology) learnMetricStyle(key string, metricType string) {
+	if key == "" || (metricType != bgpls.MetricTypeISISNarrow && metricType != bgpls.MetricTypeISISWide) {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	t.getNode(key).metricStyle = metricType
+}
+
+// prefixMetricType returns metric type of the LS Prefix, for OSPF the type is derived from
+// OSPF Route Type, for IS-IS metrics exceeding narrow range are always wide, otherwise the metric style
+// learned from the originating node's links is used. Empty string is returned when the type cannot be determined.
+func (t *topology) prefixMetricType(key string, prfx *LSPrefix) string {
+	switch prfx.ProtocolID {
+	case base.OSPFv2:
+		fallthrough
+	case base.OSPFv3:
+		return bgpls.OSPFMetricType(prfx.OSPFRouteType)
+	case base.ISISL1:
+		fallthrough
+	case base.ISISL2:
+		if prfx.PrefixMetric > bgpls.ISISNarrowMetricMax {
+			return bgpls.MetricTypeISISWide
+		}
+		t.RLock()
+		defer t.RUnlock()
+		if n, ok := t.nodes[key]; ok {
+			return n.metricStyle
+		}
+	}
+
+	return ""
+}
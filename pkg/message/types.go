@@ -17,76 +17,116 @@ import (
 
 // PeerStateChange defines a message format sent to as a result of BMP Peer Up or Peer Down message
 type PeerStateChange struct {
-	Key             string         `json:"_key,omitempty"`
-	ID              string         `json:"_id,omitempty"`
-	Rev             string         `json:"_rev,omitempty"`
-	Action          string         `json:"action,omitempty"` // Action can be "add" for peer up and "del" for peer down message
-	Sequence        int            `json:"sequence,omitempty"`
-	Hash            string         `json:"hash,omitempty"`
-	RouterHash      string         `json:"router_hash,omitempty"`
-	Name            string         `json:"name,omitempty"`
-	RemoteBGPID     string         `json:"remote_bgp_id,omitempty"`
-	RouterIP        string         `json:"router_ip,omitempty"`
-	Timestamp       string         `json:"timestamp,omitempty"`
-	RemoteASN       uint32         `json:"remote_asn,omitempty"`
-	RemoteIP        string         `json:"remote_ip,omitempty"`
-	PeerType        uint8          `json:"peer_type"`
-	PeerRD          string         `json:"peer_rd,omitempty"`
-	RemotePort      int            `json:"remote_port,omitempty"`
-	LocalASN        uint32         `json:"local_asn,omitempty"`
-	LocalIP         string         `json:"local_ip,omitempty"`
-	LocalPort       int            `json:"local_port,omitempty"`
-	LocalBGPID      string         `json:"local_bgp_id,omitempty"`
-	InfoData        []byte         `json:"info_data,omitempty"`
-	AdvCapabilities bgp.Capability `json:"adv_cap,omitempty"`
-	RcvCapabilities bgp.Capability `json:"recv_cap,omitempty"`
-	RemoteHolddown  int            `json:"remote_holddown,omitempty"`
-	AdvHolddown     int            `json:"adv_holddown,omitempty"`
-	BMPReason       int            `json:"bmp_reason,omitempty"`
-	BMPErrorCode    int            `json:"bmp_error_code,omitempty"`
-	BMPErrorSubCode int            `json:"bmp_error_sub_code,omitempty"`
-	ErrorText       string         `json:"error_text,omitempty"`
-	IsL3VPN         bool           `json:"is_l"`
-	IsPrepolicy     bool           `json:"is_prepolicy"`
-	IsIPv4          bool           `json:"is_ipv4"`
-	TableName       string         `json:"table_name,omitempty"`
+	Key                string         `json:"_key,omitempty"`
+	ID                 string         `json:"_id,omitempty"`
+	Rev                string         `json:"_rev,omitempty"`
+	Action             string         `json:"action,omitempty"` // Action can be "add" for peer up and "del" for peer down message
+	Sequence           int            `json:"sequence,omitempty"`
+	Hash               string         `json:"hash,omitempty"`
+	RouterHash         string         `json:"router_hash,omitempty"`
+	Name               string         `json:"name,omitempty"`
+	RemoteBGPID        string         `json:"remote_bgp_id,omitempty"`
+	RouterIP           string         `json:"router_ip,omitempty"`
+	Timestamp          string         `json:"timestamp,omitempty"`
+	CollectorTimestamp string         `json:"collector_timestamp,omitempty"`
+	RemoteASN          uint32         `json:"remote_asn,omitempty"`
+	RemoteIP           string         `json:"remote_ip,omitempty"`
+	PeerType           uint8          `json:"peer_type"`
+	PeerRD             string         `json:"peer_rd,omitempty"`
+	RemotePort         int            `json:"remote_port,omitempty"`
+	LocalASN           uint32         `json:"local_asn,omitempty"`
+	LocalIP            string         `json:"local_ip,omitempty"`
+	LocalPort          int            `json:"local_port,omitempty"`
+	LocalBGPID         string         `json:"local_bgp_id,omitempty"`
+	InfoData           []byte         `json:"info_data,omitempty"`
+	AdvCapabilities    bgp.Capability `json:"adv_cap,omitempty"`
+	RcvCapabilities    bgp.Capability `json:"recv_cap,omitempty"`
+	CapMismatches      []string       `json:"cap_mismatch,omitempty"`
+	RemoteHolddown     int            `json:"remote_holddown,omitempty"`
+	AdvHolddown        int            `json:"adv_holddown,omitempty"`
+	BMPReason          int            `json:"bmp_reason,omitempty"`
+	BMPErrorCode       int            `json:"bmp_error_code,omitempty"`
+	BMPErrorSubCode    int            `json:"bmp_error_sub_code,omitempty"`
+	ErrorText          string         `json:"error_text,omitempty"`
+	IsL3VPN            bool           `json:"is_l"`
+	IsPrepolicy        bool           `json:"is_prepolicy"`
+	IsIPv4             bool           `json:"is_ipv4"`
+	TableName          string         `json:"table_name,omitempty"`
 	// Values are assigned based on PerPeerHeader flas
 	IsAdjRIBInPost   bool `json:"is_adj_rib_in_post_policy"`
 	IsAdjRIBOutPost  bool `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool `json:"is_adj_rib_out"`
+	IsPostPolicy     bool `json:"is_post_policy"`
 	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+	// RIBType is "loc-rib" for Loc-RIB instance peers, TableName is VRF/Table Name of the instance
+	RIBType string `json:"rib_type,omitempty"`
+	// AddPath lists ADD-PATH modes negotiated by the session by AFI/SAFI, "receive" when routes received from
+	// the peer carry Path Identifier, "send" when routes sent to the peer do
+	AddPath map[string]string `json:"add_path,omitempty"`
+	// ExtendedMessage is true when both speakers advertised Extended Message capability, RFC 8654, and BGP
+	// messages of the session may exceed 4096 bytes
+	ExtendedMessage bool `json:"extended_message,omitempty"`
+	// LocalRole and RemoteRole are BGP Roles, RFC 9234, advertised by the local and the remote speakers,
+	// RoleMismatch is true when the roles do not form an allowed pair, for example provider and peer
+	LocalRole    string `json:"local_role,omitempty"`
+	RemoteRole   string `json:"remote_role,omitempty"`
+	RoleMismatch bool   `json:"role_mismatch,omitempty"`
 }
 
 // UnicastPrefix defines a message format sent as a result of BMP Route Monitor message
 // which carries BGP Update with original NLRI information.
 type UnicastPrefix struct {
-	Key            string              `json:"_key,omitempty"`
-	ID             string              `json:"_id,omitempty"`
-	Rev            string              `json:"_rev,omitempty"`
-	Action         string              `json:"action,omitempty"` // Action can be "add" or "del"
-	Sequence       int                 `json:"sequence,omitempty"`
-	Hash           string              `json:"hash,omitempty"`
-	RouterHash     string              `json:"router_hash,omitempty"`
-	RouterIP       string              `json:"router_ip,omitempty"`
-	BaseAttributes *bgp.BaseAttributes `json:"base_attrs,omitempty"`
-	PeerHash       string              `json:"peer_hash,omitempty"`
-	PeerIP         string              `json:"peer_ip,omitempty"`
-	PeerType       uint8               `json:"peer_type"`
-	PeerASN        uint32              `json:"peer_asn,omitempty"`
-	Timestamp      string              `json:"timestamp,omitempty"`
-	Prefix         string              `json:"prefix,omitempty"`
-	PrefixLen      int32               `json:"prefix_len,omitempty"`
-	IsIPv4         bool                `json:"is_ipv4"`
-	OriginAS       int32               `json:"origin_as,omitempty"`
-	Nexthop        string              `json:"nexthop,omitempty"`
-	IsNexthopIPv4  bool                `json:"is_nexthop_ipv4"`
-	PathID         int32               `json:"path_id,omitempty"`
-	Labels         []uint32            `json:"labels,omitempty"`
-	PrefixSID      *prefixsid.PSid     `json:"prefix_sid,omitempty"`
-	IsEOR          bool                `json:"is_eor,omitempty"`
+	Key                string              `json:"_key,omitempty"`
+	ID                 string              `json:"_id,omitempty"`
+	Rev                string              `json:"_rev,omitempty"`
+	Action             string              `json:"action,omitempty"` // Action can be "add" or "del"
+	Sequence           int                 `json:"sequence,omitempty"`
+	Hash               string              `json:"hash,omitempty"`
+	RouterHash         string              `json:"router_hash,omitempty"`
+	RouterIP           string              `json:"router_ip,omitempty"`
+	BaseAttributes     *bgp.BaseAttributes `json:"base_attrs,omitempty"`
+	PeerHash           string              `json:"peer_hash,omitempty"`
+	PeerIP             string              `json:"peer_ip,omitempty"`
+	PeerType           uint8               `json:"peer_type"`
+	PeerRD             string              `json:"peer_rd,omitempty"`
+	PeerASN            uint32              `json:"peer_asn,omitempty"`
+	Timestamp          string              `json:"timestamp,omitempty"`
+	CollectorTimestamp string              `json:"collector_timestamp,omitempty"`
+	Prefix             string              `json:"prefix,omitempty"`
+	PrefixLen          int32               `json:"prefix_len,omitempty"`
+	IsIPv4             bool                `json:"is_ipv4"`
+	OriginAS           int32               `json:"origin_as,omitempty"`
+	Nexthop            string              `json:"nexthop,omitempty"`
+	IsNexthopIPv4      bool                `json:"is_nexthop_ipv4"`
+	PathID             int32               `json:"path_id,omitempty"`
+	Labels             []uint32            `json:"labels,omitempty"`
+	PrefixSID          *prefixsid.PSid     `json:"prefix_sid,omitempty"`
+	IsEOR              bool                `json:"is_eor,omitempty"`
+	// SRv6SIDs are SIDs of SRv6 L3 Service of prefix_sid of routes over SRv6, SRv6ServiceSIDs carry the SIDs with
+	// their Endpoint Behavior and SID Structure
+	SRv6SIDs        []string           `json:"srv6_sids,omitempty"`
+	SRv6ServiceSIDs []*srv6.ServiceSID `json:"srv6_service_sids,omitempty"`
+	// IsLLGRStale is true for routes carrying LLGR_STALE community, retained by Long-Lived Graceful Restart
+	IsLLGRStale bool `json:"is_llgr_stale,omitempty"`
 	// Values are assigned based on PerPeerHeader flags
-	IsAdjRIBInPost   bool `json:"is_adj_rib_in_post_policy"`
-	IsAdjRIBOutPost  bool `json:"is_adj_rib_out_post_policy"`
-	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool   `json:"is_adj_rib_out"`
+	IsPostPolicy     bool   `json:"is_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+	RIBType          string `json:"rib_type,omitempty"`
+	// TableName is VRF/Table Name of Loc-RIB instance peer, or of VRF/Table Name TLV of BMP v4 Route Monitoring
+	// message, TLVs are set from TLVs of BMP v4 Route Monitoring message
+	TableName string            `json:"table_name,omitempty"`
+	TLVs      []RouteMonitorTLV `json:"bmp_tlvs,omitempty"`
+}
+
+// RouteMonitorTLV defines TLV of BMP v4 Route Monitoring message applying to the route, PEN is set for enterprise
+// specific TLVs and the value is a hex string
+type RouteMonitorTLV struct {
+	Type  uint16 `json:"type"`
+	PEN   uint32 `json:"pen,omitempty"`
+	Value string `json:"value"`
 }
 
 func (u *UnicastPrefix) Equal(ou *UnicastPrefix) (bool, []string) {
@@ -192,8 +232,10 @@ type LSNode struct {
 	PeerHash            string                          `json:"peer_hash,omitempty"`
 	PeerIP              string                          `json:"peer_ip,omitempty"`
 	PeerType            uint8                           `json:"peer_type"`
+	PeerRD              string                          `json:"peer_rd,omitempty"`
 	PeerASN             uint32                          `json:"peer_asn,omitempty"`
 	Timestamp           string                          `json:"timestamp,omitempty"`
+	CollectorTimestamp  string                          `json:"collector_timestamp,omitempty"`
 	IGPRouterID         string                          `json:"igp_router_id,omitempty"`
 	RouterID            string                          `json:"router_id,omitempty"`
 	ASN                 uint32                          `json:"asn,omitempty"`
@@ -211,9 +253,16 @@ type LSNode struct {
 	NodeMSD             []*base.MSDTV                   `json:"node_msd,omitempty"`
 	FlexAlgoDefinition  []*bgpls.FlexAlgoDefinition     `json:"flex_algo_definition,omitempty"`
 	// Values are assigned based on PerPeerHeader flas
-	IsAdjRIBInPost   bool `json:"is_adj_rib_in_post_policy"`
-	IsAdjRIBOutPost  bool `json:"is_adj_rib_out_post_policy"`
-	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool   `json:"is_adj_rib_out"`
+	IsPostPolicy     bool   `json:"is_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+	RIBType          string `json:"rib_type,omitempty"`
+	// TableName and TLVs are set from VRF/Table Name TLV and TLVs of BMP v4 Route Monitoring message applying to
+	// the NLRI of the node
+	TableName string            `json:"table_name,omitempty"`
+	TLVs      []RouteMonitorTLV `json:"bmp_tlvs,omitempty"`
 }
 
 // LSLink defines a structure of LS link message
@@ -230,8 +279,10 @@ type LSLink struct {
 	PeerHash              string                        `json:"peer_hash,omitempty"`
 	PeerIP                string                        `json:"peer_ip,omitempty"`
 	PeerType              uint8                         `json:"peer_type"`
+	PeerRD                string                        `json:"peer_rd,omitempty"`
 	PeerASN               uint32                        `json:"peer_asn,omitempty"`
 	Timestamp             string                        `json:"timestamp,omitempty"`
+	CollectorTimestamp    string                        `json:"collector_timestamp,omitempty"`
 	IGPRouterID           string                        `json:"igp_router_id,omitempty"`
 	RouterID              string                        `json:"router_id,omitempty"`
 	LSID                  uint32                        `json:"ls_id,omitempty"`
@@ -245,6 +296,7 @@ type LSLink struct {
 	LocalLinkIP           string                        `json:"local_link_ip,omitempty"`
 	RemoteLinkIP          string                        `json:"remote_link_ip,omitempty"`
 	IGPMetric             uint32                        `json:"igp_metric,omitempty"`
+	MetricType            string                        `json:"metric_type,omitempty"`
 	AdminGroup            uint32                        `json:"admin_group,omitempty"`
 	MaxLinkBW             uint32                        `json:"max_link_bw,omitempty"`
 	MaxResvBW             uint32                        `json:"max_resv_bw,omitempty"`
@@ -259,6 +311,8 @@ type LSLink struct {
 	LinkName              string                        `json:"link_name,omitempty"`
 	RemoteNodeHash        string                        `json:"remote_node_hash,omitempty"`
 	LocalNodeHash         string                        `json:"local_node_hash,omitempty"`
+	LocalNodeName         string                        `json:"local_node_name,omitempty"`
+	RemoteNodeName        string                        `json:"remote_node_name,omitempty"`
 	RemoteIGPRouterID     string                        `json:"remote_igp_router_id,omitempty"`
 	RemoteRouterID        string                        `json:"remote_router_id,omitempty"`
 	LocalNodeASN          uint32                        `json:"local_node_asn,omitempty"`
@@ -271,6 +325,7 @@ type LSLink struct {
 	PeerSetSID            *sr.PeerSID                   `json:"peer_set_sid,omitempty"`
 	SRv6BGPPeerNodeSID    *srv6.BGPPeerNodeSID          `json:"srv6_bgp_peer_node_sid,omitempty"`
 	SRv6ENDXSID           []*srv6.EndXSIDTLV            `json:"srv6_endx_sid,omitempty"`
+	SRv6LANENDXSID        []*srv6.LANEndXSIDTLV         `json:"srv6_lan_endx_sid,omitempty"`
 	LSAdjacencySID        []*sr.AdjacencySIDTLV         `json:"ls_adjacency_sid,omitempty"`
 	LinkMSD               []*base.MSDTV                 `json:"link_msd,omitempty"`
 	AppSpecLinkAttr       []*bgpls.AppSpecLinkAttr      `json:"app_spec_link_attr,omitempty"`
@@ -282,43 +337,96 @@ type LSLink struct {
 	UnidirAvailableBW     uint32                        `json:"unidir_available_bw,omitempty"`
 	UnidirBWUtilization   uint32                        `json:"unidir_bw_utilization,omitempty"`
 	// Values are assigned based on PerPeerHeader flas
-	IsAdjRIBInPost   bool `json:"is_adj_rib_in_post_policy"`
-	IsAdjRIBOutPost  bool `json:"is_adj_rib_out_post_policy"`
-	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool   `json:"is_adj_rib_out"`
+	IsPostPolicy     bool   `json:"is_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+	RIBType          string `json:"rib_type,omitempty"`
+	// TableName and TLVs are set from BMP v4 Route Monitoring message, as of ls_node messages
+	TableName string            `json:"table_name,omitempty"`
+	TLVs      []RouteMonitorTLV `json:"bmp_tlvs,omitempty"`
+}
+
+// IGPAdjacency defines a structure of IGP adjacency state change message, the message is generated
+// when both directions of a LS Link become known (adjacency "up") or when either of them gets withdrawn
+// (adjacency "down").
+type IGPAdjacency struct {
+	Action             string                        `json:"action,omitempty"` // Action can be "up" or "down"
+	RouterHash         string                        `json:"router_hash,omitempty"`
+	RouterIP           string                        `json:"router_ip,omitempty"`
+	DomainID           int64                         `json:"domain_id"`
+	PeerHash           string                        `json:"peer_hash,omitempty"`
+	PeerIP             string                        `json:"peer_ip,omitempty"`
+	PeerASN            uint32                        `json:"peer_asn,omitempty"`
+	Timestamp          string                        `json:"timestamp,omitempty"`
+	CollectorTimestamp string                        `json:"collector_timestamp,omitempty"`
+	UpSince            string                        `json:"up_since,omitempty"`
+	Protocol           string                        `json:"protocol,omitempty"`
+	ProtocolID         base.ProtoID                  `json:"protocol_id,omitempty"`
+	AreaID             string                        `json:"area_id"`
+	MTID               *base.MultiTopologyIdentifier `json:"mt_id_tlv,omitempty"`
+	LocalNodeHash      string                        `json:"local_node_hash,omitempty"`
+	RemoteNodeHash     string                        `json:"remote_node_hash,omitempty"`
+	LocalNodeName      string                        `json:"local_node_name,omitempty"`
+	RemoteNodeName     string                        `json:"remote_node_name,omitempty"`
+	IGPRouterID        string                        `json:"igp_router_id,omitempty"`
+	RemoteIGPRouterID  string                        `json:"remote_igp_router_id,omitempty"`
+	LocalLinkIP        string                        `json:"local_link_ip,omitempty"`
+	RemoteLinkIP       string                        `json:"remote_link_ip,omitempty"`
+	LocalLinkID        uint32                        `json:"local_link_id,omitempty"`
+	RemoteLinkID       uint32                        `json:"remote_link_id,omitempty"`
+	// Reason describes which direction of the link triggered the state change
+	Reason string `json:"reason,omitempty"`
 }
 
 // L3VPNPrefix defines the structure of Layer 3 VPN message
 type L3VPNPrefix struct {
-	Key            string              `json:"_key,omitempty"`
-	ID             string              `json:"_id,omitempty"`
-	Rev            string              `json:"_rev,omitempty"`
-	Action         string              `json:"action,omitempty"` // Action can be "add" or "del"
-	Sequence       int                 `json:"sequence,omitempty"`
-	Hash           string              `json:"hash,omitempty"`
-	RouterHash     string              `json:"router_hash,omitempty"`
-	RouterIP       string              `json:"router_ip,omitempty"`
-	BaseAttributes *bgp.BaseAttributes `json:"base_attrs,omitempty"`
-	PeerHash       string              `json:"peer_hash,omitempty"`
-	PeerIP         string              `json:"peer_ip,omitempty"`
-	PeerType       uint8               `json:"peer_type"`
-	PeerASN        uint32              `json:"peer_asn,omitempty"`
-	Timestamp      string              `json:"timestamp,omitempty"`
-	Prefix         string              `json:"prefix,omitempty"`
-	PrefixLen      int32               `json:"prefix_len,omitempty"`
-	IsIPv4         bool                `json:"is_ipv4"`
-	OriginAS       int32               `json:"origin_as,omitempty"`
-	Nexthop        string              `json:"nexthop,omitempty"`
-	ClusterList    string              `json:"cluster_list,omitempty"`
-	IsNexthopIPv4  bool                `json:"is_nexthop_ipv4"`
-	PathID         int32               `json:"path_id,omitempty"`
-	Labels         []uint32            `json:"labels,omitempty"`
-	VPNRD          string              `json:"vpn_rd,omitempty"`
-	VPNRDType      uint16              `json:"vpn_rd_type"`
-	PrefixSID      *prefixsid.PSid     `json:"prefix_sid,omitempty"`
+	Key                string              `json:"_key,omitempty"`
+	ID                 string              `json:"_id,omitempty"`
+	Rev                string              `json:"_rev,omitempty"`
+	Action             string              `json:"action,omitempty"` // Action can be "add" or "del"
+	Sequence           int                 `json:"sequence,omitempty"`
+	Hash               string              `json:"hash,omitempty"`
+	RouterHash         string              `json:"router_hash,omitempty"`
+	RouterIP           string              `json:"router_ip,omitempty"`
+	BaseAttributes     *bgp.BaseAttributes `json:"base_attrs,omitempty"`
+	PeerHash           string              `json:"peer_hash,omitempty"`
+	PeerIP             string              `json:"peer_ip,omitempty"`
+	PeerType           uint8               `json:"peer_type"`
+	PeerRD             string              `json:"peer_rd,omitempty"`
+	PeerASN            uint32              `json:"peer_asn,omitempty"`
+	Timestamp          string              `json:"timestamp,omitempty"`
+	CollectorTimestamp string              `json:"collector_timestamp,omitempty"`
+	Prefix             string              `json:"prefix,omitempty"`
+	PrefixLen          int32               `json:"prefix_len,omitempty"`
+	IsIPv4             bool                `json:"is_ipv4"`
+	OriginAS           int32               `json:"origin_as,omitempty"`
+	Nexthop            string              `json:"nexthop,omitempty"`
+	ClusterList        string              `json:"cluster_list,omitempty"`
+	IsNexthopIPv4      bool                `json:"is_nexthop_ipv4"`
+	PathID             int32               `json:"path_id,omitempty"`
+	Labels             []uint32            `json:"labels,omitempty"`
+	VPNRD              string              `json:"vpn_rd,omitempty"`
+	VPNRDType          uint16              `json:"vpn_rd_type"`
+	PrefixSID          *prefixsid.PSid     `json:"prefix_sid,omitempty"`
+	// SRv6SIDs are SIDs of SRv6 L3 Service of prefix_sid with bits transposed into the label restored,
+	// SRv6ServiceSIDs carry the SIDs with their Endpoint Behavior and SID Structure
+	SRv6SIDs        []string           `json:"srv6_sids,omitempty"`
+	SRv6ServiceSIDs []*srv6.ServiceSID `json:"srv6_service_sids,omitempty"`
+	// IsLLGRStale is true for routes carrying LLGR_STALE community, retained by Long-Lived Graceful Restart
+	IsLLGRStale bool `json:"is_llgr_stale,omitempty"`
 	// Values are assigned based on PerPeerHeader flas
-	IsAdjRIBInPost   bool `json:"is_adj_rib_in_post_policy"`
-	IsAdjRIBOutPost  bool `json:"is_adj_rib_out_post_policy"`
-	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool   `json:"is_adj_rib_out"`
+	IsPostPolicy     bool   `json:"is_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+	RIBType          string `json:"rib_type,omitempty"`
+	// TableName is VRF/Table Name of VRF/Table Name TLV of BMP v4 Route Monitoring message, TLVs are TLVs of the
+	// message applying to the route
+	TableName string            `json:"table_name,omitempty"`
+	TLVs      []RouteMonitorTLV `json:"bmp_tlvs,omitempty"`
 }
 
 // LSPrefix defines a structure of LS Prefix message
@@ -335,8 +443,10 @@ type LSPrefix struct {
 	PeerHash             string                        `json:"peer_hash,omitempty"`
 	PeerIP               string                        `json:"peer_ip,omitempty"`
 	PeerType             uint8                         `json:"peer_type"`
+	PeerRD               string                        `json:"peer_rd,omitempty"`
 	PeerASN              uint32                        `json:"peer_asn,omitempty"`
 	Timestamp            string                        `json:"timestamp,omitempty"`
+	CollectorTimestamp   string                        `json:"collector_timestamp,omitempty"`
 	IGPRouterID          string                        `json:"igp_router_id,omitempty"`
 	RouterID             string                        `json:"router_id,omitempty"`
 	LSID                 uint32                        `json:"ls_id,omitempty"`
@@ -345,6 +455,7 @@ type LSPrefix struct {
 	AreaID               string                        `json:"area_id"`
 	Nexthop              string                        `json:"nexthop,omitempty"`
 	LocalNodeHash        string                        `json:"local_node_hash,omitempty"`
+	LocalNodeName        string                        `json:"local_node_name,omitempty"`
 	MTID                 *base.MultiTopologyIdentifier `json:"mt_id_tlv,omitempty"`
 	OSPFRouteType        uint8                         `json:"ospf_route_type,omitempty"`
 	IGPFlags             *bgpls.IGPFlags               `json:"igp_flags,omitempty"`
@@ -354,13 +465,20 @@ type LSPrefix struct {
 	Prefix               string                        `json:"prefix,omitempty"`
 	PrefixLen            int32                         `json:"prefix_len,omitempty"`
 	PrefixMetric         uint32                        `json:"prefix_metric,omitempty"`
+	MetricType           string                        `json:"metric_type,omitempty"`
 	PrefixAttrTLVs       *bgpls.PrefixAttrTLVs         `json:"prefix_attr_tlvs,omitempty"`
 	FlexAlgoPrefixMetric []*bgpls.FlexAlgoPrefixMetric `json:"flex_algo_prefix_metric,omitempty"`
 	SRv6Locator          *srv6.LocatorTLV              `json:"srv6_locator,omitempty"`
 	// Values are assigned based on PerPeerHeader flas
-	IsAdjRIBInPost   bool `json:"is_adj_rib_in_post_policy"`
-	IsAdjRIBOutPost  bool `json:"is_adj_rib_out_post_policy"`
-	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool   `json:"is_adj_rib_out"`
+	IsPostPolicy     bool   `json:"is_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+	RIBType          string `json:"rib_type,omitempty"`
+	// TableName and TLVs are set from BMP v4 Route Monitoring message, as of ls_node messages
+	TableName string            `json:"table_name,omitempty"`
+	TLVs      []RouteMonitorTLV `json:"bmp_tlvs,omitempty"`
 }
 
 // LSSRv6SID defines a structure of LS SRv6 SID message
@@ -377,8 +495,10 @@ type LSSRv6SID struct {
 	PeerHash             string                        `json:"peer_hash,omitempty"`
 	PeerIP               string                        `json:"peer_ip,omitempty"`
 	PeerType             uint8                         `json:"peer_type"`
+	PeerRD               string                        `json:"peer_rd,omitempty"`
 	PeerASN              uint32                        `json:"peer_asn,omitempty"`
 	Timestamp            string                        `json:"timestamp,omitempty"`
+	CollectorTimestamp   string                        `json:"collector_timestamp,omitempty"`
 	IGPRouterID          string                        `json:"igp_router_id,omitempty"`
 	LocalNodeASN         uint32                        `json:"local_node_asn,omitempty"`
 	RouterID             string                        `json:"router_id,omitempty"`
@@ -388,6 +508,7 @@ type LSSRv6SID struct {
 	Protocol             string                        `json:"protocol,omitempty"`
 	Nexthop              string                        `json:"nexthop,omitempty"`
 	LocalNodeHash        string                        `json:"local_node_hash,omitempty"`
+	LocalNodeName        string                        `json:"local_node_name,omitempty"`
 	MTID                 *base.MultiTopologyIdentifier `json:"mt_id_tlv,omitempty"`
 	IGPFlags             uint8                         `json:"igp_flags"`
 	IGPRouteTag          uint8                         `json:"route_tag,omitempty"`
@@ -401,118 +522,334 @@ type LSSRv6SID struct {
 	SRv6BGPPeerNodeSID   *srv6.BGPPeerNodeSID          `json:"srv6_bgp_peer_node_sid,omitempty"`
 	SRv6SIDStructure     *srv6.SIDStructure            `json:"srv6_sid_structure,omitempty"`
 	// Values are assigned based on PerPeerHeader flas
-	IsAdjRIBInPost   bool `json:"is_adj_rib_in_post_policy"`
-	IsAdjRIBOutPost  bool `json:"is_adj_rib_out_post_policy"`
-	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool   `json:"is_adj_rib_out"`
+	IsPostPolicy     bool   `json:"is_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+	RIBType          string `json:"rib_type,omitempty"`
+	// TableName and TLVs are set from BMP v4 Route Monitoring message, as of ls_node messages
+	TableName string            `json:"table_name,omitempty"`
+	TLVs      []RouteMonitorTLV `json:"bmp_tlvs,omitempty"`
 }
 
 // EVPNPrefix defines the structure of EVPN message
 type EVPNPrefix struct {
-	Key            string              `json:"_key,omitempty"`
-	ID             string              `json:"_id,omitempty"`
-	Rev            string              `json:"_rev,omitempty"`
-	Action         string              `json:"action,omitempty"` // Action can be "add" or "del"
-	Sequence       int                 `json:"sequence,omitempty"`
-	Hash           string              `json:"hash,omitempty"`
-	RouterHash     string              `json:"router_hash,omitempty"`
-	RouterIP       string              `json:"router_ip,omitempty"`
-	BaseAttributes *bgp.BaseAttributes `json:"base_attrs,omitempty"`
-	PeerHash       string              `json:"peer_hash,omitempty"`
-	RemoteBGPID    string              `json:"remote_bgp_id,omitempty"`
-	PeerIP         string              `json:"peer_ip,omitempty"`
-	PeerType       uint8               `json:"peer_type"`
-	PeerASN        uint32              `json:"peer_asn,omitempty"`
-	Timestamp      string              `json:"timestamp,omitempty"`
-	IsIPv4         bool                `json:"is_ipv4"`
-	OriginAS       int32               `json:"origin_as,omitempty"`
-	Nexthop        string              `json:"nexthop,omitempty"`
-	ClusterList    string              `json:"cluster_list,omitempty"`
-	IsNexthopIPv4  bool                `json:"is_nexthop_ipv4"`
-	PathID         int32               `json:"path_id,omitempty"`
-	Labels         []uint32            `json:"labels,omitempty"`
-	RawLabels      []uint32            `json:"rawlabels,omitempty"`
-	VPNRD          string              `json:"vpn_rd,omitempty"`
-	VPNRDType      uint16              `json:"vpn_rd_type"`
-	ESI            string              `json:"eth_segment_id,omitempty"`
-	EthTag         []byte              `json:"eth_tag,omitempty"`
-	IPAddress      string              `json:"ip_address,omitempty"`
-	IPLength       uint8               `json:"ip_len,omitempty"`
-	GWAddress      string              `json:"gw_address,omitempty"`
-	MAC            string              `json:"mac,omitempty"`
-	MACLength      uint8               `json:"mac_len,omitempty"`
-	RouteType      uint8               `json:"route_type,omitempty"`
-	// TODO Type 3 carries nlri 22
-	// https://tools.ietf.org/html/rfc6514
-	// Add to the message
+	Key                string              `json:"_key,omitempty"`
+	ID                 string              `json:"_id,omitempty"`
+	Rev                string              `json:"_rev,omitempty"`
+	Action             string              `json:"action,omitempty"` // Action can be "add" or "del"
+	Sequence           int                 `json:"sequence,omitempty"`
+	Hash               string              `json:"hash,omitempty"`
+	RouterHash         string              `json:"router_hash,omitempty"`
+	RouterIP           string              `json:"router_ip,omitempty"`
+	BaseAttributes     *bgp.BaseAttributes `json:"base_attrs,omitempty"`
+	PeerHash           string              `json:"peer_hash,omitempty"`
+	RemoteBGPID        string              `json:"remote_bgp_id,omitempty"`
+	PeerIP             string              `json:"peer_ip,omitempty"`
+	PeerType           uint8               `json:"peer_type"`
+	PeerRD             string              `json:"peer_rd,omitempty"`
+	PeerASN            uint32              `json:"peer_asn,omitempty"`
+	Timestamp          string              `json:"timestamp,omitempty"`
+	CollectorTimestamp string              `json:"collector_timestamp,omitempty"`
+	IsIPv4             bool                `json:"is_ipv4"`
+	OriginAS           int32               `json:"origin_as,omitempty"`
+	Nexthop            string              `json:"nexthop,omitempty"`
+	ClusterList        string              `json:"cluster_list,omitempty"`
+	IsNexthopIPv4      bool                `json:"is_nexthop_ipv4"`
+	PathID             int32               `json:"path_id,omitempty"`
+	Labels             []uint32            `json:"labels,omitempty"`
+	RawLabels          []uint32            `json:"rawlabels,omitempty"`
+	VPNRD              string              `json:"vpn_rd,omitempty"`
+	VPNRDType          uint16              `json:"vpn_rd_type"`
+	ESI                string              `json:"eth_segment_id,omitempty"`
+	EthTag             []byte              `json:"eth_tag,omitempty"`
+	IPAddress          string              `json:"ip_address,omitempty"`
+	IPLength           uint8               `json:"ip_len,omitempty"`
+	GWAddress          string              `json:"gw_address,omitempty"`
+	MAC                string              `json:"mac,omitempty"`
+	MACLength          uint8               `json:"mac_len,omitempty"`
+	RouteType          uint8               `json:"route_type,omitempty"`
+	// SRv6SIDs are SIDs of SRv6 L2 and L3 Services of the route with bits transposed into its labels restored,
+	// SRv6ServiceSIDs carry the SIDs with their service, Endpoint Behavior and SID Structure
+	SRv6SIDs        []string           `json:"srv6_sids,omitempty"`
+	SRv6ServiceSIDs []*srv6.ServiceSID `json:"srv6_service_sids,omitempty"`
+	// RouterMAC is the MAC address of EVPN Router's MAC extended community of the route
+	RouterMAC string `json:"router_mac,omitempty"`
+	// OverlayIndex is the Overlay Index model of IP Prefix route announcement, one of esi, gateway_ip,
+	// router_mac, none or invalid
+	OverlayIndex string `json:"overlay_index,omitempty"`
+	// MulticastSource, MulticastGroup, Originator and MulticastFlags are carried by multicast routes of types 6, 7
+	// and 8, RFC 9251, the source is empty for (*,G) routes and MaxResponseTime is carried by Leave Synch routes
+	MulticastSource string   `json:"mcast_src,omitempty"`
+	MulticastGroup  string   `json:"mcast_grp,omitempty"`
+	Originator      string   `json:"originator_router,omitempty"`
+	MulticastFlags  []string `json:"mcast_flags,omitempty"`
+	MaxResponseTime uint8    `json:"max_response_time,omitempty"`
+	// PMSITunnel is PMSI Tunnel attribute, RFC 6514, carried by Inclusive Multicast Ethernet Tag routes of type 3
+	PMSITunnel *bgp.PMSITunnel `json:"pmsi_tunnel,omitempty"`
 	// Values are assigned based on PerPeerHeader flas
-	IsAdjRIBInPost   bool `json:"is_adj_rib_in_post_policy"`
-	IsAdjRIBOutPost  bool `json:"is_adj_rib_out_post_policy"`
-	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool   `json:"is_adj_rib_out"`
+	IsPostPolicy     bool   `json:"is_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+	RIBType          string `json:"rib_type,omitempty"`
+	// TableName and TLVs are set from BMP v4 Route Monitoring message as for L3VPN prefixes
+	TableName string            `json:"table_name,omitempty"`
+	TLVs      []RouteMonitorTLV `json:"bmp_tlvs,omitempty"`
 }
 
 // SRPolicy defines the structure of SR Policy message
 type SRPolicy struct {
-	Key            string                  `json:"_key,omitempty"`
-	ID             string                  `json:"_id,omitempty"`
-	Rev            string                  `json:"_rev,omitempty"`
-	Action         string                  `json:"action,omitempty"` // Action can be "add" or "del"
-	Sequence       int                     `json:"sequence,omitempty"`
-	Hash           string                  `json:"hash,omitempty"`
-	RouterHash     string                  `json:"router_hash,omitempty"`
-	RouterIP       string                  `json:"router_ip,omitempty"`
-	BaseAttributes *bgp.BaseAttributes     `json:"base_attrs,omitempty"`
-	PeerHash       string                  `json:"peer_hash,omitempty"`
-	PeerIP         string                  `json:"peer_ip,omitempty"`
-	PeerType       uint8                   `json:"peer_type"`
-	PeerASN        uint32                  `json:"peer_asn,omitempty"`
-	Timestamp      string                  `json:"timestamp,omitempty"`
-	IsIPv4         bool                    `json:"is_ipv4"`
-	OriginAS       int32                   `json:"origin_as,omitempty"`
-	Nexthop        string                  `json:"nexthop,omitempty"`
-	ClusterList    string                  `json:"cluster_list,omitempty"`
-	IsNexthopIPv4  bool                    `json:"is_nexthop_ipv4"`
-	PathID         int32                   `json:"path_id,omitempty"`
-	Labels         []uint32                `json:"labels,omitempty"`
-	Distinguisher  uint32                  `json:"distinguisher,omitempty"`
-	Color          uint32                  `json:"color,omitempty"`
-	Endpoint       []byte                  `json:"endpoint,omitempty"`
-	PolicyName     string                  `json:"policy_name,omitempty"`
-	BSID           *srpolicy.BindingSID    `json:"binding_sid,omitempty"`
-	Preference     *srpolicy.Preference    `json:"preference_subtlv,omitempty"`
-	Priority       byte                    `json:"priority_subtlv,omitempty"`
-	PolicyPathName string                  `json:"policy_path_name,omitempty"`
-	ENLP           *srpolicy.ENLP          `json:"enlp_subtlv,omitempty"`
-	SegmentList    []*srpolicy.SegmentList `json:"segment_list_subtlv,omitempty"`
+	Key                string                  `json:"_key,omitempty"`
+	ID                 string                  `json:"_id,omitempty"`
+	Rev                string                  `json:"_rev,omitempty"`
+	Action             string                  `json:"action,omitempty"` // Action can be "add" or "del"
+	Sequence           int                     `json:"sequence,omitempty"`
+	Hash               string                  `json:"hash,omitempty"`
+	RouterHash         string                  `json:"router_hash,omitempty"`
+	RouterIP           string                  `json:"router_ip,omitempty"`
+	BaseAttributes     *bgp.BaseAttributes     `json:"base_attrs,omitempty"`
+	PeerHash           string                  `json:"peer_hash,omitempty"`
+	PeerIP             string                  `json:"peer_ip,omitempty"`
+	PeerType           uint8                   `json:"peer_type"`
+	PeerRD             string                  `json:"peer_rd,omitempty"`
+	PeerASN            uint32                  `json:"peer_asn,omitempty"`
+	Timestamp          string                  `json:"timestamp,omitempty"`
+	CollectorTimestamp string                  `json:"collector_timestamp,omitempty"`
+	IsIPv4             bool                    `json:"is_ipv4"`
+	OriginAS           int32                   `json:"origin_as,omitempty"`
+	Nexthop            string                  `json:"nexthop,omitempty"`
+	ClusterList        string                  `json:"cluster_list,omitempty"`
+	IsNexthopIPv4      bool                    `json:"is_nexthop_ipv4"`
+	PathID             int32                   `json:"path_id,omitempty"`
+	Labels             []uint32                `json:"labels,omitempty"`
+	Distinguisher      uint32                  `json:"distinguisher,omitempty"`
+	Color              uint32                  `json:"color,omitempty"`
+	Endpoint           []byte                  `json:"endpoint,omitempty"`
+	PolicyName         string                  `json:"policy_name,omitempty"`
+	BSID               *srpolicy.BindingSID    `json:"binding_sid,omitempty"`
+	Preference         *srpolicy.Preference    `json:"preference_subtlv,omitempty"`
+	Priority           byte                    `json:"priority_subtlv,omitempty"`
+	PolicyPathName     string                  `json:"policy_path_name,omitempty"`
+	ENLP               *srpolicy.ENLP          `json:"enlp_subtlv,omitempty"`
+	SegmentList        []*srpolicy.SegmentList `json:"segment_list_subtlv,omitempty"`
 	// Values are assigned based on PerPeerHeader flas
-	IsAdjRIBInPost   bool `json:"is_adj_rib_in_post_policy"`
-	IsAdjRIBOutPost  bool `json:"is_adj_rib_out_post_policy"`
-	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool   `json:"is_adj_rib_out"`
+	IsPostPolicy     bool   `json:"is_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+	RIBType          string `json:"rib_type,omitempty"`
+	// TableName and TLVs are set from BMP v4 Route Monitoring message carrying the policy
+	TableName string            `json:"table_name,omitempty"`
+	TLVs      []RouteMonitorTLV `json:"bmp_tlvs,omitempty"`
 }
 
-// Flowspec defines the structure of SR Policy message
+// Flowspec defines the structure of Flowspec message
 type Flowspec struct {
-	Key            string              `json:"_key,omitempty"`
-	ID             string              `json:"_id,omitempty"`
-	Rev            string              `json:"_rev,omitempty"`
-	Action         string              `json:"action,omitempty"` // Action can be "add" or "del"
-	Sequence       int                 `json:"sequence,omitempty"`
-	RouterIP       string              `json:"router_ip,omitempty"`
-	BaseAttributes *bgp.BaseAttributes `json:"base_attrs,omitempty"`
-	PeerIP         string              `json:"peer_ip,omitempty"`
-	PeerType       uint8               `json:"peer_type"`
-	PeerASN        uint32              `json:"peer_asn,omitempty"`
-	Timestamp      string              `json:"timestamp,omitempty"`
-	IsIPv4         bool                `json:"is_ipv4"`
-	OriginAS       int32               `json:"origin_as,omitempty"`
-	Nexthop        string              `json:"nexthop,omitempty"`
-	IsNexthopIPv4  bool                `json:"is_nexthop_ipv4"`
-	PathID         int32               `json:"path_id,omitempty"`
-	SpecHash       string              `json:"spec_hash,omitempty"`
-	Spec           []flowspec.Spec     `json:"spec,omitempty"`
+	Key                string              `json:"_key,omitempty"`
+	ID                 string              `json:"_id,omitempty"`
+	Rev                string              `json:"_rev,omitempty"`
+	Action             string              `json:"action,omitempty"` // Action can be "add" or "del"
+	Sequence           int                 `json:"sequence,omitempty"`
+	RouterIP           string              `json:"router_ip,omitempty"`
+	BaseAttributes     *bgp.BaseAttributes `json:"base_attrs,omitempty"`
+	PeerIP             string              `json:"peer_ip,omitempty"`
+	PeerType           uint8               `json:"peer_type"`
+	PeerRD             string              `json:"peer_rd,omitempty"`
+	PeerASN            uint32              `json:"peer_asn,omitempty"`
+	Timestamp          string              `json:"timestamp,omitempty"`
+	CollectorTimestamp string              `json:"collector_timestamp,omitempty"`
+	IsIPv4             bool                `json:"is_ipv4"`
+	OriginAS           int32               `json:"origin_as,omitempty"`
+	Nexthop            string              `json:"nexthop,omitempty"`
+	IsNexthopIPv4      bool                `json:"is_nexthop_ipv4"`
+	PathID             int32               `json:"path_id,omitempty"`
+	VPNRD              string              `json:"vpn_rd,omitempty"`
+	SpecHash           string              `json:"spec_hash,omitempty"`
+	Spec               []flowspec.Spec     `json:"spec,omitempty"`
+	// Rules are components of Spec rendered by their names, for example "destination_port": ">=1024&<=2048"
+	Rules map[string]string `json:"rules,omitempty"`
 	// Values are assigned based on PerPeerHeader flas
-	IsAdjRIBInPost   bool `json:"is_adj_rib_in_post_policy"`
-	IsAdjRIBOutPost  bool `json:"is_adj_rib_out_post_policy"`
-	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool   `json:"is_adj_rib_out"`
+	IsPostPolicy     bool   `json:"is_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+	RIBType          string `json:"rib_type,omitempty"`
+	// TableName and TLVs are set from BMP v4 Route Monitoring message carrying the Flow Specification
+	TableName string            `json:"table_name,omitempty"`
+	TLVs      []RouteMonitorTLV `json:"bmp_tlvs,omitempty"`
+}
+
+// MVPN defines the structure of MCAST-VPN route message, AFI 1 and 2 SAFI 5 (RFC 6514, RFC 6515)
+type MVPN struct {
+	Action             string              `json:"action,omitempty"` // Action can be "add" or "del"
+	RouterHash         string              `json:"router_hash,omitempty"`
+	RouterIP           string              `json:"router_ip,omitempty"`
+	BaseAttributes     *bgp.BaseAttributes `json:"base_attrs,omitempty"`
+	PeerHash           string              `json:"peer_hash,omitempty"`
+	PeerIP             string              `json:"peer_ip,omitempty"`
+	PeerType           uint8               `json:"peer_type"`
+	PeerRD             string              `json:"peer_rd,omitempty"`
+	PeerASN            uint32              `json:"peer_asn,omitempty"`
+	Timestamp          string              `json:"timestamp,omitempty"`
+	CollectorTimestamp string              `json:"collector_timestamp,omitempty"`
+	IsIPv4             bool                `json:"is_ipv4"`
+	OriginAS           int32               `json:"origin_as,omitempty"`
+	Nexthop            string              `json:"nexthop,omitempty"`
+	IsNexthopIPv4      bool                `json:"is_nexthop_ipv4"`
+	PathID             int32               `json:"path_id,omitempty"`
+	RouteType          uint8               `json:"route_type"`
+	// RouteTypeName is the name of the route type, for example "s_pmsi_ad" or "source_tree_join"
+	RouteTypeName string `json:"route_type_name"`
+	VPNRD         string `json:"vpn_rd,omitempty"`
+	// SourceAS is Source AS of Inter-AS I-PMSI A-D and C-multicast routes
+	SourceAS uint32 `json:"source_as,omitempty"`
+	// MulticastSource and MulticastGroup are empty for wildcards, the source of Shared Tree Join routes is C-RP
+	MulticastSource string `json:"mcast_src,omitempty"`
+	MulticastGroup  string `json:"mcast_grp,omitempty"`
+	Originator      string `json:"originator_router,omitempty"`
+	// RouteKey is the route Leaf A-D route responds to
+	RouteKey *MVPNRouteKey `json:"route_key,omitempty"`
+	// PMSITunnel is PMSI Tunnel attribute, RFC 6514, carried by I-PMSI and S-PMSI A-D routes and by Leaf A-D
+	// routes of ingress replication
+	PMSITunnel *bgp.PMSITunnel `json:"pmsi_tunnel,omitempty"`
+	// Values are assigned based on PerPeerHeader flas
+	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool   `json:"is_adj_rib_out"`
+	IsPostPolicy     bool   `json:"is_post_policy"`
+	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
+	RIBType          string `json:"rib_type,omitempty"`
+	// TableName and TLVs are set from BMP v4 Route Monitoring message carrying the route
+	TableName string            `json:"table_name,omitempty"`
+	TLVs      []RouteMonitorTLV `json:"bmp_tlvs,omitempty"`
+}
+
+// MVPNRouteKey defines Route Key of Leaf A-D route, Intra-AS I-PMSI A-D or S-PMSI A-D route
+type MVPNRouteKey struct {
+	RouteType       uint8  `json:"route_type"`
+	RouteTypeName   string `json:"route_type_name"`
+	VPNRD           string `json:"vpn_rd,omitempty"`
+	MulticastSource string `json:"mcast_src,omitempty"`
+	MulticastGroup  string `json:"mcast_grp,omitempty"`
+	Originator      string `json:"originator_router,omitempty"`
+}
+
+// RouteRefresh defines a message format sent as a result of BGP Route Refresh message carried by BMP Route
+// Monitoring or Route Mirroring message
+type RouteRefresh struct {
+	RouterHash         string `json:"router_hash,omitempty"`
+	RouterIP           string `json:"router_ip,omitempty"`
+	PeerHash           string `json:"peer_hash,omitempty"`
+	PeerIP             string `json:"peer_ip,omitempty"`
+	PeerType           uint8  `json:"peer_type"`
+	PeerRD             string `json:"peer_rd,omitempty"`
+	PeerASN            uint32 `json:"peer_asn,omitempty"`
+	Timestamp          string `json:"timestamp,omitempty"`
+	CollectorTimestamp string `json:"collector_timestamp,omitempty"`
+	AFI                uint16 `json:"afi"`
+	SAFI               uint8  `json:"safi"`
+	// Subtype is "refresh" for the request, "borr" and "eorr" for the beginning and the end of Enhanced Route Refresh
+	Subtype string    `json:"subtype"`
+	ORFs    []bgp.ORF `json:"orfs,omitempty"`
+	// Source is RouteRefreshMonitored or RouteRefreshMirrored
+	Source       string `json:"source"`
+	IsAdjRIBOut  bool   `json:"is_adj_rib_out"`
+	IsPostPolicy bool   `json:"is_post_policy"`
+	RIBType      string `json:"rib_type,omitempty"`
+}
+
+// MirroredBGPMessage defines a BGP message carried by BMP Route Mirroring message, only fields of the BGP message
+// type are set
+type MirroredBGPMessage struct {
+	// BGPType is "open", "update", "notification", "keepalive" or "route_refresh", Length is the length of BGP message
+	BGPType string `json:"bgp_type"`
+	Length  int    `json:"length"`
+	// Open message
+	Version      uint8          `json:"version,omitempty"`
+	MyASN        uint32         `json:"my_asn,omitempty"`
+	HoldTime     int            `json:"hold_time,omitempty"`
+	BGPID        string         `json:"bgp_id,omitempty"`
+	Capabilities bgp.Capability `json:"capabilities,omitempty"`
+	// Update message, NLRI and WithdrawnRoutes are IPv4 prefixes, MPAddressFamilies lists AFI/SAFI of
+	// MP_REACH_NLRI and MP_UNREACH_NLRI attributes
+	BaseAttributes    *bgp.BaseAttributes `json:"base_attrs,omitempty"`
+	NLRI              []string            `json:"nlri,omitempty"`
+	WithdrawnRoutes   []string            `json:"withdrawn_routes,omitempty"`
+	MPAddressFamilies []string            `json:"mp_afi_safi,omitempty"`
+	// Notification message
+	ErrorCode    uint8  `json:"error_code,omitempty"`
+	ErrorSubcode uint8  `json:"error_sub_code,omitempty"`
+	Error        string `json:"error,omitempty"`
+	Data         []byte `json:"data,omitempty"`
+	// Route Refresh message
+	AFI     uint16    `json:"afi,omitempty"`
+	SAFI    uint8     `json:"safi,omitempty"`
+	Subtype string    `json:"subtype,omitempty"`
+	ORFs    []bgp.ORF `json:"orfs,omitempty"`
+	// PDU is the BGP message which could not be decoded, DecodeError is the reason
+	PDU         []byte `json:"pdu,omitempty"`
+	DecodeError string `json:"decode_error,omitempty"`
+}
+
+// MirroredMessage defines a message format sent as a result of BGP Open, Update, Notification or Keepalive message
+// carried by BMP Route Mirroring message
+type MirroredMessage struct {
+	RouterHash         string `json:"router_hash,omitempty"`
+	RouterIP           string `json:"router_ip,omitempty"`
+	PeerHash           string `json:"peer_hash,omitempty"`
+	PeerIP             string `json:"peer_ip,omitempty"`
+	PeerType           uint8  `json:"peer_type"`
+	PeerRD             string `json:"peer_rd,omitempty"`
+	PeerASN            uint32 `json:"peer_asn,omitempty"`
+	Timestamp          string `json:"timestamp,omitempty"`
+	CollectorTimestamp string `json:"collector_timestamp,omitempty"`
+	MirroredBGPMessage
+	IsAdjRIBOut  bool   `json:"is_adj_rib_out"`
+	IsPostPolicy bool   `json:"is_post_policy"`
+	RIBType      string `json:"rib_type,omitempty"`
+}
+
+// RouteMirror defines a message format sent as a result of BMP Route Mirroring message
+type RouteMirror struct {
+	RouterHash         string `json:"router_hash,omitempty"`
+	RouterIP           string `json:"router_ip,omitempty"`
+	PeerHash           string `json:"peer_hash,omitempty"`
+	PeerIP             string `json:"peer_ip,omitempty"`
+	PeerType           uint8  `json:"peer_type"`
+	PeerRD             string `json:"peer_rd,omitempty"`
+	PeerASN            uint32 `json:"peer_asn,omitempty"`
+	Timestamp          string `json:"timestamp,omitempty"`
+	CollectorTimestamp string `json:"collector_timestamp,omitempty"`
+	// Information lists codes of Information TLVs, "errored_pdu" when the mirrored messages are errored PDUs and
+	// "messages_lost" when the router lost mirrored messages
+	Information  []string              `json:"information,omitempty"`
+	Messages     []*MirroredBGPMessage `json:"messages,omitempty"`
+	IsAdjRIBOut  bool                  `json:"is_adj_rib_out"`
+	IsPostPolicy bool                  `json:"is_post_policy"`
+	RIBType      string                `json:"rib_type,omitempty"`
+}
+
+// RawUpdate defines a message format carrying BGP Update message of BMP Route Monitoring message as received from
+// the router, for consumers decoding BGP messages themselves
+type RawUpdate struct {
+	RouterHash         string `json:"router_hash,omitempty"`
+	RouterIP           string `json:"router_ip,omitempty"`
+	PeerHash           string `json:"peer_hash,omitempty"`
+	PeerIP             string `json:"peer_ip,omitempty"`
+	PeerType           uint8  `json:"peer_type"`
+	PeerRD             string `json:"peer_rd,omitempty"`
+	PeerASN            uint32 `json:"peer_asn,omitempty"`
+	Timestamp          string `json:"timestamp,omitempty"`
+	CollectorTimestamp string `json:"collector_timestamp,omitempty"`
+	RIBType            string `json:"rib_type,omitempty"`
+	TableName          string `json:"table_name,omitempty"`
+	// PDU is BGP Update message including BGP message header
+	PDU []byte `json:"pdu"`
 }
 
 // Stats defines a message format sent to as a result of BMP Stats Message
@@ -529,6 +866,7 @@ type Stats struct {
 	RemoteIP                   string `json:"remote_ip,omitempty"`
 	PeerRD                     string `json:"peer_rd,omitempty"`
 	Timestamp                  string `json:"timestamp,omitempty"`
+	CollectorTimestamp         string `json:"collector_timestamp,omitempty"`
 	DuplicatePrefixs           uint32 `json:"duplicate_prefix,omitempty"`
 	DuplicateWithDraws         uint32 `json:"duplicate_withdraws,omitempty"`
 	InvalidatedDueCluster      uint32 `json:"invalidated_due_cluster,omitempty"`
@@ -539,4 +877,7 @@ type Stats struct {
 	LocalRib                   uint64 `json:"local_rib,omitempty"`
 	UpdatesAsWithdraw          uint32 `json:"updates_as_withdraw,omitempty"`
 	PrefixesAsWithdraw         uint32 `json:"prefixes_as_withdraw,omitempty"`
+	IsAdjRIBOut                bool   `json:"is_adj_rib_out"`
+	IsPostPolicy               bool   `json:"is_post_policy"`
+	RIBType                    string `json:"rib_type,omitempty"`
 }
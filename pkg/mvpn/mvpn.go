@@ -0,0 +1,238 @@
+package mvpn
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/base"
+	"github.com/sbezverk/tools"
+)
+
+// MCAST-VPN route types, RFC 6514 section 4
+const (
+	// IntraASIPMSIAD is Intra-AS I-PMSI A-D route type
+	IntraASIPMSIAD = 1
+	// InterASIPMSIAD is Inter-AS I-PMSI A-D route type
+	InterASIPMSIAD = 2
+	// SPMSIAD is S-PMSI A-D route type
+	SPMSIAD = 3
+	// LeafAD is Leaf A-D route type
+	LeafAD = 4
+	// SourceActiveAD is Source Active A-D route type
+	SourceActiveAD = 5
+	// SharedTreeJoin is C-multicast Shared Tree Join route type
+	SharedTreeJoin = 6
+	// SourceTreeJoin is C-multicast Source Tree Join route type
+	SourceTreeJoin = 7
+)
+
+var routeTypeNames = map[uint8]string{
+	IntraASIPMSIAD: "intra_as_i_pmsi_ad",
+	InterASIPMSIAD: "inter_as_i_pmsi_ad",
+	SPMSIAD:        "s_pmsi_ad",
+	LeafAD:         "leaf_ad",
+	SourceActiveAD: "source_active_ad",
+	SharedTreeJoin: "shared_tree_join",
+	SourceTreeJoin: "source_tree_join",
+}
+
+// RouteTypeName returns the name of MCAST-VPN route type
+func RouteTypeName(t uint8) string {
+	if n, ok := routeTypeNames[t]; ok {
+		return n
+	}
+
+	return fmt.Sprintf("unknown(%d)", t)
+}
+
+// Route defines MCAST-VPN NLRI of a route, fields not carried by the route type are not set
+type Route struct {
+	PathID uint32
+	Type   uint8
+	// RD is Route Distinguisher of all route types but Leaf A-D
+	RD *base.RD
+	// SourceAS is Source AS of Inter-AS I-PMSI A-D and C-multicast routes
+	SourceAS uint32
+	// Source and Group are Multicast Source and Group of S-PMSI A-D, Source Active A-D and C-multicast routes,
+	// Source of Shared Tree Join routes is the address of C-RP. Wildcards of RFC 6625 have empty addresses.
+	SourceLength uint8
+	Source       []byte
+	GroupLength  uint8
+	Group        []byte
+	// OriginatorIP is Originating Router's IP Address of A-D routes but Inter-AS I-PMSI and Source Active A-D
+	OriginatorIP []byte
+	// RouteKey is the route the Leaf A-D route responds to, Intra-AS I-PMSI A-D or S-PMSI A-D route
+	RouteKey *Route
+}
+
+func address(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+
+	return net.IP(b).String()
+}
+
+// GetRD returns Route Distinguisher of the route, empty string is returned for routes without RD
+func (r *Route) GetRD() string {
+	if r.RD == nil {
+		return ""
+	}
+
+	return r.RD.String()
+}
+
+// GetSource returns Multicast Source of the route, empty string is returned for wildcard source
+func (r *Route) GetSource() string {
+	return address(r.Source)
+}
+
+// GetGroup returns Multicast Group of the route, empty string is returned for wildcard group
+func (r *Route) GetGroup() string {
+	return address(r.Group)
+}
+
+// GetOriginatorIP returns Originating Router's IP Address of the route
+func (r *Route) GetOriginatorIP() string {
+	return address(r.OriginatorIP)
+}
+
+// unmarshalAddress returns the address of length in bits preceding the address, 0 length is a wildcard
+func unmarshalAddress(b []byte, p int, name string) (uint8, []byte, int, error) {
+	if p >= len(b) {
+		return 0, nil, p, fmt.Errorf("not enough bytes to unmarshal %s length", name)
+	}
+	l := b[p]
+	p++
+	if l != 0 && l != 32 && l != 128 {
+		return 0, nil, p, fmt.Errorf("invalid %s length %d", name, l)
+	}
+	n := int(l / 8)
+	if p+n > len(b) {
+		return 0, nil, p, fmt.Errorf("not enough bytes to unmarshal %s of length %d", name, l)
+	}
+	var addr []byte
+	if n != 0 {
+		addr = make([]byte, n)
+		copy(addr, b[p:p+n])
+	}
+
+	return l, addr, p + n, nil
+}
+
+// unmarshalOriginatorIP returns Originating Router's IP Address filling the rest of route type specific field
+func unmarshalOriginatorIP(b []byte) ([]byte, error) {
+	if len(b) != net.IPv4len && len(b) != net.IPv6len {
+		return nil, fmt.Errorf("invalid length %d of originating router's ip address", len(b))
+	}
+	addr := make([]byte, len(b))
+	copy(addr, b)
+
+	return addr, nil
+}
+
+// unmarshalRoute decodes route type specific field of the route type
+func unmarshalRoute(t uint8, b []byte) (*Route, error) {
+	r := &Route{Type: t}
+	var err error
+	if t != LeafAD {
+		if len(b) < 8 {
+			return nil, fmt.Errorf("not enough bytes to unmarshal route distinguisher of %s route", RouteTypeName(t))
+		}
+		if r.RD, err = base.MakeRD(b[:8]); err != nil {
+			return nil, err
+		}
+	}
+	p := 8
+	switch t {
+	case IntraASIPMSIAD:
+		r.OriginatorIP, err = unmarshalOriginatorIP(b[p:])
+	case InterASIPMSIAD:
+		if len(b) != p+4 {
+			return nil, fmt.Errorf("invalid length %d of %s route", len(b), RouteTypeName(t))
+		}
+		r.SourceAS = binary.BigEndian.Uint32(b[p:])
+	case SPMSIAD, SourceActiveAD:
+		if r.SourceLength, r.Source, p, err = unmarshalAddress(b, p, "multicast source"); err != nil {
+			return nil, err
+		}
+		if r.GroupLength, r.Group, p, err = unmarshalAddress(b, p, "multicast group"); err != nil {
+			return nil, err
+		}
+		if t == SPMSIAD {
+			r.OriginatorIP, err = unmarshalOriginatorIP(b[p:])
+		} else if p != len(b) {
+			err = fmt.Errorf("invalid length %d of %s route", len(b), RouteTypeName(t))
+		}
+	case SharedTreeJoin, SourceTreeJoin:
+		if len(b) < p+4 {
+			return nil, fmt.Errorf("not enough bytes to unmarshal source as of %s route", RouteTypeName(t))
+		}
+		r.SourceAS = binary.BigEndian.Uint32(b[p : p+4])
+		p += 4
+		if r.SourceLength, r.Source, p, err = unmarshalAddress(b, p, "multicast source"); err != nil {
+			return nil, err
+		}
+		if r.GroupLength, r.Group, p, err = unmarshalAddress(b, p, "multicast group"); err != nil {
+			return nil, err
+		}
+		if p != len(b) {
+			err = fmt.Errorf("invalid length %d of %s route", len(b), RouteTypeName(t))
+		}
+	case LeafAD:
+		// Route Key is MCAST-VPN NLRI of the route the Leaf A-D route responds to
+		if len(b) < 2 || len(b) < 2+int(b[1]) {
+			return nil, fmt.Errorf("not enough bytes to unmarshal route key of %s route", RouteTypeName(t))
+		}
+		l := 2 + int(b[1])
+		if r.RouteKey, err = unmarshalRoute(b[0], b[2:l]); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal route key of %s route with error: %+v", RouteTypeName(t), err)
+		}
+		r.OriginatorIP, err = unmarshalOriginatorIP(b[l:])
+	default:
+		return nil, fmt.Errorf("unknown mcast-vpn route type %d", t)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// UnmarshalMVPNNLRI instantiates MCAST-VPN routes, AFI 1 and 2 SAFI 5, from a slice of bytes carrying NLRI,
+// NLRI are prefixed by Path Identifier when pathID is true
+func UnmarshalMVPNNLRI(b []byte, pathID bool) ([]*Route, error) {
+	if glog.V(6) {
+		glog.Infof("MCAST-VPN NLRI Raw: %s", tools.MessageHex(b))
+	}
+	routes := make([]*Route, 0)
+	for p := 0; p < len(b); {
+		var id uint32
+		if pathID {
+			if p+4 > len(b) {
+				return nil, fmt.Errorf("not enough bytes to unmarshal path id of mcast-vpn nlri")
+			}
+			id = binary.BigEndian.Uint32(b[p : p+4])
+			p += 4
+		}
+		if p+2 > len(b) {
+			return nil, fmt.Errorf("not enough bytes to unmarshal mcast-vpn nlri")
+		}
+		t, l := b[p], int(b[p+1])
+		p += 2
+		if p+l > len(b) {
+			return nil, fmt.Errorf("mcast-vpn nlri length %d exceeds remaining %d bytes", l, len(b)-p)
+		}
+		r, err := unmarshalRoute(t, b[p:p+l])
+		if err != nil {
+			return nil, err
+		}
+		r.PathID = id
+		routes = append(routes, r)
+		p += l
+	}
+
+	return routes, nil
+}
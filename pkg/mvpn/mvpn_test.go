@@ -0,0 +1,132 @@
+package mvpn
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalMVPNNLRI(t *testing.T) {
+	rd := []byte{0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64}
+	nlri := func(routeType byte, parts ...[]byte) []byte {
+		var v []byte
+		for _, p := range parts {
+			v = append(v, p...)
+		}
+		return append([]byte{routeType, byte(len(v))}, v...)
+	}
+	sPMSI := nlri(SPMSIAD, rd, []byte{32, 192, 168, 1, 1, 32, 232, 1, 1, 1}, []byte{10, 0, 0, 1})
+	tests := []struct {
+		name   string
+		input  []byte
+		pathID bool
+		expect []*Route
+		fail   bool
+	}{
+		{
+			name:  "intra-as i-pmsi a-d",
+			input: nlri(IntraASIPMSIAD, rd, []byte{10, 0, 0, 1}),
+			expect: []*Route{
+				{Type: IntraASIPMSIAD, OriginatorIP: net.IP{10, 0, 0, 1}},
+			},
+		},
+		{
+			name:   "inter-as i-pmsi a-d with path id",
+			input:  append([]byte{0, 0, 0, 7}, nlri(InterASIPMSIAD, rd, []byte{0, 0, 0xfd, 0xe9})...),
+			pathID: true,
+			expect: []*Route{
+				{PathID: 7, Type: InterASIPMSIAD, SourceAS: 65001},
+			},
+		},
+		{
+			name:  "s-pmsi a-d",
+			input: sPMSI,
+			expect: []*Route{
+				{Type: SPMSIAD, SourceLength: 32, Source: []byte{192, 168, 1, 1}, GroupLength: 32, Group: []byte{232, 1, 1, 1},
+					OriginatorIP: []byte{10, 0, 0, 1}},
+			},
+		},
+		{
+			name:  "leaf a-d responding to s-pmsi a-d",
+			input: nlri(LeafAD, sPMSI, []byte{10, 0, 0, 2}),
+			expect: []*Route{
+				{Type: LeafAD, OriginatorIP: []byte{10, 0, 0, 2}, RouteKey: &Route{Type: SPMSIAD, SourceLength: 32,
+					Source: []byte{192, 168, 1, 1}, GroupLength: 32, Group: []byte{232, 1, 1, 1}, OriginatorIP: []byte{10, 0, 0, 1}}},
+			},
+		},
+		{
+			name: "source active a-d and ipv6 source tree join",
+			input: append(nlri(SourceActiveAD, rd, []byte{32, 192, 168, 1, 1, 32, 232, 1, 1, 1}),
+				nlri(SourceTreeJoin, rd, []byte{0, 0, 0xfd, 0xe8},
+					[]byte{128, 0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+					[]byte{128, 0xff, 0x3e, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})...),
+			expect: []*Route{
+				{Type: SourceActiveAD, SourceLength: 32, Source: []byte{192, 168, 1, 1}, GroupLength: 32, Group: []byte{232, 1, 1, 1}},
+				{Type: SourceTreeJoin, SourceAS: 65000, SourceLength: 128, Source: net.ParseIP("2001:db8::1"),
+					GroupLength: 128, Group: net.ParseIP("ff3e::1")},
+			},
+		},
+		{
+			name:  "shared tree join with wildcard source",
+			input: nlri(SharedTreeJoin, rd, []byte{0, 0, 0xfd, 0xe8, 0, 32, 239, 1, 1, 1}),
+			expect: []*Route{
+				{Type: SharedTreeJoin, SourceAS: 65000, GroupLength: 32, Group: []byte{239, 1, 1, 1}},
+			},
+		},
+		{
+			name:  "unknown route type",
+			input: nlri(8, rd),
+			fail:  true,
+		},
+		{
+			name:  "invalid originator length",
+			input: nlri(IntraASIPMSIAD, rd, []byte{10, 0, 0}),
+			fail:  true,
+		},
+		{
+			name:  "invalid group length",
+			input: nlri(SourceActiveAD, rd, []byte{32, 192, 168, 1, 1, 24, 232, 1, 1}),
+			fail:  true,
+		},
+		{
+			name:  "nlri length exceeds data",
+			input: []byte{IntraASIPMSIAD, 12, 0, 0, 0xfd, 0xe8},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes, err := UnmarshalMVPNNLRI(tt.input, tt.pathID)
+			if tt.fail {
+				if err == nil {
+					t.Fatalf("expected nlri to fail to unmarshal")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to unmarshal nlri with error: %+v", err)
+			}
+			if len(routes) != len(tt.expect) {
+				t.Fatalf("expected %d routes but got %d", len(tt.expect), len(routes))
+			}
+			for i, r := range routes {
+				if r.Type != LeafAD && r.GetRD() != "65000:100" {
+					t.Errorf("unexpected route distinguisher %s", r.GetRD())
+				}
+				r.RD = nil
+				if r.RouteKey != nil {
+					r.RouteKey.RD = nil
+				}
+				e := tt.expect[i]
+				if r.PathID != e.PathID || r.Type != e.Type || r.SourceAS != e.SourceAS ||
+					r.GetSource() != e.GetSource() || r.GetGroup() != e.GetGroup() ||
+					r.GetOriginatorIP() != e.GetOriginatorIP() || r.SourceLength != e.SourceLength || r.GroupLength != e.GroupLength {
+					t.Errorf("expected route %+v but got %+v", e, r)
+				}
+				if !reflect.DeepEqual(r.RouteKey, e.RouteKey) {
+					t.Errorf("expected route key %+v but got %+v", e.RouteKey, r.RouteKey)
+				}
+			}
+		})
+	}
+}
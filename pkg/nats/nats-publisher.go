@@ -1,38 +1,17 @@
 package nats
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/golang/glog"
 	"github.com/nats-io/nats.go"
 	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/events"
 	"github.com/sbezverk/gobmp/pkg/pub"
 )
 
-// Define constants for each topic name
-const (
-	peerTopic              = "gobmp.parsed.peer"
-	unicastMessageTopic    = "gobmp.parsed.unicast_prefix"
-	unicastMessageV4Topic  = "gobmp.parsed.unicast_prefix_v4"
-	unicastMessageV6Topic  = "gobmp.parsed.unicast_prefix_v6"
-	lsNodeMessageTopic     = "gobmp.parsed.ls_node"
-	lsLinkMessageTopic     = "gobmp.parsed.ls_link"
-	l3vpnMessageTopic      = "gobmp.parsed.l3vpn"
-	l3vpnMessageV4Topic    = "gobmp.parsed.l3vpn_v4"
-	l3vpnMessageV6Topic    = "gobmp.parsed.l3vpn_v6"
-	lsPrefixMessageTopic   = "gobmp.parsed.ls_prefix"
-	lsSRv6SIDMessageTopic  = "gobmp.parsed.ls_srv6_sid"
-	evpnMessageTopic       = "gobmp.parsed.evpn"
-	srPolicyMessageTopic   = "gobmp.parsed.sr_policy"
-	srPolicyMessageV4Topic = "gobmp.parsed.sr_policy_v4"
-	srPolicyMessageV6Topic = "gobmp.parsed.sr_policy_v6"
-	flowspecMessageTopic   = "gobmp.parsed.flowspec"
-	flowspecMessageV4Topic = "gobmp.parsed.flowspec_v4"
-	flowspecMessageV6Topic = "gobmp.parsed.flowspec_v6"
-	statsMessageTopic      = "gobmp.parsed.statistics"
-)
-
 var (
 	maxReconnects = 10
 	natsTimeout   = time.Second
@@ -40,53 +19,34 @@ var (
 )
 
 type publisher struct {
-	nc *nats.Conn
-	js nats.JetStreamContext
+	nc     *nats.Conn
+	js     nats.JetStreamContext
+	prefix string
 }
 
 func (p *publisher) PublishMessage(t int, key []byte, msg []byte) error {
-	switch t {
-	case bmp.PeerStateChangeMsg:
-		return p.produceMessage(peerTopic, key, msg)
-	case bmp.UnicastPrefixMsg:
-		return p.produceMessage(unicastMessageTopic, key, msg)
-	case bmp.UnicastPrefixV4Msg:
-		return p.produceMessage(unicastMessageV4Topic, key, msg)
-	case bmp.UnicastPrefixV6Msg:
-		return p.produceMessage(unicastMessageV6Topic, key, msg)
-	case bmp.LSNodeMsg:
-		return p.produceMessage(lsNodeMessageTopic, key, msg)
-	case bmp.LSLinkMsg:
-		return p.produceMessage(lsLinkMessageTopic, key, msg)
-	case bmp.L3VPNMsg:
-		return p.produceMessage(l3vpnMessageTopic, key, msg)
-	case bmp.L3VPNV4Msg:
-		return p.produceMessage(l3vpnMessageV4Topic, key, msg)
-	case bmp.L3VPNV6Msg:
-		return p.produceMessage(l3vpnMessageV6Topic, key, msg)
-	case bmp.LSPrefixMsg:
-		return p.produceMessage(lsPrefixMessageTopic, key, msg)
-	case bmp.LSSRv6SIDMsg:
-		return p.produceMessage(lsSRv6SIDMessageTopic, key, msg)
-	case bmp.EVPNMsg:
-		return p.produceMessage(evpnMessageTopic, key, msg)
-	case bmp.SRPolicyMsg:
-		return p.produceMessage(srPolicyMessageTopic, key, msg)
-	case bmp.SRPolicyV4Msg:
-		return p.produceMessage(srPolicyMessageV4Topic, key, msg)
-	case bmp.SRPolicyV6Msg:
-		return p.produceMessage(srPolicyMessageV6Topic, key, msg)
-	case bmp.FlowspecMsg:
-		return p.produceMessage(flowspecMessageTopic, key, msg)
-	case bmp.FlowspecV4Msg:
-		return p.produceMessage(flowspecMessageV4Topic, key, msg)
-	case bmp.FlowspecV6Msg:
-		return p.produceMessage(flowspecMessageV6Topic, key, msg)
-	case bmp.StatsReportMsg:
-		return p.produceMessage(statsMessageTopic, key, msg)
+	subject, ok := bmp.MessageTopic(t)
+	if !ok {
+		return fmt.Errorf("not implemented")
 	}
 
-	return fmt.Errorf("not implemented")
+	return p.produceMessage(p.prefix+subject, key, msg)
+}
+
+// PublishValue encodes the message into a pooled buffer, the buffer is reused once the message
+// is acknowledged by JetStream
+func (p *publisher) PublishValue(t int, key []byte, v interface{}) error {
+	subject, ok := bmp.MessageTopic(t)
+	if !ok {
+		return fmt.Errorf("not implemented")
+	}
+	buf, err := pub.EncodeValue(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode a message of type %d with error: %+v", t, err)
+	}
+	defer pub.ReleaseBuffer(buf)
+
+	return p.produceMessage(p.prefix+subject, key, buf.Bytes())
 }
 
 func (p *publisher) produceMessage(subject string, key []byte, data []byte) error {
@@ -102,18 +62,59 @@ func (p *publisher) produceMessage(subject string, key []byte, data []byte) erro
 
 	_, err := p.js.PublishMsg(msg)
 	if err != nil {
+		// Failures of collector events are not reported, they would be published over the same failing publisher
+		if t, _ := bmp.MessageTopic(bmp.CollectorEventMsg); subject != p.prefix+t {
+			events.Report(events.CodePublishFailed, "", "failed to publish message to subject %s with error: %+v", subject, err)
+		}
 		return err
 	}
 
 	return nil
 }
 
+// Healthy returns the error when the connection to NATS server is lost, the connection is reconnected by the client
+func (p *publisher) Healthy() error {
+	if !p.nc.IsConnected() {
+		return fmt.Errorf("connection to NATS server is %s", p.nc.Status())
+	}
+	return nil
+}
+
 func (p *publisher) Stop() {
 	p.nc.Close()
 }
 
-// NewPublisher instantiates a new instance of a NATS publisher
-func NewPublisher(natsSrv string) (pub.Publisher, error) {
+// ensureStream creates JetStream stream capturing subjects of all registered message types prefixed by the prefix
+// when the stream does not exist, an existing stream is used as it is configured
+func ensureStream(js nats.JetStreamContext, name string, prefix string) error {
+	_, err := js.StreamInfo(name)
+	if err == nil {
+		glog.Infof("Using existing NATS JetStream stream %s", name)
+		return nil
+	}
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return fmt.Errorf("failed to get NATS JetStream stream %s with error: %+v", name, err)
+	}
+	subjects := make([]string, 0)
+	for _, mt := range bmp.MessageTypes() {
+		subjects = append(subjects, prefix+mt.Topic)
+	}
+	if _, err := js.AddStream(&nats.StreamConfig{
+		Name:     name,
+		Subjects: subjects,
+		Storage:  nats.FileStorage,
+	}); err != nil {
+		return fmt.Errorf("failed to create NATS JetStream stream %s with error: %+v", name, err)
+	}
+	glog.Infof("Created NATS JetStream stream %s with %d subjects", name, len(subjects))
+
+	return nil
+}
+
+// NewPublisher instantiates a new instance of a NATS publisher, messages are published to subjects named
+// as Kafka topics of their types prefixed by the prefix. When stream is not empty, JetStream stream capturing
+// the subjects is created if it does not exist.
+func NewPublisher(natsSrv string, stream string, prefix string) (pub.Publisher, error) {
 	glog.Infof("Initializing NATS producer client")
 
 	opts := []nats.Option{
@@ -131,11 +132,19 @@ func NewPublisher(natsSrv string) (pub.Publisher, error) {
 	// Create a JetStream context
 	js, err := nc.JetStream()
 	if err != nil {
+		nc.Close()
 		return nil, err
 	}
+	if stream != "" {
+		if err := ensureStream(js, stream, prefix); err != nil {
+			nc.Close()
+			return nil, err
+		}
+	}
 
 	return &publisher{
-		nc: nc,
-		js: js,
+		nc:     nc,
+		js:     js,
+		prefix: prefix,
 	}, nil
 }
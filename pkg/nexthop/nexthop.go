@@ -0,0 +1,258 @@
+package nexthop
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/netip"
+	"strconv"
+	"sync"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/radix"
+)
+
+// unresolvedTag is appended to messages of routes with next hop not resolvable in IGP topology
+var unresolvedTag = []byte(`,"nexthop_unresolved":true}`)
+
+type lsPrefixMsg struct {
+	Action      string `json:"action"`
+	RouterIP    string `json:"router_ip"`
+	PeerIP      string `json:"peer_ip"`
+	PeerRD      string `json:"peer_rd"`
+	ProtocolID  int    `json:"protocol_id"`
+	AreaID      string `json:"area_id"`
+	IGPRouterID string `json:"igp_router_id"`
+	Prefix      string `json:"prefix"`
+	PrefixLen   int32  `json:"prefix_len"`
+}
+
+type routeMsg struct {
+	Action  string `json:"action"`
+	Nexthop string `json:"nexthop"`
+	IsEOR   bool   `json:"is_eor"`
+}
+
+type peerMsg struct {
+	Action   string `json:"action"`
+	RouterIP string `json:"router_ip"`
+	RemoteIP string `json:"remote_ip"`
+	PeerRD   string `json:"peer_rd"`
+}
+
+// feedKey identifies BGP-LS session of a router advertising IGP topology
+type feedKey struct {
+	routerIP string
+	peerRD   string
+	peerIP   string
+}
+
+type checker struct {
+	sync.Mutex
+	publisher pub.Publisher
+	// prefixes stores IGP prefixes with the number of advertisements of the prefix
+	prefixes *radix.Tree[int]
+	// families stores the number of IGP prefixes per address family, true for IPv4
+	families map[bool]int
+	// feeds stores advertisements of IGP prefixes received over BGP-LS sessions
+	feeds map[feedKey]map[string]netip.Prefix
+	// nexthops stores next hops of routes seen and whether they were resolvable
+	nexthops map[netip.Addr]bool
+}
+
+func (c *checker) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	switch msgType {
+	case bmp.LSPrefixMsg:
+		m := &lsPrefixMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode ls prefix message for next hop check with error: %+v", err)
+			break
+		}
+		c.updatePrefix(m)
+	case bmp.PeerStateChangeMsg:
+		m := &peerMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode peer message for next hop check with error: %+v", err)
+			break
+		}
+		if m.Action != "add" {
+			c.removeFeed(feedKey{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.RemoteIP})
+		}
+	case bmp.UnicastPrefixMsg, bmp.UnicastPrefixV4Msg, bmp.UnicastPrefixV6Msg, bmp.L3VPNMsg, bmp.L3VPNV4Msg, bmp.L3VPNV6Msg:
+		m := &routeMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode route message for next hop check with error: %+v", err)
+			break
+		}
+		if m.Action == "add" && !m.IsEOR && !c.resolvable(m.Nexthop) {
+			msg = tag(msg)
+		}
+	}
+
+	return c.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+func (c *checker) Stop() {
+	c.publisher.Stop()
+}
+
+// tag returns a copy of json object msg with nexthop_unresolved key
+func tag(msg []byte) []byte {
+	b := bytes.TrimRight(msg, " \t\r\n")
+	if len(b) < 2 || b[len(b)-1] != '}' {
+		return msg
+	}
+	t := make([]byte, 0, len(b)+len(unresolvedTag))
+	t = append(t, b[:len(b)-1]...)
+	if len(b) == 2 {
+		// Empty object
+		return append(t, unresolvedTag[1:]...)
+	}
+
+	return append(t, unresolvedTag...)
+}
+
+// resolvable returns false if IGP topology of the next hop's address family is known and no IGP prefix
+// other than the default route covers the next hop
+func (c *checker) resolvable(nexthop string) bool {
+	addr, err := netip.ParseAddr(nexthop)
+	if err != nil {
+		return true
+	}
+	addr = addr.Unmap()
+	c.Lock()
+	defer c.Unlock()
+	if c.families[addr.Is4()] == 0 {
+		return true
+	}
+	_, _, ok := c.prefixes.LongestMatch(netip.PrefixFrom(addr, addr.BitLen()))
+	if resolved, seen := c.nexthops[addr]; !seen || resolved != ok {
+		if !ok {
+			glog.V(3).Infof("next hop %s is not resolvable in IGP topology", addr)
+		}
+		c.nexthops[addr] = ok
+	}
+
+	return ok
+}
+
+func (c *checker) updatePrefix(m *lsPrefixMsg) {
+	addr, err := netip.ParseAddr(m.Prefix)
+	if err != nil {
+		return
+	}
+	p, err := addr.Unmap().Prefix(int(m.PrefixLen))
+	if err != nil || p.Bits() == 0 {
+		// The default route does not resolve next hops
+		return
+	}
+	fk := feedKey{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.PeerIP}
+	key := strconv.Itoa(m.ProtocolID) + " " + m.AreaID + " " + m.IGPRouterID + " " + p.String()
+	c.Lock()
+	defer c.Unlock()
+	advs := c.feeds[fk]
+	_, ok := advs[key]
+	if m.Action == "del" {
+		if ok {
+			delete(advs, key)
+			c.release(p)
+		}
+		return
+	}
+	if ok {
+		return
+	}
+	if advs == nil {
+		advs = make(map[string]netip.Prefix)
+		c.feeds[fk] = advs
+	}
+	advs[key] = p
+	n, _ := c.prefixes.Get(p)
+	c.prefixes.Insert(p, n+1)
+	if n == 0 {
+		c.families[p.Addr().Is4()]++
+	}
+}
+
+func (c *checker) removeFeed(fk feedKey) {
+	c.Lock()
+	defer c.Unlock()
+	for _, p := range c.feeds[fk] {
+		c.release(p)
+	}
+	delete(c.feeds, fk)
+}
+
+// EvictPeer removes IGP prefixes advertised over BGP-LS session with the peer of the router, or over all
+// sessions of the router when peerIP is empty
+func (c *checker) EvictPeer(routerIP, peerRD, peerIP string) int {
+	c.Lock()
+	defer c.Unlock()
+	n := 0
+	for fk, advs := range c.feeds {
+		if fk.routerIP != routerIP || (peerIP != "" && (fk.peerRD != peerRD || fk.peerIP != peerIP)) {
+			continue
+		}
+		for _, p := range advs {
+			c.release(p)
+		}
+		n += len(advs)
+		delete(c.feeds, fk)
+	}
+
+	return n
+}
+
+// release removes an advertisement of IGP prefix, when the last advertisement is removed, next hops which are
+// no longer resolvable are reported
+func (c *checker) release(p netip.Prefix) {
+	n, _ := c.prefixes.Get(p)
+	if n > 1 {
+		c.prefixes.Insert(p, n-1)
+		return
+	}
+	c.prefixes.Delete(p)
+	c.families[p.Addr().Is4()]--
+	for addr, resolved := range c.nexthops {
+		if !resolved || !p.Contains(addr) {
+			continue
+		}
+		if _, _, ok := c.prefixes.LongestMatch(netip.PrefixFrom(addr, addr.BitLen())); !ok {
+			glog.Warningf("next hop %s is no longer resolvable in IGP topology after withdrawal of %s", addr, p)
+			c.nexthops[addr] = false
+		}
+	}
+}
+
+// MemoryUsage returns estimated memory used by IGP prefixes advertised per BGP-LS session of routers, the prefixes
+// tree and next hops of routes shared by all routers are counted once
+func (c *checker) MemoryUsage() memory.Usage {
+	c.Lock()
+	defer c.Unlock()
+	u := memory.NewCounter("nexthop")
+	for fk, advs := range c.feeds {
+		for key, p := range advs {
+			u.Add(fk.routerIP, fk.peerIP, uint64(unsafe.Sizeof(key)+unsafe.Sizeof(p))+uint64(len(key))+memory.MapEntryOverhead)
+		}
+	}
+	u.AddShared(c.prefixes.Len(), c.prefixes.Size())
+	u.AddShared(len(c.nexthops), uint64(len(c.nexthops))*(uint64(unsafe.Sizeof(netip.Addr{})+unsafe.Sizeof(true))+memory.MapEntryOverhead))
+
+	return u.Usage()
+}
+
+// NewChecker returns a publisher checking next hops of unicast and l3vpn routes against IGP prefixes received in
+// ls_prefix messages, messages of routes with next hop not covered by any IGP prefix are tagged with
+// "nexthop_unresolved" key and passed to publisher.
+func NewChecker(publisher pub.Publisher) pub.Publisher {
+	return &checker{
+		publisher: publisher,
+		prefixes:  radix.New[int](),
+		families:  make(map[bool]int),
+		feeds:     make(map[feedKey]map[string]netip.Prefix),
+		nexthops:  make(map[netip.Addr]bool),
+	}
+}
@@ -0,0 +1,158 @@
+package nexthop
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	last []byte
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.last = msg
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+type testMsg struct {
+	msgType int
+	msg     string
+}
+
+func TestTag(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want string
+	}{
+		{
+			name: "object",
+			msg:  `{"action":"add"}`,
+			want: `{"action":"add","nexthop_unresolved":true}`,
+		},
+		{
+			name: "object with trailing new line",
+			msg:  "{\"action\":\"add\"}\n",
+			want: `{"action":"add","nexthop_unresolved":true}`,
+		},
+		{
+			name: "empty object",
+			msg:  `{}`,
+			want: `{"nexthop_unresolved":true}`,
+		},
+		{
+			name: "not an object",
+			msg:  `[]`,
+			want: `[]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tag([]byte(tt.msg)); string(got) != tt.want {
+				t.Errorf("expected %s but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestChecker(t *testing.T) {
+	lsPrefix := func(action, routerIP, prefix, plen, node string) testMsg {
+		return testMsg{bmp.LSPrefixMsg, `{"action":"` + action + `","router_ip":"` + routerIP + `","peer_ip":"192.168.0.1","protocol_id":2,"igp_router_id":"` + node + `","prefix":"` + prefix + `","prefix_len":` + plen + `}`}
+	}
+	tests := []struct {
+		name       string
+		topology   []testMsg
+		route      testMsg
+		unresolved bool
+	}{
+		{
+			name:  "unknown topology",
+			route: testMsg{bmp.UnicastPrefixV4Msg, `{"action":"add","prefix":"10.1.0.0","prefix_len":16,"nexthop":"10.9.9.9"}`},
+		},
+		{
+			name:     "resolvable next hop",
+			topology: []testMsg{lsPrefix("add", "10.0.0.1", "10.0.0.0", "24", "0000.0000.0001")},
+			route:    testMsg{bmp.UnicastPrefixV4Msg, `{"action":"add","prefix":"10.1.0.0","prefix_len":16,"nexthop":"10.0.0.7"}`},
+		},
+		{
+			name:       "unresolvable next hop",
+			topology:   []testMsg{lsPrefix("add", "10.0.0.1", "10.0.0.0", "24", "0000.0000.0001")},
+			route:      testMsg{bmp.UnicastPrefixV4Msg, `{"action":"add","prefix":"10.1.0.0","prefix_len":16,"nexthop":"10.9.9.9"}`},
+			unresolved: true,
+		},
+		{
+			name: "default route does not resolve",
+			topology: []testMsg{
+				lsPrefix("add", "10.0.0.1", "10.0.0.0", "24", "0000.0000.0001"),
+				lsPrefix("add", "10.0.0.1", "0.0.0.0", "0", "0000.0000.0001"),
+			},
+			route:      testMsg{bmp.L3VPNV4Msg, `{"action":"add","prefix":"10.1.0.0","prefix_len":16,"vpn_rd":"100:1","nexthop":"10.9.9.9"}`},
+			unresolved: true,
+		},
+		{
+			name:     "unknown topology of address family",
+			topology: []testMsg{lsPrefix("add", "10.0.0.1", "10.0.0.0", "24", "0000.0000.0001")},
+			route:    testMsg{bmp.UnicastPrefixV6Msg, `{"action":"add","prefix":"2001:db8::","prefix_len":32,"nexthop":"2001:db8:ffff::1"}`},
+		},
+		{
+			name:     "ipv4-mapped next hop",
+			topology: []testMsg{lsPrefix("add", "10.0.0.1", "10.0.0.0", "24", "0000.0000.0001")},
+			route:    testMsg{bmp.L3VPNV6Msg, `{"action":"add","prefix":"2001:db8::","prefix_len":32,"vpn_rd":"100:1","nexthop":"::ffff:10.0.0.7"}`},
+		},
+		{
+			name: "withdrawn prefix",
+			topology: []testMsg{
+				lsPrefix("add", "10.0.0.1", "10.0.0.0", "24", "0000.0000.0001"),
+				lsPrefix("add", "10.0.0.1", "10.0.1.0", "24", "0000.0000.0002"),
+				lsPrefix("del", "10.0.0.1", "10.0.0.0", "24", "0000.0000.0001"),
+			},
+			route:      testMsg{bmp.UnicastPrefixV4Msg, `{"action":"add","prefix":"10.1.0.0","prefix_len":16,"nexthop":"10.0.0.7"}`},
+			unresolved: true,
+		},
+		{
+			name: "prefix advertised by another feed",
+			topology: []testMsg{
+				lsPrefix("add", "10.0.0.1", "10.0.0.0", "24", "0000.0000.0001"),
+				lsPrefix("add", "10.0.0.2", "10.0.0.0", "24", "0000.0000.0001"),
+				lsPrefix("del", "10.0.0.1", "10.0.0.0", "24", "0000.0000.0001"),
+			},
+			route: testMsg{bmp.UnicastPrefixV4Msg, `{"action":"add","prefix":"10.1.0.0","prefix_len":16,"nexthop":"10.0.0.7"}`},
+		},
+		{
+			name: "feed down",
+			topology: []testMsg{
+				lsPrefix("add", "10.0.0.1", "10.0.0.0", "24", "0000.0000.0001"),
+				lsPrefix("add", "10.0.0.2", "10.0.1.0", "24", "0000.0000.0002"),
+				{bmp.PeerStateChangeMsg, `{"action":"down","router_ip":"10.0.0.1","remote_ip":"192.168.0.1"}`},
+			},
+			route:      testMsg{bmp.UnicastPrefixV4Msg, `{"action":"add","prefix":"10.1.0.0","prefix_len":16,"nexthop":"10.0.0.7"}`},
+			unresolved: true,
+		},
+		{
+			name:     "withdrawn route",
+			topology: []testMsg{lsPrefix("add", "10.0.0.1", "10.0.0.0", "24", "0000.0000.0001")},
+			route:    testMsg{bmp.UnicastPrefixV4Msg, `{"action":"del","prefix":"10.1.0.0","prefix_len":16}`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{}
+			c := NewChecker(p)
+			for _, m := range tt.topology {
+				if err := c.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+			}
+			if err := c.PublishMessage(tt.route.msgType, nil, []byte(tt.route.msg)); err != nil {
+				t.Fatalf("failed to publish message with error: %+v", err)
+			}
+			if unresolved := bytes.Contains(p.last, []byte(`"nexthop_unresolved":true`)); unresolved != tt.unresolved {
+				t.Errorf("expected unresolved %t but got message %s", tt.unresolved, p.last)
+			}
+		})
+	}
+}
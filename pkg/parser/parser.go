@@ -1,27 +1,107 @@
 package parser
 
 import (
+	"context"
+	"time"
+
 	"github.com/golang/glog"
 	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/tracing"
 	"github.com/sbezverk/tools"
 )
 
+// Request is a BMP message passed to the parser with the context carrying the trace of the message
+type Request struct {
+	Context context.Context
+	Message []byte
+}
+
+// ErrorHandler is called by parsing workers with the type of BMP message which failed to parse
+type ErrorHandler func(msgType byte, err error)
+
+func (h ErrorHandler) report(msgType byte, err error) {
+	if h != nil {
+		h(msgType, err)
+	}
+}
+
 // Parser dispatches workers upon request received from the channel
 func Parser(queue chan []byte, producerQueue chan bmp.Message, stop chan struct{}) {
+	ParserWithErrorHandler(queue, producerQueue, stop, nil)
+}
+
+// ParserWithErrorHandler dispatches workers upon request received from the channel, parsing errors are
+// reported to the handler, if it is not nil
+func ParserWithErrorHandler(queue chan []byte, producerQueue chan bmp.Message, stop chan struct{}, handler ErrorHandler) {
+	ParserWithWorkers(queue, producerQueue, stop, 0, handler)
+}
+
+// ParserWithWorkers dispatches at most workers concurrent workers upon request received from the channel,
+// the number of workers is not limited when workers is 0, parsing errors are reported to the handler, if it is not nil
+func ParserWithWorkers(queue chan []byte, producerQueue chan bmp.Message, stop chan struct{}, workers int, handler ErrorHandler) {
+	dispatch(queue, nil, producerQueue, stop, workers, handler)
+}
+
+// ParserWithRequests dispatches workers as ParserWithWorkers upon requests carrying contexts of traces of messages,
+// parsing of the message is traced as a child of the span of the request's context
+func ParserWithRequests(queue chan Request, producerQueue chan bmp.Message, stop chan struct{}, workers int, handler ErrorHandler) {
+	dispatch(nil, queue, producerQueue, stop, workers, handler)
+}
+
+// dispatch dispatches workers upon messages received from either of queue or requests channels
+func dispatch(queue chan []byte, requests chan Request, producerQueue chan bmp.Message, stop chan struct{}, workers int, handler ErrorHandler) {
+	var sem chan struct{}
+	if workers > 0 {
+		sem = make(chan struct{}, workers)
+	}
 	for {
+		var req Request
 		select {
-		case msg := <-queue:
-			go parsingWorker(msg, producerQueue)
+		case req.Message = <-queue:
+		case req = <-requests:
 		case <-stop:
 			glog.Infof("received interrupt, stopping.")
 			return
 		}
+		if sem == nil {
+			go parse(req.Context, req.Message, producerQueue, handler)
+			continue
+		}
+		// Waiting for a worker to complete before the next message is taken from the queue
+		select {
+		case sem <- struct{}{}:
+		case <-stop:
+			glog.Infof("received interrupt, stopping.")
+			return
+		}
+		go func(req Request) {
+			defer func() { <-sem }()
+			parse(req.Context, req.Message, producerQueue, handler)
+		}(req)
 	}
 }
 
-func parsingWorker(b []byte, producerQueue chan bmp.Message) {
+// Parse parses BMP messages of b and sends them to producerQueue, it returns once all parsed messages are sent,
+// parsing errors are reported to the handler, if it is not nil
+func Parse(b []byte, producerQueue chan bmp.Message, handler ErrorHandler) {
+	parsingWorker(b, producerQueue, handler)
+}
+
+func parsingWorker(b []byte, producerQueue chan bmp.Message, handler ErrorHandler) {
+	parse(nil, b, producerQueue, handler)
+}
+
+// parse parses BMP messages of b, messages sent to producerQueue carry the context of the parsing span
+func parse(ctx context.Context, b []byte, producerQueue chan bmp.Message, handler ErrorHandler) {
+	ctx, span := tracing.Start(ctx, "bmp.parse", tracing.KindInternal)
+	defer span.End()
 	perPerHeaderLen := 0
-	var bmpMsg bmp.Message
+	bmpMsg := bmp.Message{Context: ctx}
+	received := time.Now()
+	report := func(msgType byte, err error) {
+		span.SetError(err)
+		handler.report(msgType, err)
+	}
 	// Loop through all found Common Headers in the slice and process them
 	for p := 0; p < len(b); {
 		bmpMsg.PeerHeader = nil
@@ -30,17 +110,26 @@ func parsingWorker(b []byte, producerQueue chan bmp.Message) {
 		ch, err := bmp.UnmarshalCommonHeader(b[p : p+bmp.CommonHeaderLength])
 		if err != nil {
 			glog.Errorf("fail to recover BMP message Common Header with error: %+v", err)
+			span.SetError(err)
 			return
 		}
+		if p == 0 && span != nil {
+			span.SetAttributes(tracing.Int("bmp.message_type", int(ch.MessageType)), tracing.Int("bmp.length", len(b)))
+		}
 		p += bmp.CommonHeaderLength
 		switch ch.MessageType {
 		case bmp.RouteMonitorMsg:
 			if bmpMsg.PeerHeader, err = bmp.UnmarshalPerPeerHeader(b[p : p+bmp.PerPeerHeaderLength]); err != nil {
 				glog.Errorf("fail to recover BMP Per Peer Header with error: %+v", err)
+				report(ch.MessageType, err)
 				return
 			}
 			perPerHeaderLen = bmp.PerPeerHeaderLength
-			rm, err := bmp.UnmarshalBMPRouteMonitorMessage(b[p+perPerHeaderLen : p+int(ch.MessageLength)-bmp.CommonHeaderLength])
+			unmarshal := bmp.UnmarshalBMPRouteMonitorMessage
+			if ch.Version == bmp.Version4 {
+				unmarshal = bmp.UnmarshalBMPv4RouteMonitorMessage
+			}
+			rm, err := unmarshal(b[p+perPerHeaderLen : p+int(ch.MessageLength)-bmp.CommonHeaderLength])
 			if err != nil {
 				glog.Errorf("fail to recover BMP Route Monitoring with error: %+v", err)
 				if glog.V(5) {
@@ -48,6 +137,7 @@ func parsingWorker(b []byte, producerQueue chan bmp.Message) {
 					glog.Infof("per peer header content: %s", tools.MessageHex(b[p:p+bmp.PerPeerHeaderLength]))
 					glog.Infof("message content: %s", tools.MessageHex(b[p+perPerHeaderLen:p+int(ch.MessageLength)-bmp.CommonHeaderLength]))
 				}
+				report(ch.MessageType, err)
 				return
 			}
 			bmpMsg.Payload = rm
@@ -55,39 +145,46 @@ func parsingWorker(b []byte, producerQueue chan bmp.Message) {
 		case bmp.StatsReportMsg:
 			if bmpMsg.PeerHeader, err = bmp.UnmarshalPerPeerHeader(b[p : p+int(ch.MessageLength-bmp.CommonHeaderLength)]); err != nil {
 				glog.Errorf("fail to recover BMP Per Peer Header with error: %+v", err)
+				report(ch.MessageType, err)
 				return
 			}
 			perPerHeaderLen = bmp.PerPeerHeaderLength
 			if bmpMsg.Payload, err = bmp.UnmarshalBMPStatsReportMessage(b[p+perPerHeaderLen:]); err != nil {
 				glog.Errorf("fail to recover BMP Stats Reports message with error: %+v", err)
+				report(ch.MessageType, err)
 				return
 			}
 			p += perPerHeaderLen
 		case bmp.PeerDownMsg:
 			if bmpMsg.PeerHeader, err = bmp.UnmarshalPerPeerHeader(b[p : p+int(ch.MessageLength-bmp.CommonHeaderLength)]); err != nil {
 				glog.Errorf("fail to recover BMP Per Peer Header with error: %+v", err)
+				report(ch.MessageType, err)
 				return
 			}
 			perPerHeaderLen = bmp.PerPeerHeaderLength
 			if bmpMsg.Payload, err = bmp.UnmarshalPeerDownMessage(b[p+perPerHeaderLen : p+int(ch.MessageLength)-bmp.CommonHeaderLength]); err != nil {
 				glog.Errorf("fail to recover BMP Peer Down message with error: %+v", err)
+				report(ch.MessageType, err)
 				return
 			}
 			p += perPerHeaderLen
 		case bmp.PeerUpMsg:
 			if bmpMsg.PeerHeader, err = bmp.UnmarshalPerPeerHeader(b[p : p+int(ch.MessageLength-bmp.CommonHeaderLength)]); err != nil {
 				glog.Errorf("fail to recover BMP Per Peer Header with error: %+v", err)
+				report(ch.MessageType, err)
 				return
 			}
 			perPerHeaderLen = bmp.PerPeerHeaderLength
 			if bmpMsg.Payload, err = bmp.UnmarshalPeerUpMessage(b[p+perPerHeaderLen:p+int(ch.MessageLength)-bmp.CommonHeaderLength], bmpMsg.PeerHeader.IsRemotePeerIPv6()); err != nil {
 				glog.Errorf("fail to recover BMP Peer Up message with error: %+v", err)
+				report(ch.MessageType, err)
 				return
 			}
 			p += perPerHeaderLen
 		case bmp.InitiationMsg:
 			if _, err := bmp.UnmarshalInitiationMessage(b[p : p+(int(ch.MessageLength)-bmp.CommonHeaderLength)]); err != nil {
 				glog.Errorf("fail to recover BMP Initiation message with error: %+v", err)
+				report(ch.MessageType, err)
 				return
 			}
 		case bmp.TerminationMsg:
@@ -97,13 +194,35 @@ func parsingWorker(b []byte, producerQueue chan bmp.Message) {
 			}
 		case bmp.RouteMirrorMsg:
 			glog.V(5).Infof("Route Mirroring message")
-			if glog.V(6) {
-				glog.Infof("Content:%s", tools.MessageHex(b))
+			if bmpMsg.PeerHeader, err = bmp.UnmarshalPerPeerHeader(b[p : p+int(ch.MessageLength-bmp.CommonHeaderLength)]); err != nil {
+				glog.Errorf("fail to recover BMP Per Peer Header with error: %+v", err)
+				report(ch.MessageType, err)
+				return
+			}
+			perPerHeaderLen = bmp.PerPeerHeaderLength
+			if bmpMsg.Payload, err = bmp.UnmarshalBMPRouteMirrorMessage(b[p+perPerHeaderLen : p+int(ch.MessageLength)-bmp.CommonHeaderLength]); err != nil {
+				glog.Errorf("fail to recover BMP Route Mirroring message with error: %+v", err)
+				report(ch.MessageType, err)
+				return
 			}
+			p += perPerHeaderLen
 		}
 		p += (int(ch.MessageLength) - bmp.CommonHeaderLength)
+		if bmpMsg.PeerHeader != nil {
+			bmpMsg.PeerHeader.ReceivedAt = received
+		}
 		if producerQueue != nil && bmpMsg.Payload != nil {
 			producerQueue <- bmpMsg
 		}
+		// Route Monitoring message of several peers is published as messages of each of the peers
+		if rm, ok := bmpMsg.Payload.(*bmp.RouteMonitor); ok {
+			for _, m := range rm.Split() {
+				m.PeerHeader.ReceivedAt = received
+				m.Context = ctx
+				if producerQueue != nil {
+					producerQueue <- m
+				}
+			}
+		}
 	}
 }
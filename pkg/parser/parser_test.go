@@ -1,6 +1,14 @@
 package parser
 
-import "testing"
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/tracing"
+)
 
 func TestParsingWorker(t *testing.T) {
 	tests := []struct {
@@ -14,7 +22,120 @@ func TestParsingWorker(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			parsingWorker(tt.input, nil)
+			parsingWorker(tt.input, nil, nil)
 		})
 	}
 }
+
+func TestParserWithWorkers(t *testing.T) {
+	// Peer Up message of "test 1" following the Initiation message
+	peerUp := []byte{3, 0, 0, 0, 234, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 192, 168, 80, 103, 0, 0, 19, 206, 57, 112, 1, 254, 94, 98, 129, 171, 0, 0, 215, 126, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 192, 168, 80, 128, 0, 179, 131, 152, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 0, 91, 1, 4, 19, 206, 0, 90, 192, 168, 8, 8, 62, 2, 6, 1, 4, 0, 1, 0, 1, 2, 6, 1, 4, 0, 1, 0, 4, 2, 6, 1, 4, 0, 1, 0, 128, 2, 2, 128, 0, 2, 2, 2, 0, 2, 6, 65, 4, 0, 0, 19, 206, 2, 20, 5, 18, 0, 1, 0, 1, 0, 2, 0, 1, 0, 2, 0, 2, 0, 1, 0, 128, 0, 2, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 0, 75, 1, 4, 19, 206, 0, 90, 57, 112, 1, 254, 46, 2, 44, 2, 0, 1, 4, 0, 1, 0, 1, 1, 4, 0, 2, 0, 1, 1, 4, 0, 1, 0, 4, 1, 4, 0, 2, 0, 4, 1, 4, 0, 1, 0, 128, 1, 4, 0, 2, 0, 128, 65, 4, 0, 0, 19, 206}
+	tests := []struct {
+		name    string
+		workers int
+	}{
+		{
+			name:    "unlimited workers",
+			workers: 0,
+		},
+		{
+			name:    "single worker",
+			workers: 1,
+		},
+		{
+			name:    "two workers",
+			workers: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := make(chan []byte)
+			producerQueue := make(chan bmp.Message)
+			stop := make(chan struct{})
+			defer close(stop)
+			go ParserWithWorkers(queue, producerQueue, stop, tt.workers, nil)
+			n := 5
+			go func() {
+				for i := 0; i < n; i++ {
+					queue <- peerUp
+				}
+			}()
+			for i := 0; i < n; i++ {
+				select {
+				case msg := <-producerQueue:
+					if _, ok := msg.Payload.(*bmp.PeerUpMessage); !ok {
+						t.Fatalf("expected peer up message but got %T", msg.Payload)
+					}
+				case <-time.After(time.Second):
+					t.Fatalf("parsed %d of %d messages", i, n)
+				}
+			}
+		})
+	}
+}
+
+func TestParserWithRequests(t *testing.T) {
+	// Peer Up message of "test 1" following the Initiation message
+	peerUp := []byte{3, 0, 0, 0, 234, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 192, 168, 80, 103, 0, 0, 19, 206, 57, 112, 1, 254, 94, 98, 129, 171, 0, 0, 215, 126, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 192, 168, 80, 128, 0, 179, 131, 152, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 0, 91, 1, 4, 19, 206, 0, 90, 192, 168, 8, 8, 62, 2, 6, 1, 4, 0, 1, 0, 1, 2, 6, 1, 4, 0, 1, 0, 4, 2, 6, 1, 4, 0, 1, 0, 128, 2, 2, 128, 0, 2, 2, 2, 0, 2, 6, 65, 4, 0, 0, 19, 206, 2, 20, 5, 18, 0, 1, 0, 1, 0, 2, 0, 1, 0, 2, 0, 2, 0, 1, 0, 128, 0, 2, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 0, 75, 1, 4, 19, 206, 0, 90, 57, 112, 1, 254, 46, 2, 44, 2, 0, 1, 4, 0, 1, 0, 1, 1, 4, 0, 2, 0, 1, 1, 4, 0, 1, 0, 4, 1, 4, 0, 2, 0, 4, 1, 4, 0, 1, 0, 128, 1, 4, 0, 2, 0, 128, 65, 4, 0, 0, 19, 206}
+	tracer, err := tracing.NewTracer(&tracing.Config{Endpoint: "http://127.0.0.1:1", SampleRatio: 1})
+	if err != nil {
+		t.Fatalf("failed to create tracer with error: %+v", err)
+	}
+	defer tracer.Stop()
+	tracing.SetDefault(tracer)
+	defer tracing.SetDefault(nil)
+	queue := make(chan Request)
+	producerQueue := make(chan bmp.Message)
+	stop := make(chan struct{})
+	defer close(stop)
+	go ParserWithRequests(queue, producerQueue, stop, 1, nil)
+	ctx, receive := tracing.Start(context.Background(), "bmp.receive", tracing.KindServer)
+	defer receive.End()
+	queue <- Request{Context: ctx, Message: peerUp}
+	select {
+	case msg := <-producerQueue:
+		parse := tracing.SpanFromContext(msg.Context)
+		if parse == nil || parse == receive {
+			t.Fatalf("expected message to carry the span of parsing")
+		}
+		if parse.TraceID() != receive.TraceID() {
+			t.Errorf("expected parsing span of trace %s but got %s", receive.TraceID(), parse.TraceID())
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("message is not parsed")
+	}
+}
+
+func TestParsingWorkerPeerHeaders(t *testing.T) {
+	// BMP v4 Route Monitoring message of peer 192.168.1.1 with Peer Headers TLV of peers 192.168.1.2 and 192.168.1.3,
+	// BGP Update carries NLRI 10.1.1.0/24
+	peer := func(ip byte) []byte {
+		return []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 192, 168, 1, ip, 0, 0, 253, 233, 192, 168, 1, ip, 0, 0, 0, 0, 0, 0, 0, 0}
+	}
+	pdu := []byte{255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 0, 41, 2, 0, 0, 0, 14, 64, 1, 1, 0, 64, 2, 0, 64, 3, 4, 10, 0, 0, 1, 24, 10, 1, 1}
+	headers := append(peer(2), peer(3)...)
+	body := append(peer(1), 0, 4, 0, byte(len(pdu)), 0, 0)
+	body = append(body, pdu...)
+	body = append(body, 0, 5, 0, byte(len(headers)), 0, 0)
+	body = append(body, headers...)
+	input := []byte{4, 0, 0, 0, byte(bmp.CommonHeaderLength + len(body)), bmp.RouteMonitorMsg}
+	input = append(input, body...)
+	bmp.SetPeerHeadersTLV(5)
+	defer bmp.SetPeerHeadersTLV(0)
+	producerQueue := make(chan bmp.Message, 4)
+	parsingWorker(input, producerQueue, nil)
+	close(producerQueue)
+	var peers []string
+	for msg := range producerQueue {
+		if _, ok := msg.Payload.(*bmp.RouteMonitor); !ok {
+			t.Fatalf("expected route monitor message but got %T", msg.Payload)
+		}
+		if msg.PeerHeader.ReceivedAt.IsZero() {
+			t.Errorf("expected receive time of peer %s", msg.PeerHeader.GetPeerAddrString())
+		}
+		peers = append(peers, msg.PeerHeader.GetPeerAddrString())
+	}
+	if expect := []string{"192.168.1.1", "192.168.1.2", "192.168.1.3"}; !reflect.DeepEqual(peers, expect) {
+		t.Errorf("expected messages of peers %v but got %v", expect, peers)
+	}
+}
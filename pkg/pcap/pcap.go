@@ -0,0 +1,186 @@
+// Package pcap reads TCP streams of packet captures in pcap and pcapng formats, so BMP sessions captured on the wire
+// are replayed as if they were received by the collector. Segments are reassembled in the order of their sequence
+// numbers, retransmitted segments are dropped and streams report bytes missing from the capture.
+package pcap
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Magic numbers of capture formats
+const (
+	pcapMagic      = 0xa1b2c3d4
+	pcapNanoMagic  = 0xa1b23c4d
+	pcapngSHB      = 0x0a0d0d0a
+	pcapngByteMark = 0x1a2b3c4d
+)
+
+// pcapng block types
+const (
+	blockIDB = 0x00000001
+	blockPB  = 0x00000002
+	blockSPB = 0x00000003
+	blockEPB = 0x00000006
+)
+
+// maxBlock is the maximum length of pcap records and pcapng blocks
+const maxBlock = 1 << 24
+
+// IsCapture returns true when b starts with the magic number of pcap or pcapng files
+func IsCapture(b []byte) bool {
+	if len(b) < 4 {
+		return false
+	}
+	be, le := binary.BigEndian.Uint32(b), binary.LittleEndian.Uint32(b)
+	for _, m := range []uint32{pcapMagic, pcapNanoMagic, pcapngSHB} {
+		if be == m || le == m {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReadStreams reads the capture and passes TCP streams to or from the port to the handler, 0 port selects all TCP
+// streams. Streams still open at the end of the capture are closed.
+func ReadStreams(r io.Reader, port uint16, h Handler) error {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(4)
+	if err != nil {
+		return fmt.Errorf("failed to read capture header with error: %+v", err)
+	}
+	a := newAssembler(port, h)
+	defer a.closeAll()
+	if binary.BigEndian.Uint32(magic) == pcapngSHB {
+		return readPcapng(br, a)
+	}
+
+	return readPcap(br, a)
+}
+
+func readPcap(r io.Reader, a *assembler) error {
+	var hdr [24]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return fmt.Errorf("failed to read pcap header with error: %+v", err)
+	}
+	var order binary.ByteOrder
+	switch {
+	case binary.LittleEndian.Uint32(hdr[:]) == pcapMagic || binary.LittleEndian.Uint32(hdr[:]) == pcapNanoMagic:
+		order = binary.LittleEndian
+	case binary.BigEndian.Uint32(hdr[:]) == pcapMagic || binary.BigEndian.Uint32(hdr[:]) == pcapNanoMagic:
+		order = binary.BigEndian
+	default:
+		return fmt.Errorf("invalid pcap magic number 0x%x", hdr[:4])
+	}
+	// Upper bits of the link type carry FCS information
+	link := order.Uint32(hdr[20:]) & 0x0fffffff
+	var rec [16]byte
+	for {
+		if _, err := io.ReadFull(r, rec[:]); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("failed to read pcap record with error: %+v", err)
+		}
+		captured, length := order.Uint32(rec[8:]), order.Uint32(rec[12:])
+		if captured > maxBlock {
+			return fmt.Errorf("invalid captured length %d of pcap record", captured)
+		}
+		data := make([]byte, captured)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return fmt.Errorf("failed to read pcap record with error: %+v", err)
+		}
+		a.packet(link, data, captured < length)
+	}
+}
+
+func readPcapng(r io.Reader, a *assembler) error {
+	var order binary.ByteOrder = binary.LittleEndian
+	// links holds link types of interfaces of the current section
+	var links []uint32
+	var snaplens []uint32
+	var hdr [8]byte
+	for {
+		if _, err := io.ReadFull(r, hdr[:]); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("failed to read pcapng block with error: %+v", err)
+		}
+		if binary.BigEndian.Uint32(hdr[:]) == pcapngSHB {
+			// Section header block sets the byte order of the section
+			var mark [4]byte
+			if _, err := io.ReadFull(r, mark[:]); err != nil {
+				return fmt.Errorf("failed to read pcapng section header with error: %+v", err)
+			}
+			switch {
+			case binary.LittleEndian.Uint32(mark[:]) == pcapngByteMark:
+				order = binary.LittleEndian
+			case binary.BigEndian.Uint32(mark[:]) == pcapngByteMark:
+				order = binary.BigEndian
+			default:
+				return fmt.Errorf("invalid pcapng byte order magic 0x%x", mark)
+			}
+			length := order.Uint32(hdr[4:])
+			if length < 28 || length > maxBlock || length%4 != 0 {
+				return fmt.Errorf("invalid pcapng section header length %d", length)
+			}
+			if _, err := io.CopyN(io.Discard, r, int64(length)-12); err != nil {
+				return fmt.Errorf("failed to read pcapng section header with error: %+v", err)
+			}
+			links, snaplens = nil, nil
+			continue
+		}
+		t, length := order.Uint32(hdr[:]), order.Uint32(hdr[4:])
+		if length < 12 || length > maxBlock || length%4 != 0 {
+			return fmt.Errorf("invalid pcapng block length %d", length)
+		}
+		body := make([]byte, length-8)
+		if _, err := io.ReadFull(r, body); err != nil {
+			return fmt.Errorf("failed to read pcapng block with error: %+v", err)
+		}
+		// The trailing length is not part of the body
+		body = body[:len(body)-4]
+		switch t {
+		case blockIDB:
+			if len(body) < 8 {
+				return fmt.Errorf("invalid pcapng interface description block")
+			}
+			links = append(links, uint32(order.Uint16(body)))
+			snaplens = append(snaplens, order.Uint32(body[4:]))
+		case blockEPB, blockPB:
+			var id, captured, orig uint32
+			if t == blockEPB {
+				if len(body) < 20 {
+					return fmt.Errorf("invalid pcapng enhanced packet block")
+				}
+				id, captured, orig = order.Uint32(body), order.Uint32(body[12:]), order.Uint32(body[16:])
+			} else {
+				if len(body) < 20 {
+					return fmt.Errorf("invalid pcapng packet block")
+				}
+				id, captured, orig = uint32(order.Uint16(body)), order.Uint32(body[12:]), order.Uint32(body[16:])
+			}
+			if int(id) >= len(links) || captured > uint32(len(body)-20) {
+				return fmt.Errorf("invalid pcapng packet block of interface %d", id)
+			}
+			a.packet(links[id], body[20:20+captured], captured < orig)
+		case blockSPB:
+			if len(body) < 4 || len(links) == 0 {
+				return fmt.Errorf("invalid pcapng simple packet block")
+			}
+			orig := order.Uint32(body)
+			captured := uint32(len(body) - 4)
+			if orig < captured {
+				captured = orig
+			}
+			if snaplens[0] != 0 && snaplens[0] < captured {
+				captured = snaplens[0]
+			}
+			a.packet(links[0], body[4:4+captured], captured < orig)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package pcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+type testHandler struct {
+	data   map[string]string
+	lost   map[string][]int
+	closed []string
+}
+
+func newTestHandler() *testHandler {
+	return &testHandler{data: make(map[string]string), lost: make(map[string][]int)}
+}
+
+func (h *testHandler) Data(flow Flow, data []byte, lost bool) {
+	f := flow.String()
+	if lost {
+		h.lost[f] = append(h.lost[f], len(h.data[f]))
+	}
+	h.data[f] += string(data)
+}
+
+func (h *testHandler) Close(flow Flow) {
+	h.closed = append(h.closed, flow.String())
+}
+
+// segment returns TCP segment of the flow
+func segment(src, dst string, seq uint32, flags byte, data string) []byte {
+	s, d := netip.MustParseAddrPort(src), netip.MustParseAddrPort(dst)
+	tcp := make([]byte, 20, 20+len(data))
+	binary.BigEndian.PutUint16(tcp, s.Port())
+	binary.BigEndian.PutUint16(tcp[2:], d.Port())
+	binary.BigEndian.PutUint32(tcp[4:], seq)
+	tcp[12], tcp[13] = 5<<4, flags
+	tcp = append(tcp, data...)
+	if s.Addr().Is4() {
+		ip := make([]byte, 20, 20+len(tcp))
+		ip[0], ip[8], ip[9] = 0x45, 64, protoTCP
+		binary.BigEndian.PutUint16(ip[2:], uint16(20+len(tcp)))
+		copy(ip[12:], s.Addr().AsSlice())
+		copy(ip[16:], d.Addr().AsSlice())
+		return append(ip, tcp...)
+	}
+	ip := make([]byte, 40, 40+len(tcp))
+	ip[0], ip[6], ip[7] = 0x60, protoTCP, 64
+	binary.BigEndian.PutUint16(ip[4:], uint16(len(tcp)))
+	copy(ip[8:], s.Addr().AsSlice())
+	copy(ip[24:], d.Addr().AsSlice())
+
+	return append(ip, tcp...)
+}
+
+func ethernet(ip []byte) []byte {
+	b := make([]byte, 14, 18+len(ip))
+	// 802.1Q tagged frame
+	binary.BigEndian.PutUint16(b[12:], etherVLAN)
+	b = append(b, 0, 10, 0x08, 0x00)
+	b = append(b, ip...)
+	// Frames are padded to the minimum length
+	for len(b) < 60 {
+		b = append(b, 0)
+	}
+
+	return b
+}
+
+func pcapFile(order binary.ByteOrder, link uint32, packets ...[]byte) []byte {
+	hdr := make([]byte, 24)
+	order.PutUint32(hdr, pcapMagic)
+	order.PutUint16(hdr[4:], 2)
+	order.PutUint16(hdr[6:], 4)
+	order.PutUint32(hdr[16:], 65535)
+	order.PutUint32(hdr[20:], link)
+	b := bytes.NewBuffer(hdr)
+	for _, p := range packets {
+		rec := make([]byte, 16)
+		order.PutUint32(rec[8:], uint32(len(p)))
+		order.PutUint32(rec[12:], uint32(len(p)))
+		b.Write(rec)
+		b.Write(p)
+	}
+
+	return b.Bytes()
+}
+
+func block(t uint32, body []byte) []byte {
+	for len(body)%4 != 0 {
+		body = append(body, 0)
+	}
+	b := make([]byte, 8, 12+len(body))
+	binary.LittleEndian.PutUint32(b, t)
+	binary.LittleEndian.PutUint32(b[4:], uint32(12+len(body)))
+	b = append(b, body...)
+
+	return binary.LittleEndian.AppendUint32(b, uint32(12+len(body)))
+}
+
+func pcapngFile(packets ...[]byte) []byte {
+	shb := make([]byte, 16)
+	binary.LittleEndian.PutUint32(shb, pcapngByteMark)
+	binary.LittleEndian.PutUint16(shb[4:], 1)
+	binary.LittleEndian.PutUint64(shb[8:], 0xffffffffffffffff)
+	b := block(pcapngSHB, shb)
+	idb := make([]byte, 8)
+	binary.LittleEndian.PutUint16(idb, linkSLL)
+	b = append(b, block(blockIDB, idb)...)
+	for i, p := range packets {
+		sll := append(make([]byte, 14), 0x86, 0xdd)
+		p = append(sll, p...)
+		if i%2 == 0 {
+			epb := make([]byte, 20)
+			binary.LittleEndian.PutUint32(epb[12:], uint32(len(p)))
+			binary.LittleEndian.PutUint32(epb[16:], uint32(len(p)))
+			b = append(b, block(blockEPB, append(epb, p...))...)
+			continue
+		}
+		spb := binary.LittleEndian.AppendUint32(nil, uint32(len(p)))
+		b = append(b, block(blockSPB, append(spb, p...))...)
+	}
+
+	return b
+}
+
+func TestReadStreams(t *testing.T) {
+	const router, collector = "192.0.2.1:40000", "198.51.100.1:11019"
+	v4 := []([]byte){
+		segment(router, collector, 100, tcpSyn, ""),
+		segment(collector, router, 500, tcpSyn|0x10, ""),
+		segment(router, collector, 101, 0x10, "abc"),
+		// Out of order segment
+		segment(router, collector, 107, 0x10, "ghi"),
+		segment(router, collector, 104, 0x10, "def"),
+		// Retransmission overlapping delivered bytes
+		segment(router, collector, 105, 0x10, "efghij"),
+		// Other port
+		segment("192.0.2.2:40000", "198.51.100.1:179", 1, 0x10, "bgp"),
+		segment(router, collector, 111, tcpFin|0x10, "kl"),
+		// Stream captured after the connection was established with a segment never captured
+		segment("192.0.2.3:40001", collector, 1000, 0x10, "xy"),
+		segment("192.0.2.3:40001", collector, 1005, 0x10, "z"),
+	}
+	v6 := []([]byte){
+		segment("[2001:db8::1]:40000", "[2001:db8::2]:11019", 0xfffffffe, tcpSyn, ""),
+		// Sequence numbers wrap around
+		segment("[2001:db8::1]:40000", "[2001:db8::2]:11019", 0xffffffff, 0x10, "ab"),
+		segment("[2001:db8::1]:40000", "[2001:db8::2]:11019", 1, 0x10, "cd"),
+	}
+	tests := []struct {
+		name    string
+		capture []byte
+		port    uint16
+		data    map[string]string
+		lost    map[string][]int
+		closed  []string
+	}{
+		{
+			name:    "pcap little endian",
+			capture: pcapFile(binary.LittleEndian, linkEthernet, mapEthernet(v4)...),
+			port:    11019,
+			data: map[string]string{
+				router + "->" + collector:             "abcdefghijkl",
+				"192.0.2.3:40001->198.51.100.1:11019": "xyz",
+			},
+			lost: map[string][]int{"192.0.2.3:40001->198.51.100.1:11019": {0, 2}},
+			closed: []string{
+				router + "->" + collector,
+				"192.0.2.3:40001->198.51.100.1:11019",
+				collector + "->" + router,
+			},
+		},
+		{
+			name:    "pcap big endian raw ip of all ports",
+			capture: pcapFile(binary.BigEndian, linkRaw, v4[6]),
+			data:    map[string]string{"192.0.2.2:40000->198.51.100.1:179": "bgp"},
+			lost:    map[string][]int{"192.0.2.2:40000->198.51.100.1:179": {0}},
+			closed:  []string{"192.0.2.2:40000->198.51.100.1:179"},
+		},
+		{
+			name:    "pcapng",
+			capture: pcapngFile(v6...),
+			port:    11019,
+			data:    map[string]string{"[2001:db8::1]:40000->[2001:db8::2]:11019": "abcd"},
+			lost:    map[string][]int{},
+			closed:  []string{"[2001:db8::1]:40000->[2001:db8::2]:11019"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !IsCapture(tt.capture) {
+				t.Fatalf("capture is not detected")
+			}
+			h := newTestHandler()
+			if err := ReadStreams(bytes.NewReader(tt.capture), tt.port, h); err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if !reflect.DeepEqual(h.data, tt.data) {
+				t.Errorf("expected streams %v but got %v", tt.data, h.data)
+			}
+			if !reflect.DeepEqual(h.lost, tt.lost) {
+				t.Errorf("expected lost bytes at %v but got %v", tt.lost, h.lost)
+			}
+			if !reflect.DeepEqual(h.closed, tt.closed) {
+				t.Errorf("expected closed streams %v but got %v", tt.closed, h.closed)
+			}
+		})
+	}
+	if IsCapture([]byte{3, 0, 0, 0, 6, 4}) {
+		t.Errorf("BMP message is detected as capture")
+	}
+	if err := ReadStreams(bytes.NewReader([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24}), 0, newTestHandler()); err == nil {
+		t.Errorf("expected error of invalid capture but succeeded")
+	}
+}
+
+func mapEthernet(packets [][]byte) [][]byte {
+	frames := make([][]byte, 0, len(packets))
+	for _, p := range packets {
+		frames = append(frames, ethernet(p))
+	}
+
+	return frames
+}
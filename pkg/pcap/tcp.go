@@ -0,0 +1,296 @@
+package pcap
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"sort"
+)
+
+// Link types of captured packets
+const (
+	linkNull     = 0
+	linkEthernet = 1
+	linkRaw      = 101
+	linkLoop     = 108
+	linkSLL      = 113
+	linkIPv4     = 228
+	linkIPv6     = 229
+	linkSLL2     = 276
+)
+
+// Ether types
+const (
+	etherIPv4  = 0x0800
+	etherIPv6  = 0x86dd
+	etherVLAN  = 0x8100
+	etherQinQ  = 0x88a8
+	protoTCP   = 6
+	tcpFin     = 0x01
+	tcpSyn     = 0x02
+	tcpRst     = 0x04
+	maxPending = 1 << 12
+)
+
+// Flow is the direction of a TCP connection
+type Flow struct {
+	Src netip.AddrPort
+	Dst netip.AddrPort
+}
+
+func (f Flow) String() string {
+	return f.Src.String() + "->" + f.Dst.String()
+}
+
+// Handler receives reassembled TCP streams
+type Handler interface {
+	// Data passes bytes of the stream in order, lost is true when bytes preceding data are missing in the capture,
+	// such as bytes of the stream captured after the connection was established
+	Data(flow Flow, data []byte, lost bool)
+	// Close is called when the stream is closed by FIN or RST or when the capture ends
+	Close(flow Flow)
+}
+
+// stream is the reassembly state of a flow
+type stream struct {
+	next uint32
+	lost bool
+	// pending holds segments received ahead of next keyed by their sequence numbers
+	pending map[uint32][]byte
+}
+
+type assembler struct {
+	port    uint16
+	handler Handler
+	streams map[Flow]*stream
+}
+
+func newAssembler(port uint16, h Handler) *assembler {
+	return &assembler{port: port, handler: h, streams: make(map[Flow]*stream)}
+}
+
+// packet decodes the packet of the link type, truncated packets are dropped as their payload is incomplete
+func (a *assembler) packet(link uint32, b []byte, truncated bool) {
+	if truncated {
+		return
+	}
+	var ethType uint16
+	switch link {
+	case linkEthernet:
+		if len(b) < 14 {
+			return
+		}
+		ethType, b = binary.BigEndian.Uint16(b[12:]), b[14:]
+		for (ethType == etherVLAN || ethType == etherQinQ) && len(b) >= 4 {
+			ethType, b = binary.BigEndian.Uint16(b[2:]), b[4:]
+		}
+	case linkNull, linkLoop:
+		if len(b) < 4 {
+			return
+		}
+		// Address family is in the byte order of the capturing host, IPv6 families differ between systems
+		family := binary.LittleEndian.Uint32(b)
+		if family > 0xffff {
+			family = binary.BigEndian.Uint32(b)
+		}
+		switch family {
+		case 2:
+			ethType = etherIPv4
+		case 10, 24, 28, 30:
+			ethType = etherIPv6
+		}
+		b = b[4:]
+	case linkSLL:
+		if len(b) < 16 {
+			return
+		}
+		ethType, b = binary.BigEndian.Uint16(b[14:]), b[16:]
+	case linkSLL2:
+		if len(b) < 20 {
+			return
+		}
+		ethType, b = binary.BigEndian.Uint16(b), b[20:]
+	case linkRaw, linkIPv4, linkIPv6:
+		if len(b) == 0 {
+			return
+		}
+		switch b[0] >> 4 {
+		case 4:
+			ethType = etherIPv4
+		case 6:
+			ethType = etherIPv6
+		}
+	}
+	switch ethType {
+	case etherIPv4:
+		a.ipv4(b)
+	case etherIPv6:
+		a.ipv6(b)
+	}
+}
+
+func (a *assembler) ipv4(b []byte) {
+	if len(b) < 20 || b[0]>>4 != 4 {
+		return
+	}
+	hl, total := int(b[0]&0x0f)*4, int(binary.BigEndian.Uint16(b[2:]))
+	// Fragments are not reassembled
+	if b[9] != protoTCP || hl < 20 || total < hl || total > len(b) || binary.BigEndian.Uint16(b[6:])&0x3fff != 0 {
+		return
+	}
+	src, _ := netip.AddrFromSlice(b[12:16])
+	dst, _ := netip.AddrFromSlice(b[16:20])
+	a.tcp(src, dst, b[hl:total])
+}
+
+func (a *assembler) ipv6(b []byte) {
+	if len(b) < 40 || b[0]>>4 != 6 {
+		return
+	}
+	payload := int(binary.BigEndian.Uint16(b[4:]))
+	if 40+payload > len(b) {
+		return
+	}
+	src, _ := netip.AddrFromSlice(b[8:24])
+	dst, _ := netip.AddrFromSlice(b[24:40])
+	next, p := b[6], b[40:40+payload]
+	// Hop-by-hop, routing and destination options headers are skipped, fragments are not reassembled
+	for next == 0 || next == 43 || next == 60 {
+		if len(p) < 8 || len(p) < 8+int(p[1])*8 {
+			return
+		}
+		next, p = p[0], p[8+int(p[1])*8:]
+	}
+	if next != protoTCP {
+		return
+	}
+	a.tcp(src, dst, p)
+}
+
+func (a *assembler) tcp(src, dst netip.Addr, b []byte) {
+	if len(b) < 20 {
+		return
+	}
+	off := int(b[12]>>4) * 4
+	if off < 20 || off > len(b) {
+		return
+	}
+	f := Flow{
+		Src: netip.AddrPortFrom(src, binary.BigEndian.Uint16(b)),
+		Dst: netip.AddrPortFrom(dst, binary.BigEndian.Uint16(b[2:])),
+	}
+	if a.port != 0 && f.Src.Port() != a.port && f.Dst.Port() != a.port {
+		return
+	}
+	seq, flags, data := binary.BigEndian.Uint32(b[4:]), b[13], b[off:]
+	s, ok := a.streams[f]
+	if flags&tcpSyn != 0 {
+		if ok {
+			// The connection is reopened
+			a.close(f)
+		}
+		a.streams[f] = &stream{next: seq + 1, pending: make(map[uint32][]byte)}
+		return
+	}
+	if !ok {
+		if len(data) == 0 || flags&(tcpFin|tcpRst) != 0 {
+			return
+		}
+		// The stream is captured after the connection was established
+		s = &stream{next: seq, lost: true, pending: make(map[uint32][]byte)}
+		a.streams[f] = s
+	}
+	if len(data) != 0 {
+		a.segment(f, s, seq, data)
+	}
+	if flags&(tcpFin|tcpRst) != 0 {
+		a.close(f)
+	}
+}
+
+// segment passes the segment and following pending segments when the segment is next in the stream
+func (a *assembler) segment(f Flow, s *stream, seq uint32, data []byte) {
+	if d := int32(s.next - seq); d > 0 {
+		// Retransmitted bytes are dropped
+		if int(d) >= len(data) {
+			return
+		}
+		seq, data = s.next, data[d:]
+	}
+	if seq != s.next {
+		if p, ok := s.pending[seq]; !ok || len(p) < len(data) {
+			s.pending[seq] = append([]byte(nil), data...)
+		}
+		if len(s.pending) > maxPending {
+			// Bytes of the gap are not in the capture
+			a.skip(f, s)
+		}
+		return
+	}
+	a.deliver(f, s, data)
+	for {
+		p, ok := s.pending[s.next]
+		if !ok {
+			a.drain(f, s)
+			return
+		}
+		delete(s.pending, s.next)
+		a.deliver(f, s, p)
+	}
+}
+
+func (a *assembler) deliver(f Flow, s *stream, data []byte) {
+	a.handler.Data(f, data, s.lost)
+	s.lost = false
+	s.next += uint32(len(data))
+}
+
+// drain removes pending segments retransmitted within delivered bytes and passes their remainder
+func (a *assembler) drain(f Flow, s *stream) {
+	for seq, p := range s.pending {
+		d := int32(s.next - seq)
+		if d <= 0 {
+			continue
+		}
+		delete(s.pending, seq)
+		if int(d) < len(p) {
+			a.segment(f, s, seq, p)
+			return
+		}
+	}
+}
+
+// skip passes pending segments after the gap preceding them
+func (a *assembler) skip(f Flow, s *stream) {
+	seqs := make([]uint32, 0, len(s.pending))
+	for seq := range s.pending {
+		seqs = append(seqs, seq)
+	}
+	// Sequence numbers are ordered relative to the next expected byte, as they wrap around
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i]-s.next < seqs[j]-s.next })
+	s.next, s.lost = seqs[0], true
+	p := s.pending[seqs[0]]
+	delete(s.pending, seqs[0])
+	a.segment(f, s, seqs[0], p)
+}
+
+// close passes pending segments of the stream and closes it
+func (a *assembler) close(f Flow) {
+	s := a.streams[f]
+	for len(s.pending) != 0 {
+		a.skip(f, s)
+	}
+	delete(a.streams, f)
+	a.handler.Close(f)
+}
+
+func (a *assembler) closeAll() {
+	flows := make([]Flow, 0, len(a.streams))
+	for f := range a.streams {
+		flows = append(flows, f)
+	}
+	// Streams are closed in a stable order
+	sort.Slice(flows, func(i, j int) bool { return flows[i].String() < flows[j].String() })
+	for _, f := range flows {
+		a.close(f)
+	}
+}
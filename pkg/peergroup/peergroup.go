@@ -0,0 +1,287 @@
+package peergroup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"sync"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// Group defines a peer group, a peer is a member of the group when it matches any of ASNs, Prefixes or PeerNames
+type Group struct {
+	Name string   `json:"name"`
+	ASNs []uint32 `json:"asns,omitempty"`
+	// Prefixes are address ranges in CIDR notation covering addresses of peers
+	Prefixes []string `json:"prefixes,omitempty"`
+	// PeerNames are regular expressions matched against names of peers carried by String TLVs of Peer Up messages
+	PeerNames []string `json:"peer_names,omitempty"`
+	asns      map[uint32]bool
+	networks  []*net.IPNet
+	names     []*regexp.Regexp
+}
+
+// Config defines the structure of peer groups file, the peer is a member of the first matching group
+type Config struct {
+	Groups []*Group `json:"groups"`
+}
+
+func (g *Group) init(i int) error {
+	if g.Name == "" {
+		return fmt.Errorf("peer group %d has no name", i)
+	}
+	g.asns = make(map[uint32]bool)
+	for _, asn := range g.ASNs {
+		g.asns[asn] = true
+	}
+	g.networks = make([]*net.IPNet, 0, len(g.Prefixes))
+	for _, p := range g.Prefixes {
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return fmt.Errorf("peer group %s has invalid prefix %q with error: %+v", g.Name, p, err)
+		}
+		g.networks = append(g.networks, n)
+	}
+	g.names = make([]*regexp.Regexp, 0, len(g.PeerNames))
+	for _, n := range g.PeerNames {
+		re, err := regexp.Compile(n)
+		if err != nil {
+			return fmt.Errorf("peer group %s has invalid peer name expression %q with error: %+v", g.Name, n, err)
+		}
+		g.names = append(g.names, re)
+	}
+
+	return nil
+}
+
+func (g *Group) match(asn uint32, ip net.IP, name string) bool {
+	if g.asns[asn] {
+		return true
+	}
+	if ip != nil {
+		for _, n := range g.networks {
+			if n.Contains(ip) {
+				return true
+			}
+		}
+	}
+	if name != "" {
+		for _, re := range g.names {
+			if re.MatchString(name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// LoadConfig reads peer groups from json file
+func LoadConfig(file string) (*Config, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal peer groups file %s with error: %+v", file, err)
+	}
+
+	return c, nil
+}
+
+// peerMsg carries keys identifying the peer in route messages, peer_ip and peer_asn, and in peer and stats
+// messages, remote_ip and remote_asn
+type peerMsg struct {
+	RouterIP  string `json:"router_ip"`
+	PeerRD    string `json:"peer_rd"`
+	PeerIP    string `json:"peer_ip"`
+	PeerASN   uint32 `json:"peer_asn"`
+	RemoteIP  string `json:"remote_ip"`
+	RemoteASN uint32 `json:"remote_asn"`
+	Name      string `json:"name"`
+}
+
+type peerKey struct {
+	routerIP string
+	peerRD   string
+	peerIP   string
+}
+
+// peer stores the name of the peer learned from peer messages and its group
+type peer struct {
+	name  string
+	asn   uint32
+	group string
+	// generation is the generation of groups the group was matched with
+	generation uint64
+}
+
+// Reloader is implemented by publishers of NewGrouper, Reload replaces groups of peers, groups of peers are
+// matched again by messages published after it returns
+type Reloader interface {
+	Reload(config *Config) error
+}
+
+type grouper struct {
+	sync.Mutex
+	publisher pub.Publisher
+	groups    []*Group
+	peers     map[peerKey]*peer
+	// generation is incremented when groups are reloaded
+	generation uint64
+}
+
+func (g *grouper) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	m := &peerMsg{}
+	if err := json.Unmarshal(msg, m); err != nil {
+		return g.publisher.PublishMessage(msgType, msgHash, msg)
+	}
+	ip, asn := m.PeerIP, m.PeerASN
+	if ip == "" {
+		ip, asn = m.RemoteIP, m.RemoteASN
+	}
+	if ip == "" {
+		return g.publisher.PublishMessage(msgType, msgHash, msg)
+	}
+	name := ""
+	if msgType == bmp.PeerStateChangeMsg {
+		name = m.Name
+	}
+	group := g.group(peerKey{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: ip}, asn, name)
+	if group == "" {
+		return g.publisher.PublishMessage(msgType, msgHash, msg)
+	}
+	b, err := addGroup(msg, group)
+	if err != nil {
+		glog.Errorf("failed to add peer group to message of type %d with error: %+v", msgType, err)
+		return g.publisher.PublishMessage(msgType, msgHash, msg)
+	}
+
+	return g.publisher.PublishMessage(msgType, msgHash, b)
+}
+
+// group returns the group of the peer, the group is matched again when the peer's AS number or name changes,
+// name of the peer is remembered for messages not carrying it
+func (g *grouper) group(k peerKey, asn uint32, name string) string {
+	g.Lock()
+	defer g.Unlock()
+	p, ok := g.peers[k]
+	if ok && p.asn == asn && (name == "" || name == p.name) && p.generation == g.generation {
+		return p.group
+	}
+	if !ok {
+		p = &peer{}
+		g.peers[k] = p
+	}
+	p.asn = asn
+	p.generation = g.generation
+	if name != "" {
+		p.name = name
+	}
+	p.group = ""
+	ip := net.ParseIP(k.peerIP)
+	for _, gr := range g.groups {
+		if gr.match(asn, ip, p.name) {
+			p.group = gr.Name
+			break
+		}
+	}
+
+	return p.group
+}
+
+// addGroup adds peer_group key to the json object of the message
+func addGroup(msg []byte, group string) ([]byte, error) {
+	m := bytes.TrimRight(msg, " \t\r\n")
+	if len(m) < 2 || m[len(m)-1] != '}' {
+		return nil, fmt.Errorf("message is not a json object")
+	}
+	v, err := json.Marshal(group)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, len(m)+len(v)+16)
+	b = append(b, m[:len(m)-1]...)
+	if len(bytes.TrimSpace(m[1:len(m)-1])) != 0 {
+		b = append(b, ',')
+	}
+	b = append(b, `"peer_group":`...)
+	b = append(b, v...)
+	b = append(b, '}')
+
+	return b, nil
+}
+
+func (g *grouper) Reload(config *Config) error {
+	for i, gr := range config.Groups {
+		if err := gr.init(i); err != nil {
+			return err
+		}
+	}
+	g.Lock()
+	defer g.Unlock()
+	g.groups = config.Groups
+	// Names of peers are kept, as messages without names are matched by them
+	g.generation++
+
+	return nil
+}
+
+func (g *grouper) Stop() {
+	g.publisher.Stop()
+}
+
+// EvictPeer removes the name and the group of the peer of the router, or of all peers of the router when peerIP
+// is empty
+func (g *grouper) EvictPeer(routerIP, peerRD, peerIP string) int {
+	g.Lock()
+	defer g.Unlock()
+	n := 0
+	for k := range g.peers {
+		if k.routerIP == routerIP && (peerIP == "" || k.peerRD == peerRD && k.peerIP == peerIP) {
+			delete(g.peers, k)
+			n++
+		}
+	}
+
+	return n
+}
+
+// MemoryUsage returns estimated memory used by names and groups of peers
+func (g *grouper) MemoryUsage() memory.Usage {
+	g.Lock()
+	defer g.Unlock()
+	c := memory.NewCounter("peer_group")
+	for k, p := range g.peers {
+		b := uint64(unsafe.Sizeof(k)+unsafe.Sizeof(p)+unsafe.Sizeof(*p)) + memory.MapEntryOverhead +
+			uint64(len(k.routerIP)+len(k.peerRD)+len(k.peerIP)+len(p.name))
+		c.Add(k.routerIP, k.peerIP, b)
+	}
+
+	return c.Usage()
+}
+
+// NewGrouper returns a publisher adding peer_group with the name of the peer's group to messages of peers
+// matching a group of the configuration before passing them to the wrapped publisher.
+func NewGrouper(publisher pub.Publisher, config *Config) (pub.Publisher, error) {
+	for i, g := range config.Groups {
+		if err := g.init(i); err != nil {
+			return nil, err
+		}
+	}
+
+	return &grouper{
+		publisher: publisher,
+		groups:    config.Groups,
+		peers:     make(map[peerKey]*peer),
+	}, nil
+}
@@ -0,0 +1,140 @@
+package peergroup
+
+import (
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	msg []byte
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.msg = msg
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+func TestGrouper(t *testing.T) {
+	config := &Config{
+		Groups: []*Group{
+			{Name: "transit", ASNs: []uint32{174, 3356}, PeerNames: []string{"^transit-"}},
+			{Name: "peering", Prefixes: []string{"192.0.2.0/24", "2001:db8:1::/48"}},
+			{Name: "customer", PeerNames: []string{"(?i)^cust"}},
+		},
+	}
+	p := &testPublisher{}
+	g, err := NewGrouper(p, config)
+	if err != nil {
+		t.Fatalf("failed to initialize peer groups with error: %+v", err)
+	}
+	tests := []struct {
+		name    string
+		msgType int
+		input   string
+		expect  string
+	}{
+		{
+			name:    "route of transit asn",
+			msgType: bmp.UnicastPrefixV4Msg,
+			input:   `{"router_ip":"10.0.0.1","peer_ip":"198.51.100.1","peer_asn":174,"prefix":"10.1.0.0"}`,
+			expect:  `{"router_ip":"10.0.0.1","peer_ip":"198.51.100.1","peer_asn":174,"prefix":"10.1.0.0","peer_group":"transit"}`,
+		},
+		{
+			name:    "route of peering address range",
+			msgType: bmp.UnicastPrefixV6Msg,
+			input:   `{"router_ip":"10.0.0.1","peer_ip":"2001:db8:1::5","peer_asn":65010}`,
+			expect:  `{"router_ip":"10.0.0.1","peer_ip":"2001:db8:1::5","peer_asn":65010,"peer_group":"peering"}`,
+		},
+		{
+			name:    "peer up with customer name",
+			msgType: bmp.PeerStateChangeMsg,
+			input:   `{"action":"add","router_ip":"10.0.0.1","remote_ip":"203.0.113.9","remote_asn":65020,"name":"Customer-ACME"}`,
+			expect:  `{"action":"add","router_ip":"10.0.0.1","remote_ip":"203.0.113.9","remote_asn":65020,"name":"Customer-ACME","peer_group":"customer"}`,
+		},
+		{
+			name:    "route of the named peer",
+			msgType: bmp.UnicastPrefixV4Msg,
+			input:   `{"router_ip":"10.0.0.1","peer_ip":"203.0.113.9","peer_asn":65020}`,
+			expect:  `{"router_ip":"10.0.0.1","peer_ip":"203.0.113.9","peer_asn":65020,"peer_group":"customer"}`,
+		},
+		{
+			name:    "route of the same address of another router",
+			msgType: bmp.UnicastPrefixV4Msg,
+			input:   `{"router_ip":"10.0.0.2","peer_ip":"203.0.113.9","peer_asn":65020}`,
+			expect:  `{"router_ip":"10.0.0.2","peer_ip":"203.0.113.9","peer_asn":65020}`,
+		},
+		{
+			name:    "stats of peering peer",
+			msgType: bmp.StatsReportMsg,
+			input:   `{"router_ip":"10.0.0.1","remote_ip":"192.0.2.7","remote_asn":65030,"ads_rib_in":100}`,
+			expect:  `{"router_ip":"10.0.0.1","remote_ip":"192.0.2.7","remote_asn":65030,"ads_rib_in":100,"peer_group":"peering"}`,
+		},
+		{
+			name:    "message without peer",
+			msgType: bmp.CollectorEventMsg,
+			input:   `{"code":"parse_error","count":1}`,
+			expect:  `{"code":"parse_error","count":1}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := g.PublishMessage(tt.msgType, nil, []byte(tt.input)); err != nil {
+				t.Fatalf("failed to publish message with error: %+v", err)
+			}
+			if string(p.msg) != tt.expect {
+				t.Errorf("expected message %s but got %s", tt.expect, string(p.msg))
+			}
+		})
+	}
+	if n := g.(*grouper).EvictPeer("10.0.0.1", "", ""); n != 4 {
+		t.Errorf("expected 4 evicted peers but got %d", n)
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		group *Group
+	}{
+		{name: "no name", group: &Group{ASNs: []uint32{174}}},
+		{name: "invalid prefix", group: &Group{Name: "transit", Prefixes: []string{"192.0.2.0/33"}}},
+		{name: "invalid expression", group: &Group{Name: "transit", PeerNames: []string{"transit-("}}},
+	}
+	for _, tt := range tests {
+		if _, err := NewGrouper(&testPublisher{}, &Config{Groups: []*Group{tt.group}}); err == nil {
+			t.Errorf("expected group with %s to fail", tt.name)
+		}
+	}
+}
+
+func TestReload(t *testing.T) {
+	p := &testPublisher{}
+	g, err := NewGrouper(p, &Config{Groups: []*Group{{Name: "transit", ASNs: []uint32{174}}}})
+	if err != nil {
+		t.Fatalf("failed to initialize peer groups with error: %+v", err)
+	}
+	msg := []byte(`{"router_ip":"10.0.0.1","peer_ip":"198.51.100.1","peer_asn":174}`)
+	if err := g.PublishMessage(bmp.UnicastPrefixV4Msg, nil, msg); err != nil {
+		t.Fatalf("failed to publish message with error: %+v", err)
+	}
+	r, ok := g.(Reloader)
+	if !ok {
+		t.Fatalf("expected grouper to implement Reloader")
+	}
+	if err := r.Reload(&Config{Groups: []*Group{{Name: "peering", ASNs: []uint32{174}}}}); err != nil {
+		t.Fatalf("failed to reload groups with error: %+v", err)
+	}
+	// The group of the peer cached before the reload is matched again
+	if err := g.PublishMessage(bmp.UnicastPrefixV4Msg, nil, msg); err != nil {
+		t.Fatalf("failed to publish message with error: %+v", err)
+	}
+	if expect := `{"router_ip":"10.0.0.1","peer_ip":"198.51.100.1","peer_asn":174,"peer_group":"peering"}`; string(p.msg) != expect {
+		t.Errorf("expected message %s but got %s", expect, string(p.msg))
+	}
+	if err := r.Reload(&Config{Groups: []*Group{{}}}); err == nil {
+		t.Errorf("expected group without name to fail")
+	}
+}
@@ -0,0 +1,119 @@
+package postpolicy
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+const (
+	// All selects all types of messages of routes carrying is_adj_rib_in_post_policy, peer messages are
+	// split only when selected by name
+	All = "all"
+	// NameSuffix is appended to the name of the message type to form the name of its post-policy message type,
+	// the default topic of post-policy messages is gobmp.parsed.{name}_post_policy
+	NameSuffix = "_post_policy"
+	// TypeOffset is added to the message type to form its post-policy message type
+	TypeOffset = 1000
+)
+
+// postPolicyKey is the json key of messages of Adj-RIB-In routes set to true for post-policy routes, L flag
+// of Per-Peer Header
+const postPolicyKey = "is_adj_rib_in_post_policy"
+
+var postPolicyTrue = []byte(`"` + postPolicyKey + `":true`)
+
+// hasPostPolicyKey returns true when the schema of the message type carries is_adj_rib_in_post_policy
+func hasPostPolicyKey(s reflect.Type) bool {
+	if s == nil || s.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < s.NumField(); i++ {
+		if strings.Split(s.Field(i).Tag.Get("json"), ",")[0] == postPolicyKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Register registers post-policy message types of the message types named in the list, "all" selects all types
+// of messages of routes carrying is_adj_rib_in_post_policy. It returns the map of message types to their
+// post-policy message types, it must be called before publishers are initialized, so they learn topics of
+// post-policy messages.
+func Register(names []string) (map[int]int, error) {
+	selected := make(map[string]bool)
+	for _, n := range names {
+		if n = strings.TrimSpace(n); n != "" {
+			selected[n] = true
+		}
+	}
+	var mts []bmp.MessageType
+	for _, mt := range bmp.MessageTypes() {
+		if !hasPostPolicyKey(mt.Schema) {
+			if selected[mt.Name] {
+				return nil, fmt.Errorf("messages of type %s do not carry %s", mt.Name, postPolicyKey)
+			}
+			continue
+		}
+		if (selected[All] && mt.Type != bmp.PeerStateChangeMsg) || selected[mt.Name] {
+			mts = append(mts, mt)
+		}
+		delete(selected, mt.Name)
+	}
+	delete(selected, All)
+	if len(selected) != 0 {
+		unknown := make([]string, 0, len(selected))
+		for n := range selected {
+			unknown = append(unknown, n)
+		}
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown message types %s", strings.Join(unknown, ","))
+	}
+	types := make(map[int]int, len(mts))
+	for _, mt := range mts {
+		post := bmp.MessageType{
+			Type:   mt.Type + TypeOffset,
+			Name:   mt.Name + NameSuffix,
+			Schema: mt.Schema,
+		}
+		if err := bmp.RegisterMessageType(post); err != nil {
+			return nil, fmt.Errorf("failed to register post-policy message type of %s with error: %+v", mt.Name, err)
+		}
+		types[mt.Type] = post.Type
+	}
+
+	return types, nil
+}
+
+type splitter struct {
+	publisher pub.Publisher
+	types     map[int]int
+}
+
+func (s *splitter) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if post, ok := s.types[msgType]; ok && bytes.Contains(msg, postPolicyTrue) {
+		msgType = post
+	}
+
+	return s.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+func (s *splitter) Stop() {
+	s.publisher.Stop()
+}
+
+// NewSplitter returns a publisher passing post-policy messages of Adj-RIB-In routes of the message types as their
+// post-policy message types returned by Register, so they are published to separate topics, pre-policy messages
+// keep their types. Messages must be json, the splitter wraps the publisher encoding messages.
+func NewSplitter(publisher pub.Publisher, types map[int]int) pub.Publisher {
+	return &splitter{
+		publisher: publisher,
+		types:     types,
+	}
+}
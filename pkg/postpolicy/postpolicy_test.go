@@ -0,0 +1,78 @@
+package postpolicy
+
+import (
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	_ "github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/pubtest"
+)
+
+func TestRegisterInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		names []string
+	}{
+		{name: "unknown type", names: []string{"unicast_prefix_v4", "unicast_prefix_v5"}},
+		{name: "type without post-policy flag", names: []string{"statistics"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Register(tt.names); err == nil {
+				t.Fatalf("expected registration of %v to fail", tt.names)
+			}
+		})
+	}
+}
+
+func TestSplitter(t *testing.T) {
+	types, err := Register([]string{"unicast_prefix_v4", " l3vpn_v4"})
+	if err != nil {
+		t.Fatalf("failed to register post-policy message types with error: %+v", err)
+	}
+	if len(types) != 2 || types[bmp.UnicastPrefixV4Msg] != bmp.UnicastPrefixV4Msg+TypeOffset {
+		t.Fatalf("unexpected post-policy message types %+v", types)
+	}
+	if topic, _ := bmp.MessageTopic(types[bmp.UnicastPrefixV4Msg]); topic != "gobmp.parsed.unicast_prefix_v4_post_policy" {
+		t.Errorf("unexpected topic %s of post-policy unicast prefix messages", topic)
+	}
+	rec := pubtest.NewRecorder()
+	p := NewSplitter(rec, types)
+	tests := []struct {
+		name    string
+		msgType int
+		msg     string
+		expect  int
+	}{
+		{
+			name:    "post-policy unicast prefix",
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     `{"prefix":"10.0.0.0","is_adj_rib_in_post_policy":true}`,
+			expect:  bmp.UnicastPrefixV4Msg + TypeOffset,
+		},
+		{
+			name:    "pre-policy unicast prefix",
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     `{"prefix":"10.0.0.0","is_adj_rib_in_post_policy":false}`,
+			expect:  bmp.UnicastPrefixV4Msg,
+		},
+		{
+			name:    "post-policy prefix of type not split",
+			msgType: bmp.UnicastPrefixV6Msg,
+			msg:     `{"prefix":"2001:db8::","is_adj_rib_in_post_policy":true}`,
+			expect:  bmp.UnicastPrefixV6Msg,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec.Reset()
+			if err := p.PublishMessage(tt.msgType, nil, []byte(tt.msg)); err != nil {
+				t.Fatalf("failed to publish message with error: %+v", err)
+			}
+			msgs := rec.Messages()
+			if len(msgs) != 1 || msgs[0].Type != tt.expect {
+				t.Errorf("expected message of type %d but got %+v", tt.expect, msgs)
+			}
+		})
+	}
+}
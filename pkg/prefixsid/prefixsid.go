@@ -2,6 +2,7 @@ package prefixsid
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	"github.com/golang/glog"
 	"github.com/sbezverk/gobmp/pkg/srv6"
@@ -52,7 +53,7 @@ func UnmarshalBGPAttrPrefixSID(b []byte) (*PSid, error) {
 		OriginatorSRGB: nil,
 	}
 	for p := 0; p < len(b); {
-		// Determin the type, currently only type 1 and 3 are supported
+		// Determin the type, currently only type 1, 3, 5 and 6 are supported
 		switch b[p] {
 		case 1:
 			p++
@@ -91,14 +92,36 @@ func UnmarshalBGPAttrPrefixSID(b []byte) (*PSid, error) {
 			}
 		case 5:
 			p++
+			if p+2 > len(b) {
+				return nil, fmt.Errorf("not enough bytes to unmarshal SRv6 L3 Service TLV")
+			}
 			l := binary.BigEndian.Uint16(b[p : p+2])
 			p += 2
+			if p+int(l) > len(b) {
+				return nil, fmt.Errorf("invalid length %d of SRv6 L3 Service TLV, only %d bytes remain", l, len(b)-p)
+			}
 			l3, err := srv6.UnmarshalSRv6L3Service(b[p : p+int(l)])
 			if err != nil {
 				return nil, err
 			}
 			psid.SRv6L3Service = l3
 			p += int(l)
+		case 6:
+			p++
+			if p+2 > len(b) {
+				return nil, fmt.Errorf("not enough bytes to unmarshal SRv6 L2 Service TLV")
+			}
+			l := binary.BigEndian.Uint16(b[p : p+2])
+			p += 2
+			if p+int(l) > len(b) {
+				return nil, fmt.Errorf("invalid length %d of SRv6 L2 Service TLV, only %d bytes remain", l, len(b)-p)
+			}
+			l2, err := srv6.UnmarshalSRv6L2Service(b[p : p+int(l)])
+			if err != nil {
+				return nil, err
+			}
+			psid.SRv6L2Service = l2
+			p += int(l)
 		default:
 			// Skip unknown type, length 2 bytes and the value
 			p++
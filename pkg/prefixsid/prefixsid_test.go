@@ -56,6 +56,35 @@ func TestUnmarshalBGPAttrPrefixSID(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "prefix sid type 6",
+			input: []byte{0x06, 0x00, 0x22, 0x00, 0x01, 0x00, 0x1e, 0x00, 0x20, 0x01, 0x00, 0x00, 0x00, 0x05, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x11, 0x00, 0x01, 0x00, 0x06, 0x28, 0x18, 0x10, 0x00, 0x10, 0x40},
+			expect: &PSid{
+				SRv6L2Service: &srv6.L2Service{
+					SubTLVs: map[uint8][]srv6.SvcSubTLV{
+						1: {
+							&srv6.InformationSubTLV{
+								SID:              net.IP([]byte{0x20, 0x01, 0x00, 0x00, 0x00, 0x05, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}).To16().String(),
+								Flags:            0,
+								EndpointBehavior: 17,
+								SubSubTLVs: map[uint8][]srv6.SvcSubSubTLV{
+									1: {
+										&srv6.SIDStructureSubSubTLV{
+											LocalBlockLength:    0x28,
+											LocalNodeLength:     0x18,
+											FunctionLength:      0x10,
+											ArgumentLength:      0,
+											TranspositionLength: 0x10,
+											TranspositionOffset: 0x40,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
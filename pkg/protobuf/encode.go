@@ -0,0 +1,287 @@
+package protobuf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// appendJSON appends protobuf binary encoding of the json message of the message to out, fields missing in the
+// message, null and zero values are not encoded as proto3 does not encode default values
+func (m *message) appendJSON(out []byte, b []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("invalid json with error: %+v", err)
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid json, data after the top level value")
+	}
+	if m.goType == nil {
+		// Messages of types without Go types are carried as json
+		out = protowire.AppendTag(out, 1, protowire.BytesType)
+		return protowire.AppendBytes(out, b), nil
+	}
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v where a json object is expected", v)
+	}
+
+	return m.encode(obj, out)
+}
+
+func (m *message) encode(obj map[string]interface{}, out []byte) ([]byte, error) {
+	for _, f := range m.fields {
+		v, ok := obj[f.json]
+		if !ok || v == nil {
+			continue
+		}
+		var err error
+		switch {
+		case f.entry:
+			out, err = f.appendMap(v, out)
+		case f.repeated:
+			out, err = f.appendRepeated(v, out)
+		default:
+			out, err = f.appendValue(v, out, true)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid field %s with error: %+v", f.json, err)
+		}
+	}
+
+	return out, nil
+}
+
+// appendValue appends the field of the value, zero values are not appended when omit is true
+func (f *field) appendValue(v interface{}, out []byte, omit bool) ([]byte, error) {
+	num := protowire.Number(f.number)
+	switch {
+	case f.asJSON:
+		s, err := jsonString(v)
+		if err != nil {
+			return nil, err
+		}
+		if s == "" && omit {
+			return out, nil
+		}
+		out = protowire.AppendTag(out, num, protowire.BytesType)
+		return protowire.AppendString(out, s), nil
+	case f.kind == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+		obj, ok := v.(map[string]interface{})
+		if !ok && v != nil {
+			return nil, fmt.Errorf("%v where a json object is expected", v)
+		}
+		b, err := f.message.encode(obj, nil)
+		if err != nil {
+			return nil, err
+		}
+		out = protowire.AppendTag(out, num, protowire.BytesType)
+		return protowire.AppendBytes(out, b), nil
+	case f.kind == descriptorpb.FieldDescriptorProto_TYPE_STRING || f.kind == descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		b, err := f.bytes(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) == 0 && omit {
+			return out, nil
+		}
+		out = protowire.AppendTag(out, num, protowire.BytesType)
+		return protowire.AppendBytes(out, b), nil
+	}
+	b, err := f.appendScalar(v, nil)
+	if err != nil {
+		return nil, err
+	}
+	if omit && isZero(b) {
+		return out, nil
+	}
+	out = protowire.AppendTag(out, num, f.wireType())
+
+	return append(out, b...), nil
+}
+
+// appendRepeated appends the repeated field of the json array, numbers and booleans are packed
+func (f *field) appendRepeated(v interface{}, out []byte) ([]byte, error) {
+	items, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v where a json array is expected", v)
+	}
+	if len(items) == 0 {
+		return out, nil
+	}
+	if f.asJSON || f.wireType() == protowire.BytesType {
+		for _, item := range items {
+			var err error
+			if out, err = f.appendValue(item, out, false); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	}
+	var packed []byte
+	for _, item := range items {
+		var err error
+		if packed, err = f.appendScalar(item, packed); err != nil {
+			return nil, err
+		}
+	}
+	out = protowire.AppendTag(out, protowire.Number(f.number), protowire.BytesType)
+
+	return protowire.AppendBytes(out, packed), nil
+}
+
+// appendMap appends entries of the map field of the json object ordered by keys
+func (f *field) appendMap(v interface{}, out []byte) ([]byte, error) {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v where a json object is expected", v)
+	}
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		entry := protowire.AppendTag(nil, 1, protowire.BytesType)
+		entry = protowire.AppendString(entry, k)
+		var err error
+		if entry, err = f.message.fields[1].appendValue(obj[k], entry, false); err != nil {
+			return nil, fmt.Errorf("invalid value of key %s with error: %+v", k, err)
+		}
+		out = protowire.AppendTag(out, protowire.Number(f.number), protowire.BytesType)
+		out = protowire.AppendBytes(out, entry)
+	}
+
+	return out, nil
+}
+
+// appendScalar appends the number or the boolean without the tag
+func (f *field) appendScalar(v interface{}, out []byte) ([]byte, error) {
+	switch f.kind {
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		b, ok := v.(bool)
+		if !ok && v != nil {
+			return nil, fmt.Errorf("%v where a boolean is expected", v)
+		}
+		return protowire.AppendVarint(out, protowire.EncodeBool(b)), nil
+	case descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+		n, err := float(v)
+		if err != nil {
+			return nil, err
+		}
+		return protowire.AppendFixed32(out, math.Float32bits(float32(n))), nil
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+		n, err := float(v)
+		if err != nil {
+			return nil, err
+		}
+		return protowire.AppendFixed64(out, math.Float64bits(n)), nil
+	}
+	n, err := integer(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return protowire.AppendVarint(out, n), nil
+}
+
+// bytes returns the string or base64 decoded bytes of the field
+func (f *field) bytes(v interface{}) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok && v != nil {
+		return nil, fmt.Errorf("%v where a string is expected", v)
+	}
+	if f.kind == descriptorpb.FieldDescriptorProto_TYPE_STRING {
+		return []byte(s), nil
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 bytes with error: %+v", err)
+	}
+
+	return b, nil
+}
+
+func (f *field) wireType() protowire.Type {
+	switch f.kind {
+	case descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+		return protowire.Fixed32Type
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return protowire.Fixed64Type
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+		descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+		return protowire.BytesType
+	}
+
+	return protowire.VarintType
+}
+
+// jsonString returns the json encoding of the value, strings are returned without quotes
+func jsonString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// integer returns the varint of the json number, negative numbers are sign extended as protobuf encodes int32
+// and int64
+func integer(v interface{}) (uint64, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		if v != nil {
+			return 0, fmt.Errorf("%v where a number is expected", v)
+		}
+		return 0, nil
+	}
+	if i, err := n.Int64(); err == nil {
+		return uint64(i), nil
+	}
+	u, err := strconv.ParseUint(string(n), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer %s", n)
+	}
+
+	return u, nil
+}
+
+func float(v interface{}) (float64, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		if v != nil {
+			return 0, fmt.Errorf("%v where a number is expected", v)
+		}
+		return 0, nil
+	}
+
+	return n.Float64()
+}
+
+// isZero returns true when the encoded number or boolean is zero
+func isZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+
+	return true
+}
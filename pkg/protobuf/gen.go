@@ -0,0 +1,113 @@
+//go:build ignore
+
+// gen writes the .proto file of messages derived from Go types of message types and generates its Go bindings
+// with protoc-gen-go, run by go generate in pkg/protobuf. The .proto file and bindings are regenerated when
+// structures of messages change. Numbers of fields are pinned in numbers.go, fields added to Go types are
+// appended to it, so numbers of existing fields never change.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+
+	// Packages registering Go types of message types
+	_ "github.com/sbezverk/gobmp/pkg/asgraph"
+	_ "github.com/sbezverk/gobmp/pkg/baseline"
+	_ "github.com/sbezverk/gobmp/pkg/epe"
+	_ "github.com/sbezverk/gobmp/pkg/events"
+	_ "github.com/sbezverk/gobmp/pkg/flap"
+	_ "github.com/sbezverk/gobmp/pkg/gobmpsrv"
+	_ "github.com/sbezverk/gobmp/pkg/message"
+	_ "github.com/sbezverk/gobmp/pkg/report"
+	_ "github.com/sbezverk/gobmp/pkg/srvalidator"
+	_ "github.com/sbezverk/gobmp/pkg/storm"
+	_ "github.com/sbezverk/gobmp/pkg/verbosity"
+
+	"github.com/sbezverk/gobmp/pkg/protobuf"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func main() {
+	out := flag.String("out", "messagepb", "directory of the generated .proto file and Go bindings")
+	flag.Parse()
+
+	if err := writeNumbers("numbers.go", protobuf.FieldNumbers()); err != nil {
+		fail(err)
+	}
+	if err := os.WriteFile(filepath.Join(*out, protobuf.ProtoFileName), []byte(protobuf.ProtoFile()), 0644); err != nil {
+		fail(err)
+	}
+	req, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{protobuf.ProtoFileName},
+		Parameter:      proto.String("paths=source_relative"),
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{protobuf.FileDescriptor()},
+	})
+	if err != nil {
+		fail(err)
+	}
+	var stdout bytes.Buffer
+	cmd := exec.Command("go", "run", "google.golang.org/protobuf/cmd/protoc-gen-go")
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = bytes.NewReader(req), &stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		fail(fmt.Errorf("protoc-gen-go failed with error: %+v", err))
+	}
+	var resp pluginpb.CodeGeneratorResponse
+	if err := proto.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		fail(err)
+	}
+	if resp.Error != nil {
+		fail(fmt.Errorf("protoc-gen-go failed with error: %s", resp.GetError()))
+	}
+	for _, f := range resp.File {
+		if err := os.WriteFile(filepath.Join(*out, f.GetName()), []byte(f.GetContent()), 0644); err != nil {
+			fail(err)
+		}
+	}
+}
+
+// writeNumbers writes the Go source of numbers of fields pinned by the schema
+func writeNumbers(file string, numbers map[string]map[string]int32) error {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen.go from Go types of message types; DO NOT EDIT.\n\n")
+	b.WriteString("package protobuf\n\n")
+	b.WriteString("// fieldNumbers pins numbers of fields keyed by message name and field name, numbers of fields removed from Go\n")
+	b.WriteString("// types stay pinned so they are reserved and never reused\n")
+	b.WriteString("var fieldNumbers = map[string]map[string]int32{\n")
+	messages := make([]string, 0, len(numbers))
+	for m := range numbers {
+		messages = append(messages, m)
+	}
+	sort.Strings(messages)
+	for _, m := range messages {
+		fields := make([]string, 0, len(numbers[m]))
+		for f := range numbers[m] {
+			fields = append(fields, f)
+		}
+		sort.Slice(fields, func(i, j int) bool { return numbers[m][fields[i]] < numbers[m][fields[j]] })
+		fmt.Fprintf(&b, "%q: {\n", m)
+		for _, f := range fields {
+			fmt.Fprintf(&b, "%q: %d,\n", f, numbers[m][f])
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n")
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(file, src, 0644)
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+	os.Exit(1)
+}
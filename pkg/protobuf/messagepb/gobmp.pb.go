@@ -0,0 +1,14252 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: gobmp.proto
+
+package messagepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Anomaly struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action           string   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Type             string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	RouterIp         string   `protobuf:"bytes,3,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerIp           string   `protobuf:"bytes,4,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerAsn          uint32   `protobuf:"varint,5,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Prefix           string   `protobuf:"bytes,6,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen        int32    `protobuf:"varint,7,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	OriginAs         uint32   `protobuf:"varint,8,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	BaselinePrefix   string   `protobuf:"bytes,9,opt,name=baseline_prefix,json=baselinePrefix,proto3" json:"baseline_prefix,omitempty"`
+	ExpectedOriginAs []uint32 `protobuf:"varint,10,rep,packed,name=expected_origin_as,json=expectedOriginAs,proto3" json:"expected_origin_as,omitempty"`
+	Timestamp        string   `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Anomaly) Reset() {
+	*x = Anomaly{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly) ProtoMessage() {}
+
+func (x *Anomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly.ProtoReflect.Descriptor instead.
+func (*Anomaly) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Anomaly) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Anomaly) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Anomaly) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *Anomaly) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *Anomaly) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *Anomaly) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Anomaly) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *Anomaly) GetOriginAs() uint32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *Anomaly) GetBaselinePrefix() string {
+	if x != nil {
+		return x.BaselinePrefix
+	}
+	return ""
+}
+
+func (x *Anomaly) GetExpectedOriginAs() []uint32 {
+	if x != nil {
+		return x.ExpectedOriginAs
+	}
+	return nil
+}
+
+func (x *Anomaly) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type AppSpecLinkAttr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SaibmLength             uint32    `protobuf:"varint,1,opt,name=saibm_length,json=saibmLength,proto3" json:"saibm_length,omitempty"`
+	UdaibmLength            uint32    `protobuf:"varint,2,opt,name=udaibm_length,json=udaibmLength,proto3" json:"udaibm_length,omitempty"`
+	StdAppIdBitMask         []byte    `protobuf:"bytes,3,opt,name=std_app_id_bit_mask,json=stdAppIdBitMask,proto3" json:"std_app_id_bit_mask,omitempty"`
+	UdAppIdBitMask          []byte    `protobuf:"bytes,4,opt,name=ud_app_id_bit_mask,json=udAppIdBitMask,proto3" json:"ud_app_id_bit_mask,omitempty"`
+	SubTlvs                 []*SubTLV `protobuf:"bytes,5,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty"`
+	Applications            []string  `protobuf:"bytes,6,rep,name=applications,proto3" json:"applications,omitempty"`
+	UserDefinedApplications []int64   `protobuf:"varint,7,rep,packed,name=user_defined_applications,json=userDefinedApplications,proto3" json:"user_defined_applications,omitempty"`
+	AllApplications         bool      `protobuf:"varint,8,opt,name=all_applications,json=allApplications,proto3" json:"all_applications,omitempty"`
+	AdminGroup              uint32    `protobuf:"varint,9,opt,name=admin_group,json=adminGroup,proto3" json:"admin_group,omitempty"`
+	ExtendedAdminGroup      []uint32  `protobuf:"varint,10,rep,packed,name=extended_admin_group,json=extendedAdminGroup,proto3" json:"extended_admin_group,omitempty"`
+	TeDefaultMetric         uint32    `protobuf:"varint,11,opt,name=te_default_metric,json=teDefaultMetric,proto3" json:"te_default_metric,omitempty"`
+	Srlg                    []uint32  `protobuf:"varint,12,rep,packed,name=srlg,proto3" json:"srlg,omitempty"`
+	UnidirLinkDelay         uint32    `protobuf:"varint,13,opt,name=unidir_link_delay,json=unidirLinkDelay,proto3" json:"unidir_link_delay,omitempty"`
+	UnidirLinkDelayMinMax   []uint32  `protobuf:"varint,14,rep,packed,name=unidir_link_delay_min_max,json=unidirLinkDelayMinMax,proto3" json:"unidir_link_delay_min_max,omitempty"`
+	UnidirDelayVariation    uint32    `protobuf:"varint,15,opt,name=unidir_delay_variation,json=unidirDelayVariation,proto3" json:"unidir_delay_variation,omitempty"`
+	UnidirPacketLoss        uint32    `protobuf:"varint,16,opt,name=unidir_packet_loss,json=unidirPacketLoss,proto3" json:"unidir_packet_loss,omitempty"`
+	UnidirResidualBw        uint32    `protobuf:"varint,17,opt,name=unidir_residual_bw,json=unidirResidualBw,proto3" json:"unidir_residual_bw,omitempty"`
+	UnidirAvailableBw       uint32    `protobuf:"varint,18,opt,name=unidir_available_bw,json=unidirAvailableBw,proto3" json:"unidir_available_bw,omitempty"`
+	UnidirBwUtilization     uint32    `protobuf:"varint,19,opt,name=unidir_bw_utilization,json=unidirBwUtilization,proto3" json:"unidir_bw_utilization,omitempty"`
+}
+
+func (x *AppSpecLinkAttr) Reset() {
+	*x = AppSpecLinkAttr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppSpecLinkAttr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppSpecLinkAttr) ProtoMessage() {}
+
+func (x *AppSpecLinkAttr) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppSpecLinkAttr.ProtoReflect.Descriptor instead.
+func (*AppSpecLinkAttr) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AppSpecLinkAttr) GetSaibmLength() uint32 {
+	if x != nil {
+		return x.SaibmLength
+	}
+	return 0
+}
+
+func (x *AppSpecLinkAttr) GetUdaibmLength() uint32 {
+	if x != nil {
+		return x.UdaibmLength
+	}
+	return 0
+}
+
+func (x *AppSpecLinkAttr) GetStdAppIdBitMask() []byte {
+	if x != nil {
+		return x.StdAppIdBitMask
+	}
+	return nil
+}
+
+func (x *AppSpecLinkAttr) GetUdAppIdBitMask() []byte {
+	if x != nil {
+		return x.UdAppIdBitMask
+	}
+	return nil
+}
+
+func (x *AppSpecLinkAttr) GetSubTlvs() []*SubTLV {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+func (x *AppSpecLinkAttr) GetApplications() []string {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *AppSpecLinkAttr) GetUserDefinedApplications() []int64 {
+	if x != nil {
+		return x.UserDefinedApplications
+	}
+	return nil
+}
+
+func (x *AppSpecLinkAttr) GetAllApplications() bool {
+	if x != nil {
+		return x.AllApplications
+	}
+	return false
+}
+
+func (x *AppSpecLinkAttr) GetAdminGroup() uint32 {
+	if x != nil {
+		return x.AdminGroup
+	}
+	return 0
+}
+
+func (x *AppSpecLinkAttr) GetExtendedAdminGroup() []uint32 {
+	if x != nil {
+		return x.ExtendedAdminGroup
+	}
+	return nil
+}
+
+func (x *AppSpecLinkAttr) GetTeDefaultMetric() uint32 {
+	if x != nil {
+		return x.TeDefaultMetric
+	}
+	return 0
+}
+
+func (x *AppSpecLinkAttr) GetSrlg() []uint32 {
+	if x != nil {
+		return x.Srlg
+	}
+	return nil
+}
+
+func (x *AppSpecLinkAttr) GetUnidirLinkDelay() uint32 {
+	if x != nil {
+		return x.UnidirLinkDelay
+	}
+	return 0
+}
+
+func (x *AppSpecLinkAttr) GetUnidirLinkDelayMinMax() []uint32 {
+	if x != nil {
+		return x.UnidirLinkDelayMinMax
+	}
+	return nil
+}
+
+func (x *AppSpecLinkAttr) GetUnidirDelayVariation() uint32 {
+	if x != nil {
+		return x.UnidirDelayVariation
+	}
+	return 0
+}
+
+func (x *AppSpecLinkAttr) GetUnidirPacketLoss() uint32 {
+	if x != nil {
+		return x.UnidirPacketLoss
+	}
+	return 0
+}
+
+func (x *AppSpecLinkAttr) GetUnidirResidualBw() uint32 {
+	if x != nil {
+		return x.UnidirResidualBw
+	}
+	return 0
+}
+
+func (x *AppSpecLinkAttr) GetUnidirAvailableBw() uint32 {
+	if x != nil {
+		return x.UnidirAvailableBw
+	}
+	return 0
+}
+
+func (x *AppSpecLinkAttr) GetUnidirBwUtilization() uint32 {
+	if x != nil {
+		return x.UnidirBwUtilization
+	}
+	return 0
+}
+
+type BGPPeerNodeFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BFlag bool `protobuf:"varint,1,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	SFlag bool `protobuf:"varint,2,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
+	PFlag bool `protobuf:"varint,3,opt,name=p_flag,json=pFlag,proto3" json:"p_flag,omitempty"`
+}
+
+func (x *BGPPeerNodeFlags) Reset() {
+	*x = BGPPeerNodeFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BGPPeerNodeFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPPeerNodeFlags) ProtoMessage() {}
+
+func (x *BGPPeerNodeFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPPeerNodeFlags.ProtoReflect.Descriptor instead.
+func (*BGPPeerNodeFlags) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BGPPeerNodeFlags) GetBFlag() bool {
+	if x != nil {
+		return x.BFlag
+	}
+	return false
+}
+
+func (x *BGPPeerNodeFlags) GetSFlag() bool {
+	if x != nil {
+		return x.SFlag
+	}
+	return false
+}
+
+func (x *BGPPeerNodeFlags) GetPFlag() bool {
+	if x != nil {
+		return x.PFlag
+	}
+	return false
+}
+
+type BGPPeerNodeSID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags     *BGPPeerNodeFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Weight    uint32            `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	PeerAsn   uint32            `protobuf:"varint,3,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	PeerId    []byte            `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	PeerBgpId string            `protobuf:"bytes,5,opt,name=peer_bgp_id,json=peerBgpId,proto3" json:"peer_bgp_id,omitempty"`
+}
+
+func (x *BGPPeerNodeSID) Reset() {
+	*x = BGPPeerNodeSID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BGPPeerNodeSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPPeerNodeSID) ProtoMessage() {}
+
+func (x *BGPPeerNodeSID) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPPeerNodeSID.ProtoReflect.Descriptor instead.
+func (*BGPPeerNodeSID) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BGPPeerNodeSID) GetFlags() *BGPPeerNodeFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *BGPPeerNodeSID) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *BGPPeerNodeSID) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *BGPPeerNodeSID) GetPeerId() []byte {
+	if x != nil {
+		return x.PeerId
+	}
+	return nil
+}
+
+func (x *BGPPeerNodeSID) GetPeerBgpId() string {
+	if x != nil {
+		return x.PeerBgpId
+	}
+	return ""
+}
+
+type BaseAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseAttrHash       string   `protobuf:"bytes,1,opt,name=base_attr_hash,json=baseAttrHash,proto3" json:"base_attr_hash,omitempty"`
+	Origin             string   `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	AsPath             []uint32 `protobuf:"varint,3,rep,packed,name=as_path,json=asPath,proto3" json:"as_path,omitempty"`
+	AsPathCount        int32    `protobuf:"varint,4,opt,name=as_path_count,json=asPathCount,proto3" json:"as_path_count,omitempty"`
+	Nexthop            string   `protobuf:"bytes,5,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	Med                uint32   `protobuf:"varint,6,opt,name=med,proto3" json:"med,omitempty"`
+	LocalPref          uint32   `protobuf:"varint,7,opt,name=local_pref,json=localPref,proto3" json:"local_pref,omitempty"`
+	IsAtomicAgg        bool     `protobuf:"varint,8,opt,name=is_atomic_agg,json=isAtomicAgg,proto3" json:"is_atomic_agg,omitempty"`
+	Aggregator         []byte   `protobuf:"bytes,9,opt,name=aggregator,proto3" json:"aggregator,omitempty"`
+	CommunityList      []string `protobuf:"bytes,10,rep,name=community_list,json=communityList,proto3" json:"community_list,omitempty"`
+	OriginatorId       string   `protobuf:"bytes,11,opt,name=originator_id,json=originatorId,proto3" json:"originator_id,omitempty"`
+	ClusterList        string   `protobuf:"bytes,12,opt,name=cluster_list,json=clusterList,proto3" json:"cluster_list,omitempty"`
+	ExtCommunityList   []string `protobuf:"bytes,13,rep,name=ext_community_list,json=extCommunityList,proto3" json:"ext_community_list,omitempty"`
+	As4Path            []uint32 `protobuf:"varint,14,rep,packed,name=as4_path,json=as4Path,proto3" json:"as4_path,omitempty"`
+	As4PathCount       int32    `protobuf:"varint,15,opt,name=as4_path_count,json=as4PathCount,proto3" json:"as4_path_count,omitempty"`
+	As4Aggregator      []byte   `protobuf:"bytes,16,opt,name=as4_aggregator,json=as4Aggregator,proto3" json:"as4_aggregator,omitempty"`
+	LargeCommunityList []string `protobuf:"bytes,17,rep,name=large_community_list,json=largeCommunityList,proto3" json:"large_community_list,omitempty"`
+	Otc                uint32   `protobuf:"varint,18,opt,name=otc,proto3" json:"otc,omitempty"`
+}
+
+func (x *BaseAttributes) Reset() {
+	*x = BaseAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaseAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseAttributes) ProtoMessage() {}
+
+func (x *BaseAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseAttributes.ProtoReflect.Descriptor instead.
+func (*BaseAttributes) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BaseAttributes) GetBaseAttrHash() string {
+	if x != nil {
+		return x.BaseAttrHash
+	}
+	return ""
+}
+
+func (x *BaseAttributes) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *BaseAttributes) GetAsPath() []uint32 {
+	if x != nil {
+		return x.AsPath
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetAsPathCount() int32 {
+	if x != nil {
+		return x.AsPathCount
+	}
+	return 0
+}
+
+func (x *BaseAttributes) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *BaseAttributes) GetMed() uint32 {
+	if x != nil {
+		return x.Med
+	}
+	return 0
+}
+
+func (x *BaseAttributes) GetLocalPref() uint32 {
+	if x != nil {
+		return x.LocalPref
+	}
+	return 0
+}
+
+func (x *BaseAttributes) GetIsAtomicAgg() bool {
+	if x != nil {
+		return x.IsAtomicAgg
+	}
+	return false
+}
+
+func (x *BaseAttributes) GetAggregator() []byte {
+	if x != nil {
+		return x.Aggregator
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetCommunityList() []string {
+	if x != nil {
+		return x.CommunityList
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetOriginatorId() string {
+	if x != nil {
+		return x.OriginatorId
+	}
+	return ""
+}
+
+func (x *BaseAttributes) GetClusterList() string {
+	if x != nil {
+		return x.ClusterList
+	}
+	return ""
+}
+
+func (x *BaseAttributes) GetExtCommunityList() []string {
+	if x != nil {
+		return x.ExtCommunityList
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetAs4Path() []uint32 {
+	if x != nil {
+		return x.As4Path
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetAs4PathCount() int32 {
+	if x != nil {
+		return x.As4PathCount
+	}
+	return 0
+}
+
+func (x *BaseAttributes) GetAs4Aggregator() []byte {
+	if x != nil {
+		return x.As4Aggregator
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetLargeCommunityList() []string {
+	if x != nil {
+		return x.LargeCommunityList
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetOtc() uint32 {
+	if x != nil {
+		return x.Otc
+	}
+	return 0
+}
+
+type CapabilityTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OFlag bool `protobuf:"varint,1,opt,name=o_flag,json=oFlag,proto3" json:"o_flag,omitempty"`
+}
+
+func (x *CapabilityTLV) Reset() {
+	*x = CapabilityTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapabilityTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilityTLV) ProtoMessage() {}
+
+func (x *CapabilityTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilityTLV.ProtoReflect.Descriptor instead.
+func (*CapabilityTLV) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CapabilityTLV) GetOFlag() bool {
+	if x != nil {
+		return x.OFlag
+	}
+	return false
+}
+
+type Change struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouterIp   string  `protobuf:"bytes,1,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	RouterHash string  `protobuf:"bytes,2,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	PeerIp     string  `protobuf:"bytes,3,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerHash   string  `protobuf:"bytes,4,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerAsn    uint32  `protobuf:"varint,5,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	PeerRd     string  `protobuf:"bytes,6,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	Mode       string  `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"`
+	Rate       float64 `protobuf:"fixed64,8,opt,name=rate,proto3" json:"rate,omitempty"`
+	Threshold  int64   `protobuf:"varint,9,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Timestamp  string  `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Change) Reset() {
+	*x = Change{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Change) ProtoMessage() {}
+
+func (x *Change) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Change.ProtoReflect.Descriptor instead.
+func (*Change) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Change) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *Change) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *Change) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *Change) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *Change) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *Change) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *Change) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Change) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Change) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Change) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type Diff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp string  `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Added     []*Link `protobuf:"bytes,2,rep,name=added,proto3" json:"added,omitempty"`
+	Removed   []*Link `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *Diff) Reset() {
+	*x = Diff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Diff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diff) ProtoMessage() {}
+
+func (x *Diff) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diff.ProtoReflect.Descriptor instead.
+func (*Diff) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Diff) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Diff) GetAdded() []*Link {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *Diff) GetRemoved() []*Link {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+type ENLP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Enlp  uint32 `protobuf:"varint,2,opt,name=enlp,proto3" json:"enlp,omitempty"`
+}
+
+func (x *ENLP) Reset() {
+	*x = ENLP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ENLP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ENLP) ProtoMessage() {}
+
+func (x *ENLP) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ENLP.ProtoReflect.Descriptor instead.
+func (*ENLP) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ENLP) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *ENLP) GetEnlp() uint32 {
+	if x != nil {
+		return x.Enlp
+	}
+	return 0
+}
+
+type EVPNPrefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XKey                  string             `protobuf:"bytes,1,opt,name=_key,json=Key,proto3" json:"_key,omitempty"`
+	XId                   string             `protobuf:"bytes,2,opt,name=_id,json=Id,proto3" json:"_id,omitempty"`
+	XRev                  string             `protobuf:"bytes,3,opt,name=_rev,json=Rev,proto3" json:"_rev,omitempty"`
+	Action                string             `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64              `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string             `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string             `protobuf:"bytes,7,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string             `protobuf:"bytes,8,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes    `protobuf:"bytes,9,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string             `protobuf:"bytes,10,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	RemoteBgpId           string             `protobuf:"bytes,11,opt,name=remote_bgp_id,json=remoteBgpId,proto3" json:"remote_bgp_id,omitempty"`
+	PeerIp                string             `protobuf:"bytes,12,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32             `protobuf:"varint,13,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd                string             `protobuf:"bytes,14,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn               uint32             `protobuf:"varint,15,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string             `protobuf:"bytes,16,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp    string             `protobuf:"bytes,17,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	IsIpv4                bool               `protobuf:"varint,18,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              int32              `protobuf:"varint,19,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string             `protobuf:"bytes,20,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	ClusterList           string             `protobuf:"bytes,21,opt,name=cluster_list,json=clusterList,proto3" json:"cluster_list,omitempty"`
+	IsNexthopIpv4         bool               `protobuf:"varint,22,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32              `protobuf:"varint,23,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Labels                []uint32           `protobuf:"varint,24,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Rawlabels             []uint32           `protobuf:"varint,25,rep,packed,name=rawlabels,proto3" json:"rawlabels,omitempty"`
+	VpnRd                 string             `protobuf:"bytes,26,opt,name=vpn_rd,json=vpnRd,proto3" json:"vpn_rd,omitempty"`
+	VpnRdType             uint32             `protobuf:"varint,27,opt,name=vpn_rd_type,json=vpnRdType,proto3" json:"vpn_rd_type,omitempty"`
+	EthSegmentId          string             `protobuf:"bytes,28,opt,name=eth_segment_id,json=ethSegmentId,proto3" json:"eth_segment_id,omitempty"`
+	EthTag                []byte             `protobuf:"bytes,29,opt,name=eth_tag,json=ethTag,proto3" json:"eth_tag,omitempty"`
+	IpAddress             string             `protobuf:"bytes,30,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	IpLen                 uint32             `protobuf:"varint,31,opt,name=ip_len,json=ipLen,proto3" json:"ip_len,omitempty"`
+	GwAddress             string             `protobuf:"bytes,32,opt,name=gw_address,json=gwAddress,proto3" json:"gw_address,omitempty"`
+	Mac                   string             `protobuf:"bytes,33,opt,name=mac,proto3" json:"mac,omitempty"`
+	MacLen                uint32             `protobuf:"varint,34,opt,name=mac_len,json=macLen,proto3" json:"mac_len,omitempty"`
+	RouteType             uint32             `protobuf:"varint,35,opt,name=route_type,json=routeType,proto3" json:"route_type,omitempty"`
+	Srv6Sids              []string           `protobuf:"bytes,36,rep,name=srv6_sids,json=srv6Sids,proto3" json:"srv6_sids,omitempty"`
+	Srv6ServiceSids       []*ServiceSID      `protobuf:"bytes,37,rep,name=srv6_service_sids,json=srv6ServiceSids,proto3" json:"srv6_service_sids,omitempty"`
+	RouterMac             string             `protobuf:"bytes,38,opt,name=router_mac,json=routerMac,proto3" json:"router_mac,omitempty"`
+	OverlayIndex          string             `protobuf:"bytes,39,opt,name=overlay_index,json=overlayIndex,proto3" json:"overlay_index,omitempty"`
+	McastSrc              string             `protobuf:"bytes,40,opt,name=mcast_src,json=mcastSrc,proto3" json:"mcast_src,omitempty"`
+	McastGrp              string             `protobuf:"bytes,41,opt,name=mcast_grp,json=mcastGrp,proto3" json:"mcast_grp,omitempty"`
+	OriginatorRouter      string             `protobuf:"bytes,42,opt,name=originator_router,json=originatorRouter,proto3" json:"originator_router,omitempty"`
+	McastFlags            []string           `protobuf:"bytes,43,rep,name=mcast_flags,json=mcastFlags,proto3" json:"mcast_flags,omitempty"`
+	MaxResponseTime       uint32             `protobuf:"varint,44,opt,name=max_response_time,json=maxResponseTime,proto3" json:"max_response_time,omitempty"`
+	PmsiTunnel            *PMSITunnel        `protobuf:"bytes,45,opt,name=pmsi_tunnel,json=pmsiTunnel,proto3" json:"pmsi_tunnel,omitempty"`
+	IsAdjRibInPostPolicy  bool               `protobuf:"varint,46,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool               `protobuf:"varint,47,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool               `protobuf:"varint,48,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy          bool               `protobuf:"varint,49,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	IsLocRibFiltered      bool               `protobuf:"varint,50,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	RibType               string             `protobuf:"bytes,51,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	TableName             string             `protobuf:"bytes,52,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	BmpTlvs               []*RouteMonitorTLV `protobuf:"bytes,53,rep,name=bmp_tlvs,json=bmpTlvs,proto3" json:"bmp_tlvs,omitempty"`
+}
+
+func (x *EVPNPrefix) Reset() {
+	*x = EVPNPrefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVPNPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVPNPrefix) ProtoMessage() {}
+
+func (x *EVPNPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVPNPrefix.ProtoReflect.Descriptor instead.
+func (*EVPNPrefix) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EVPNPrefix) GetXKey() string {
+	if x != nil {
+		return x.XKey
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetXId() string {
+	if x != nil {
+		return x.XId
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetXRev() string {
+	if x != nil {
+		return x.XRev
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetRemoteBgpId() string {
+	if x != nil {
+		return x.RemoteBgpId
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetOriginAs() int32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetClusterList() string {
+	if x != nil {
+		return x.ClusterList
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetLabels() []uint32 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetRawlabels() []uint32 {
+	if x != nil {
+		return x.Rawlabels
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetVpnRd() string {
+	if x != nil {
+		return x.VpnRd
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetVpnRdType() uint32 {
+	if x != nil {
+		return x.VpnRdType
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetEthSegmentId() string {
+	if x != nil {
+		return x.EthSegmentId
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetEthTag() []byte {
+	if x != nil {
+		return x.EthTag
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetIpLen() uint32 {
+	if x != nil {
+		return x.IpLen
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetGwAddress() string {
+	if x != nil {
+		return x.GwAddress
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetMac() string {
+	if x != nil {
+		return x.Mac
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetMacLen() uint32 {
+	if x != nil {
+		return x.MacLen
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetRouteType() uint32 {
+	if x != nil {
+		return x.RouteType
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetSrv6Sids() []string {
+	if x != nil {
+		return x.Srv6Sids
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetSrv6ServiceSids() []*ServiceSID {
+	if x != nil {
+		return x.Srv6ServiceSids
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetRouterMac() string {
+	if x != nil {
+		return x.RouterMac
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetOverlayIndex() string {
+	if x != nil {
+		return x.OverlayIndex
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetMcastSrc() string {
+	if x != nil {
+		return x.McastSrc
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetMcastGrp() string {
+	if x != nil {
+		return x.McastGrp
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetOriginatorRouter() string {
+	if x != nil {
+		return x.OriginatorRouter
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetMcastFlags() []string {
+	if x != nil {
+		return x.McastFlags
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetMaxResponseTime() uint32 {
+	if x != nil {
+		return x.MaxResponseTime
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetPmsiTunnel() *PMSITunnel {
+	if x != nil {
+		return x.PmsiTunnel
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetBmpTlvs() []*RouteMonitorTLV {
+	if x != nil {
+		return x.BmpTlvs
+	}
+	return nil
+}
+
+type EgressSID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Sid         uint32 `protobuf:"varint,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	Weight      uint32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	LocalIp     string `protobuf:"bytes,4,opt,name=local_ip,json=localIp,proto3" json:"local_ip,omitempty"`
+	BgpRouterId string `protobuf:"bytes,5,opt,name=bgp_router_id,json=bgpRouterId,proto3" json:"bgp_router_id,omitempty"`
+	RouterIp    string `protobuf:"bytes,6,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+}
+
+func (x *EgressSID) Reset() {
+	*x = EgressSID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressSID) ProtoMessage() {}
+
+func (x *EgressSID) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressSID.ProtoReflect.Descriptor instead.
+func (*EgressSID) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EgressSID) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EgressSID) GetSid() uint32 {
+	if x != nil {
+		return x.Sid
+	}
+	return 0
+}
+
+func (x *EgressSID) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *EgressSID) GetLocalIp() string {
+	if x != nil {
+		return x.LocalIp
+	}
+	return ""
+}
+
+func (x *EgressSID) GetBgpRouterId() string {
+	if x != nil {
+		return x.BgpRouterId
+	}
+	return ""
+}
+
+func (x *EgressSID) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+type EndXSIDFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BFlag bool `protobuf:"varint,1,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	SFlag bool `protobuf:"varint,2,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
+	PFlag bool `protobuf:"varint,3,opt,name=p_flag,json=pFlag,proto3" json:"p_flag,omitempty"`
+}
+
+func (x *EndXSIDFlags) Reset() {
+	*x = EndXSIDFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndXSIDFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndXSIDFlags) ProtoMessage() {}
+
+func (x *EndXSIDFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndXSIDFlags.ProtoReflect.Descriptor instead.
+func (*EndXSIDFlags) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EndXSIDFlags) GetBFlag() bool {
+	if x != nil {
+		return x.BFlag
+	}
+	return false
+}
+
+func (x *EndXSIDFlags) GetSFlag() bool {
+	if x != nil {
+		return x.SFlag
+	}
+	return false
+}
+
+func (x *EndXSIDFlags) GetPFlag() bool {
+	if x != nil {
+		return x.PFlag
+	}
+	return false
+}
+
+type EndXSIDTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type             uint32        `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Length           uint32        `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	EndpointBehavior uint32        `protobuf:"varint,3,opt,name=endpoint_behavior,json=endpointBehavior,proto3" json:"endpoint_behavior,omitempty"`
+	Flags            *EndXSIDFlags `protobuf:"bytes,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	Algorithm        uint32        `protobuf:"varint,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Weight           uint32        `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	Sid              string        `protobuf:"bytes,7,opt,name=sid,proto3" json:"sid,omitempty"`
+	SubTlvs          []string      `protobuf:"bytes,8,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty"`
+}
+
+func (x *EndXSIDTLV) Reset() {
+	*x = EndXSIDTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndXSIDTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndXSIDTLV) ProtoMessage() {}
+
+func (x *EndXSIDTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndXSIDTLV.ProtoReflect.Descriptor instead.
+func (*EndXSIDTLV) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EndXSIDTLV) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *EndXSIDTLV) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *EndXSIDTLV) GetEndpointBehavior() uint32 {
+	if x != nil {
+		return x.EndpointBehavior
+	}
+	return 0
+}
+
+func (x *EndXSIDTLV) GetFlags() *EndXSIDFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *EndXSIDTLV) GetAlgorithm() uint32 {
+	if x != nil {
+		return x.Algorithm
+	}
+	return 0
+}
+
+func (x *EndXSIDTLV) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *EndXSIDTLV) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *EndXSIDTLV) GetSubTlvs() []string {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+type EndpointBehavior struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndpointBehavior uint32 `protobuf:"varint,1,opt,name=endpoint_behavior,json=endpointBehavior,proto3" json:"endpoint_behavior,omitempty"`
+	Flag             uint32 `protobuf:"varint,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	Algo             uint32 `protobuf:"varint,3,opt,name=algo,proto3" json:"algo,omitempty"`
+}
+
+func (x *EndpointBehavior) Reset() {
+	*x = EndpointBehavior{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndpointBehavior) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointBehavior) ProtoMessage() {}
+
+func (x *EndpointBehavior) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointBehavior.ProtoReflect.Descriptor instead.
+func (*EndpointBehavior) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EndpointBehavior) GetEndpointBehavior() uint32 {
+	if x != nil {
+		return x.EndpointBehavior
+	}
+	return 0
+}
+
+func (x *EndpointBehavior) GetFlag() uint32 {
+	if x != nil {
+		return x.Flag
+	}
+	return 0
+}
+
+func (x *EndpointBehavior) GetAlgo() uint32 {
+	if x != nil {
+		return x.Algo
+	}
+	return 0
+}
+
+type EventsEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Category  string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Severity  string `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	Message   string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Action    string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	RouterIp  string `protobuf:"bytes,6,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	Count     uint64 `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	FirstSeen string `protobuf:"bytes,8,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen  string `protobuf:"bytes,9,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Timestamp string `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *EventsEvent) Reset() {
+	*x = EventsEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsEvent) ProtoMessage() {}
+
+func (x *EventsEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsEvent.ProtoReflect.Descriptor instead.
+func (*EventsEvent) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EventsEvent) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *EventsEvent) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *EventsEvent) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *EventsEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EventsEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *EventsEvent) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *EventsEvent) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EventsEvent) GetFirstSeen() string {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return ""
+}
+
+func (x *EventsEvent) GetLastSeen() string {
+	if x != nil {
+		return x.LastSeen
+	}
+	return ""
+}
+
+func (x *EventsEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type FADSubTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExcludeAny  []uint32        `protobuf:"varint,1,rep,packed,name=exclude_any,json=excludeAny,proto3" json:"exclude_any,omitempty"`
+	IncludeAny  []uint32        `protobuf:"varint,2,rep,packed,name=include_any,json=includeAny,proto3" json:"include_any,omitempty"`
+	IncludeAll  []uint32        `protobuf:"varint,3,rep,packed,name=include_all,json=includeAll,proto3" json:"include_all,omitempty"`
+	Flags       *FADSubTLVFlags `protobuf:"bytes,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	ExcludeSrlg []uint32        `protobuf:"varint,5,rep,packed,name=exclude_srlg,json=excludeSrlg,proto3" json:"exclude_srlg,omitempty"`
+}
+
+func (x *FADSubTLV) Reset() {
+	*x = FADSubTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FADSubTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FADSubTLV) ProtoMessage() {}
+
+func (x *FADSubTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FADSubTLV.ProtoReflect.Descriptor instead.
+func (*FADSubTLV) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FADSubTLV) GetExcludeAny() []uint32 {
+	if x != nil {
+		return x.ExcludeAny
+	}
+	return nil
+}
+
+func (x *FADSubTLV) GetIncludeAny() []uint32 {
+	if x != nil {
+		return x.IncludeAny
+	}
+	return nil
+}
+
+func (x *FADSubTLV) GetIncludeAll() []uint32 {
+	if x != nil {
+		return x.IncludeAll
+	}
+	return nil
+}
+
+func (x *FADSubTLV) GetFlags() *FADSubTLVFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *FADSubTLV) GetExcludeSrlg() []uint32 {
+	if x != nil {
+		return x.ExcludeSrlg
+	}
+	return nil
+}
+
+type FADSubTLVFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MFlag bool `protobuf:"varint,1,opt,name=m_flag,json=mFlag,proto3" json:"m_flag,omitempty"`
+}
+
+func (x *FADSubTLVFlags) Reset() {
+	*x = FADSubTLVFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FADSubTLVFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FADSubTLVFlags) ProtoMessage() {}
+
+func (x *FADSubTLVFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FADSubTLVFlags.ProtoReflect.Descriptor instead.
+func (*FADSubTLVFlags) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FADSubTLVFlags) GetMFlag() bool {
+	if x != nil {
+		return x.MFlag
+	}
+	return false
+}
+
+type FlexAlgoDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlexAlgo        uint32     `protobuf:"varint,1,opt,name=flex_algo,json=flexAlgo,proto3" json:"flex_algo,omitempty"`
+	MetricType      uint32     `protobuf:"varint,2,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	CalculationType uint32     `protobuf:"varint,3,opt,name=calculation_type,json=calculationType,proto3" json:"calculation_type,omitempty"`
+	Priority        uint32     `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	SubTlv          *FADSubTLV `protobuf:"bytes,5,opt,name=sub_tlv,json=subTlv,proto3" json:"sub_tlv,omitempty"`
+}
+
+func (x *FlexAlgoDefinition) Reset() {
+	*x = FlexAlgoDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlexAlgoDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexAlgoDefinition) ProtoMessage() {}
+
+func (x *FlexAlgoDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexAlgoDefinition.ProtoReflect.Descriptor instead.
+func (*FlexAlgoDefinition) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FlexAlgoDefinition) GetFlexAlgo() uint32 {
+	if x != nil {
+		return x.FlexAlgo
+	}
+	return 0
+}
+
+func (x *FlexAlgoDefinition) GetMetricType() uint32 {
+	if x != nil {
+		return x.MetricType
+	}
+	return 0
+}
+
+func (x *FlexAlgoDefinition) GetCalculationType() uint32 {
+	if x != nil {
+		return x.CalculationType
+	}
+	return 0
+}
+
+func (x *FlexAlgoDefinition) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *FlexAlgoDefinition) GetSubTlv() *FADSubTLV {
+	if x != nil {
+		return x.SubTlv
+	}
+	return nil
+}
+
+type FlexAlgoPrefixMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlexAlgo uint32 `protobuf:"varint,1,opt,name=flex_algo,json=flexAlgo,proto3" json:"flex_algo,omitempty"`
+	Metric   uint32 `protobuf:"varint,2,opt,name=metric,proto3" json:"metric,omitempty"`
+}
+
+func (x *FlexAlgoPrefixMetric) Reset() {
+	*x = FlexAlgoPrefixMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlexAlgoPrefixMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexAlgoPrefixMetric) ProtoMessage() {}
+
+func (x *FlexAlgoPrefixMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexAlgoPrefixMetric.ProtoReflect.Descriptor instead.
+func (*FlexAlgoPrefixMetric) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FlexAlgoPrefixMetric) GetFlexAlgo() uint32 {
+	if x != nil {
+		return x.FlexAlgo
+	}
+	return 0
+}
+
+func (x *FlexAlgoPrefixMetric) GetMetric() uint32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+type Flowspec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XKey                  string             `protobuf:"bytes,1,opt,name=_key,json=Key,proto3" json:"_key,omitempty"`
+	XId                   string             `protobuf:"bytes,2,opt,name=_id,json=Id,proto3" json:"_id,omitempty"`
+	XRev                  string             `protobuf:"bytes,3,opt,name=_rev,json=Rev,proto3" json:"_rev,omitempty"`
+	Action                string             `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64              `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	RouterIp              string             `protobuf:"bytes,6,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes    `protobuf:"bytes,7,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerIp                string             `protobuf:"bytes,8,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32             `protobuf:"varint,9,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd                string             `protobuf:"bytes,10,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn               uint32             `protobuf:"varint,11,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string             `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp    string             `protobuf:"bytes,13,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	IsIpv4                bool               `protobuf:"varint,14,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              int32              `protobuf:"varint,15,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string             `protobuf:"bytes,16,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	IsNexthopIpv4         bool               `protobuf:"varint,17,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32              `protobuf:"varint,18,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	VpnRd                 string             `protobuf:"bytes,19,opt,name=vpn_rd,json=vpnRd,proto3" json:"vpn_rd,omitempty"`
+	SpecHash              string             `protobuf:"bytes,20,opt,name=spec_hash,json=specHash,proto3" json:"spec_hash,omitempty"`
+	Spec                  []string           `protobuf:"bytes,21,rep,name=spec,proto3" json:"spec,omitempty"`
+	Rules                 map[string]string  `protobuf:"bytes,22,rep,name=rules,proto3" json:"rules,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	IsAdjRibInPostPolicy  bool               `protobuf:"varint,23,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool               `protobuf:"varint,24,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool               `protobuf:"varint,25,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy          bool               `protobuf:"varint,26,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	IsLocRibFiltered      bool               `protobuf:"varint,27,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	RibType               string             `protobuf:"bytes,28,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	TableName             string             `protobuf:"bytes,29,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	BmpTlvs               []*RouteMonitorTLV `protobuf:"bytes,30,rep,name=bmp_tlvs,json=bmpTlvs,proto3" json:"bmp_tlvs,omitempty"`
+}
+
+func (x *Flowspec) Reset() {
+	*x = Flowspec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Flowspec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flowspec) ProtoMessage() {}
+
+func (x *Flowspec) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flowspec.ProtoReflect.Descriptor instead.
+func (*Flowspec) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Flowspec) GetXKey() string {
+	if x != nil {
+		return x.XKey
+	}
+	return ""
+}
+
+func (x *Flowspec) GetXId() string {
+	if x != nil {
+		return x.XId
+	}
+	return ""
+}
+
+func (x *Flowspec) GetXRev() string {
+	if x != nil {
+		return x.XRev
+	}
+	return ""
+}
+
+func (x *Flowspec) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Flowspec) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Flowspec) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *Flowspec) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *Flowspec) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *Flowspec) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *Flowspec) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *Flowspec) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *Flowspec) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Flowspec) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *Flowspec) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *Flowspec) GetOriginAs() int32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *Flowspec) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *Flowspec) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *Flowspec) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *Flowspec) GetVpnRd() string {
+	if x != nil {
+		return x.VpnRd
+	}
+	return ""
+}
+
+func (x *Flowspec) GetSpecHash() string {
+	if x != nil {
+		return x.SpecHash
+	}
+	return ""
+}
+
+func (x *Flowspec) GetSpec() []string {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Flowspec) GetRules() map[string]string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Flowspec) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *Flowspec) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *Flowspec) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *Flowspec) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *Flowspec) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *Flowspec) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *Flowspec) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *Flowspec) GetBmpTlvs() []*RouteMonitorTLV {
+	if x != nil {
+		return x.BmpTlvs
+	}
+	return nil
+}
+
+type IGPAdjacency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action             string                   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	RouterHash         string                   `protobuf:"bytes,2,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp           string                   `protobuf:"bytes,3,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	DomainId           int64                    `protobuf:"varint,4,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	PeerHash           string                   `protobuf:"bytes,5,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp             string                   `protobuf:"bytes,6,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerAsn            uint32                   `protobuf:"varint,7,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp          string                   `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp string                   `protobuf:"bytes,9,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	UpSince            string                   `protobuf:"bytes,10,opt,name=up_since,json=upSince,proto3" json:"up_since,omitempty"`
+	Protocol           string                   `protobuf:"bytes,11,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	ProtocolId         uint32                   `protobuf:"varint,12,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	AreaId             string                   `protobuf:"bytes,13,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	MtIdTlv            *MultiTopologyIdentifier `protobuf:"bytes,14,opt,name=mt_id_tlv,json=mtIdTlv,proto3" json:"mt_id_tlv,omitempty"`
+	LocalNodeHash      string                   `protobuf:"bytes,15,opt,name=local_node_hash,json=localNodeHash,proto3" json:"local_node_hash,omitempty"`
+	RemoteNodeHash     string                   `protobuf:"bytes,16,opt,name=remote_node_hash,json=remoteNodeHash,proto3" json:"remote_node_hash,omitempty"`
+	LocalNodeName      string                   `protobuf:"bytes,17,opt,name=local_node_name,json=localNodeName,proto3" json:"local_node_name,omitempty"`
+	RemoteNodeName     string                   `protobuf:"bytes,18,opt,name=remote_node_name,json=remoteNodeName,proto3" json:"remote_node_name,omitempty"`
+	IgpRouterId        string                   `protobuf:"bytes,19,opt,name=igp_router_id,json=igpRouterId,proto3" json:"igp_router_id,omitempty"`
+	RemoteIgpRouterId  string                   `protobuf:"bytes,20,opt,name=remote_igp_router_id,json=remoteIgpRouterId,proto3" json:"remote_igp_router_id,omitempty"`
+	LocalLinkIp        string                   `protobuf:"bytes,21,opt,name=local_link_ip,json=localLinkIp,proto3" json:"local_link_ip,omitempty"`
+	RemoteLinkIp       string                   `protobuf:"bytes,22,opt,name=remote_link_ip,json=remoteLinkIp,proto3" json:"remote_link_ip,omitempty"`
+	LocalLinkId        uint32                   `protobuf:"varint,23,opt,name=local_link_id,json=localLinkId,proto3" json:"local_link_id,omitempty"`
+	RemoteLinkId       uint32                   `protobuf:"varint,24,opt,name=remote_link_id,json=remoteLinkId,proto3" json:"remote_link_id,omitempty"`
+	Reason             string                   `protobuf:"bytes,25,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *IGPAdjacency) Reset() {
+	*x = IGPAdjacency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IGPAdjacency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IGPAdjacency) ProtoMessage() {}
+
+func (x *IGPAdjacency) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IGPAdjacency.ProtoReflect.Descriptor instead.
+func (*IGPAdjacency) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *IGPAdjacency) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *IGPAdjacency) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *IGPAdjacency) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetUpSince() string {
+	if x != nil {
+		return x.UpSince
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetProtocolId() uint32 {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return 0
+}
+
+func (x *IGPAdjacency) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetMtIdTlv() *MultiTopologyIdentifier {
+	if x != nil {
+		return x.MtIdTlv
+	}
+	return nil
+}
+
+func (x *IGPAdjacency) GetLocalNodeHash() string {
+	if x != nil {
+		return x.LocalNodeHash
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetRemoteNodeHash() string {
+	if x != nil {
+		return x.RemoteNodeHash
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetLocalNodeName() string {
+	if x != nil {
+		return x.LocalNodeName
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetRemoteNodeName() string {
+	if x != nil {
+		return x.RemoteNodeName
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetIgpRouterId() string {
+	if x != nil {
+		return x.IgpRouterId
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetRemoteIgpRouterId() string {
+	if x != nil {
+		return x.RemoteIgpRouterId
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetLocalLinkIp() string {
+	if x != nil {
+		return x.LocalLinkIp
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetRemoteLinkIp() string {
+	if x != nil {
+		return x.RemoteLinkIp
+	}
+	return ""
+}
+
+func (x *IGPAdjacency) GetLocalLinkId() uint32 {
+	if x != nil {
+		return x.LocalLinkId
+	}
+	return 0
+}
+
+func (x *IGPAdjacency) GetRemoteLinkId() uint32 {
+	if x != nil {
+		return x.RemoteLinkId
+	}
+	return 0
+}
+
+func (x *IGPAdjacency) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type IGPFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DFlag bool `protobuf:"varint,1,opt,name=d_flag,json=dFlag,proto3" json:"d_flag,omitempty"`
+	NFlag bool `protobuf:"varint,2,opt,name=n_flag,json=nFlag,proto3" json:"n_flag,omitempty"`
+	LFlag bool `protobuf:"varint,3,opt,name=l_flag,json=lFlag,proto3" json:"l_flag,omitempty"`
+	PFlag bool `protobuf:"varint,4,opt,name=p_flag,json=pFlag,proto3" json:"p_flag,omitempty"`
+}
+
+func (x *IGPFlags) Reset() {
+	*x = IGPFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IGPFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IGPFlags) ProtoMessage() {}
+
+func (x *IGPFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IGPFlags.ProtoReflect.Descriptor instead.
+func (*IGPFlags) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *IGPFlags) GetDFlag() bool {
+	if x != nil {
+		return x.DFlag
+	}
+	return false
+}
+
+func (x *IGPFlags) GetNFlag() bool {
+	if x != nil {
+		return x.NFlag
+	}
+	return false
+}
+
+func (x *IGPFlags) GetLFlag() bool {
+	if x != nil {
+		return x.LFlag
+	}
+	return false
+}
+
+func (x *IGPFlags) GetPFlag() bool {
+	if x != nil {
+		return x.PFlag
+	}
+	return false
+}
+
+type JSONMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Json string `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *JSONMessage) Reset() {
+	*x = JSONMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONMessage) ProtoMessage() {}
+
+func (x *JSONMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONMessage.ProtoReflect.Descriptor instead.
+func (*JSONMessage) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *JSONMessage) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+type L2Service struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubTlvs string `protobuf:"bytes,1,opt,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty"`
+}
+
+func (x *L2Service) Reset() {
+	*x = L2Service{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L2Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L2Service) ProtoMessage() {}
+
+func (x *L2Service) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L2Service.ProtoReflect.Descriptor instead.
+func (*L2Service) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *L2Service) GetSubTlvs() string {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return ""
+}
+
+type L3Service struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubTlvs string `protobuf:"bytes,1,opt,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty"`
+}
+
+func (x *L3Service) Reset() {
+	*x = L3Service{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L3Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L3Service) ProtoMessage() {}
+
+func (x *L3Service) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L3Service.ProtoReflect.Descriptor instead.
+func (*L3Service) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *L3Service) GetSubTlvs() string {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return ""
+}
+
+type L3VPNPrefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XKey                  string             `protobuf:"bytes,1,opt,name=_key,json=Key,proto3" json:"_key,omitempty"`
+	XId                   string             `protobuf:"bytes,2,opt,name=_id,json=Id,proto3" json:"_id,omitempty"`
+	XRev                  string             `protobuf:"bytes,3,opt,name=_rev,json=Rev,proto3" json:"_rev,omitempty"`
+	Action                string             `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64              `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string             `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string             `protobuf:"bytes,7,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string             `protobuf:"bytes,8,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes    `protobuf:"bytes,9,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string             `protobuf:"bytes,10,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string             `protobuf:"bytes,11,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32             `protobuf:"varint,12,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd                string             `protobuf:"bytes,13,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn               uint32             `protobuf:"varint,14,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string             `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp    string             `protobuf:"bytes,16,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	Prefix                string             `protobuf:"bytes,17,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen             int32              `protobuf:"varint,18,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	IsIpv4                bool               `protobuf:"varint,19,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              int32              `protobuf:"varint,20,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string             `protobuf:"bytes,21,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	ClusterList           string             `protobuf:"bytes,22,opt,name=cluster_list,json=clusterList,proto3" json:"cluster_list,omitempty"`
+	IsNexthopIpv4         bool               `protobuf:"varint,23,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32              `protobuf:"varint,24,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Labels                []uint32           `protobuf:"varint,25,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	VpnRd                 string             `protobuf:"bytes,26,opt,name=vpn_rd,json=vpnRd,proto3" json:"vpn_rd,omitempty"`
+	VpnRdType             uint32             `protobuf:"varint,27,opt,name=vpn_rd_type,json=vpnRdType,proto3" json:"vpn_rd_type,omitempty"`
+	PrefixSid             *PSid              `protobuf:"bytes,28,opt,name=prefix_sid,json=prefixSid,proto3" json:"prefix_sid,omitempty"`
+	Srv6Sids              []string           `protobuf:"bytes,29,rep,name=srv6_sids,json=srv6Sids,proto3" json:"srv6_sids,omitempty"`
+	Srv6ServiceSids       []*ServiceSID      `protobuf:"bytes,30,rep,name=srv6_service_sids,json=srv6ServiceSids,proto3" json:"srv6_service_sids,omitempty"`
+	IsLlgrStale           bool               `protobuf:"varint,31,opt,name=is_llgr_stale,json=isLlgrStale,proto3" json:"is_llgr_stale,omitempty"`
+	IsAdjRibInPostPolicy  bool               `protobuf:"varint,32,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool               `protobuf:"varint,33,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool               `protobuf:"varint,34,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy          bool               `protobuf:"varint,35,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	IsLocRibFiltered      bool               `protobuf:"varint,36,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	RibType               string             `protobuf:"bytes,37,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	TableName             string             `protobuf:"bytes,38,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	BmpTlvs               []*RouteMonitorTLV `protobuf:"bytes,39,rep,name=bmp_tlvs,json=bmpTlvs,proto3" json:"bmp_tlvs,omitempty"`
+}
+
+func (x *L3VPNPrefix) Reset() {
+	*x = L3VPNPrefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L3VPNPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L3VPNPrefix) ProtoMessage() {}
+
+func (x *L3VPNPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L3VPNPrefix.ProtoReflect.Descriptor instead.
+func (*L3VPNPrefix) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *L3VPNPrefix) GetXKey() string {
+	if x != nil {
+		return x.XKey
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetXId() string {
+	if x != nil {
+		return x.XId
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetXRev() string {
+	if x != nil {
+		return x.XRev
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *L3VPNPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetOriginAs() int32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetClusterList() string {
+	if x != nil {
+		return x.ClusterList
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetLabels() []uint32 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *L3VPNPrefix) GetVpnRd() string {
+	if x != nil {
+		return x.VpnRd
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetVpnRdType() uint32 {
+	if x != nil {
+		return x.VpnRdType
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetPrefixSid() *PSid {
+	if x != nil {
+		return x.PrefixSid
+	}
+	return nil
+}
+
+func (x *L3VPNPrefix) GetSrv6Sids() []string {
+	if x != nil {
+		return x.Srv6Sids
+	}
+	return nil
+}
+
+func (x *L3VPNPrefix) GetSrv6ServiceSids() []*ServiceSID {
+	if x != nil {
+		return x.Srv6ServiceSids
+	}
+	return nil
+}
+
+func (x *L3VPNPrefix) GetIsLlgrStale() bool {
+	if x != nil {
+		return x.IsLlgrStale
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetBmpTlvs() []*RouteMonitorTLV {
+	if x != nil {
+		return x.BmpTlvs
+	}
+	return nil
+}
+
+type LANEndXSIDTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type             uint32        `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Length           uint32        `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	EndpointBehavior uint32        `protobuf:"varint,3,opt,name=endpoint_behavior,json=endpointBehavior,proto3" json:"endpoint_behavior,omitempty"`
+	Flags            *EndXSIDFlags `protobuf:"bytes,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	Algorithm        uint32        `protobuf:"varint,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Weight           uint32        `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	NeighborId       string        `protobuf:"bytes,7,opt,name=neighbor_id,json=neighborId,proto3" json:"neighbor_id,omitempty"`
+	Sid              string        `protobuf:"bytes,8,opt,name=sid,proto3" json:"sid,omitempty"`
+	SubTlvs          []string      `protobuf:"bytes,9,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty"`
+}
+
+func (x *LANEndXSIDTLV) Reset() {
+	*x = LANEndXSIDTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LANEndXSIDTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LANEndXSIDTLV) ProtoMessage() {}
+
+func (x *LANEndXSIDTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LANEndXSIDTLV.ProtoReflect.Descriptor instead.
+func (*LANEndXSIDTLV) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LANEndXSIDTLV) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *LANEndXSIDTLV) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *LANEndXSIDTLV) GetEndpointBehavior() uint32 {
+	if x != nil {
+		return x.EndpointBehavior
+	}
+	return 0
+}
+
+func (x *LANEndXSIDTLV) GetFlags() *EndXSIDFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *LANEndXSIDTLV) GetAlgorithm() uint32 {
+	if x != nil {
+		return x.Algorithm
+	}
+	return 0
+}
+
+func (x *LANEndXSIDTLV) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *LANEndXSIDTLV) GetNeighborId() string {
+	if x != nil {
+		return x.NeighborId
+	}
+	return ""
+}
+
+func (x *LANEndXSIDTLV) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *LANEndXSIDTLV) GetSubTlvs() []string {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+type LSLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XKey                  string                   `protobuf:"bytes,1,opt,name=_key,json=Key,proto3" json:"_key,omitempty"`
+	XId                   string                   `protobuf:"bytes,2,opt,name=_id,json=Id,proto3" json:"_id,omitempty"`
+	XRev                  string                   `protobuf:"bytes,3,opt,name=_rev,json=Rev,proto3" json:"_rev,omitempty"`
+	Action                string                   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                    `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                   `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                   `protobuf:"bytes,7,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                   `protobuf:"bytes,8,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	DomainId              int64                    `protobuf:"varint,9,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	PeerHash              string                   `protobuf:"bytes,10,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                   `protobuf:"bytes,11,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                   `protobuf:"varint,12,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd                string                   `protobuf:"bytes,13,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn               uint32                   `protobuf:"varint,14,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                   `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp    string                   `protobuf:"bytes,16,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	IgpRouterId           string                   `protobuf:"bytes,17,opt,name=igp_router_id,json=igpRouterId,proto3" json:"igp_router_id,omitempty"`
+	RouterId              string                   `protobuf:"bytes,18,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	LsId                  uint32                   `protobuf:"varint,19,opt,name=ls_id,json=lsId,proto3" json:"ls_id,omitempty"`
+	Protocol              string                   `protobuf:"bytes,20,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	ProtocolId            uint32                   `protobuf:"varint,21,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	AreaId                string                   `protobuf:"bytes,22,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	Nexthop               string                   `protobuf:"bytes,23,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	MtIdTlv               *MultiTopologyIdentifier `protobuf:"bytes,24,opt,name=mt_id_tlv,json=mtIdTlv,proto3" json:"mt_id_tlv,omitempty"`
+	LocalLinkId           uint32                   `protobuf:"varint,25,opt,name=local_link_id,json=localLinkId,proto3" json:"local_link_id,omitempty"`
+	RemoteLinkId          uint32                   `protobuf:"varint,26,opt,name=remote_link_id,json=remoteLinkId,proto3" json:"remote_link_id,omitempty"`
+	LocalLinkIp           string                   `protobuf:"bytes,27,opt,name=local_link_ip,json=localLinkIp,proto3" json:"local_link_ip,omitempty"`
+	RemoteLinkIp          string                   `protobuf:"bytes,28,opt,name=remote_link_ip,json=remoteLinkIp,proto3" json:"remote_link_ip,omitempty"`
+	IgpMetric             uint32                   `protobuf:"varint,29,opt,name=igp_metric,json=igpMetric,proto3" json:"igp_metric,omitempty"`
+	MetricType            string                   `protobuf:"bytes,30,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	AdminGroup            uint32                   `protobuf:"varint,31,opt,name=admin_group,json=adminGroup,proto3" json:"admin_group,omitempty"`
+	MaxLinkBw             uint32                   `protobuf:"varint,32,opt,name=max_link_bw,json=maxLinkBw,proto3" json:"max_link_bw,omitempty"`
+	MaxResvBw             uint32                   `protobuf:"varint,33,opt,name=max_resv_bw,json=maxResvBw,proto3" json:"max_resv_bw,omitempty"`
+	UnresvBw              []uint32                 `protobuf:"varint,34,rep,packed,name=unresv_bw,json=unresvBw,proto3" json:"unresv_bw,omitempty"`
+	MaxLinkBwKbps         uint64                   `protobuf:"varint,35,opt,name=max_link_bw_kbps,json=maxLinkBwKbps,proto3" json:"max_link_bw_kbps,omitempty"`
+	MaxResvBwKbps         uint64                   `protobuf:"varint,36,opt,name=max_resv_bw_kbps,json=maxResvBwKbps,proto3" json:"max_resv_bw_kbps,omitempty"`
+	UnresvBwKbps          []uint64                 `protobuf:"varint,37,rep,packed,name=unresv_bw_kbps,json=unresvBwKbps,proto3" json:"unresv_bw_kbps,omitempty"`
+	TeDefaultMetric       uint32                   `protobuf:"varint,38,opt,name=te_default_metric,json=teDefaultMetric,proto3" json:"te_default_metric,omitempty"`
+	LinkProtection        uint32                   `protobuf:"varint,39,opt,name=link_protection,json=linkProtection,proto3" json:"link_protection,omitempty"`
+	MplsProtoMask         uint32                   `protobuf:"varint,40,opt,name=mpls_proto_mask,json=mplsProtoMask,proto3" json:"mpls_proto_mask,omitempty"`
+	Srlg                  []uint32                 `protobuf:"varint,41,rep,packed,name=srlg,proto3" json:"srlg,omitempty"`
+	LinkName              string                   `protobuf:"bytes,42,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	RemoteNodeHash        string                   `protobuf:"bytes,43,opt,name=remote_node_hash,json=remoteNodeHash,proto3" json:"remote_node_hash,omitempty"`
+	LocalNodeHash         string                   `protobuf:"bytes,44,opt,name=local_node_hash,json=localNodeHash,proto3" json:"local_node_hash,omitempty"`
+	LocalNodeName         string                   `protobuf:"bytes,45,opt,name=local_node_name,json=localNodeName,proto3" json:"local_node_name,omitempty"`
+	RemoteNodeName        string                   `protobuf:"bytes,46,opt,name=remote_node_name,json=remoteNodeName,proto3" json:"remote_node_name,omitempty"`
+	RemoteIgpRouterId     string                   `protobuf:"bytes,47,opt,name=remote_igp_router_id,json=remoteIgpRouterId,proto3" json:"remote_igp_router_id,omitempty"`
+	RemoteRouterId        string                   `protobuf:"bytes,48,opt,name=remote_router_id,json=remoteRouterId,proto3" json:"remote_router_id,omitempty"`
+	LocalNodeAsn          uint32                   `protobuf:"varint,49,opt,name=local_node_asn,json=localNodeAsn,proto3" json:"local_node_asn,omitempty"`
+	RemoteNodeAsn         uint32                   `protobuf:"varint,50,opt,name=remote_node_asn,json=remoteNodeAsn,proto3" json:"remote_node_asn,omitempty"`
+	BgpRouterId           string                   `protobuf:"bytes,51,opt,name=bgp_router_id,json=bgpRouterId,proto3" json:"bgp_router_id,omitempty"`
+	BgpRemoteRouterId     string                   `protobuf:"bytes,52,opt,name=bgp_remote_router_id,json=bgpRemoteRouterId,proto3" json:"bgp_remote_router_id,omitempty"`
+	MemberAs              uint32                   `protobuf:"varint,53,opt,name=member_as,json=memberAs,proto3" json:"member_as,omitempty"`
+	PeerNodeSid           *PeerSID                 `protobuf:"bytes,54,opt,name=peer_node_sid,json=peerNodeSid,proto3" json:"peer_node_sid,omitempty"`
+	PeerAdjSid            *PeerSID                 `protobuf:"bytes,55,opt,name=peer_adj_sid,json=peerAdjSid,proto3" json:"peer_adj_sid,omitempty"`
+	PeerSetSid            *PeerSID                 `protobuf:"bytes,56,opt,name=peer_set_sid,json=peerSetSid,proto3" json:"peer_set_sid,omitempty"`
+	Srv6BgpPeerNodeSid    *BGPPeerNodeSID          `protobuf:"bytes,57,opt,name=srv6_bgp_peer_node_sid,json=srv6BgpPeerNodeSid,proto3" json:"srv6_bgp_peer_node_sid,omitempty"`
+	Srv6EndxSid           []*EndXSIDTLV            `protobuf:"bytes,58,rep,name=srv6_endx_sid,json=srv6EndxSid,proto3" json:"srv6_endx_sid,omitempty"`
+	Srv6LanEndxSid        []*LANEndXSIDTLV         `protobuf:"bytes,59,rep,name=srv6_lan_endx_sid,json=srv6LanEndxSid,proto3" json:"srv6_lan_endx_sid,omitempty"`
+	LsAdjacencySid        []string                 `protobuf:"bytes,60,rep,name=ls_adjacency_sid,json=lsAdjacencySid,proto3" json:"ls_adjacency_sid,omitempty"`
+	LinkMsd               []*MSDTV                 `protobuf:"bytes,61,rep,name=link_msd,json=linkMsd,proto3" json:"link_msd,omitempty"`
+	AppSpecLinkAttr       []*AppSpecLinkAttr       `protobuf:"bytes,62,rep,name=app_spec_link_attr,json=appSpecLinkAttr,proto3" json:"app_spec_link_attr,omitempty"`
+	UnidirLinkDelay       uint32                   `protobuf:"varint,63,opt,name=unidir_link_delay,json=unidirLinkDelay,proto3" json:"unidir_link_delay,omitempty"`
+	UnidirLinkDelayMinMax []uint32                 `protobuf:"varint,64,rep,packed,name=unidir_link_delay_min_max,json=unidirLinkDelayMinMax,proto3" json:"unidir_link_delay_min_max,omitempty"`
+	UnidirDelayVariation  uint32                   `protobuf:"varint,65,opt,name=unidir_delay_variation,json=unidirDelayVariation,proto3" json:"unidir_delay_variation,omitempty"`
+	UnidirPacketLoss      uint32                   `protobuf:"varint,66,opt,name=unidir_packet_loss,json=unidirPacketLoss,proto3" json:"unidir_packet_loss,omitempty"`
+	UnidirResidualBw      uint32                   `protobuf:"varint,67,opt,name=unidir_residual_bw,json=unidirResidualBw,proto3" json:"unidir_residual_bw,omitempty"`
+	UnidirAvailableBw     uint32                   `protobuf:"varint,68,opt,name=unidir_available_bw,json=unidirAvailableBw,proto3" json:"unidir_available_bw,omitempty"`
+	UnidirBwUtilization   uint32                   `protobuf:"varint,69,opt,name=unidir_bw_utilization,json=unidirBwUtilization,proto3" json:"unidir_bw_utilization,omitempty"`
+	IsAdjRibInPostPolicy  bool                     `protobuf:"varint,70,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                     `protobuf:"varint,71,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                     `protobuf:"varint,72,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy          bool                     `protobuf:"varint,73,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	IsLocRibFiltered      bool                     `protobuf:"varint,74,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	RibType               string                   `protobuf:"bytes,75,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	TableName             string                   `protobuf:"bytes,76,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	BmpTlvs               []*RouteMonitorTLV       `protobuf:"bytes,77,rep,name=bmp_tlvs,json=bmpTlvs,proto3" json:"bmp_tlvs,omitempty"`
+}
+
+func (x *LSLink) Reset() {
+	*x = LSLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LSLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LSLink) ProtoMessage() {}
+
+func (x *LSLink) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LSLink.ProtoReflect.Descriptor instead.
+func (*LSLink) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LSLink) GetXKey() string {
+	if x != nil {
+		return x.XKey
+	}
+	return ""
+}
+
+func (x *LSLink) GetXId() string {
+	if x != nil {
+		return x.XId
+	}
+	return ""
+}
+
+func (x *LSLink) GetXRev() string {
+	if x != nil {
+		return x.XRev
+	}
+	return ""
+}
+
+func (x *LSLink) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LSLink) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LSLink) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *LSLink) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *LSLink) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *LSLink) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *LSLink) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *LSLink) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *LSLink) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *LSLink) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *LSLink) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *LSLink) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *LSLink) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *LSLink) GetIgpRouterId() string {
+	if x != nil {
+		return x.IgpRouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetLsId() uint32 {
+	if x != nil {
+		return x.LsId
+	}
+	return 0
+}
+
+func (x *LSLink) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *LSLink) GetProtocolId() uint32 {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return 0
+}
+
+func (x *LSLink) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *LSLink) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *LSLink) GetMtIdTlv() *MultiTopologyIdentifier {
+	if x != nil {
+		return x.MtIdTlv
+	}
+	return nil
+}
+
+func (x *LSLink) GetLocalLinkId() uint32 {
+	if x != nil {
+		return x.LocalLinkId
+	}
+	return 0
+}
+
+func (x *LSLink) GetRemoteLinkId() uint32 {
+	if x != nil {
+		return x.RemoteLinkId
+	}
+	return 0
+}
+
+func (x *LSLink) GetLocalLinkIp() string {
+	if x != nil {
+		return x.LocalLinkIp
+	}
+	return ""
+}
+
+func (x *LSLink) GetRemoteLinkIp() string {
+	if x != nil {
+		return x.RemoteLinkIp
+	}
+	return ""
+}
+
+func (x *LSLink) GetIgpMetric() uint32 {
+	if x != nil {
+		return x.IgpMetric
+	}
+	return 0
+}
+
+func (x *LSLink) GetMetricType() string {
+	if x != nil {
+		return x.MetricType
+	}
+	return ""
+}
+
+func (x *LSLink) GetAdminGroup() uint32 {
+	if x != nil {
+		return x.AdminGroup
+	}
+	return 0
+}
+
+func (x *LSLink) GetMaxLinkBw() uint32 {
+	if x != nil {
+		return x.MaxLinkBw
+	}
+	return 0
+}
+
+func (x *LSLink) GetMaxResvBw() uint32 {
+	if x != nil {
+		return x.MaxResvBw
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnresvBw() []uint32 {
+	if x != nil {
+		return x.UnresvBw
+	}
+	return nil
+}
+
+func (x *LSLink) GetMaxLinkBwKbps() uint64 {
+	if x != nil {
+		return x.MaxLinkBwKbps
+	}
+	return 0
+}
+
+func (x *LSLink) GetMaxResvBwKbps() uint64 {
+	if x != nil {
+		return x.MaxResvBwKbps
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnresvBwKbps() []uint64 {
+	if x != nil {
+		return x.UnresvBwKbps
+	}
+	return nil
+}
+
+func (x *LSLink) GetTeDefaultMetric() uint32 {
+	if x != nil {
+		return x.TeDefaultMetric
+	}
+	return 0
+}
+
+func (x *LSLink) GetLinkProtection() uint32 {
+	if x != nil {
+		return x.LinkProtection
+	}
+	return 0
+}
+
+func (x *LSLink) GetMplsProtoMask() uint32 {
+	if x != nil {
+		return x.MplsProtoMask
+	}
+	return 0
+}
+
+func (x *LSLink) GetSrlg() []uint32 {
+	if x != nil {
+		return x.Srlg
+	}
+	return nil
+}
+
+func (x *LSLink) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *LSLink) GetRemoteNodeHash() string {
+	if x != nil {
+		return x.RemoteNodeHash
+	}
+	return ""
+}
+
+func (x *LSLink) GetLocalNodeHash() string {
+	if x != nil {
+		return x.LocalNodeHash
+	}
+	return ""
+}
+
+func (x *LSLink) GetLocalNodeName() string {
+	if x != nil {
+		return x.LocalNodeName
+	}
+	return ""
+}
+
+func (x *LSLink) GetRemoteNodeName() string {
+	if x != nil {
+		return x.RemoteNodeName
+	}
+	return ""
+}
+
+func (x *LSLink) GetRemoteIgpRouterId() string {
+	if x != nil {
+		return x.RemoteIgpRouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetRemoteRouterId() string {
+	if x != nil {
+		return x.RemoteRouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetLocalNodeAsn() uint32 {
+	if x != nil {
+		return x.LocalNodeAsn
+	}
+	return 0
+}
+
+func (x *LSLink) GetRemoteNodeAsn() uint32 {
+	if x != nil {
+		return x.RemoteNodeAsn
+	}
+	return 0
+}
+
+func (x *LSLink) GetBgpRouterId() string {
+	if x != nil {
+		return x.BgpRouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetBgpRemoteRouterId() string {
+	if x != nil {
+		return x.BgpRemoteRouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetMemberAs() uint32 {
+	if x != nil {
+		return x.MemberAs
+	}
+	return 0
+}
+
+func (x *LSLink) GetPeerNodeSid() *PeerSID {
+	if x != nil {
+		return x.PeerNodeSid
+	}
+	return nil
+}
+
+func (x *LSLink) GetPeerAdjSid() *PeerSID {
+	if x != nil {
+		return x.PeerAdjSid
+	}
+	return nil
+}
+
+func (x *LSLink) GetPeerSetSid() *PeerSID {
+	if x != nil {
+		return x.PeerSetSid
+	}
+	return nil
+}
+
+func (x *LSLink) GetSrv6BgpPeerNodeSid() *BGPPeerNodeSID {
+	if x != nil {
+		return x.Srv6BgpPeerNodeSid
+	}
+	return nil
+}
+
+func (x *LSLink) GetSrv6EndxSid() []*EndXSIDTLV {
+	if x != nil {
+		return x.Srv6EndxSid
+	}
+	return nil
+}
+
+func (x *LSLink) GetSrv6LanEndxSid() []*LANEndXSIDTLV {
+	if x != nil {
+		return x.Srv6LanEndxSid
+	}
+	return nil
+}
+
+func (x *LSLink) GetLsAdjacencySid() []string {
+	if x != nil {
+		return x.LsAdjacencySid
+	}
+	return nil
+}
+
+func (x *LSLink) GetLinkMsd() []*MSDTV {
+	if x != nil {
+		return x.LinkMsd
+	}
+	return nil
+}
+
+func (x *LSLink) GetAppSpecLinkAttr() []*AppSpecLinkAttr {
+	if x != nil {
+		return x.AppSpecLinkAttr
+	}
+	return nil
+}
+
+func (x *LSLink) GetUnidirLinkDelay() uint32 {
+	if x != nil {
+		return x.UnidirLinkDelay
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnidirLinkDelayMinMax() []uint32 {
+	if x != nil {
+		return x.UnidirLinkDelayMinMax
+	}
+	return nil
+}
+
+func (x *LSLink) GetUnidirDelayVariation() uint32 {
+	if x != nil {
+		return x.UnidirDelayVariation
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnidirPacketLoss() uint32 {
+	if x != nil {
+		return x.UnidirPacketLoss
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnidirResidualBw() uint32 {
+	if x != nil {
+		return x.UnidirResidualBw
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnidirAvailableBw() uint32 {
+	if x != nil {
+		return x.UnidirAvailableBw
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnidirBwUtilization() uint32 {
+	if x != nil {
+		return x.UnidirBwUtilization
+	}
+	return 0
+}
+
+func (x *LSLink) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *LSLink) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *LSLink) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *LSLink) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *LSLink) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *LSLink) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *LSLink) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *LSLink) GetBmpTlvs() []*RouteMonitorTLV {
+	if x != nil {
+		return x.BmpTlvs
+	}
+	return nil
+}
+
+type LSNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XKey                  string                     `protobuf:"bytes,1,opt,name=_key,json=Key,proto3" json:"_key,omitempty"`
+	XId                   string                     `protobuf:"bytes,2,opt,name=_id,json=Id,proto3" json:"_id,omitempty"`
+	XRev                  string                     `protobuf:"bytes,3,opt,name=_rev,json=Rev,proto3" json:"_rev,omitempty"`
+	Action                string                     `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                      `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                     `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                     `protobuf:"bytes,7,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	DomainId              int64                      `protobuf:"varint,8,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	RouterIp              string                     `protobuf:"bytes,9,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerHash              string                     `protobuf:"bytes,10,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                     `protobuf:"bytes,11,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                     `protobuf:"varint,12,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd                string                     `protobuf:"bytes,13,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn               uint32                     `protobuf:"varint,14,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                     `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp    string                     `protobuf:"bytes,16,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	IgpRouterId           string                     `protobuf:"bytes,17,opt,name=igp_router_id,json=igpRouterId,proto3" json:"igp_router_id,omitempty"`
+	RouterId              string                     `protobuf:"bytes,18,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	Asn                   uint32                     `protobuf:"varint,19,opt,name=asn,proto3" json:"asn,omitempty"`
+	LsId                  uint32                     `protobuf:"varint,20,opt,name=ls_id,json=lsId,proto3" json:"ls_id,omitempty"`
+	MtIdTlv               []*MultiTopologyIdentifier `protobuf:"bytes,21,rep,name=mt_id_tlv,json=mtIdTlv,proto3" json:"mt_id_tlv,omitempty"`
+	AreaId                string                     `protobuf:"bytes,22,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	Protocol              string                     `protobuf:"bytes,23,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	ProtocolId            uint32                     `protobuf:"varint,24,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	NodeFlags             *NodeAttrFlags             `protobuf:"bytes,25,opt,name=node_flags,json=nodeFlags,proto3" json:"node_flags,omitempty"`
+	Name                  string                     `protobuf:"bytes,26,opt,name=name,proto3" json:"name,omitempty"`
+	LsSrCapabilities      string                     `protobuf:"bytes,27,opt,name=ls_sr_capabilities,json=lsSrCapabilities,proto3" json:"ls_sr_capabilities,omitempty"`
+	SrAlgorithm           []int64                    `protobuf:"varint,28,rep,packed,name=sr_algorithm,json=srAlgorithm,proto3" json:"sr_algorithm,omitempty"`
+	SrLocalBlock          *LocalBlock                `protobuf:"bytes,29,opt,name=sr_local_block,json=srLocalBlock,proto3" json:"sr_local_block,omitempty"`
+	Srv6CapabilitiesTlv   *CapabilityTLV             `protobuf:"bytes,30,opt,name=srv6_capabilities_tlv,json=srv6CapabilitiesTlv,proto3" json:"srv6_capabilities_tlv,omitempty"`
+	NodeMsd               []*MSDTV                   `protobuf:"bytes,31,rep,name=node_msd,json=nodeMsd,proto3" json:"node_msd,omitempty"`
+	FlexAlgoDefinition    []*FlexAlgoDefinition      `protobuf:"bytes,32,rep,name=flex_algo_definition,json=flexAlgoDefinition,proto3" json:"flex_algo_definition,omitempty"`
+	IsAdjRibInPostPolicy  bool                       `protobuf:"varint,33,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                       `protobuf:"varint,34,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                       `protobuf:"varint,35,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy          bool                       `protobuf:"varint,36,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	IsLocRibFiltered      bool                       `protobuf:"varint,37,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	RibType               string                     `protobuf:"bytes,38,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	TableName             string                     `protobuf:"bytes,39,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	BmpTlvs               []*RouteMonitorTLV         `protobuf:"bytes,40,rep,name=bmp_tlvs,json=bmpTlvs,proto3" json:"bmp_tlvs,omitempty"`
+}
+
+func (x *LSNode) Reset() {
+	*x = LSNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LSNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LSNode) ProtoMessage() {}
+
+func (x *LSNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LSNode.ProtoReflect.Descriptor instead.
+func (*LSNode) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LSNode) GetXKey() string {
+	if x != nil {
+		return x.XKey
+	}
+	return ""
+}
+
+func (x *LSNode) GetXId() string {
+	if x != nil {
+		return x.XId
+	}
+	return ""
+}
+
+func (x *LSNode) GetXRev() string {
+	if x != nil {
+		return x.XRev
+	}
+	return ""
+}
+
+func (x *LSNode) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LSNode) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LSNode) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *LSNode) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *LSNode) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *LSNode) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *LSNode) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *LSNode) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *LSNode) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *LSNode) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *LSNode) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *LSNode) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *LSNode) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *LSNode) GetIgpRouterId() string {
+	if x != nil {
+		return x.IgpRouterId
+	}
+	return ""
+}
+
+func (x *LSNode) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *LSNode) GetAsn() uint32 {
+	if x != nil {
+		return x.Asn
+	}
+	return 0
+}
+
+func (x *LSNode) GetLsId() uint32 {
+	if x != nil {
+		return x.LsId
+	}
+	return 0
+}
+
+func (x *LSNode) GetMtIdTlv() []*MultiTopologyIdentifier {
+	if x != nil {
+		return x.MtIdTlv
+	}
+	return nil
+}
+
+func (x *LSNode) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *LSNode) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *LSNode) GetProtocolId() uint32 {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return 0
+}
+
+func (x *LSNode) GetNodeFlags() *NodeAttrFlags {
+	if x != nil {
+		return x.NodeFlags
+	}
+	return nil
+}
+
+func (x *LSNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LSNode) GetLsSrCapabilities() string {
+	if x != nil {
+		return x.LsSrCapabilities
+	}
+	return ""
+}
+
+func (x *LSNode) GetSrAlgorithm() []int64 {
+	if x != nil {
+		return x.SrAlgorithm
+	}
+	return nil
+}
+
+func (x *LSNode) GetSrLocalBlock() *LocalBlock {
+	if x != nil {
+		return x.SrLocalBlock
+	}
+	return nil
+}
+
+func (x *LSNode) GetSrv6CapabilitiesTlv() *CapabilityTLV {
+	if x != nil {
+		return x.Srv6CapabilitiesTlv
+	}
+	return nil
+}
+
+func (x *LSNode) GetNodeMsd() []*MSDTV {
+	if x != nil {
+		return x.NodeMsd
+	}
+	return nil
+}
+
+func (x *LSNode) GetFlexAlgoDefinition() []*FlexAlgoDefinition {
+	if x != nil {
+		return x.FlexAlgoDefinition
+	}
+	return nil
+}
+
+func (x *LSNode) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *LSNode) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *LSNode) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *LSNode) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *LSNode) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *LSNode) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *LSNode) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *LSNode) GetBmpTlvs() []*RouteMonitorTLV {
+	if x != nil {
+		return x.BmpTlvs
+	}
+	return nil
+}
+
+type LSPrefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XKey                  string                   `protobuf:"bytes,1,opt,name=_key,json=Key,proto3" json:"_key,omitempty"`
+	XId                   string                   `protobuf:"bytes,2,opt,name=_id,json=Id,proto3" json:"_id,omitempty"`
+	XRev                  string                   `protobuf:"bytes,3,opt,name=_rev,json=Rev,proto3" json:"_rev,omitempty"`
+	Action                string                   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                    `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                   `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                   `protobuf:"bytes,7,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                   `protobuf:"bytes,8,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	DomainId              int64                    `protobuf:"varint,9,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	PeerHash              string                   `protobuf:"bytes,10,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                   `protobuf:"bytes,11,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                   `protobuf:"varint,12,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd                string                   `protobuf:"bytes,13,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn               uint32                   `protobuf:"varint,14,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                   `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp    string                   `protobuf:"bytes,16,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	IgpRouterId           string                   `protobuf:"bytes,17,opt,name=igp_router_id,json=igpRouterId,proto3" json:"igp_router_id,omitempty"`
+	RouterId              string                   `protobuf:"bytes,18,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	LsId                  uint32                   `protobuf:"varint,19,opt,name=ls_id,json=lsId,proto3" json:"ls_id,omitempty"`
+	ProtocolId            uint32                   `protobuf:"varint,20,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	Protocol              string                   `protobuf:"bytes,21,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	AreaId                string                   `protobuf:"bytes,22,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	Nexthop               string                   `protobuf:"bytes,23,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	LocalNodeHash         string                   `protobuf:"bytes,24,opt,name=local_node_hash,json=localNodeHash,proto3" json:"local_node_hash,omitempty"`
+	LocalNodeName         string                   `protobuf:"bytes,25,opt,name=local_node_name,json=localNodeName,proto3" json:"local_node_name,omitempty"`
+	MtIdTlv               *MultiTopologyIdentifier `protobuf:"bytes,26,opt,name=mt_id_tlv,json=mtIdTlv,proto3" json:"mt_id_tlv,omitempty"`
+	OspfRouteType         uint32                   `protobuf:"varint,27,opt,name=ospf_route_type,json=ospfRouteType,proto3" json:"ospf_route_type,omitempty"`
+	IgpFlags              *IGPFlags                `protobuf:"bytes,28,opt,name=igp_flags,json=igpFlags,proto3" json:"igp_flags,omitempty"`
+	RouteTag              []uint32                 `protobuf:"varint,29,rep,packed,name=route_tag,json=routeTag,proto3" json:"route_tag,omitempty"`
+	ExtRouteTag           []uint64                 `protobuf:"varint,30,rep,packed,name=ext_route_tag,json=extRouteTag,proto3" json:"ext_route_tag,omitempty"`
+	OspfFwdAddr           string                   `protobuf:"bytes,31,opt,name=ospf_fwd_addr,json=ospfFwdAddr,proto3" json:"ospf_fwd_addr,omitempty"`
+	Prefix                string                   `protobuf:"bytes,32,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen             int32                    `protobuf:"varint,33,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	PrefixMetric          uint32                   `protobuf:"varint,34,opt,name=prefix_metric,json=prefixMetric,proto3" json:"prefix_metric,omitempty"`
+	MetricType            string                   `protobuf:"bytes,35,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	PrefixAttrTlvs        string                   `protobuf:"bytes,36,opt,name=prefix_attr_tlvs,json=prefixAttrTlvs,proto3" json:"prefix_attr_tlvs,omitempty"`
+	FlexAlgoPrefixMetric  []*FlexAlgoPrefixMetric  `protobuf:"bytes,37,rep,name=flex_algo_prefix_metric,json=flexAlgoPrefixMetric,proto3" json:"flex_algo_prefix_metric,omitempty"`
+	Srv6Locator           *LocatorTLV              `protobuf:"bytes,38,opt,name=srv6_locator,json=srv6Locator,proto3" json:"srv6_locator,omitempty"`
+	IsAdjRibInPostPolicy  bool                     `protobuf:"varint,39,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                     `protobuf:"varint,40,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                     `protobuf:"varint,41,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy          bool                     `protobuf:"varint,42,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	IsLocRibFiltered      bool                     `protobuf:"varint,43,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	RibType               string                   `protobuf:"bytes,44,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	TableName             string                   `protobuf:"bytes,45,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	BmpTlvs               []*RouteMonitorTLV       `protobuf:"bytes,46,rep,name=bmp_tlvs,json=bmpTlvs,proto3" json:"bmp_tlvs,omitempty"`
+}
+
+func (x *LSPrefix) Reset() {
+	*x = LSPrefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LSPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LSPrefix) ProtoMessage() {}
+
+func (x *LSPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LSPrefix.ProtoReflect.Descriptor instead.
+func (*LSPrefix) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LSPrefix) GetXKey() string {
+	if x != nil {
+		return x.XKey
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetXId() string {
+	if x != nil {
+		return x.XId
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetXRev() string {
+	if x != nil {
+		return x.XRev
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetIgpRouterId() string {
+	if x != nil {
+		return x.IgpRouterId
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetLsId() uint32 {
+	if x != nil {
+		return x.LsId
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetProtocolId() uint32 {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetLocalNodeHash() string {
+	if x != nil {
+		return x.LocalNodeHash
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetLocalNodeName() string {
+	if x != nil {
+		return x.LocalNodeName
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetMtIdTlv() *MultiTopologyIdentifier {
+	if x != nil {
+		return x.MtIdTlv
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetOspfRouteType() uint32 {
+	if x != nil {
+		return x.OspfRouteType
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetIgpFlags() *IGPFlags {
+	if x != nil {
+		return x.IgpFlags
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetRouteTag() []uint32 {
+	if x != nil {
+		return x.RouteTag
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetExtRouteTag() []uint64 {
+	if x != nil {
+		return x.ExtRouteTag
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetOspfFwdAddr() string {
+	if x != nil {
+		return x.OspfFwdAddr
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetPrefixMetric() uint32 {
+	if x != nil {
+		return x.PrefixMetric
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetMetricType() string {
+	if x != nil {
+		return x.MetricType
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetPrefixAttrTlvs() string {
+	if x != nil {
+		return x.PrefixAttrTlvs
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetFlexAlgoPrefixMetric() []*FlexAlgoPrefixMetric {
+	if x != nil {
+		return x.FlexAlgoPrefixMetric
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetSrv6Locator() *LocatorTLV {
+	if x != nil {
+		return x.Srv6Locator
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *LSPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *LSPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *LSPrefix) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *LSPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *LSPrefix) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetBmpTlvs() []*RouteMonitorTLV {
+	if x != nil {
+		return x.BmpTlvs
+	}
+	return nil
+}
+
+type LSSRv6SID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XKey                  string                   `protobuf:"bytes,1,opt,name=_key,json=Key,proto3" json:"_key,omitempty"`
+	XId                   string                   `protobuf:"bytes,2,opt,name=_id,json=Id,proto3" json:"_id,omitempty"`
+	XRev                  string                   `protobuf:"bytes,3,opt,name=_rev,json=Rev,proto3" json:"_rev,omitempty"`
+	Action                string                   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                    `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                   `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                   `protobuf:"bytes,7,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                   `protobuf:"bytes,8,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	DomainId              int64                    `protobuf:"varint,9,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	PeerHash              string                   `protobuf:"bytes,10,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                   `protobuf:"bytes,11,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                   `protobuf:"varint,12,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd                string                   `protobuf:"bytes,13,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn               uint32                   `protobuf:"varint,14,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                   `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp    string                   `protobuf:"bytes,16,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	IgpRouterId           string                   `protobuf:"bytes,17,opt,name=igp_router_id,json=igpRouterId,proto3" json:"igp_router_id,omitempty"`
+	LocalNodeAsn          uint32                   `protobuf:"varint,18,opt,name=local_node_asn,json=localNodeAsn,proto3" json:"local_node_asn,omitempty"`
+	RouterId              string                   `protobuf:"bytes,19,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	LsId                  uint32                   `protobuf:"varint,20,opt,name=ls_id,json=lsId,proto3" json:"ls_id,omitempty"`
+	AreaId                string                   `protobuf:"bytes,21,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	ProtocolId            uint32                   `protobuf:"varint,22,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	Protocol              string                   `protobuf:"bytes,23,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Nexthop               string                   `protobuf:"bytes,24,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	LocalNodeHash         string                   `protobuf:"bytes,25,opt,name=local_node_hash,json=localNodeHash,proto3" json:"local_node_hash,omitempty"`
+	LocalNodeName         string                   `protobuf:"bytes,26,opt,name=local_node_name,json=localNodeName,proto3" json:"local_node_name,omitempty"`
+	MtIdTlv               *MultiTopologyIdentifier `protobuf:"bytes,27,opt,name=mt_id_tlv,json=mtIdTlv,proto3" json:"mt_id_tlv,omitempty"`
+	IgpFlags              uint32                   `protobuf:"varint,28,opt,name=igp_flags,json=igpFlags,proto3" json:"igp_flags,omitempty"`
+	RouteTag              uint32                   `protobuf:"varint,29,opt,name=route_tag,json=routeTag,proto3" json:"route_tag,omitempty"`
+	ExtRouteTag           uint32                   `protobuf:"varint,30,opt,name=ext_route_tag,json=extRouteTag,proto3" json:"ext_route_tag,omitempty"`
+	OspfFwdAddr           string                   `protobuf:"bytes,31,opt,name=ospf_fwd_addr,json=ospfFwdAddr,proto3" json:"ospf_fwd_addr,omitempty"`
+	IgpMetric             uint32                   `protobuf:"varint,32,opt,name=igp_metric,json=igpMetric,proto3" json:"igp_metric,omitempty"`
+	Prefix                string                   `protobuf:"bytes,33,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen             int32                    `protobuf:"varint,34,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	Srv6Sid               string                   `protobuf:"bytes,35,opt,name=srv6_sid,json=srv6Sid,proto3" json:"srv6_sid,omitempty"`
+	Srv6EndpointBehavior  *EndpointBehavior        `protobuf:"bytes,36,opt,name=srv6_endpoint_behavior,json=srv6EndpointBehavior,proto3" json:"srv6_endpoint_behavior,omitempty"`
+	Srv6BgpPeerNodeSid    *BGPPeerNodeSID          `protobuf:"bytes,37,opt,name=srv6_bgp_peer_node_sid,json=srv6BgpPeerNodeSid,proto3" json:"srv6_bgp_peer_node_sid,omitempty"`
+	Srv6SidStructure      *SIDStructure            `protobuf:"bytes,38,opt,name=srv6_sid_structure,json=srv6SidStructure,proto3" json:"srv6_sid_structure,omitempty"`
+	IsAdjRibInPostPolicy  bool                     `protobuf:"varint,39,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                     `protobuf:"varint,40,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                     `protobuf:"varint,41,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy          bool                     `protobuf:"varint,42,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	IsLocRibFiltered      bool                     `protobuf:"varint,43,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	RibType               string                   `protobuf:"bytes,44,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	TableName             string                   `protobuf:"bytes,45,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	BmpTlvs               []*RouteMonitorTLV       `protobuf:"bytes,46,rep,name=bmp_tlvs,json=bmpTlvs,proto3" json:"bmp_tlvs,omitempty"`
+}
+
+func (x *LSSRv6SID) Reset() {
+	*x = LSSRv6SID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LSSRv6SID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LSSRv6SID) ProtoMessage() {}
+
+func (x *LSSRv6SID) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LSSRv6SID.ProtoReflect.Descriptor instead.
+func (*LSSRv6SID) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LSSRv6SID) GetXKey() string {
+	if x != nil {
+		return x.XKey
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetXId() string {
+	if x != nil {
+		return x.XId
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetXRev() string {
+	if x != nil {
+		return x.XRev
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetIgpRouterId() string {
+	if x != nil {
+		return x.IgpRouterId
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetLocalNodeAsn() uint32 {
+	if x != nil {
+		return x.LocalNodeAsn
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetLsId() uint32 {
+	if x != nil {
+		return x.LsId
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetProtocolId() uint32 {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetLocalNodeHash() string {
+	if x != nil {
+		return x.LocalNodeHash
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetLocalNodeName() string {
+	if x != nil {
+		return x.LocalNodeName
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetMtIdTlv() *MultiTopologyIdentifier {
+	if x != nil {
+		return x.MtIdTlv
+	}
+	return nil
+}
+
+func (x *LSSRv6SID) GetIgpFlags() uint32 {
+	if x != nil {
+		return x.IgpFlags
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetRouteTag() uint32 {
+	if x != nil {
+		return x.RouteTag
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetExtRouteTag() uint32 {
+	if x != nil {
+		return x.ExtRouteTag
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetOspfFwdAddr() string {
+	if x != nil {
+		return x.OspfFwdAddr
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetIgpMetric() uint32 {
+	if x != nil {
+		return x.IgpMetric
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetSrv6Sid() string {
+	if x != nil {
+		return x.Srv6Sid
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetSrv6EndpointBehavior() *EndpointBehavior {
+	if x != nil {
+		return x.Srv6EndpointBehavior
+	}
+	return nil
+}
+
+func (x *LSSRv6SID) GetSrv6BgpPeerNodeSid() *BGPPeerNodeSID {
+	if x != nil {
+		return x.Srv6BgpPeerNodeSid
+	}
+	return nil
+}
+
+func (x *LSSRv6SID) GetSrv6SidStructure() *SIDStructure {
+	if x != nil {
+		return x.Srv6SidStructure
+	}
+	return nil
+}
+
+func (x *LSSRv6SID) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *LSSRv6SID) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *LSSRv6SID) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *LSSRv6SID) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *LSSRv6SID) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *LSSRv6SID) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetBmpTlvs() []*RouteMonitorTLV {
+	if x != nil {
+		return x.BmpTlvs
+	}
+	return nil
+}
+
+type LabelIndexTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags     uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	LastIndex uint32 `protobuf:"varint,2,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
+}
+
+func (x *LabelIndexTLV) Reset() {
+	*x = LabelIndexTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelIndexTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelIndexTLV) ProtoMessage() {}
+
+func (x *LabelIndexTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelIndexTLV.ProtoReflect.Descriptor instead.
+func (*LabelIndexTLV) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LabelIndexTLV) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *LabelIndexTLV) GetLastIndex() uint32 {
+	if x != nil {
+		return x.LastIndex
+	}
+	return 0
+}
+
+type Link struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	As1     uint32   `protobuf:"varint,1,opt,name=as1,proto3" json:"as1,omitempty"`
+	As2     uint32   `protobuf:"varint,2,opt,name=as2,proto3" json:"as2,omitempty"`
+	Paths   int64    `protobuf:"varint,3,opt,name=paths,proto3" json:"paths,omitempty"`
+	Routers []string `protobuf:"bytes,4,rep,name=routers,proto3" json:"routers,omitempty"`
+}
+
+func (x *Link) Reset() {
+	*x = Link{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Link) ProtoMessage() {}
+
+func (x *Link) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Link.ProtoReflect.Descriptor instead.
+func (*Link) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Link) GetAs1() uint32 {
+	if x != nil {
+		return x.As1
+	}
+	return 0
+}
+
+func (x *Link) GetAs2() uint32 {
+	if x != nil {
+		return x.As2
+	}
+	return 0
+}
+
+func (x *Link) GetPaths() int64 {
+	if x != nil {
+		return x.Paths
+	}
+	return 0
+}
+
+func (x *Link) GetRouters() []string {
+	if x != nil {
+		return x.Routers
+	}
+	return nil
+}
+
+type LocalBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags     uint32           `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Subranges []*LocalBlockTLV `protobuf:"bytes,2,rep,name=subranges,proto3" json:"subranges,omitempty"`
+}
+
+func (x *LocalBlock) Reset() {
+	*x = LocalBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalBlock) ProtoMessage() {}
+
+func (x *LocalBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalBlock.ProtoReflect.Descriptor instead.
+func (*LocalBlock) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LocalBlock) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *LocalBlock) GetSubranges() []*LocalBlockTLV {
+	if x != nil {
+		return x.Subranges
+	}
+	return nil
+}
+
+type LocalBlockTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RangeSize uint32 `protobuf:"varint,1,opt,name=range_size,json=rangeSize,proto3" json:"range_size,omitempty"`
+	Label     uint32 `protobuf:"varint,2,opt,name=label,proto3" json:"label,omitempty"`
+	Index     uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *LocalBlockTLV) Reset() {
+	*x = LocalBlockTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalBlockTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalBlockTLV) ProtoMessage() {}
+
+func (x *LocalBlockTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalBlockTLV.ProtoReflect.Descriptor instead.
+func (*LocalBlockTLV) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LocalBlockTLV) GetRangeSize() uint32 {
+	if x != nil {
+		return x.RangeSize
+	}
+	return 0
+}
+
+func (x *LocalBlockTLV) GetLabel() uint32 {
+	if x != nil {
+		return x.Label
+	}
+	return 0
+}
+
+func (x *LocalBlockTLV) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type LocatorFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DFlag bool `protobuf:"varint,1,opt,name=d_flag,json=dFlag,proto3" json:"d_flag,omitempty"`
+}
+
+func (x *LocatorFlags) Reset() {
+	*x = LocatorFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocatorFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocatorFlags) ProtoMessage() {}
+
+func (x *LocatorFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocatorFlags.ProtoReflect.Descriptor instead.
+func (*LocatorFlags) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LocatorFlags) GetDFlag() bool {
+	if x != nil {
+		return x.DFlag
+	}
+	return false
+}
+
+type LocatorTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags   *LocatorFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Algo    uint32        `protobuf:"varint,2,opt,name=algo,proto3" json:"algo,omitempty"`
+	Metric  uint32        `protobuf:"varint,3,opt,name=metric,proto3" json:"metric,omitempty"`
+	SubTlvs []*SubTLV     `protobuf:"bytes,4,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty"`
+}
+
+func (x *LocatorTLV) Reset() {
+	*x = LocatorTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocatorTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocatorTLV) ProtoMessage() {}
+
+func (x *LocatorTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocatorTLV.ProtoReflect.Descriptor instead.
+func (*LocatorTLV) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LocatorTLV) GetFlags() *LocatorFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *LocatorTLV) GetAlgo() uint32 {
+	if x != nil {
+		return x.Algo
+	}
+	return 0
+}
+
+func (x *LocatorTLV) GetMetric() uint32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+func (x *LocatorTLV) GetSubTlvs() []*SubTLV {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+type MSDTV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsdType  uint32 `protobuf:"varint,1,opt,name=msd_type,json=msdType,proto3" json:"msd_type,omitempty"`
+	MsdValue uint32 `protobuf:"varint,2,opt,name=msd_value,json=msdValue,proto3" json:"msd_value,omitempty"`
+}
+
+func (x *MSDTV) Reset() {
+	*x = MSDTV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MSDTV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSDTV) ProtoMessage() {}
+
+func (x *MSDTV) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSDTV.ProtoReflect.Descriptor instead.
+func (*MSDTV) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MSDTV) GetMsdType() uint32 {
+	if x != nil {
+		return x.MsdType
+	}
+	return 0
+}
+
+func (x *MSDTV) GetMsdValue() uint32 {
+	if x != nil {
+		return x.MsdValue
+	}
+	return 0
+}
+
+type MVPN struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action                string             `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	RouterHash            string             `protobuf:"bytes,2,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string             `protobuf:"bytes,3,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes    `protobuf:"bytes,4,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string             `protobuf:"bytes,5,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string             `protobuf:"bytes,6,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32             `protobuf:"varint,7,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd                string             `protobuf:"bytes,8,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn               uint32             `protobuf:"varint,9,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string             `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp    string             `protobuf:"bytes,11,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	IsIpv4                bool               `protobuf:"varint,12,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              int32              `protobuf:"varint,13,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string             `protobuf:"bytes,14,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	IsNexthopIpv4         bool               `protobuf:"varint,15,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32              `protobuf:"varint,16,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	RouteType             uint32             `protobuf:"varint,17,opt,name=route_type,json=routeType,proto3" json:"route_type,omitempty"`
+	RouteTypeName         string             `protobuf:"bytes,18,opt,name=route_type_name,json=routeTypeName,proto3" json:"route_type_name,omitempty"`
+	VpnRd                 string             `protobuf:"bytes,19,opt,name=vpn_rd,json=vpnRd,proto3" json:"vpn_rd,omitempty"`
+	SourceAs              uint32             `protobuf:"varint,20,opt,name=source_as,json=sourceAs,proto3" json:"source_as,omitempty"`
+	McastSrc              string             `protobuf:"bytes,21,opt,name=mcast_src,json=mcastSrc,proto3" json:"mcast_src,omitempty"`
+	McastGrp              string             `protobuf:"bytes,22,opt,name=mcast_grp,json=mcastGrp,proto3" json:"mcast_grp,omitempty"`
+	OriginatorRouter      string             `protobuf:"bytes,23,opt,name=originator_router,json=originatorRouter,proto3" json:"originator_router,omitempty"`
+	RouteKey              *MVPNRouteKey      `protobuf:"bytes,24,opt,name=route_key,json=routeKey,proto3" json:"route_key,omitempty"`
+	PmsiTunnel            *PMSITunnel        `protobuf:"bytes,25,opt,name=pmsi_tunnel,json=pmsiTunnel,proto3" json:"pmsi_tunnel,omitempty"`
+	IsAdjRibInPostPolicy  bool               `protobuf:"varint,26,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool               `protobuf:"varint,27,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool               `protobuf:"varint,28,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy          bool               `protobuf:"varint,29,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	IsLocRibFiltered      bool               `protobuf:"varint,30,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	RibType               string             `protobuf:"bytes,31,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	TableName             string             `protobuf:"bytes,32,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	BmpTlvs               []*RouteMonitorTLV `protobuf:"bytes,33,rep,name=bmp_tlvs,json=bmpTlvs,proto3" json:"bmp_tlvs,omitempty"`
+}
+
+func (x *MVPN) Reset() {
+	*x = MVPN{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MVPN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MVPN) ProtoMessage() {}
+
+func (x *MVPN) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MVPN.ProtoReflect.Descriptor instead.
+func (*MVPN) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MVPN) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *MVPN) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *MVPN) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *MVPN) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *MVPN) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *MVPN) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *MVPN) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *MVPN) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *MVPN) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *MVPN) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *MVPN) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *MVPN) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *MVPN) GetOriginAs() int32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *MVPN) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *MVPN) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *MVPN) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *MVPN) GetRouteType() uint32 {
+	if x != nil {
+		return x.RouteType
+	}
+	return 0
+}
+
+func (x *MVPN) GetRouteTypeName() string {
+	if x != nil {
+		return x.RouteTypeName
+	}
+	return ""
+}
+
+func (x *MVPN) GetVpnRd() string {
+	if x != nil {
+		return x.VpnRd
+	}
+	return ""
+}
+
+func (x *MVPN) GetSourceAs() uint32 {
+	if x != nil {
+		return x.SourceAs
+	}
+	return 0
+}
+
+func (x *MVPN) GetMcastSrc() string {
+	if x != nil {
+		return x.McastSrc
+	}
+	return ""
+}
+
+func (x *MVPN) GetMcastGrp() string {
+	if x != nil {
+		return x.McastGrp
+	}
+	return ""
+}
+
+func (x *MVPN) GetOriginatorRouter() string {
+	if x != nil {
+		return x.OriginatorRouter
+	}
+	return ""
+}
+
+func (x *MVPN) GetRouteKey() *MVPNRouteKey {
+	if x != nil {
+		return x.RouteKey
+	}
+	return nil
+}
+
+func (x *MVPN) GetPmsiTunnel() *PMSITunnel {
+	if x != nil {
+		return x.PmsiTunnel
+	}
+	return nil
+}
+
+func (x *MVPN) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *MVPN) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *MVPN) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *MVPN) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *MVPN) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *MVPN) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *MVPN) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *MVPN) GetBmpTlvs() []*RouteMonitorTLV {
+	if x != nil {
+		return x.BmpTlvs
+	}
+	return nil
+}
+
+type MVPNRouteKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteType        uint32 `protobuf:"varint,1,opt,name=route_type,json=routeType,proto3" json:"route_type,omitempty"`
+	RouteTypeName    string `protobuf:"bytes,2,opt,name=route_type_name,json=routeTypeName,proto3" json:"route_type_name,omitempty"`
+	VpnRd            string `protobuf:"bytes,3,opt,name=vpn_rd,json=vpnRd,proto3" json:"vpn_rd,omitempty"`
+	McastSrc         string `protobuf:"bytes,4,opt,name=mcast_src,json=mcastSrc,proto3" json:"mcast_src,omitempty"`
+	McastGrp         string `protobuf:"bytes,5,opt,name=mcast_grp,json=mcastGrp,proto3" json:"mcast_grp,omitempty"`
+	OriginatorRouter string `protobuf:"bytes,6,opt,name=originator_router,json=originatorRouter,proto3" json:"originator_router,omitempty"`
+}
+
+func (x *MVPNRouteKey) Reset() {
+	*x = MVPNRouteKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MVPNRouteKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MVPNRouteKey) ProtoMessage() {}
+
+func (x *MVPNRouteKey) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MVPNRouteKey.ProtoReflect.Descriptor instead.
+func (*MVPNRouteKey) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MVPNRouteKey) GetRouteType() uint32 {
+	if x != nil {
+		return x.RouteType
+	}
+	return 0
+}
+
+func (x *MVPNRouteKey) GetRouteTypeName() string {
+	if x != nil {
+		return x.RouteTypeName
+	}
+	return ""
+}
+
+func (x *MVPNRouteKey) GetVpnRd() string {
+	if x != nil {
+		return x.VpnRd
+	}
+	return ""
+}
+
+func (x *MVPNRouteKey) GetMcastSrc() string {
+	if x != nil {
+		return x.McastSrc
+	}
+	return ""
+}
+
+func (x *MVPNRouteKey) GetMcastGrp() string {
+	if x != nil {
+		return x.McastGrp
+	}
+	return ""
+}
+
+func (x *MVPNRouteKey) GetOriginatorRouter() string {
+	if x != nil {
+		return x.OriginatorRouter
+	}
+	return ""
+}
+
+type MirroredBGPMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BgpType         string          `protobuf:"bytes,1,opt,name=bgp_type,json=bgpType,proto3" json:"bgp_type,omitempty"`
+	Length          int64           `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	Version         uint32          `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	MyAsn           uint32          `protobuf:"varint,4,opt,name=my_asn,json=myAsn,proto3" json:"my_asn,omitempty"`
+	HoldTime        int64           `protobuf:"varint,5,opt,name=hold_time,json=holdTime,proto3" json:"hold_time,omitempty"`
+	BgpId           string          `protobuf:"bytes,6,opt,name=bgp_id,json=bgpId,proto3" json:"bgp_id,omitempty"`
+	Capabilities    string          `protobuf:"bytes,7,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	BaseAttrs       *BaseAttributes `protobuf:"bytes,8,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	Nlri            []string        `protobuf:"bytes,9,rep,name=nlri,proto3" json:"nlri,omitempty"`
+	WithdrawnRoutes []string        `protobuf:"bytes,10,rep,name=withdrawn_routes,json=withdrawnRoutes,proto3" json:"withdrawn_routes,omitempty"`
+	MpAfiSafi       []string        `protobuf:"bytes,11,rep,name=mp_afi_safi,json=mpAfiSafi,proto3" json:"mp_afi_safi,omitempty"`
+	ErrorCode       uint32          `protobuf:"varint,12,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorSubCode    uint32          `protobuf:"varint,13,opt,name=error_sub_code,json=errorSubCode,proto3" json:"error_sub_code,omitempty"`
+	Error           string          `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	Data            []byte          `protobuf:"bytes,15,opt,name=data,proto3" json:"data,omitempty"`
+	Afi             uint32          `protobuf:"varint,16,opt,name=afi,proto3" json:"afi,omitempty"`
+	Safi            uint32          `protobuf:"varint,17,opt,name=safi,proto3" json:"safi,omitempty"`
+	Subtype         string          `protobuf:"bytes,18,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	Orfs            []*ORF          `protobuf:"bytes,19,rep,name=orfs,proto3" json:"orfs,omitempty"`
+	Pdu             []byte          `protobuf:"bytes,20,opt,name=pdu,proto3" json:"pdu,omitempty"`
+	DecodeError     string          `protobuf:"bytes,21,opt,name=decode_error,json=decodeError,proto3" json:"decode_error,omitempty"`
+}
+
+func (x *MirroredBGPMessage) Reset() {
+	*x = MirroredBGPMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MirroredBGPMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MirroredBGPMessage) ProtoMessage() {}
+
+func (x *MirroredBGPMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MirroredBGPMessage.ProtoReflect.Descriptor instead.
+func (*MirroredBGPMessage) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MirroredBGPMessage) GetBgpType() string {
+	if x != nil {
+		return x.BgpType
+	}
+	return ""
+}
+
+func (x *MirroredBGPMessage) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *MirroredBGPMessage) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MirroredBGPMessage) GetMyAsn() uint32 {
+	if x != nil {
+		return x.MyAsn
+	}
+	return 0
+}
+
+func (x *MirroredBGPMessage) GetHoldTime() int64 {
+	if x != nil {
+		return x.HoldTime
+	}
+	return 0
+}
+
+func (x *MirroredBGPMessage) GetBgpId() string {
+	if x != nil {
+		return x.BgpId
+	}
+	return ""
+}
+
+func (x *MirroredBGPMessage) GetCapabilities() string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return ""
+}
+
+func (x *MirroredBGPMessage) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *MirroredBGPMessage) GetNlri() []string {
+	if x != nil {
+		return x.Nlri
+	}
+	return nil
+}
+
+func (x *MirroredBGPMessage) GetWithdrawnRoutes() []string {
+	if x != nil {
+		return x.WithdrawnRoutes
+	}
+	return nil
+}
+
+func (x *MirroredBGPMessage) GetMpAfiSafi() []string {
+	if x != nil {
+		return x.MpAfiSafi
+	}
+	return nil
+}
+
+func (x *MirroredBGPMessage) GetErrorCode() uint32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *MirroredBGPMessage) GetErrorSubCode() uint32 {
+	if x != nil {
+		return x.ErrorSubCode
+	}
+	return 0
+}
+
+func (x *MirroredBGPMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MirroredBGPMessage) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MirroredBGPMessage) GetAfi() uint32 {
+	if x != nil {
+		return x.Afi
+	}
+	return 0
+}
+
+func (x *MirroredBGPMessage) GetSafi() uint32 {
+	if x != nil {
+		return x.Safi
+	}
+	return 0
+}
+
+func (x *MirroredBGPMessage) GetSubtype() string {
+	if x != nil {
+		return x.Subtype
+	}
+	return ""
+}
+
+func (x *MirroredBGPMessage) GetOrfs() []*ORF {
+	if x != nil {
+		return x.Orfs
+	}
+	return nil
+}
+
+func (x *MirroredBGPMessage) GetPdu() []byte {
+	if x != nil {
+		return x.Pdu
+	}
+	return nil
+}
+
+func (x *MirroredBGPMessage) GetDecodeError() string {
+	if x != nil {
+		return x.DecodeError
+	}
+	return ""
+}
+
+type MirroredMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouterHash         string          `protobuf:"bytes,1,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp           string          `protobuf:"bytes,2,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerHash           string          `protobuf:"bytes,3,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp             string          `protobuf:"bytes,4,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType           uint32          `protobuf:"varint,5,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd             string          `protobuf:"bytes,6,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn            uint32          `protobuf:"varint,7,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp          string          `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp string          `protobuf:"bytes,9,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	BgpType            string          `protobuf:"bytes,10,opt,name=bgp_type,json=bgpType,proto3" json:"bgp_type,omitempty"`
+	Length             int64           `protobuf:"varint,11,opt,name=length,proto3" json:"length,omitempty"`
+	Version            uint32          `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	MyAsn              uint32          `protobuf:"varint,13,opt,name=my_asn,json=myAsn,proto3" json:"my_asn,omitempty"`
+	HoldTime           int64           `protobuf:"varint,14,opt,name=hold_time,json=holdTime,proto3" json:"hold_time,omitempty"`
+	BgpId              string          `protobuf:"bytes,15,opt,name=bgp_id,json=bgpId,proto3" json:"bgp_id,omitempty"`
+	Capabilities       string          `protobuf:"bytes,16,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	BaseAttrs          *BaseAttributes `protobuf:"bytes,17,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	Nlri               []string        `protobuf:"bytes,18,rep,name=nlri,proto3" json:"nlri,omitempty"`
+	WithdrawnRoutes    []string        `protobuf:"bytes,19,rep,name=withdrawn_routes,json=withdrawnRoutes,proto3" json:"withdrawn_routes,omitempty"`
+	MpAfiSafi          []string        `protobuf:"bytes,20,rep,name=mp_afi_safi,json=mpAfiSafi,proto3" json:"mp_afi_safi,omitempty"`
+	ErrorCode          uint32          `protobuf:"varint,21,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorSubCode       uint32          `protobuf:"varint,22,opt,name=error_sub_code,json=errorSubCode,proto3" json:"error_sub_code,omitempty"`
+	Error              string          `protobuf:"bytes,23,opt,name=error,proto3" json:"error,omitempty"`
+	Data               []byte          `protobuf:"bytes,24,opt,name=data,proto3" json:"data,omitempty"`
+	Afi                uint32          `protobuf:"varint,25,opt,name=afi,proto3" json:"afi,omitempty"`
+	Safi               uint32          `protobuf:"varint,26,opt,name=safi,proto3" json:"safi,omitempty"`
+	Subtype            string          `protobuf:"bytes,27,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	Orfs               []*ORF          `protobuf:"bytes,28,rep,name=orfs,proto3" json:"orfs,omitempty"`
+	Pdu                []byte          `protobuf:"bytes,29,opt,name=pdu,proto3" json:"pdu,omitempty"`
+	DecodeError        string          `protobuf:"bytes,30,opt,name=decode_error,json=decodeError,proto3" json:"decode_error,omitempty"`
+	IsAdjRibOut        bool            `protobuf:"varint,31,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy       bool            `protobuf:"varint,32,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	RibType            string          `protobuf:"bytes,33,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+}
+
+func (x *MirroredMessage) Reset() {
+	*x = MirroredMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MirroredMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MirroredMessage) ProtoMessage() {}
+
+func (x *MirroredMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MirroredMessage.ProtoReflect.Descriptor instead.
+func (*MirroredMessage) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MirroredMessage) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *MirroredMessage) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *MirroredMessage) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetBgpType() string {
+	if x != nil {
+		return x.BgpType
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *MirroredMessage) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MirroredMessage) GetMyAsn() uint32 {
+	if x != nil {
+		return x.MyAsn
+	}
+	return 0
+}
+
+func (x *MirroredMessage) GetHoldTime() int64 {
+	if x != nil {
+		return x.HoldTime
+	}
+	return 0
+}
+
+func (x *MirroredMessage) GetBgpId() string {
+	if x != nil {
+		return x.BgpId
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetCapabilities() string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *MirroredMessage) GetNlri() []string {
+	if x != nil {
+		return x.Nlri
+	}
+	return nil
+}
+
+func (x *MirroredMessage) GetWithdrawnRoutes() []string {
+	if x != nil {
+		return x.WithdrawnRoutes
+	}
+	return nil
+}
+
+func (x *MirroredMessage) GetMpAfiSafi() []string {
+	if x != nil {
+		return x.MpAfiSafi
+	}
+	return nil
+}
+
+func (x *MirroredMessage) GetErrorCode() uint32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *MirroredMessage) GetErrorSubCode() uint32 {
+	if x != nil {
+		return x.ErrorSubCode
+	}
+	return 0
+}
+
+func (x *MirroredMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MirroredMessage) GetAfi() uint32 {
+	if x != nil {
+		return x.Afi
+	}
+	return 0
+}
+
+func (x *MirroredMessage) GetSafi() uint32 {
+	if x != nil {
+		return x.Safi
+	}
+	return 0
+}
+
+func (x *MirroredMessage) GetSubtype() string {
+	if x != nil {
+		return x.Subtype
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetOrfs() []*ORF {
+	if x != nil {
+		return x.Orfs
+	}
+	return nil
+}
+
+func (x *MirroredMessage) GetPdu() []byte {
+	if x != nil {
+		return x.Pdu
+	}
+	return nil
+}
+
+func (x *MirroredMessage) GetDecodeError() string {
+	if x != nil {
+		return x.DecodeError
+	}
+	return ""
+}
+
+func (x *MirroredMessage) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *MirroredMessage) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *MirroredMessage) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+type MultiTopologyIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OFlag bool   `protobuf:"varint,1,opt,name=o_flag,json=oFlag,proto3" json:"o_flag,omitempty"`
+	AFlag bool   `protobuf:"varint,2,opt,name=a_flag,json=aFlag,proto3" json:"a_flag,omitempty"`
+	MtId  uint32 `protobuf:"varint,3,opt,name=mt_id,json=mtId,proto3" json:"mt_id,omitempty"`
+}
+
+func (x *MultiTopologyIdentifier) Reset() {
+	*x = MultiTopologyIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiTopologyIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiTopologyIdentifier) ProtoMessage() {}
+
+func (x *MultiTopologyIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiTopologyIdentifier.ProtoReflect.Descriptor instead.
+func (*MultiTopologyIdentifier) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MultiTopologyIdentifier) GetOFlag() bool {
+	if x != nil {
+		return x.OFlag
+	}
+	return false
+}
+
+func (x *MultiTopologyIdentifier) GetAFlag() bool {
+	if x != nil {
+		return x.AFlag
+	}
+	return false
+}
+
+func (x *MultiTopologyIdentifier) GetMtId() uint32 {
+	if x != nil {
+		return x.MtId
+	}
+	return 0
+}
+
+type NodeAttrFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OFlag bool `protobuf:"varint,1,opt,name=o_flag,json=oFlag,proto3" json:"o_flag,omitempty"`
+	TFlag bool `protobuf:"varint,2,opt,name=t_flag,json=tFlag,proto3" json:"t_flag,omitempty"`
+	EFlag bool `protobuf:"varint,3,opt,name=e_flag,json=eFlag,proto3" json:"e_flag,omitempty"`
+	BFlag bool `protobuf:"varint,4,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	RFlag bool `protobuf:"varint,5,opt,name=r_flag,json=rFlag,proto3" json:"r_flag,omitempty"`
+	VFlag bool `protobuf:"varint,6,opt,name=v_flag,json=vFlag,proto3" json:"v_flag,omitempty"`
+}
+
+func (x *NodeAttrFlags) Reset() {
+	*x = NodeAttrFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeAttrFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAttrFlags) ProtoMessage() {}
+
+func (x *NodeAttrFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAttrFlags.ProtoReflect.Descriptor instead.
+func (*NodeAttrFlags) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *NodeAttrFlags) GetOFlag() bool {
+	if x != nil {
+		return x.OFlag
+	}
+	return false
+}
+
+func (x *NodeAttrFlags) GetTFlag() bool {
+	if x != nil {
+		return x.TFlag
+	}
+	return false
+}
+
+func (x *NodeAttrFlags) GetEFlag() bool {
+	if x != nil {
+		return x.EFlag
+	}
+	return false
+}
+
+func (x *NodeAttrFlags) GetBFlag() bool {
+	if x != nil {
+		return x.BFlag
+	}
+	return false
+}
+
+func (x *NodeAttrFlags) GetRFlag() bool {
+	if x != nil {
+		return x.RFlag
+	}
+	return false
+}
+
+func (x *NodeAttrFlags) GetVFlag() bool {
+	if x != nil {
+		return x.VFlag
+	}
+	return false
+}
+
+type ORF struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WhenToRefresh string      `protobuf:"bytes,1,opt,name=when_to_refresh,json=whenToRefresh,proto3" json:"when_to_refresh,omitempty"`
+	OrfType       uint32      `protobuf:"varint,2,opt,name=orf_type,json=orfType,proto3" json:"orf_type,omitempty"`
+	Entries       []*ORFEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ORF) Reset() {
+	*x = ORF{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ORF) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ORF) ProtoMessage() {}
+
+func (x *ORF) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ORF.ProtoReflect.Descriptor instead.
+func (*ORF) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ORF) GetWhenToRefresh() string {
+	if x != nil {
+		return x.WhenToRefresh
+	}
+	return ""
+}
+
+func (x *ORF) GetOrfType() uint32 {
+	if x != nil {
+		return x.OrfType
+	}
+	return 0
+}
+
+func (x *ORF) GetEntries() []*ORFEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ORFEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action    string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Match     string `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"`
+	Sequence  uint32 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	MinLen    uint32 `protobuf:"varint,4,opt,name=min_len,json=minLen,proto3" json:"min_len,omitempty"`
+	MaxLen    uint32 `protobuf:"varint,5,opt,name=max_len,json=maxLen,proto3" json:"max_len,omitempty"`
+	Prefix    string `protobuf:"bytes,6,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen uint32 `protobuf:"varint,7,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	Value     []byte `protobuf:"bytes,8,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ORFEntry) Reset() {
+	*x = ORFEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ORFEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ORFEntry) ProtoMessage() {}
+
+func (x *ORFEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ORFEntry.ProtoReflect.Descriptor instead.
+func (*ORFEntry) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ORFEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ORFEntry) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *ORFEntry) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ORFEntry) GetMinLen() uint32 {
+	if x != nil {
+		return x.MinLen
+	}
+	return 0
+}
+
+func (x *ORFEntry) GetMaxLen() uint32 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
+func (x *ORFEntry) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ORFEntry) GetPrefixLen() uint32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *ORFEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type OriginatorSRGBTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags uint32  `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Srgb  []*SRGB `protobuf:"bytes,2,rep,name=srgb,proto3" json:"srgb,omitempty"`
+}
+
+func (x *OriginatorSRGBTLV) Reset() {
+	*x = OriginatorSRGBTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OriginatorSRGBTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginatorSRGBTLV) ProtoMessage() {}
+
+func (x *OriginatorSRGBTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginatorSRGBTLV.ProtoReflect.Descriptor instead.
+func (*OriginatorSRGBTLV) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *OriginatorSRGBTLV) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *OriginatorSRGBTLV) GetSrgb() []*SRGB {
+	if x != nil {
+		return x.Srgb
+	}
+	return nil
+}
+
+type PMSITunnel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags            uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	LeafInfoRequired bool   `protobuf:"varint,2,opt,name=leaf_info_required,json=leafInfoRequired,proto3" json:"leaf_info_required,omitempty"`
+	TunnelType       uint32 `protobuf:"varint,3,opt,name=tunnel_type,json=tunnelType,proto3" json:"tunnel_type,omitempty"`
+	TunnelTypeName   string `protobuf:"bytes,4,opt,name=tunnel_type_name,json=tunnelTypeName,proto3" json:"tunnel_type_name,omitempty"`
+	Label            uint32 `protobuf:"varint,5,opt,name=label,proto3" json:"label,omitempty"`
+	Vni              uint32 `protobuf:"varint,6,opt,name=vni,proto3" json:"vni,omitempty"`
+	TunnelId         string `protobuf:"bytes,7,opt,name=tunnel_id,json=tunnelId,proto3" json:"tunnel_id,omitempty"`
+	TunnelEndpoint   string `protobuf:"bytes,8,opt,name=tunnel_endpoint,json=tunnelEndpoint,proto3" json:"tunnel_endpoint,omitempty"`
+	Sender           string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
+	PGroup           string `protobuf:"bytes,10,opt,name=p_group,json=pGroup,proto3" json:"p_group,omitempty"`
+	RootNode         string `protobuf:"bytes,11,opt,name=root_node,json=rootNode,proto3" json:"root_node,omitempty"`
+	Opaque           string `protobuf:"bytes,12,opt,name=opaque,proto3" json:"opaque,omitempty"`
+	ExtendedTunnelId string `protobuf:"bytes,13,opt,name=extended_tunnel_id,json=extendedTunnelId,proto3" json:"extended_tunnel_id,omitempty"`
+	RsvpTunnelId     uint32 `protobuf:"varint,14,opt,name=rsvp_tunnel_id,json=rsvpTunnelId,proto3" json:"rsvp_tunnel_id,omitempty"`
+	P2MpId           uint32 `protobuf:"varint,15,opt,name=p2mp_id,json=p2mpId,proto3" json:"p2mp_id,omitempty"`
+	SourcePe         string `protobuf:"bytes,16,opt,name=source_pe,json=sourcePe,proto3" json:"source_pe,omitempty"`
+	LocalNumber      string `protobuf:"bytes,17,opt,name=local_number,json=localNumber,proto3" json:"local_number,omitempty"`
+	SubDomainId      uint32 `protobuf:"varint,18,opt,name=sub_domain_id,json=subDomainId,proto3" json:"sub_domain_id,omitempty"`
+	BfrId            uint32 `protobuf:"varint,19,opt,name=bfr_id,json=bfrId,proto3" json:"bfr_id,omitempty"`
+	BfrPrefix        string `protobuf:"bytes,20,opt,name=bfr_prefix,json=bfrPrefix,proto3" json:"bfr_prefix,omitempty"`
+}
+
+func (x *PMSITunnel) Reset() {
+	*x = PMSITunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PMSITunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PMSITunnel) ProtoMessage() {}
+
+func (x *PMSITunnel) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PMSITunnel.ProtoReflect.Descriptor instead.
+func (*PMSITunnel) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PMSITunnel) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *PMSITunnel) GetLeafInfoRequired() bool {
+	if x != nil {
+		return x.LeafInfoRequired
+	}
+	return false
+}
+
+func (x *PMSITunnel) GetTunnelType() uint32 {
+	if x != nil {
+		return x.TunnelType
+	}
+	return 0
+}
+
+func (x *PMSITunnel) GetTunnelTypeName() string {
+	if x != nil {
+		return x.TunnelTypeName
+	}
+	return ""
+}
+
+func (x *PMSITunnel) GetLabel() uint32 {
+	if x != nil {
+		return x.Label
+	}
+	return 0
+}
+
+func (x *PMSITunnel) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+func (x *PMSITunnel) GetTunnelId() string {
+	if x != nil {
+		return x.TunnelId
+	}
+	return ""
+}
+
+func (x *PMSITunnel) GetTunnelEndpoint() string {
+	if x != nil {
+		return x.TunnelEndpoint
+	}
+	return ""
+}
+
+func (x *PMSITunnel) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *PMSITunnel) GetPGroup() string {
+	if x != nil {
+		return x.PGroup
+	}
+	return ""
+}
+
+func (x *PMSITunnel) GetRootNode() string {
+	if x != nil {
+		return x.RootNode
+	}
+	return ""
+}
+
+func (x *PMSITunnel) GetOpaque() string {
+	if x != nil {
+		return x.Opaque
+	}
+	return ""
+}
+
+func (x *PMSITunnel) GetExtendedTunnelId() string {
+	if x != nil {
+		return x.ExtendedTunnelId
+	}
+	return ""
+}
+
+func (x *PMSITunnel) GetRsvpTunnelId() uint32 {
+	if x != nil {
+		return x.RsvpTunnelId
+	}
+	return 0
+}
+
+func (x *PMSITunnel) GetP2MpId() uint32 {
+	if x != nil {
+		return x.P2MpId
+	}
+	return 0
+}
+
+func (x *PMSITunnel) GetSourcePe() string {
+	if x != nil {
+		return x.SourcePe
+	}
+	return ""
+}
+
+func (x *PMSITunnel) GetLocalNumber() string {
+	if x != nil {
+		return x.LocalNumber
+	}
+	return ""
+}
+
+func (x *PMSITunnel) GetSubDomainId() uint32 {
+	if x != nil {
+		return x.SubDomainId
+	}
+	return 0
+}
+
+func (x *PMSITunnel) GetBfrId() uint32 {
+	if x != nil {
+		return x.BfrId
+	}
+	return 0
+}
+
+func (x *PMSITunnel) GetBfrPrefix() string {
+	if x != nil {
+		return x.BfrPrefix
+	}
+	return ""
+}
+
+type PSid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabelIndex     *LabelIndexTLV     `protobuf:"bytes,1,opt,name=label_index,json=labelIndex,proto3" json:"label_index,omitempty"`
+	OriginatorSrgb *OriginatorSRGBTLV `protobuf:"bytes,2,opt,name=originator_srgb,json=originatorSrgb,proto3" json:"originator_srgb,omitempty"`
+	Srv6L3Service  *L3Service         `protobuf:"bytes,3,opt,name=srv6_l3_service,json=srv6L3Service,proto3" json:"srv6_l3_service,omitempty"`
+	Srv6L2Service  *L2Service         `protobuf:"bytes,4,opt,name=srv6_l2_service,json=srv6L2Service,proto3" json:"srv6_l2_service,omitempty"`
+}
+
+func (x *PSid) Reset() {
+	*x = PSid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PSid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PSid) ProtoMessage() {}
+
+func (x *PSid) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PSid.ProtoReflect.Descriptor instead.
+func (*PSid) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PSid) GetLabelIndex() *LabelIndexTLV {
+	if x != nil {
+		return x.LabelIndex
+	}
+	return nil
+}
+
+func (x *PSid) GetOriginatorSrgb() *OriginatorSRGBTLV {
+	if x != nil {
+		return x.OriginatorSrgb
+	}
+	return nil
+}
+
+func (x *PSid) GetSrv6L3Service() *L3Service {
+	if x != nil {
+		return x.Srv6L3Service
+	}
+	return nil
+}
+
+func (x *PSid) GetSrv6L2Service() *L2Service {
+	if x != nil {
+		return x.Srv6L2Service
+	}
+	return nil
+}
+
+type Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerIp    string `protobuf:"bytes,1,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerAsn   uint32 `protobuf:"varint,2,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	PeerRd    string `protobuf:"bytes,3,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	BmpReason int64  `protobuf:"varint,4,opt,name=bmp_reason,json=bmpReason,proto3" json:"bmp_reason,omitempty"`
+	Timestamp string `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Peer) Reset() {
+	*x = Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Peer) ProtoMessage() {}
+
+func (x *Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
+func (*Peer) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Peer) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *Peer) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *Peer) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *Peer) GetBmpReason() int64 {
+	if x != nil {
+		return x.BmpReason
+	}
+	return 0
+}
+
+func (x *Peer) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type PeerAvailability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouterIp     string  `protobuf:"bytes,1,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerIp       string  `protobuf:"bytes,2,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerRd       string  `protobuf:"bytes,3,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn      uint32  `protobuf:"varint,4,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	State        string  `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	UpSeconds    int64   `protobuf:"varint,6,opt,name=up_seconds,json=upSeconds,proto3" json:"up_seconds,omitempty"`
+	Availability float64 `protobuf:"fixed64,7,opt,name=availability,proto3" json:"availability,omitempty"`
+	Flaps        int64   `protobuf:"varint,8,opt,name=flaps,proto3" json:"flaps,omitempty"`
+}
+
+func (x *PeerAvailability) Reset() {
+	*x = PeerAvailability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerAvailability) ProtoMessage() {}
+
+func (x *PeerAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerAvailability.ProtoReflect.Descriptor instead.
+func (*PeerAvailability) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PeerAvailability) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *PeerAvailability) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *PeerAvailability) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *PeerAvailability) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *PeerAvailability) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PeerAvailability) GetUpSeconds() int64 {
+	if x != nil {
+		return x.UpSeconds
+	}
+	return 0
+}
+
+func (x *PeerAvailability) GetAvailability() float64 {
+	if x != nil {
+		return x.Availability
+	}
+	return 0
+}
+
+func (x *PeerAvailability) GetFlaps() int64 {
+	if x != nil {
+		return x.Flaps
+	}
+	return 0
+}
+
+type PeerFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VFlag bool `protobuf:"varint,1,opt,name=v_flag,json=vFlag,proto3" json:"v_flag,omitempty"`
+	LFlag bool `protobuf:"varint,2,opt,name=l_flag,json=lFlag,proto3" json:"l_flag,omitempty"`
+	BFlag bool `protobuf:"varint,3,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	PFlag bool `protobuf:"varint,4,opt,name=p_flag,json=pFlag,proto3" json:"p_flag,omitempty"`
+}
+
+func (x *PeerFlags) Reset() {
+	*x = PeerFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerFlags) ProtoMessage() {}
+
+func (x *PeerFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerFlags.ProtoReflect.Descriptor instead.
+func (*PeerFlags) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PeerFlags) GetVFlag() bool {
+	if x != nil {
+		return x.VFlag
+	}
+	return false
+}
+
+func (x *PeerFlags) GetLFlag() bool {
+	if x != nil {
+		return x.LFlag
+	}
+	return false
+}
+
+func (x *PeerFlags) GetBFlag() bool {
+	if x != nil {
+		return x.BFlag
+	}
+	return false
+}
+
+func (x *PeerFlags) GetPFlag() bool {
+	if x != nil {
+		return x.PFlag
+	}
+	return false
+}
+
+type PeerSID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags  *PeerFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Weight uint32     `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Sid    uint32     `protobuf:"varint,3,opt,name=sid,proto3" json:"sid,omitempty"`
+}
+
+func (x *PeerSID) Reset() {
+	*x = PeerSID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerSID) ProtoMessage() {}
+
+func (x *PeerSID) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerSID.ProtoReflect.Descriptor instead.
+func (*PeerSID) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PeerSID) GetFlags() *PeerFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *PeerSID) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PeerSID) GetSid() uint32 {
+	if x != nil {
+		return x.Sid
+	}
+	return 0
+}
+
+type PeerStateChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XKey                  string            `protobuf:"bytes,1,opt,name=_key,json=Key,proto3" json:"_key,omitempty"`
+	XId                   string            `protobuf:"bytes,2,opt,name=_id,json=Id,proto3" json:"_id,omitempty"`
+	XRev                  string            `protobuf:"bytes,3,opt,name=_rev,json=Rev,proto3" json:"_rev,omitempty"`
+	Action                string            `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64             `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string            `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string            `protobuf:"bytes,7,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	Name                  string            `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	RemoteBgpId           string            `protobuf:"bytes,9,opt,name=remote_bgp_id,json=remoteBgpId,proto3" json:"remote_bgp_id,omitempty"`
+	RouterIp              string            `protobuf:"bytes,10,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	Timestamp             string            `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp    string            `protobuf:"bytes,12,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	RemoteAsn             uint32            `protobuf:"varint,13,opt,name=remote_asn,json=remoteAsn,proto3" json:"remote_asn,omitempty"`
+	RemoteIp              string            `protobuf:"bytes,14,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	PeerType              uint32            `protobuf:"varint,15,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd                string            `protobuf:"bytes,16,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	RemotePort            int64             `protobuf:"varint,17,opt,name=remote_port,json=remotePort,proto3" json:"remote_port,omitempty"`
+	LocalAsn              uint32            `protobuf:"varint,18,opt,name=local_asn,json=localAsn,proto3" json:"local_asn,omitempty"`
+	LocalIp               string            `protobuf:"bytes,19,opt,name=local_ip,json=localIp,proto3" json:"local_ip,omitempty"`
+	LocalPort             int64             `protobuf:"varint,20,opt,name=local_port,json=localPort,proto3" json:"local_port,omitempty"`
+	LocalBgpId            string            `protobuf:"bytes,21,opt,name=local_bgp_id,json=localBgpId,proto3" json:"local_bgp_id,omitempty"`
+	InfoData              []byte            `protobuf:"bytes,22,opt,name=info_data,json=infoData,proto3" json:"info_data,omitempty"`
+	AdvCap                string            `protobuf:"bytes,23,opt,name=adv_cap,json=advCap,proto3" json:"adv_cap,omitempty"`
+	RecvCap               string            `protobuf:"bytes,24,opt,name=recv_cap,json=recvCap,proto3" json:"recv_cap,omitempty"`
+	CapMismatch           []string          `protobuf:"bytes,25,rep,name=cap_mismatch,json=capMismatch,proto3" json:"cap_mismatch,omitempty"`
+	RemoteHolddown        int64             `protobuf:"varint,26,opt,name=remote_holddown,json=remoteHolddown,proto3" json:"remote_holddown,omitempty"`
+	AdvHolddown           int64             `protobuf:"varint,27,opt,name=adv_holddown,json=advHolddown,proto3" json:"adv_holddown,omitempty"`
+	BmpReason             int64             `protobuf:"varint,28,opt,name=bmp_reason,json=bmpReason,proto3" json:"bmp_reason,omitempty"`
+	BmpErrorCode          int64             `protobuf:"varint,29,opt,name=bmp_error_code,json=bmpErrorCode,proto3" json:"bmp_error_code,omitempty"`
+	BmpErrorSubCode       int64             `protobuf:"varint,30,opt,name=bmp_error_sub_code,json=bmpErrorSubCode,proto3" json:"bmp_error_sub_code,omitempty"`
+	ErrorText             string            `protobuf:"bytes,31,opt,name=error_text,json=errorText,proto3" json:"error_text,omitempty"`
+	IsL                   bool              `protobuf:"varint,32,opt,name=is_l,json=isL,proto3" json:"is_l,omitempty"`
+	IsPrepolicy           bool              `protobuf:"varint,33,opt,name=is_prepolicy,json=isPrepolicy,proto3" json:"is_prepolicy,omitempty"`
+	IsIpv4                bool              `protobuf:"varint,34,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	TableName             string            `protobuf:"bytes,35,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	IsAdjRibInPostPolicy  bool              `protobuf:"varint,36,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool              `protobuf:"varint,37,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool              `protobuf:"varint,38,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy          bool              `protobuf:"varint,39,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	IsLocRibFiltered      bool              `protobuf:"varint,40,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	RibType               string            `protobuf:"bytes,41,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	AddPath               map[string]string `protobuf:"bytes,42,rep,name=add_path,json=addPath,proto3" json:"add_path,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExtendedMessage       bool              `protobuf:"varint,43,opt,name=extended_message,json=extendedMessage,proto3" json:"extended_message,omitempty"`
+	LocalRole             string            `protobuf:"bytes,44,opt,name=local_role,json=localRole,proto3" json:"local_role,omitempty"`
+	RemoteRole            string            `protobuf:"bytes,45,opt,name=remote_role,json=remoteRole,proto3" json:"remote_role,omitempty"`
+	RoleMismatch          bool              `protobuf:"varint,46,opt,name=role_mismatch,json=roleMismatch,proto3" json:"role_mismatch,omitempty"`
+}
+
+func (x *PeerStateChange) Reset() {
+	*x = PeerStateChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerStateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerStateChange) ProtoMessage() {}
+
+func (x *PeerStateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerStateChange.ProtoReflect.Descriptor instead.
+func (*PeerStateChange) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PeerStateChange) GetXKey() string {
+	if x != nil {
+		return x.XKey
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetXId() string {
+	if x != nil {
+		return x.XId
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetXRev() string {
+	if x != nil {
+		return x.XRev
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRemoteBgpId() string {
+	if x != nil {
+		return x.RemoteBgpId
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRemoteAsn() uint32 {
+	if x != nil {
+		return x.RemoteAsn
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRemotePort() int64 {
+	if x != nil {
+		return x.RemotePort
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetLocalAsn() uint32 {
+	if x != nil {
+		return x.LocalAsn
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetLocalIp() string {
+	if x != nil {
+		return x.LocalIp
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetLocalPort() int64 {
+	if x != nil {
+		return x.LocalPort
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetLocalBgpId() string {
+	if x != nil {
+		return x.LocalBgpId
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetInfoData() []byte {
+	if x != nil {
+		return x.InfoData
+	}
+	return nil
+}
+
+func (x *PeerStateChange) GetAdvCap() string {
+	if x != nil {
+		return x.AdvCap
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRecvCap() string {
+	if x != nil {
+		return x.RecvCap
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetCapMismatch() []string {
+	if x != nil {
+		return x.CapMismatch
+	}
+	return nil
+}
+
+func (x *PeerStateChange) GetRemoteHolddown() int64 {
+	if x != nil {
+		return x.RemoteHolddown
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetAdvHolddown() int64 {
+	if x != nil {
+		return x.AdvHolddown
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetBmpReason() int64 {
+	if x != nil {
+		return x.BmpReason
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetBmpErrorCode() int64 {
+	if x != nil {
+		return x.BmpErrorCode
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetBmpErrorSubCode() int64 {
+	if x != nil {
+		return x.BmpErrorSubCode
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetErrorText() string {
+	if x != nil {
+		return x.ErrorText
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetIsL() bool {
+	if x != nil {
+		return x.IsL
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsPrepolicy() bool {
+	if x != nil {
+		return x.IsPrepolicy
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetAddPath() map[string]string {
+	if x != nil {
+		return x.AddPath
+	}
+	return nil
+}
+
+func (x *PeerStateChange) GetExtendedMessage() bool {
+	if x != nil {
+		return x.ExtendedMessage
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetLocalRole() string {
+	if x != nil {
+		return x.LocalRole
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRemoteRole() string {
+	if x != nil {
+		return x.RemoteRole
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRoleMismatch() bool {
+	if x != nil {
+		return x.RoleMismatch
+	}
+	return false
+}
+
+type PeerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouterIp         string `protobuf:"bytes,1,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerIp           string `protobuf:"bytes,2,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerRd           string `protobuf:"bytes,3,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn          uint32 `protobuf:"varint,4,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Announcements    uint64 `protobuf:"varint,5,opt,name=announcements,proto3" json:"announcements,omitempty"`
+	Withdrawals      uint64 `protobuf:"varint,6,opt,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	Flaps            uint64 `protobuf:"varint,7,opt,name=flaps,proto3" json:"flaps,omitempty"`
+	FlappingPrefixes int64  `protobuf:"varint,8,opt,name=flapping_prefixes,json=flappingPrefixes,proto3" json:"flapping_prefixes,omitempty"`
+	PeerDowns        uint64 `protobuf:"varint,9,opt,name=peer_downs,json=peerDowns,proto3" json:"peer_downs,omitempty"`
+}
+
+func (x *PeerStats) Reset() {
+	*x = PeerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerStats) ProtoMessage() {}
+
+func (x *PeerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerStats.ProtoReflect.Descriptor instead.
+func (*PeerStats) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PeerStats) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *PeerStats) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *PeerStats) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *PeerStats) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *PeerStats) GetAnnouncements() uint64 {
+	if x != nil {
+		return x.Announcements
+	}
+	return 0
+}
+
+func (x *PeerStats) GetWithdrawals() uint64 {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return 0
+}
+
+func (x *PeerStats) GetFlaps() uint64 {
+	if x != nil {
+		return x.Flaps
+	}
+	return 0
+}
+
+func (x *PeerStats) GetFlappingPrefixes() int64 {
+	if x != nil {
+		return x.FlappingPrefixes
+	}
+	return 0
+}
+
+func (x *PeerStats) GetPeerDowns() uint64 {
+	if x != nil {
+		return x.PeerDowns
+	}
+	return 0
+}
+
+type Preference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags      uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Preference uint32 `protobuf:"varint,2,opt,name=preference,proto3" json:"preference,omitempty"`
+}
+
+func (x *Preference) Reset() {
+	*x = Preference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Preference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preference) ProtoMessage() {}
+
+func (x *Preference) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preference.ProtoReflect.Descriptor instead.
+func (*Preference) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Preference) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *Preference) GetPreference() uint32 {
+	if x != nil {
+		return x.Preference
+	}
+	return 0
+}
+
+type PrefixChurn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	VpnRd         string `protobuf:"bytes,2,opt,name=vpn_rd,json=vpnRd,proto3" json:"vpn_rd,omitempty"`
+	Announcements uint64 `protobuf:"varint,3,opt,name=announcements,proto3" json:"announcements,omitempty"`
+	Withdrawals   uint64 `protobuf:"varint,4,opt,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+}
+
+func (x *PrefixChurn) Reset() {
+	*x = PrefixChurn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefixChurn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixChurn) ProtoMessage() {}
+
+func (x *PrefixChurn) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixChurn.ProtoReflect.Descriptor instead.
+func (*PrefixChurn) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PrefixChurn) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *PrefixChurn) GetVpnRd() string {
+	if x != nil {
+		return x.VpnRd
+	}
+	return ""
+}
+
+func (x *PrefixChurn) GetAnnouncements() uint64 {
+	if x != nil {
+		return x.Announcements
+	}
+	return 0
+}
+
+func (x *PrefixChurn) GetWithdrawals() uint64 {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return 0
+}
+
+type PrefixStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouterIp      string `protobuf:"bytes,1,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerIp        string `protobuf:"bytes,2,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerRd        string `protobuf:"bytes,3,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	Prefix        string `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	VpnRd         string `protobuf:"bytes,5,opt,name=vpn_rd,json=vpnRd,proto3" json:"vpn_rd,omitempty"`
+	PathId        int32  `protobuf:"varint,6,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Announcements uint64 `protobuf:"varint,7,opt,name=announcements,proto3" json:"announcements,omitempty"`
+	Withdrawals   uint64 `protobuf:"varint,8,opt,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	Flaps         uint64 `protobuf:"varint,9,opt,name=flaps,proto3" json:"flaps,omitempty"`
+}
+
+func (x *PrefixStats) Reset() {
+	*x = PrefixStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefixStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixStats) ProtoMessage() {}
+
+func (x *PrefixStats) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixStats.ProtoReflect.Descriptor instead.
+func (*PrefixStats) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PrefixStats) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *PrefixStats) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *PrefixStats) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *PrefixStats) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *PrefixStats) GetVpnRd() string {
+	if x != nil {
+		return x.VpnRd
+	}
+	return ""
+}
+
+func (x *PrefixStats) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *PrefixStats) GetAnnouncements() uint64 {
+	if x != nil {
+		return x.Announcements
+	}
+	return 0
+}
+
+func (x *PrefixStats) GetWithdrawals() uint64 {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return 0
+}
+
+func (x *PrefixStats) GetFlaps() uint64 {
+	if x != nil {
+		return x.Flaps
+	}
+	return 0
+}
+
+type RawUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouterHash         string `protobuf:"bytes,1,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp           string `protobuf:"bytes,2,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerHash           string `protobuf:"bytes,3,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp             string `protobuf:"bytes,4,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType           uint32 `protobuf:"varint,5,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd             string `protobuf:"bytes,6,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn            uint32 `protobuf:"varint,7,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp          string `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp string `protobuf:"bytes,9,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	RibType            string `protobuf:"bytes,10,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	TableName          string `protobuf:"bytes,11,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Pdu                []byte `protobuf:"bytes,12,opt,name=pdu,proto3" json:"pdu,omitempty"`
+}
+
+func (x *RawUpdate) Reset() {
+	*x = RawUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawUpdate) ProtoMessage() {}
+
+func (x *RawUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawUpdate.ProtoReflect.Descriptor instead.
+func (*RawUpdate) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RawUpdate) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *RawUpdate) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *RawUpdate) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *RawUpdate) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *RawUpdate) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *RawUpdate) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *RawUpdate) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *RawUpdate) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *RawUpdate) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *RawUpdate) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *RawUpdate) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *RawUpdate) GetPdu() []byte {
+	if x != nil {
+		return x.Pdu
+	}
+	return nil
+}
+
+type Record struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action     string       `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	RouterIp   string       `protobuf:"bytes,2,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerIp     string       `protobuf:"bytes,3,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerAsn    uint32       `protobuf:"varint,4,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Prefix     string       `protobuf:"bytes,5,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen  int32        `protobuf:"varint,6,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	PathId     int32        `protobuf:"varint,7,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Nexthop    string       `protobuf:"bytes,8,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	IsIpv4     bool         `protobuf:"varint,9,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	EgressSids []*EgressSID `protobuf:"bytes,10,rep,name=egress_sids,json=egressSids,proto3" json:"egress_sids,omitempty"`
+	Timestamp  string       `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Record) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Record) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *Record) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *Record) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *Record) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Record) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *Record) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *Record) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *Record) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *Record) GetEgressSids() []*EgressSID {
+	if x != nil {
+		return x.EgressSids
+	}
+	return nil
+}
+
+func (x *Record) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type Report struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start            string              `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End              string              `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Partial          bool                `protobuf:"varint,5,opt,name=partial,proto3" json:"partial,omitempty"`
+	Peers            []*PeerAvailability `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	TopChurnPrefixes []*PrefixChurn      `protobuf:"bytes,4,rep,name=top_churn_prefixes,json=topChurnPrefixes,proto3" json:"top_churn_prefixes,omitempty"`
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Report) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Report) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *Report) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+func (x *Report) GetPeers() []*PeerAvailability {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *Report) GetTopChurnPrefixes() []*PrefixChurn {
+	if x != nil {
+		return x.TopChurnPrefixes
+	}
+	return nil
+}
+
+type RouteMirror struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouterHash         string                `protobuf:"bytes,1,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp           string                `protobuf:"bytes,2,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerHash           string                `protobuf:"bytes,3,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp             string                `protobuf:"bytes,4,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType           uint32                `protobuf:"varint,5,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd             string                `protobuf:"bytes,6,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn            uint32                `protobuf:"varint,7,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp          string                `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp string                `protobuf:"bytes,9,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	Information        []string              `protobuf:"bytes,10,rep,name=information,proto3" json:"information,omitempty"`
+	Messages           []*MirroredBGPMessage `protobuf:"bytes,11,rep,name=messages,proto3" json:"messages,omitempty"`
+	IsAdjRibOut        bool                  `protobuf:"varint,12,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy       bool                  `protobuf:"varint,13,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	RibType            string                `protobuf:"bytes,14,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+}
+
+func (x *RouteMirror) Reset() {
+	*x = RouteMirror{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteMirror) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteMirror) ProtoMessage() {}
+
+func (x *RouteMirror) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteMirror.ProtoReflect.Descriptor instead.
+func (*RouteMirror) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RouteMirror) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *RouteMirror) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *RouteMirror) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *RouteMirror) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *RouteMirror) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *RouteMirror) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *RouteMirror) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *RouteMirror) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *RouteMirror) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *RouteMirror) GetInformation() []string {
+	if x != nil {
+		return x.Information
+	}
+	return nil
+}
+
+func (x *RouteMirror) GetMessages() []*MirroredBGPMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *RouteMirror) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *RouteMirror) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *RouteMirror) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+type RouteMonitorTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Pen   uint32 `protobuf:"varint,2,opt,name=pen,proto3" json:"pen,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RouteMonitorTLV) Reset() {
+	*x = RouteMonitorTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteMonitorTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteMonitorTLV) ProtoMessage() {}
+
+func (x *RouteMonitorTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteMonitorTLV.ProtoReflect.Descriptor instead.
+func (*RouteMonitorTLV) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RouteMonitorTLV) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *RouteMonitorTLV) GetPen() uint32 {
+	if x != nil {
+		return x.Pen
+	}
+	return 0
+}
+
+func (x *RouteMonitorTLV) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type RouteRefresh struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouterHash         string `protobuf:"bytes,1,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp           string `protobuf:"bytes,2,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerHash           string `protobuf:"bytes,3,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp             string `protobuf:"bytes,4,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType           uint32 `protobuf:"varint,5,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd             string `protobuf:"bytes,6,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn            uint32 `protobuf:"varint,7,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp          string `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp string `protobuf:"bytes,9,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	Afi                uint32 `protobuf:"varint,10,opt,name=afi,proto3" json:"afi,omitempty"`
+	Safi               uint32 `protobuf:"varint,11,opt,name=safi,proto3" json:"safi,omitempty"`
+	Subtype            string `protobuf:"bytes,12,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	Orfs               []*ORF `protobuf:"bytes,13,rep,name=orfs,proto3" json:"orfs,omitempty"`
+	Source             string `protobuf:"bytes,14,opt,name=source,proto3" json:"source,omitempty"`
+	IsAdjRibOut        bool   `protobuf:"varint,15,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy       bool   `protobuf:"varint,16,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	RibType            string `protobuf:"bytes,17,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+}
+
+func (x *RouteRefresh) Reset() {
+	*x = RouteRefresh{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteRefresh) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteRefresh) ProtoMessage() {}
+
+func (x *RouteRefresh) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteRefresh.ProtoReflect.Descriptor instead.
+func (*RouteRefresh) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RouteRefresh) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *RouteRefresh) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *RouteRefresh) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *RouteRefresh) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *RouteRefresh) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *RouteRefresh) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *RouteRefresh) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *RouteRefresh) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *RouteRefresh) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *RouteRefresh) GetAfi() uint32 {
+	if x != nil {
+		return x.Afi
+	}
+	return 0
+}
+
+func (x *RouteRefresh) GetSafi() uint32 {
+	if x != nil {
+		return x.Safi
+	}
+	return 0
+}
+
+func (x *RouteRefresh) GetSubtype() string {
+	if x != nil {
+		return x.Subtype
+	}
+	return ""
+}
+
+func (x *RouteRefresh) GetOrfs() []*ORF {
+	if x != nil {
+		return x.Orfs
+	}
+	return nil
+}
+
+func (x *RouteRefresh) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RouteRefresh) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *RouteRefresh) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *RouteRefresh) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+type RouteStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start           string         `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End             string         `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Peers           []*PeerStats   `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	TopFlapPrefixes []*PrefixStats `protobuf:"bytes,4,rep,name=top_flap_prefixes,json=topFlapPrefixes,proto3" json:"top_flap_prefixes,omitempty"`
+}
+
+func (x *RouteStats) Reset() {
+	*x = RouteStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteStats) ProtoMessage() {}
+
+func (x *RouteStats) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteStats.ProtoReflect.Descriptor instead.
+func (*RouteStats) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RouteStats) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *RouteStats) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *RouteStats) GetPeers() []*PeerStats {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *RouteStats) GetTopFlapPrefixes() []*PrefixStats {
+	if x != nil {
+		return x.TopFlapPrefixes
+	}
+	return nil
+}
+
+type SIDStructure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type               uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Length             uint32 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	LocatorBlockLength uint32 `protobuf:"varint,3,opt,name=locator_block_length,json=locatorBlockLength,proto3" json:"locator_block_length,omitempty"`
+	LocatorNodeLength  uint32 `protobuf:"varint,4,opt,name=locator_node_length,json=locatorNodeLength,proto3" json:"locator_node_length,omitempty"`
+	FunctionLength     uint32 `protobuf:"varint,5,opt,name=function_length,json=functionLength,proto3" json:"function_length,omitempty"`
+	ArgumentLength     uint32 `protobuf:"varint,6,opt,name=argument_length,json=argumentLength,proto3" json:"argument_length,omitempty"`
+}
+
+func (x *SIDStructure) Reset() {
+	*x = SIDStructure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SIDStructure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIDStructure) ProtoMessage() {}
+
+func (x *SIDStructure) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIDStructure.ProtoReflect.Descriptor instead.
+func (*SIDStructure) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SIDStructure) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SIDStructure) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *SIDStructure) GetLocatorBlockLength() uint32 {
+	if x != nil {
+		return x.LocatorBlockLength
+	}
+	return 0
+}
+
+func (x *SIDStructure) GetLocatorNodeLength() uint32 {
+	if x != nil {
+		return x.LocatorNodeLength
+	}
+	return 0
+}
+
+func (x *SIDStructure) GetFunctionLength() uint32 {
+	if x != nil {
+		return x.FunctionLength
+	}
+	return 0
+}
+
+func (x *SIDStructure) GetArgumentLength() uint32 {
+	if x != nil {
+		return x.ArgumentLength
+	}
+	return 0
+}
+
+type SRGB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	First  uint32 `protobuf:"varint,1,opt,name=first,proto3" json:"first,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *SRGB) Reset() {
+	*x = SRGB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRGB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRGB) ProtoMessage() {}
+
+func (x *SRGB) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRGB.ProtoReflect.Descriptor instead.
+func (*SRGB) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SRGB) GetFirst() uint32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *SRGB) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+type SRPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XKey                  string             `protobuf:"bytes,1,opt,name=_key,json=Key,proto3" json:"_key,omitempty"`
+	XId                   string             `protobuf:"bytes,2,opt,name=_id,json=Id,proto3" json:"_id,omitempty"`
+	XRev                  string             `protobuf:"bytes,3,opt,name=_rev,json=Rev,proto3" json:"_rev,omitempty"`
+	Action                string             `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64              `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string             `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string             `protobuf:"bytes,7,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string             `protobuf:"bytes,8,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes    `protobuf:"bytes,9,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string             `protobuf:"bytes,10,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string             `protobuf:"bytes,11,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32             `protobuf:"varint,12,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd                string             `protobuf:"bytes,13,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn               uint32             `protobuf:"varint,14,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string             `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp    string             `protobuf:"bytes,16,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	IsIpv4                bool               `protobuf:"varint,17,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              int32              `protobuf:"varint,18,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string             `protobuf:"bytes,19,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	ClusterList           string             `protobuf:"bytes,20,opt,name=cluster_list,json=clusterList,proto3" json:"cluster_list,omitempty"`
+	IsNexthopIpv4         bool               `protobuf:"varint,21,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32              `protobuf:"varint,22,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Labels                []uint32           `protobuf:"varint,23,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Distinguisher         uint32             `protobuf:"varint,24,opt,name=distinguisher,proto3" json:"distinguisher,omitempty"`
+	Color                 uint32             `protobuf:"varint,25,opt,name=color,proto3" json:"color,omitempty"`
+	Endpoint              []byte             `protobuf:"bytes,26,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	PolicyName            string             `protobuf:"bytes,27,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	BindingSid            string             `protobuf:"bytes,28,opt,name=binding_sid,json=bindingSid,proto3" json:"binding_sid,omitempty"`
+	PreferenceSubtlv      *Preference        `protobuf:"bytes,29,opt,name=preference_subtlv,json=preferenceSubtlv,proto3" json:"preference_subtlv,omitempty"`
+	PrioritySubtlv        uint32             `protobuf:"varint,30,opt,name=priority_subtlv,json=prioritySubtlv,proto3" json:"priority_subtlv,omitempty"`
+	PolicyPathName        string             `protobuf:"bytes,31,opt,name=policy_path_name,json=policyPathName,proto3" json:"policy_path_name,omitempty"`
+	EnlpSubtlv            *ENLP              `protobuf:"bytes,32,opt,name=enlp_subtlv,json=enlpSubtlv,proto3" json:"enlp_subtlv,omitempty"`
+	SegmentListSubtlv     []*SegmentList     `protobuf:"bytes,33,rep,name=segment_list_subtlv,json=segmentListSubtlv,proto3" json:"segment_list_subtlv,omitempty"`
+	IsAdjRibInPostPolicy  bool               `protobuf:"varint,34,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool               `protobuf:"varint,35,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool               `protobuf:"varint,36,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy          bool               `protobuf:"varint,37,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	IsLocRibFiltered      bool               `protobuf:"varint,38,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	RibType               string             `protobuf:"bytes,39,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	TableName             string             `protobuf:"bytes,40,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	BmpTlvs               []*RouteMonitorTLV `protobuf:"bytes,41,rep,name=bmp_tlvs,json=bmpTlvs,proto3" json:"bmp_tlvs,omitempty"`
+}
+
+func (x *SRPolicy) Reset() {
+	*x = SRPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRPolicy) ProtoMessage() {}
+
+func (x *SRPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRPolicy.ProtoReflect.Descriptor instead.
+func (*SRPolicy) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SRPolicy) GetXKey() string {
+	if x != nil {
+		return x.XKey
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetXId() string {
+	if x != nil {
+		return x.XId
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetXRev() string {
+	if x != nil {
+		return x.XRev
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *SRPolicy) GetOriginAs() int32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetClusterList() string {
+	if x != nil {
+		return x.ClusterList
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *SRPolicy) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetLabels() []uint32 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetDistinguisher() uint32 {
+	if x != nil {
+		return x.Distinguisher
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetEndpoint() []byte {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetBindingSid() string {
+	if x != nil {
+		return x.BindingSid
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetPreferenceSubtlv() *Preference {
+	if x != nil {
+		return x.PreferenceSubtlv
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetPrioritySubtlv() uint32 {
+	if x != nil {
+		return x.PrioritySubtlv
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetPolicyPathName() string {
+	if x != nil {
+		return x.PolicyPathName
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetEnlpSubtlv() *ENLP {
+	if x != nil {
+		return x.EnlpSubtlv
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetSegmentListSubtlv() []*SegmentList {
+	if x != nil {
+		return x.SegmentListSubtlv
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *SRPolicy) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *SRPolicy) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *SRPolicy) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *SRPolicy) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *SRPolicy) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetBmpTlvs() []*RouteMonitorTLV {
+	if x != nil {
+		return x.BmpTlvs
+	}
+	return nil
+}
+
+type SegmentList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WeightSubtlv *Weight  `protobuf:"bytes,1,opt,name=weight_subtlv,json=weightSubtlv,proto3" json:"weight_subtlv,omitempty"`
+	Segments     []string `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *SegmentList) Reset() {
+	*x = SegmentList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentList) ProtoMessage() {}
+
+func (x *SegmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentList.ProtoReflect.Descriptor instead.
+func (*SegmentList) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SegmentList) GetWeightSubtlv() *Weight {
+	if x != nil {
+		return x.WeightSubtlv
+	}
+	return nil
+}
+
+func (x *SegmentList) GetSegments() []string {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type ServiceSID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service             string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Sid                 string `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	Flags               uint32 `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+	EndpointBehavior    uint32 `protobuf:"varint,4,opt,name=endpoint_behavior,json=endpointBehavior,proto3" json:"endpoint_behavior,omitempty"`
+	LocatorBlockLength  uint32 `protobuf:"varint,5,opt,name=locator_block_length,json=locatorBlockLength,proto3" json:"locator_block_length,omitempty"`
+	LocatorNodeLength   uint32 `protobuf:"varint,6,opt,name=locator_node_length,json=locatorNodeLength,proto3" json:"locator_node_length,omitempty"`
+	FunctionLength      uint32 `protobuf:"varint,7,opt,name=function_length,json=functionLength,proto3" json:"function_length,omitempty"`
+	ArgumentLength      uint32 `protobuf:"varint,8,opt,name=argument_length,json=argumentLength,proto3" json:"argument_length,omitempty"`
+	TranspositionLength uint32 `protobuf:"varint,9,opt,name=transposition_length,json=transpositionLength,proto3" json:"transposition_length,omitempty"`
+	TranspositionOffset uint32 `protobuf:"varint,10,opt,name=transposition_offset,json=transpositionOffset,proto3" json:"transposition_offset,omitempty"`
+}
+
+func (x *ServiceSID) Reset() {
+	*x = ServiceSID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceSID) ProtoMessage() {}
+
+func (x *ServiceSID) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceSID.ProtoReflect.Descriptor instead.
+func (*ServiceSID) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ServiceSID) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ServiceSID) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *ServiceSID) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *ServiceSID) GetEndpointBehavior() uint32 {
+	if x != nil {
+		return x.EndpointBehavior
+	}
+	return 0
+}
+
+func (x *ServiceSID) GetLocatorBlockLength() uint32 {
+	if x != nil {
+		return x.LocatorBlockLength
+	}
+	return 0
+}
+
+func (x *ServiceSID) GetLocatorNodeLength() uint32 {
+	if x != nil {
+		return x.LocatorNodeLength
+	}
+	return 0
+}
+
+func (x *ServiceSID) GetFunctionLength() uint32 {
+	if x != nil {
+		return x.FunctionLength
+	}
+	return 0
+}
+
+func (x *ServiceSID) GetArgumentLength() uint32 {
+	if x != nil {
+		return x.ArgumentLength
+	}
+	return 0
+}
+
+func (x *ServiceSID) GetTranspositionLength() uint32 {
+	if x != nil {
+		return x.TranspositionLength
+	}
+	return 0
+}
+
+func (x *ServiceSID) GetTranspositionOffset() uint32 {
+	if x != nil {
+		return x.TranspositionOffset
+	}
+	return 0
+}
+
+type SessionSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RemoteAddress     string            `protobuf:"bytes,2,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	Listener          string            `protobuf:"bytes,3,opt,name=listener,proto3" json:"listener,omitempty"`
+	RouterIp          string            `protobuf:"bytes,4,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	SysName           string            `protobuf:"bytes,5,opt,name=sys_name,json=sysName,proto3" json:"sys_name,omitempty"`
+	SysDescr          string            `protobuf:"bytes,6,opt,name=sys_descr,json=sysDescr,proto3" json:"sys_descr,omitempty"`
+	Vendor            string            `protobuf:"bytes,7,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	ConnectedSince    string            `protobuf:"bytes,8,opt,name=connected_since,json=connectedSince,proto3" json:"connected_since,omitempty"`
+	MessagesReceived  uint64            `protobuf:"varint,9,opt,name=messages_received,json=messagesReceived,proto3" json:"messages_received,omitempty"`
+	MessagesPublished uint64            `protobuf:"varint,10,opt,name=messages_published,json=messagesPublished,proto3" json:"messages_published,omitempty"`
+	MessagesDiscarded uint64            `protobuf:"varint,11,opt,name=messages_discarded,json=messagesDiscarded,proto3" json:"messages_discarded,omitempty"`
+	Paused            bool              `protobuf:"varint,12,opt,name=paused,proto3" json:"paused,omitempty"`
+	TlsCommonName     string            `protobuf:"bytes,13,opt,name=tls_common_name,json=tlsCommonName,proto3" json:"tls_common_name,omitempty"`
+	Journal           string            `protobuf:"bytes,14,opt,name=journal,proto3" json:"journal,omitempty"`
+	JournalOffset     int64             `protobuf:"varint,15,opt,name=journal_offset,json=journalOffset,proto3" json:"journal_offset,omitempty"`
+	End               string            `protobuf:"bytes,16,opt,name=end,proto3" json:"end,omitempty"`
+	DurationSeconds   int64             `protobuf:"varint,17,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	ClosedBy          string            `protobuf:"bytes,18,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	TerminationReason string            `protobuf:"bytes,19,opt,name=termination_reason,json=terminationReason,proto3" json:"termination_reason,omitempty"`
+	TerminationInfo   []string          `protobuf:"bytes,20,rep,name=termination_info,json=terminationInfo,proto3" json:"termination_info,omitempty"`
+	BmpMessages       map[string]uint64 `protobuf:"bytes,21,rep,name=bmp_messages,json=bmpMessages,proto3" json:"bmp_messages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	PublishedMessages map[string]uint64 `protobuf:"bytes,22,rep,name=published_messages,json=publishedMessages,proto3" json:"published_messages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ParseErrors       map[string]uint64 `protobuf:"bytes,23,rep,name=parse_errors,json=parseErrors,proto3" json:"parse_errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Errors            uint64            `protobuf:"varint,24,opt,name=errors,proto3" json:"errors,omitempty"`
+	Peers             int64             `protobuf:"varint,25,opt,name=peers,proto3" json:"peers,omitempty"`
+	PeersUp           int64             `protobuf:"varint,26,opt,name=peers_up,json=peersUp,proto3" json:"peers_up,omitempty"`
+	Prefixes          uint64            `protobuf:"varint,27,opt,name=prefixes,proto3" json:"prefixes,omitempty"`
+	Timestamp         string            `protobuf:"bytes,28,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SessionSummary) Reset() {
+	*x = SessionSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionSummary) ProtoMessage() {}
+
+func (x *SessionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionSummary.ProtoReflect.Descriptor instead.
+func (*SessionSummary) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SessionSummary) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetListener() string {
+	if x != nil {
+		return x.Listener
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetSysName() string {
+	if x != nil {
+		return x.SysName
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetSysDescr() string {
+	if x != nil {
+		return x.SysDescr
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetConnectedSince() string {
+	if x != nil {
+		return x.ConnectedSince
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetMessagesReceived() uint64 {
+	if x != nil {
+		return x.MessagesReceived
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetMessagesPublished() uint64 {
+	if x != nil {
+		return x.MessagesPublished
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetMessagesDiscarded() uint64 {
+	if x != nil {
+		return x.MessagesDiscarded
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *SessionSummary) GetTlsCommonName() string {
+	if x != nil {
+		return x.TlsCommonName
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetJournal() string {
+	if x != nil {
+		return x.Journal
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetJournalOffset() int64 {
+	if x != nil {
+		return x.JournalOffset
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetTerminationReason() string {
+	if x != nil {
+		return x.TerminationReason
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetTerminationInfo() []string {
+	if x != nil {
+		return x.TerminationInfo
+	}
+	return nil
+}
+
+func (x *SessionSummary) GetBmpMessages() map[string]uint64 {
+	if x != nil {
+		return x.BmpMessages
+	}
+	return nil
+}
+
+func (x *SessionSummary) GetPublishedMessages() map[string]uint64 {
+	if x != nil {
+		return x.PublishedMessages
+	}
+	return nil
+}
+
+func (x *SessionSummary) GetParseErrors() map[string]uint64 {
+	if x != nil {
+		return x.ParseErrors
+	}
+	return nil
+}
+
+func (x *SessionSummary) GetErrors() uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetPeers() int64 {
+	if x != nil {
+		return x.Peers
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetPeersUp() int64 {
+	if x != nil {
+		return x.PeersUp
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetPrefixes() uint64 {
+	if x != nil {
+		return x.Prefixes
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type SrvalidatorEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action        string   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	RouterIp      string   `protobuf:"bytes,2,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerIp        string   `protobuf:"bytes,3,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	Distinguisher uint32   `protobuf:"varint,4,opt,name=distinguisher,proto3" json:"distinguisher,omitempty"`
+	Color         uint32   `protobuf:"varint,5,opt,name=color,proto3" json:"color,omitempty"`
+	Endpoint      string   `protobuf:"bytes,6,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	PolicyName    string   `protobuf:"bytes,7,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	UnknownSids   []uint32 `protobuf:"varint,8,rep,packed,name=unknown_sids,json=unknownSids,proto3" json:"unknown_sids,omitempty"`
+	Timestamp     string   `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SrvalidatorEvent) Reset() {
+	*x = SrvalidatorEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrvalidatorEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrvalidatorEvent) ProtoMessage() {}
+
+func (x *SrvalidatorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrvalidatorEvent.ProtoReflect.Descriptor instead.
+func (*SrvalidatorEvent) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SrvalidatorEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SrvalidatorEvent) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *SrvalidatorEvent) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *SrvalidatorEvent) GetDistinguisher() uint32 {
+	if x != nil {
+		return x.Distinguisher
+	}
+	return 0
+}
+
+func (x *SrvalidatorEvent) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *SrvalidatorEvent) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *SrvalidatorEvent) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *SrvalidatorEvent) GetUnknownSids() []uint32 {
+	if x != nil {
+		return x.UnknownSids
+	}
+	return nil
+}
+
+func (x *SrvalidatorEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type Stats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XKey                       string `protobuf:"bytes,1,opt,name=_key,json=Key,proto3" json:"_key,omitempty"`
+	XId                        string `protobuf:"bytes,2,opt,name=_id,json=Id,proto3" json:"_id,omitempty"`
+	XRev                       string `protobuf:"bytes,3,opt,name=_rev,json=Rev,proto3" json:"_rev,omitempty"`
+	Sequence                   int64  `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	RouterHash                 string `protobuf:"bytes,5,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp                   string `protobuf:"bytes,6,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerType                   uint32 `protobuf:"varint,7,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	RemoteBgpId                string `protobuf:"bytes,8,opt,name=remote_bgp_id,json=remoteBgpId,proto3" json:"remote_bgp_id,omitempty"`
+	RemoteAsn                  uint32 `protobuf:"varint,9,opt,name=remote_asn,json=remoteAsn,proto3" json:"remote_asn,omitempty"`
+	RemoteIp                   string `protobuf:"bytes,10,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	PeerRd                     string `protobuf:"bytes,11,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	Timestamp                  string `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp         string `protobuf:"bytes,13,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	DuplicatePrefix            uint32 `protobuf:"varint,14,opt,name=duplicate_prefix,json=duplicatePrefix,proto3" json:"duplicate_prefix,omitempty"`
+	DuplicateWithdraws         uint32 `protobuf:"varint,15,opt,name=duplicate_withdraws,json=duplicateWithdraws,proto3" json:"duplicate_withdraws,omitempty"`
+	InvalidatedDueCluster      uint32 `protobuf:"varint,16,opt,name=invalidated_due_cluster,json=invalidatedDueCluster,proto3" json:"invalidated_due_cluster,omitempty"`
+	InvalidatedDueAspath       uint32 `protobuf:"varint,17,opt,name=invalidated_due_aspath,json=invalidatedDueAspath,proto3" json:"invalidated_due_aspath,omitempty"`
+	InvalidatedDueOriginatorId uint32 `protobuf:"varint,18,opt,name=invalidated_due_originator_id,json=invalidatedDueOriginatorId,proto3" json:"invalidated_due_originator_id,omitempty"`
+	InvalidatedDueAsconfed     uint32 `protobuf:"varint,19,opt,name=invalidated_due_asconfed,json=invalidatedDueAsconfed,proto3" json:"invalidated_due_asconfed,omitempty"`
+	AdsRibIn                   uint64 `protobuf:"varint,20,opt,name=ads_rib_in,json=adsRibIn,proto3" json:"ads_rib_in,omitempty"`
+	LocalRib                   uint64 `protobuf:"varint,21,opt,name=local_rib,json=localRib,proto3" json:"local_rib,omitempty"`
+	UpdatesAsWithdraw          uint32 `protobuf:"varint,22,opt,name=updates_as_withdraw,json=updatesAsWithdraw,proto3" json:"updates_as_withdraw,omitempty"`
+	PrefixesAsWithdraw         uint32 `protobuf:"varint,23,opt,name=prefixes_as_withdraw,json=prefixesAsWithdraw,proto3" json:"prefixes_as_withdraw,omitempty"`
+	IsAdjRibOut                bool   `protobuf:"varint,24,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy               bool   `protobuf:"varint,25,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	RibType                    string `protobuf:"bytes,26,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Stats) GetXKey() string {
+	if x != nil {
+		return x.XKey
+	}
+	return ""
+}
+
+func (x *Stats) GetXId() string {
+	if x != nil {
+		return x.XId
+	}
+	return ""
+}
+
+func (x *Stats) GetXRev() string {
+	if x != nil {
+		return x.XRev
+	}
+	return ""
+}
+
+func (x *Stats) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Stats) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *Stats) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *Stats) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *Stats) GetRemoteBgpId() string {
+	if x != nil {
+		return x.RemoteBgpId
+	}
+	return ""
+}
+
+func (x *Stats) GetRemoteAsn() uint32 {
+	if x != nil {
+		return x.RemoteAsn
+	}
+	return 0
+}
+
+func (x *Stats) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *Stats) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *Stats) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Stats) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *Stats) GetDuplicatePrefix() uint32 {
+	if x != nil {
+		return x.DuplicatePrefix
+	}
+	return 0
+}
+
+func (x *Stats) GetDuplicateWithdraws() uint32 {
+	if x != nil {
+		return x.DuplicateWithdraws
+	}
+	return 0
+}
+
+func (x *Stats) GetInvalidatedDueCluster() uint32 {
+	if x != nil {
+		return x.InvalidatedDueCluster
+	}
+	return 0
+}
+
+func (x *Stats) GetInvalidatedDueAspath() uint32 {
+	if x != nil {
+		return x.InvalidatedDueAspath
+	}
+	return 0
+}
+
+func (x *Stats) GetInvalidatedDueOriginatorId() uint32 {
+	if x != nil {
+		return x.InvalidatedDueOriginatorId
+	}
+	return 0
+}
+
+func (x *Stats) GetInvalidatedDueAsconfed() uint32 {
+	if x != nil {
+		return x.InvalidatedDueAsconfed
+	}
+	return 0
+}
+
+func (x *Stats) GetAdsRibIn() uint64 {
+	if x != nil {
+		return x.AdsRibIn
+	}
+	return 0
+}
+
+func (x *Stats) GetLocalRib() uint64 {
+	if x != nil {
+		return x.LocalRib
+	}
+	return 0
+}
+
+func (x *Stats) GetUpdatesAsWithdraw() uint32 {
+	if x != nil {
+		return x.UpdatesAsWithdraw
+	}
+	return 0
+}
+
+func (x *Stats) GetPrefixesAsWithdraw() uint32 {
+	if x != nil {
+		return x.PrefixesAsWithdraw
+	}
+	return 0
+}
+
+func (x *Stats) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *Stats) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *Stats) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+type Storm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouterIp   string           `protobuf:"bytes,1,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	RouterHash string           `protobuf:"bytes,2,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	Start      string           `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End        string           `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	PeerCount  int64            `protobuf:"varint,5,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	Reasons    map[string]int64 `protobuf:"bytes,6,rep,name=reasons,proto3" json:"reasons,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Peers      []*Peer          `protobuf:"bytes,7,rep,name=peers,proto3" json:"peers,omitempty"`
+	Timestamp  string           `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Storm) Reset() {
+	*x = Storm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Storm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Storm) ProtoMessage() {}
+
+func (x *Storm) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Storm.ProtoReflect.Descriptor instead.
+func (*Storm) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Storm) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *Storm) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *Storm) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Storm) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *Storm) GetPeerCount() int64 {
+	if x != nil {
+		return x.PeerCount
+	}
+	return 0
+}
+
+func (x *Storm) GetReasons() map[string]int64 {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *Storm) GetPeers() []*Peer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *Storm) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type SubTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubTlvType  uint32 `protobuf:"varint,1,opt,name=sub_tlv_type,json=subTlvType,proto3" json:"sub_tlv_type,omitempty"`
+	SubTlvValue []byte `protobuf:"bytes,2,opt,name=sub_tlv_value,json=subTlvValue,proto3" json:"sub_tlv_value,omitempty"`
+}
+
+func (x *SubTLV) Reset() {
+	*x = SubTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubTLV) ProtoMessage() {}
+
+func (x *SubTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubTLV.ProtoReflect.Descriptor instead.
+func (*SubTLV) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SubTLV) GetSubTlvType() uint32 {
+	if x != nil {
+		return x.SubTlvType
+	}
+	return 0
+}
+
+func (x *SubTLV) GetSubTlvValue() []byte {
+	if x != nil {
+		return x.SubTlvValue
+	}
+	return nil
+}
+
+type Summary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouterIp      string            `protobuf:"bytes,1,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	RouterHash    string            `protobuf:"bytes,2,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	PeerIp        string            `protobuf:"bytes,3,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerHash      string            `protobuf:"bytes,4,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerAsn       uint32            `protobuf:"varint,5,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	PeerRd        string            `protobuf:"bytes,6,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	Start         string            `protobuf:"bytes,7,opt,name=start,proto3" json:"start,omitempty"`
+	End           string            `protobuf:"bytes,8,opt,name=end,proto3" json:"end,omitempty"`
+	Announcements uint64            `protobuf:"varint,9,opt,name=announcements,proto3" json:"announcements,omitempty"`
+	Withdrawals   uint64            `protobuf:"varint,10,opt,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	Messages      map[string]uint64 `protobuf:"bytes,11,rep,name=messages,proto3" json:"messages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Timestamp     string            `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Summary) Reset() {
+	*x = Summary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Summary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Summary) ProtoMessage() {}
+
+func (x *Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Summary.ProtoReflect.Descriptor instead.
+func (*Summary) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Summary) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *Summary) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *Summary) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *Summary) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *Summary) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *Summary) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *Summary) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Summary) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *Summary) GetAnnouncements() uint64 {
+	if x != nil {
+		return x.Announcements
+	}
+	return 0
+}
+
+func (x *Summary) GetWithdrawals() uint64 {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return 0
+}
+
+func (x *Summary) GetMessages() map[string]uint64 {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *Summary) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type UnicastPrefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XKey                  string             `protobuf:"bytes,1,opt,name=_key,json=Key,proto3" json:"_key,omitempty"`
+	XId                   string             `protobuf:"bytes,2,opt,name=_id,json=Id,proto3" json:"_id,omitempty"`
+	XRev                  string             `protobuf:"bytes,3,opt,name=_rev,json=Rev,proto3" json:"_rev,omitempty"`
+	Action                string             `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64              `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string             `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string             `protobuf:"bytes,7,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string             `protobuf:"bytes,8,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes    `protobuf:"bytes,9,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string             `protobuf:"bytes,10,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string             `protobuf:"bytes,11,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32             `protobuf:"varint,12,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd                string             `protobuf:"bytes,13,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	PeerAsn               uint32             `protobuf:"varint,14,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string             `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CollectorTimestamp    string             `protobuf:"bytes,16,opt,name=collector_timestamp,json=collectorTimestamp,proto3" json:"collector_timestamp,omitempty"`
+	Prefix                string             `protobuf:"bytes,17,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen             int32              `protobuf:"varint,18,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	IsIpv4                bool               `protobuf:"varint,19,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              int32              `protobuf:"varint,20,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string             `protobuf:"bytes,21,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	IsNexthopIpv4         bool               `protobuf:"varint,22,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32              `protobuf:"varint,23,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Labels                []uint32           `protobuf:"varint,24,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	PrefixSid             *PSid              `protobuf:"bytes,25,opt,name=prefix_sid,json=prefixSid,proto3" json:"prefix_sid,omitempty"`
+	IsEor                 bool               `protobuf:"varint,26,opt,name=is_eor,json=isEor,proto3" json:"is_eor,omitempty"`
+	Srv6Sids              []string           `protobuf:"bytes,27,rep,name=srv6_sids,json=srv6Sids,proto3" json:"srv6_sids,omitempty"`
+	Srv6ServiceSids       []*ServiceSID      `protobuf:"bytes,28,rep,name=srv6_service_sids,json=srv6ServiceSids,proto3" json:"srv6_service_sids,omitempty"`
+	IsLlgrStale           bool               `protobuf:"varint,29,opt,name=is_llgr_stale,json=isLlgrStale,proto3" json:"is_llgr_stale,omitempty"`
+	IsAdjRibInPostPolicy  bool               `protobuf:"varint,30,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool               `protobuf:"varint,31,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool               `protobuf:"varint,32,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsPostPolicy          bool               `protobuf:"varint,33,opt,name=is_post_policy,json=isPostPolicy,proto3" json:"is_post_policy,omitempty"`
+	IsLocRibFiltered      bool               `protobuf:"varint,34,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	RibType               string             `protobuf:"bytes,35,opt,name=rib_type,json=ribType,proto3" json:"rib_type,omitempty"`
+	TableName             string             `protobuf:"bytes,36,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	BmpTlvs               []*RouteMonitorTLV `protobuf:"bytes,37,rep,name=bmp_tlvs,json=bmpTlvs,proto3" json:"bmp_tlvs,omitempty"`
+}
+
+func (x *UnicastPrefix) Reset() {
+	*x = UnicastPrefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnicastPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnicastPrefix) ProtoMessage() {}
+
+func (x *UnicastPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnicastPrefix.ProtoReflect.Descriptor instead.
+func (*UnicastPrefix) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UnicastPrefix) GetXKey() string {
+	if x != nil {
+		return x.XKey
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetXId() string {
+	if x != nil {
+		return x.XId
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetXRev() string {
+	if x != nil {
+		return x.XRev
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *UnicastPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetCollectorTimestamp() string {
+	if x != nil {
+		return x.CollectorTimestamp
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetOriginAs() int32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetLabels() []uint32 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UnicastPrefix) GetPrefixSid() *PSid {
+	if x != nil {
+		return x.PrefixSid
+	}
+	return nil
+}
+
+func (x *UnicastPrefix) GetIsEor() bool {
+	if x != nil {
+		return x.IsEor
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetSrv6Sids() []string {
+	if x != nil {
+		return x.Srv6Sids
+	}
+	return nil
+}
+
+func (x *UnicastPrefix) GetSrv6ServiceSids() []*ServiceSID {
+	if x != nil {
+		return x.Srv6ServiceSids
+	}
+	return nil
+}
+
+func (x *UnicastPrefix) GetIsLlgrStale() bool {
+	if x != nil {
+		return x.IsLlgrStale
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetIsPostPolicy() bool {
+	if x != nil {
+		return x.IsPostPolicy
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetRibType() string {
+	if x != nil {
+		return x.RibType
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetBmpTlvs() []*RouteMonitorTLV {
+	if x != nil {
+		return x.BmpTlvs
+	}
+	return nil
+}
+
+type Weight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags  uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *Weight) Reset() {
+	*x = Weight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gobmp_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Weight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Weight) ProtoMessage() {}
+
+func (x *Weight) ProtoReflect() protoreflect.Message {
+	mi := &file_gobmp_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Weight.ProtoReflect.Descriptor instead.
+func (*Weight) Descriptor() ([]byte, []int) {
+	return file_gobmp_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Weight) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *Weight) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+var File_gobmp_proto protoreflect.FileDescriptor
+
+var file_gobmp_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x67,
+	0x6f, 0x62, 0x6d, 0x70, 0x22, 0xcf, 0x02, 0x0a, 0x07, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72,
+	0x49, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f,
+	0x6c, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x4c, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x61,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61, 0x73, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xd7, 0x06, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x53, 0x70,
+	0x65, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x74, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x61,
+	0x69, 0x62, 0x6d, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x73, 0x61, 0x69, 0x62, 0x6d, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x23, 0x0a,
+	0x0d, 0x75, 0x64, 0x61, 0x69, 0x62, 0x6d, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x64, 0x61, 0x69, 0x62, 0x6d, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x2c, 0x0a, 0x13, 0x73, 0x74, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64,
+	0x5f, 0x62, 0x69, 0x74, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0f, 0x73, 0x74, 0x64, 0x41, 0x70, 0x70, 0x49, 0x64, 0x42, 0x69, 0x74, 0x4d, 0x61, 0x73, 0x6b,
+	0x12, 0x2a, 0x0a, 0x12, 0x75, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x5f, 0x62, 0x69,
+	0x74, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x75, 0x64,
+	0x41, 0x70, 0x70, 0x49, 0x64, 0x42, 0x69, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x08,
+	0x73, 0x75, 0x62, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x52, 0x07, 0x73,
+	0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x17, 0x75,
+	0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x5f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x12, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x72, 0x6c, 0x67, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04,
+	0x73, 0x72, 0x6c, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x5f, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x38, 0x0a, 0x19, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x15, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6e, 0x4d, 0x61, 0x78, 0x12, 0x34, 0x0a, 0x16, 0x75, 0x6e,
+	0x69, 0x64, 0x69, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x75, 0x6e, 0x69, 0x64,
+	0x69, 0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2c, 0x0a, 0x12, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x75, 0x6e,
+	0x69, 0x64, 0x69, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61,
+	0x6c, 0x5f, 0x62, 0x77, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x75, 0x6e, 0x69, 0x64,
+	0x69, 0x72, 0x52, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x42, 0x77, 0x12, 0x2e, 0x0a, 0x13,
+	0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x62, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x75, 0x6e, 0x69, 0x64, 0x69,
+	0x72, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x77, 0x12, 0x32, 0x0a, 0x15,
+	0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x5f, 0x62, 0x77, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x75, 0x6e, 0x69,
+	0x64, 0x69, 0x72, 0x42, 0x77, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x57, 0x0a, 0x10, 0x42, 0x47, 0x50, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x73,
+	0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x22, 0xab, 0x01, 0x0a, 0x0e, 0x42, 0x47,
+	0x50, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x62, 0x6d, 0x70, 0x2e, 0x42, 0x47, 0x50, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x62, 0x67, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65,
+	0x65, 0x72, 0x42, 0x67, 0x70, 0x49, 0x64, 0x22, 0xe3, 0x04, 0x0a, 0x0e, 0x42, 0x61, 0x73, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x73, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x73, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x73, 0x50, 0x61, 0x74, 0x68,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x65,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x66,
+	0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x5f, 0x61, 0x67,
+	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x74, 0x6f, 0x6d, 0x69,
+	0x63, 0x41, 0x67, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x34, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x73, 0x34, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0e,
+	0x61, 0x73, 0x34, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x73, 0x34, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x73, 0x34, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x73, 0x34, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x72,
+	0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f,
+	0x74, 0x63, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6f, 0x74, 0x63, 0x22, 0x26, 0x0a,
+	0x0d, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x4c, 0x56, 0x12, 0x15,
+	0x0a, 0x06, 0x6f, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x6f, 0x46, 0x6c, 0x61, 0x67, 0x22, 0x94, 0x02, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x6e, 0x0a, 0x04,
+	0x44, 0x69, 0x66, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x21, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x30, 0x0a, 0x04,
+	0x45, 0x4e, 0x4c, 0x50, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x6e,
+	0x6c, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x65, 0x6e, 0x6c, 0x70, 0x22, 0xdb,
+	0x0d, 0x0a, 0x0a, 0x45, 0x56, 0x50, 0x4e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x11, 0x0a,
+	0x04, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79,
+	0x12, 0x0f, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x64, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x52, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a,
+	0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x67, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42, 0x67, 0x70, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
+	0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x72, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73,
+	0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x49,
+	0x70, 0x76, 0x34, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x73,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x69, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x5f, 0x69, 0x70, 0x76, 0x34,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x68, 0x6f,
+	0x70, 0x49, 0x70, 0x76, 0x34, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x74, 0x68, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x61, 0x77, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x70, 0x6e, 0x5f, 0x72, 0x64, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x70, 0x6e, 0x52, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x76,
+	0x70, 0x6e, 0x5f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x76, 0x70, 0x6e, 0x52, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x65,
+	0x74, 0x68, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x74, 0x68, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x65, 0x74, 0x68, 0x54, 0x61, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x70, 0x5f,
+	0x6c, 0x65, 0x6e, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x70, 0x4c, 0x65, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61,
+	0x63, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x22, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x63, 0x4c, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x72, 0x76,
+	0x36, 0x5f, 0x73, 0x69, 0x64, 0x73, 0x18, 0x24, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x72,
+	0x76, 0x36, 0x53, 0x69, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x11, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x73, 0x18, 0x25, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x49, 0x44, 0x52, 0x0f, 0x73, 0x72, 0x76, 0x36, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x63, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x4d, 0x61, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x63, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x72, 0x63, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x63,
+	0x61, 0x73, 0x74, 0x53, 0x72, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x5f,
+	0x67, 0x72, 0x70, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x63, 0x61, 0x73, 0x74,
+	0x47, 0x72, 0x70, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x2b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a,
+	0x0b, 0x70, 0x6d, 0x73, 0x69, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x2d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x50, 0x4d, 0x53, 0x49, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x70, 0x6d, 0x73, 0x69, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x37, 0x0a, 0x19, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f,
+	0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x2e,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x49, 0x6e,
+	0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x1a, 0x69, 0x73,
+	0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f,
+	0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x30, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
+	0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x31, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x2d, 0x0a, 0x13, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x32, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69,
+	0x73, 0x4c, 0x6f, 0x63, 0x52, 0x69, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x62, 0x6d, 0x70,
+	0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x35, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x62, 0x6d, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x54, 0x4c, 0x56, 0x52, 0x07, 0x62, 0x6d, 0x70, 0x54, 0x6c, 0x76, 0x73, 0x22, 0xa5, 0x01, 0x0a,
+	0x09, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x49, 0x70, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x67, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x67, 0x70, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x49, 0x70, 0x22, 0x53, 0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x58, 0x53, 0x49, 0x44, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x73,
+	0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x22, 0xf3, 0x01, 0x0a, 0x0a, 0x45, 0x6e,
+	0x64, 0x58, 0x53, 0x49, 0x44, 0x54, 0x4c, 0x56, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x72, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x45, 0x6e, 0x64, 0x58, 0x53, 0x49, 0x44,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x6c, 0x76, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x22,
+	0x67, 0x0a, 0x10, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f,
+	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x66, 0x6c, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x6c, 0x67, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x61, 0x6c, 0x67, 0x6f, 0x22, 0x98, 0x02, 0x0a, 0x0b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x49, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0xbe, 0x01, 0x0a, 0x09, 0x46, 0x41, 0x44, 0x53, 0x75, 0x62, 0x54, 0x4c,
+	0x56, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x6e, 0x79,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41,
+	0x6e, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x6e,
+	0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x41, 0x6e, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61,
+	0x6c, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x41, 0x6c, 0x6c, 0x12, 0x2b, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x46, 0x41, 0x44, 0x53,
+	0x75, 0x62, 0x54, 0x4c, 0x56, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x72, 0x6c,
+	0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x53, 0x72, 0x6c, 0x67, 0x22, 0x27, 0x0a, 0x0e, 0x46, 0x41, 0x44, 0x53, 0x75, 0x62, 0x54, 0x4c,
+	0x56, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x5f, 0x66, 0x6c, 0x61, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x46, 0x6c, 0x61, 0x67, 0x22, 0xc4, 0x01,
+	0x0a, 0x12, 0x46, 0x6c, 0x65, 0x78, 0x41, 0x6c, 0x67, 0x6f, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x65, 0x78, 0x5f, 0x61, 0x6c, 0x67,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x66, 0x6c, 0x65, 0x78, 0x41, 0x6c, 0x67,
+	0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x75, 0x62,
+	0x5f, 0x74, 0x6c, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x46, 0x41, 0x44, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x52, 0x06, 0x73, 0x75,
+	0x62, 0x54, 0x6c, 0x76, 0x22, 0x4b, 0x0a, 0x14, 0x46, 0x6c, 0x65, 0x78, 0x41, 0x6c, 0x67, 0x6f,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x6c, 0x65, 0x78, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x66, 0x6c, 0x65, 0x78, 0x41, 0x6c, 0x67, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x22, 0xa1, 0x08, 0x0a, 0x08, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x70, 0x65, 0x63, 0x12, 0x11,
+	0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65,
+	0x79, 0x12, 0x0f, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x64, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x52, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61,
+	0x74, 0x74, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70,
+	0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x34,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x49, 0x70, 0x76, 0x34, 0x12, 0x1b,
+	0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
+	0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x68, 0x6f, 0x70, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x69, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x49, 0x70, 0x76, 0x34, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x61, 0x74, 0x68, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x70, 0x6e, 0x5f, 0x72, 0x64,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x70, 0x6e, 0x52, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x70, 0x65, 0x63, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x30,
+	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x37, 0x0a, 0x19, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x69,
+	0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x49, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x1a, 0x69, 0x73, 0x5f,
+	0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69,
+	0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72,
+	0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73,
+	0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x2d, 0x0a, 0x13, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73,
+	0x4c, 0x6f, 0x63, 0x52, 0x69, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x62, 0x6d, 0x70, 0x5f,
+	0x74, 0x6c, 0x76, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54,
+	0x4c, 0x56, 0x52, 0x07, 0x62, 0x6d, 0x70, 0x54, 0x6c, 0x76, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf3, 0x06, 0x0a, 0x0c, 0x49, 0x47, 0x50, 0x41, 0x64, 0x6a,
+	0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65,
+	0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12,
+	0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x70, 0x5f,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x70, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x65, 0x61, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6d, 0x74,
+	0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6c, 0x76, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x6d,
+	0x74, 0x49, 0x64, 0x54, 0x6c, 0x76, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28,
+	0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x67,
+	0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x69, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f,
+	0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x67, 0x70, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x49, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x22, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x70,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x6e,
+	0x6b, 0x49, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x69, 0x70, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x70, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x08, 0x49,
+	0x47, 0x50, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x5f, 0x66, 0x6c, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15,
+	0x0a, 0x06, 0x6e, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06,
+	0x70, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x46,
+	0x6c, 0x61, 0x67, 0x22, 0x21, 0x0a, 0x0b, 0x4a, 0x53, 0x4f, 0x4e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x09, 0x4c, 0x32, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x22, 0x26,
+	0x0a, 0x09, 0x4c, 0x33, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x75, 0x62, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x22, 0x97, 0x0a, 0x0a, 0x0b, 0x4c, 0x33, 0x56, 0x50, 0x4e,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x03, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x72,
+	0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x76, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x49, 0x70, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x09,
+	0x62, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65,
+	0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69,
+	0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73,
+	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f,
+	0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x69, 0x70, 0x76,
+	0x34, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x49, 0x70, 0x76, 0x34, 0x12,
+	0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f,
+	0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x49, 0x70, 0x76,
+	0x34, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x74, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x70, 0x6e, 0x5f, 0x72, 0x64, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x70, 0x6e, 0x52, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x76, 0x70, 0x6e,
+	0x5f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x76, 0x70, 0x6e, 0x52, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x50, 0x53, 0x69, 0x64, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x53, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x69,
+	0x64, 0x73, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x72, 0x76, 0x36, 0x53, 0x69,
+	0x64, 0x73, 0x12, 0x3d, 0x0a, 0x11, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x49, 0x44,
+	0x52, 0x0f, 0x73, 0x72, 0x76, 0x36, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x69, 0x64,
+	0x73, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6c, 0x6c, 0x67, 0x72, 0x5f, 0x73, 0x74, 0x61,
+	0x6c, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x4c, 0x6c, 0x67, 0x72,
+	0x53, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x19, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f,
+	0x72, 0x69, 0x62, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52,
+	0x69, 0x62, 0x49, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39,
+	0x0a, 0x1a, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x21, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x15, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f,
+	0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x72,
+	0x69, 0x62, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x52, 0x69, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x26, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a,
+	0x08, 0x62, 0x6d, 0x70, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x27, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x54, 0x4c, 0x56, 0x52, 0x07, 0x62, 0x6d, 0x70, 0x54, 0x6c, 0x76, 0x73,
+	0x22, 0x97, 0x02, 0x0a, 0x0d, 0x4c, 0x41, 0x4e, 0x45, 0x6e, 0x64, 0x58, 0x53, 0x49, 0x44, 0x54,
+	0x4c, 0x56, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2b,
+	0x0a, 0x11, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x45, 0x6e, 0x64, 0x58, 0x53, 0x49, 0x44, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x22, 0x9b, 0x17, 0x0a, 0x06, 0x4c,
+	0x53, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x72, 0x65,
+	0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70,
+	0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x67, 0x70, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x67,
+	0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x6c, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x73, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61,
+	0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x65, 0x61, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x3a, 0x0a, 0x09, 0x6d,
+	0x74, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6c, 0x76, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07,
+	0x6d, 0x74, 0x49, 0x64, 0x54, 0x6c, 0x76, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x69, 0x70, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4c,
+	0x69, 0x6e, 0x6b, 0x49, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x70, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x67, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x69, 0x67, 0x70, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x62, 0x77, 0x18, 0x20, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x6b, 0x42, 0x77, 0x12, 0x1e, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x76, 0x5f, 0x62, 0x77, 0x18, 0x21, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x76, 0x42, 0x77, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x6e, 0x72, 0x65, 0x73, 0x76, 0x5f, 0x62, 0x77, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x08, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x76, 0x42, 0x77, 0x12, 0x27, 0x0a, 0x10, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x62, 0x77, 0x5f, 0x6b, 0x62, 0x70, 0x73, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x6b, 0x42, 0x77, 0x4b, 0x62,
+	0x70, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x76, 0x5f, 0x62,
+	0x77, 0x5f, 0x6b, 0x62, 0x70, 0x73, 0x18, 0x24, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x73, 0x76, 0x42, 0x77, 0x4b, 0x62, 0x70, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x75,
+	0x6e, 0x72, 0x65, 0x73, 0x76, 0x5f, 0x62, 0x77, 0x5f, 0x6b, 0x62, 0x70, 0x73, 0x18, 0x25, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0c, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x76, 0x42, 0x77, 0x4b, 0x62, 0x70,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x65,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x27, 0x0a,
+	0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x27, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x6d, 0x70, 0x6c, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x72, 0x6c, 0x67, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x72,
+	0x6c, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x2c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x2e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x67,
+	0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x2f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x73, 0x6e,
+	0x18, 0x31, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64,
+	0x65, 0x41, 0x73, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x73, 0x6e, 0x12, 0x22, 0x0a, 0x0d,
+	0x62, 0x67, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x33, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2f, 0x0a, 0x14, 0x62, 0x67, 0x70, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x62, 0x67, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x18, 0x35,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x73, 0x12, 0x32,
+	0x0a, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x18,
+	0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x53, 0x49, 0x44, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x53,
+	0x69, 0x64, 0x12, 0x30, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x73,
+	0x69, 0x64, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x49, 0x44, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x41, 0x64,
+	0x6a, 0x53, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74,
+	0x5f, 0x73, 0x69, 0x64, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x49, 0x44, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x53, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x16, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x62,
+	0x67, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x64,
+	0x18, 0x39, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x42,
+	0x47, 0x50, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x49, 0x44, 0x52, 0x12, 0x73,
+	0x72, 0x76, 0x36, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69,
+	0x64, 0x12, 0x35, 0x0a, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x65, 0x6e, 0x64, 0x78, 0x5f, 0x73,
+	0x69, 0x64, 0x18, 0x3a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70,
+	0x2e, 0x45, 0x6e, 0x64, 0x58, 0x53, 0x49, 0x44, 0x54, 0x4c, 0x56, 0x52, 0x0b, 0x73, 0x72, 0x76,
+	0x36, 0x45, 0x6e, 0x64, 0x78, 0x53, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x11, 0x73, 0x72, 0x76, 0x36,
+	0x5f, 0x6c, 0x61, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x78, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x3b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4c, 0x41, 0x4e, 0x45,
+	0x6e, 0x64, 0x58, 0x53, 0x49, 0x44, 0x54, 0x4c, 0x56, 0x52, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x4c,
+	0x61, 0x6e, 0x45, 0x6e, 0x64, 0x78, 0x53, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x73, 0x5f,
+	0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x3c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x73, 0x41, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79,
+	0x53, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x73, 0x64, 0x18,
+	0x3d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4d, 0x53,
+	0x44, 0x54, 0x56, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x73, 0x64, 0x12, 0x43, 0x0a, 0x12,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x18, 0x3e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70,
+	0x2e, 0x41, 0x70, 0x70, 0x53, 0x70, 0x65, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x74, 0x72,
+	0x52, 0x0f, 0x61, 0x70, 0x70, 0x53, 0x70, 0x65, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x74,
+	0x72, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x75, 0x6e,
+	0x69, 0x64, 0x69, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x38, 0x0a,
+	0x19, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x40, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x15, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x4d, 0x69, 0x6e, 0x4d, 0x61, 0x78, 0x12, 0x34, 0x0a, 0x16, 0x75, 0x6e, 0x69, 0x64, 0x69,
+	0x72, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x41, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a,
+	0x12, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c,
+	0x6f, 0x73, 0x73, 0x18, 0x42, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x75, 0x6e, 0x69, 0x64, 0x69,
+	0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x75,
+	0x6e, 0x69, 0x64, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x5f, 0x62,
+	0x77, 0x18, 0x43, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x52,
+	0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x42, 0x77, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x6e, 0x69,
+	0x64, 0x69, 0x72, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x77,
+	0x18, 0x44, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x77, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x6e, 0x69,
+	0x64, 0x69, 0x72, 0x5f, 0x62, 0x77, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x45, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x75, 0x6e, 0x69, 0x64, 0x69, 0x72,
+	0x42, 0x77, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a,
+	0x19, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x69, 0x6e, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x46, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x49, 0x6e, 0x50, 0x6f, 0x73, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x1a, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a,
+	0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x47, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x73, 0x41, 0x64,
+	0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f,
+	0x6f, 0x75, 0x74, 0x18, 0x48, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x64, 0x6a,
+	0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x49, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x13,
+	0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x4c, 0x6f, 0x63,
+	0x52, 0x69, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x62, 0x6d, 0x70, 0x5f, 0x74, 0x6c, 0x76,
+	0x73, 0x18, 0x4d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x4c, 0x56, 0x52,
+	0x07, 0x62, 0x6d, 0x70, 0x54, 0x6c, 0x76, 0x73, 0x22, 0xa0, 0x0b, 0x0a, 0x06, 0x4c, 0x53, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x72, 0x65, 0x76, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65,
+	0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x41, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x67, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x67, 0x70, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x6c, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x73, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6d,
+	0x74, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6c, 0x76, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07,
+	0x6d, 0x74, 0x49, 0x64, 0x54, 0x6c, 0x76, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x65, 0x61, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x33, 0x0a,
+	0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x73, 0x5f, 0x73, 0x72, 0x5f,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x6c, 0x73, 0x53, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x72, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x72, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x37, 0x0a, 0x0e, 0x73, 0x72, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x0c, 0x73, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x48, 0x0a, 0x15, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6c, 0x76, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x54, 0x4c, 0x56, 0x52, 0x13, 0x73, 0x72, 0x76, 0x36, 0x43, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x54, 0x6c, 0x76, 0x12, 0x27, 0x0a, 0x08, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x6d, 0x73, 0x64, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67,
+	0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4d, 0x53, 0x44, 0x54, 0x56, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65,
+	0x4d, 0x73, 0x64, 0x12, 0x4b, 0x0a, 0x14, 0x66, 0x6c, 0x65, 0x78, 0x5f, 0x61, 0x6c, 0x67, 0x6f,
+	0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x20, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x46, 0x6c, 0x65, 0x78, 0x41, 0x6c,
+	0x67, 0x6f, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x66, 0x6c,
+	0x65, 0x78, 0x41, 0x6c, 0x67, 0x6f, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x37, 0x0a, 0x19, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x69,
+	0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x21, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x49, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x1a, 0x69, 0x73, 0x5f,
+	0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69,
+	0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72,
+	0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73,
+	0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x24, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x2d, 0x0a, 0x13, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73,
+	0x4c, 0x6f, 0x63, 0x52, 0x69, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x62, 0x6d, 0x70, 0x5f,
+	0x74, 0x6c, 0x76, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54,
+	0x4c, 0x56, 0x52, 0x07, 0x62, 0x6d, 0x70, 0x54, 0x6c, 0x76, 0x73, 0x22, 0xd3, 0x0c, 0x0a, 0x08,
+	0x4c, 0x53, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x03, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x04,
+	0x5f, 0x72, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x76, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x65, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x67, 0x70, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x69, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x6c, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x73, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x61,
+	0x72, 0x65, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72,
+	0x65, 0x61, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x26,
+	0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f,
+	0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a,
+	0x0a, 0x09, 0x6d, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6c, 0x76, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x07, 0x6d, 0x74, 0x49, 0x64, 0x54, 0x6c, 0x76, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x73,
+	0x70, 0x66, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6f, 0x73, 0x70, 0x66, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x69, 0x67, 0x70, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x49, 0x47,
+	0x50, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x08, 0x69, 0x67, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x1d, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x22, 0x0a,
+	0x0d, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x1e,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61,
+	0x67, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x70, 0x66, 0x5f, 0x66, 0x77, 0x64, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73, 0x70, 0x66, 0x46, 0x77,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x21, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x61, 0x74, 0x74,
+	0x72, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x41, 0x74, 0x74, 0x72, 0x54, 0x6c, 0x76, 0x73, 0x12, 0x52, 0x0a, 0x17,
+	0x66, 0x6c, 0x65, 0x78, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x25, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x46, 0x6c, 0x65, 0x78, 0x41, 0x6c, 0x67, 0x6f, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x14, 0x66, 0x6c, 0x65, 0x78,
+	0x41, 0x6c, 0x67, 0x6f, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x12, 0x34, 0x0a, 0x0c, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x4c, 0x56, 0x52, 0x0b, 0x73, 0x72, 0x76, 0x36, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x19, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a,
+	0x5f, 0x72, 0x69, 0x62, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x41, 0x64, 0x6a,
+	0x52, 0x69, 0x62, 0x49, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x39, 0x0a, 0x1a, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75,
+	0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x28, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73,
+	0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x29, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12,
+	0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x5f,
+	0x72, 0x69, 0x62, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x2b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x52, 0x69, 0x62, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x2d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31,
+	0x0a, 0x08, 0x62, 0x6d, 0x70, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x2e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x4c, 0x56, 0x52, 0x07, 0x62, 0x6d, 0x70, 0x54, 0x6c, 0x76,
+	0x73, 0x22, 0xde, 0x0c, 0x0a, 0x09, 0x4c, 0x53, 0x53, 0x52, 0x76, 0x36, 0x53, 0x49, 0x44, 0x12,
+	0x11, 0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b,
+	0x65, 0x79, 0x12, 0x0f, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x52, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65,
+	0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69,
+	0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73,
+	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f,
+	0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x22, 0x0a, 0x0d, 0x69, 0x67, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x73, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x6c, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x73, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61,
+	0x72, 0x65, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72,
+	0x65, 0x61, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x6d,
+	0x74, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6c, 0x76, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07,
+	0x6d, 0x74, 0x49, 0x64, 0x54, 0x6c, 0x76, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x67, 0x70, 0x5f, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69, 0x67, 0x70, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x61,
+	0x67, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61,
+	0x67, 0x12, 0x22, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74,
+	0x61, 0x67, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x70, 0x66, 0x5f, 0x66, 0x77,
+	0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73,
+	0x70, 0x66, 0x46, 0x77, 0x64, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x67, 0x70,
+	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69,
+	0x67, 0x70, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x72, 0x76, 0x36, 0x53, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x16, 0x73, 0x72,
+	0x76, 0x36, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61,
+	0x76, 0x69, 0x6f, 0x72, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x52, 0x14, 0x73, 0x72, 0x76, 0x36, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x49, 0x0a, 0x16, 0x73, 0x72, 0x76,
+	0x36, 0x5f, 0x62, 0x67, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x73, 0x69, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x62, 0x6d,
+	0x70, 0x2e, 0x42, 0x47, 0x50, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x49, 0x44,
+	0x52, 0x12, 0x73, 0x72, 0x76, 0x36, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x12, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x69, 0x64,
+	0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x53, 0x49, 0x44, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x10, 0x73, 0x72, 0x76, 0x36, 0x53, 0x69, 0x64, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x19, 0x69, 0x73, 0x5f, 0x61, 0x64,
+	0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x41, 0x64,
+	0x6a, 0x52, 0x69, 0x62, 0x49, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x39, 0x0a, 0x1a, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x28,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0e, 0x69,
+	0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x29, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63,
+	0x5f, 0x72, 0x69, 0x62, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x2b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x52, 0x69, 0x62, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x2d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x31, 0x0a, 0x08, 0x62, 0x6d, 0x70, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x2e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x4c, 0x56, 0x52, 0x07, 0x62, 0x6d, 0x70, 0x54, 0x6c,
+	0x76, 0x73, 0x22, 0x44, 0x0a, 0x0d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x54, 0x4c, 0x56, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x5a, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61,
+	0x73, 0x31, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x61, 0x73, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0x56, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x62, 0x6d, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x4c,
+	0x56, 0x52, 0x09, 0x73, 0x75, 0x62, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x0d,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x4c, 0x56, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x25, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x5f, 0x66, 0x6c,
+	0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x22,
+	0x8d, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x4c, 0x56, 0x12, 0x29,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x6c, 0x67,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x61, 0x6c, 0x67, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x28, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x6c, 0x76,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e,
+	0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x22,
+	0x3f, 0x0a, 0x05, 0x4d, 0x53, 0x44, 0x54, 0x56, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73, 0x64, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x73, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x73, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x73, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x9c, 0x09, 0x0a, 0x04, 0x4d, 0x56, 0x50, 0x4e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12,
+	0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x70, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x69,
+	0x73, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73,
+	0x49, 0x70, 0x76, 0x34, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x61,
+	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x69,
+	0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x49,
+	0x70, 0x76, 0x34, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x74, 0x68, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x70, 0x6e, 0x5f, 0x72, 0x64, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x70, 0x6e, 0x52, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x63, 0x61, 0x73, 0x74,
+	0x5f, 0x73, 0x72, 0x63, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x63, 0x61, 0x73,
+	0x74, 0x53, 0x72, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x67, 0x72,
+	0x70, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72,
+	0x70, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x30,
+	0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4d, 0x56, 0x50, 0x4e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x32, 0x0a, 0x0b, 0x70, 0x6d, 0x73, 0x69, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x50, 0x4d,
+	0x53, 0x49, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0a, 0x70, 0x6d, 0x73, 0x69, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x19, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72,
+	0x69, 0x62, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69,
+	0x62, 0x49, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x0a,
+	0x1a, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61,
+	0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x72, 0x69,
+	0x62, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x52, 0x69, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x08,
+	0x62, 0x6d, 0x70, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x54, 0x4c, 0x56, 0x52, 0x07, 0x62, 0x6d, 0x70, 0x54, 0x6c, 0x76, 0x73, 0x22,
+	0xd3, 0x01, 0x0a, 0x0c, 0x4d, 0x56, 0x50, 0x4e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x70, 0x6e, 0x5f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x70, 0x6e, 0x52, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x72, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x53, 0x72, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x63, 0x61, 0x73, 0x74, 0x5f, 0x67, 0x72, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x70, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x22, 0xe9, 0x04, 0x0a, 0x12, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72,
+	0x65, 0x64, 0x42, 0x47, 0x50, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x67, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x67, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x79, 0x5f,
+	0x61, 0x73, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x79, 0x41, 0x73, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x62, 0x67, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62,
+	0x67, 0x70, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67,
+	0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6c, 0x72, 0x69, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6c,
+	0x72, 0x69, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0b, 0x6d, 0x70, 0x5f, 0x61, 0x66, 0x69, 0x5f, 0x73, 0x61, 0x66, 0x69, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x70, 0x41, 0x66, 0x69, 0x53, 0x61, 0x66, 0x69, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x66, 0x69, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x66, 0x69, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x61, 0x66, 0x69, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x61,
+	0x66, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x04,
+	0x6f, 0x72, 0x66, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x4f, 0x52, 0x46, 0x52, 0x04, 0x6f, 0x72, 0x66, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x70, 0x64, 0x75, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x70, 0x64, 0x75, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xe0, 0x07, 0x0a, 0x0f, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x65,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x67, 0x70,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x67, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x79, 0x5f, 0x61, 0x73, 0x6e,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x79, 0x41, 0x73, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x67,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x67, 0x70, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x62, 0x6d,
+	0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x74, 0x74, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6c, 0x72, 0x69, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6c, 0x72, 0x69, 0x12,
+	0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x70,
+	0x5f, 0x61, 0x66, 0x69, 0x5f, 0x73, 0x61, 0x66, 0x69, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x70, 0x41, 0x66, 0x69, 0x53, 0x61, 0x66, 0x69, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x66, 0x69,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x66, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x61, 0x66, 0x69, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x61, 0x66, 0x69, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x6f, 0x72, 0x66,
+	0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e,
+	0x4f, 0x52, 0x46, 0x52, 0x04, 0x6f, 0x72, 0x66, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x64, 0x75,
+	0x18, 0x1d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x70, 0x64, 0x75, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23,
+	0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62,
+	0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x50,
+	0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x69, 0x62,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x69, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x5c, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x15, 0x0a, 0x06, 0x6f, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x6f, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x5f, 0x66, 0x6c, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x13, 0x0a,
+	0x05, 0x6d, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x74,
+	0x49, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x74, 0x74, 0x72, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6f, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x74,
+	0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x5f, 0x66,
+	0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x46, 0x6c, 0x61, 0x67,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x5f, 0x66, 0x6c, 0x61,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x46, 0x6c, 0x61, 0x67, 0x22, 0x73,
+	0x0a, 0x03, 0x4f, 0x52, 0x46, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x68, 0x65, 0x6e, 0x5f, 0x74, 0x6f,
+	0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x77, 0x68, 0x65, 0x6e, 0x54, 0x6f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x72, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x6f, 0x72, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x62, 0x6d,
+	0x70, 0x2e, 0x4f, 0x52, 0x46, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x08, 0x4f, 0x52, 0x46, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69,
+	0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x69, 0x6e,
+	0x4c, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c,
+	0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x4c, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4a, 0x0a, 0x11, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x52, 0x47, 0x42, 0x54, 0x4c, 0x56, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x72, 0x67, 0x62, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x53, 0x52, 0x47, 0x42, 0x52,
+	0x04, 0x73, 0x72, 0x67, 0x62, 0x22, 0xf6, 0x04, 0x0a, 0x0a, 0x50, 0x4d, 0x53, 0x49, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x65,
+	0x61, 0x66, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6c, 0x65, 0x61, 0x66, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x73, 0x76, 0x70, 0x5f, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72,
+	0x73, 0x76, 0x70, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x32, 0x6d, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x32,
+	0x6d, 0x70, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x75, 0x62,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x66, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x66, 0x72, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x66, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x66, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0xf4,
+	0x01, 0x0a, 0x04, 0x50, 0x53, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x54,
+	0x4c, 0x56, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x41,
+	0x0a, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x72, 0x67,
+	0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x52, 0x47, 0x42, 0x54, 0x4c,
+	0x56, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x72, 0x67,
+	0x62, 0x12, 0x38, 0x0a, 0x0f, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x6c, 0x33, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x4c, 0x33, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0d, 0x73, 0x72,
+	0x76, 0x36, 0x4c, 0x33, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x0f, 0x73,
+	0x72, 0x76, 0x36, 0x5f, 0x6c, 0x32, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4c, 0x32, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x4c, 0x32, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x61, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41,
+	0x73, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6d, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x62, 0x6d, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xeb, 0x01, 0x0a, 0x10, 0x50, 0x65, 0x65,
+	0x72, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65,
+	0x72, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x66, 0x6c, 0x61, 0x70, 0x73, 0x22, 0x67, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x5f,
+	0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x46, 0x6c, 0x61,
+	0x67, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x62, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x5f, 0x66, 0x6c,
+	0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x22,
+	0x5b, 0x0a, 0x07, 0x50, 0x65, 0x65, 0x72, 0x53, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x62, 0x6d,
+	0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x69, 0x64, 0x22, 0xb5, 0x0c, 0x0a,
+	0x0f, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x11, 0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x4b, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x62,
+	0x67, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x42, 0x67, 0x70, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61,
+	0x73, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x41, 0x73, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x61, 0x73, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x41, 0x73, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x70,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x20,
+	0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x67, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x67, 0x70, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x6e, 0x66, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a,
+	0x07, 0x61, 0x64, 0x76, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x64, 0x76, 0x43, 0x61, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x63,
+	0x61, 0x70, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x63, 0x76, 0x43, 0x61,
+	0x70, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x70, 0x4d, 0x69, 0x73, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x68,
+	0x6f, 0x6c, 0x64, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x64, 0x76, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x64, 0x76, 0x48, 0x6f, 0x6c, 0x64, 0x64, 0x6f, 0x77, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6d, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x1c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6d, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x0e, 0x62, 0x6d, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6d, 0x70, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x62, 0x6d, 0x70, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x62, 0x6d, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x11, 0x0a, 0x04, 0x69, 0x73, 0x5f, 0x6c, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x69, 0x73, 0x4c, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x72,
+	0x65, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x69, 0x70,
+	0x76, 0x34, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x49, 0x70, 0x76, 0x34,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x23,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x37, 0x0a, 0x19, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x69, 0x6e,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x24, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x49, 0x6e, 0x50, 0x6f,
+	0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x1a, 0x69, 0x73, 0x5f, 0x61,
+	0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x73,
+	0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69,
+	0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x26, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41,
+	0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d,
+	0x0a, 0x13, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x4c,
+	0x6f, 0x63, 0x52, 0x69, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x2a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x2b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x73, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x65,
+	0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x3a, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x02, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x24, 0x0a, 0x0d,
+	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x6c,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x6c, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x64, 0x6f, 0x77, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x65, 0x65,
+	0x72, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x22, 0x42, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0b, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x43, 0x68, 0x75, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x70, 0x6e, 0x5f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x70, 0x6e, 0x52, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x73, 0x22, 0x82, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x70, 0x6e, 0x5f,
+	0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x70, 0x6e, 0x52, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x70, 0x61, 0x74, 0x68, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x66, 0x6c, 0x61, 0x70, 0x73, 0x22, 0xeb, 0x02, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x65, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x69, 0x62, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x69, 0x62, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x64, 0x75, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x70, 0x64, 0x75, 0x22, 0xc5, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x70, 0x61, 0x74, 0x68, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74,
+	0x68, 0x6f, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68,
+	0x6f, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x49, 0x70, 0x76, 0x34, 0x12, 0x31, 0x0a, 0x0b, 0x65,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x49, 0x44, 0x52, 0x0a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x69, 0x64, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xbb, 0x01, 0x0a,
+	0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x12, 0x74, 0x6f, 0x70, 0x5f,
+	0x63, 0x68, 0x75, 0x72, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x43, 0x68, 0x75, 0x72, 0x6e, 0x52, 0x10, 0x74, 0x6f, 0x70, 0x43, 0x68, 0x75,
+	0x72, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0xe0, 0x03, 0x0a, 0x0b, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65,
+	0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65,
+	0x65, 0x72, 0x52, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a,
+	0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x20,
+	0x0a, 0x0b, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x35, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f,
+	0x72, 0x65, 0x64, 0x42, 0x47, 0x50, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x64,
+	0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x69, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x22, 0x4d, 0x0a,
+	0x0f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x4c, 0x56,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x70, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x80, 0x04, 0x0a,
+	0x0c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x65, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49,
+	0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x61, 0x73, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41,
+	0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x66, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x61, 0x66, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x66, 0x69, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x73, 0x61, 0x66, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1e, 0x0a, 0x04, 0x6f, 0x72, 0x66, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x4f, 0x52, 0x46, 0x52, 0x04, 0x6f, 0x72, 0x66,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f,
+	0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x9c, 0x01, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x3e,
+	0x0a, 0x11, 0x74, 0x6f, 0x70, 0x5f, 0x66, 0x6c, 0x61, 0x70, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x62, 0x6d,
+	0x70, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0f, 0x74,
+	0x6f, 0x70, 0x46, 0x6c, 0x61, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0xee,
+	0x01, 0x0a, 0x0c, 0x53, 0x49, 0x44, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2e, 0x0a,
+	0x13, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x27, 0x0a,
+	0x0f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22,
+	0x34, 0x0a, 0x04, 0x53, 0x52, 0x47, 0x42, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x99, 0x0b, 0x0a, 0x08, 0x53, 0x52, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12,
+	0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x70, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x72, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x69,
+	0x73, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73,
+	0x49, 0x70, 0x76, 0x34, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x61,
+	0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x5f, 0x69, 0x70, 0x76,
+	0x34, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x68,
+	0x6f, 0x70, 0x49, 0x70, 0x76, 0x34, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69,
+	0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x74, 0x68, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69,
+	0x64, 0x12, 0x3e, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x73, 0x75, 0x62, 0x74, 0x6c, 0x76, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67,
+	0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x10, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x75, 0x62, 0x74, 0x6c,
+	0x76, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x75,
+	0x62, 0x74, 0x6c, 0x76, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x53, 0x75, 0x62, 0x74, 0x6c, 0x76, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x61, 0x74, 0x68,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0b, 0x65, 0x6e, 0x6c, 0x70, 0x5f, 0x73, 0x75, 0x62,
+	0x74, 0x6c, 0x76, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x62, 0x6d,
+	0x70, 0x2e, 0x45, 0x4e, 0x4c, 0x50, 0x52, 0x0a, 0x65, 0x6e, 0x6c, 0x70, 0x53, 0x75, 0x62, 0x74,
+	0x6c, 0x76, 0x12, 0x42, 0x0a, 0x13, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x6c, 0x76, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x11, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x74, 0x6c, 0x76, 0x12, 0x37, 0x0a, 0x19, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a,
+	0x5f, 0x72, 0x69, 0x62, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x41, 0x64, 0x6a,
+	0x52, 0x69, 0x62, 0x49, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x39, 0x0a, 0x1a, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75,
+	0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73,
+	0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x24, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12,
+	0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x5f,
+	0x72, 0x69, 0x62, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x26, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x52, 0x69, 0x62, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x28, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31,
+	0x0a, 0x08, 0x62, 0x6d, 0x70, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x4c, 0x56, 0x52, 0x07, 0x62, 0x6d, 0x70, 0x54, 0x6c, 0x76,
+	0x73, 0x22, 0x5d, 0x0a, 0x0b, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x32, 0x0a, 0x0d, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x6c,
+	0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x53, 0x75,
+	0x62, 0x74, 0x6c, 0x76, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x95, 0x03, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x30,
+	0x0a, 0x14, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x27, 0x0a, 0x0f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xf5, 0x09, 0x0a, 0x0e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x79, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x79, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x73, 0x5f, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x73, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x64, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x14, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0c, 0x62, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x2e, 0x42, 0x6d, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x62, 0x6d, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x5b, 0x0a, 0x12, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f,
+	0x62, 0x6d, 0x70, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0c,
+	0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x17, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x73,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x73, 0x5f, 0x75,
+	0x70, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x73, 0x55, 0x70,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x3e, 0x0a, 0x10, 0x42, 0x6d,
+	0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3e, 0x0a, 0x10, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x9a, 0x02, 0x0a, 0x10, 0x53, 0x72, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65,
+	0x72, 0x49, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69,
+	0x73, 0x68, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x69, 0x64, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xc7, 0x07,
+	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x03, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x04, 0x5f,
+	0x72, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x76, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x65, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x62, 0x67, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x42, 0x67, 0x70, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x29, 0x0a,
+	0x10, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x75, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x73, 0x70, 0x61, 0x74, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x14, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x75,
+	0x65, 0x41, 0x73, 0x70, 0x61, 0x74, 0x68, 0x12, 0x41, 0x0a, 0x1d, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x75, 0x65, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x73,
+	0x63, 0x6f, 0x6e, 0x66, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x69, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x75, 0x65, 0x41, 0x73, 0x63, 0x6f,
+	0x6e, 0x66, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x64, 0x73, 0x5f, 0x72, 0x69, 0x62, 0x5f,
+	0x69, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x64, 0x73, 0x52, 0x69, 0x62,
+	0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x69, 0x62, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x69, 0x62, 0x12,
+	0x2e, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x41, 0x73, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12,
+	0x30, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x41, 0x73, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f,
+	0x6f, 0x75, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x64, 0x6a,
+	0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x22, 0xbe, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72,
+	0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x65, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x05, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x62,
+	0x6d, 0x70, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4e, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x54,
+	0x4c, 0x56, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x6c, 0x76, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x54, 0x6c, 0x76,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x6c, 0x76, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x75, 0x62,
+	0x54, 0x6c, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb6, 0x03, 0x0a, 0x07, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49,
+	0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x65, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x61, 0x73, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72,
+	0x41, 0x73, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x52, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xd6, 0x09, 0x0a, 0x0d, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x04, 0x5f, 0x72, 0x65, 0x76, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x52, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x70,
+	0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x09, 0x62, 0x61, 0x73,
+	0x65, 0x41, 0x74, 0x74, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x72, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72,
+	0x52, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c,
+	0x65, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x4c, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x49, 0x70, 0x76, 0x34, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78,
+	0x74, 0x68, 0x6f, 0x70, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74,
+	0x68, 0x6f, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f,
+	0x70, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73,
+	0x4e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x49, 0x70, 0x76, 0x34, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x61, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61,
+	0x74, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x18,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x0a,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e, 0x50, 0x53, 0x69, 0x64, 0x52, 0x09, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x65,
+	0x6f, 0x72, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x45, 0x6f, 0x72, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x69, 0x64, 0x73, 0x18, 0x1b, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x72, 0x76, 0x36, 0x53, 0x69, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x11,
+	0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x69, 0x64,
+	0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x49, 0x44, 0x52, 0x0f, 0x73, 0x72, 0x76, 0x36,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x69, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x69,
+	0x73, 0x5f, 0x6c, 0x6c, 0x67, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x4c, 0x6c, 0x67, 0x72, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x12,
+	0x37, 0x0a, 0x19, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x69, 0x6e,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x49, 0x6e, 0x50, 0x6f,
+	0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x1a, 0x69, 0x73, 0x5f, 0x61,
+	0x64, 0x6a, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x73,
+	0x41, 0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6a, 0x5f, 0x72, 0x69,
+	0x62, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41,
+	0x64, 0x6a, 0x52, 0x69, 0x62, 0x4f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x69, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d,
+	0x0a, 0x13, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x72, 0x69, 0x62, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x4c,
+	0x6f, 0x63, 0x52, 0x69, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x69, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x69, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x62, 0x6d, 0x70, 0x5f, 0x74,
+	0x6c, 0x76, 0x73, 0x18, 0x25, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x62, 0x6d,
+	0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x4c,
+	0x56, 0x52, 0x07, 0x62, 0x6d, 0x70, 0x54, 0x6c, 0x76, 0x73, 0x22, 0x36, 0x0a, 0x06, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x62, 0x65, 0x7a, 0x76, 0x65, 0x72, 0x6b, 0x2f, 0x67, 0x6f, 0x62, 0x6d, 0x70, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gobmp_proto_rawDescOnce sync.Once
+	file_gobmp_proto_rawDescData = file_gobmp_proto_rawDesc
+)
+
+func file_gobmp_proto_rawDescGZIP() []byte {
+	file_gobmp_proto_rawDescOnce.Do(func() {
+		file_gobmp_proto_rawDescData = protoimpl.X.CompressGZIP(file_gobmp_proto_rawDescData)
+	})
+	return file_gobmp_proto_rawDescData
+}
+
+var file_gobmp_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
+var file_gobmp_proto_goTypes = []interface{}{
+	(*Anomaly)(nil),                 // 0: gobmp.Anomaly
+	(*AppSpecLinkAttr)(nil),         // 1: gobmp.AppSpecLinkAttr
+	(*BGPPeerNodeFlags)(nil),        // 2: gobmp.BGPPeerNodeFlags
+	(*BGPPeerNodeSID)(nil),          // 3: gobmp.BGPPeerNodeSID
+	(*BaseAttributes)(nil),          // 4: gobmp.BaseAttributes
+	(*CapabilityTLV)(nil),           // 5: gobmp.CapabilityTLV
+	(*Change)(nil),                  // 6: gobmp.Change
+	(*Diff)(nil),                    // 7: gobmp.Diff
+	(*ENLP)(nil),                    // 8: gobmp.ENLP
+	(*EVPNPrefix)(nil),              // 9: gobmp.EVPNPrefix
+	(*EgressSID)(nil),               // 10: gobmp.EgressSID
+	(*EndXSIDFlags)(nil),            // 11: gobmp.EndXSIDFlags
+	(*EndXSIDTLV)(nil),              // 12: gobmp.EndXSIDTLV
+	(*EndpointBehavior)(nil),        // 13: gobmp.EndpointBehavior
+	(*EventsEvent)(nil),             // 14: gobmp.EventsEvent
+	(*FADSubTLV)(nil),               // 15: gobmp.FADSubTLV
+	(*FADSubTLVFlags)(nil),          // 16: gobmp.FADSubTLVFlags
+	(*FlexAlgoDefinition)(nil),      // 17: gobmp.FlexAlgoDefinition
+	(*FlexAlgoPrefixMetric)(nil),    // 18: gobmp.FlexAlgoPrefixMetric
+	(*Flowspec)(nil),                // 19: gobmp.Flowspec
+	(*IGPAdjacency)(nil),            // 20: gobmp.IGPAdjacency
+	(*IGPFlags)(nil),                // 21: gobmp.IGPFlags
+	(*JSONMessage)(nil),             // 22: gobmp.JSONMessage
+	(*L2Service)(nil),               // 23: gobmp.L2Service
+	(*L3Service)(nil),               // 24: gobmp.L3Service
+	(*L3VPNPrefix)(nil),             // 25: gobmp.L3VPNPrefix
+	(*LANEndXSIDTLV)(nil),           // 26: gobmp.LANEndXSIDTLV
+	(*LSLink)(nil),                  // 27: gobmp.LSLink
+	(*LSNode)(nil),                  // 28: gobmp.LSNode
+	(*LSPrefix)(nil),                // 29: gobmp.LSPrefix
+	(*LSSRv6SID)(nil),               // 30: gobmp.LSSRv6SID
+	(*LabelIndexTLV)(nil),           // 31: gobmp.LabelIndexTLV
+	(*Link)(nil),                    // 32: gobmp.Link
+	(*LocalBlock)(nil),              // 33: gobmp.LocalBlock
+	(*LocalBlockTLV)(nil),           // 34: gobmp.LocalBlockTLV
+	(*LocatorFlags)(nil),            // 35: gobmp.LocatorFlags
+	(*LocatorTLV)(nil),              // 36: gobmp.LocatorTLV
+	(*MSDTV)(nil),                   // 37: gobmp.MSDTV
+	(*MVPN)(nil),                    // 38: gobmp.MVPN
+	(*MVPNRouteKey)(nil),            // 39: gobmp.MVPNRouteKey
+	(*MirroredBGPMessage)(nil),      // 40: gobmp.MirroredBGPMessage
+	(*MirroredMessage)(nil),         // 41: gobmp.MirroredMessage
+	(*MultiTopologyIdentifier)(nil), // 42: gobmp.MultiTopologyIdentifier
+	(*NodeAttrFlags)(nil),           // 43: gobmp.NodeAttrFlags
+	(*ORF)(nil),                     // 44: gobmp.ORF
+	(*ORFEntry)(nil),                // 45: gobmp.ORFEntry
+	(*OriginatorSRGBTLV)(nil),       // 46: gobmp.OriginatorSRGBTLV
+	(*PMSITunnel)(nil),              // 47: gobmp.PMSITunnel
+	(*PSid)(nil),                    // 48: gobmp.PSid
+	(*Peer)(nil),                    // 49: gobmp.Peer
+	(*PeerAvailability)(nil),        // 50: gobmp.PeerAvailability
+	(*PeerFlags)(nil),               // 51: gobmp.PeerFlags
+	(*PeerSID)(nil),                 // 52: gobmp.PeerSID
+	(*PeerStateChange)(nil),         // 53: gobmp.PeerStateChange
+	(*PeerStats)(nil),               // 54: gobmp.PeerStats
+	(*Preference)(nil),              // 55: gobmp.Preference
+	(*PrefixChurn)(nil),             // 56: gobmp.PrefixChurn
+	(*PrefixStats)(nil),             // 57: gobmp.PrefixStats
+	(*RawUpdate)(nil),               // 58: gobmp.RawUpdate
+	(*Record)(nil),                  // 59: gobmp.Record
+	(*Report)(nil),                  // 60: gobmp.Report
+	(*RouteMirror)(nil),             // 61: gobmp.RouteMirror
+	(*RouteMonitorTLV)(nil),         // 62: gobmp.RouteMonitorTLV
+	(*RouteRefresh)(nil),            // 63: gobmp.RouteRefresh
+	(*RouteStats)(nil),              // 64: gobmp.RouteStats
+	(*SIDStructure)(nil),            // 65: gobmp.SIDStructure
+	(*SRGB)(nil),                    // 66: gobmp.SRGB
+	(*SRPolicy)(nil),                // 67: gobmp.SRPolicy
+	(*SegmentList)(nil),             // 68: gobmp.SegmentList
+	(*ServiceSID)(nil),              // 69: gobmp.ServiceSID
+	(*SessionSummary)(nil),          // 70: gobmp.SessionSummary
+	(*SrvalidatorEvent)(nil),        // 71: gobmp.SrvalidatorEvent
+	(*Stats)(nil),                   // 72: gobmp.Stats
+	(*Storm)(nil),                   // 73: gobmp.Storm
+	(*SubTLV)(nil),                  // 74: gobmp.SubTLV
+	(*Summary)(nil),                 // 75: gobmp.Summary
+	(*UnicastPrefix)(nil),           // 76: gobmp.UnicastPrefix
+	(*Weight)(nil),                  // 77: gobmp.Weight
+	nil,                             // 78: gobmp.Flowspec.RulesEntry
+	nil,                             // 79: gobmp.PeerStateChange.AddPathEntry
+	nil,                             // 80: gobmp.SessionSummary.BmpMessagesEntry
+	nil,                             // 81: gobmp.SessionSummary.PublishedMessagesEntry
+	nil,                             // 82: gobmp.SessionSummary.ParseErrorsEntry
+	nil,                             // 83: gobmp.Storm.ReasonsEntry
+	nil,                             // 84: gobmp.Summary.MessagesEntry
+}
+var file_gobmp_proto_depIdxs = []int32{
+	74, // 0: gobmp.AppSpecLinkAttr.sub_tlvs:type_name -> gobmp.SubTLV
+	2,  // 1: gobmp.BGPPeerNodeSID.flags:type_name -> gobmp.BGPPeerNodeFlags
+	32, // 2: gobmp.Diff.added:type_name -> gobmp.Link
+	32, // 3: gobmp.Diff.removed:type_name -> gobmp.Link
+	4,  // 4: gobmp.EVPNPrefix.base_attrs:type_name -> gobmp.BaseAttributes
+	69, // 5: gobmp.EVPNPrefix.srv6_service_sids:type_name -> gobmp.ServiceSID
+	47, // 6: gobmp.EVPNPrefix.pmsi_tunnel:type_name -> gobmp.PMSITunnel
+	62, // 7: gobmp.EVPNPrefix.bmp_tlvs:type_name -> gobmp.RouteMonitorTLV
+	11, // 8: gobmp.EndXSIDTLV.flags:type_name -> gobmp.EndXSIDFlags
+	16, // 9: gobmp.FADSubTLV.flags:type_name -> gobmp.FADSubTLVFlags
+	15, // 10: gobmp.FlexAlgoDefinition.sub_tlv:type_name -> gobmp.FADSubTLV
+	4,  // 11: gobmp.Flowspec.base_attrs:type_name -> gobmp.BaseAttributes
+	78, // 12: gobmp.Flowspec.rules:type_name -> gobmp.Flowspec.RulesEntry
+	62, // 13: gobmp.Flowspec.bmp_tlvs:type_name -> gobmp.RouteMonitorTLV
+	42, // 14: gobmp.IGPAdjacency.mt_id_tlv:type_name -> gobmp.MultiTopologyIdentifier
+	4,  // 15: gobmp.L3VPNPrefix.base_attrs:type_name -> gobmp.BaseAttributes
+	48, // 16: gobmp.L3VPNPrefix.prefix_sid:type_name -> gobmp.PSid
+	69, // 17: gobmp.L3VPNPrefix.srv6_service_sids:type_name -> gobmp.ServiceSID
+	62, // 18: gobmp.L3VPNPrefix.bmp_tlvs:type_name -> gobmp.RouteMonitorTLV
+	11, // 19: gobmp.LANEndXSIDTLV.flags:type_name -> gobmp.EndXSIDFlags
+	42, // 20: gobmp.LSLink.mt_id_tlv:type_name -> gobmp.MultiTopologyIdentifier
+	52, // 21: gobmp.LSLink.peer_node_sid:type_name -> gobmp.PeerSID
+	52, // 22: gobmp.LSLink.peer_adj_sid:type_name -> gobmp.PeerSID
+	52, // 23: gobmp.LSLink.peer_set_sid:type_name -> gobmp.PeerSID
+	3,  // 24: gobmp.LSLink.srv6_bgp_peer_node_sid:type_name -> gobmp.BGPPeerNodeSID
+	12, // 25: gobmp.LSLink.srv6_endx_sid:type_name -> gobmp.EndXSIDTLV
+	26, // 26: gobmp.LSLink.srv6_lan_endx_sid:type_name -> gobmp.LANEndXSIDTLV
+	37, // 27: gobmp.LSLink.link_msd:type_name -> gobmp.MSDTV
+	1,  // 28: gobmp.LSLink.app_spec_link_attr:type_name -> gobmp.AppSpecLinkAttr
+	62, // 29: gobmp.LSLink.bmp_tlvs:type_name -> gobmp.RouteMonitorTLV
+	42, // 30: gobmp.LSNode.mt_id_tlv:type_name -> gobmp.MultiTopologyIdentifier
+	43, // 31: gobmp.LSNode.node_flags:type_name -> gobmp.NodeAttrFlags
+	33, // 32: gobmp.LSNode.sr_local_block:type_name -> gobmp.LocalBlock
+	5,  // 33: gobmp.LSNode.srv6_capabilities_tlv:type_name -> gobmp.CapabilityTLV
+	37, // 34: gobmp.LSNode.node_msd:type_name -> gobmp.MSDTV
+	17, // 35: gobmp.LSNode.flex_algo_definition:type_name -> gobmp.FlexAlgoDefinition
+	62, // 36: gobmp.LSNode.bmp_tlvs:type_name -> gobmp.RouteMonitorTLV
+	42, // 37: gobmp.LSPrefix.mt_id_tlv:type_name -> gobmp.MultiTopologyIdentifier
+	21, // 38: gobmp.LSPrefix.igp_flags:type_name -> gobmp.IGPFlags
+	18, // 39: gobmp.LSPrefix.flex_algo_prefix_metric:type_name -> gobmp.FlexAlgoPrefixMetric
+	36, // 40: gobmp.LSPrefix.srv6_locator:type_name -> gobmp.LocatorTLV
+	62, // 41: gobmp.LSPrefix.bmp_tlvs:type_name -> gobmp.RouteMonitorTLV
+	42, // 42: gobmp.LSSRv6SID.mt_id_tlv:type_name -> gobmp.MultiTopologyIdentifier
+	13, // 43: gobmp.LSSRv6SID.srv6_endpoint_behavior:type_name -> gobmp.EndpointBehavior
+	3,  // 44: gobmp.LSSRv6SID.srv6_bgp_peer_node_sid:type_name -> gobmp.BGPPeerNodeSID
+	65, // 45: gobmp.LSSRv6SID.srv6_sid_structure:type_name -> gobmp.SIDStructure
+	62, // 46: gobmp.LSSRv6SID.bmp_tlvs:type_name -> gobmp.RouteMonitorTLV
+	34, // 47: gobmp.LocalBlock.subranges:type_name -> gobmp.LocalBlockTLV
+	35, // 48: gobmp.LocatorTLV.flags:type_name -> gobmp.LocatorFlags
+	74, // 49: gobmp.LocatorTLV.sub_tlvs:type_name -> gobmp.SubTLV
+	4,  // 50: gobmp.MVPN.base_attrs:type_name -> gobmp.BaseAttributes
+	39, // 51: gobmp.MVPN.route_key:type_name -> gobmp.MVPNRouteKey
+	47, // 52: gobmp.MVPN.pmsi_tunnel:type_name -> gobmp.PMSITunnel
+	62, // 53: gobmp.MVPN.bmp_tlvs:type_name -> gobmp.RouteMonitorTLV
+	4,  // 54: gobmp.MirroredBGPMessage.base_attrs:type_name -> gobmp.BaseAttributes
+	44, // 55: gobmp.MirroredBGPMessage.orfs:type_name -> gobmp.ORF
+	4,  // 56: gobmp.MirroredMessage.base_attrs:type_name -> gobmp.BaseAttributes
+	44, // 57: gobmp.MirroredMessage.orfs:type_name -> gobmp.ORF
+	45, // 58: gobmp.ORF.entries:type_name -> gobmp.ORFEntry
+	66, // 59: gobmp.OriginatorSRGBTLV.srgb:type_name -> gobmp.SRGB
+	31, // 60: gobmp.PSid.label_index:type_name -> gobmp.LabelIndexTLV
+	46, // 61: gobmp.PSid.originator_srgb:type_name -> gobmp.OriginatorSRGBTLV
+	24, // 62: gobmp.PSid.srv6_l3_service:type_name -> gobmp.L3Service
+	23, // 63: gobmp.PSid.srv6_l2_service:type_name -> gobmp.L2Service
+	51, // 64: gobmp.PeerSID.flags:type_name -> gobmp.PeerFlags
+	79, // 65: gobmp.PeerStateChange.add_path:type_name -> gobmp.PeerStateChange.AddPathEntry
+	10, // 66: gobmp.Record.egress_sids:type_name -> gobmp.EgressSID
+	50, // 67: gobmp.Report.peers:type_name -> gobmp.PeerAvailability
+	56, // 68: gobmp.Report.top_churn_prefixes:type_name -> gobmp.PrefixChurn
+	40, // 69: gobmp.RouteMirror.messages:type_name -> gobmp.MirroredBGPMessage
+	44, // 70: gobmp.RouteRefresh.orfs:type_name -> gobmp.ORF
+	54, // 71: gobmp.RouteStats.peers:type_name -> gobmp.PeerStats
+	57, // 72: gobmp.RouteStats.top_flap_prefixes:type_name -> gobmp.PrefixStats
+	4,  // 73: gobmp.SRPolicy.base_attrs:type_name -> gobmp.BaseAttributes
+	55, // 74: gobmp.SRPolicy.preference_subtlv:type_name -> gobmp.Preference
+	8,  // 75: gobmp.SRPolicy.enlp_subtlv:type_name -> gobmp.ENLP
+	68, // 76: gobmp.SRPolicy.segment_list_subtlv:type_name -> gobmp.SegmentList
+	62, // 77: gobmp.SRPolicy.bmp_tlvs:type_name -> gobmp.RouteMonitorTLV
+	77, // 78: gobmp.SegmentList.weight_subtlv:type_name -> gobmp.Weight
+	80, // 79: gobmp.SessionSummary.bmp_messages:type_name -> gobmp.SessionSummary.BmpMessagesEntry
+	81, // 80: gobmp.SessionSummary.published_messages:type_name -> gobmp.SessionSummary.PublishedMessagesEntry
+	82, // 81: gobmp.SessionSummary.parse_errors:type_name -> gobmp.SessionSummary.ParseErrorsEntry
+	83, // 82: gobmp.Storm.reasons:type_name -> gobmp.Storm.ReasonsEntry
+	49, // 83: gobmp.Storm.peers:type_name -> gobmp.Peer
+	84, // 84: gobmp.Summary.messages:type_name -> gobmp.Summary.MessagesEntry
+	4,  // 85: gobmp.UnicastPrefix.base_attrs:type_name -> gobmp.BaseAttributes
+	48, // 86: gobmp.UnicastPrefix.prefix_sid:type_name -> gobmp.PSid
+	69, // 87: gobmp.UnicastPrefix.srv6_service_sids:type_name -> gobmp.ServiceSID
+	62, // 88: gobmp.UnicastPrefix.bmp_tlvs:type_name -> gobmp.RouteMonitorTLV
+	89, // [89:89] is the sub-list for method output_type
+	89, // [89:89] is the sub-list for method input_type
+	89, // [89:89] is the sub-list for extension type_name
+	89, // [89:89] is the sub-list for extension extendee
+	0,  // [0:89] is the sub-list for field type_name
+}
+
+func init() { file_gobmp_proto_init() }
+func file_gobmp_proto_init() {
+	if File_gobmp_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gobmp_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Anomaly); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppSpecLinkAttr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BGPPeerNodeFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BGPPeerNodeSID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BaseAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapabilityTLV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Change); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Diff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ENLP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVPNPrefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressSID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndXSIDFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndXSIDTLV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndpointBehavior); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventsEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FADSubTLV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FADSubTLVFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlexAlgoDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlexAlgoPrefixMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Flowspec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IGPAdjacency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IGPFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L2Service); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L3Service); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L3VPNPrefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LANEndXSIDTLV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LSLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LSNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LSPrefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LSSRv6SID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelIndexTLV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Link); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalBlockTLV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocatorFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocatorTLV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MSDTV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MVPN); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MVPNRouteKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MirroredBGPMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MirroredMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiTopologyIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeAttrFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ORF); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ORFEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OriginatorSRGBTLV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PMSITunnel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PSid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Peer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerAvailability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerSID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerStateChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Preference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefixChurn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefixStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Record); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteMirror); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteMonitorTLV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteRefresh); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SIDStructure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRGB); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceSID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrvalidatorEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Storm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubTLV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Summary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnicastPrefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gobmp_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Weight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gobmp_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   85,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gobmp_proto_goTypes,
+		DependencyIndexes: file_gobmp_proto_depIdxs,
+		MessageInfos:      file_gobmp_proto_msgTypes,
+	}.Build()
+	File_gobmp_proto = out.File
+	file_gobmp_proto_rawDesc = nil
+	file_gobmp_proto_goTypes = nil
+	file_gobmp_proto_depIdxs = nil
+}
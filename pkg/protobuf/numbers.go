@@ -0,0 +1,1146 @@
+// Code generated by gen.go from Go types of message types; DO NOT EDIT.
+
+package protobuf
+
+// fieldNumbers pins numbers of fields keyed by message name and field name, numbers of fields removed from Go
+// types stay pinned so they are reserved and never reused
+var fieldNumbers = map[string]map[string]int32{
+	"Anomaly": {
+		"action":             1,
+		"type":               2,
+		"router_ip":          3,
+		"peer_ip":            4,
+		"peer_asn":           5,
+		"prefix":             6,
+		"prefix_len":         7,
+		"origin_as":          8,
+		"baseline_prefix":    9,
+		"expected_origin_as": 10,
+		"timestamp":          11,
+	},
+	"AppSpecLinkAttr": {
+		"saibm_length":              1,
+		"udaibm_length":             2,
+		"std_app_id_bit_mask":       3,
+		"ud_app_id_bit_mask":        4,
+		"sub_tlvs":                  5,
+		"applications":              6,
+		"user_defined_applications": 7,
+		"all_applications":          8,
+		"admin_group":               9,
+		"extended_admin_group":      10,
+		"te_default_metric":         11,
+		"srlg":                      12,
+		"unidir_link_delay":         13,
+		"unidir_link_delay_min_max": 14,
+		"unidir_delay_variation":    15,
+		"unidir_packet_loss":        16,
+		"unidir_residual_bw":        17,
+		"unidir_available_bw":       18,
+		"unidir_bw_utilization":     19,
+	},
+	"BGPPeerNodeFlags": {
+		"b_flag": 1,
+		"s_flag": 2,
+		"p_flag": 3,
+	},
+	"BGPPeerNodeSID": {
+		"flags":       1,
+		"weight":      2,
+		"peer_asn":    3,
+		"peer_id":     4,
+		"peer_bgp_id": 5,
+	},
+	"BaseAttributes": {
+		"base_attr_hash":       1,
+		"origin":               2,
+		"as_path":              3,
+		"as_path_count":        4,
+		"nexthop":              5,
+		"med":                  6,
+		"local_pref":           7,
+		"is_atomic_agg":        8,
+		"aggregator":           9,
+		"community_list":       10,
+		"originator_id":        11,
+		"cluster_list":         12,
+		"ext_community_list":   13,
+		"as4_path":             14,
+		"as4_path_count":       15,
+		"as4_aggregator":       16,
+		"large_community_list": 17,
+		"otc":                  18,
+	},
+	"CapabilityTLV": {
+		"o_flag": 1,
+	},
+	"Change": {
+		"router_ip":   1,
+		"router_hash": 2,
+		"peer_ip":     3,
+		"peer_hash":   4,
+		"peer_asn":    5,
+		"peer_rd":     6,
+		"mode":        7,
+		"rate":        8,
+		"threshold":   9,
+		"timestamp":   10,
+	},
+	"Diff": {
+		"timestamp": 1,
+		"added":     2,
+		"removed":   3,
+	},
+	"ENLP": {
+		"flags": 1,
+		"enlp":  2,
+	},
+	"EVPNPrefix": {
+		"_key":                       1,
+		"_id":                        2,
+		"_rev":                       3,
+		"action":                     4,
+		"sequence":                   5,
+		"hash":                       6,
+		"router_hash":                7,
+		"router_ip":                  8,
+		"base_attrs":                 9,
+		"peer_hash":                  10,
+		"remote_bgp_id":              11,
+		"peer_ip":                    12,
+		"peer_type":                  13,
+		"peer_rd":                    14,
+		"peer_asn":                   15,
+		"timestamp":                  16,
+		"collector_timestamp":        17,
+		"is_ipv4":                    18,
+		"origin_as":                  19,
+		"nexthop":                    20,
+		"cluster_list":               21,
+		"is_nexthop_ipv4":            22,
+		"path_id":                    23,
+		"labels":                     24,
+		"rawlabels":                  25,
+		"vpn_rd":                     26,
+		"vpn_rd_type":                27,
+		"eth_segment_id":             28,
+		"eth_tag":                    29,
+		"ip_address":                 30,
+		"ip_len":                     31,
+		"gw_address":                 32,
+		"mac":                        33,
+		"mac_len":                    34,
+		"route_type":                 35,
+		"srv6_sids":                  36,
+		"srv6_service_sids":          37,
+		"router_mac":                 38,
+		"overlay_index":              39,
+		"mcast_src":                  40,
+		"mcast_grp":                  41,
+		"originator_router":          42,
+		"mcast_flags":                43,
+		"max_response_time":          44,
+		"pmsi_tunnel":                45,
+		"is_adj_rib_in_post_policy":  46,
+		"is_adj_rib_out_post_policy": 47,
+		"is_adj_rib_out":             48,
+		"is_post_policy":             49,
+		"is_loc_rib_filtered":        50,
+		"rib_type":                   51,
+		"table_name":                 52,
+		"bmp_tlvs":                   53,
+	},
+	"EgressSID": {
+		"type":          1,
+		"sid":           2,
+		"weight":        3,
+		"local_ip":      4,
+		"bgp_router_id": 5,
+		"router_ip":     6,
+	},
+	"EndXSIDFlags": {
+		"b_flag": 1,
+		"s_flag": 2,
+		"p_flag": 3,
+	},
+	"EndXSIDTLV": {
+		"type":              1,
+		"length":            2,
+		"endpoint_behavior": 3,
+		"flags":             4,
+		"algorithm":         5,
+		"weight":            6,
+		"sid":               7,
+		"sub_tlvs":          8,
+	},
+	"EndpointBehavior": {
+		"endpoint_behavior": 1,
+		"flag":              2,
+		"algo":              3,
+	},
+	"EventsEvent": {
+		"code":       1,
+		"category":   2,
+		"severity":   3,
+		"message":    4,
+		"action":     5,
+		"router_ip":  6,
+		"count":      7,
+		"first_seen": 8,
+		"last_seen":  9,
+		"timestamp":  10,
+	},
+	"FADSubTLV": {
+		"exclude_any":  1,
+		"include_any":  2,
+		"include_all":  3,
+		"flags":        4,
+		"exclude_srlg": 5,
+	},
+	"FADSubTLVFlags": {
+		"m_flag": 1,
+	},
+	"FlexAlgoDefinition": {
+		"flex_algo":        1,
+		"metric_type":      2,
+		"calculation_type": 3,
+		"priority":         4,
+		"sub_tlv":          5,
+	},
+	"FlexAlgoPrefixMetric": {
+		"flex_algo": 1,
+		"metric":    2,
+	},
+	"Flowspec": {
+		"_key":                       1,
+		"_id":                        2,
+		"_rev":                       3,
+		"action":                     4,
+		"sequence":                   5,
+		"router_ip":                  6,
+		"base_attrs":                 7,
+		"peer_ip":                    8,
+		"peer_type":                  9,
+		"peer_rd":                    10,
+		"peer_asn":                   11,
+		"timestamp":                  12,
+		"collector_timestamp":        13,
+		"is_ipv4":                    14,
+		"origin_as":                  15,
+		"nexthop":                    16,
+		"is_nexthop_ipv4":            17,
+		"path_id":                    18,
+		"vpn_rd":                     19,
+		"spec_hash":                  20,
+		"spec":                       21,
+		"rules":                      22,
+		"is_adj_rib_in_post_policy":  23,
+		"is_adj_rib_out_post_policy": 24,
+		"is_adj_rib_out":             25,
+		"is_post_policy":             26,
+		"is_loc_rib_filtered":        27,
+		"rib_type":                   28,
+		"table_name":                 29,
+		"bmp_tlvs":                   30,
+	},
+	"IGPAdjacency": {
+		"action":               1,
+		"router_hash":          2,
+		"router_ip":            3,
+		"domain_id":            4,
+		"peer_hash":            5,
+		"peer_ip":              6,
+		"peer_asn":             7,
+		"timestamp":            8,
+		"collector_timestamp":  9,
+		"up_since":             10,
+		"protocol":             11,
+		"protocol_id":          12,
+		"area_id":              13,
+		"mt_id_tlv":            14,
+		"local_node_hash":      15,
+		"remote_node_hash":     16,
+		"local_node_name":      17,
+		"remote_node_name":     18,
+		"igp_router_id":        19,
+		"remote_igp_router_id": 20,
+		"local_link_ip":        21,
+		"remote_link_ip":       22,
+		"local_link_id":        23,
+		"remote_link_id":       24,
+		"reason":               25,
+	},
+	"IGPFlags": {
+		"d_flag": 1,
+		"n_flag": 2,
+		"l_flag": 3,
+		"p_flag": 4,
+	},
+	"L2Service": {
+		"sub_tlvs": 1,
+	},
+	"L3Service": {
+		"sub_tlvs": 1,
+	},
+	"L3VPNPrefix": {
+		"_key":                       1,
+		"_id":                        2,
+		"_rev":                       3,
+		"action":                     4,
+		"sequence":                   5,
+		"hash":                       6,
+		"router_hash":                7,
+		"router_ip":                  8,
+		"base_attrs":                 9,
+		"peer_hash":                  10,
+		"peer_ip":                    11,
+		"peer_type":                  12,
+		"peer_rd":                    13,
+		"peer_asn":                   14,
+		"timestamp":                  15,
+		"collector_timestamp":        16,
+		"prefix":                     17,
+		"prefix_len":                 18,
+		"is_ipv4":                    19,
+		"origin_as":                  20,
+		"nexthop":                    21,
+		"cluster_list":               22,
+		"is_nexthop_ipv4":            23,
+		"path_id":                    24,
+		"labels":                     25,
+		"vpn_rd":                     26,
+		"vpn_rd_type":                27,
+		"prefix_sid":                 28,
+		"srv6_sids":                  29,
+		"srv6_service_sids":          30,
+		"is_llgr_stale":              31,
+		"is_adj_rib_in_post_policy":  32,
+		"is_adj_rib_out_post_policy": 33,
+		"is_adj_rib_out":             34,
+		"is_post_policy":             35,
+		"is_loc_rib_filtered":        36,
+		"rib_type":                   37,
+		"table_name":                 38,
+		"bmp_tlvs":                   39,
+	},
+	"LANEndXSIDTLV": {
+		"type":              1,
+		"length":            2,
+		"endpoint_behavior": 3,
+		"flags":             4,
+		"algorithm":         5,
+		"weight":            6,
+		"neighbor_id":       7,
+		"sid":               8,
+		"sub_tlvs":          9,
+	},
+	"LSLink": {
+		"_key":                       1,
+		"_id":                        2,
+		"_rev":                       3,
+		"action":                     4,
+		"sequence":                   5,
+		"hash":                       6,
+		"router_hash":                7,
+		"router_ip":                  8,
+		"domain_id":                  9,
+		"peer_hash":                  10,
+		"peer_ip":                    11,
+		"peer_type":                  12,
+		"peer_rd":                    13,
+		"peer_asn":                   14,
+		"timestamp":                  15,
+		"collector_timestamp":        16,
+		"igp_router_id":              17,
+		"router_id":                  18,
+		"ls_id":                      19,
+		"protocol":                   20,
+		"protocol_id":                21,
+		"area_id":                    22,
+		"nexthop":                    23,
+		"mt_id_tlv":                  24,
+		"local_link_id":              25,
+		"remote_link_id":             26,
+		"local_link_ip":              27,
+		"remote_link_ip":             28,
+		"igp_metric":                 29,
+		"metric_type":                30,
+		"admin_group":                31,
+		"max_link_bw":                32,
+		"max_resv_bw":                33,
+		"unresv_bw":                  34,
+		"max_link_bw_kbps":           35,
+		"max_resv_bw_kbps":           36,
+		"unresv_bw_kbps":             37,
+		"te_default_metric":          38,
+		"link_protection":            39,
+		"mpls_proto_mask":            40,
+		"srlg":                       41,
+		"link_name":                  42,
+		"remote_node_hash":           43,
+		"local_node_hash":            44,
+		"local_node_name":            45,
+		"remote_node_name":           46,
+		"remote_igp_router_id":       47,
+		"remote_router_id":           48,
+		"local_node_asn":             49,
+		"remote_node_asn":            50,
+		"bgp_router_id":              51,
+		"bgp_remote_router_id":       52,
+		"member_as":                  53,
+		"peer_node_sid":              54,
+		"peer_adj_sid":               55,
+		"peer_set_sid":               56,
+		"srv6_bgp_peer_node_sid":     57,
+		"srv6_endx_sid":              58,
+		"srv6_lan_endx_sid":          59,
+		"ls_adjacency_sid":           60,
+		"link_msd":                   61,
+		"app_spec_link_attr":         62,
+		"unidir_link_delay":          63,
+		"unidir_link_delay_min_max":  64,
+		"unidir_delay_variation":     65,
+		"unidir_packet_loss":         66,
+		"unidir_residual_bw":         67,
+		"unidir_available_bw":        68,
+		"unidir_bw_utilization":      69,
+		"is_adj_rib_in_post_policy":  70,
+		"is_adj_rib_out_post_policy": 71,
+		"is_adj_rib_out":             72,
+		"is_post_policy":             73,
+		"is_loc_rib_filtered":        74,
+		"rib_type":                   75,
+		"table_name":                 76,
+		"bmp_tlvs":                   77,
+	},
+	"LSNode": {
+		"_key":                       1,
+		"_id":                        2,
+		"_rev":                       3,
+		"action":                     4,
+		"sequence":                   5,
+		"hash":                       6,
+		"router_hash":                7,
+		"domain_id":                  8,
+		"router_ip":                  9,
+		"peer_hash":                  10,
+		"peer_ip":                    11,
+		"peer_type":                  12,
+		"peer_rd":                    13,
+		"peer_asn":                   14,
+		"timestamp":                  15,
+		"collector_timestamp":        16,
+		"igp_router_id":              17,
+		"router_id":                  18,
+		"asn":                        19,
+		"ls_id":                      20,
+		"mt_id_tlv":                  21,
+		"area_id":                    22,
+		"protocol":                   23,
+		"protocol_id":                24,
+		"node_flags":                 25,
+		"name":                       26,
+		"ls_sr_capabilities":         27,
+		"sr_algorithm":               28,
+		"sr_local_block":             29,
+		"srv6_capabilities_tlv":      30,
+		"node_msd":                   31,
+		"flex_algo_definition":       32,
+		"is_adj_rib_in_post_policy":  33,
+		"is_adj_rib_out_post_policy": 34,
+		"is_adj_rib_out":             35,
+		"is_post_policy":             36,
+		"is_loc_rib_filtered":        37,
+		"rib_type":                   38,
+		"table_name":                 39,
+		"bmp_tlvs":                   40,
+	},
+	"LSPrefix": {
+		"_key":                       1,
+		"_id":                        2,
+		"_rev":                       3,
+		"action":                     4,
+		"sequence":                   5,
+		"hash":                       6,
+		"router_hash":                7,
+		"router_ip":                  8,
+		"domain_id":                  9,
+		"peer_hash":                  10,
+		"peer_ip":                    11,
+		"peer_type":                  12,
+		"peer_rd":                    13,
+		"peer_asn":                   14,
+		"timestamp":                  15,
+		"collector_timestamp":        16,
+		"igp_router_id":              17,
+		"router_id":                  18,
+		"ls_id":                      19,
+		"protocol_id":                20,
+		"protocol":                   21,
+		"area_id":                    22,
+		"nexthop":                    23,
+		"local_node_hash":            24,
+		"local_node_name":            25,
+		"mt_id_tlv":                  26,
+		"ospf_route_type":            27,
+		"igp_flags":                  28,
+		"route_tag":                  29,
+		"ext_route_tag":              30,
+		"ospf_fwd_addr":              31,
+		"prefix":                     32,
+		"prefix_len":                 33,
+		"prefix_metric":              34,
+		"metric_type":                35,
+		"prefix_attr_tlvs":           36,
+		"flex_algo_prefix_metric":    37,
+		"srv6_locator":               38,
+		"is_adj_rib_in_post_policy":  39,
+		"is_adj_rib_out_post_policy": 40,
+		"is_adj_rib_out":             41,
+		"is_post_policy":             42,
+		"is_loc_rib_filtered":        43,
+		"rib_type":                   44,
+		"table_name":                 45,
+		"bmp_tlvs":                   46,
+	},
+	"LSSRv6SID": {
+		"_key":                       1,
+		"_id":                        2,
+		"_rev":                       3,
+		"action":                     4,
+		"sequence":                   5,
+		"hash":                       6,
+		"router_hash":                7,
+		"router_ip":                  8,
+		"domain_id":                  9,
+		"peer_hash":                  10,
+		"peer_ip":                    11,
+		"peer_type":                  12,
+		"peer_rd":                    13,
+		"peer_asn":                   14,
+		"timestamp":                  15,
+		"collector_timestamp":        16,
+		"igp_router_id":              17,
+		"local_node_asn":             18,
+		"router_id":                  19,
+		"ls_id":                      20,
+		"area_id":                    21,
+		"protocol_id":                22,
+		"protocol":                   23,
+		"nexthop":                    24,
+		"local_node_hash":            25,
+		"local_node_name":            26,
+		"mt_id_tlv":                  27,
+		"igp_flags":                  28,
+		"route_tag":                  29,
+		"ext_route_tag":              30,
+		"ospf_fwd_addr":              31,
+		"igp_metric":                 32,
+		"prefix":                     33,
+		"prefix_len":                 34,
+		"srv6_sid":                   35,
+		"srv6_endpoint_behavior":     36,
+		"srv6_bgp_peer_node_sid":     37,
+		"srv6_sid_structure":         38,
+		"is_adj_rib_in_post_policy":  39,
+		"is_adj_rib_out_post_policy": 40,
+		"is_adj_rib_out":             41,
+		"is_post_policy":             42,
+		"is_loc_rib_filtered":        43,
+		"rib_type":                   44,
+		"table_name":                 45,
+		"bmp_tlvs":                   46,
+	},
+	"LabelIndexTLV": {
+		"flags":      1,
+		"last_index": 2,
+	},
+	"Link": {
+		"as1":     1,
+		"as2":     2,
+		"paths":   3,
+		"routers": 4,
+	},
+	"LocalBlock": {
+		"flags":     1,
+		"subranges": 2,
+	},
+	"LocalBlockTLV": {
+		"range_size": 1,
+		"label":      2,
+		"index":      3,
+	},
+	"LocatorFlags": {
+		"d_flag": 1,
+	},
+	"LocatorTLV": {
+		"flags":    1,
+		"algo":     2,
+		"metric":   3,
+		"sub_tlvs": 4,
+	},
+	"MSDTV": {
+		"msd_type":  1,
+		"msd_value": 2,
+	},
+	"MVPN": {
+		"action":                     1,
+		"router_hash":                2,
+		"router_ip":                  3,
+		"base_attrs":                 4,
+		"peer_hash":                  5,
+		"peer_ip":                    6,
+		"peer_type":                  7,
+		"peer_rd":                    8,
+		"peer_asn":                   9,
+		"timestamp":                  10,
+		"collector_timestamp":        11,
+		"is_ipv4":                    12,
+		"origin_as":                  13,
+		"nexthop":                    14,
+		"is_nexthop_ipv4":            15,
+		"path_id":                    16,
+		"route_type":                 17,
+		"route_type_name":            18,
+		"vpn_rd":                     19,
+		"source_as":                  20,
+		"mcast_src":                  21,
+		"mcast_grp":                  22,
+		"originator_router":          23,
+		"route_key":                  24,
+		"pmsi_tunnel":                25,
+		"is_adj_rib_in_post_policy":  26,
+		"is_adj_rib_out_post_policy": 27,
+		"is_adj_rib_out":             28,
+		"is_post_policy":             29,
+		"is_loc_rib_filtered":        30,
+		"rib_type":                   31,
+		"table_name":                 32,
+		"bmp_tlvs":                   33,
+	},
+	"MVPNRouteKey": {
+		"route_type":        1,
+		"route_type_name":   2,
+		"vpn_rd":            3,
+		"mcast_src":         4,
+		"mcast_grp":         5,
+		"originator_router": 6,
+	},
+	"MirroredBGPMessage": {
+		"bgp_type":         1,
+		"length":           2,
+		"version":          3,
+		"my_asn":           4,
+		"hold_time":        5,
+		"bgp_id":           6,
+		"capabilities":     7,
+		"base_attrs":       8,
+		"nlri":             9,
+		"withdrawn_routes": 10,
+		"mp_afi_safi":      11,
+		"error_code":       12,
+		"error_sub_code":   13,
+		"error":            14,
+		"data":             15,
+		"afi":              16,
+		"safi":             17,
+		"subtype":          18,
+		"orfs":             19,
+		"pdu":              20,
+		"decode_error":     21,
+	},
+	"MirroredMessage": {
+		"router_hash":         1,
+		"router_ip":           2,
+		"peer_hash":           3,
+		"peer_ip":             4,
+		"peer_type":           5,
+		"peer_rd":             6,
+		"peer_asn":            7,
+		"timestamp":           8,
+		"collector_timestamp": 9,
+		"bgp_type":            10,
+		"length":              11,
+		"version":             12,
+		"my_asn":              13,
+		"hold_time":           14,
+		"bgp_id":              15,
+		"capabilities":        16,
+		"base_attrs":          17,
+		"nlri":                18,
+		"withdrawn_routes":    19,
+		"mp_afi_safi":         20,
+		"error_code":          21,
+		"error_sub_code":      22,
+		"error":               23,
+		"data":                24,
+		"afi":                 25,
+		"safi":                26,
+		"subtype":             27,
+		"orfs":                28,
+		"pdu":                 29,
+		"decode_error":        30,
+		"is_adj_rib_out":      31,
+		"is_post_policy":      32,
+		"rib_type":            33,
+	},
+	"MultiTopologyIdentifier": {
+		"o_flag": 1,
+		"a_flag": 2,
+		"mt_id":  3,
+	},
+	"NodeAttrFlags": {
+		"o_flag": 1,
+		"t_flag": 2,
+		"e_flag": 3,
+		"b_flag": 4,
+		"r_flag": 5,
+		"v_flag": 6,
+	},
+	"ORF": {
+		"when_to_refresh": 1,
+		"orf_type":        2,
+		"entries":         3,
+	},
+	"ORFEntry": {
+		"action":     1,
+		"match":      2,
+		"sequence":   3,
+		"min_len":    4,
+		"max_len":    5,
+		"prefix":     6,
+		"prefix_len": 7,
+		"value":      8,
+	},
+	"OriginatorSRGBTLV": {
+		"flags": 1,
+		"srgb":  2,
+	},
+	"PMSITunnel": {
+		"flags":              1,
+		"leaf_info_required": 2,
+		"tunnel_type":        3,
+		"tunnel_type_name":   4,
+		"label":              5,
+		"vni":                6,
+		"tunnel_id":          7,
+		"tunnel_endpoint":    8,
+		"sender":             9,
+		"p_group":            10,
+		"root_node":          11,
+		"opaque":             12,
+		"extended_tunnel_id": 13,
+		"rsvp_tunnel_id":     14,
+		"p2mp_id":            15,
+		"source_pe":          16,
+		"local_number":       17,
+		"sub_domain_id":      18,
+		"bfr_id":             19,
+		"bfr_prefix":         20,
+	},
+	"PSid": {
+		"label_index":     1,
+		"originator_srgb": 2,
+		"srv6_l3_service": 3,
+		"srv6_l2_service": 4,
+	},
+	"Peer": {
+		"peer_ip":    1,
+		"peer_asn":   2,
+		"peer_rd":    3,
+		"bmp_reason": 4,
+		"timestamp":  5,
+	},
+	"PeerAvailability": {
+		"router_ip":    1,
+		"peer_ip":      2,
+		"peer_rd":      3,
+		"peer_asn":     4,
+		"state":        5,
+		"up_seconds":   6,
+		"availability": 7,
+		"flaps":        8,
+	},
+	"PeerFlags": {
+		"v_flag": 1,
+		"l_flag": 2,
+		"b_flag": 3,
+		"p_flag": 4,
+	},
+	"PeerSID": {
+		"flags":  1,
+		"weight": 2,
+		"sid":    3,
+	},
+	"PeerStateChange": {
+		"_key":                       1,
+		"_id":                        2,
+		"_rev":                       3,
+		"action":                     4,
+		"sequence":                   5,
+		"hash":                       6,
+		"router_hash":                7,
+		"name":                       8,
+		"remote_bgp_id":              9,
+		"router_ip":                  10,
+		"timestamp":                  11,
+		"collector_timestamp":        12,
+		"remote_asn":                 13,
+		"remote_ip":                  14,
+		"peer_type":                  15,
+		"peer_rd":                    16,
+		"remote_port":                17,
+		"local_asn":                  18,
+		"local_ip":                   19,
+		"local_port":                 20,
+		"local_bgp_id":               21,
+		"info_data":                  22,
+		"adv_cap":                    23,
+		"recv_cap":                   24,
+		"cap_mismatch":               25,
+		"remote_holddown":            26,
+		"adv_holddown":               27,
+		"bmp_reason":                 28,
+		"bmp_error_code":             29,
+		"bmp_error_sub_code":         30,
+		"error_text":                 31,
+		"is_l":                       32,
+		"is_prepolicy":               33,
+		"is_ipv4":                    34,
+		"table_name":                 35,
+		"is_adj_rib_in_post_policy":  36,
+		"is_adj_rib_out_post_policy": 37,
+		"is_adj_rib_out":             38,
+		"is_post_policy":             39,
+		"is_loc_rib_filtered":        40,
+		"rib_type":                   41,
+		"add_path":                   42,
+		"extended_message":           43,
+		"local_role":                 44,
+		"remote_role":                45,
+		"role_mismatch":              46,
+	},
+	"PeerStats": {
+		"router_ip":         1,
+		"peer_ip":           2,
+		"peer_rd":           3,
+		"peer_asn":          4,
+		"announcements":     5,
+		"withdrawals":       6,
+		"flaps":             7,
+		"flapping_prefixes": 8,
+		"peer_downs":        9,
+	},
+	"Preference": {
+		"flags":      1,
+		"preference": 2,
+	},
+	"PrefixChurn": {
+		"prefix":        1,
+		"vpn_rd":        2,
+		"announcements": 3,
+		"withdrawals":   4,
+	},
+	"PrefixStats": {
+		"router_ip":     1,
+		"peer_ip":       2,
+		"peer_rd":       3,
+		"prefix":        4,
+		"vpn_rd":        5,
+		"path_id":       6,
+		"announcements": 7,
+		"withdrawals":   8,
+		"flaps":         9,
+	},
+	"RawUpdate": {
+		"router_hash":         1,
+		"router_ip":           2,
+		"peer_hash":           3,
+		"peer_ip":             4,
+		"peer_type":           5,
+		"peer_rd":             6,
+		"peer_asn":            7,
+		"timestamp":           8,
+		"collector_timestamp": 9,
+		"rib_type":            10,
+		"table_name":          11,
+		"pdu":                 12,
+	},
+	"Record": {
+		"action":      1,
+		"router_ip":   2,
+		"peer_ip":     3,
+		"peer_asn":    4,
+		"prefix":      5,
+		"prefix_len":  6,
+		"path_id":     7,
+		"nexthop":     8,
+		"is_ipv4":     9,
+		"egress_sids": 10,
+		"timestamp":   11,
+	},
+	"Report": {
+		"start":              1,
+		"end":                2,
+		"peers":              3,
+		"top_churn_prefixes": 4,
+		"partial":            5,
+	},
+	"RouteMirror": {
+		"router_hash":         1,
+		"router_ip":           2,
+		"peer_hash":           3,
+		"peer_ip":             4,
+		"peer_type":           5,
+		"peer_rd":             6,
+		"peer_asn":            7,
+		"timestamp":           8,
+		"collector_timestamp": 9,
+		"information":         10,
+		"messages":            11,
+		"is_adj_rib_out":      12,
+		"is_post_policy":      13,
+		"rib_type":            14,
+	},
+	"RouteMonitorTLV": {
+		"type":  1,
+		"pen":   2,
+		"value": 3,
+	},
+	"RouteRefresh": {
+		"router_hash":         1,
+		"router_ip":           2,
+		"peer_hash":           3,
+		"peer_ip":             4,
+		"peer_type":           5,
+		"peer_rd":             6,
+		"peer_asn":            7,
+		"timestamp":           8,
+		"collector_timestamp": 9,
+		"afi":                 10,
+		"safi":                11,
+		"subtype":             12,
+		"orfs":                13,
+		"source":              14,
+		"is_adj_rib_out":      15,
+		"is_post_policy":      16,
+		"rib_type":            17,
+	},
+	"RouteStats": {
+		"start":             1,
+		"end":               2,
+		"peers":             3,
+		"top_flap_prefixes": 4,
+	},
+	"SIDStructure": {
+		"type":                 1,
+		"length":               2,
+		"locator_block_length": 3,
+		"locator_node_length":  4,
+		"function_length":      5,
+		"argument_length":      6,
+	},
+	"SRGB": {
+		"first":  1,
+		"number": 2,
+	},
+	"SRPolicy": {
+		"_key":                       1,
+		"_id":                        2,
+		"_rev":                       3,
+		"action":                     4,
+		"sequence":                   5,
+		"hash":                       6,
+		"router_hash":                7,
+		"router_ip":                  8,
+		"base_attrs":                 9,
+		"peer_hash":                  10,
+		"peer_ip":                    11,
+		"peer_type":                  12,
+		"peer_rd":                    13,
+		"peer_asn":                   14,
+		"timestamp":                  15,
+		"collector_timestamp":        16,
+		"is_ipv4":                    17,
+		"origin_as":                  18,
+		"nexthop":                    19,
+		"cluster_list":               20,
+		"is_nexthop_ipv4":            21,
+		"path_id":                    22,
+		"labels":                     23,
+		"distinguisher":              24,
+		"color":                      25,
+		"endpoint":                   26,
+		"policy_name":                27,
+		"binding_sid":                28,
+		"preference_subtlv":          29,
+		"priority_subtlv":            30,
+		"policy_path_name":           31,
+		"enlp_subtlv":                32,
+		"segment_list_subtlv":        33,
+		"is_adj_rib_in_post_policy":  34,
+		"is_adj_rib_out_post_policy": 35,
+		"is_adj_rib_out":             36,
+		"is_post_policy":             37,
+		"is_loc_rib_filtered":        38,
+		"rib_type":                   39,
+		"table_name":                 40,
+		"bmp_tlvs":                   41,
+	},
+	"SegmentList": {
+		"weight_subtlv": 1,
+		"segments":      2,
+	},
+	"ServiceSID": {
+		"service":              1,
+		"sid":                  2,
+		"flags":                3,
+		"endpoint_behavior":    4,
+		"locator_block_length": 5,
+		"locator_node_length":  6,
+		"function_length":      7,
+		"argument_length":      8,
+		"transposition_length": 9,
+		"transposition_offset": 10,
+	},
+	"SessionSummary": {
+		"id":                 1,
+		"remote_address":     2,
+		"listener":           3,
+		"router_ip":          4,
+		"sys_name":           5,
+		"sys_descr":          6,
+		"vendor":             7,
+		"connected_since":    8,
+		"messages_received":  9,
+		"messages_published": 10,
+		"messages_discarded": 11,
+		"paused":             12,
+		"tls_common_name":    13,
+		"journal":            14,
+		"journal_offset":     15,
+		"end":                16,
+		"duration_seconds":   17,
+		"closed_by":          18,
+		"termination_reason": 19,
+		"termination_info":   20,
+		"bmp_messages":       21,
+		"published_messages": 22,
+		"parse_errors":       23,
+		"errors":             24,
+		"peers":              25,
+		"peers_up":           26,
+		"prefixes":           27,
+		"timestamp":          28,
+	},
+	"SrvalidatorEvent": {
+		"action":        1,
+		"router_ip":     2,
+		"peer_ip":       3,
+		"distinguisher": 4,
+		"color":         5,
+		"endpoint":      6,
+		"policy_name":   7,
+		"unknown_sids":  8,
+		"timestamp":     9,
+	},
+	"Stats": {
+		"_key":                          1,
+		"_id":                           2,
+		"_rev":                          3,
+		"sequence":                      4,
+		"router_hash":                   5,
+		"router_ip":                     6,
+		"peer_type":                     7,
+		"remote_bgp_id":                 8,
+		"remote_asn":                    9,
+		"remote_ip":                     10,
+		"peer_rd":                       11,
+		"timestamp":                     12,
+		"collector_timestamp":           13,
+		"duplicate_prefix":              14,
+		"duplicate_withdraws":           15,
+		"invalidated_due_cluster":       16,
+		"invalidated_due_aspath":        17,
+		"invalidated_due_originator_id": 18,
+		"invalidated_due_asconfed":      19,
+		"ads_rib_in":                    20,
+		"local_rib":                     21,
+		"updates_as_withdraw":           22,
+		"prefixes_as_withdraw":          23,
+		"is_adj_rib_out":                24,
+		"is_post_policy":                25,
+		"rib_type":                      26,
+	},
+	"Storm": {
+		"router_ip":   1,
+		"router_hash": 2,
+		"start":       3,
+		"end":         4,
+		"peer_count":  5,
+		"reasons":     6,
+		"peers":       7,
+		"timestamp":   8,
+	},
+	"SubTLV": {
+		"sub_tlv_type":  1,
+		"sub_tlv_value": 2,
+	},
+	"Summary": {
+		"router_ip":     1,
+		"router_hash":   2,
+		"peer_ip":       3,
+		"peer_hash":     4,
+		"peer_asn":      5,
+		"peer_rd":       6,
+		"start":         7,
+		"end":           8,
+		"announcements": 9,
+		"withdrawals":   10,
+		"messages":      11,
+		"timestamp":     12,
+	},
+	"UnicastPrefix": {
+		"_key":                       1,
+		"_id":                        2,
+		"_rev":                       3,
+		"action":                     4,
+		"sequence":                   5,
+		"hash":                       6,
+		"router_hash":                7,
+		"router_ip":                  8,
+		"base_attrs":                 9,
+		"peer_hash":                  10,
+		"peer_ip":                    11,
+		"peer_type":                  12,
+		"peer_rd":                    13,
+		"peer_asn":                   14,
+		"timestamp":                  15,
+		"collector_timestamp":        16,
+		"prefix":                     17,
+		"prefix_len":                 18,
+		"is_ipv4":                    19,
+		"origin_as":                  20,
+		"nexthop":                    21,
+		"is_nexthop_ipv4":            22,
+		"path_id":                    23,
+		"labels":                     24,
+		"prefix_sid":                 25,
+		"is_eor":                     26,
+		"srv6_sids":                  27,
+		"srv6_service_sids":          28,
+		"is_llgr_stale":              29,
+		"is_adj_rib_in_post_policy":  30,
+		"is_adj_rib_out_post_policy": 31,
+		"is_adj_rib_out":             32,
+		"is_post_policy":             33,
+		"is_loc_rib_filtered":        34,
+		"rib_type":                   35,
+		"table_name":                 36,
+		"bmp_tlvs":                   37,
+	},
+	"Weight": {
+		"flags":  1,
+		"weight": 2,
+	},
+}
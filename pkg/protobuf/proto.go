@@ -0,0 +1,155 @@
+package protobuf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// scalarNames maps kinds of fields to their names in .proto files
+var scalarNames = map[descriptorpb.FieldDescriptorProto_Type]string{
+	descriptorpb.FieldDescriptorProto_TYPE_BOOL:   "bool",
+	descriptorpb.FieldDescriptorProto_TYPE_STRING: "string",
+	descriptorpb.FieldDescriptorProto_TYPE_BYTES:  "bytes",
+	descriptorpb.FieldDescriptorProto_TYPE_INT32:  "int32",
+	descriptorpb.FieldDescriptorProto_TYPE_UINT32: "uint32",
+	descriptorpb.FieldDescriptorProto_TYPE_INT64:  "int64",
+	descriptorpb.FieldDescriptorProto_TYPE_UINT64: "uint64",
+	descriptorpb.FieldDescriptorProto_TYPE_FLOAT:  "float",
+	descriptorpb.FieldDescriptorProto_TYPE_DOUBLE: "double",
+}
+
+// ProtoFile returns the .proto file of messages of registered message types
+func ProtoFile() string {
+	return newSchema(bmp.MessageTypes(), fieldNumbers).proto()
+}
+
+// FileDescriptor returns the descriptor of the .proto file of messages of registered message types, the descriptor
+// is passed to protoc plugins generating bindings
+func FileDescriptor() *descriptorpb.FileDescriptorProto {
+	return newSchema(bmp.MessageTypes(), fieldNumbers).descriptor()
+}
+
+// FieldNumbers returns numbers of fields of messages of registered message types keyed by message name and field
+// name, numbers pinned by the generated numbers.go are kept and fields not pinned yet are numbered after them
+func FieldNumbers() map[string]map[string]int32 {
+	return newSchema(bmp.MessageTypes(), fieldNumbers).numbers(fieldNumbers)
+}
+
+func (s *schema) descriptor() *descriptorpb.FileDescriptorProto {
+	fd := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String(ProtoFileName),
+		Package: proto.String(protoPackage),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String(goPackage)},
+	}
+	for _, m := range s.messages {
+		fd.MessageType = append(fd.MessageType, m.descriptor(""))
+	}
+
+	return fd
+}
+
+// descriptor returns the descriptor of the message, parent is the full name of the message of map entries
+func (m *message) descriptor(parent string) *descriptorpb.DescriptorProto {
+	name := "." + protoPackage + "." + m.name
+	if parent != "" {
+		name = parent + "." + m.name
+	}
+	d := &descriptorpb.DescriptorProto{Name: proto.String(m.name)}
+	for _, e := range m.entries {
+		ed := e.descriptor(name)
+		ed.Options = &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)}
+		d.NestedType = append(d.NestedType, ed)
+	}
+	for _, f := range m.fields {
+		// protojson names fields after json names of gobmp when they are not valid protobuf names
+		jn := jsonName(f.name)
+		if f.json != "" && f.json != f.name {
+			jn = f.json
+		}
+		fd := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(f.name),
+			Number:   proto.Int32(f.number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     f.kind.Enum(),
+			JsonName: proto.String(jn),
+		}
+		if f.repeated {
+			fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		}
+		switch {
+		case f.entry:
+			fd.TypeName = proto.String(name + "." + f.message.name)
+		case f.message != nil:
+			fd.TypeName = proto.String("." + protoPackage + "." + f.message.name)
+		}
+		d.Field = append(d.Field, fd)
+	}
+	for _, n := range m.reserved {
+		d.ReservedRange = append(d.ReservedRange, &descriptorpb.DescriptorProto_ReservedRange{Start: proto.Int32(n), End: proto.Int32(n + 1)})
+	}
+
+	return d
+}
+
+func (s *schema) proto() string {
+	var sb strings.Builder
+	sb.WriteString("// Code generated by gen.go from Go types of message types; DO NOT EDIT.\n\n")
+	sb.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(&sb, "package %s;\n\n", protoPackage)
+	fmt.Fprintf(&sb, "option go_package = %q;\n", goPackage)
+	for _, m := range s.messages {
+		sb.WriteString("\n")
+		if m.goType != nil {
+			fmt.Fprintf(&sb, "// %s is Go type %s", m.name, m.goType.String())
+		} else {
+			fmt.Fprintf(&sb, "// %s carries the json message in the json field", m.name)
+		}
+		if len(m.types) != 0 {
+			fmt.Fprintf(&sb, ", messages of types %s", strings.Join(m.types, ", "))
+		}
+		fmt.Fprintf(&sb, "\nmessage %s {\n", m.name)
+		if len(m.reserved) != 0 {
+			l := make([]string, 0, len(m.reserved))
+			for _, n := range m.reserved {
+				l = append(l, strconv.Itoa(int(n)))
+			}
+			fmt.Fprintf(&sb, "  reserved %s;\n", strings.Join(l, ", "))
+		}
+		for _, f := range m.fields {
+			fmt.Fprintf(&sb, "  %s %s = %d", f.typeName(), f.name, f.number)
+			if f.json != "" && f.json != f.name {
+				fmt.Fprintf(&sb, " [json_name = %q]", f.json)
+			}
+			sb.WriteString(";")
+			if f.asJSON {
+				sb.WriteString(" // json")
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("}\n")
+	}
+
+	return sb.String()
+}
+
+// typeName returns the type of the field in .proto files
+func (f *field) typeName() string {
+	if f.entry {
+		return fmt.Sprintf("map<string, %s>", f.message.fields[1].typeName())
+	}
+	t := scalarNames[f.kind]
+	if f.message != nil {
+		t = f.message.name
+	}
+	if f.repeated {
+		return "repeated " + t
+	}
+
+	return t
+}
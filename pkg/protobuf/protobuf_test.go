@@ -0,0 +1,319 @@
+package protobuf
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/sbezverk/gobmp/pkg/asgraph"
+	_ "github.com/sbezverk/gobmp/pkg/baseline"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	_ "github.com/sbezverk/gobmp/pkg/epe"
+	_ "github.com/sbezverk/gobmp/pkg/events"
+	_ "github.com/sbezverk/gobmp/pkg/flap"
+	_ "github.com/sbezverk/gobmp/pkg/gobmpsrv"
+	gobmpmsg "github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/protobuf/messagepb"
+	_ "github.com/sbezverk/gobmp/pkg/report"
+	_ "github.com/sbezverk/gobmp/pkg/srvalidator"
+	_ "github.com/sbezverk/gobmp/pkg/storm"
+	_ "github.com/sbezverk/gobmp/pkg/verbosity"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+)
+
+type testInner struct {
+	Label string `json:"label"`
+}
+
+type testEmbedded struct {
+	Hash string `json:"hash"`
+}
+
+type testMessage struct {
+	testEmbedded
+	Action  string                `json:"action"`
+	ASN     uint32                `json:"peer_asn,omitempty"`
+	Len     int32                 `json:"prefix_len"`
+	IPv4    bool                  `json:"is_ipv4"`
+	Ratio   float64               `json:"ratio"`
+	Labels  []uint32              `json:"labels,omitempty"`
+	Inner   *testInner            `json:"inner,omitempty"`
+	Tags    map[string]string     `json:"tags,omitempty"`
+	Raw     []byte                `json:"raw,omitempty"`
+	Updated time.Time             `json:"updated"`
+	Next    *testMessage          `json:"next-hop,omitempty"`
+	Matrix  [][]int               `json:"matrix,omitempty"`
+	Keyed   map[int]string        `json:"keyed,omitempty"`
+	Inners  map[string]*testInner `json:"inners,omitempty"`
+	Hidden  string                `json:"hash,omitempty"`
+	skipped int
+	Ignored string `json:"-"`
+}
+
+func TestProto(t *testing.T) {
+	s := newSchema([]bmp.MessageType{
+		{Type: 1, Name: "test", Schema: reflect.TypeOf(testMessage{})},
+		{Type: 2, Name: "event"},
+	}, nil)
+	expect := `// Code generated by gen.go from Go types of message types; DO NOT EDIT.
+
+syntax = "proto3";
+
+package gobmp;
+
+option go_package = "github.com/sbezverk/gobmp/pkg/protobuf/messagepb";
+
+// JSONMessage carries the json message in the json field, messages of types event
+message JSONMessage {
+  string json = 1;
+}
+
+// testInner is Go type protobuf.testInner
+message testInner {
+  string label = 1;
+}
+
+// testMessage is Go type protobuf.testMessage, messages of types test
+message testMessage {
+  string hash = 1;
+  string action = 2;
+  uint32 peer_asn = 3;
+  int32 prefix_len = 4;
+  bool is_ipv4 = 5;
+  double ratio = 6;
+  repeated uint32 labels = 7;
+  testInner inner = 8;
+  map<string, string> tags = 9;
+  bytes raw = 10;
+  string updated = 11; // json
+  testMessage next_hop = 12 [json_name = "next-hop"];
+  repeated string matrix = 13; // json
+  string keyed = 14; // json
+  map<string, testInner> inners = 15;
+}
+`
+	if got := s.proto(); got != expect {
+		t.Errorf("expected .proto file:\n%s\nbut got:\n%s", expect, got)
+	}
+	if _, err := protodesc.NewFile(s.descriptor(), nil); err != nil {
+		t.Errorf("invalid file descriptor with error: %+v", err)
+	}
+}
+
+func TestAppendJSON(t *testing.T) {
+	m := newSchema([]bmp.MessageType{{Type: 1, Name: "test", Schema: reflect.TypeOf(testMessage{})}}, nil).byType[1]
+	tests := []struct {
+		name   string
+		input  string
+		expect string
+		fail   bool
+	}{
+		{
+			name:   "zero values",
+			input:  `{"hash":"","action":null,"prefix_len":0,"is_ipv4":false,"ratio":0,"labels":[],"tags":{}}`,
+			expect: "",
+		},
+		{
+			name: "all fields",
+			input: `{"hash":"h","action":"add","peer_asn":4200000000,"prefix_len":-1,"is_ipv4":true,"ratio":1.5,` +
+				`"labels":[1,300],"inner":{"label":"a"},"tags":{"k":"v"},"raw":"AQI=","updated":"1","next-hop":{},` +
+				`"matrix":[[1],[2]],"keyed":{"1":"a"},"inners":{"x":null},"unknown":1}`,
+			expect: "0a0168" + "1203616464" + "1880d4dbd20f" + "20ffffffffffffffffff01" + "2801" + "31000000000000f83f" +
+				"3a0301ac02" + "42030a0161" + "4a060a016b120176" + "52020102" + "5a0131" + "6200" +
+				"6a035b315d" + "6a035b325d" + "72097b2231223a2261227d" + "7a050a01781200",
+		},
+		{name: "string mismatch", input: `{"action":1}`, fail: true},
+		{name: "number mismatch", input: `{"prefix_len":"1"}`, fail: true},
+		{name: "fraction of integer", input: `{"prefix_len":1.5}`, fail: true},
+		{name: "array mismatch", input: `{"labels":1}`, fail: true},
+		{name: "not an object", input: `[]`, fail: true},
+		{name: "invalid json", input: `{"action":}`, fail: true},
+		{name: "trailing data", input: `{} {}`, fail: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := m.appendJSON(nil, []byte(tt.input))
+			if err != nil && !tt.fail {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err == nil && tt.fail {
+				t.Fatalf("expected error but succeeded")
+			}
+			if got := hex.EncodeToString(b); !tt.fail && got != tt.expect {
+				t.Errorf("expected %s but got %s", tt.expect, got)
+			}
+		})
+	}
+}
+
+// TestProtoFile verifies the generated .proto file and the Go bindings match Go types of message types
+func TestProtoFile(t *testing.T) {
+	b, err := os.ReadFile("messagepb/" + ProtoFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != ProtoFile() {
+		t.Errorf("messagepb/%s does not match Go types of message types, run go generate in pkg/protobuf", ProtoFileName)
+	}
+	u := &gobmpmsg.UnicastPrefix{
+		Action:         "add",
+		RouterIP:       "192.0.2.1",
+		BaseAttributes: &bgp.BaseAttributes{ASPath: []uint32{65000, 4200000000}, LocalPref: 100, CommunityList: []string{"65000:1"}},
+		PeerASN:        65000,
+		Prefix:         "10.0.0.0",
+		PrefixLen:      8,
+		IsIPv4:         true,
+		Labels:         []uint32{16000},
+	}
+	msg, err := json.Marshal(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := &testPublisher{}
+	if err := NewPublisher(out).PublishMessage(bmp.UnicastPrefixV4Msg, nil, msg); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	var got messagepb.UnicastPrefix
+	if err := proto.Unmarshal(out.msgs[0], &got); err != nil {
+		t.Fatalf("failed to unmarshal published message with error: %+v", err)
+	}
+	expect := &messagepb.UnicastPrefix{
+		Action:    "add",
+		RouterIp:  "192.0.2.1",
+		BaseAttrs: &messagepb.BaseAttributes{AsPath: []uint32{65000, 4200000000}, LocalPref: 100, CommunityList: []string{"65000:1"}},
+		PeerAsn:   65000,
+		Prefix:    "10.0.0.0",
+		PrefixLen: 8,
+		IsIpv4:    true,
+		Labels:    []uint32{16000},
+	}
+	if !proto.Equal(&got, expect) {
+		t.Errorf("expected message %v but got %v", expect, &got)
+	}
+	if err := NewPublisher(out).PublishMessage(-1, nil, msg); err == nil {
+		t.Errorf("expected error of unknown message type but succeeded")
+	}
+}
+
+// TestFieldNumbers verifies fields keep their numbers when fields are added to, moved within or removed from Go types
+func TestFieldNumbers(t *testing.T) {
+	type testPinned struct {
+		Action string `json:"action"`
+		Prefix string `json:"prefix"`
+		Len    int32  `json:"prefix_len"`
+	}
+	pinned := newSchema([]bmp.MessageType{{Type: 1, Name: "test", Schema: reflect.TypeOf(testPinned{})}}, nil).numbers(nil)
+	tests := []struct {
+		name     string
+		schema   interface{}
+		expect   map[string]int32
+		reserved []int32
+	}{
+		{
+			name: "unchanged",
+			schema: struct {
+				Action string `json:"action"`
+				Prefix string `json:"prefix"`
+				Len    int32  `json:"prefix_len"`
+			}{},
+			expect: map[string]int32{"action": 1, "prefix": 2, "prefix_len": 3},
+		},
+		{
+			name: "field inserted and fields moved",
+			schema: struct {
+				Len     int32  `json:"prefix_len"`
+				Action  string `json:"action"`
+				Nexthop string `json:"nexthop"`
+				Prefix  string `json:"prefix"`
+			}{},
+			expect: map[string]int32{"action": 1, "prefix": 2, "prefix_len": 3, "nexthop": 4},
+		},
+		{
+			name: "field removed",
+			schema: struct {
+				Action  string `json:"action"`
+				Len     int32  `json:"prefix_len"`
+				Nexthop string `json:"nexthop"`
+			}{},
+			expect:   map[string]int32{"action": 1, "prefix_len": 3, "nexthop": 4},
+			reserved: []int32{2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Anonymous structs are named by their order, the message is renamed to share numbers of testPinned
+			b := newBuilder()
+			m := b.add(reflect.TypeOf(tt.schema))
+			b.name()
+			m.name = "testPinned"
+			b.number(pinned)
+			got := make(map[string]int32)
+			for _, f := range m.fields {
+				got[f.name] = f.number
+			}
+			if !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("expected numbers %v but got %v", tt.expect, got)
+			}
+			if !reflect.DeepEqual(m.reserved, tt.reserved) {
+				t.Errorf("expected reserved numbers %v but got %v", tt.reserved, m.reserved)
+			}
+		})
+	}
+}
+
+// TestPinnedFieldNumbers verifies all fields of messages of registered message types are pinned, and numbers of
+// fields of the generated .proto file do not change
+func TestPinnedFieldNumbers(t *testing.T) {
+	for m, fields := range FieldNumbers() {
+		for f, n := range fields {
+			if p, ok := fieldNumbers[m][f]; !ok {
+				t.Errorf("field %s of message %s is not pinned, run go generate in pkg/protobuf", f, m)
+			} else if p != n {
+				t.Errorf("field %s of message %s is numbered %d, pinned to %d", f, m, n, p)
+			}
+		}
+	}
+	b, err := os.ReadFile("messagepb/" + ProtoFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m string
+	for _, l := range strings.Split(string(b), "\n") {
+		if strings.HasPrefix(l, "message ") {
+			m = strings.Fields(l)[1]
+			continue
+		}
+		// Fields are lines "  type name = number[ options];"
+		fs := strings.Fields(strings.SplitN(l, "[", 2)[0])
+		if len(fs) < 4 || fs[len(fs)-2] != "=" || m == jsonMessageName {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSuffix(fs[len(fs)-1], ";"))
+		if err != nil {
+			continue
+		}
+		f := fs[len(fs)-3]
+		if p, ok := fieldNumbers[m][f]; !ok || p != int32(n) {
+			t.Errorf("field %s of message %s numbered %d in messagepb/%s is pinned to %d", f, m, n, ProtoFileName, p)
+		}
+	}
+}
+
+type testPublisher struct {
+	msgs [][]byte
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.msgs = append(p.msgs, msg)
+
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
@@ -0,0 +1,34 @@
+package protobuf
+
+import (
+	"fmt"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// publisher converts json messages into protobuf messages of the .proto file before they are passed to
+// the publisher
+type publisher struct {
+	pub.Publisher
+	schema *schema
+}
+
+func (p *publisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	m, ok := p.schema.byType[msgType]
+	if !ok {
+		return fmt.Errorf("message type %d is not registered", msgType)
+	}
+	b, err := m.appendJSON(make([]byte, 0, len(msg)/2), msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode a message of type %d into protobuf with error: %+v", msgType, err)
+	}
+
+	return p.Publisher.PublishMessage(msgType, msgHash, b)
+}
+
+// NewPublisher returns a publisher passing messages encoded in protobuf to publisher p, messages of types
+// registered later than the publisher is created are not published
+func NewPublisher(p pub.Publisher) pub.Publisher {
+	return &publisher{Publisher: p, schema: newSchema(bmp.MessageTypes(), fieldNumbers)}
+}
@@ -0,0 +1,368 @@
+// Package protobuf encodes json messages as Protocol Buffers (proto3 binary encoding) of messages derived from Go
+// types of message types. The .proto file of the messages and its Go bindings in messagepb are generated from the
+// same Go types by go generate, so consumers decode published messages with bindings of any language.
+package protobuf
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+//go:generate go run gen.go
+
+// Package and Go package of the generated .proto file
+const (
+	protoPackage = "gobmp"
+	goPackage    = "github.com/sbezverk/gobmp/pkg/protobuf/messagepb"
+	// ProtoFileName is the name of the generated .proto file
+	ProtoFileName = "gobmp.proto"
+)
+
+// jsonMessageName is the message of types without registered Go types, the json message is carried by the json
+// field
+const jsonMessageName = "JSONMessage"
+
+var jsonMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// message is the protobuf message of a Go struct
+type message struct {
+	name   string
+	goType reflect.Type
+	fields []*field
+	// entries are map entry messages of map fields of the message
+	entries []*message
+	// types are names of message types published as the message
+	types []string
+	// reserved are numbers of pinned fields no longer found in the Go type, they are not reused
+	reserved []int32
+}
+
+// field is the field of the message, name is the protobuf name of the field named json in json messages
+type field struct {
+	name     string
+	json     string
+	number   int32
+	kind     descriptorpb.FieldDescriptorProto_Type
+	repeated bool
+	// message is the message of fields of kind message and the entry of map fields
+	message *message
+	entry   bool
+	// asJSON fields are strings carrying json encoding of values of Go types with custom json marshaling,
+	// interfaces and values protobuf has no field for, values marshaled as json strings are carried without quotes
+	asJSON bool
+}
+
+// builder derives messages from Go types, structs found again share their message
+type builder struct {
+	messages map[reflect.Type]*message
+	// order lists messages in the order they are found
+	order []*message
+}
+
+func newBuilder() *builder {
+	return &builder{messages: make(map[reflect.Type]*message)}
+}
+
+// add adds the message of the Go type of messages and returns it
+func (b *builder) add(t reflect.Type) *message {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return b.message(t)
+}
+
+func (b *builder) message(t reflect.Type) *message {
+	if m, ok := b.messages[t]; ok {
+		return m
+	}
+	m := &message{name: t.Name(), goType: t}
+	b.messages[t] = m
+	b.order = append(b.order, m)
+	// proto3 names of fields must differ when lower cased without underscores, fields found again are embedded
+	// fields hidden by fields of the struct as they are by encoding/json
+	names := make(map[string]bool)
+	for _, f := range b.fields(t) {
+		n := strings.ReplaceAll(strings.ToLower(f.name), "_", "")
+		if names[n] {
+			continue
+		}
+		names[n] = true
+		if f.entry {
+			f.message.name = camelCase(f.name) + "Entry"
+			m.entries = append(m.entries, f.message)
+		}
+		m.fields = append(m.fields, f)
+	}
+
+	return m
+}
+
+// fields returns fields of the struct as marshaled by encoding/json, fields of embedded structs without json name
+// are promoted
+func (b *builder) fields(t reflect.Type) []*field {
+	var fields []*field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			fields = append(fields, b.fields(ft)...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		pf := b.field(f.Type, true)
+		pf.name, pf.json = protoName(name), name
+		fields = append(fields, pf)
+	}
+
+	return fields
+}
+
+// field returns the field of values of the Go type, repeated is false when the field is an item of a repeated
+// field or the value of a map field, protobuf has no repeated items nor maps of maps
+func (b *builder) field(t reflect.Type, repeated bool) *field {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Implements(jsonMarshaler) || reflect.PtrTo(t).Implements(jsonMarshaler) {
+		return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_STRING, asJSON: true}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_BOOL}
+	case reflect.String:
+		return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_STRING}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_INT32}
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_UINT32}
+	case reflect.Int, reflect.Int64:
+		return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_INT64}
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_UINT64}
+	case reflect.Float32:
+		return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_FLOAT}
+	case reflect.Float64:
+		return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_DOUBLE}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// Byte slices are marshaled as base64 strings
+			return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_BYTES}
+		}
+		fallthrough
+	case reflect.Array:
+		if !repeated {
+			break
+		}
+		f := b.field(t.Elem(), false)
+		if f.repeated {
+			break
+		}
+		f.repeated = true
+		return f
+	case reflect.Map:
+		if !repeated || t.Key().Kind() != reflect.String {
+			break
+		}
+		v := b.field(t.Elem(), false)
+		if v.repeated {
+			break
+		}
+		v.name, v.number = "value", 2
+		entry := &message{fields: []*field{
+			{name: "key", number: 1, kind: descriptorpb.FieldDescriptorProto_TYPE_STRING},
+			v,
+		}}
+		return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, repeated: true, message: entry, entry: true}
+	case reflect.Struct:
+		return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, message: b.message(t)}
+	}
+
+	return &field{kind: descriptorpb.FieldDescriptorProto_TYPE_STRING, asJSON: true}
+}
+
+// name assigns unique names to messages, messages of Go types with the same name in different packages are
+// prefixed by their package names and messages of anonymous structs are named after their order
+func (b *builder) name() {
+	count := make(map[string]int)
+	for _, m := range b.order {
+		count[m.name]++
+	}
+	anonymous := 0
+	for _, m := range b.order {
+		switch {
+		case m.name == "":
+			anonymous++
+			m.name = "Anonymous" + strconv.Itoa(anonymous)
+		case count[m.name] > 1:
+			pkg := m.goType.PkgPath()
+			if i := strings.LastIndexByte(pkg, '/'); i >= 0 {
+				pkg = pkg[i+1:]
+			}
+			m.name = camelCase(protoName(pkg)) + m.name
+		}
+	}
+}
+
+// number assigns numbers to fields of messages, fields pinned by numbers, keyed by message name and field name,
+// keep their number and other fields are numbered after the highest number pinned for the message, so numbers of
+// fields do not change when fields are added to, moved within or removed from Go types
+func (b *builder) number(numbers map[string]map[string]int32) {
+	for _, m := range b.order {
+		pinned := numbers[m.name]
+		next := int32(1)
+		for _, n := range pinned {
+			if n >= next {
+				next = n + 1
+			}
+		}
+		found := make(map[string]bool, len(m.fields))
+		for _, f := range m.fields {
+			found[f.name] = true
+			if n, ok := pinned[f.name]; ok {
+				f.number = n
+				continue
+			}
+			f.number = next
+			next++
+		}
+		for name, n := range pinned {
+			if !found[name] {
+				m.reserved = append(m.reserved, n)
+			}
+		}
+		sort.Slice(m.reserved, func(i, j int) bool { return m.reserved[i] < m.reserved[j] })
+	}
+}
+
+// schema is the set of messages of message types
+type schema struct {
+	messages []*message
+	// byType holds top level messages keyed by message types
+	byType map[int]*message
+	json   *message
+}
+
+// newSchema returns messages of the message types with fields numbered by numbers, types without registered Go types
+// are encoded as JSONMessage
+func newSchema(types []bmp.MessageType, numbers map[string]map[string]int32) *schema {
+	b := newBuilder()
+	s := &schema{byType: make(map[int]*message)}
+	for _, mt := range types {
+		if mt.Schema == nil {
+			continue
+		}
+		m := b.add(mt.Schema)
+		m.types = append(m.types, mt.Name)
+		s.byType[mt.Type] = m
+	}
+	b.name()
+	b.number(numbers)
+	s.json = &message{
+		name:   jsonMessageName,
+		fields: []*field{{name: "json", json: "json", number: 1, kind: descriptorpb.FieldDescriptorProto_TYPE_STRING}},
+	}
+	for _, mt := range types {
+		if mt.Schema == nil {
+			s.json.types = append(s.json.types, mt.Name)
+			s.byType[mt.Type] = s.json
+		}
+	}
+	s.messages = append(b.order, s.json)
+	sort.SliceStable(s.messages, func(i, j int) bool { return s.messages[i].name < s.messages[j].name })
+
+	return s
+}
+
+// numbers returns numbers of fields of messages keyed by message name and field name, including numbers of
+// reserved fields
+func (s *schema) numbers(pinned map[string]map[string]int32) map[string]map[string]int32 {
+	numbers := make(map[string]map[string]int32, len(s.messages))
+	for _, m := range s.messages {
+		if m.goType == nil {
+			continue
+		}
+		fields := make(map[string]int32, len(m.fields)+len(m.reserved))
+		for name, n := range pinned[m.name] {
+			fields[name] = n
+		}
+		for _, f := range m.fields {
+			fields[f.name] = f.number
+		}
+		numbers[m.name] = fields
+	}
+
+	return numbers
+}
+
+// protoName replaces characters not allowed in protobuf names by '_'
+func protoName(s string) string {
+	n := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, s)
+	if n == "" || n[0] >= '0' && n[0] <= '9' {
+		n = "_" + n
+	}
+
+	return n
+}
+
+// camelCase returns the name in camel case starting with an upper case letter, as protoc names map entries
+func camelCase(s string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range s {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper && r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		upper = false
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// jsonName returns the json name protoc derives from the field name
+func jsonName(s string) string {
+	var sb strings.Builder
+	upper := false
+	for _, r := range s {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper && r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		upper = false
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
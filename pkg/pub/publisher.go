@@ -1,5 +1,12 @@
 package pub
 
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
 // Publisher defines an interface and method to publish message
 // msgType is the type of message, defined in pkg/bmp/consts.go
 // MsgHash optionally defines the key to use by the backend when storing message
@@ -8,3 +15,76 @@ type Publisher interface {
 	PublishMessage(msgType int, msgHash []byte, msg []byte) error
 	Stop()
 }
+
+// ValuePublisher is implemented by publishers json encoding messages directly into their own buffers,
+// v is the message of msgType before it is marshaled
+type ValuePublisher interface {
+	PublishValue(msgType int, msgHash []byte, v interface{}) error
+}
+
+// DeliveryReporter is implemented by publishers delivering messages asynchronously, f is called with the latency
+// and the error of the delivery of every message published after it is set. Latency of publishers not implementing
+// DeliveryReporter is the time PublishMessage takes to return.
+type DeliveryReporter interface {
+	ReportDeliveries(f func(latency time.Duration, err error))
+}
+
+// HealthChecker is implemented by publishers connected to a server, Healthy returns the error when the server
+// is not reachable
+type HealthChecker interface {
+	Healthy() error
+}
+
+// Healthy returns the error of the publisher's check if it implements HealthChecker, publishers not implementing
+// HealthChecker are healthy
+func Healthy(p Publisher) error {
+	if hc, ok := p.(HealthChecker); ok {
+		return hc.Healthy()
+	}
+	return nil
+}
+
+// PublishValue publishes json encoding of v, v is encoded by the publisher if it implements ValuePublisher,
+// otherwise v is marshaled and passed to PublishMessage
+func PublishValue(p Publisher, msgType int, msgHash []byte, v interface{}) error {
+	if vp, ok := p.(ValuePublisher); ok {
+		return vp.PublishValue(msgType, msgHash, v)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return p.PublishMessage(msgType, msgHash, b)
+}
+
+// maxPooledBuffer is the capacity of the largest buffer kept for reuse, buffers of initial dumps of
+// large BGP-LS topologies are reused while smaller messages do not hold multi-megabyte buffers forever
+const maxPooledBuffer = 8 << 20
+
+var buffers = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// EncodeValue returns a buffer carrying json encoding of v, the encoding is identical to json.Marshal.
+// The buffer is owned by the caller until it is returned by ReleaseBuffer.
+func EncodeValue(v interface{}) (*bytes.Buffer, error) {
+	buf := buffers.Get().(*bytes.Buffer)
+	buf.Reset()
+	if err := json.NewEncoder(buf).Encode(v); err != nil {
+		ReleaseBuffer(buf)
+		return nil, err
+	}
+	// Encode terminates the value with a new line
+	buf.Truncate(buf.Len() - 1)
+
+	return buf, nil
+}
+
+// ReleaseBuffer returns the buffer of EncodeValue for reuse, the buffer must not be used after it is released
+func ReleaseBuffer(buf *bytes.Buffer) {
+	if buf == nil || buf.Cap() > maxPooledBuffer {
+		return
+	}
+	buffers.Put(buf)
+}
@@ -0,0 +1,79 @@
+package pub
+
+import (
+	"bytes"
+	"sync/atomic"
+)
+
+var routerIPField = []byte(`"router_ip":"`)
+
+// Router passes messages to the publisher of the topic prefix of the message's router, messages of routers
+// without topic prefixes and messages without router_ip are passed to the default publisher. Router does not
+// implement ValuePublisher, as the router of the message is found in its json encoding.
+type Router struct {
+	def        Publisher
+	publishers map[string]Publisher
+	prefix     atomic.Value
+}
+
+var _ Publisher = &Router{}
+var _ HealthChecker = &Router{}
+
+// SetPrefixes sets the function returning the topic prefix of the router, messages are passed to the default
+// publisher until the function is set
+func (r *Router) SetPrefixes(f func(router string) string) {
+	r.prefix.Store(f)
+}
+
+// publisher returns the publisher of the topic prefix of the router of the message
+func (r *Router) publisher(msg []byte) Publisher {
+	f, ok := r.prefix.Load().(func(string) string)
+	if !ok {
+		return r.def
+	}
+	i := bytes.Index(msg, routerIPField)
+	if i < 0 {
+		return r.def
+	}
+	router := msg[i+len(routerIPField):]
+	j := bytes.IndexByte(router, '"')
+	if j < 0 {
+		return r.def
+	}
+	if p, ok := r.publishers[f(string(router[:j]))]; ok {
+		return p
+	}
+
+	return r.def
+}
+
+func (r *Router) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	return r.publisher(msg).PublishMessage(msgType, msgHash, msg)
+}
+
+// Healthy returns the error of the first publisher failing its check
+func (r *Router) Healthy() error {
+	if err := Healthy(r.def); err != nil {
+		return err
+	}
+	for _, p := range r.publishers {
+		if err := Healthy(p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Router) Stop() {
+	r.def.Stop()
+	for _, p := range r.publishers {
+		p.Stop()
+	}
+}
+
+// NewRouter instantiates a new instance of a router passing messages to publishers of topic prefixes,
+// publishers are keyed by topic prefixes
+func NewRouter(def Publisher, publishers map[string]Publisher) *Router {
+	return &Router{def: def, publishers: publishers}
+}
@@ -0,0 +1,206 @@
+// Package pubtest provides an in-memory publisher capturing published messages, applications embedding gobmp
+// and tests of publisher pipelines can assert on produced messages without a Kafka or NATS server.
+package pubtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// Message defines a message captured by Recorder
+type Message struct {
+	// Type is the type of the message defined in pkg/bmp/consts.go
+	Type int
+	Hash []byte
+	// Msg is json encoding of the message
+	Msg []byte
+}
+
+// Name returns the name of the type of the message
+func (m Message) Name() string {
+	return bmp.MessageTypeName(m.Type)
+}
+
+// Field returns the value of top level json key of the message, false is returned when the message is not a json
+// object or the key is missing
+func (m Message) Field(key string) (interface{}, bool) {
+	o := make(map[string]interface{})
+	d := json.NewDecoder(bytes.NewReader(m.Msg))
+	d.UseNumber()
+	if err := d.Decode(&o); err != nil {
+		return nil, false
+	}
+	v, ok := o[key]
+
+	return v, ok
+}
+
+// Recorder is a publisher capturing published messages in memory, it is safe for concurrent use
+type Recorder struct {
+	sync.Mutex
+	msgs    []Message
+	stopped bool
+	// published is closed and replaced when a message is captured, waiters are woken up by closing
+	published chan struct{}
+}
+
+var _ pub.Publisher = &Recorder{}
+
+// NewRecorder returns a new instance of in-memory publisher
+func NewRecorder() *Recorder {
+	return &Recorder{
+		msgs:      make([]Message, 0),
+		published: make(chan struct{}),
+	}
+}
+
+// PublishMessage captures the message, msgHash and msg are copied as publishers may reuse their buffers
+func (r *Recorder) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	m := Message{
+		Type: msgType,
+		Msg:  make([]byte, len(msg)),
+	}
+	copy(m.Msg, msg)
+	if msgHash != nil {
+		m.Hash = make([]byte, len(msgHash))
+		copy(m.Hash, msgHash)
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.msgs = append(r.msgs, m)
+	close(r.published)
+	r.published = make(chan struct{})
+
+	return nil
+}
+
+// Stop marks the recorder stopped, captured messages are kept
+func (r *Recorder) Stop() {
+	r.Lock()
+	defer r.Unlock()
+	r.stopped = true
+}
+
+// Stopped returns true when Stop was called
+func (r *Recorder) Stopped() bool {
+	r.Lock()
+	defer r.Unlock()
+	return r.stopped
+}
+
+// Reset removes captured messages
+func (r *Recorder) Reset() {
+	r.Lock()
+	defer r.Unlock()
+	r.msgs = make([]Message, 0)
+}
+
+// Messages returns captured messages of types in the order of publishing, all messages when no type is given
+func (r *Recorder) Messages(types ...int) []Message {
+	r.Lock()
+	defer r.Unlock()
+	return r.messages(types)
+}
+
+func (r *Recorder) messages(types []int) []Message {
+	msgs := make([]Message, 0)
+	for _, m := range r.msgs {
+		if len(types) == 0 || hasType(types, m.Type) {
+			msgs = append(msgs, m)
+		}
+	}
+
+	return msgs
+}
+
+func hasType(types []int, t int) bool {
+	for _, tt := range types {
+		if tt == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Count returns the number of captured messages of types, the number of all messages when no type is given
+func (r *Recorder) Count(types ...int) int {
+	return len(r.Messages(types...))
+}
+
+// Where returns captured messages of the type with top level json key equal to value, value is compared with
+// the key by its json encoding, so numbers of any Go type match numbers of messages
+func (r *Recorder) Where(msgType int, key string, value interface{}) ([]Message, error) {
+	v, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal value of key %s with error: %+v", key, err)
+	}
+	msgs := make([]Message, 0)
+	for _, m := range r.Messages(msgType) {
+		o := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(m.Msg, &o); err != nil {
+			continue
+		}
+		if f, ok := o[key]; ok && bytes.Equal(compact(f), v) {
+			msgs = append(msgs, m)
+		}
+	}
+
+	return msgs, nil
+}
+
+func compact(b []byte) []byte {
+	buf := &bytes.Buffer{}
+	if err := json.Compact(buf, b); err != nil {
+		return b
+	}
+
+	return buf.Bytes()
+}
+
+// Unmarshal unmarshals captured messages of the type into v, v is a pointer to a slice of message structures,
+// for example *[]message.UnicastPrefix
+func (r *Recorder) Unmarshal(msgType int, v interface{}) error {
+	msgs := r.Messages(msgType)
+	buf := &bytes.Buffer{}
+	buf.WriteByte('[')
+	for i, m := range msgs {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(m.Msg)
+	}
+	buf.WriteByte(']')
+	if err := json.Unmarshal(buf.Bytes(), v); err != nil {
+		return fmt.Errorf("failed to unmarshal messages of type %d with error: %+v", msgType, err)
+	}
+
+	return nil
+}
+
+// WaitFor waits until at least n messages of types are captured, all messages are counted when no type is given,
+// and returns the messages, error is returned when messages are not captured within timeout
+func (r *Recorder) WaitFor(n int, timeout time.Duration, types ...int) ([]Message, error) {
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	for {
+		r.Lock()
+		msgs := r.messages(types)
+		published := r.published
+		r.Unlock()
+		if len(msgs) >= n {
+			return msgs, nil
+		}
+		select {
+		case <-published:
+		case <-t.C:
+			return nil, fmt.Errorf("captured %d of %d messages within %s", len(msgs), n, timeout)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package pubtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+func TestRecorder(t *testing.T) {
+	r := NewRecorder()
+	buf := []byte(`{"action":"add","prefix":"10.0.0.0","prefix_len":8}`)
+	if err := r.PublishMessage(bmp.UnicastPrefixV4Msg, []byte("hash"), buf); err != nil {
+		t.Fatalf("failed to publish message with error: %+v", err)
+	}
+	// Publishers reuse buffers, captured message must not change
+	copy(buf, `{"action":"del"`)
+	if err := pub.PublishValue(r, bmp.UnicastPrefixV4Msg, nil, map[string]interface{}{"action": "del", "prefix_len": 16}); err != nil {
+		t.Fatalf("failed to publish value with error: %+v", err)
+	}
+	if err := r.PublishMessage(bmp.PeerStateChangeMsg, nil, []byte(`{"action":"add"}`)); err != nil {
+		t.Fatalf("failed to publish message with error: %+v", err)
+	}
+	if n := r.Count(); n != 3 {
+		t.Errorf("expected 3 messages but got %d", n)
+	}
+	if n := r.Count(bmp.UnicastPrefixV4Msg); n != 2 {
+		t.Errorf("expected 2 unicast prefix messages but got %d", n)
+	}
+	msgs := r.Messages(bmp.PeerStateChangeMsg)
+	if len(msgs) != 1 || msgs[0].Name() != "peer" {
+		t.Errorf("expected 1 peer message but got %+v", msgs)
+	}
+	if v, ok := r.Messages()[0].Field("action"); !ok || v != "add" {
+		t.Errorf("expected captured message to keep action add but got %v", v)
+	}
+	if msgs, err := r.Where(bmp.UnicastPrefixV4Msg, "prefix_len", uint8(16)); err != nil || len(msgs) != 1 {
+		t.Errorf("expected 1 message with prefix_len 16 but got %d with error: %+v", len(msgs), err)
+	}
+	var prefixes []struct {
+		Action    string `json:"action"`
+		PrefixLen int    `json:"prefix_len"`
+	}
+	if err := r.Unmarshal(bmp.UnicastPrefixV4Msg, &prefixes); err != nil {
+		t.Fatalf("failed to unmarshal messages with error: %+v", err)
+	}
+	if len(prefixes) != 2 || prefixes[0].Action != "add" || prefixes[1].PrefixLen != 16 {
+		t.Errorf("unexpected unmarshaled messages %+v", prefixes)
+	}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		r.PublishMessage(bmp.PeerStateChangeMsg, nil, []byte(`{"action":"del"}`))
+	}()
+	if _, err := r.WaitFor(2, time.Second, bmp.PeerStateChangeMsg); err != nil {
+		t.Errorf("expected to capture second peer message but failed with error: %+v", err)
+	}
+	if _, err := r.WaitFor(1, 10*time.Millisecond, bmp.StatsReportMsg); err == nil {
+		t.Errorf("expected waiting for stats message to time out")
+	}
+	r.Stop()
+	r.Reset()
+	if !r.Stopped() || r.Count() != 0 {
+		t.Errorf("expected stopped recorder without messages")
+	}
+}
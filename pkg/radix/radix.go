@@ -0,0 +1,305 @@
+package radix
+
+import (
+	"net/netip"
+	"unsafe"
+)
+
+// Tree is a compressed binary radix tree (Patricia trie) storing values of type T indexed by IPv4 and IPv6
+// prefixes, it supports exact, longest prefix match, covering (less specific) and covered (more specific)
+// prefixes lookups. Tree is not safe for concurrent use.
+type Tree[T any] struct {
+	v4   *node[T]
+	v6   *node[T]
+	size int
+}
+
+type node[T any] struct {
+	// bits carries the masked prefix, for IPv4 prefixes only first 4 bytes are used
+	bits  [16]byte
+	plen  int
+	child [2]*node[T]
+	val   T
+	set   bool
+}
+
+// New returns a new empty Tree
+func New[T any]() *Tree[T] {
+	return &Tree[T]{}
+}
+
+// Len returns the number of prefixes stored in the tree
+func (t *Tree[T]) Len() int {
+	return t.size
+}
+
+// Size returns memory used by nodes of the tree, including intermediate nodes carrying no value,
+// memory referenced by values is not counted
+func (t *Tree[T]) Size() uint64 {
+	return uint64(unsafe.Sizeof(node[T]{})) * uint64(nodes(t.v4)+nodes(t.v6))
+}
+
+func nodes[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+
+	return 1 + nodes(n.child[0]) + nodes(n.child[1])
+}
+
+func key(p netip.Prefix) ([16]byte, int, bool) {
+	p = p.Masked()
+	if p.Addr().Is4() {
+		var b [16]byte
+		a := p.Addr().As4()
+		copy(b[:], a[:])
+		return b, p.Bits(), true
+	}
+
+	return p.Addr().As16(), p.Bits(), false
+}
+
+func (t *Tree[T]) root(v4 bool) **node[T] {
+	if v4 {
+		return &t.v4
+	}
+
+	return &t.v6
+}
+
+func (n *node[T]) prefix(v4 bool) netip.Prefix {
+	if v4 {
+		var a [4]byte
+		copy(a[:], n.bits[:4])
+		return netip.PrefixFrom(netip.AddrFrom4(a), n.plen)
+	}
+
+	return netip.PrefixFrom(netip.AddrFrom16(n.bits), n.plen)
+}
+
+func bit(b [16]byte, i int) int {
+	return int(b[i/8]>>(7-uint(i%8))) & 1
+}
+
+// commonLen returns the number of leading bits a and b have in common, up to max bits
+func commonLen(a, b [16]byte, max int) int {
+	n := 0
+	for i := 0; i < 16 && n < max; i++ {
+		x := a[i] ^ b[i]
+		if x == 0 {
+			n += 8
+			continue
+		}
+		for x&0x80 == 0 {
+			n++
+			x <<= 1
+		}
+		break
+	}
+	if n > max {
+		return max
+	}
+
+	return n
+}
+
+func mask(b [16]byte, plen int) [16]byte {
+	var m [16]byte
+	for i := 0; i < 16 && plen > 0; i++ {
+		if plen >= 8 {
+			m[i] = b[i]
+			plen -= 8
+			continue
+		}
+		m[i] = b[i] & (0xff << (8 - uint(plen)))
+		plen = 0
+	}
+
+	return m
+}
+
+// covers returns true if node's prefix covers prefix k/plen
+func (n *node[T]) covers(k [16]byte, plen int) bool {
+	return n.plen <= plen && commonLen(n.bits, k, n.plen) == n.plen
+}
+
+// Insert stores the value for the prefix, it returns true if the value of already stored prefix is replaced
+func (t *Tree[T]) Insert(p netip.Prefix, v T) bool {
+	k, plen, v4 := key(p)
+	np := t.root(v4)
+	for {
+		cur := *np
+		if cur == nil {
+			*np = &node[T]{bits: k, plen: plen, val: v, set: true}
+			t.size++
+			return false
+		}
+		l := plen
+		if cur.plen < l {
+			l = cur.plen
+		}
+		c := commonLen(cur.bits, k, l)
+		if c < cur.plen {
+			leaf := &node[T]{bits: k, plen: plen, val: v, set: true}
+			t.size++
+			if c == plen {
+				// New prefix covers the current node
+				leaf.child[bit(cur.bits, plen)] = cur
+				*np = leaf
+				return false
+			}
+			// Prefixes diverge at bit c, a new internal node is required
+			mid := &node[T]{bits: mask(k, c), plen: c}
+			mid.child[bit(k, c)] = leaf
+			mid.child[bit(cur.bits, c)] = cur
+			*np = mid
+			return false
+		}
+		if cur.plen == plen {
+			replaced := cur.set
+			if !cur.set {
+				t.size++
+			}
+			cur.val = v
+			cur.set = true
+			return replaced
+		}
+		np = &cur.child[bit(k, cur.plen)]
+	}
+}
+
+// Get returns the value stored for the prefix
+func (t *Tree[T]) Get(p netip.Prefix) (T, bool) {
+	k, plen, v4 := key(p)
+	for cur := *t.root(v4); cur != nil && cur.covers(k, plen); cur = cur.child[bit(k, cur.plen)] {
+		if cur.plen == plen {
+			return cur.val, cur.set
+		}
+	}
+	var zero T
+
+	return zero, false
+}
+
+// Delete removes the prefix from the tree, it returns false if the prefix is not found
+func (t *Tree[T]) Delete(p netip.Prefix) bool {
+	k, plen, v4 := key(p)
+	var parent **node[T]
+	np := t.root(v4)
+	for {
+		cur := *np
+		if cur == nil || !cur.covers(k, plen) {
+			return false
+		}
+		if cur.plen == plen {
+			if !cur.set {
+				return false
+			}
+			break
+		}
+		parent = np
+		np = &cur.child[bit(k, cur.plen)]
+	}
+	cur := *np
+	var zero T
+	cur.val = zero
+	cur.set = false
+	t.size--
+	compact(np)
+	if parent != nil {
+		compact(parent)
+	}
+
+	return true
+}
+
+// compact removes the node without value if it has no children, or replaces it with its only child
+func compact[T any](np **node[T]) {
+	cur := *np
+	if cur == nil || cur.set {
+		return
+	}
+	switch {
+	case cur.child[0] == nil:
+		*np = cur.child[1]
+	case cur.child[1] == nil:
+		*np = cur.child[0]
+	}
+}
+
+// LongestMatch returns the most specific stored prefix covering the prefix p, an address can be looked up
+// as a host prefix, p itself is returned when it is stored.
+func (t *Tree[T]) LongestMatch(p netip.Prefix) (netip.Prefix, T, bool) {
+	k, plen, v4 := key(p)
+	var match *node[T]
+	for cur := *t.root(v4); cur != nil && cur.covers(k, plen); {
+		if cur.set {
+			match = cur
+		}
+		if cur.plen == plen {
+			break
+		}
+		cur = cur.child[bit(k, cur.plen)]
+	}
+	if match == nil {
+		var zero T
+		return netip.Prefix{}, zero, false
+	}
+
+	return match.prefix(v4), match.val, true
+}
+
+// Covering calls fn for all stored prefixes covering the prefix p, including p itself, from the least to
+// the most specific, iteration stops when fn returns false.
+func (t *Tree[T]) Covering(p netip.Prefix, fn func(netip.Prefix, T) bool) {
+	k, plen, v4 := key(p)
+	for cur := *t.root(v4); cur != nil && cur.covers(k, plen); {
+		if cur.set && !fn(cur.prefix(v4), cur.val) {
+			return
+		}
+		if cur.plen == plen {
+			return
+		}
+		cur = cur.child[bit(k, cur.plen)]
+	}
+}
+
+// Covered calls fn for all stored prefixes covered by the prefix p, including p itself, in the tree order,
+// iteration stops when fn returns false.
+func (t *Tree[T]) Covered(p netip.Prefix, fn func(netip.Prefix, T) bool) {
+	k, plen, v4 := key(p)
+	cur := *t.root(v4)
+	for cur != nil && cur.plen < plen {
+		if !cur.covers(k, plen) {
+			return
+		}
+		cur = cur.child[bit(k, cur.plen)]
+	}
+	if cur == nil || commonLen(cur.bits, k, plen) < plen {
+		return
+	}
+	walk(cur, v4, fn)
+}
+
+// Walk calls fn for all stored prefixes, IPv4 prefixes first, a prefix is visited before the prefixes
+// it covers, iteration stops when fn returns false.
+func (t *Tree[T]) Walk(fn func(netip.Prefix, T) bool) {
+	if !walk(t.v4, true, fn) {
+		return
+	}
+	walk(t.v6, false, fn)
+}
+
+func walk[T any](n *node[T], v4 bool, fn func(netip.Prefix, T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.set && !fn(n.prefix(v4), n.val) {
+		return false
+	}
+	if !walk(n.child[0], v4, fn) {
+		return false
+	}
+
+	return walk(n.child[1], v4, fn)
+}
@@ -0,0 +1,202 @@
+package radix
+
+import (
+	"math/rand"
+	"net/netip"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+func collect(f func(netip.Prefix, func(netip.Prefix, int) bool), p netip.Prefix) []netip.Prefix {
+	var r []netip.Prefix
+	f(p, func(p netip.Prefix, _ int) bool {
+		r = append(r, p)
+		return true
+	})
+
+	return r
+}
+
+func TestTree(t *testing.T) {
+	prefixes := []string{
+		"0.0.0.0/0",
+		"10.0.0.0/8",
+		"10.1.0.0/16",
+		"10.1.1.0/24",
+		"10.2.0.0/16",
+		"192.168.0.0/24",
+		"2001:db8::/32",
+		"2001:db8:1::/48",
+	}
+	tr := New[int]()
+	for i, p := range prefixes {
+		if tr.Insert(netip.MustParsePrefix(p), i) {
+			t.Fatalf("prefix %s is reported as replaced", p)
+		}
+	}
+	if tr.Len() != len(prefixes) {
+		t.Fatalf("expected %d prefixes but got %d", len(prefixes), tr.Len())
+	}
+	// Each stored prefix takes a node, intermediate nodes are added only where prefixes diverge
+	if n := int(tr.Size() / uint64(unsafe.Sizeof(node[int]{}))); n < len(prefixes) || n >= 2*len(prefixes) {
+		t.Fatalf("expected between %d and %d nodes but got %d", len(prefixes), 2*len(prefixes)-1, n)
+	}
+	tests := []struct {
+		name     string
+		prefix   string
+		longest  string
+		covering []string
+		covered  []string
+	}{
+		{
+			name:     "host in the most specific",
+			prefix:   "10.1.1.1/32",
+			longest:  "10.1.1.0/24",
+			covering: []string{"0.0.0.0/0", "10.0.0.0/8", "10.1.0.0/16", "10.1.1.0/24"},
+		},
+		{
+			name:     "stored prefix",
+			prefix:   "10.1.0.0/16",
+			longest:  "10.1.0.0/16",
+			covering: []string{"0.0.0.0/0", "10.0.0.0/8", "10.1.0.0/16"},
+			covered:  []string{"10.1.0.0/16", "10.1.1.0/24"},
+		},
+		{
+			name:     "not stored prefix",
+			prefix:   "10.0.0.0/14",
+			longest:  "10.0.0.0/8",
+			covering: []string{"0.0.0.0/0", "10.0.0.0/8"},
+			covered:  []string{"10.1.0.0/16", "10.1.1.0/24", "10.2.0.0/16"},
+		},
+		{
+			name:     "default route only",
+			prefix:   "172.16.0.0/12",
+			longest:  "0.0.0.0/0",
+			covering: []string{"0.0.0.0/0"},
+		},
+		{
+			name:     "ipv6",
+			prefix:   "2001:db8::/31",
+			covered:  []string{"2001:db8::/32", "2001:db8:1::/48"},
+			covering: nil,
+		},
+		{
+			name:     "ipv6 host",
+			prefix:   "2001:db8:1::1/128",
+			longest:  "2001:db8:1::/48",
+			covering: []string{"2001:db8::/32", "2001:db8:1::/48"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := netip.MustParsePrefix(tt.prefix)
+			l, _, ok := tr.LongestMatch(p)
+			if tt.longest == "" {
+				if ok {
+					t.Errorf("expected no match but got %s", l)
+				}
+			} else if !ok || l != netip.MustParsePrefix(tt.longest) {
+				t.Errorf("expected longest match %s but got %s", tt.longest, l)
+			}
+			if c := collect(tr.Covering, p); !reflect.DeepEqual(c, mustParse(tt.covering)) {
+				t.Errorf("expected covering prefixes %v but got %v", tt.covering, c)
+			}
+			if c := collect(tr.Covered, p); !reflect.DeepEqual(c, mustParse(tt.covered)) {
+				t.Errorf("expected covered prefixes %v but got %v", tt.covered, c)
+			}
+		})
+	}
+	if !tr.Insert(netip.MustParsePrefix("10.1.0.0/16"), 100) {
+		t.Errorf("expected prefix 10.1.0.0/16 to be replaced")
+	}
+	if v, ok := tr.Get(netip.MustParsePrefix("10.1.0.0/16")); !ok || v != 100 {
+		t.Errorf("expected value 100 but got %d", v)
+	}
+	if !tr.Delete(netip.MustParsePrefix("10.1.0.0/16")) {
+		t.Errorf("failed to delete 10.1.0.0/16")
+	}
+	if tr.Delete(netip.MustParsePrefix("10.1.0.0/16")) {
+		t.Errorf("deleted prefix 10.1.0.0/16 is deleted again")
+	}
+	if l, _, _ := tr.LongestMatch(netip.MustParsePrefix("10.1.2.0/24")); l != netip.MustParsePrefix("10.0.0.0/8") {
+		t.Errorf("expected longest match 10.0.0.0/8 after delete but got %s", l)
+	}
+	if tr.Len() != len(prefixes)-1 {
+		t.Errorf("expected %d prefixes after delete but got %d", len(prefixes)-1, tr.Len())
+	}
+}
+
+func mustParse(l []string) []netip.Prefix {
+	var r []netip.Prefix
+	for _, s := range l {
+		r = append(r, netip.MustParsePrefix(s))
+	}
+
+	return r
+}
+
+// TestTreeRandom compares results of the tree with linear scan of the stored prefixes
+func TestTreeRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() netip.Prefix {
+		// Narrow address space makes prefixes overlap
+		a := [4]byte{10, byte(r.Intn(4)), byte(r.Intn(256)), byte(r.Intn(256))}
+		return netip.PrefixFrom(netip.AddrFrom4(a), 8+r.Intn(25)).Masked()
+	}
+	tr := New[int]()
+	stored := make(map[netip.Prefix]int)
+	for i := 0; i < 5000; i++ {
+		p := random()
+		if r.Intn(4) == 0 {
+			_, ok := stored[p]
+			if tr.Delete(p) != ok {
+				t.Fatalf("delete of %s returned %t", p, !ok)
+			}
+			delete(stored, p)
+			continue
+		}
+		tr.Insert(p, i)
+		stored[p] = i
+	}
+	if tr.Len() != len(stored) {
+		t.Fatalf("expected %d prefixes but got %d", len(stored), tr.Len())
+	}
+	n := 0
+	tr.Walk(func(p netip.Prefix, v int) bool {
+		if stored[p] != v {
+			t.Errorf("prefix %s has value %d but expected %d", p, v, stored[p])
+		}
+		n++
+		return true
+	})
+	if n != len(stored) {
+		t.Errorf("walk visited %d prefixes but expected %d", n, len(stored))
+	}
+	for i := 0; i < 1000; i++ {
+		q := random()
+		var longest netip.Prefix
+		covering, covered := 0, 0
+		for p := range stored {
+			if p.Bits() <= q.Bits() && p.Contains(q.Addr()) {
+				covering++
+				if !longest.IsValid() || p.Bits() > longest.Bits() {
+					longest = p
+				}
+			}
+			if q.Bits() <= p.Bits() && q.Contains(p.Addr()) {
+				covered++
+			}
+		}
+		l, v, ok := tr.LongestMatch(q)
+		if ok != longest.IsValid() || l != longest || (ok && v != stored[l]) {
+			t.Errorf("longest match of %s expected %s but got %s", q, longest, l)
+		}
+		if c := len(collect(tr.Covering, q)); c != covering {
+			t.Errorf("expected %d prefixes covering %s but got %d", covering, q, c)
+		}
+		if c := len(collect(tr.Covered, q)); c != covered {
+			t.Errorf("expected %d prefixes covered by %s but got %d", covered, q, c)
+		}
+	}
+}
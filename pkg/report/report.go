@@ -0,0 +1,460 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/churn"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+const (
+	// FormatJSON defines report files in json format
+	FormatJSON = "json"
+	// FormatCSV defines report files in csv format, a file is written per section of the report
+	FormatCSV = "csv"
+	// defaultTopPrefixes is the number of the most churning prefixes included in the report by default
+	defaultTopPrefixes = 10
+)
+
+// Config defines reports generation
+type Config struct {
+	// Interval is the period a report covers, reports are generated at multiples of Interval
+	Interval time.Duration
+	// Dir is the directory where report files are written, no files are written when empty
+	Dir string
+	// Format is the format of report files, FormatJSON or FormatCSV
+	Format string
+	// Publish enables publishing of reports as messages of bmp.ReportMsg type
+	Publish bool
+	// TopPrefixes is the number of the most churning prefixes included in the report
+	TopPrefixes int
+}
+
+// Report defines the summary of the period between Start and End. Partial report carries churn of prefixes
+// expired before the end of the period, from the start of the period to the expiration, the report of the period
+// carries churn of the other prefixes.
+type Report struct {
+	Start    string              `json:"start"`
+	End      string              `json:"end"`
+	Partial  bool                `json:"partial,omitempty"`
+	Peers    []*PeerAvailability `json:"peers"`
+	TopChurn []*PrefixChurn      `json:"top_churn_prefixes"`
+}
+
+func init() {
+	if err := bmp.RegisterMessageSchema(bmp.ReportMsg, Report{}); err != nil {
+		panic(err)
+	}
+}
+
+// PeerAvailability defines the availability of a peer during the period of the report, Availability is
+// the percentage of time the peer was up, out of the time the peer was known to the collector.
+type PeerAvailability struct {
+	RouterIP     string  `json:"router_ip"`
+	PeerIP       string  `json:"peer_ip"`
+	PeerRD       string  `json:"peer_rd,omitempty"`
+	PeerASN      uint32  `json:"peer_asn"`
+	State        string  `json:"state"`
+	UpSeconds    int64   `json:"up_seconds"`
+	Availability float64 `json:"availability"`
+	Flaps        int     `json:"flaps"`
+}
+
+// PrefixChurn defines the number of announcements and withdrawals of a prefix during the period of the report,
+// counted over all peers.
+type PrefixChurn struct {
+	Prefix        string `json:"prefix"`
+	VPNRD         string `json:"vpn_rd,omitempty"`
+	Announcements uint64 `json:"announcements"`
+	Withdrawals   uint64 `json:"withdrawals"`
+}
+
+type peerKey struct {
+	routerIP string
+	peerIP   string
+	peerRD   string
+}
+
+type peerState struct {
+	info PeerAvailability
+	up   bool
+	// since is the time of the last state change or the start of the period
+	since time.Time
+	// observed is the start of the period or the time the peer was first seen during the period
+	observed time.Time
+	upTime   time.Duration
+}
+
+// prefixChurn is the churn of a prefix with the time of its last change
+type prefixChurn struct {
+	prefix string
+	vpnRD  string
+	churn.Counter
+	last time.Time
+}
+
+type reporter struct {
+	sync.Mutex
+	publisher pub.Publisher
+	config    *Config
+	now       func() time.Time
+	start     time.Time
+	peers     map[peerKey]*peerState
+	churn     map[string]*prefixChurn
+	stop      chan struct{}
+}
+
+func (r *reporter) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	switch {
+	case msgType == bmp.PeerStateChangeMsg:
+		m, err := churn.DecodePeer(msg)
+		if err != nil {
+			glog.Errorf("failed to decode peer message for reports with error: %+v", err)
+			break
+		}
+		r.peerStateChange(m)
+	case churn.IsRoute(msgType):
+		m, err := churn.DecodePrefix(msg)
+		if err != nil {
+			glog.Errorf("failed to decode prefix message for reports with error: %+v", err)
+			break
+		}
+		if m.Route() {
+			r.prefixChange(m)
+		}
+	}
+
+	return r.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+func (r *reporter) Stop() {
+	close(r.stop)
+	r.publisher.Stop()
+}
+
+func (r *reporter) peerStateChange(m *churn.Peer) {
+	k := peerKey{routerIP: m.RouterIP, peerIP: m.RemoteIP, peerRD: m.PeerRD}
+	now := r.now()
+	r.Lock()
+	defer r.Unlock()
+	p, ok := r.peers[k]
+	if !ok {
+		p = &peerState{
+			info: PeerAvailability{
+				RouterIP: m.RouterIP,
+				PeerIP:   m.RemoteIP,
+				PeerRD:   m.PeerRD,
+			},
+			since:    now,
+			observed: now,
+		}
+		r.peers[k] = p
+	}
+	if m.RemoteASN != 0 {
+		p.info.PeerASN = m.RemoteASN
+	}
+	up := m.Up()
+	if p.up {
+		p.upTime += now.Sub(p.since)
+	}
+	if p.up && !up {
+		p.info.Flaps++
+	}
+	p.up = up
+	p.since = now
+}
+
+func (r *reporter) prefixChange(m *churn.Prefix) {
+	name := m.Name()
+	k := m.VPNRD + " " + name
+	now := r.now()
+	r.Lock()
+	defer r.Unlock()
+	c, ok := r.churn[k]
+	if !ok {
+		c = &prefixChurn{prefix: name, vpnRD: m.VPNRD}
+		r.churn[k] = c
+	}
+	c.last = now
+	c.Count(m)
+}
+
+// EvictPeer removes availability of the peer of the router, or of all peers of the router when peerIP is empty,
+// from the current and next reports
+func (r *reporter) EvictPeer(routerIP, peerRD, peerIP string) int {
+	r.Lock()
+	defer r.Unlock()
+	n := 0
+	for k := range r.peers {
+		if k.routerIP == routerIP && (peerIP == "" || k.peerRD == peerRD && k.peerIP == peerIP) {
+			delete(r.peers, k)
+			n++
+		}
+	}
+
+	return n
+}
+
+// Expire removes churn of prefixes not changed since before from the current period, the churn is emitted
+// in partial report first
+func (r *reporter) Expire(before time.Time) int {
+	end := r.now()
+	r.Lock()
+	var expired []*prefixChurn
+	for k, c := range r.churn {
+		if c.last.Before(before) {
+			expired = append(expired, c)
+			delete(r.churn, k)
+		}
+	}
+	start := r.start
+	r.Unlock()
+	if len(expired) == 0 {
+		return 0
+	}
+	r.emit(&Report{
+		Start:    start.UTC().Format(time.RFC3339),
+		End:      end.UTC().Format(time.RFC3339),
+		Partial:  true,
+		Peers:    make([]*PeerAvailability, 0),
+		TopChurn: r.topChurn(expired),
+	})
+
+	return len(expired)
+}
+
+// MemoryUsage returns estimated memory used by availability of peers of routers and churn of prefixes counted
+// since the start of the period, churn is counted over all peers
+func (r *reporter) MemoryUsage() memory.Usage {
+	r.Lock()
+	defer r.Unlock()
+	c := memory.NewCounter("report")
+	for k, p := range r.peers {
+		c.Add(k.routerIP, k.peerIP, uint64(unsafe.Sizeof(k)+unsafe.Sizeof(p)+unsafe.Sizeof(*p))+
+			uint64(2*(len(k.routerIP)+len(k.peerIP)+len(k.peerRD)))+memory.MapEntryOverhead)
+	}
+	for k, p := range r.churn {
+		c.AddShared(1, uint64(unsafe.Sizeof(k)+unsafe.Sizeof(p)+unsafe.Sizeof(*p))+
+			uint64(len(k)+len(p.prefix)+len(p.vpnRD))+memory.MapEntryOverhead)
+	}
+
+	return c.Usage()
+}
+
+// report returns the report of the period ending now and starts a new period
+func (r *reporter) report() *Report {
+	end := r.now()
+	r.Lock()
+	defer r.Unlock()
+	rep := &Report{
+		Start: r.start.UTC().Format(time.RFC3339),
+		End:   end.UTC().Format(time.RFC3339),
+		Peers: make([]*PeerAvailability, 0, len(r.peers)),
+	}
+	for _, p := range r.peers {
+		if p.up {
+			p.upTime += end.Sub(p.since)
+		}
+		info := p.info
+		info.State = "down"
+		if p.up {
+			info.State = "up"
+		}
+		info.UpSeconds = int64(p.upTime / time.Second)
+		if observed := end.Sub(p.observed); observed > 0 {
+			info.Availability = float64(p.upTime) * 100 / float64(observed)
+		}
+		rep.Peers = append(rep.Peers, &info)
+		// Starting the next period with the current state of the peer
+		p.info.Flaps = 0
+		p.upTime = 0
+		p.since = end
+		p.observed = end
+	}
+	sort.Slice(rep.Peers, func(i, j int) bool {
+		a, b := rep.Peers[i], rep.Peers[j]
+		if a.RouterIP != b.RouterIP {
+			return a.RouterIP < b.RouterIP
+		}
+		if a.PeerRD != b.PeerRD {
+			return a.PeerRD < b.PeerRD
+		}
+		return a.PeerIP < b.PeerIP
+	})
+	changed := make([]*prefixChurn, 0, len(r.churn))
+	for _, c := range r.churn {
+		changed = append(changed, c)
+	}
+	rep.TopChurn = r.topChurn(changed)
+	r.churn = make(map[string]*prefixChurn)
+	r.start = end
+
+	return rep
+}
+
+// topChurn returns the most churning prefixes of changed prefixes
+func (r *reporter) topChurn(changed []*prefixChurn) []*PrefixChurn {
+	top := make([]*PrefixChurn, 0, len(changed))
+	for _, c := range changed {
+		top = append(top, &PrefixChurn{
+			Prefix:        c.prefix,
+			VPNRD:         c.vpnRD,
+			Announcements: c.Announcements,
+			Withdrawals:   c.Withdrawals,
+		})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		a, b := top[i].Announcements+top[i].Withdrawals, top[j].Announcements+top[j].Withdrawals
+		if a != b {
+			return a > b
+		}
+		if top[i].VPNRD != top[j].VPNRD {
+			return top[i].VPNRD < top[j].VPNRD
+		}
+		return top[i].Prefix < top[j].Prefix
+	})
+	if len(top) > r.config.TopPrefixes {
+		top = top[:r.config.TopPrefixes]
+	}
+
+	return top
+}
+
+func (r *reporter) generate() {
+	r.emit(r.report())
+}
+
+// emit writes the report to files and publishes it, as configured
+func (r *reporter) emit(rep *Report) {
+	if r.config.Dir != "" {
+		if err := r.write(rep); err != nil {
+			glog.Errorf("failed to write report with error: %+v", err)
+		}
+	}
+	if r.config.Publish {
+		b, err := json.Marshal(rep)
+		if err != nil {
+			glog.Errorf("failed to marshal report with error: %+v", err)
+			return
+		}
+		if err := r.publisher.PublishMessage(bmp.ReportMsg, []byte(rep.End), b); err != nil {
+			glog.Errorf("failed to publish report with error: %+v", err)
+		}
+	}
+}
+
+// write writes the report to files named after the end of the report period, names of partial reports end
+// with "-partial"
+func (r *reporter) write(rep *Report) error {
+	end, _ := time.Parse(time.RFC3339, rep.End)
+	name := filepath.Join(r.config.Dir, "report-"+end.UTC().Format("20060102T150405Z"))
+	if rep.Partial {
+		name += "-partial"
+	}
+	if r.config.Format == FormatJSON {
+		b, err := json.MarshalIndent(rep, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(name+".json", b, 0o644)
+	}
+	peers := [][]string{{"start", "end", "router_ip", "peer_ip", "peer_rd", "peer_asn", "state", "up_seconds", "availability", "flaps"}}
+	for _, p := range rep.Peers {
+		peers = append(peers, []string{rep.Start, rep.End, p.RouterIP, p.PeerIP, p.PeerRD, strconv.FormatUint(uint64(p.PeerASN), 10),
+			p.State, strconv.FormatInt(p.UpSeconds, 10), strconv.FormatFloat(p.Availability, 'f', 3, 64), strconv.Itoa(p.Flaps)})
+	}
+	if err := writeCSV(name+"-peers.csv", peers); err != nil {
+		return err
+	}
+	churn := [][]string{{"start", "end", "prefix", "vpn_rd", "announcements", "withdrawals"}}
+	for _, c := range rep.TopChurn {
+		churn = append(churn, []string{rep.Start, rep.End, c.Prefix, c.VPNRD, strconv.FormatUint(c.Announcements, 10),
+			strconv.FormatUint(c.Withdrawals, 10)})
+	}
+
+	return writeCSV(name+"-churn.csv", churn)
+}
+
+func writeCSV(file string, records [][]string) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(f)
+	if err := w.WriteAll(records); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// run generates reports at multiples of the interval until the reporter is stopped
+func (r *reporter) run() {
+	for {
+		now := r.now()
+		next := now.Truncate(r.config.Interval).Add(r.config.Interval)
+		t := time.NewTimer(next.Sub(now))
+		select {
+		case <-t.C:
+			r.generate()
+		case <-r.stop:
+			t.Stop()
+			return
+		}
+	}
+}
+
+// NewReporter returns a publisher collecting peers availability and prefixes churn from published messages
+// and generating reports periodically, messages are passed to the wrapped publisher. Reports are written to
+// files or published as messages to the wrapped publisher.
+func NewReporter(publisher pub.Publisher, config *Config) (pub.Publisher, error) {
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("invalid report interval %s", config.Interval)
+	}
+	if config.Format == "" {
+		config.Format = FormatJSON
+	}
+	if config.Format != FormatJSON && config.Format != FormatCSV {
+		return nil, fmt.Errorf("unknown report format %q", config.Format)
+	}
+	if config.Dir == "" && !config.Publish {
+		return nil, fmt.Errorf("reports are neither written to files nor published")
+	}
+	if config.Dir != "" {
+		if fi, err := os.Stat(config.Dir); err != nil || !fi.IsDir() {
+			return nil, fmt.Errorf("report directory %s is not accessible", config.Dir)
+		}
+	}
+	if config.TopPrefixes <= 0 {
+		config.TopPrefixes = defaultTopPrefixes
+	}
+	r := newReporter(publisher, config, time.Now)
+	go r.run()
+
+	return r, nil
+}
+
+func newReporter(publisher pub.Publisher, config *Config, now func() time.Time) *reporter {
+	return &reporter{
+		publisher: publisher,
+		config:    config,
+		now:       now,
+		start:     now(),
+		peers:     make(map[peerKey]*peerState),
+		churn:     make(map[string]*prefixChurn),
+		stop:      make(chan struct{}),
+	}
+}
@@ -0,0 +1,201 @@
+package report
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	msgs map[int][][]byte
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.msgs[msgType] = append(p.msgs[msgType], msg)
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+type testEvent struct {
+	at      time.Duration
+	msgType int
+	msg     string
+}
+
+func TestReport(t *testing.T) {
+	start := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		events []testEvent
+		end    time.Duration
+		top    int
+		peers  []PeerAvailability
+		churn  []PrefixChurn
+	}{
+		{
+			name: "peer flap and churn",
+			events: []testEvent{
+				{at: 0, msgType: bmp.PeerStateChangeMsg, msg: `{"action":"add","router_ip":"10.0.0.1","remote_ip":"192.168.1.1","remote_asn":65001}`},
+				{at: time.Hour, msgType: bmp.UnicastPrefixV4Msg, msg: `{"action":"add","prefix":"10.1.0.0","prefix_len":16}`},
+				{at: time.Hour, msgType: bmp.UnicastPrefixV4Msg, msg: `{"action":"del","prefix":"10.1.0.0","prefix_len":16}`},
+				{at: time.Hour, msgType: bmp.UnicastPrefixV4Msg, msg: `{"action":"add","prefix":"10.2.0.0","prefix_len":16}`},
+				{at: time.Hour, msgType: bmp.UnicastPrefixV4Msg, msg: `{"action":"add","is_eor":true}`},
+				{at: time.Hour, msgType: bmp.L3VPNV4Msg, msg: `{"action":"add","prefix":"10.1.0.0","prefix_len":16,"vpn_rd":"100:1"}`},
+				{at: 6 * time.Hour, msgType: bmp.PeerStateChangeMsg, msg: `{"action":"down","router_ip":"10.0.0.1","remote_ip":"192.168.1.1"}`},
+				{at: 12 * time.Hour, msgType: bmp.PeerStateChangeMsg, msg: `{"action":"down","router_ip":"10.0.0.2","remote_ip":"192.168.2.1"}`},
+			},
+			end: 24 * time.Hour,
+			top: 2,
+			peers: []PeerAvailability{
+				{RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", PeerASN: 65001, State: "down", UpSeconds: 6 * 3600, Availability: 25, Flaps: 1},
+				{RouterIP: "10.0.0.2", PeerIP: "192.168.2.1", State: "down"},
+			},
+			churn: []PrefixChurn{
+				{Prefix: "10.1.0.0/16", Announcements: 1, Withdrawals: 1},
+				{Prefix: "10.2.0.0/16", Announcements: 1},
+			},
+		},
+		{
+			name: "peer up since the middle of the period",
+			events: []testEvent{
+				{at: 18 * time.Hour, msgType: bmp.PeerStateChangeMsg, msg: `{"action":"add","router_ip":"10.0.0.1","remote_ip":"192.168.1.1"}`},
+			},
+			end: 24 * time.Hour,
+			top: 10,
+			peers: []PeerAvailability{
+				{RouterIP: "10.0.0.1", PeerIP: "192.168.1.1", State: "up", UpSeconds: 6 * 3600, Availability: 100},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := start
+			p := &testPublisher{msgs: make(map[int][][]byte)}
+			r := newReporter(p, &Config{Interval: 24 * time.Hour, TopPrefixes: tt.top}, func() time.Time { return now })
+			for _, e := range tt.events {
+				now = start.Add(e.at)
+				if err := r.PublishMessage(e.msgType, nil, []byte(e.msg)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+			}
+			if len(p.msgs[bmp.PeerStateChangeMsg])+len(p.msgs[bmp.UnicastPrefixV4Msg])+len(p.msgs[bmp.L3VPNV4Msg]) != len(tt.events) {
+				t.Fatalf("messages are not passed to the publisher")
+			}
+			now = start.Add(tt.end)
+			rep := r.report()
+			if len(rep.Peers) != len(tt.peers) {
+				t.Fatalf("expected %d peers but got %d", len(tt.peers), len(rep.Peers))
+			}
+			for i := range tt.peers {
+				if *rep.Peers[i] != tt.peers[i] {
+					t.Errorf("expected peer %+v but got %+v", tt.peers[i], *rep.Peers[i])
+				}
+			}
+			if len(rep.TopChurn) != len(tt.churn) {
+				t.Fatalf("expected %d prefixes but got %d", len(tt.churn), len(rep.TopChurn))
+			}
+			for i := range tt.churn {
+				if *rep.TopChurn[i] != tt.churn[i] {
+					t.Errorf("expected prefix %+v but got %+v", tt.churn[i], *rep.TopChurn[i])
+				}
+			}
+			// The next period starts with the current state of peers and no churn
+			now = now.Add(24 * time.Hour)
+			rep = r.report()
+			if len(rep.TopChurn) != 0 {
+				t.Errorf("expected no churn in the next period but got %d prefixes", len(rep.TopChurn))
+			}
+			for i, p := range rep.Peers {
+				if p.State != tt.peers[i].State || p.Flaps != 0 || (p.State == "up") != (p.Availability == 100) {
+					t.Errorf("unexpected peer %+v in the next period", *p)
+				}
+			}
+		})
+	}
+}
+
+func TestReportOutput(t *testing.T) {
+	for _, format := range []string{FormatJSON, FormatCSV} {
+		t.Run(format, func(t *testing.T) {
+			dir := t.TempDir()
+			now := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+			p := &testPublisher{msgs: make(map[int][][]byte)}
+			r := newReporter(p, &Config{Interval: time.Hour, Dir: dir, Format: format, Publish: true, TopPrefixes: 1}, func() time.Time { return now })
+			_ = r.PublishMessage(bmp.PeerStateChangeMsg, nil, []byte(`{"action":"add","router_ip":"10.0.0.1","remote_ip":"192.168.1.1"}`))
+			now = now.Add(time.Hour)
+			r.generate()
+			if len(p.msgs[bmp.ReportMsg]) != 1 {
+				t.Fatalf("expected 1 published report but got %d", len(p.msgs[bmp.ReportMsg]))
+			}
+			rep := &Report{}
+			if err := json.Unmarshal(p.msgs[bmp.ReportMsg][0], rep); err != nil {
+				t.Fatalf("failed to decode published report with error: %+v", err)
+			}
+			if len(rep.Peers) != 1 || rep.End != "2026-10-14T01:00:00Z" {
+				t.Errorf("unexpected published report %+v", rep)
+			}
+			files := []string{"report-20261014T010000Z.json"}
+			if format == FormatCSV {
+				files = []string{"report-20261014T010000Z-peers.csv", "report-20261014T010000Z-churn.csv"}
+			}
+			for _, f := range files {
+				if _, err := os.Stat(filepath.Join(dir, f)); err != nil {
+					t.Errorf("report file %s is not written with error: %+v", f, err)
+				}
+			}
+		})
+	}
+}
+
+func TestReportRetention(t *testing.T) {
+	now := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	dir := t.TempDir()
+	p := &testPublisher{msgs: make(map[int][][]byte)}
+	r := newReporter(p, &Config{Interval: time.Hour, Dir: dir, Format: FormatJSON, Publish: true, TopPrefixes: 10}, func() time.Time { return now })
+	_ = r.PublishMessage(bmp.PeerStateChangeMsg, nil, []byte(`{"action":"down","router_ip":"10.0.0.1","remote_ip":"192.168.1.1"}`))
+	_ = r.PublishMessage(bmp.PeerStateChangeMsg, nil, []byte(`{"action":"add","router_ip":"10.0.0.2","remote_ip":"192.168.1.1"}`))
+	_ = r.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(`{"action":"add","prefix":"10.1.0.0","prefix_len":16}`))
+	_ = r.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(`{"action":"del","prefix":"10.1.0.0","prefix_len":16}`))
+	now = now.Add(30 * time.Minute)
+	_ = r.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(`{"action":"add","prefix":"10.2.0.0","prefix_len":16}`))
+	if n := r.EvictPeer("10.0.0.1", "", ""); n != 1 {
+		t.Errorf("expected 1 evicted peer but got %d", n)
+	}
+	if n := r.Expire(now); n != 1 {
+		t.Errorf("expected 1 expired prefix but got %d", n)
+	}
+	// Churn of the expired prefix is emitted in partial report before it is dropped from the period
+	if len(p.msgs[bmp.ReportMsg]) != 1 {
+		t.Fatalf("expected 1 partial report but got %d reports", len(p.msgs[bmp.ReportMsg]))
+	}
+	partial := &Report{}
+	if err := json.Unmarshal(p.msgs[bmp.ReportMsg][0], partial); err != nil {
+		t.Fatalf("failed to decode partial report with error: %+v", err)
+	}
+	want := PrefixChurn{Prefix: "10.1.0.0/16", Announcements: 1, Withdrawals: 1}
+	if !partial.Partial || partial.Start != "2026-10-14T00:00:00Z" || partial.End != "2026-10-14T00:30:00Z" ||
+		len(partial.TopChurn) != 1 || *partial.TopChurn[0] != want || len(partial.Peers) != 0 {
+		t.Errorf("expected partial report of churn %+v but got %+v", want, partial)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "report-20261014T003000Z-partial.json")); err != nil {
+		t.Errorf("partial report file is not written with error: %+v", err)
+	}
+	if n := r.Expire(now); n != 0 {
+		t.Errorf("expected no expired prefixes but got %d", n)
+	}
+	if len(p.msgs[bmp.ReportMsg]) != 1 {
+		t.Errorf("expected no partial report without expired prefixes but got %d reports", len(p.msgs[bmp.ReportMsg]))
+	}
+	rep := r.report()
+	if len(rep.Peers) != 1 || rep.Peers[0].RouterIP != "10.0.0.2" {
+		t.Errorf("expected only peer of router 10.0.0.2 but got %+v", rep.Peers)
+	}
+	if len(rep.TopChurn) != 1 || rep.TopChurn[0].Prefix != "10.2.0.0/16" {
+		t.Errorf("expected only churn of prefix 10.2.0.0/16 but got %+v", rep.TopChurn)
+	}
+}
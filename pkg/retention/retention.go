@@ -0,0 +1,293 @@
+package retention
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// checkInterval is the period between checks for peers and routers gone for longer than the retention
+const checkInterval = time.Minute
+
+// Retention is a publisher evicting state stored by subsystems for peers down, and routers without BMP session,
+// for longer than the retention
+type Retention interface {
+	pub.Publisher
+	// Add adds the subsystem to the retention, state of the subsystem implementing memory.Evicter is evicted
+	// per peer, state of the subsystem implementing memory.Expirer expires after the retention. address translates
+	// addresses of routers and peers into addresses stored by the subsystem, nil when they are stored unchanged.
+	Add(r memory.Reporter, address func(string) string)
+	// SetActiveRouters sets the function returning routers with active BMP sessions, state of other routers
+	// is evicted after the retention
+	SetActiveRouters(routers func() []string)
+	// Reporters returns added subsystems reporting entries evicted by the retention with their memory usage
+	Reporters() []memory.Reporter
+}
+
+type peerMsg struct {
+	Action   string `json:"action"`
+	RouterIP string `json:"router_ip"`
+	RemoteIP string `json:"remote_ip"`
+	PeerRD   string `json:"peer_rd"`
+}
+
+type routerPeer struct {
+	routerIP string
+	peerRD   string
+	peerIP   string
+}
+
+// subsystem is a subsystem added to the retention
+type subsystem struct {
+	reporter memory.Reporter
+	address  func(string) string
+	sync.Mutex
+	evicted uint64
+}
+
+func (s *subsystem) MemoryUsage() memory.Usage {
+	u := s.reporter.MemoryUsage()
+	s.Lock()
+	u.Evicted = s.evicted
+	s.Unlock()
+
+	return u
+}
+
+func (s *subsystem) evict(routerIP, peerRD, peerIP string) {
+	e, ok := s.reporter.(memory.Evicter)
+	if !ok {
+		return
+	}
+	if s.address != nil {
+		routerIP = s.address(routerIP)
+		if peerIP != "" {
+			peerIP = s.address(peerIP)
+		}
+	}
+	s.count(e.EvictPeer(routerIP, peerRD, peerIP))
+}
+
+func (s *subsystem) expire(before time.Time) {
+	if e, ok := s.reporter.(memory.Expirer); ok {
+		s.count(e.Expire(before))
+	}
+}
+
+func (s *subsystem) count(n int) {
+	s.Lock()
+	s.evicted += uint64(n)
+	s.Unlock()
+}
+
+type retention struct {
+	sync.Mutex
+	publisher  pub.Publisher
+	retention  time.Duration
+	subsystems []*subsystem
+	active     func() []string
+	// down stores the time peers of routers went down
+	down map[routerPeer]time.Time
+	// routers stores routers with peers, closed stores the time the BMP session of the router was found closed
+	routers map[string]bool
+	closed  map[string]time.Time
+	now     func() time.Time
+	stop    chan struct{}
+}
+
+func (r *retention) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if msgType == bmp.PeerStateChangeMsg {
+		m := &peerMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode peer message for state retention with error: %+v", err)
+		} else {
+			r.peerStateChange(m)
+		}
+	}
+
+	return r.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+func (r *retention) Stop() {
+	close(r.stop)
+	r.publisher.Stop()
+}
+
+func (r *retention) Add(rep memory.Reporter, address func(string) string) {
+	r.Lock()
+	defer r.Unlock()
+	r.subsystems = append(r.subsystems, &subsystem{reporter: rep, address: address})
+}
+
+func (r *retention) SetActiveRouters(routers func() []string) {
+	r.Lock()
+	defer r.Unlock()
+	r.active = routers
+}
+
+func (r *retention) Reporters() []memory.Reporter {
+	r.Lock()
+	defer r.Unlock()
+	l := make([]memory.Reporter, 0, len(r.subsystems)+1)
+	for _, s := range r.subsystems {
+		l = append(l, s)
+	}
+
+	return append(l, r)
+}
+
+func (r *retention) peerStateChange(m *peerMsg) {
+	rp := routerPeer{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.RemoteIP}
+	now := r.now()
+	r.Lock()
+	defer r.Unlock()
+	r.routers[m.RouterIP] = true
+	// Peer Up message carries action "add"
+	if m.Action == "add" {
+		delete(r.down, rp)
+		return
+	}
+	if _, ok := r.down[rp]; !ok {
+		r.down[rp] = now
+	}
+}
+
+// expired returns peers down and routers without BMP session for longer than the retention, and removes them
+func (r *retention) expired(now time.Time) ([]routerPeer, []string) {
+	r.Lock()
+	defer r.Unlock()
+	var routers []string
+	if r.active != nil {
+		active := make(map[string]bool)
+		for _, ip := range r.active() {
+			active[ip] = true
+		}
+		for ip := range r.routers {
+			if active[ip] {
+				delete(r.closed, ip)
+				continue
+			}
+			since, ok := r.closed[ip]
+			if !ok {
+				r.closed[ip] = now
+				continue
+			}
+			if now.Sub(since) < r.retention {
+				continue
+			}
+			routers = append(routers, ip)
+			delete(r.routers, ip)
+			delete(r.closed, ip)
+		}
+	}
+	var peers []routerPeer
+	for rp, since := range r.down {
+		if now.Sub(since) >= r.retention {
+			peers = append(peers, rp)
+			delete(r.down, rp)
+		}
+	}
+	// Peers of evicted routers are evicted with the router
+	removed := make(map[string]bool, len(routers))
+	for _, ip := range routers {
+		removed[ip] = true
+	}
+	for rp := range r.down {
+		if removed[rp.routerIP] {
+			delete(r.down, rp)
+		}
+	}
+	l := peers[:0]
+	for _, rp := range peers {
+		if !removed[rp.routerIP] {
+			l = append(l, rp)
+		}
+	}
+
+	return l, routers
+}
+
+// evict evicts state of peers down and routers without BMP session for longer than the retention, and expires
+// state not bound to peers
+func (r *retention) evict() {
+	now := r.now()
+	peers, routers := r.expired(now)
+	r.Lock()
+	subsystems := r.subsystems
+	r.Unlock()
+	for _, ip := range routers {
+		glog.Infof("state of router %s is evicted, its BMP session is closed for longer than %s", ip, r.retention)
+		for _, s := range subsystems {
+			s.evict(ip, "", "")
+		}
+	}
+	for _, rp := range peers {
+		glog.V(5).Infof("state of peer %s of router %s is evicted, the peer is down for longer than %s", rp.peerIP, rp.routerIP, r.retention)
+		for _, s := range subsystems {
+			s.evict(rp.routerIP, rp.peerRD, rp.peerIP)
+		}
+	}
+	for _, s := range subsystems {
+		s.expire(now.Add(-r.retention))
+	}
+}
+
+func (r *retention) run() {
+	t := time.NewTicker(checkInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			r.evict()
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// MemoryUsage returns estimated memory used by peers down and routers tracked by the retention
+func (r *retention) MemoryUsage() memory.Usage {
+	r.Lock()
+	defer r.Unlock()
+	c := memory.NewCounter("retention")
+	for rp, t := range r.down {
+		c.Add(rp.routerIP, rp.peerIP, uint64(unsafe.Sizeof(rp)+unsafe.Sizeof(t))+uint64(len(rp.routerIP)+len(rp.peerRD)+len(rp.peerIP))+memory.MapEntryOverhead)
+	}
+	for ip := range r.routers {
+		b := uint64(unsafe.Sizeof(ip)+unsafe.Sizeof(true)) + uint64(len(ip)) + memory.MapEntryOverhead
+		if t, ok := r.closed[ip]; ok {
+			b += uint64(unsafe.Sizeof(ip)+unsafe.Sizeof(t)) + memory.MapEntryOverhead
+		}
+		c.AddSession(ip, 1, b)
+	}
+
+	return c.Usage()
+}
+
+// NewRetention returns a publisher tracking peers going down, state stored by added subsystems for peers down
+// longer than the retention, and for routers without BMP session for longer than the retention, is evicted.
+// State not bound to peers expires when it is not updated for the retention. Messages are passed to publisher.
+func NewRetention(publisher pub.Publisher, retain time.Duration) Retention {
+	r := newRetention(publisher, retain, time.Now)
+	go r.run()
+
+	return r
+}
+
+func newRetention(publisher pub.Publisher, retain time.Duration, now func() time.Time) *retention {
+	return &retention{
+		publisher: publisher,
+		retention: retain,
+		down:      make(map[routerPeer]time.Time),
+		routers:   make(map[string]bool),
+		closed:    make(map[string]time.Time),
+		now:       now,
+		stop:      make(chan struct{}),
+	}
+}
@@ -0,0 +1,172 @@
+package retention
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+)
+
+type testPublisher struct{}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error { return nil }
+
+func (p *testPublisher) Stop() {}
+
+// testSubsystem stores one entry per peer of a router, and entries expiring after the retention
+type testSubsystem struct {
+	peers   map[routerPeer]bool
+	updated []time.Time
+	evicted []string
+}
+
+func (s *testSubsystem) MemoryUsage() memory.Usage {
+	return memory.NewCounter("test").Usage()
+}
+
+func (s *testSubsystem) EvictPeer(routerIP, peerRD, peerIP string) int {
+	n := 0
+	for rp := range s.peers {
+		if rp.routerIP == routerIP && (peerIP == "" || rp.peerRD == peerRD && rp.peerIP == peerIP) {
+			delete(s.peers, rp)
+			s.evicted = append(s.evicted, strings.Join(strings.Fields(rp.routerIP+" "+rp.peerRD+" "+rp.peerIP), " "))
+			n++
+		}
+	}
+
+	return n
+}
+
+func (s *testSubsystem) Expire(before time.Time) int {
+	l := s.updated[:0]
+	for _, t := range s.updated {
+		if !t.Before(before) {
+			l = append(l, t)
+		}
+	}
+	n := len(s.updated) - len(l)
+	s.updated = l
+
+	return n
+}
+
+func peerState(action, router, rd, peer string) []byte {
+	return []byte(`{"action":"` + action + `","router_ip":"` + router + `","peer_rd":"` + rd + `","remote_ip":"` + peer + `"}`)
+}
+
+type testStep struct {
+	// after is the time since the previous step
+	after  time.Duration
+	action string
+	router string
+	rd     string
+	peer   string
+}
+
+func TestRetention(t *testing.T) {
+	tests := []struct {
+		name   string
+		steps  []testStep
+		active []string
+		// check is the time since the last step the retention is checked
+		check   time.Duration
+		evicted []string
+	}{
+		{
+			name:   "peer down within retention",
+			steps:  []testStep{{0, "down", "10.0.0.1", "", "192.168.0.1"}},
+			active: []string{"10.0.0.1"},
+			check:  30 * time.Minute,
+		},
+		{
+			name:    "peer down for retention",
+			steps:   []testStep{{0, "down", "10.0.0.1", "", "192.168.0.1"}, {30 * time.Minute, "down", "10.0.0.1", "", "192.168.0.1"}},
+			active:  []string{"10.0.0.1"},
+			check:   30 * time.Minute,
+			evicted: []string{"10.0.0.1 192.168.0.1"},
+		},
+		{
+			name:   "peer up again",
+			steps:  []testStep{{0, "down", "10.0.0.1", "", "192.168.0.1"}, {30 * time.Minute, "add", "10.0.0.1", "", "192.168.0.1"}},
+			active: []string{"10.0.0.1"},
+			check:  time.Hour,
+		},
+		{
+			name:    "router without session",
+			steps:   []testStep{{0, "add", "10.0.0.1", "", "192.168.0.1"}, {0, "down", "10.0.0.1", "", "192.168.0.2"}, {0, "add", "10.0.0.2", "", "192.168.0.1"}},
+			active:  []string{"10.0.0.2"},
+			check:   time.Hour,
+			evicted: []string{"10.0.0.1 192.168.0.1", "10.0.0.1 192.168.0.2"},
+		},
+		{
+			name: "peers of route distinguisher instances sharing the address",
+			steps: []testStep{{0, "down", "10.0.0.1", "65000:1", "192.168.0.1"}, {30 * time.Minute, "add", "10.0.0.1", "65000:2", "192.168.0.1"},
+				{30 * time.Minute, "down", "10.0.0.1", "65000:1", "192.168.0.1"}},
+			active:  []string{"10.0.0.1"},
+			check:   30 * time.Minute,
+			evicted: []string{"10.0.0.1 65000:1 192.168.0.1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			r := newRetention(&testPublisher{}, time.Hour, func() time.Time { return now })
+			r.SetActiveRouters(func() []string { return tt.active })
+			s := &testSubsystem{peers: make(map[routerPeer]bool)}
+			r.Add(s, nil)
+			for _, st := range tt.steps {
+				now = now.Add(st.after)
+				s.peers[routerPeer{routerIP: st.router, peerRD: st.rd, peerIP: st.peer}] = true
+				if err := r.PublishMessage(bmp.PeerStateChangeMsg, nil, peerState(st.action, st.router, st.rd, st.peer)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+			}
+			// The first check finds routers without session, they are evicted after the retention
+			r.evict()
+			now = now.Add(tt.check)
+			r.evict()
+			sort.Strings(s.evicted)
+			if !reflect.DeepEqual(s.evicted, tt.evicted) {
+				t.Errorf("got evicted %v, want %v", s.evicted, tt.evicted)
+			}
+			u := r.Reporters()[0].MemoryUsage()
+			if u.Evicted != uint64(len(tt.evicted)) {
+				t.Errorf("got %d evicted entries in memory usage, want %d", u.Evicted, len(tt.evicted))
+			}
+		})
+	}
+}
+
+func TestRetentionExpire(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := newRetention(&testPublisher{}, time.Hour, func() time.Time { return now })
+	s := &testSubsystem{updated: []time.Time{now, now.Add(30 * time.Minute), now.Add(2 * time.Hour)}}
+	r.Add(s, nil)
+	now = now.Add(90 * time.Minute)
+	r.evict()
+	if len(s.updated) != 2 {
+		t.Errorf("got %d entries after expiration, want 2", len(s.updated))
+	}
+	if u := r.Reporters()[0].MemoryUsage(); u.Evicted != 1 {
+		t.Errorf("got %d evicted entries in memory usage, want 1", u.Evicted)
+	}
+}
+
+func TestRetentionAddress(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := newRetention(&testPublisher{}, time.Hour, func() time.Time { return now })
+	s := &testSubsystem{peers: map[routerPeer]bool{{routerIP: "a-10.0.0.1", peerIP: "a-192.168.0.1"}: true}}
+	r.Add(s, func(ip string) string { return "a-" + ip })
+	if err := r.PublishMessage(bmp.PeerStateChangeMsg, nil, peerState("down", "10.0.0.1", "", "192.168.0.1")); err != nil {
+		t.Fatalf("failed to publish message with error: %+v", err)
+	}
+	now = now.Add(time.Hour)
+	r.evict()
+	if want := []string{"a-10.0.0.1 a-192.168.0.1"}; !reflect.DeepEqual(s.evicted, want) {
+		t.Errorf("got evicted %v, want %v", s.evicted, want)
+	}
+}
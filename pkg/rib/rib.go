@@ -0,0 +1,794 @@
+package rib
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"net/netip"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/radix"
+)
+
+// volatileKeys lists keys of route messages which change without a change of the route's path,
+// they are excluded from the path hash
+var volatileKeys = map[string]bool{
+	"_key":                true,
+	"_id":                 true,
+	"_rev":                true,
+	"action":              true,
+	"sequence":            true,
+	"hash":                true,
+	"router_hash":         true,
+	"router_ip":           true,
+	"peer_hash":           true,
+	"timestamp":           true,
+	"collector_timestamp": true,
+	"nexthop_unresolved":  true,
+	"duplicate":           true,
+	"first_seen":          true,
+	"last_changed":        true,
+	"path_hash":           true,
+	"change":              true,
+	"previous_nexthop":    true,
+	"previous_base_attrs": true,
+	"stale":               true,
+	"stale_routes":        true,
+}
+
+type routeMsg struct {
+	Action           string    `json:"action"`
+	RouterIP         string    `json:"router_ip"`
+	PeerIP           string    `json:"peer_ip"`
+	PeerType         uint8     `json:"peer_type"`
+	PeerRD           string    `json:"peer_rd"`
+	PeerASN          uint32    `json:"peer_asn"`
+	VPNRD            string    `json:"vpn_rd"`
+	Prefix           string    `json:"prefix"`
+	PrefixLen        int32     `json:"prefix_len"`
+	PathID           int32     `json:"path_id"`
+	Nexthop          string    `json:"nexthop"`
+	BaseAttributes   *attrsMsg `json:"base_attrs"`
+	RIBType          string    `json:"rib_type"`
+	IsEOR            bool      `json:"is_eor"`
+	IsAdjRIBInPost   bool      `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool      `json:"is_adj_rib_out_post_policy"`
+	IsLocRIBFiltered bool      `json:"is_loc_rib_filtered"`
+	IsLLGRStale      bool      `json:"is_llgr_stale"`
+	Change           string    `json:"change"`
+}
+
+// snapshotMsg defines messages of routes republished by Snapshot
+type snapshotMsg struct {
+	Action           string    `json:"action"`
+	RouterHash       string    `json:"router_hash"`
+	RouterIP         string    `json:"router_ip"`
+	PeerIP           string    `json:"peer_ip"`
+	PeerType         uint8     `json:"peer_type"`
+	PeerRD           string    `json:"peer_rd"`
+	PeerASN          uint32    `json:"peer_asn"`
+	VPNRD            string    `json:"vpn_rd,omitempty"`
+	Prefix           string    `json:"prefix"`
+	PrefixLen        int32     `json:"prefix_len"`
+	IsIPv4           bool      `json:"is_ipv4"`
+	PathID           int32     `json:"path_id,omitempty"`
+	Nexthop          string    `json:"nexthop"`
+	BaseAttributes   *attrsMsg `json:"base_attrs"`
+	RIBType          string    `json:"rib_type,omitempty"`
+	IsAdjRIBInPost   bool      `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool      `json:"is_adj_rib_out_post_policy"`
+	IsLocRIBFiltered bool      `json:"is_loc_rib_filtered"`
+}
+
+// attrsMsg defines base attributes of route messages exported by Routes and carried by withdrawals
+type attrsMsg struct {
+	ASPath           []uint32 `json:"as_path,omitempty"`
+	MED              uint32   `json:"med,omitempty"`
+	LocalPref        uint32   `json:"local_pref,omitempty"`
+	CommunityList    []string `json:"community_list,omitempty"`
+	ExtCommunityList []string `json:"ext_community_list,omitempty"`
+	LgCommunityList  []string `json:"large_community_list,omitempty"`
+}
+
+type peerMsg struct {
+	Action   string                     `json:"action"`
+	RouterIP string                     `json:"router_ip"`
+	RemoteIP string                     `json:"remote_ip"`
+	PeerRD   string                     `json:"peer_rd"`
+	AdvCap   map[string]json.RawMessage `json:"adv_cap"`
+	RecvCap  map[string]json.RawMessage `json:"recv_cap"`
+}
+
+// Capabilities of Graceful Restart (RFC 4724) and Long-Lived Graceful Restart (RFC 9494)
+const (
+	capGracefulRestart          = "64"
+	capLongLivedGracefulRestart = "71"
+)
+
+// gracefulRestart returns true when both the router and the peer advertised Graceful Restart or Long-Lived
+// Graceful Restart capability, the router then retains routes of the peer as stale when the session goes down
+func (m *peerMsg) gracefulRestart() bool {
+	for _, c := range []string{capGracefulRestart, capLongLivedGracefulRestart} {
+		_, adv := m.AdvCap[c]
+		_, recv := m.RecvCap[c]
+		if adv && recv {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Changes of routes carried by messages of advertised routes
+const (
+	// ChangeNew marks routes not stored in the RIB
+	ChangeNew = "new"
+	// ChangeUpdate marks routes advertised with a changed path
+	ChangeUpdate = "update"
+	// ChangeDuplicate marks routes advertised again with the same path
+	ChangeDuplicate = "duplicate"
+	// ChangeSnapshot marks routes republished by Snapshot
+	ChangeSnapshot = "snapshot"
+)
+
+// Stale states of routes
+const (
+	// StaleGR marks routes of a peer retained by Graceful Restart after the session with the peer went down,
+	// until the peer advertises them again or sends End-of-RIB
+	StaleGR = "gr"
+	// StaleLLGR marks routes carrying LLGR_STALE community
+	StaleLLGR = "llgr"
+)
+
+// routeKey identifies a route of a BGP peer in a RIB of a router
+type routeKey struct {
+	msgType          int
+	peerType         uint8
+	peerASN          uint32
+	vpnRD            string
+	prefix           string
+	prefixLen        int32
+	pathID           int32
+	isAdjRIBInPost   bool
+	isAdjRIBOutPost  bool
+	isLocRIBFiltered bool
+}
+
+// routerPeer identifies a peer of a router, peers of distinct route distinguisher instances may share the address
+type routerPeer struct {
+	routerIP string
+	peerRD   string
+	peerIP   string
+}
+
+// routeRef identifies a route of a peer of a router in the prefix index
+type routeRef struct {
+	rp routerPeer
+	rk routeKey
+}
+
+// route stores the time the route was first reported, the time its path last changed, its stale state and
+// attributes exported by Routes
+type route struct {
+	firstSeen   time.Time
+	lastChanged time.Time
+	pathHash    uint64
+	stale       string
+	ribType     string
+	nexthop     string
+	attrs       attrsMsg
+}
+
+// Route defines a route of a peer of a router stored in the RIB
+type Route struct {
+	// Type is the type of messages of the route
+	Type             int       `json:"-"`
+	RouterIP         string    `json:"router_ip"`
+	PeerIP           string    `json:"peer_ip"`
+	PeerRD           string    `json:"peer_rd,omitempty"`
+	PeerASN          uint32    `json:"peer_asn,omitempty"`
+	RIBType          string    `json:"rib_type,omitempty"`
+	VPNRD            string    `json:"vpn_rd,omitempty"`
+	Prefix           string    `json:"prefix"`
+	PrefixLen        int32     `json:"prefix_len"`
+	PathID           int32     `json:"path_id,omitempty"`
+	Nexthop          string    `json:"nexthop,omitempty"`
+	ASPath           []uint32  `json:"as_path,omitempty"`
+	Communities      []string  `json:"community_list,omitempty"`
+	LargeCommunities []string  `json:"large_community_list,omitempty"`
+	MED              uint32    `json:"med,omitempty"`
+	LocalPref        uint32    `json:"local_pref,omitempty"`
+	FirstSeen        time.Time `json:"first_seen"`
+	LastChanged      time.Time `json:"last_changed"`
+	Stale            string    `json:"stale,omitempty"`
+}
+
+// RIB defines a publisher storing unicast and l3vpn routes of peers of routers
+type RIB interface {
+	pub.Publisher
+	// Routes returns routes of the peer of the router, of all its route distinguisher instances, ordered by peer
+	// distinguisher, RIB type, route distinguisher, prefix and Path Identifier
+	Routes(routerIP, peerIP string) []Route
+	// Lookup returns routes of the prefix of all peers of all routers ordered by router, peer, peer distinguisher,
+	// RIB type, route distinguisher and Path Identifier
+	Lookup(prefix string, prefixLen int32) []Route
+	// Snapshot publishes messages of routes of all peers of the router stored in the RIB and returns the number
+	// of published messages
+	Snapshot(routerIP string) (int, error)
+	// SetSnapshotPublisher sets the publisher of messages of Snapshot, the head of the publisher chain wrapping the
+	// RIB, so snapshots pass through the same stages as messages of routes reported by routers
+	SetSnapshotPublisher(head pub.Publisher)
+}
+
+type rib struct {
+	sync.Mutex
+	publisher pub.Publisher
+	// snapshots is the publisher of messages of Snapshot, messages are passed to publisher when it is not set
+	snapshots pub.Publisher
+	// routes stores routes per peer of a router
+	routes map[routerPeer]map[routeKey]*route
+	// prefixes indexes routes of all peers of all routers by prefix
+	prefixes *radix.Tree[map[routeRef]*route]
+	// gr stores peers of routers which negotiated Graceful Restart
+	gr map[routerPeer]bool
+	// withdrawals enables previous_nexthop and previous_base_attrs of withdrawals of stored routes
+	withdrawals bool
+	now         func() time.Time
+}
+
+func (r *rib) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	switch msgType {
+	case bmp.PeerStateChangeMsg:
+		m := &peerMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode peer message for route age with error: %+v", err)
+			break
+		}
+		rp := routerPeer{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.RemoteIP}
+		if m.Action == "add" {
+			r.peerUp(rp, m.gracefulRestart())
+			break
+		}
+		if n := r.peerDown(rp); n != 0 {
+			msg = tagStaleRoutes(msg, n)
+		}
+	case bmp.UnicastPrefixMsg, bmp.UnicastPrefixV4Msg, bmp.UnicastPrefixV6Msg, bmp.L3VPNMsg, bmp.L3VPNV4Msg, bmp.L3VPNV6Msg:
+		m := &routeMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode route message for route age with error: %+v", err)
+			break
+		}
+		if m.Change == ChangeSnapshot {
+			// Messages of Snapshot passed through the publisher chain do not change stored routes
+			break
+		}
+		if m.IsEOR {
+			r.endOfRIB(msgType, routerPeer{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.PeerIP})
+			break
+		}
+		h, err := pathHash(msg)
+		if err != nil {
+			glog.Errorf("failed to hash route message for route age with error: %+v", err)
+			break
+		}
+		if rt, change := r.update(msgType, m, h); rt != nil {
+			msg = tag(msg, rt, change, r.withdrawals && m.Action == "del")
+		}
+	}
+
+	return r.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+func (r *rib) Stop() {
+	r.publisher.Stop()
+}
+
+// update records the route reported by the router and returns its state and change, withdrawn routes are removed
+// and their last state is returned without a change, nil is returned for withdrawn routes which were not reported
+func (r *rib) update(msgType int, m *routeMsg, h uint64) (*route, string) {
+	rk := routeKey{
+		msgType:          msgType,
+		peerType:         m.PeerType,
+		peerASN:          m.PeerASN,
+		vpnRD:            m.VPNRD,
+		prefix:           m.Prefix,
+		prefixLen:        m.PrefixLen,
+		pathID:           m.PathID,
+		isAdjRIBInPost:   m.IsAdjRIBInPost,
+		isAdjRIBOutPost:  m.IsAdjRIBOutPost,
+		isLocRIBFiltered: m.IsLocRIBFiltered,
+	}
+	rp := routerPeer{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.PeerIP}
+	r.Lock()
+	defer r.Unlock()
+	rt, ok := r.routes[rp][rk]
+	if m.Action == "del" {
+		if !ok {
+			return nil, ""
+		}
+		r.remove(rp, rk)
+		return rt, ""
+	}
+	now := r.now().UTC()
+	change := ChangeDuplicate
+	if !ok {
+		change = ChangeNew
+		rt = &route{firstSeen: now, lastChanged: now, pathHash: h}
+		r.insert(rp, rk, rt)
+	} else if rt.pathHash != h {
+		change = ChangeUpdate
+		rt.lastChanged = now
+		rt.pathHash = h
+	}
+	rt.ribType, rt.nexthop = m.RIBType, m.Nexthop
+	rt.attrs = attrsMsg{}
+	if m.BaseAttributes != nil {
+		rt.attrs = *m.BaseAttributes
+	}
+	// Advertised route is no longer stale by Graceful Restart, it remains stale by Long-Lived Graceful Restart
+	// while it carries LLGR_STALE community
+	rt.stale = ""
+	if m.IsLLGRStale {
+		rt.stale = StaleLLGR
+	}
+	c := *rt
+
+	return &c, change
+}
+
+// indexKey returns the prefix of the route in the prefix index
+func indexKey(rk *routeKey) (netip.Prefix, bool) {
+	addr, err := netip.ParseAddr(rk.prefix)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	p, err := addr.Unmap().Prefix(int(rk.prefixLen))
+
+	return p, err == nil
+}
+
+// insert stores the route of the peer and indexes it by prefix
+func (r *rib) insert(rp routerPeer, rk routeKey, rt *route) {
+	if r.routes[rp] == nil {
+		r.routes[rp] = make(map[routeKey]*route)
+	}
+	r.routes[rp][rk] = rt
+	p, ok := indexKey(&rk)
+	if !ok {
+		return
+	}
+	refs, _ := r.prefixes.Get(p)
+	if refs == nil {
+		refs = make(map[routeRef]*route)
+		r.prefixes.Insert(p, refs)
+	}
+	refs[routeRef{rp: rp, rk: rk}] = rt
+}
+
+// remove removes the route of the peer and its entry of the prefix index
+func (r *rib) remove(rp routerPeer, rk routeKey) {
+	delete(r.routes[rp], rk)
+	if len(r.routes[rp]) == 0 {
+		delete(r.routes, rp)
+	}
+	p, ok := indexKey(&rk)
+	if !ok {
+		return
+	}
+	if refs, _ := r.prefixes.Get(p); refs != nil {
+		delete(refs, routeRef{rp: rp, rk: rk})
+		if len(refs) == 0 {
+			r.prefixes.Delete(p)
+		}
+	}
+}
+
+// removePeer removes all routes of the peer and returns the number of removed routes
+func (r *rib) removePeer(rp routerPeer) int {
+	routes := r.routes[rp]
+	n := len(routes)
+	for rk := range routes {
+		r.remove(rp, rk)
+	}
+
+	return n
+}
+
+// exported returns the route of the peer of the router with its attributes
+func exported(rp routerPeer, rk *routeKey, rt *route) Route {
+	return Route{
+		Type:             rk.msgType,
+		RouterIP:         rp.routerIP,
+		PeerIP:           rp.peerIP,
+		PeerRD:           rp.peerRD,
+		PeerASN:          rk.peerASN,
+		RIBType:          rt.ribType,
+		VPNRD:            rk.vpnRD,
+		Prefix:           rk.prefix,
+		PrefixLen:        rk.prefixLen,
+		PathID:           rk.pathID,
+		Nexthop:          rt.nexthop,
+		ASPath:           rt.attrs.ASPath,
+		Communities:      rt.attrs.CommunityList,
+		LargeCommunities: rt.attrs.LgCommunityList,
+		MED:              rt.attrs.MED,
+		LocalPref:        rt.attrs.LocalPref,
+		FirstSeen:        rt.firstSeen,
+		LastChanged:      rt.lastChanged,
+		Stale:            rt.stale,
+	}
+}
+
+// Routes returns routes of the peer of the router, of all its route distinguisher instances, with their attributes
+func (r *rib) Routes(routerIP, peerIP string) []Route {
+	routes := make([]Route, 0)
+	r.Lock()
+	for rp, peerRoutes := range r.routes {
+		if rp.routerIP != routerIP || rp.peerIP != peerIP {
+			continue
+		}
+		for rk, rt := range peerRoutes {
+			routes = append(routes, exported(rp, &rk, rt))
+		}
+	}
+	r.Unlock()
+	sort.Slice(routes, func(i, j int) bool {
+		a, b := &routes[i], &routes[j]
+		if a.PeerRD != b.PeerRD {
+			return a.PeerRD < b.PeerRD
+		}
+		if a.RIBType != b.RIBType {
+			return a.RIBType < b.RIBType
+		}
+		if a.VPNRD != b.VPNRD {
+			return a.VPNRD < b.VPNRD
+		}
+		if c := bytes.Compare(net.ParseIP(a.Prefix).To16(), net.ParseIP(b.Prefix).To16()); c != 0 {
+			return c < 0
+		}
+		if a.PrefixLen != b.PrefixLen {
+			return a.PrefixLen < b.PrefixLen
+		}
+		return a.PathID < b.PathID
+	})
+
+	return routes
+}
+
+// Lookup returns routes of the prefix of all peers of all routers with their attributes, routes of all route
+// distinguishers of l3vpn prefixes are returned
+func (r *rib) Lookup(prefix string, prefixLen int32) []Route {
+	routes := make([]Route, 0)
+	p, ok := indexKey(&routeKey{prefix: prefix, prefixLen: prefixLen})
+	if !ok {
+		return routes
+	}
+	r.Lock()
+	refs, _ := r.prefixes.Get(p)
+	for ref, rt := range refs {
+		if ref.rk.prefix == prefix && ref.rk.prefixLen == prefixLen {
+			routes = append(routes, exported(ref.rp, &ref.rk, rt))
+		}
+	}
+	r.Unlock()
+	sort.Slice(routes, func(i, j int) bool {
+		a, b := &routes[i], &routes[j]
+		if c := bytes.Compare(net.ParseIP(a.RouterIP).To16(), net.ParseIP(b.RouterIP).To16()); c != 0 {
+			return c < 0
+		}
+		if c := bytes.Compare(net.ParseIP(a.PeerIP).To16(), net.ParseIP(b.PeerIP).To16()); c != 0 {
+			return c < 0
+		}
+		if a.PeerRD != b.PeerRD {
+			return a.PeerRD < b.PeerRD
+		}
+		if a.RIBType != b.RIBType {
+			return a.RIBType < b.RIBType
+		}
+		if a.VPNRD != b.VPNRD {
+			return a.VPNRD < b.VPNRD
+		}
+		return a.PathID < b.PathID
+	})
+
+	return routes
+}
+
+// Snapshot publishes messages of routes of all peers of the router, so consumers rebuild routes of the router without
+// a new BMP session, messages carry action "add", change "snapshot" and the state of the route. Messages are passed to
+// the publisher set by SetSnapshotPublisher, or to the publisher of the RIB, and do not change stored routes.
+func (r *rib) Snapshot(routerIP string) (int, error) {
+	type snapshotRoute struct {
+		rp routerPeer
+		rk routeKey
+		rt route
+	}
+	var routes []snapshotRoute
+	r.Lock()
+	publisher := r.snapshots
+	if publisher == nil {
+		publisher = r.publisher
+	}
+	for rp, peerRoutes := range r.routes {
+		if rp.routerIP != routerIP {
+			continue
+		}
+		for rk, rt := range peerRoutes {
+			routes = append(routes, snapshotRoute{rp: rp, rk: rk, rt: *rt})
+		}
+	}
+	r.Unlock()
+	sort.Slice(routes, func(i, j int) bool {
+		a, b := &routes[i], &routes[j]
+		if a.rp != b.rp {
+			return a.rp.peerIP < b.rp.peerIP || a.rp.peerIP == b.rp.peerIP && a.rp.peerRD < b.rp.peerRD
+		}
+		if a.rk.prefix != b.rk.prefix {
+			return a.rk.prefix < b.rk.prefix
+		}
+		if a.rk.prefixLen != b.rk.prefixLen {
+			return a.rk.prefixLen < b.rk.prefixLen
+		}
+		return a.rk.pathID < b.rk.pathID
+	})
+	hash := fmt.Sprintf("%x", md5.Sum([]byte(routerIP)))
+	for i := range routes {
+		sr := &routes[i]
+		addr, _ := netip.ParseAddr(sr.rk.prefix)
+		attrs := sr.rt.attrs
+		m := &snapshotMsg{
+			Action:           "add",
+			RouterHash:       hash,
+			RouterIP:         routerIP,
+			PeerIP:           sr.rp.peerIP,
+			PeerType:         sr.rk.peerType,
+			PeerRD:           sr.rp.peerRD,
+			PeerASN:          sr.rk.peerASN,
+			VPNRD:            sr.rk.vpnRD,
+			Prefix:           sr.rk.prefix,
+			PrefixLen:        sr.rk.prefixLen,
+			IsIPv4:           addr.Is4(),
+			PathID:           sr.rk.pathID,
+			Nexthop:          sr.rt.nexthop,
+			BaseAttributes:   &attrs,
+			RIBType:          sr.rt.ribType,
+			IsAdjRIBInPost:   sr.rk.isAdjRIBInPost,
+			IsAdjRIBOutPost:  sr.rk.isAdjRIBOutPost,
+			IsLocRIBFiltered: sr.rk.isLocRIBFiltered,
+		}
+		msg, err := json.Marshal(m)
+		if err != nil {
+			return i, fmt.Errorf("failed to marshal snapshot of route with error: %+v", err)
+		}
+		if err := publisher.PublishMessage(sr.rk.msgType, []byte(hash), tag(msg, &sr.rt, ChangeSnapshot, false)); err != nil {
+			return i, fmt.Errorf("failed to publish snapshot of route with error: %+v", err)
+		}
+	}
+
+	return len(routes), nil
+}
+
+func (r *rib) SetSnapshotPublisher(head pub.Publisher) {
+	r.Lock()
+	defer r.Unlock()
+	r.snapshots = head
+}
+
+func (r *rib) peerUp(rp routerPeer, gr bool) {
+	r.Lock()
+	defer r.Unlock()
+	if gr {
+		r.gr[rp] = true
+	} else {
+		delete(r.gr, rp)
+	}
+}
+
+// peerDown removes routes of the peer, routes of the peer which negotiated Graceful Restart are retained as stale
+// instead, and returns the number of retained routes
+func (r *rib) peerDown(rp routerPeer) int {
+	r.Lock()
+	defer r.Unlock()
+	if !r.gr[rp] {
+		r.removePeer(rp)
+		return 0
+	}
+	for _, rt := range r.routes[rp] {
+		if rt.stale == "" {
+			rt.stale = StaleGR
+		}
+	}
+
+	return len(r.routes[rp])
+}
+
+// endOfRIB removes routes of the message type of the peer still stale by Graceful Restart, the peer did not
+// advertise them again before End-of-RIB
+func (r *rib) endOfRIB(msgType int, rp routerPeer) {
+	r.Lock()
+	defer r.Unlock()
+	for rk, rt := range r.routes[rp] {
+		if rk.msgType == msgType && rt.stale == StaleGR {
+			r.remove(rp, rk)
+		}
+	}
+}
+
+// EvictPeer removes routes of the peer of the route distinguisher instance of the router, or of all peers of the
+// router when peerIP is empty
+func (r *rib) EvictPeer(routerIP, peerRD, peerIP string) int {
+	r.Lock()
+	defer r.Unlock()
+	n := 0
+	for rp := range r.routes {
+		if rp.routerIP == routerIP && (peerIP == "" || rp.peerRD == peerRD && rp.peerIP == peerIP) {
+			n += r.removePeer(rp)
+		}
+	}
+	for rp := range r.gr {
+		if rp.routerIP == routerIP && (peerIP == "" || rp.peerRD == peerRD && rp.peerIP == peerIP) {
+			delete(r.gr, rp)
+		}
+	}
+
+	return n
+}
+
+// MemoryUsage returns estimated memory used by routes stored per peer of routers and by their prefix index
+func (r *rib) MemoryUsage() memory.Usage {
+	r.Lock()
+	defer r.Unlock()
+	c := memory.NewCounter("rib")
+	for rp, routes := range r.routes {
+		for rk, rt := range routes {
+			// The route is stored per peer and in the prefix index
+			b := uint64(unsafe.Sizeof(rk)+unsafe.Sizeof(rt)+unsafe.Sizeof(*rt)+unsafe.Sizeof(routeRef{})+unsafe.Sizeof(rt)) + 2*memory.MapEntryOverhead +
+				uint64(len(rk.vpnRD)+len(rk.prefix)+len(rt.ribType)+len(rt.nexthop)+4*len(rt.attrs.ASPath))
+			for _, c := range rt.attrs.CommunityList {
+				b += uint64(len(c)) + uint64(unsafe.Sizeof(c))
+			}
+			for _, c := range rt.attrs.ExtCommunityList {
+				b += uint64(len(c)) + uint64(unsafe.Sizeof(c))
+			}
+			for _, c := range rt.attrs.LgCommunityList {
+				b += uint64(len(c)) + uint64(unsafe.Sizeof(c))
+			}
+			c.Add(rp.routerIP, rp.peerIP, b)
+		}
+	}
+	for rp := range r.gr {
+		c.Add(rp.routerIP, rp.peerIP, uint64(unsafe.Sizeof(rp)+unsafe.Sizeof(true))+uint64(len(rp.peerRD))+memory.MapEntryOverhead)
+	}
+	c.AddShared(r.prefixes.Len(), r.prefixes.Size())
+
+	return c.Usage()
+}
+
+// pathHash returns the hash of the route message keys and values excluding keys changing without a change
+// of the route's path
+func pathHash(msg []byte) (uint64, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &m); err != nil {
+		return 0, err
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		if !volatileKeys[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	h := fnv.New64a()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write(m[k])
+		h.Write([]byte{0})
+	}
+
+	return h.Sum64(), nil
+}
+
+// tag returns a copy of json object msg with first_seen, last_changed and path_hash keys of the route, change key of
+// advertised routes and stale key of stale routes, previous_nexthop and previous_base_attrs keys carry the next hop and
+// attributes of the route when previous is true
+func tag(msg []byte, rt *route, change string, previous bool) []byte {
+	var attrs []byte
+	if previous {
+		var err error
+		if attrs, err = json.Marshal(&rt.attrs); err != nil {
+			glog.Errorf("failed to marshal previous attributes of withdrawn route with error: %+v", err)
+			previous = false
+		}
+	}
+	t, ok := openObject(msg, 136+len(rt.nexthop)+len(attrs))
+	if !ok {
+		return msg
+	}
+	t = append(t, `"first_seen":"`...)
+	t = rt.firstSeen.AppendFormat(t, time.RFC3339)
+	t = append(t, `","last_changed":"`...)
+	t = rt.lastChanged.AppendFormat(t, time.RFC3339)
+	t = append(t, `","path_hash":"`...)
+	t = strconv.AppendUint(t, rt.pathHash, 16)
+	if change != "" {
+		t = append(t, `","change":"`...)
+		t = append(t, change...)
+	}
+	if rt.stale != "" {
+		t = append(t, `","stale":"`...)
+		t = append(t, rt.stale...)
+	}
+	t = append(t, '"')
+	if previous {
+		if rt.nexthop != "" {
+			t = append(t, `,"previous_nexthop":`...)
+			t = strconv.AppendQuote(t, rt.nexthop)
+		}
+		t = append(t, `,"previous_base_attrs":`...)
+		t = append(t, attrs...)
+	}
+
+	return append(t, '}')
+}
+
+// tagStaleRoutes returns a copy of json object msg with stale_routes key carrying the number of routes of the peer
+// retained as stale
+func tagStaleRoutes(msg []byte, n int) []byte {
+	t, ok := openObject(msg, 24)
+	if !ok {
+		return msg
+	}
+	t = append(t, `"stale_routes":`...)
+	t = strconv.AppendInt(t, int64(n), 10)
+
+	return append(t, '}')
+}
+
+// openObject returns a copy of json object msg without its closing brace, followed by a comma when the object
+// has keys, so keys can be appended, extra is the capacity reserved for appended keys
+func openObject(msg []byte, extra int) ([]byte, bool) {
+	b := bytes.TrimRight(msg, " \t\r\n")
+	if len(b) < 2 || b[len(b)-1] != '}' {
+		return nil, false
+	}
+	t := make([]byte, 0, len(b)+extra)
+	t = append(t, b[:len(b)-1]...)
+	if len(b) > 2 {
+		t = append(t, ',')
+	}
+
+	return t, true
+}
+
+// NewRIB returns a publisher storing unicast and l3vpn routes of peers of routers, messages of the routes are passed to
+// publisher with first_seen, the time the route was first reported, last_changed, the time its path last changed, and
+// path_hash, the hash of the route's attributes. Messages of advertised routes carry change, "new" for routes not
+// stored, "update" for routes of a changed path and "duplicate" for routes advertised again with the same path.
+// Messages of withdrawn routes carry the last state of the route, with withdrawals they also carry previous_nexthop and
+// previous_base_attrs, the next hop, AS path, MED, Local Preference and communities of the route. Routes of a peer are
+// removed when the peer goes down, routes of a peer which negotiated Graceful Restart are retained as stale, messages
+// of stale routes carry stale key, "gr" or "llgr", and Peer Down message of the peer carries stale_routes, the number
+// of retained routes. Routes of a peer with their next hop, AS path, communities, MED and Local Preference are returned
+// by Routes, routes of a prefix of all peers are returned by Lookup. Snapshot republishes routes of a router.
+func NewRIB(publisher pub.Publisher, withdrawals bool) RIB {
+	return &rib{
+		publisher:   publisher,
+		routes:      make(map[routerPeer]map[routeKey]*route),
+		prefixes:    radix.New[map[routeRef]*route](),
+		gr:          make(map[routerPeer]bool),
+		withdrawals: withdrawals,
+		now:         time.Now,
+	}
+}
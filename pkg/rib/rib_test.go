@@ -0,0 +1,460 @@
+package rib
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+var start = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type testPublisher struct {
+	msgs   []string
+	hashes []string
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	m := &struct {
+		Action      string `json:"action"`
+		FirstSeen   string `json:"first_seen"`
+		LastChanged string `json:"last_changed"`
+		PathHash    string `json:"path_hash"`
+		Change      string `json:"change"`
+		Stale       string `json:"stale"`
+		StaleRoutes int    `json:"stale_routes"`
+	}{}
+	if err := json.Unmarshal(msg, m); err != nil {
+		return err
+	}
+	s := m.Action
+	for _, ts := range []string{m.FirstSeen, m.LastChanged} {
+		if ts == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, ts)
+		if err != nil {
+			return err
+		}
+		s += " " + t.Sub(start).String()
+	}
+	if m.Change != "" {
+		s += " " + m.Change
+	}
+	if m.Stale != "" {
+		s += " stale=" + m.Stale
+	}
+	if m.StaleRoutes != 0 {
+		s += " stale_routes=" + strconv.Itoa(m.StaleRoutes)
+	}
+	p.msgs = append(p.msgs, s)
+	p.hashes = append(p.hashes, m.PathHash)
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+type testMsg struct {
+	msgType int
+	msg     string
+}
+
+func unicast(action, prefix, nexthop, timestamp string) testMsg {
+	return testMsg{bmp.UnicastPrefixV4Msg, `{"action":"` + action + `","router_ip":"10.0.0.1","timestamp":"` + timestamp +
+		`","peer_ip":"192.168.0.1","peer_asn":65001,"prefix":"` + prefix + `","prefix_len":16,"nexthop":"` + nexthop + `"}`}
+}
+
+func TestRIB(t *testing.T) {
+	peerDown := testMsg{bmp.PeerStateChangeMsg, `{"action":"down","router_ip":"10.0.0.1","remote_ip":"192.168.0.1"}`}
+	peerUpGR := testMsg{bmp.PeerStateChangeMsg, `{"action":"add","router_ip":"10.0.0.1","remote_ip":"192.168.0.1",` +
+		`"adv_cap":{"1":[],"64":[]},"recv_cap":{"1":[],"64":[]}}`}
+	peerUp := testMsg{bmp.PeerStateChangeMsg, `{"action":"add","router_ip":"10.0.0.1","remote_ip":"192.168.0.1",` +
+		`"adv_cap":{"1":[],"64":[]},"recv_cap":{"1":[]}}`}
+	eor := testMsg{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","is_eor":true}`}
+	tests := []struct {
+		name       string
+		msgs       []testMsg
+		want       []string
+		sameHashes bool
+	}{
+		{
+			name:       "new route",
+			msgs:       []testMsg{unicast("add", "10.1.0.0", "10.9.9.9", "1")},
+			want:       []string{"add 1m0s 1m0s new"},
+			sameHashes: true,
+		},
+		{
+			name:       "route refreshed",
+			msgs:       []testMsg{unicast("add", "10.1.0.0", "10.9.9.9", "1"), unicast("add", "10.1.0.0", "10.9.9.9", "2")},
+			want:       []string{"add 1m0s 1m0s new", "add 1m0s 1m0s duplicate"},
+			sameHashes: true,
+		},
+		{
+			name: "path changed",
+			msgs: []testMsg{unicast("add", "10.1.0.0", "10.9.9.9", "1"), unicast("add", "10.1.0.0", "10.8.8.8", "2")},
+			want: []string{"add 1m0s 1m0s new", "add 1m0s 2m0s update"},
+		},
+		{
+			name: "different prefixes",
+			msgs: []testMsg{unicast("add", "10.1.0.0", "10.9.9.9", "1"), unicast("add", "10.2.0.0", "10.9.9.9", "2")},
+			want: []string{"add 1m0s 1m0s new", "add 2m0s 2m0s new"},
+		},
+		{
+			name: "route withdrawn and readvertised",
+			msgs: []testMsg{unicast("add", "10.1.0.0", "10.9.9.9", "1"), unicast("del", "10.1.0.0", "", "2"),
+				unicast("add", "10.1.0.0", "10.9.9.9", "3")},
+			want: []string{"add 1m0s 1m0s new", "del 1m0s 1m0s", "add 3m0s 3m0s new"},
+		},
+		{
+			name: "unknown route withdrawn",
+			msgs: []testMsg{unicast("del", "10.1.0.0", "", "1")},
+			want: []string{"del"},
+		},
+		{
+			name: "peer down",
+			msgs: []testMsg{unicast("add", "10.1.0.0", "10.9.9.9", "1"), peerDown, unicast("add", "10.1.0.0", "10.9.9.9", "3")},
+			want: []string{"add 1m0s 1m0s new", "down", "add 3m0s 3m0s new"},
+		},
+		{
+			name: "graceful restart retains routes",
+			msgs: []testMsg{peerUpGR, unicast("add", "10.1.0.0", "10.9.9.9", "1"), peerDown, peerUpGR,
+				unicast("add", "10.1.0.0", "10.9.9.9", "3")},
+			want: []string{"add", "add 2m0s 2m0s new", "down stale_routes=1", "add", "add 2m0s 2m0s duplicate"},
+		},
+		{
+			name: "stale routes withdrawn and removed by end of rib",
+			msgs: []testMsg{peerUpGR, unicast("add", "10.1.0.0", "10.9.9.9", "1"), unicast("add", "10.2.0.0", "10.9.9.9", "1"),
+				unicast("add", "10.3.0.0", "10.9.9.9", "1"), peerDown, peerUpGR, unicast("del", "10.2.0.0", "", "3"),
+				unicast("add", "10.1.0.0", "10.9.9.9", "3"), eor, unicast("del", "10.3.0.0", "", "4"),
+				unicast("del", "10.1.0.0", "", "4")},
+			want: []string{"add", "add 2m0s 2m0s new", "add 3m0s 3m0s new", "add 4m0s 4m0s new", "down stale_routes=3", "add",
+				"del 3m0s 3m0s stale=gr", "add 2m0s 2m0s duplicate", "add", "del", "del 2m0s 2m0s"},
+		},
+		{
+			name: "routes removed without graceful restart of the peer",
+			msgs: []testMsg{peerUp, unicast("add", "10.1.0.0", "10.9.9.9", "1"), peerDown, unicast("add", "10.1.0.0", "10.9.9.9", "3")},
+			want: []string{"add", "add 2m0s 2m0s new", "down", "add 4m0s 4m0s new"},
+		},
+		{
+			name: "long-lived stale route",
+			msgs: []testMsg{unicast("add", "10.1.0.0", "10.9.9.9", "1"),
+				{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","peer_asn":65001,` +
+					`"prefix":"10.1.0.0","prefix_len":16,"nexthop":"10.9.9.9","is_llgr_stale":true}`},
+				unicast("add", "10.1.0.0", "10.9.9.9", "3")},
+			want: []string{"add 1m0s 1m0s new", "add 1m0s 2m0s update stale=llgr", "add 1m0s 3m0s update"},
+		},
+		{
+			name: "end of rib",
+			msgs: []testMsg{{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","is_eor":true}`}},
+			want: []string{"add"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{}
+			r := NewRIB(p, false).(*rib)
+			now := start
+			r.now = func() time.Time {
+				return now
+			}
+			for _, m := range tt.msgs {
+				now = now.Add(time.Minute)
+				if err := r.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+			}
+			if !reflect.DeepEqual(p.msgs, tt.want) {
+				t.Errorf("got messages %v, want %v", p.msgs, tt.want)
+			}
+			if tt.sameHashes {
+				for _, h := range p.hashes {
+					if h == "" || h != p.hashes[0] {
+						t.Errorf("got path hashes %v, want the same path hash", p.hashes)
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	p := &testPublisher{}
+	r := NewRIB(p, false)
+	now := start
+	r.(*rib).now = func() time.Time {
+		return now
+	}
+	msgs := []testMsg{
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","peer_asn":65001,"prefix":"10.10.0.0",` +
+			`"prefix_len":16,"nexthop":"192.168.0.1","rib_type":"adj-rib-in-pre"}`},
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","peer_asn":65001,"prefix":"10.9.0.0",` +
+			`"prefix_len":16,"nexthop":"192.168.0.1","rib_type":"adj-rib-in-pre","base_attrs":{"as_path":[65001,65002],"med":10,` +
+			`"local_pref":200,"community_list":["65001:1"],"large_community_list":["65001:1:2"]}}`},
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.2","prefix":"10.1.0.0","prefix_len":16}`},
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","peer_asn":65001,"prefix":"10.8.0.0",` +
+			`"prefix_len":16}`},
+		{bmp.UnicastPrefixV4Msg, `{"action":"del","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","peer_asn":65001,"prefix":"10.8.0.0",` +
+			`"prefix_len":16}`},
+	}
+	for _, m := range msgs {
+		now = now.Add(time.Minute)
+		if err := r.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+			t.Fatalf("failed to publish message with error: %+v", err)
+		}
+	}
+	want := []Route{
+		{
+			Type: bmp.UnicastPrefixV4Msg, RouterIP: "10.0.0.1", PeerIP: "192.168.0.1", PeerASN: 65001, RIBType: "adj-rib-in-pre",
+			Prefix: "10.9.0.0", PrefixLen: 16, Nexthop: "192.168.0.1", ASPath: []uint32{65001, 65002}, Communities: []string{"65001:1"},
+			LargeCommunities: []string{"65001:1:2"}, MED: 10, LocalPref: 200, FirstSeen: start.Add(2 * time.Minute),
+			LastChanged: start.Add(2 * time.Minute),
+		},
+		{
+			Type: bmp.UnicastPrefixV4Msg, RouterIP: "10.0.0.1", PeerIP: "192.168.0.1", PeerASN: 65001, RIBType: "adj-rib-in-pre",
+			Prefix: "10.10.0.0", PrefixLen: 16, Nexthop: "192.168.0.1", FirstSeen: start.Add(time.Minute), LastChanged: start.Add(time.Minute),
+		},
+	}
+	if routes := r.Routes("10.0.0.1", "192.168.0.1"); !reflect.DeepEqual(routes, want) {
+		t.Errorf("got routes %+v, want %+v", routes, want)
+	}
+	if routes := r.Routes("10.0.0.2", "192.168.0.1"); len(routes) != 0 {
+		t.Errorf("expected no routes of unknown router but got %+v", routes)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	p := &testPublisher{}
+	r := NewRIB(p, false)
+	now := start
+	r.(*rib).now = func() time.Time {
+		return now
+	}
+	msgs := []testMsg{
+		unicast("add", "10.2.0.0", "192.168.0.1", ""),
+		unicast("add", "10.1.0.0", "192.168.0.1", ""),
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.2","peer_ip":"192.168.0.1","prefix":"10.3.0.0","prefix_len":16}`},
+	}
+	for _, m := range msgs {
+		now = now.Add(time.Minute)
+		if err := r.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+			t.Fatalf("failed to publish message with error: %+v", err)
+		}
+	}
+	routes := r.Routes("10.0.0.1", "192.168.0.1")
+	p.msgs, p.hashes = nil, nil
+	now = now.Add(time.Minute)
+	n, err := r.Snapshot("10.0.0.1")
+	if err != nil {
+		t.Fatalf("failed to publish snapshot with error: %+v", err)
+	}
+	if n != 2 {
+		t.Errorf("expected snapshot of 2 routes but got %d", n)
+	}
+	if want := []string{"add 2m0s 2m0s snapshot", "add 1m0s 1m0s snapshot"}; !reflect.DeepEqual(p.msgs, want) {
+		t.Errorf("got snapshot messages %v, want %v", p.msgs, want)
+	}
+	if len(p.hashes) != 2 || p.hashes[0] == "" || p.hashes[0] == p.hashes[1] {
+		t.Errorf("expected path hashes of routes of the snapshot but got %v", p.hashes)
+	}
+	if got := r.Routes("10.0.0.1", "192.168.0.1"); !reflect.DeepEqual(got, routes) {
+		t.Errorf("expected routes unchanged by snapshot %+v but got %+v", routes, got)
+	}
+	if n, err := r.Snapshot("10.0.0.9"); err != nil || n != 0 {
+		t.Errorf("expected empty snapshot of unknown router but got %d routes with error: %+v", n, err)
+	}
+}
+
+// outerStage is a stage of the publisher chain wrapping the RIB, it records messages passed through it
+type outerStage struct {
+	next pub.Publisher
+	msgs testPublisher
+}
+
+func (s *outerStage) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if err := s.msgs.PublishMessage(msgType, msgHash, msg); err != nil {
+		return err
+	}
+	return s.next.PublishMessage(msgType, msgHash, msg)
+}
+
+func (s *outerStage) Stop() {}
+
+func TestSnapshotPublisher(t *testing.T) {
+	p := &testPublisher{}
+	r := NewRIB(p, false)
+	now := start
+	r.(*rib).now = func() time.Time {
+		return now
+	}
+	head := &outerStage{next: r}
+	for _, m := range []testMsg{unicast("add", "10.1.0.0", "192.168.0.1", ""), unicast("add", "10.2.0.0", "192.168.0.1", "")} {
+		now = now.Add(time.Minute)
+		if err := head.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+			t.Fatalf("failed to publish message with error: %+v", err)
+		}
+	}
+	routes := r.Routes("10.0.0.1", "192.168.0.1")
+	r.SetSnapshotPublisher(head)
+	p.msgs, head.msgs.msgs = nil, nil
+	now = now.Add(time.Minute)
+	n, err := r.Snapshot("10.0.0.1")
+	if err != nil {
+		t.Fatalf("failed to publish snapshot with error: %+v", err)
+	}
+	if n != 2 {
+		t.Errorf("expected snapshot of 2 routes but got %d", n)
+	}
+	want := []string{"add 1m0s 1m0s snapshot", "add 2m0s 2m0s snapshot"}
+	if !reflect.DeepEqual(head.msgs.msgs, want) {
+		t.Errorf("got snapshot messages of the outer stage %v, want %v", head.msgs.msgs, want)
+	}
+	// Messages of the snapshot pass through the RIB as they are
+	if !reflect.DeepEqual(p.msgs, want) {
+		t.Errorf("got snapshot messages %v, want %v", p.msgs, want)
+	}
+	if got := r.Routes("10.0.0.1", "192.168.0.1"); !reflect.DeepEqual(got, routes) {
+		t.Errorf("expected routes unchanged by snapshot %+v but got %+v", routes, got)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	r := NewRIB(&testPublisher{}, false)
+	msgs := []testMsg{
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.2","peer_ip":"192.168.0.1","prefix":"10.1.0.0","prefix_len":16}`},
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.2","prefix":"10.1.0.0","prefix_len":16}`},
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.10","prefix":"10.1.0.0","prefix_len":16}`},
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.2","prefix":"10.1.0.0","prefix_len":24}`},
+		{bmp.L3VPNV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.2","vpn_rd":"100:1","prefix":"10.1.0.0",` +
+			`"prefix_len":16}`},
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.3","prefix":"10.1.0.0","prefix_len":16}`},
+		{bmp.UnicastPrefixV4Msg, `{"action":"del","router_ip":"10.0.0.1","peer_ip":"192.168.0.3","prefix":"10.1.0.0","prefix_len":16}`},
+	}
+	for _, m := range msgs {
+		if err := r.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+			t.Fatalf("failed to publish message with error: %+v", err)
+		}
+	}
+	var got []string
+	for _, rt := range r.Lookup("10.1.0.0", 16) {
+		got = append(got, rt.RouterIP+" "+rt.PeerIP+" "+rt.VPNRD)
+	}
+	want := []string{"10.0.0.1 192.168.0.2 ", "10.0.0.1 192.168.0.2 100:1", "10.0.0.1 192.168.0.10 ", "10.0.0.2 192.168.0.1 "}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got routes %v, want %v", got, want)
+	}
+	if routes := r.Lookup("10.2.0.0", 16); len(routes) != 0 {
+		t.Errorf("expected no routes of unknown prefix but got %+v", routes)
+	}
+}
+
+func TestPeerDistinguishers(t *testing.T) {
+	r := NewRIB(&testPublisher{}, false)
+	msgs := []testMsg{
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","peer_rd":"65000:1","prefix":"10.1.0.0",` +
+			`"prefix_len":16}`},
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","peer_rd":"65000:2","prefix":"10.1.0.0",` +
+			`"prefix_len":16}`},
+		{bmp.UnicastPrefixV4Msg, `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","peer_rd":"65000:2","prefix":"10.2.0.0",` +
+			`"prefix_len":16}`},
+	}
+	for _, m := range msgs {
+		if err := r.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+			t.Fatalf("failed to publish message with error: %+v", err)
+		}
+	}
+	distinguishers := func(routes []Route) []string {
+		l := make([]string, 0, len(routes))
+		for _, rt := range routes {
+			l = append(l, rt.PeerRD+" "+rt.Prefix)
+		}
+		return l
+	}
+	if got, want := distinguishers(r.Lookup("10.1.0.0", 16)), []string{"65000:1 10.1.0.0", "65000:2 10.1.0.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got routes %v, want %v", got, want)
+	}
+	// Peer Down of one instance of the peer leaves routes of the other instance
+	down := `{"action":"down","router_ip":"10.0.0.1","remote_ip":"192.168.0.1","peer_rd":"65000:1"}`
+	if err := r.PublishMessage(bmp.PeerStateChangeMsg, nil, []byte(down)); err != nil {
+		t.Fatalf("failed to publish message with error: %+v", err)
+	}
+	if got, want := distinguishers(r.Routes("10.0.0.1", "192.168.0.1")), []string{"65000:2 10.1.0.0", "65000:2 10.2.0.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got routes %v after peer down, want %v", got, want)
+	}
+	if n := r.(*rib).EvictPeer("10.0.0.1", "65000:1", "192.168.0.1"); n != 0 {
+		t.Errorf("expected no routes of the instance down evicted but got %d", n)
+	}
+	if n := r.(*rib).EvictPeer("10.0.0.1", "65000:2", "192.168.0.1"); n != 2 {
+		t.Errorf("expected 2 routes of the instance evicted but got %d", n)
+	}
+	if n := r.(*rib).prefixes.Len(); n != 0 {
+		t.Errorf("expected no prefixes indexed after routes are removed but got %d", n)
+	}
+}
+
+type rawPublisher struct {
+	msgs []string
+}
+
+func (p *rawPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.msgs = append(p.msgs, string(msg))
+	return nil
+}
+
+func (p *rawPublisher) Stop() {}
+
+func TestWithdrawals(t *testing.T) {
+	add := `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","prefix":"10.1.0.0","prefix_len":16,"nexthop":"192.168.0.1",` +
+		`"base_attrs":{"as_path":[65001,65002],"med":10,"community_list":["65001:1"],"ext_community_list":["rt=65001:100"],"origin":"igp"}}`
+	del := `{"action":"del","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","prefix":"10.1.0.0","prefix_len":16}`
+	tests := []struct {
+		name        string
+		withdrawals bool
+		msgs        []string
+		// previous is the expected end of the withdrawal, the withdrawal carries no previous attributes when empty
+		previous string
+	}{
+		{
+			name:        "withdrawal of stored route",
+			withdrawals: true,
+			msgs:        []string{add, del},
+			previous: `,"previous_nexthop":"192.168.0.1","previous_base_attrs":{"as_path":[65001,65002],"med":10,` +
+				`"community_list":["65001:1"],"ext_community_list":["rt=65001:100"]}}`,
+		},
+		{
+			name:        "withdrawal of unknown route",
+			withdrawals: true,
+			msgs:        []string{del},
+		},
+		{
+			name: "withdrawals disabled",
+			msgs: []string{add, del},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &rawPublisher{}
+			r := NewRIB(p, tt.withdrawals)
+			for _, m := range tt.msgs {
+				if err := r.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(m)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+			}
+			got := p.msgs[len(p.msgs)-1]
+			if !json.Valid([]byte(got)) {
+				t.Fatalf("got invalid json %s", got)
+			}
+			if tt.previous != "" && !strings.HasSuffix(got, tt.previous) {
+				t.Errorf("got withdrawal %s, want previous attributes %s", got, tt.previous)
+			}
+			if tt.previous == "" && strings.Contains(got, "previous_") {
+				t.Errorf("got withdrawal %s with previous attributes", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package scripting
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"go.starlark.net/starlark"
+)
+
+// toStarlark converts json decoded value to Starlark value, json numbers are converted
+// to Starlark int when they are integers, otherwise to Starlark float.
+func toStarlark(v interface{}) (starlark.Value, error) {
+	switch o := v.(type) {
+	case nil:
+		return starlark.None, nil
+	case bool:
+		return starlark.Bool(o), nil
+	case string:
+		return starlark.String(o), nil
+	case json.Number:
+		if i, ok := new(big.Int).SetString(o.String(), 10); ok {
+			return starlark.MakeBigInt(i), nil
+		}
+		f, err := o.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return starlark.Float(f), nil
+	case []interface{}:
+		l := make([]starlark.Value, len(o))
+		for i := range o {
+			e, err := toStarlark(o[i])
+			if err != nil {
+				return nil, err
+			}
+			l[i] = e
+		}
+		return starlark.NewList(l), nil
+	case map[string]interface{}:
+		// Keys are sorted to make the iteration order of the dict predictable for scripts
+		keys := make([]string, 0, len(o))
+		for k := range o {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		d := starlark.NewDict(len(o))
+		for _, k := range keys {
+			e, err := toStarlark(o[k])
+			if err != nil {
+				return nil, err
+			}
+			if err := d.SetKey(starlark.String(k), e); err != nil {
+				return nil, err
+			}
+		}
+		return d, nil
+	}
+
+	return nil, fmt.Errorf("unsupported value type %T", v)
+}
+
+// fromStarlark converts Starlark value to the value which can be marshaled to json
+func fromStarlark(v starlark.Value) (interface{}, error) {
+	switch o := v.(type) {
+	case starlark.NoneType:
+		return nil, nil
+	case starlark.Bool:
+		return bool(o), nil
+	case starlark.String:
+		return string(o), nil
+	case starlark.Int:
+		return json.Number(o.String()), nil
+	case starlark.Float:
+		return float64(o), nil
+	case *starlark.List:
+		l := make([]interface{}, o.Len())
+		for i := 0; i < o.Len(); i++ {
+			e, err := fromStarlark(o.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			l[i] = e
+		}
+		return l, nil
+	case starlark.Tuple:
+		l := make([]interface{}, len(o))
+		for i := range o {
+			e, err := fromStarlark(o[i])
+			if err != nil {
+				return nil, err
+			}
+			l[i] = e
+		}
+		return l, nil
+	case *starlark.Dict:
+		m := make(map[string]interface{}, o.Len())
+		for _, item := range o.Items() {
+			k, ok := item[0].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("dict key %s is not a string", item[0])
+			}
+			e, err := fromStarlark(item[1])
+			if err != nil {
+				return nil, err
+			}
+			m[string(k)] = e
+		}
+		return m, nil
+	}
+
+	return nil, fmt.Errorf("unsupported starlark value type %s", v.Type())
+}
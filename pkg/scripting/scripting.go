@@ -0,0 +1,177 @@
+package scripting
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/events"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	starjson "go.starlark.net/lib/json"
+	"go.starlark.net/starlark"
+)
+
+const (
+	// processFunction is the name of the function a script must define, the function is called with
+	// the message as a dict and the name of message type, it returns the message to publish or None
+	// to drop the message.
+	processFunction = "process"
+	// maxExecutionSteps limits the number of steps a single call of the script can take
+	maxExecutionSteps = 1000000
+)
+
+// Script defines a Starlark script invoked for messages of listed types, all messages when the list is empty.
+type Script struct {
+	File         string   `json:"file"`
+	MessageTypes []string `json:"message_types,omitempty"`
+	msgTypes     map[int]bool
+	process      starlark.Callable
+}
+
+// Config defines the structure of scripts configuration file
+type Config struct {
+	Scripts []*Script `json:"scripts"`
+}
+
+func (s *Script) init() error {
+	s.msgTypes = make(map[int]bool)
+	for _, n := range s.MessageTypes {
+		t, ok := bmp.MessageTypeByName(n)
+		if !ok {
+			return fmt.Errorf("script %s refers to unknown message type %q", s.File, n)
+		}
+		s.msgTypes[t] = true
+	}
+	src, err := os.ReadFile(s.File)
+	if err != nil {
+		return err
+	}
+	thread := newThread(s.File)
+	predeclared := starlark.StringDict{
+		"json": starjson.Module,
+	}
+	globals, err := starlark.ExecFile(thread, s.File, src, predeclared)
+	if err != nil {
+		return fmt.Errorf("failed to load script %s with error: %+v", s.File, err)
+	}
+	// Globals are frozen by ExecFile, so the function can be called concurrently
+	f, ok := globals[processFunction].(starlark.Callable)
+	if !ok {
+		return fmt.Errorf("script %s does not define %s function", s.File, processFunction)
+	}
+	s.process = f
+
+	return nil
+}
+
+func newThread(name string) *starlark.Thread {
+	thread := &starlark.Thread{
+		Name: name,
+		Print: func(_ *starlark.Thread, msg string) {
+			glog.Infof("script %s: %s", name, msg)
+		},
+	}
+	thread.SetMaxExecutionSteps(maxExecutionSteps)
+
+	return thread
+}
+
+// run calls the script for the message, the returned message is nil when the script drops the message
+func (s *Script) run(msgType int, m interface{}) (interface{}, error) {
+	v, err := toStarlark(m)
+	if err != nil {
+		return nil, err
+	}
+	r, err := starlark.Call(newThread(s.File), s.process, starlark.Tuple{v, starlark.String(bmp.MessageTypeName(msgType))}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if r == starlark.None {
+		return nil, nil
+	}
+	if _, ok := r.(*starlark.Dict); !ok {
+		return nil, fmt.Errorf("%s function returned %s instead of dict or None", processFunction, r.Type())
+	}
+
+	return fromStarlark(r)
+}
+
+type scripting struct {
+	publisher pub.Publisher
+	scripts   []*Script
+}
+
+func (sc *scripting) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	var m interface{}
+	decoded := false
+	for _, s := range sc.scripts {
+		if len(s.msgTypes) != 0 && !s.msgTypes[msgType] {
+			continue
+		}
+		if !decoded {
+			d := json.NewDecoder(bytes.NewReader(msg))
+			d.UseNumber()
+			if err := d.Decode(&m); err != nil {
+				return fmt.Errorf("failed to decode message of type %d for scripts with error: %+v", msgType, err)
+			}
+			decoded = true
+		}
+		r, err := s.run(msgType, m)
+		if err != nil {
+			// Scripts run on a Starlark copy of the message, so changes of a failed script are discarded and the
+			// decoded message is passed on to the following scripts
+			glog.Errorf("script %s failed for message of type %d with error: %+v", s.File, msgType, err)
+			events.Report(events.CodeScriptFailed, "", "script %s failed for message of type %d with error: %+v", s.File, msgType, err)
+			continue
+		}
+		if r == nil {
+			return nil
+		}
+		m = r
+	}
+	if decoded {
+		b, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		msg = b
+	}
+
+	return sc.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+func (sc *scripting) Stop() {
+	sc.publisher.Stop()
+}
+
+// LoadConfig reads scripts configuration from json file
+func LoadConfig(file string) (*Config, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scripts file %s with error: %+v", file, err)
+	}
+
+	return c, nil
+}
+
+// NewScripting returns a publisher invoking Starlark scripts for messages before passing them to
+// the wrapped publisher. Scripts are invoked in the order they are defined.
+func NewScripting(publisher pub.Publisher, config *Config) (pub.Publisher, error) {
+	for _, s := range config.Scripts {
+		if err := s.init(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &scripting{
+		publisher: publisher,
+		scripts:   config.Scripts,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package scripting
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	msgs [][]byte
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.msgs = append(p.msgs, msg)
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+func TestScripting(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		types   []string
+		msgType int
+		msg     string
+		expect  map[string]interface{}
+		drop    bool
+		fail    bool
+	}{
+		{
+			name: "map communities to services",
+			script: `
+services = {"65000:100": "voice", "65000:200": "video"}
+
+def process(msg, msg_type):
+    attrs = msg.get("base_attrs", {})
+    msg["services"] = [services[c] for c in attrs.get("community_list", []) if c in services]
+    msg["type_name"] = msg_type
+    return msg
+`,
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     `{"prefix_len":24,"base_attrs":{"community_list":["65000:100","65000:300"],"med":18446744073709551615}}`,
+			expect: map[string]interface{}{
+				"prefix_len": json.Number("24"),
+				"base_attrs": map[string]interface{}{
+					"community_list": []interface{}{"65000:100", "65000:300"},
+					"med":            json.Number("18446744073709551615"),
+				},
+				"services":  []interface{}{"voice"},
+				"type_name": "unicast_prefix_v4",
+			},
+		},
+		{
+			name: "drop message",
+			script: `
+def process(msg, msg_type):
+    if msg["prefix_len"] == 32:
+        return None
+    return msg
+`,
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     `{"prefix_len":32}`,
+			drop:    true,
+		},
+		{
+			name:    "script of other message type",
+			script:  "def process(msg, msg_type):\n    return None\n",
+			types:   []string{"peer"},
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     `{"prefix_len":32}`,
+			expect:  map[string]interface{}{"prefix_len": json.Number("32")},
+		},
+		{
+			name:    "failing script publishes original message",
+			script:  "def process(msg, msg_type):\n    return msg[\"missing\"]\n",
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     `{"prefix_len":32}`,
+			expect:  map[string]interface{}{"prefix_len": json.Number("32")},
+		},
+		{
+			name:   "missing process function",
+			script: "x = 1\n",
+			fail:   true,
+		},
+		{
+			name:   "syntax error",
+			script: "def process(msg, msg_type)\n",
+			fail:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := filepath.Join(t.TempDir(), "script.star")
+			if err := os.WriteFile(f, []byte(tt.script), 0o600); err != nil {
+				t.Fatalf("failed to write script with error: %+v", err)
+			}
+			p := &testPublisher{}
+			sc, err := NewScripting(p, &Config{Scripts: []*Script{{File: f, MessageTypes: tt.types}}})
+			if err != nil && !tt.fail {
+				t.Fatalf("expected to succeed but failed with error: %+v", err)
+			}
+			if err == nil && tt.fail {
+				t.Fatalf("expected to fail but succeeded")
+			}
+			if err != nil {
+				return
+			}
+			if err := sc.PublishMessage(tt.msgType, nil, []byte(tt.msg)); err != nil {
+				t.Fatalf("failed to publish message with error: %+v", err)
+			}
+			if tt.drop {
+				if len(p.msgs) != 0 {
+					t.Fatalf("expected message to be dropped but it was published: %s", string(p.msgs[0]))
+				}
+				return
+			}
+			if len(p.msgs) != 1 {
+				t.Fatalf("expected message to be published")
+			}
+			var m map[string]interface{}
+			d := json.NewDecoder(bytes.NewReader(p.msgs[0]))
+			d.UseNumber()
+			if err := d.Decode(&m); err != nil {
+				t.Fatalf("failed to decode published message with error: %+v", err)
+			}
+			if !reflect.DeepEqual(m, tt.expect) {
+				t.Errorf("expected message %+v but got %+v", tt.expect, m)
+			}
+		})
+	}
+}
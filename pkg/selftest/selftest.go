@@ -0,0 +1,329 @@
+// Package selftest feeds a built-in set of representative BMP messages through a BMP server listening on
+// a loopback port and verifies messages captured by an in-memory publisher, it is a sanity check of an installed
+// collector which does not need a router, Kafka or NATS.
+package selftest
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+	"github.com/sbezverk/gobmp/pkg/pubtest"
+)
+
+// Check defines a message expected to be published for the built-in BMP messages
+type Check struct {
+	Name string
+	Type int
+	// Fields are top level json keys of the message and their values, values are compared by their json encoding
+	Fields map[string]interface{}
+}
+
+// Result defines the result of a check
+type Result struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report defines results of the self-test
+type Report struct {
+	// BMPMessages is the number of built-in BMP messages sent to the server
+	BMPMessages int `json:"bmp_messages"`
+	// PublishedMessages counts messages captured by the in-memory publisher per type of published messages
+	PublishedMessages map[string]int `json:"published_messages"`
+	Results           []Result       `json:"results"`
+	DurationSeconds   float64        `json:"duration_seconds"`
+}
+
+// Passed returns true when all checks of the report passed
+func (r *Report) Passed() bool {
+	for _, res := range r.Results {
+		if !res.Passed {
+			return false
+		}
+	}
+
+	return len(r.Results) != 0
+}
+
+const (
+	routerName = "gobmp-selftest"
+	localASN   = 65000
+	peerASN    = 65001
+)
+
+// Checks lists messages expected to be published for the built-in BMP messages
+var Checks = []Check{
+	{
+		Name:   "peer up",
+		Type:   bmp.PeerStateChangeMsg,
+		Fields: map[string]interface{}{"action": "add", "remote_ip": "192.0.2.1", "remote_asn": peerASN, "local_asn": localASN},
+	},
+	{
+		Name: "ipv4 unicast announcement",
+		Type: bmp.UnicastPrefixV4Msg,
+		Fields: map[string]interface{}{"action": "add", "prefix": "198.51.100.0", "prefix_len": 24, "nexthop": "192.0.2.1",
+			"peer_asn": peerASN, "origin_as": peerASN},
+	},
+	{
+		Name:   "ipv6 unicast announcement",
+		Type:   bmp.UnicastPrefixV6Msg,
+		Fields: map[string]interface{}{"action": "add", "prefix": "2001:db8:100::", "prefix_len": 48, "nexthop": "2001:db8::1"},
+	},
+	{
+		Name:   "ipv4 unicast withdrawal",
+		Type:   bmp.UnicastPrefixV4Msg,
+		Fields: map[string]interface{}{"action": "del", "prefix": "198.51.100.0", "prefix_len": 24},
+	},
+	{
+		Name:   "statistics report",
+		Type:   bmp.StatsReportMsg,
+		Fields: map[string]interface{}{"remote_ip": "192.0.2.1", "duplicate_prefix": 7},
+	},
+	{
+		Name:   "peer down",
+		Type:   bmp.PeerStateChangeMsg,
+		Fields: map[string]interface{}{"action": "down", "remote_ip": "192.0.2.1", "bmp_reason": 4},
+	},
+	{
+		Name:   "session summary",
+		Type:   bmp.SessionSummaryMsg,
+		Fields: map[string]interface{}{"closed_by": gobmpsrv.ClosedByRouter, "errors": 0},
+	},
+}
+
+func commonHeader(t byte, body []byte) []byte {
+	b := make([]byte, 6, 6+len(body))
+	b[0] = 3
+	binary.BigEndian.PutUint32(b[1:], uint32(6+len(body)))
+	b[5] = t
+
+	return append(b, body...)
+}
+
+func perPeerHeader() []byte {
+	b := make([]byte, 42)
+	copy(b[22:26], net.ParseIP("192.0.2.1").To4())
+	binary.BigEndian.PutUint32(b[26:], peerASN)
+	copy(b[30:34], net.ParseIP("192.0.2.1").To4())
+	binary.BigEndian.PutUint32(b[34:], uint32(time.Now().Unix()))
+
+	return b
+}
+
+func tlv(t uint16, v []byte) []byte {
+	b := make([]byte, 4, 4+len(v))
+	binary.BigEndian.PutUint16(b, t)
+	binary.BigEndian.PutUint16(b[2:], uint16(len(v)))
+
+	return append(b, v...)
+}
+
+func bgpMessage(t byte, body []byte) []byte {
+	b := make([]byte, 19, 19+len(body))
+	for i := 0; i < 16; i++ {
+		b[i] = 0xff
+	}
+	binary.BigEndian.PutUint16(b[16:], uint16(19+len(body)))
+	b[18] = t
+
+	return append(b, body...)
+}
+
+func openMessage(asn uint32, id string) []byte {
+	caps := []byte{
+		1, 4, 0, 1, 0, 1, // IPv4 unicast
+		1, 4, 0, 2, 0, 1, // IPv6 unicast
+		65, 4, // 4-octet AS number
+	}
+	caps = binary.BigEndian.AppendUint32(caps, asn)
+	b := []byte{4}
+	b = binary.BigEndian.AppendUint16(b, uint16(asn))
+	b = binary.BigEndian.AppendUint16(b, 90)
+	b = append(b, net.ParseIP(id).To4()...)
+	b = append(b, byte(len(caps)+2), 2, byte(len(caps)))
+
+	return bgpMessage(1, append(b, caps...))
+}
+
+func updateMessage(withdrawn, attrs, nlri []byte) []byte {
+	b := binary.BigEndian.AppendUint16(nil, uint16(len(withdrawn)))
+	b = append(b, withdrawn...)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(attrs)))
+	b = append(b, attrs...)
+
+	return bgpMessage(2, append(b, nlri...))
+}
+
+// Messages returns the built-in BMP messages of a session of a router monitoring a single peer: Initiation,
+// Peer Up, IPv4 and IPv6 unicast announcements, IPv4 unicast withdrawal, Statistics Report, Peer Down and Termination
+func Messages() [][]byte {
+	origin := []byte{0x40, 1, 1, 0}
+	asPath := append([]byte{0x40, 2, 6, 2, 1}, binary.BigEndian.AppendUint32(nil, peerASN)...)
+	nextHop := append([]byte{0x40, 3, 4}, net.ParseIP("192.0.2.1").To4()...)
+	mpReach := append([]byte{0, 2, 1, 16}, net.ParseIP("2001:db8::1")...)
+	mpReach = append(mpReach, 0, 48, 0x20, 0x01, 0x0d, 0xb8, 0x01, 0x00)
+	ipv6Attrs := append(append(append([]byte{}, origin...), asPath...), append([]byte{0x80, 14, byte(len(mpReach))}, mpReach...)...)
+
+	peerUp := append(perPeerHeader(), make([]byte, 12)...)
+	peerUp = append(peerUp, net.ParseIP("192.0.2.254").To4()...)
+	peerUp = binary.BigEndian.AppendUint16(peerUp, 179)
+	peerUp = binary.BigEndian.AppendUint16(peerUp, 50000)
+	peerUp = append(peerUp, openMessage(localASN, "192.0.2.254")...)
+	peerUp = append(peerUp, openMessage(peerASN, "192.0.2.1")...)
+
+	stats := append(perPeerHeader(), 0, 0, 0, 1)
+	stats = append(stats, tlv(1, []byte{0, 0, 0, 7})...)
+
+	return [][]byte{
+		commonHeader(bmp.InitiationMsg, append(tlv(2, []byte(routerName)), tlv(1, []byte("gobmp self-test"))...)),
+		commonHeader(bmp.PeerUpMsg, peerUp),
+		commonHeader(bmp.RouteMonitorMsg, append(perPeerHeader(),
+			updateMessage(nil, append(append(append([]byte{}, origin...), asPath...), nextHop...), []byte{24, 198, 51, 100})...)),
+		commonHeader(bmp.RouteMonitorMsg, append(perPeerHeader(), updateMessage(nil, ipv6Attrs, nil)...)),
+		commonHeader(bmp.RouteMonitorMsg, append(perPeerHeader(), updateMessage([]byte{24, 198, 51, 100}, nil, nil)...)),
+		commonHeader(bmp.StatsReportMsg, stats),
+		// Peer Down reason 4, the remote system closed the session without a notification message
+		commonHeader(bmp.PeerDownMsg, append(perPeerHeader(), 4)),
+		commonHeader(bmp.TerminationMsg, tlv(1, []byte{0, 0})),
+	}
+}
+
+// matches returns true when top level json keys of the message carry values of fields
+func matches(m pubtest.Message, fields map[string]interface{}) bool {
+	o := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(m.Msg, &o); err != nil {
+		return false
+	}
+	for k, v := range fields {
+		f, ok := o[k]
+		if !ok {
+			return false
+		}
+		var got, expect interface{}
+		e, err := json.Marshal(v)
+		if err != nil {
+			return false
+		}
+		if json.Unmarshal(f, &got) != nil || json.Unmarshal(e, &expect) != nil || fmt.Sprint(got) != fmt.Sprint(expect) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// verify returns results of checks of captured messages
+func verify(r *pubtest.Recorder, checks []Check) []Result {
+	results := make([]Result, 0, len(checks))
+	for _, c := range checks {
+		res := Result{Name: c.Name}
+		msgs := r.Messages(c.Type)
+		for _, m := range msgs {
+			if matches(m, c.Fields) {
+				res.Passed = true
+				break
+			}
+		}
+		if !res.Passed {
+			res.Error = fmt.Sprintf("none of %d %s messages carries %v", len(msgs), bmp.MessageTypeName(c.Type), c.Fields)
+		}
+		results = append(results, res)
+	}
+
+	return results
+}
+
+func passed(results []Result) bool {
+	for _, res := range results {
+		if !res.Passed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// wait returns results of checks once all pass or the deadline expires
+func wait(r *pubtest.Recorder, checks []Check, deadline time.Time) []Result {
+	for {
+		// Messages captured after verification wake up the wait
+		n := r.Count()
+		results := verify(r, checks)
+		remaining := time.Until(deadline)
+		if passed(results) || remaining <= 0 {
+			return results
+		}
+		if _, err := r.WaitFor(n+1, remaining); err != nil {
+			return verify(r, checks)
+		}
+	}
+}
+
+// Run starts a BMP server on a loopback port publishing to an in-memory publisher, sends the built-in BMP messages
+// over a session and verifies published messages against Checks, error is returned when the server or the session
+// fails to start. Checks not passed within timeout are reported as failed.
+func Run(timeout time.Duration) (*Report, error) {
+	start := time.Now()
+	deadline := start.Add(timeout)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on loopback port with error: %+v", err)
+	}
+	opts := gobmpsrv.DefaultSocketOptions()
+	opts.Listener = l
+	rec := pubtest.NewRecorder()
+	srv, err := gobmpsrv.NewBMPServer(0, 0, false, rec, true, "", opts, nil, nil, false, nil, nil, nil, nil)
+	if err != nil {
+		l.Close()
+		return nil, fmt.Errorf("failed to start BMP server with error: %+v", err)
+	}
+	srv.Start()
+	defer srv.Stop()
+	conn, err := net.DialTimeout("tcp", l.Addr().String(), timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to BMP server on %s with error: %+v", l.Addr(), err)
+	}
+	defer conn.Close()
+	send := func(msgs [][]byte) error {
+		for _, m := range msgs {
+			if _, err := conn.Write(m); err != nil {
+				return fmt.Errorf("failed to send BMP message with error: %+v", err)
+			}
+		}
+		return nil
+	}
+	// Messages read but not yet published when the session ends are discarded, so Termination is sent and
+	// the session is closed once messages of the routes are published
+	msgs := Messages()
+	var routeChecks []Check
+	for _, c := range Checks {
+		if c.Type != bmp.SessionSummaryMsg {
+			routeChecks = append(routeChecks, c)
+		}
+	}
+	if err := send(msgs[:len(msgs)-1]); err != nil {
+		return nil, err
+	}
+	wait(rec, routeChecks, deadline)
+	if err := send(msgs[len(msgs)-1:]); err != nil {
+		return nil, err
+	}
+	conn.Close()
+	report := &Report{
+		BMPMessages:       len(msgs),
+		PublishedMessages: make(map[string]int),
+		Results:           wait(rec, Checks, deadline),
+		DurationSeconds:   time.Since(start).Seconds(),
+	}
+	for _, m := range rec.Messages() {
+		report.PublishedMessages[m.Name()]++
+	}
+
+	return report, nil
+}
@@ -0,0 +1,24 @@
+package selftest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	report, err := Run(5 * time.Second)
+	if err != nil {
+		t.Fatalf("failed to run self-test with error: %+v", err)
+	}
+	if report.BMPMessages != len(Messages()) {
+		t.Errorf("expected %d BMP messages sent but got %d", len(Messages()), report.BMPMessages)
+	}
+	for _, res := range report.Results {
+		if !res.Passed {
+			t.Errorf("check %q failed: %s", res.Name, res.Error)
+		}
+	}
+	if !report.Passed() {
+		t.Errorf("expected self-test to pass, published messages %+v", report.PublishedMessages)
+	}
+}
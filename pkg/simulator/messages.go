@@ -0,0 +1,252 @@
+package simulator
+
+import (
+	"encoding/binary"
+	"net"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+const (
+	localASN = 65000
+	// peerASN is the AS number of the first peer, following peers get following AS numbers
+	peerASN = 4200000001
+	asTrans = 23456
+)
+
+// Path attributes
+var (
+	attrOrigin = []byte{0x40, 1, 1, 0}
+	// attrRouteTarget is Route Target 65000:1 of VPN routes
+	attrRouteTarget = []byte{0xc0, 16, 8, 0, 2, 0xfd, 0xe8, 0, 0, 0, 1}
+	// rd is Route Distinguisher 65000:1 of VPN routes
+	rd = []byte{0, 0, 0xfd, 0xe8, 0, 0, 0, 1}
+)
+
+// peer defines a simulated BGP peer of a router
+type peer struct {
+	addr  net.IP
+	addr6 net.IP
+	asn   uint32
+}
+
+// newPeer returns the peer of the index among peers of all routers, peers are numbered from 0
+func newPeer(index int) *peer {
+	addr := binary.BigEndian.AppendUint32(nil, 10<<24+uint32(index)+1)
+	addr6 := append(net.ParseIP("2001:db8::").To16()[:12:12], addr...)
+
+	return &peer{addr: net.IP(addr), addr6: net.IP(addr6), asn: peerASN + uint32(index)}
+}
+
+// routerAddr returns the local address of the router of the index, routers are numbered from 0
+func routerAddr(index int) net.IP {
+	return net.IP(binary.BigEndian.AppendUint32(nil, 172<<24+16<<16+uint32(index)+1))
+}
+
+func commonHeader(t byte, body []byte) []byte {
+	b := make([]byte, bmp.CommonHeaderLength, bmp.CommonHeaderLength+len(body))
+	b[0] = bmp.Version3
+	binary.BigEndian.PutUint32(b[1:], uint32(bmp.CommonHeaderLength+len(body)))
+	b[5] = t
+
+	return append(b, body...)
+}
+
+// perPeerHeader returns Per-Peer Header of a global instance peer with 4 octets AS numbers
+func perPeerHeader(p *peer, ts time.Time) []byte {
+	b := make([]byte, 42)
+	copy(b[22:26], p.addr)
+	binary.BigEndian.PutUint32(b[26:], p.asn)
+	copy(b[30:34], p.addr)
+	binary.BigEndian.PutUint32(b[34:], uint32(ts.Unix()))
+	binary.BigEndian.PutUint32(b[38:], uint32(ts.Nanosecond()/1000))
+
+	return b
+}
+
+func tlv(t uint16, v []byte) []byte {
+	b := make([]byte, 4, 4+len(v))
+	binary.BigEndian.PutUint16(b, t)
+	binary.BigEndian.PutUint16(b[2:], uint16(len(v)))
+
+	return append(b, v...)
+}
+
+func bgpMessage(t byte, body []byte) []byte {
+	b := make([]byte, 19, 19+len(body))
+	for i := 0; i < 16; i++ {
+		b[i] = 0xff
+	}
+	binary.BigEndian.PutUint16(b[16:], uint16(19+len(body)))
+	b[18] = t
+
+	return append(b, body...)
+}
+
+// openMessage returns BGP Open message with Multiprotocol capabilities of the families and 4-octet AS number
+// capability
+func openMessage(asn uint32, id net.IP, families []*Family) []byte {
+	var caps []byte
+	for _, f := range families {
+		caps = append(caps, 2, 6, 1, 4)
+		caps = binary.BigEndian.AppendUint16(caps, f.AFI)
+		caps = append(caps, 0, f.SAFI)
+	}
+	caps = append(caps, 2, 6, 65, 4)
+	caps = binary.BigEndian.AppendUint32(caps, asn)
+	b := []byte{4}
+	if asn > 0xffff {
+		b = binary.BigEndian.AppendUint16(b, asTrans)
+	} else {
+		b = binary.BigEndian.AppendUint16(b, uint16(asn))
+	}
+	b = binary.BigEndian.AppendUint16(b, 90)
+	b = append(b, id.To4()...)
+	b = append(b, byte(len(caps)))
+
+	return bgpMessage(1, append(b, caps...))
+}
+
+func initiationMessage(name string) []byte {
+	return commonHeader(bmp.InitiationMsg, append(tlv(2, []byte(name)), tlv(1, []byte("gobmp simulator"))...))
+}
+
+func peerUpMessage(p *peer, local net.IP, port int, families []*Family, ts time.Time) []byte {
+	b := append(perPeerHeader(p, ts), make([]byte, 12)...)
+	b = append(b, local.To4()...)
+	b = binary.BigEndian.AppendUint16(b, 179)
+	b = binary.BigEndian.AppendUint16(b, uint16(port))
+	b = append(b, openMessage(localASN, local, families)...)
+	b = append(b, openMessage(p.asn, p.addr, families)...)
+
+	return commonHeader(bmp.PeerUpMsg, b)
+}
+
+// peerDownMessage returns Peer Down message of reason 4, the remote system closed the session without a notification
+func peerDownMessage(p *peer, ts time.Time) []byte {
+	return commonHeader(bmp.PeerDownMsg, append(perPeerHeader(p, ts), 4))
+}
+
+// statsMessage returns Statistics Report of the number of routes in Adj-RIBs-In of the peer
+func statsMessage(p *peer, routes uint64, ts time.Time) []byte {
+	b := append(perPeerHeader(p, ts), 0, 0, 0, 1)
+
+	return commonHeader(bmp.StatsReportMsg, append(b, tlv(7, binary.BigEndian.AppendUint64(nil, routes))...))
+}
+
+// terminationMessage returns Termination message of reason 0, the session is administratively closed
+func terminationMessage() []byte {
+	return commonHeader(bmp.TerminationMsg, tlv(1, []byte{0, 0}))
+}
+
+// nlri returns NLRI of the route of the index of the family
+func (f *Family) nlri(index int) []byte {
+	var prefix []byte
+	if f.AFI == 1 {
+		// IPv4 /24 prefixes starting from 1.0.0.0/24
+		prefix = binary.BigEndian.AppendUint32(nil, (1<<16+uint32(index))<<8)[:3]
+	} else {
+		// IPv6 /48 prefixes starting from 3fff::/48
+		prefix = binary.BigEndian.AppendUint64(nil, (0x3fff<<32+uint64(index))<<16)[:6]
+	}
+	var b []byte
+	switch f.SAFI {
+	case safiLabeled, safiVPN:
+		// Labels are allocated from 16 with the bottom of stack bit set
+		label := uint32(16 + index%(1<<20-16))
+		bits := 24 + len(prefix)*8
+		if f.SAFI == safiVPN {
+			bits += len(rd) * 8
+		}
+		b = append(b, byte(bits), byte(label>>12), byte(label>>4), byte(label<<4|1))
+		if f.SAFI == safiVPN {
+			b = append(b, rd...)
+		}
+	default:
+		b = append(b, byte(len(prefix)*8))
+	}
+
+	return append(b, prefix...)
+}
+
+// nextHop returns the next hop of routes of the peer of the family carried by MP_REACH_NLRI
+func (f *Family) nextHop(p *peer) []byte {
+	var nh []byte
+	if f.SAFI == safiVPN {
+		nh = make([]byte, len(rd))
+	}
+	if f.AFI == 1 {
+		return append(nh, p.addr...)
+	}
+
+	return append(nh, p.addr6...)
+}
+
+func mpAttribute(t byte, v []byte) []byte {
+	b := []byte{0x90, t}
+	b = binary.BigEndian.AppendUint16(b, uint16(len(v)))
+
+	return append(b, v...)
+}
+
+func updateMessage(withdrawn, attrs, nlri []byte) []byte {
+	b := binary.BigEndian.AppendUint16(nil, uint16(len(withdrawn)))
+	b = append(b, withdrawn...)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(attrs)))
+	b = append(b, attrs...)
+
+	return bgpMessage(2, append(b, nlri...))
+}
+
+// announcement returns BGP Update message announcing routes of indexes of the family by the peer, routes carry
+// AS path of the peer and the origin AS derived from the first route
+func (f *Family) announcement(p *peer, indexes []int) []byte {
+	var nlri []byte
+	for _, i := range indexes {
+		nlri = append(nlri, f.nlri(i)...)
+	}
+	attrs := append([]byte{}, attrOrigin...)
+	attrs = append(attrs, 0x40, 2, 10, 2, 2)
+	attrs = binary.BigEndian.AppendUint32(attrs, p.asn)
+	attrs = binary.BigEndian.AppendUint32(attrs, uint32(64512+indexes[0]%1000))
+	// MED
+	attrs = append(attrs, 0x80, 4, 4)
+	attrs = binary.BigEndian.AppendUint32(attrs, uint32(indexes[0]%100))
+	if f.SAFI == safiVPN {
+		attrs = append(attrs, attrRouteTarget...)
+	}
+	if f.AFI == 1 && f.SAFI == safiUnicast {
+		attrs = append(attrs, 0x40, 3, 4)
+		attrs = append(attrs, p.addr...)
+		return updateMessage(nil, attrs, nlri)
+	}
+	nh := f.nextHop(p)
+	mp := binary.BigEndian.AppendUint16(nil, f.AFI)
+	mp = append(mp, f.SAFI, byte(len(nh)))
+	mp = append(mp, nh...)
+	mp = append(mp, 0)
+	mp = append(mp, nlri...)
+
+	return updateMessage(nil, append(attrs, mpAttribute(14, mp)...), nil)
+}
+
+// withdrawal returns BGP Update message withdrawing routes of indexes of the family, no indexes return End-of-RIB
+// marker of the family
+func (f *Family) withdrawal(indexes []int) []byte {
+	var nlri []byte
+	for _, i := range indexes {
+		nlri = append(nlri, f.nlri(i)...)
+	}
+	if f.AFI == 1 && f.SAFI == safiUnicast {
+		return updateMessage(nlri, nil, nil)
+	}
+	mp := binary.BigEndian.AppendUint16(nil, f.AFI)
+	mp = append(mp, f.SAFI)
+
+	return updateMessage(nil, mpAttribute(15, append(mp, nlri...)), nil)
+}
+
+func routeMonitorMessage(p *peer, update []byte, ts time.Time) []byte {
+	return commonHeader(bmp.RouteMonitorMsg, append(perPeerHeader(p, ts), update...))
+}
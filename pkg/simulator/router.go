@@ -0,0 +1,252 @@
+package simulator
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"time"
+)
+
+const (
+	dialTimeout = 10 * time.Second
+	// portBase is the remote port of BGP sessions of the first peer of a router
+	portBase = 50000
+)
+
+// batch is a range of routes of a family announced by a Route Monitoring message
+type batch struct {
+	family *Family
+	start  int
+	n      int
+}
+
+func (b *batch) indexes() []int {
+	idx := make([]int, b.n)
+	for i := range idx {
+		idx[i] = b.start + i
+	}
+
+	return idx
+}
+
+// router is a simulated router sending its BMP session
+type router struct {
+	config  *Config
+	name    string
+	local   net.IP
+	peers   []*peer
+	batches []*batch
+	// routes holds the number of routes announced by each peer
+	routes []uint64
+	w      *bufio.Writer
+	report Report
+	// sent is the number of Route Monitoring messages paced by the rate
+	sent      int
+	started   time.Time
+	nextStats time.Time
+}
+
+func newRouter(config *Config, index int) *router {
+	r := &router{
+		config:  config,
+		name:    "gobmp-simulator-" + strconv.Itoa(index+1),
+		local:   routerAddr(index),
+		peers:   make([]*peer, config.Peers),
+		routes:  make([]uint64, config.Peers),
+		batches: schedule(config),
+		report:  Report{Messages: make(map[string]int)},
+	}
+	for i := range r.peers {
+		r.peers[i] = newPeer(index*config.Peers + i)
+	}
+
+	return r
+}
+
+// schedule splits routes among families by their weights and returns batches of routes of the table, batches of
+// families are interleaved, so families are announced in proportion to their weights
+func schedule(config *Config) []*batch {
+	weights := 0
+	for _, f := range config.Families {
+		weights += f.Weight
+	}
+	counts := make([]int, len(config.Families))
+	left := config.Prefixes
+	for i, f := range config.Families {
+		counts[i] = config.Prefixes * f.Weight / weights
+		left -= counts[i]
+	}
+	// Remaining routes are assigned to families in their order
+	for i := 0; left > 0; i, left = i+1, left-1 {
+		counts[i%len(counts)]++
+	}
+	sent := make([]int, len(counts))
+	var batches []*batch
+	for {
+		next := -1
+		for i := range counts {
+			if sent[i] >= counts[i] {
+				continue
+			}
+			// The family with the lowest fraction of its routes announced is next
+			if next < 0 || sent[i]*counts[next] < sent[next]*counts[i] {
+				next = i
+			}
+		}
+		if next < 0 {
+			return batches
+		}
+		n := config.PrefixesPerUpdate
+		if counts[next]-sent[next] < n {
+			n = counts[next] - sent[next]
+		}
+		batches = append(batches, &batch{family: config.Families[next], start: sent[next], n: n})
+		sent[next] += n
+	}
+}
+
+func (r *router) send(msg []byte) error {
+	if _, err := r.w.Write(msg); err != nil {
+		return err
+	}
+	r.report.Messages[messageNames[msg[5]]]++
+	r.report.Bytes += int64(len(msg))
+
+	return nil
+}
+
+// routeMonitor sends the Route Monitoring message paced by the rate and Statistics Reports when they are due
+func (r *router) routeMonitor(p *peer, update []byte) error {
+	if r.config.Rate > 0 {
+		due := r.started.Add(time.Duration(r.sent) * time.Second / time.Duration(r.config.Rate))
+		if d := time.Until(due); d > 0 {
+			if err := r.w.Flush(); err != nil {
+				return err
+			}
+			time.Sleep(d)
+		}
+	}
+	r.sent++
+	now := time.Now()
+	if err := r.send(routeMonitorMessage(p, update, now)); err != nil {
+		return err
+	}
+	if r.config.StatsInterval > 0 && !now.Before(r.nextStats) {
+		r.nextStats = now.Add(r.config.StatsInterval)
+		return r.stats()
+	}
+
+	return nil
+}
+
+func (r *router) stats() error {
+	for i, p := range r.peers {
+		if err := r.send(statsMessage(p, r.routes[i], time.Now())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// table announces routes of all batches by all peers followed by End-of-RIB markers of families
+func (r *router) table() error {
+	for _, b := range r.batches {
+		update := make([][]byte, len(r.peers))
+		for i, p := range r.peers {
+			update[i] = b.family.announcement(p, b.indexes())
+		}
+		for i, p := range r.peers {
+			if err := r.routeMonitor(p, update[i]); err != nil {
+				return err
+			}
+			r.routes[i] += uint64(b.n)
+			r.report.Announced += b.n
+		}
+	}
+	for _, p := range r.peers {
+		for _, f := range r.config.Families {
+			if err := r.routeMonitor(p, f.withdrawal(nil)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// churn withdraws batches of routes of peers and announces them again until the end of the duration
+func (r *router) churn() error {
+	if len(r.batches) == 0 {
+		return nil
+	}
+	end := time.Now().Add(r.config.Duration)
+	for k := 0; time.Now().Before(end); k++ {
+		// Steps are pairs of a withdrawal and an announcement of the same batch, peers are churned in turn
+		step := k / 2
+		i := step % len(r.peers)
+		b := r.batches[(step/len(r.peers))%len(r.batches)]
+		p := r.peers[i]
+		if k%2 == 0 {
+			if err := r.routeMonitor(p, b.family.withdrawal(b.indexes())); err != nil {
+				return err
+			}
+			r.routes[i] -= uint64(b.n)
+			r.report.Withdrawn += b.n
+			continue
+		}
+		if err := r.routeMonitor(p, b.family.announcement(p, b.indexes())); err != nil {
+			return err
+		}
+		r.routes[i] += uint64(b.n)
+		r.report.Announced += b.n
+	}
+
+	return nil
+}
+
+// run sends the BMP session of the router and closes it once Linger passes after the Termination message
+func (r *router) run() error {
+	conn, err := net.DialTimeout("tcp", r.config.Target, dialTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	r.w = bufio.NewWriterSize(conn, 64<<10)
+	r.started = time.Now()
+	r.nextStats = r.started.Add(r.config.StatsInterval)
+	if err := r.send(initiationMessage(r.name)); err != nil {
+		return err
+	}
+	for i, p := range r.peers {
+		if err := r.send(peerUpMessage(p, r.local, portBase+i%10000, r.config.Families, time.Now())); err != nil {
+			return err
+		}
+	}
+	if err := r.table(); err != nil {
+		return err
+	}
+	if r.config.StatsInterval > 0 {
+		if err := r.stats(); err != nil {
+			return err
+		}
+	}
+	if err := r.churn(); err != nil {
+		return err
+	}
+	for _, p := range r.peers {
+		if err := r.send(peerDownMessage(p, time.Now())); err != nil {
+			return err
+		}
+	}
+	if err := r.send(terminationMessage()); err != nil {
+		return err
+	}
+	if err := r.w.Flush(); err != nil {
+		return err
+	}
+	// The collector may drop messages not yet published when the session is closed
+	time.Sleep(r.config.Linger)
+
+	return nil
+}
@@ -0,0 +1,204 @@
+// Package simulator synthesizes BMP sessions of routers against a collector for load testing and for validating
+// publisher configurations before production routers are connected. Each simulated router opens its own session,
+// sends Initiation, Peer Up messages of its peers, Route Monitoring messages of a table of routes of a mix of address
+// families at a configurable rate, Statistics Reports, optionally churns routes and closes the session with
+// Peer Down and Termination messages.
+package simulator
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// SAFIs of simulated families
+const (
+	safiUnicast = 1
+	safiLabeled = 4
+	safiVPN     = 128
+)
+
+const (
+	maxPrefixes          = 1 << 22
+	maxPrefixesPerUpdate = 100
+)
+
+// Family defines an address family of simulated routes and its weight in the mix of routes of a peer
+type Family struct {
+	Name   string `json:"name"`
+	AFI    uint16 `json:"afi"`
+	SAFI   uint8  `json:"safi"`
+	Weight int    `json:"weight"`
+}
+
+// families lists simulated families by their names
+var families = map[string]Family{
+	"ipv4-unicast":         {AFI: 1, SAFI: safiUnicast},
+	"ipv6-unicast":         {AFI: 2, SAFI: safiUnicast},
+	"ipv4-labeled-unicast": {AFI: 1, SAFI: safiLabeled},
+	"ipv6-labeled-unicast": {AFI: 2, SAFI: safiLabeled},
+	"ipv4-vpn":             {AFI: 1, SAFI: safiVPN},
+	"ipv6-vpn":             {AFI: 2, SAFI: safiVPN},
+}
+
+// ParseFamilies parses the comma separated list of families and their weights, for example
+// "ipv4-unicast:70,ipv6-unicast:30", the weight is 1 when not specified
+func ParseFamilies(s string) ([]*Family, error) {
+	var fs []*Family
+	seen := make(map[string]bool)
+	for _, e := range strings.Split(s, ",") {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		name, weight := e, 1
+		if i := strings.Index(e, ":"); i >= 0 {
+			w, err := strconv.Atoi(e[i+1:])
+			if err != nil || w <= 0 {
+				return nil, fmt.Errorf("invalid weight of family %s", e)
+			}
+			name, weight = e[:i], w
+		}
+		f, ok := families[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown family %s, supported families are ipv4-unicast, ipv6-unicast, "+
+				"ipv4-labeled-unicast, ipv6-labeled-unicast, ipv4-vpn and ipv6-vpn", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("family %s is listed more than once", name)
+		}
+		seen[name] = true
+		f.Name, f.Weight = name, weight
+		fs = append(fs, &f)
+	}
+	if len(fs) == 0 {
+		return nil, fmt.Errorf("no families are specified")
+	}
+
+	return fs, nil
+}
+
+// Config defines simulated routers, Target is host:port address of the collector. Each of Routers routers has Peers
+// peers announcing Prefixes routes split among Families by their weights, up to PrefixesPerUpdate routes of a family
+// are announced by a Route Monitoring message. Rate limits Route Monitoring messages per second of a router, 0 does
+// not limit the rate. Statistics Reports of peers are sent every StatsInterval, 0 disables them. Once the table is
+// sent, routes are withdrawn and announced again for Duration, 0 closes sessions after the table is sent. Sessions
+// are kept open for Linger after Termination messages, so the collector publishes messages before sessions close.
+type Config struct {
+	Target            string
+	Routers           int
+	Peers             int
+	Prefixes          int
+	PrefixesPerUpdate int
+	Families          []*Family
+	Rate              int
+	StatsInterval     time.Duration
+	Duration          time.Duration
+	Linger            time.Duration
+}
+
+// Report defines BMP messages sent by simulated routers
+type Report struct {
+	Routers int `json:"routers"`
+	Peers   int `json:"peers"`
+	// Messages counts sent BMP messages by names of their types
+	Messages  map[string]int `json:"messages"`
+	Announced int            `json:"announced_routes"`
+	Withdrawn int            `json:"withdrawn_routes"`
+	Bytes     int64          `json:"bytes"`
+	// DurationSeconds is the time from the start of the first session to the close of the last session
+	DurationSeconds   float64 `json:"duration_seconds"`
+	MessagesPerSecond float64 `json:"messages_per_second"`
+}
+
+func (r *Report) add(o *Report) {
+	for k, v := range o.Messages {
+		r.Messages[k] += v
+	}
+	r.Announced += o.Announced
+	r.Withdrawn += o.Withdrawn
+	r.Bytes += o.Bytes
+}
+
+// messageNames are names of BMP message types of reports
+var messageNames = map[byte]string{
+	bmp.RouteMonitorMsg: "route_monitoring",
+	bmp.StatsReportMsg:  "statistics_report",
+	bmp.PeerDownMsg:     "peer_down",
+	bmp.PeerUpMsg:       "peer_up",
+	bmp.InitiationMsg:   "initiation",
+	bmp.TerminationMsg:  "termination",
+}
+
+func (c *Config) validate() error {
+	if c.Target == "" {
+		return fmt.Errorf("target collector is not specified")
+	}
+	if _, _, err := net.SplitHostPort(c.Target); err != nil {
+		return fmt.Errorf("invalid target collector %s with error: %+v", c.Target, err)
+	}
+	if c.Routers <= 0 || c.Peers <= 0 || c.Routers*c.Peers > 1<<20 {
+		return fmt.Errorf("invalid number of routers %d and peers %d, at least 1 and up to %d peers of all routers",
+			c.Routers, c.Peers, 1<<20)
+	}
+	if c.Prefixes < 0 || c.Prefixes > maxPrefixes {
+		return fmt.Errorf("invalid number of prefixes %d, up to %d prefixes of a peer", c.Prefixes, maxPrefixes)
+	}
+	if c.PrefixesPerUpdate <= 0 || c.PrefixesPerUpdate > maxPrefixesPerUpdate {
+		return fmt.Errorf("invalid number of prefixes per update %d, 1 to %d", c.PrefixesPerUpdate, maxPrefixesPerUpdate)
+	}
+	if len(c.Families) == 0 {
+		return fmt.Errorf("no families are specified")
+	}
+	if c.Rate < 0 || c.StatsInterval < 0 || c.Duration < 0 || c.Linger < 0 {
+		return fmt.Errorf("invalid rate, stats interval, duration or linger, they cannot be negative")
+	}
+
+	return nil
+}
+
+// Run simulates routers of the config and returns the report of sent BMP messages once all sessions are closed,
+// error is returned when the config is invalid or a session fails.
+func Run(config *Config) (*Report, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	reports := make([]*Report, config.Routers)
+	errs := make([]error, config.Routers)
+	var wg sync.WaitGroup
+	for i := 0; i < config.Routers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r := newRouter(config, i)
+			errs[i] = r.run()
+			reports[i] = &r.report
+		}(i)
+	}
+	wg.Wait()
+	report := &Report{Routers: config.Routers, Peers: config.Routers * config.Peers, Messages: make(map[string]int)}
+	for _, r := range reports {
+		report.add(r)
+	}
+	report.DurationSeconds = time.Since(start).Seconds()
+	n := 0
+	for _, v := range report.Messages {
+		n += v
+	}
+	if report.DurationSeconds > 0 {
+		report.MessagesPerSecond = float64(n) / report.DurationSeconds
+	}
+	for i, err := range errs {
+		if err != nil {
+			return report, fmt.Errorf("session of router %d failed with error: %+v", i, err)
+		}
+	}
+
+	return report, nil
+}
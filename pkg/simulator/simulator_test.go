@@ -0,0 +1,201 @@
+package simulator
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+	"github.com/sbezverk/gobmp/pkg/pubtest"
+)
+
+func TestParseFamilies(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		expect []*Family
+		fail   bool
+	}{
+		{
+			name:   "default weight",
+			input:  "ipv4-unicast",
+			expect: []*Family{{Name: "ipv4-unicast", AFI: 1, SAFI: 1, Weight: 1}},
+		},
+		{
+			name:  "weights",
+			input: "ipv6-unicast:30, ipv4-vpn:70",
+			expect: []*Family{
+				{Name: "ipv6-unicast", AFI: 2, SAFI: 1, Weight: 30},
+				{Name: "ipv4-vpn", AFI: 1, SAFI: 128, Weight: 70},
+			},
+		},
+		{name: "unknown family", input: "ipv4-multicast", fail: true},
+		{name: "invalid weight", input: "ipv4-unicast:0", fail: true},
+		{name: "duplicate family", input: "ipv4-unicast,ipv4-unicast:2", fail: true},
+		{name: "empty", input: " ", fail: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFamilies(tt.input)
+			if err != nil && !tt.fail {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err == nil && tt.fail {
+				t.Fatalf("expected error but succeeded")
+			}
+			if !tt.fail && !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("expected families %+v but got %+v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	fs, err := ParseFamilies("ipv4-unicast:2,ipv6-unicast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got [][3]int
+	for _, b := range schedule(&Config{Prefixes: 10, PrefixesPerUpdate: 3, Families: fs}) {
+		got = append(got, [3]int{int(b.family.AFI), b.start, b.n})
+	}
+	// 7 IPv4 and 3 IPv6 routes
+	expect := [][3]int{{1, 0, 3}, {2, 0, 3}, {1, 3, 3}, {1, 6, 1}}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected batches %v but got %v", expect, got)
+	}
+}
+
+func TestRun(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := gobmpsrv.DefaultSocketOptions()
+	opts.Listener = l
+	rec := pubtest.NewRecorder()
+	srv, err := gobmpsrv.NewBMPServer(0, 0, false, rec, true, "", opts, nil, nil, false, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.Start()
+	defer srv.Stop()
+	fs, err := ParseFamilies("ipv4-unicast,ipv6-unicast,ipv4-labeled-unicast,ipv6-labeled-unicast,ipv4-vpn,ipv6-vpn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &Config{
+		Target:            l.Addr().String(),
+		Routers:           2,
+		Peers:             2,
+		Prefixes:          60,
+		PrefixesPerUpdate: 10,
+		Families:          fs,
+		StatsInterval:     time.Hour,
+		Linger:            100 * time.Millisecond,
+	}
+	report, err := Run(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	// Each peer sends 6 Route Monitoring messages of routes and 6 End-of-RIB markers
+	expect := map[string]int{"initiation": 2, "peer_up": 4, "route_monitoring": 48, "statistics_report": 4, "peer_down": 4, "termination": 2}
+	if !reflect.DeepEqual(report.Messages, expect) {
+		t.Errorf("expected sent messages %v but got %v", expect, report.Messages)
+	}
+	if report.Announced != 240 || report.Withdrawn != 0 {
+		t.Errorf("expected 240 announced and 0 withdrawn routes but got %d and %d", report.Announced, report.Withdrawn)
+	}
+	published := map[int]int{
+		// Routes of unicast and labeled unicast families and End-of-RIB markers of IPv4 unicast
+		bmp.UnicastPrefixV4Msg: 84,
+		bmp.UnicastPrefixV6Msg: 80,
+		bmp.L3VPNV4Msg:         40,
+		bmp.L3VPNV6Msg:         40,
+		bmp.PeerStateChangeMsg: 8,
+		bmp.StatsReportMsg:     4,
+	}
+	for msgType, n := range published {
+		if _, err := rec.WaitFor(n, 5*time.Second, msgType); err != nil {
+			t.Errorf("expected %d %s messages but got %d", n, bmp.MessageTypeName(msgType), rec.Count(msgType))
+		}
+	}
+	// Messages of a session are produced concurrently with Peer Up messages setting identity of the router, the test
+	// run with -race checks the identity is shared safely
+	peerUps, err := rec.Where(bmp.PeerStateChangeMsg, "action", "add")
+	if err != nil {
+		t.Fatal(err)
+	}
+	routers := map[string]int{}
+	for _, m := range peerUps {
+		ip, _ := m.Field("router_ip")
+		routers[fmt.Sprint(ip)]++
+	}
+	if expect := map[string]int{"172.16.0.1": 2, "172.16.0.2": 2}; !reflect.DeepEqual(routers, expect) {
+		t.Errorf("expected Peer Up messages of routers %v but got %v", expect, routers)
+	}
+	withdrawn, err := rec.Where(bmp.UnicastPrefixV4Msg, "action", "del")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(withdrawn) != 0 {
+		t.Errorf("expected no withdrawn routes but got %d", len(withdrawn))
+	}
+	if _, err := Run(&Config{Target: l.Addr().String()}); err == nil {
+		t.Errorf("expected error of invalid config but succeeded")
+	}
+}
+
+func TestChurn(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				b := make([]byte, 4096)
+				for {
+					if _, err := c.Read(b); err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+	fs, err := ParseFamilies("ipv4-unicast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Run(&Config{
+		Target:            l.Addr().String(),
+		Routers:           1,
+		Peers:             1,
+		Prefixes:          20,
+		PrefixesPerUpdate: 10,
+		Families:          fs,
+		Rate:              100,
+		Duration:          200 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	// 2 Route Monitoring messages of the table and an End-of-RIB marker are followed by pairs of withdrawals and
+	// announcements paced by the rate
+	if n := report.Messages["route_monitoring"]; n < 10 || n > 30 {
+		t.Errorf("expected about 23 Route Monitoring messages at the rate but got %d", n)
+	}
+	if report.Announced-report.Withdrawn < 10 || report.Announced-report.Withdrawn > 20 {
+		t.Errorf("expected at most one batch withdrawn at the end but got %d announced and %d withdrawn routes",
+			report.Announced, report.Withdrawn)
+	}
+}
@@ -95,6 +95,10 @@ func (bsid *BindingSID) MarshalJSON() ([]byte, error) {
 			flags: bsid.BSID.GetFlag(),
 			bsid:  bsid.BSID.GetBSID(),
 		}
+		if s, ok := bsid.BSID.(SRv6BSID); ok {
+			sid.eb = s.GetEndpointBehavior()
+			sid.st = s.GetSIDStructure()
+		}
 		return json.Marshal(&struct {
 			Type BSIDType  `json:"bsid_type,omitempty"`
 			BSID *srv6BSID `json:"bsid,omitempty"`
@@ -206,6 +210,7 @@ func (l *labelBSID) UnmarshalJSON(b []byte) error {
 // SRv6BSID defines SRv6 BSID specific method
 type SRv6BSID interface {
 	GetEndpointBehavior() *srv6.EndpointBehavior
+	GetSIDStructure() *srv6.SIDStructure
 }
 
 // srv6BSID defines structure when Binding SID sub tlv carries a srv6 as Binding SID
@@ -213,6 +218,7 @@ type srv6BSID struct {
 	flags byte
 	bsid  []byte
 	eb    *srv6.EndpointBehavior
+	st    *srv6.SIDStructure
 }
 
 var _ BSID = &srv6BSID{}
@@ -229,14 +235,25 @@ func (s *srv6BSID) GetBSID() []byte {
 func (s *srv6BSID) GetEndpointBehavior() *srv6.EndpointBehavior {
 	return s.eb
 }
+func (s *srv6BSID) GetSIDStructure() *srv6.SIDStructure {
+	return s.st
+}
 
 func (s *srv6BSID) MarshalJSON() ([]byte, error) {
+	var eb *uint16
+	if s.eb != nil {
+		eb = &s.eb.EndpointBehavior
+	}
 	return json.Marshal(struct {
-		Flags byte   `json:"flags,omitempty"`
-		BSID  []byte `json:"srv6_bsid,omitempty"`
+		Flags            byte               `json:"flags,omitempty"`
+		BSID             []byte             `json:"srv6_bsid,omitempty"`
+		EndpointBehavior *uint16            `json:"endpoint_behavior,omitempty"`
+		SIDStructure     *srv6.SIDStructure `json:"sid_structure,omitempty"`
 	}{
-		Flags: s.flags,
-		BSID:  s.bsid,
+		Flags:            s.flags,
+		BSID:             s.bsid,
+		EndpointBehavior: eb,
+		SIDStructure:     s.st,
 	})
 }
 
@@ -255,6 +272,17 @@ func (s *srv6BSID) UnmarshalJSON(b []byte) error {
 			return err
 		}
 	}
+	if b, ok := objmap["endpoint_behavior"]; ok {
+		s.eb = &srv6.EndpointBehavior{}
+		if err := json.Unmarshal(b, &s.eb.EndpointBehavior); err != nil {
+			return err
+		}
+	}
+	if b, ok := objmap["sid_structure"]; ok {
+		if err := json.Unmarshal(b, &s.st); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -292,3 +320,27 @@ func UnmarshalBSIDSTLV(b []byte) (BSID, error) {
 
 	return bsid, nil
 }
+
+// UnmarshalSRv6BSIDSTLV instantiates Binding SID object of SRv6 Binding SID sub tlv, flags, reserved byte,
+// SRv6 SID and optional SRv6 Endpoint Behavior and SID Structure
+func UnmarshalSRv6BSIDSTLV(b []byte) (BSID, error) {
+	if glog.V(5) {
+		glog.Infof("SR Policy SRv6 Binding SID STLV Raw: %s", tools.MessageHex(b))
+	}
+	if len(b) != 18 && len(b) != 18+srv6EndpointBehaviorLen {
+		return nil, fmt.Errorf("invalid length %d of srv6 binding sid stlv", len(b))
+	}
+	bsid := &srv6BSID{
+		flags: b[0],
+		bsid:  make([]byte, 16),
+	}
+	copy(bsid.bsid, b[2:18])
+	if len(b) > 18 {
+		var err error
+		if bsid.eb, bsid.st, err = unmarshalSRv6EndpointBehavior(b[18:]); err != nil {
+			return nil, err
+		}
+	}
+
+	return bsid, nil
+}
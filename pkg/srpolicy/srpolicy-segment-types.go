@@ -0,0 +1,377 @@
+package srpolicy
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/srv6"
+	"github.com/sbezverk/tools"
+)
+
+// srv6EndpointBehaviorLen is the length of SRv6 Endpoint Behavior and SID Structure carried by SRv6 segments
+// and SRv6 Binding SID
+const srv6EndpointBehaviorLen = 8
+
+// unmarshalSRv6EndpointBehavior returns SRv6 Endpoint Behavior and SID Structure, 2 bytes of Endpoint Behavior,
+// 2 bytes reserved, Locator Block, Locator Node, Function and Argument lengths
+func unmarshalSRv6EndpointBehavior(b []byte) (*srv6.EndpointBehavior, *srv6.SIDStructure, error) {
+	if len(b) != srv6EndpointBehaviorLen {
+		return nil, nil, fmt.Errorf("invalid length %d of srv6 endpoint behavior and sid structure", len(b))
+	}
+	eb := &srv6.EndpointBehavior{
+		EndpointBehavior: binary.BigEndian.Uint16(b[0:2]),
+	}
+	st := &srv6.SIDStructure{
+		LBLength:  b[4],
+		LNLength:  b[5],
+		FunLength: b[6],
+		ArgLength: b[7],
+	}
+
+	return eb, st, nil
+}
+
+// segmentJSON defines json of segments of types B to K
+type segmentJSON struct {
+	SegmentType       SegmentType        `json:"segment_type,omitempty"`
+	Flags             *SegmentFlags      `json:"flags,omitempty"`
+	Algorithm         uint8              `json:"algorithm,omitempty"`
+	NodeAddress       string             `json:"node_address,omitempty"`
+	LocalInterfaceID  uint32             `json:"local_interface_id,omitempty"`
+	LocalAddress      string             `json:"local_address,omitempty"`
+	RemoteInterfaceID uint32             `json:"remote_interface_id,omitempty"`
+	RemoteAddress     string             `json:"remote_address,omitempty"`
+	Label             uint32             `json:"label,omitempty"`
+	SRv6SID           string             `json:"srv6_sid,omitempty"`
+	EndpointBehavior  *uint16            `json:"endpoint_behavior,omitempty"`
+	SIDStructure      *srv6.SIDStructure `json:"sid_structure,omitempty"`
+}
+
+// TypeBSegment defines method to access Type B specific elements
+type TypeBSegment interface {
+	GetSRv6SID() []byte
+	GetEndpointBehavior() *srv6.EndpointBehavior
+	GetSIDStructure() *srv6.SIDStructure
+}
+
+// typeBSegment defines Type B Segment, a single SRv6 SID with optional Endpoint Behavior and SID Structure
+type typeBSegment struct {
+	flags *SegmentFlags
+	sid   []byte
+	eb    *srv6.EndpointBehavior
+	st    *srv6.SIDStructure
+}
+
+var _ Segment = &typeBSegment{}
+var _ TypeBSegment = &typeBSegment{}
+
+func (tb *typeBSegment) GetFlags() *SegmentFlags {
+	return tb.flags
+}
+func (tb *typeBSegment) GetType() SegmentType {
+	return TypeB
+}
+func (tb *typeBSegment) GetSRv6SID() []byte {
+	return tb.sid
+}
+func (tb *typeBSegment) GetEndpointBehavior() *srv6.EndpointBehavior {
+	return tb.eb
+}
+func (tb *typeBSegment) GetSIDStructure() *srv6.SIDStructure {
+	return tb.st
+}
+
+func (tb *typeBSegment) MarshalJSON() ([]byte, error) {
+	s := segmentJSON{
+		SegmentType:  TypeB,
+		Flags:        tb.flags,
+		SRv6SID:      net.IP(tb.sid).String(),
+		SIDStructure: tb.st,
+	}
+	if tb.eb != nil {
+		s.EndpointBehavior = &tb.eb.EndpointBehavior
+	}
+
+	return json.Marshal(s)
+}
+
+func (tb *typeBSegment) UnmarshalJSON(b []byte) error {
+	s := segmentJSON{}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	tb.flags = s.Flags
+	tb.sid = net.ParseIP(s.SRv6SID).To16()
+	tb.st = s.SIDStructure
+	if s.EndpointBehavior != nil {
+		tb.eb = &srv6.EndpointBehavior{EndpointBehavior: *s.EndpointBehavior}
+	}
+
+	return nil
+}
+
+// UnmarshalTypeBSegment instantiates an instance of Type B Segment sub tlv
+func UnmarshalTypeBSegment(b []byte) (Segment, error) {
+	if glog.V(5) {
+		glog.Infof("SR Policy Type B Segment STLV Raw: %s", tools.MessageHex(b))
+	}
+	if len(b) != 18 && len(b) != 18+srv6EndpointBehaviorLen {
+		return nil, fmt.Errorf("invalid length %d of Type B Segment STLV", len(b))
+	}
+	s := &typeBSegment{
+		flags: NewSegmentFlags(b[0]),
+		sid:   make([]byte, 16),
+	}
+	// Skip reserved byte
+	copy(s.sid, b[2:18])
+	if len(b) > 18 {
+		var err error
+		if s.eb, s.st, err = unmarshalSRv6EndpointBehavior(b[18:]); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// AddressSegment defines methods to access elements of segments of types C to K identifying nodes or
+// adjacencies by addresses and interface identifiers, SID is optional, SR-MPLS for types C to H and SRv6 for
+// types I to K
+type AddressSegment interface {
+	GetAlgorithm() uint8
+	GetNodeAddress() []byte
+	GetLocalInterfaceID() uint32
+	GetLocalAddress() []byte
+	GetRemoteInterfaceID() uint32
+	GetRemoteAddress() []byte
+	GetLabel() (uint32, bool)
+	GetSRv6SID() []byte
+}
+
+type addressSegment struct {
+	segmentType       SegmentType
+	flags             *SegmentFlags
+	algorithm         uint8
+	nodeAddress       []byte
+	localInterfaceID  uint32
+	localAddress      []byte
+	remoteInterfaceID uint32
+	remoteAddress     []byte
+	label             *uint32
+	sid               []byte
+	eb                *srv6.EndpointBehavior
+	st                *srv6.SIDStructure
+}
+
+var _ Segment = &addressSegment{}
+var _ AddressSegment = &addressSegment{}
+
+func (a *addressSegment) GetFlags() *SegmentFlags {
+	return a.flags
+}
+func (a *addressSegment) GetType() SegmentType {
+	return a.segmentType
+}
+func (a *addressSegment) GetAlgorithm() uint8 {
+	return a.algorithm
+}
+func (a *addressSegment) GetNodeAddress() []byte {
+	return a.nodeAddress
+}
+func (a *addressSegment) GetLocalInterfaceID() uint32 {
+	return a.localInterfaceID
+}
+func (a *addressSegment) GetLocalAddress() []byte {
+	return a.localAddress
+}
+func (a *addressSegment) GetRemoteInterfaceID() uint32 {
+	return a.remoteInterfaceID
+}
+func (a *addressSegment) GetRemoteAddress() []byte {
+	return a.remoteAddress
+}
+
+// GetLabel returns SR-MPLS SID of the segment, false is returned when the segment does not carry SR-MPLS SID
+func (a *addressSegment) GetLabel() (uint32, bool) {
+	if a.label == nil {
+		return 0, false
+	}
+	return *a.label, true
+}
+
+// GetSRv6SID returns SRv6 SID of the segment, nil is returned when the segment does not carry SRv6 SID
+func (a *addressSegment) GetSRv6SID() []byte {
+	return a.sid
+}
+
+func address(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return net.IP(b).String()
+}
+
+// parseAddress returns 4 bytes of IPv4 address and 16 bytes of IPv6 address
+func parseAddress(s string) []byte {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil
+	}
+	if !strings.Contains(s, ":") {
+		return ip.To4()
+	}
+	return ip.To16()
+}
+
+func (a *addressSegment) MarshalJSON() ([]byte, error) {
+	s := segmentJSON{
+		SegmentType:       a.segmentType,
+		Flags:             a.flags,
+		Algorithm:         a.algorithm,
+		NodeAddress:       address(a.nodeAddress),
+		LocalInterfaceID:  a.localInterfaceID,
+		LocalAddress:      address(a.localAddress),
+		RemoteInterfaceID: a.remoteInterfaceID,
+		RemoteAddress:     address(a.remoteAddress),
+		SRv6SID:           address(a.sid),
+		SIDStructure:      a.st,
+	}
+	if a.label != nil {
+		s.Label = *a.label
+	}
+	if a.eb != nil {
+		s.EndpointBehavior = &a.eb.EndpointBehavior
+	}
+
+	return json.Marshal(s)
+}
+
+func (a *addressSegment) UnmarshalJSON(b []byte) error {
+	s := segmentJSON{}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	a.segmentType = s.SegmentType
+	a.flags = s.Flags
+	a.algorithm = s.Algorithm
+	a.nodeAddress = parseAddress(s.NodeAddress)
+	a.localInterfaceID = s.LocalInterfaceID
+	a.localAddress = parseAddress(s.LocalAddress)
+	a.remoteInterfaceID = s.RemoteInterfaceID
+	a.remoteAddress = parseAddress(s.RemoteAddress)
+	if s.Label != 0 {
+		l := s.Label
+		a.label = &l
+	}
+	a.sid = parseAddress(s.SRv6SID)
+	a.st = s.SIDStructure
+	if s.EndpointBehavior != nil {
+		a.eb = &srv6.EndpointBehavior{EndpointBehavior: *s.EndpointBehavior}
+	}
+
+	return nil
+}
+
+// addressSegmentFormat defines fields of segment types C to K following Flags, the second byte is SR Algorithm
+// or reserved
+type addressSegmentFormat struct {
+	algorithm        bool
+	localInterfaceID bool
+	// node, local and remote are lengths of addresses carried by the segment type
+	node   int
+	local  int
+	remote int
+	// remoteInterfaceID is carried before the remote address
+	remoteInterfaceID bool
+	srv6              bool
+}
+
+var addressSegmentFormats = map[SegmentType]addressSegmentFormat{
+	TypeC: {algorithm: true, node: net.IPv4len},
+	TypeD: {algorithm: true, node: net.IPv6len},
+	TypeE: {localInterfaceID: true, node: net.IPv4len},
+	TypeF: {local: net.IPv4len, remote: net.IPv4len},
+	TypeG: {localInterfaceID: true, local: net.IPv6len, remoteInterfaceID: true, remote: net.IPv6len},
+	TypeH: {local: net.IPv6len, remote: net.IPv6len},
+	TypeI: {algorithm: true, node: net.IPv6len, srv6: true},
+	TypeJ: {algorithm: true, localInterfaceID: true, local: net.IPv6len, remoteInterfaceID: true, remote: net.IPv6len, srv6: true},
+	TypeK: {algorithm: true, local: net.IPv6len, remote: net.IPv6len, srv6: true},
+}
+
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+// UnmarshalAddressSegment instantiates an instance of segment sub tlv of types C to K
+func UnmarshalAddressSegment(t SegmentType, b []byte) (Segment, error) {
+	if glog.V(5) {
+		glog.Infof("SR Policy Type %d Segment STLV Raw: %s", t, tools.MessageHex(b))
+	}
+	f, ok := addressSegmentFormats[t]
+	if !ok {
+		return nil, fmt.Errorf("unknown type of segment sub tlv %d", t)
+	}
+	l := 2 + f.node + f.local + f.remote
+	if f.localInterfaceID {
+		l += 4
+	}
+	if f.remoteInterfaceID {
+		l += 4
+	}
+	if len(b) < l {
+		return nil, fmt.Errorf("invalid length %d of Type %d Segment STLV", len(b), t)
+	}
+	s := &addressSegment{
+		segmentType: t,
+		flags:       NewSegmentFlags(b[0]),
+	}
+	if f.algorithm {
+		s.algorithm = b[1]
+	}
+	p := 2
+	if f.localInterfaceID {
+		s.localInterfaceID = binary.BigEndian.Uint32(b[p : p+4])
+		p += 4
+	}
+	if f.node != 0 {
+		s.nodeAddress = copyBytes(b[p : p+f.node])
+		p += f.node
+	}
+	if f.local != 0 {
+		s.localAddress = copyBytes(b[p : p+f.local])
+		p += f.local
+	}
+	if f.remoteInterfaceID {
+		s.remoteInterfaceID = binary.BigEndian.Uint32(b[p : p+4])
+		p += 4
+	}
+	if f.remote != 0 {
+		s.remoteAddress = copyBytes(b[p : p+f.remote])
+		p += f.remote
+	}
+	// Optional SID
+	switch r := len(b) - p; {
+	case r == 0:
+	case !f.srv6 && r == 4:
+		label := binary.BigEndian.Uint32(b[p:]) >> 12
+		s.label = &label
+	case f.srv6 && (r == 16 || r == 16+srv6EndpointBehaviorLen):
+		s.sid = copyBytes(b[p : p+16])
+		if r > 16 {
+			var err error
+			if s.eb, s.st, err = unmarshalSRv6EndpointBehavior(b[p+16:]); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid length %d of Type %d Segment STLV", len(b), t)
+	}
+
+	return s, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/limits"
 	"github.com/sbezverk/tools"
 )
 
@@ -99,28 +100,19 @@ func (sl *SegmentList) UnmarshalJSON(b []byte) error {
 				}
 				seg = t
 			case TypeB:
-				fallthrough
-			case TypeC:
-				fallthrough
-			case TypeD:
-				fallthrough
-			case TypeE:
-				fallthrough
-			case TypeF:
-				fallthrough
-			case TypeG:
-				fallthrough
-			case TypeH:
-				fallthrough
-			case TypeI:
-				fallthrough
-			case TypeJ:
-				fallthrough
-			case TypeK:
-				return fmt.Errorf("unsupported type of segment sub tlv %d", segType)
+				t := &typeBSegment{}
+				if err := unmarshalSegmentJSON(s, t); err != nil {
+					return err
+				}
+				seg = t
+			case TypeC, TypeD, TypeE, TypeF, TypeG, TypeH, TypeI, TypeJ, TypeK:
+				t := &addressSegment{}
+				if err := unmarshalSegmentJSON(s, t); err != nil {
+					return err
+				}
+				seg = t
 			default:
 				return fmt.Errorf("unknown type of segment sub tlv %d", segType)
-
 			}
 			segs = append(segs, seg)
 		}
@@ -130,6 +122,15 @@ func (sl *SegmentList) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// unmarshalSegmentJSON unmarshals json object of a segment into the segment
+func unmarshalSegmentJSON(objmap map[string]json.RawMessage, seg json.Unmarshaler) error {
+	b, err := json.Marshal(objmap)
+	if err != nil {
+		return err
+	}
+	return seg.UnmarshalJSON(b)
+}
+
 // UnmarshalSegmentListSTLV instantiates an instance of SegmentList Sub TLV
 func UnmarshalSegmentListSTLV(b []byte) (*SegmentList, error) {
 	if glog.V(5) {
@@ -140,60 +141,51 @@ func UnmarshalSegmentListSTLV(b []byte) (*SegmentList, error) {
 		Segment: make([]Segment, 0),
 	}
 	for p < len(b) {
+		if p+2 > len(b) {
+			return nil, fmt.Errorf("not enough bytes to unmarshal Segment List Sub TLV")
+		}
 		t := int(b[p])
-		p++
+		l := int(b[p+1])
+		p += 2
+		if p+l > len(b) {
+			return nil, fmt.Errorf("length %d of Segment List Sub TLV %d exceeds remaining %d bytes", l, t, len(b)-p)
+		}
+		v := b[p : p+l]
+		p += l
+		var s Segment
+		var err error
 		switch t {
 		case WEIGHTSTLV:
 			if sl.Weight != nil {
 				return nil, fmt.Errorf("Segment List Sub TLV can carry a single instance of Weight")
 			}
-			l := b[p]
-			p++
 			if l != 6 {
 				return nil, fmt.Errorf("invalid length %d of raw data for Weight Sub TLV", l)
 			}
-			w := &Weight{
-				Flags:  b[p],
-				Weight: binary.BigEndian.Uint32(b[p+2 : p+2+4]),
+			sl.Weight = &Weight{
+				Flags:  v[0],
+				Weight: binary.BigEndian.Uint32(v[2:6]),
 			}
-			sl.Weight = w
-			p += int(l)
+			continue
 		case int(TypeA):
-			glog.Infof("Segment of type A")
-			l := b[p]
-			p++
 			if l != 6 {
 				return nil, fmt.Errorf("invalid length %d of raw data for Type A Segment Sub TLV", l)
 			}
-			s, err := UnmarshalTypeASegment(b[p : p+int(l)])
-			if err != nil {
-				return nil, err
-			}
-			sl.Segment = append(sl.Segment, s)
-			p += int(l)
+			s, err = UnmarshalTypeASegment(v)
 		case int(TypeB):
-			glog.Infof("Segment of type B not implemented")
-		case int(TypeC):
-			glog.Infof("Segment of type C not implemented")
-		case int(TypeD):
-			glog.Infof("Segment of type D not implemented")
-		case int(TypeE):
-			glog.Infof("Segment of type E not implemented")
-		case int(TypeF):
-			glog.Infof("Segment of type F not implemented")
-		case int(TypeG):
-			glog.Infof("Segment of type G not implemented")
-		case int(TypeH):
-			glog.Infof("Segment of type H not implemented")
-		case int(TypeI):
-			glog.Infof("Segment of type I not implemented")
-		case int(TypeJ):
-			glog.Infof("Segment of type J not implemented")
-		case int(TypeK):
-			glog.Infof("Segment of type K not implemented")
+			s, err = UnmarshalTypeBSegment(v)
+		case int(TypeC), int(TypeD), int(TypeE), int(TypeF), int(TypeG), int(TypeH), int(TypeI), int(TypeJ), int(TypeK):
+			s, err = UnmarshalAddressSegment(SegmentType(t), v)
 		default:
 			return nil, fmt.Errorf("unknown type of segment sub tlv %d", t)
 		}
+		if err != nil {
+			return nil, err
+		}
+		sl.Segment = append(sl.Segment, s)
+		if err := limits.Check(limits.SegmentListLength, len(sl.Segment)); err != nil {
+			return nil, fmt.Errorf("invalid Segment List Sub TLV with error: %+v", err)
+		}
 	}
 	return sl, nil
 }
@@ -0,0 +1,169 @@
+package srpolicy
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/srv6"
+)
+
+// subTLV returns sub tlv of type t with 1 byte of length, or 2 bytes of length for types 128 to 255
+func subTLV(t byte, v []byte) []byte {
+	b := []byte{t}
+	if t >= 128 {
+		b = binary.BigEndian.AppendUint16(b, uint16(len(v)))
+	} else {
+		b = append(b, byte(len(v)))
+	}
+	return append(b, v...)
+}
+
+func TestUnmarshalSegmentListSTLV(t *testing.T) {
+	sid := net.ParseIP("2001:db8:1:e000::").To16()
+	behavior := []byte{0x00, 0x39, 0x00, 0x00, 32, 16, 16, 0}
+	tests := []struct {
+		name   string
+		input  []byte
+		expect []Segment
+		fail   bool
+	}{
+		{
+			name:  "type b srv6 sid with endpoint behavior",
+			input: subTLV(byte(TypeB), append(append([]byte{0x10, 0x00}, sid...), behavior...)),
+			expect: []Segment{&typeBSegment{
+				flags: &SegmentFlags{Bflag: true},
+				sid:   sid,
+				eb:    &srv6.EndpointBehavior{EndpointBehavior: 0x39},
+				st:    &srv6.SIDStructure{LBLength: 32, LNLength: 16, FunLength: 16},
+			}},
+		},
+		{
+			name:  "type b srv6 sid",
+			input: subTLV(byte(TypeB), append([]byte{0x00, 0x00}, sid...)),
+			expect: []Segment{&typeBSegment{
+				flags: &SegmentFlags{},
+				sid:   sid,
+			}},
+		},
+		{
+			name:  "type c ipv4 node address with sr-mpls sid",
+			input: subTLV(byte(TypeC), []byte{0x80, 0x00, 10, 0, 0, 1, 0x00, 0x3e, 0x81, 0x00}),
+			expect: []Segment{&addressSegment{
+				segmentType: TypeC,
+				flags:       &SegmentFlags{Vflag: true},
+				nodeAddress: []byte{10, 0, 0, 1},
+				label:       func() *uint32 { l := uint32(1000); return &l }(),
+			}},
+		},
+		{
+			name:  "type f ipv4 adjacency without sid",
+			input: subTLV(byte(TypeF), []byte{0x00, 0x00, 10, 0, 0, 1, 10, 0, 0, 2}),
+			expect: []Segment{&addressSegment{
+				segmentType:   TypeF,
+				flags:         &SegmentFlags{},
+				localAddress:  []byte{10, 0, 0, 1},
+				remoteAddress: []byte{10, 0, 0, 2},
+			}},
+		},
+		{
+			name: "type i ipv6 node address with srv6 sid",
+			input: subTLV(byte(TypeI), append(append([]byte{0x00, 128}, net.ParseIP("2001:db8::1").To16()...),
+				sid...)),
+			expect: []Segment{&addressSegment{
+				segmentType: TypeI,
+				flags:       &SegmentFlags{},
+				algorithm:   128,
+				nodeAddress: net.ParseIP("2001:db8::1").To16(),
+				sid:         sid,
+			}},
+		},
+		{
+			name:  "invalid length of type b segment",
+			input: subTLV(byte(TypeB), []byte{0x00, 0x00, 0x20, 0x01}),
+			fail:  true,
+		},
+		{
+			name:  "invalid length of optional sid",
+			input: subTLV(byte(TypeC), []byte{0x00, 0x00, 10, 0, 0, 1, 0x00}),
+			fail:  true,
+		},
+		{
+			name:  "truncated segment",
+			input: []byte{byte(TypeB), 18, 0x00, 0x00},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshalSegmentListSTLV(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("supposed to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if !reflect.DeepEqual(got.Segment, tt.expect) {
+				t.Fatalf("expected segments %+v but got %+v", tt.expect, got.Segment)
+			}
+			// Segments are reconstructed from json of published messages
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("failed to marshal segment list with error: %+v", err)
+			}
+			sl := &SegmentList{}
+			if err := json.Unmarshal(b, sl); err != nil {
+				t.Fatalf("failed to unmarshal segment list %s with error: %+v", string(b), err)
+			}
+			if !reflect.DeepEqual(sl.Segment, tt.expect) {
+				t.Errorf("expected segments %+v but got %+v from json %s", tt.expect, sl.Segment, string(b))
+			}
+		})
+	}
+}
+
+func TestUnmarshalSRPolicyTLVSRv6(t *testing.T) {
+	sid := net.ParseIP("2001:db8:1:e000::").To16()
+	bsid := net.ParseIP("2001:db8:1:b000::").To16()
+	var stlvs []byte
+	stlvs = append(stlvs, subTLV(PREFERENCESTLV, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x64})...)
+	stlvs = append(stlvs, subTLV(SRV6STLV, append(append([]byte{0x00, 0x00}, bsid...), 0x00, 0x48, 0x00, 0x00, 32, 16, 16, 0))...)
+	stlvs = append(stlvs, subTLV(PRIORITYSTLV, []byte{0x05, 0x00})...)
+	stlvs = append(stlvs, subTLV(PATHNAMESTLV, append([]byte{0x00}, "primary"...))...)
+	stlvs = append(stlvs, subTLV(POLICYNAMESTLV, append([]byte{0x00}, "to-pe2"...))...)
+	sl := append([]byte{0x00}, subTLV(WEIGHTSTLV, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x01})...)
+	sl = append(sl, subTLV(byte(TypeB), append([]byte{0x00, 0x00}, sid...))...)
+	stlvs = append(stlvs, subTLV(SEGMENTLISTSTLV, sl)...)
+	b := binary.BigEndian.AppendUint16(nil, SRPOLICYTUNNELTYPE)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(stlvs)))
+	b = append(b, stlvs...)
+
+	tlv, err := UnmarshalSRPolicyTLV(b)
+	if err != nil {
+		t.Fatalf("failed to unmarshal sr policy tlv with error: %+v", err)
+	}
+	if tlv.Preference == nil || tlv.Preference.Preference != 100 || tlv.Priority != 5 || tlv.PathName != "primary" || tlv.Name != "to-pe2" {
+		t.Errorf("unexpected sr policy tlv %+v", *tlv)
+	}
+	if tlv.BindingSID == nil || tlv.BindingSID.Type != SRV6BSID || !bsid.Equal(tlv.BindingSID.BSID.GetBSID()) {
+		t.Fatalf("unexpected binding sid %+v", tlv.BindingSID)
+	}
+	if eb := tlv.BindingSID.BSID.(SRv6BSID).GetEndpointBehavior(); eb == nil || eb.EndpointBehavior != 0x48 {
+		t.Errorf("unexpected endpoint behavior %+v of srv6 binding sid", eb)
+	}
+	if len(tlv.SegmentList) != 1 || tlv.SegmentList[0].Weight.Weight != 1 || len(tlv.SegmentList[0].Segment) != 1 ||
+		tlv.SegmentList[0].Segment[0].GetType() != TypeB {
+		t.Fatalf("unexpected segment lists %+v", tlv.SegmentList)
+	}
+	if got := tlv.SegmentList[0].Segment[0].(TypeBSegment).GetSRv6SID(); !sid.Equal(got) {
+		t.Errorf("expected srv6 sid %s but got %s", net.IP(sid), net.IP(got))
+	}
+	if _, err := UnmarshalSRPolicyTLV(b[:len(b)-4]); err == nil {
+		t.Errorf("expected truncated sr policy tlv to fail")
+	}
+}
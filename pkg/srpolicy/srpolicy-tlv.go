@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/limits"
 	"github.com/sbezverk/tools"
 )
 
@@ -38,8 +39,8 @@ const (
 	SEGMENTLISTSTLV = 128
 	// BSIDSTLV defines Binding SID Sub TLV code
 	BSIDSTLV = 13
-	// SRV6STLV defines SRv6 Binding SID Sub TLV code (NOT YET DEFINED)
-	SRV6STLV = 255
+	// SRV6STLV defines SRv6 Binding SID Sub TLV code
+	SRV6STLV = 20
 	// PREFERENCESTLV defines Preference Sub TLV code
 	PREFERENCESTLV = 12
 	// ENLPSTLV defines Explicit Null Label Policy Sub TLV code
@@ -48,8 +49,8 @@ const (
 	PRIORITYSTLV = 15
 	// PATHNAMESTLV defines  Policy Candidate Path Name Sub-TLV code
 	PATHNAMESTLV = 129
-	// POLICYNAMESTLV defines Policy Name Sub-TLV Sub TLV code (NOT YET DEFINED)
-	POLICYNAMESTLV = 254
+	// POLICYNAMESTLV defines Policy Name Sub-TLV Sub TLV code
+	POLICYNAMESTLV = 130
 )
 
 // UnmarshalSRPolicyTLV builds Link State NLRI object for SAFI 73
@@ -81,64 +82,87 @@ func UnmarshalSRPolicyTLV(b []byte) (*TLV, error) {
 	}
 	for p < len(b) {
 		st := b[p]
-		sl := 0
 		p++
-		switch st {
-		case SEGMENTLISTSTLV:
-			glog.Infof("Segment List Sub TLV")
+		// Sub TLVs of types 128 to 255 carry 2 bytes of length, RFC 9012
+		sl := 0
+		if st >= 128 {
+			if p+2 > len(b) {
+				return nil, fmt.Errorf("not enough bytes to unmarshal length of sub tlv %d", st)
+			}
 			sl = int(binary.BigEndian.Uint16(b[p : p+2]))
 			p += 2
-			// Skip reserved byte
+		} else {
+			if p+1 > len(b) {
+				return nil, fmt.Errorf("not enough bytes to unmarshal length of sub tlv %d", st)
+			}
+			sl = int(b[p])
 			p++
-			sl--
-			l, err := UnmarshalSegmentListSTLV(b[p : p+sl])
+		}
+		if p+sl > len(b) {
+			return nil, fmt.Errorf("length %d of sub tlv %d exceeds remaining %d bytes", sl, st, len(b)-p)
+		}
+		v := b[p : p+sl]
+		p += sl
+		switch st {
+		case SEGMENTLISTSTLV:
+			if sl < 1 {
+				return nil, fmt.Errorf("invalid length %d of segment list sub tlv", sl)
+			}
+			// Skip reserved byte
+			l, err := UnmarshalSegmentListSTLV(v[1:])
 			if err != nil {
 				return nil, err
 			}
 			tlv.SegmentList = append(tlv.SegmentList, l)
-		case BSIDSTLV:
-			glog.Infof("Binding SID Sub TLV")
-			sl = int(b[p])
-			p++
+			if err := limits.Check(limits.SegmentLists, len(tlv.SegmentList)); err != nil {
+				return nil, fmt.Errorf("invalid SR Policy candidate path with error: %+v", err)
+			}
+		case BSIDSTLV, SRV6STLV:
 			tlv.BindingSID = &BindingSID{}
-			if tlv.BindingSID.BSID, err = UnmarshalBSIDSTLV(b[p : p+sl]); err != nil {
+			if st == SRV6STLV {
+				tlv.BindingSID.BSID, err = UnmarshalSRv6BSIDSTLV(v)
+			} else {
+				tlv.BindingSID.BSID, err = UnmarshalBSIDSTLV(v)
+			}
+			if err != nil {
 				return nil, err
 			}
 			tlv.BindingSID.Type = tlv.BindingSID.BSID.GetType()
 		case PREFERENCESTLV:
-			glog.Infof("Preference Sub TLV")
-			sl = int(b[p])
-			p++
-			if tlv.Preference, err = UnmarshalPreferenceSTLV(b[p : p+sl]); err != nil {
+			if tlv.Preference, err = UnmarshalPreferenceSTLV(v); err != nil {
 				return nil, err
 			}
 		case ENLPSTLV:
 			if tlv.ENLP != nil {
 				return nil, fmt.Errorf("only 1 instance of ENLP allowed in SR Policy attributes")
 			}
-			glog.Infof("ENLP Sub TLV")
-			sl = int(b[p])
-			p++
+			if sl != 3 {
+				return nil, fmt.Errorf("invalid length %d of enlp sub tlv", sl)
+			}
 			tlv.ENLP = &ENLP{
-				Flags: b[p],
-				ENLP:  b[p+2],
+				Flags: v[0],
+				ENLP:  v[2],
 			}
 		case PRIORITYSTLV:
-			glog.Infof("Priority Sub TLV")
-			sl = int(b[p])
-			p++
-			tlv.Priority = b[p]
-		case PATHNAMESTLV:
-			glog.Infof("Policy Candidate Path Name Sub TLV")
-			sl = int(b[p])
-			p++
-			tlv.PathName = string(b[p : p+sl])
+			if sl < 1 {
+				return nil, fmt.Errorf("invalid length %d of priority sub tlv", sl)
+			}
+			tlv.Priority = v[0]
+		case PATHNAMESTLV, POLICYNAMESTLV:
+			// Skip reserved byte
+			if sl < 1 {
+				return nil, fmt.Errorf("invalid length %d of name sub tlv %d", sl, st)
+			}
+			if st == PATHNAMESTLV {
+				tlv.PathName = string(v[1:])
+			} else {
+				tlv.Name = string(v[1:])
+			}
 		default:
-			glog.Warningf("SR Policy Sub TLV %+v is not supported", st)
-			sl = int(b[p])
-			p++
+			if glog.V(5) {
+				glog.Infof("SR Policy Sub TLV %d is not supported", st)
+			}
 		}
-		p += sl
 	}
 	return tlv, nil
 }
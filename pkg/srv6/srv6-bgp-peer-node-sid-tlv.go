@@ -3,12 +3,14 @@ package srv6
 import (
 	"encoding/binary"
 	"fmt"
+	"net"
 
 	"github.com/golang/glog"
 	"github.com/sbezverk/tools"
 )
 
-// BGPPeerNodeFlags defines Flags structure for BGP Peer Node SID object
+// BGPPeerNodeFlags defines Flags structure for BGP Peer Node SID object, B flag is set for a backup SID,
+// S flag for a SID of a set of peers and P flag for a persistent SID
 type BGPPeerNodeFlags struct {
 	BFlag bool `json:"b_flag"`
 	SFlag bool `json:"s_flag"`
@@ -26,13 +28,14 @@ func UnmarshalBGPPeerNodeFlags(b []byte) (*BGPPeerNodeFlags, error) {
 	}, nil
 }
 
-// BGPPeerNodeSID defines SRv6 BGP Peer Node SID TLV object
-// No RFC yet
+// BGPPeerNodeSID defines SRv6 BGP Peer Node SID TLV object, PeerBGPID is the string of BGP Identifier of PeerID
+// https://www.rfc-editor.org/rfc/rfc9514.html#section-7.2
 type BGPPeerNodeSID struct {
-	Flags   *BGPPeerNodeFlags `json:"flags"`
-	Weight  uint8             `json:"weight"`
-	PeerASN uint32            `json:"peer_asn"`
-	PeerID  []byte            `json:"peer_id"`
+	Flags     *BGPPeerNodeFlags `json:"flags"`
+	Weight    uint8             `json:"weight"`
+	PeerASN   uint32            `json:"peer_asn"`
+	PeerID    []byte            `json:"peer_id"`
+	PeerBGPID string            `json:"peer_bgp_id,omitempty"`
 }
 
 // UnmarshalSRv6BGPPeerNodeSIDTLV builds SRv6 BGP Peer Node SID TLV object
@@ -40,6 +43,9 @@ func UnmarshalSRv6BGPPeerNodeSIDTLV(b []byte) (*BGPPeerNodeSID, error) {
 	if glog.V(6) {
 		glog.Infof("SRv6 BGP Peer Node SID TLV Raw: %s", tools.MessageHex(b))
 	}
+	if len(b) != 12 {
+		return nil, fmt.Errorf("invalid length %d of SRv6 BGP Peer Node SID TLV", len(b))
+	}
 	bgp := BGPPeerNodeSID{}
 	p := 0
 	f, err := UnmarshalBGPPeerNodeFlags(b[p : p+1])
@@ -49,12 +55,14 @@ func UnmarshalSRv6BGPPeerNodeSIDTLV(b []byte) (*BGPPeerNodeSID, error) {
 	bgp.Flags = f
 	p++
 	bgp.Weight = b[p]
+	p++
 	// Skip reserved 2 bytes
 	p += 2
 	bgp.PeerASN = binary.BigEndian.Uint32(b[p : p+4])
 	p += 4
 	bgp.PeerID = make([]byte, 4)
 	copy(bgp.PeerID, b[p:p+4])
+	bgp.PeerBGPID = net.IP(bgp.PeerID).To4().String()
 
 	return &bgp, nil
 }
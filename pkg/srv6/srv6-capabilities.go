@@ -7,8 +7,9 @@ import (
 	"github.com/sbezverk/tools"
 )
 
-// CapabilityTLV defines SRv6 Capability TLV object
-// No RFC yet
+// CapabilityTLV defines SRv6 Capability TLV object, O flag is set when the node supports the O-bit of
+// Segment Routing Header
+// https://www.rfc-editor.org/rfc/rfc9514.html#section-3.1
 type CapabilityTLV struct {
 	OFlag bool `json:"o_flag"`
 }
@@ -21,7 +22,7 @@ func UnmarshalSRv6CapabilityTLV(b []byte) (*CapabilityTLV, error) {
 	cap := CapabilityTLV{}
 	p := 0
 	if len(b) < 4 {
-		return nil, fmt.Errorf("not enough bytes to decde SRv6 Capability TLV")
+		return nil, fmt.Errorf("not enough bytes to decode SRv6 Capability TLV")
 	}
 	cap.OFlag = b[p]&0x40 == 0x40
 
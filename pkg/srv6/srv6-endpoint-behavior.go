@@ -2,13 +2,14 @@ package srv6
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	"github.com/golang/glog"
 	"github.com/sbezverk/tools"
 )
 
 // EndpointBehavior defines SRv6 Endpoint Behavior TLV object
-// No RFC yet
+// https://www.rfc-editor.org/rfc/rfc9514.html#section-7.1
 type EndpointBehavior struct {
 	EndpointBehavior uint16 `json:"endpoint_behavior"`
 	Flag             uint8  `json:"flag"`
@@ -18,7 +19,10 @@ type EndpointBehavior struct {
 // UnmarshalSRv6EndpointBehaviorTLV builds SRv6 Endpoint Behavior TLV object
 func UnmarshalSRv6EndpointBehaviorTLV(b []byte) (*EndpointBehavior, error) {
 	if glog.V(6) {
-		glog.Infof("SRv6 End.X SID TLV Raw: %s", tools.MessageHex(b))
+		glog.Infof("SRv6 Endpoint Behavior TLV Raw: %s", tools.MessageHex(b))
+	}
+	if len(b) != 4 {
+		return nil, fmt.Errorf("invalid length %d of SRv6 Endpoint Behavior TLV", len(b))
 	}
 	e := EndpointBehavior{}
 	p := 0
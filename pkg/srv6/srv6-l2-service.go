@@ -1,6 +1,52 @@
 package srv6
 
-// L2Service defines SRv6 L2 Service message structure
+import (
+	"github.com/golang/glog"
+	"github.com/sbezverk/tools"
+)
+
+// L2Service defines SRv6 L2 Service message structure, its Sub TLVs are encoded as Sub TLVs of SRv6 L3 Service
 // https://tools.ietf.org/html/draft-dawra-bess-srv6-services-02#section-2
 type L2Service struct {
+	SubTLVs map[uint8][]SvcSubTLV `json:"sub_tlvs,omitempty"`
+}
+
+// UnmarshalJSON unmarshals a slice of byte into L2Service object
+func (l2s *L2Service) UnmarshalJSON(b []byte) error {
+	l3s := &L3Service{}
+	if err := l3s.UnmarshalJSON(b); err != nil {
+		return err
+	}
+	l2s.SubTLVs = l3s.SubTLVs
+
+	return nil
+}
+
+// UnmarshalSRv6L2Service instantiate from the slice of byte SRv6 L2 Service Object
+func UnmarshalSRv6L2Service(b []byte) (*L2Service, error) {
+	if glog.V(6) {
+		glog.Infof("SRv6 L2 Service Raw: %s", tools.MessageHex(b))
+	}
+	if len(b) == 0 {
+		return &L2Service{SubTLVs: make(map[uint8][]SvcSubTLV)}, nil
+	}
+	// Skipping reserved byte
+	stlv, err := UnmarshalSRv6L3ServiceSubTLV(b[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &L2Service{SubTLVs: stlv}, nil
+}
+
+// SIDs returns SIDs of SRv6 Information Sub TLVs of the service with bits transposed into the MPLS Label field
+// of the NLRI restored, label is the 24 bits MPLS Label field
+func (l2s *L2Service) SIDs(label uint32) ([]string, error) {
+	return serviceSIDs(l2s.SubTLVs, label)
+}
+
+// ServiceSIDs returns SIDs of SRv6 Information Sub TLVs of L2 service with their Endpoint Behavior and
+// SID Structure, label is the 24 bits MPLS Label field of the NLRI
+func (l2s *L2Service) ServiceSIDs(label *uint32) ([]*ServiceSID, error) {
+	return serviceSIDInfo("l2", l2s.SubTLVs, label)
 }
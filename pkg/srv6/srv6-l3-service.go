@@ -24,7 +24,9 @@ type SIDStructureSubSubTLV struct {
 
 // UnmarshalSIDStructureSubSubTLV instantiates SID Structure Sub Sub TLV
 func UnmarshalSIDStructureSubSubTLV(b []byte) (*SIDStructureSubSubTLV, error) {
-	// Skip Resrved byte
+	if len(b) < 6 {
+		return nil, fmt.Errorf("invalid length %d of SID Structure Sub Sub TLV", len(b))
+	}
 	p := 0
 	tlv := &SIDStructureSubSubTLV{}
 	tlv.LocalBlockLength = b[p]
@@ -94,8 +96,15 @@ func (istlv *InformationSubTLV) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// informationSubTLVMinLen is the length of reserved bytes, SID, flags and Endpoint Behavior of SRv6 Information
+// Sub TLV
+const informationSubTLVMinLen = 21
+
 // UnmarshalInformationSubTLV instantiates Information SubT LV
 func UnmarshalInformationSubTLV(b []byte) (*InformationSubTLV, error) {
+	if len(b) < informationSubTLVMinLen {
+		return nil, fmt.Errorf("invalid length %d of SRv6 Information Sub TLV", len(b))
+	}
 	// Skip Resrved byte
 	p := 1
 	tlv := &InformationSubTLV{}
@@ -105,6 +114,8 @@ func UnmarshalInformationSubTLV(b []byte) (*InformationSubTLV, error) {
 	p++
 	tlv.EndpointBehavior = binary.BigEndian.Uint16(b[p : p+2])
 	p += 2
+	// Skip Reserved byte
+	p++
 	if p < len(b) {
 		stlv, err := UnmarshalSRv6L3ServiceSubSubTLV(b[p:])
 		if err != nil {
@@ -174,6 +185,9 @@ func UnmarshalSRv6L3Service(b []byte) (*L3Service, error) {
 	l3 := L3Service{
 		SubTLVs: make(map[uint8][]SvcSubTLV),
 	}
+	if len(b) == 0 {
+		return &l3, nil
+	}
 	// Skipping reserved byte
 	stlv, err := UnmarshalSRv6L3ServiceSubTLV(b[1:])
 	if err != nil {
@@ -189,10 +203,16 @@ func UnmarshalSRv6L3ServiceSubTLV(b []byte) (map[uint8][]SvcSubTLV, error) {
 	m := make(map[uint8][]SvcSubTLV)
 	var err error
 	for p := 0; p < len(b); {
+		if p+3 > len(b) {
+			return nil, fmt.Errorf("not enough bytes to unmarshal SRv6 Service Sub TLV")
+		}
 		t := b[p]
 		p++
 		l := binary.BigEndian.Uint16(b[p : p+2])
 		p += 2
+		if p+int(l) > len(b) {
+			return nil, fmt.Errorf("invalid length %d of SRv6 Service Sub TLV type %d, only %d bytes remain", l, t, len(b)-p)
+		}
 		var s SvcSubTLV
 		switch t {
 		case 1:
@@ -219,11 +239,17 @@ func UnmarshalSRv6L3ServiceSubTLV(b []byte) (map[uint8][]SvcSubTLV, error) {
 func UnmarshalSRv6L3ServiceSubSubTLV(b []byte) (map[uint8][]SvcSubSubTLV, error) {
 	var err error
 	m := make(map[uint8][]SvcSubSubTLV)
-	for p := 1; p < len(b); {
+	for p := 0; p < len(b); {
+		if p+3 > len(b) {
+			return nil, fmt.Errorf("not enough bytes to unmarshal SRv6 Service Sub Sub TLV")
+		}
 		t := b[p]
 		p++
 		l := binary.BigEndian.Uint16(b[p : p+2])
 		p += 2
+		if p+int(l) > len(b) {
+			return nil, fmt.Errorf("invalid length %d of SRv6 Service Sub Sub TLV type %d, only %d bytes remain", l, t, len(b)-p)
+		}
 		var s SvcSubSubTLV
 		switch t {
 		case 1:
@@ -0,0 +1,109 @@
+package srv6
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/tools"
+)
+
+const (
+	// ISISLANEndXSIDTLVType defines the type of IS-IS SRv6 LAN End.X SID TLV
+	ISISLANEndXSIDTLVType = 1107
+	// OSPFv3LANEndXSIDTLVType defines the type of OSPFv3 SRv6 LAN End.X SID TLV
+	OSPFv3LANEndXSIDTLVType = 1108
+)
+
+// LANEndXSIDTLV defines SRv6 LAN End.X SID TLV object, NeighborID is System ID of IS-IS neighbor or
+// Router ID of OSPFv3 neighbor
+// https://www.rfc-editor.org/rfc/rfc9514.html#section-4.2
+type LANEndXSIDTLV struct {
+	Type             uint16        `json:"type,omitempty"`
+	Length           uint16        `json:"length,omitempty"`
+	EndpointBehavior uint16        `json:"endpoint_behavior"`
+	Flags            *EndXSIDFlags `json:"flags,omitempty"`
+	Algorithm        uint8         `json:"algorithm"`
+	Weight           uint8         `json:"weight"`
+	NeighborID       string        `json:"neighbor_id,omitempty"`
+	SID              string        `json:"sid,omitempty"`
+	SubTLVs          []SubTLV      `json:"sub_tlvs,omitempty"`
+}
+
+func (e *LANEndXSIDTLV) GetType() uint16 {
+	return e.Type
+}
+func (e *LANEndXSIDTLV) GetLen() uint16 {
+	return e.Length
+}
+
+// UnmarshalJSON reconstructs LAN End.X SID TLV with its sub tlvs from a slice of bytes
+func (e *LANEndXSIDTLV) UnmarshalJSON(b []byte) error {
+	// Fields other than sub tlvs are decoded by the alias type avoiding recursion
+	type lanEndXSIDTLV LANEndXSIDTLV
+	result := &struct {
+		*lanEndXSIDTLV
+		SubTLVs []map[string]json.RawMessage `json:"sub_tlvs,omitempty"`
+	}{
+		lanEndXSIDTLV: (*lanEndXSIDTLV)(&LANEndXSIDTLV{}),
+	}
+	if err := json.Unmarshal(b, result); err != nil {
+		return err
+	}
+	stlvs, err := UnmarshalJSONAllSubTLV(result.SubTLVs)
+	if err != nil {
+		return err
+	}
+	*e = LANEndXSIDTLV(*result.lanEndXSIDTLV)
+	e.SubTLVs = stlvs
+
+	return nil
+}
+
+// UnmarshalSRv6LANEndXSIDTLV builds SRv6 LAN End.X SID TLV object of type t, IS-IS SRv6 LAN End.X SID TLV
+// carries 6 bytes of neighbor's System ID, OSPFv3 SRv6 LAN End.X SID TLV 4 bytes of neighbor's Router ID
+func UnmarshalSRv6LANEndXSIDTLV(t uint16, b []byte) (*LANEndXSIDTLV, error) {
+	if glog.V(5) {
+		glog.Infof("SRv6 LAN End.X SID TLV Raw: %s", tools.MessageHex(b))
+	}
+	var nl int
+	switch t {
+	case ISISLANEndXSIDTLVType:
+		nl = 6
+	case OSPFv3LANEndXSIDTLVType:
+		nl = 4
+	default:
+		return nil, fmt.Errorf("invalid type %d of SRv6 LAN End.X SID TLV", t)
+	}
+	if len(b) < 6+nl+16 {
+		return nil, fmt.Errorf("invalid length of data %d, expected minimum of %d", len(b), 6+nl+16)
+	}
+	e := LANEndXSIDTLV{
+		EndpointBehavior: binary.BigEndian.Uint16(b[0:2]),
+		Algorithm:        b[3],
+		Weight:           b[4],
+	}
+	var err error
+	if e.Flags, err = UnmarshalEndXSIDFlags(b[2:3]); err != nil {
+		return nil, err
+	}
+	// Skip reserved byte
+	p := 6
+	if nl == 4 {
+		e.NeighborID = net.IP(b[p : p+nl]).To4().String()
+	} else {
+		e.NeighborID = fmt.Sprintf("%02x%02x.%02x%02x.%02x%02x", b[p], b[p+1], b[p+2], b[p+3], b[p+4], b[p+5])
+	}
+	p += nl
+	e.SID = net.IP(b[p : p+16]).To16().String()
+	p += 16
+	if len(b) > p {
+		if e.SubTLVs, err = UnmarshalAllSRv6SubTLV(b[p:]); err != nil {
+			return nil, err
+		}
+	}
+
+	return &e, nil
+}
@@ -0,0 +1,124 @@
+package srv6
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestUnmarshalSRv6LANEndXSIDTLV(t *testing.T) {
+	sid := []byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x01, 0xe0, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+	structure := []byte{0x04, 0xE4, 0x00, 0x04, 0x20, 0x10, 0x10, 0x00}
+	tests := []struct {
+		name   string
+		t      uint16
+		input  []byte
+		expect *LANEndXSIDTLV
+		fail   bool
+	}{
+		{
+			name:  "isis with sid structure",
+			t:     ISISLANEndXSIDTLVType,
+			input: append(append([]byte{0x00, 0x39, 0x40, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02}, sid...), structure...),
+			expect: &LANEndXSIDTLV{
+				EndpointBehavior: 0x39,
+				Flags:            &EndXSIDFlags{SFlag: true},
+				Weight:           1,
+				NeighborID:       "0000.0000.0002",
+				SID:              "2001:db8:1:e001::",
+				SubTLVs: []SubTLV{&SIDStructure{
+					Type:      1252,
+					Length:    8,
+					LBLength:  32,
+					LNLength:  16,
+					FunLength: 16,
+				}},
+			},
+		},
+		{
+			name:  "ospfv3",
+			t:     OSPFv3LANEndXSIDTLVType,
+			input: append([]byte{0x00, 0x39, 0x80, 0x80, 0x00, 0x00, 10, 0, 0, 2}, sid...),
+			expect: &LANEndXSIDTLV{
+				EndpointBehavior: 0x39,
+				Flags:            &EndXSIDFlags{BFlag: true},
+				Algorithm:        128,
+				NeighborID:       "10.0.0.2",
+				SID:              "2001:db8:1:e001::",
+			},
+		},
+		{
+			name:  "isis neighbor of ospfv3 length",
+			t:     ISISLANEndXSIDTLVType,
+			input: append([]byte{0x00, 0x39, 0x80, 0x80, 0x00, 0x00, 10, 0, 0, 2}, sid...),
+			fail:  true,
+		},
+		{
+			name:  "invalid type",
+			t:     1106,
+			input: append([]byte{0x00, 0x39, 0x80, 0x80, 0x00, 0x00, 10, 0, 0, 2}, sid...),
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := UnmarshalSRv6LANEndXSIDTLV(tt.t, tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("supposed to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if !reflect.DeepEqual(tt.expect, result) {
+				t.Logf("Differences: %+v", deep.Equal(tt.expect, result))
+				t.Fatalf("Expected object: %+v does not match result: %+v", *tt.expect, *result)
+			}
+			b, err := json.Marshal(result)
+			if err != nil {
+				t.Fatalf("failed to marshal with error: %+v", err)
+			}
+			lan := &LANEndXSIDTLV{}
+			if err := json.Unmarshal(b, lan); err != nil {
+				t.Fatalf("failed to unmarshal %s with error: %+v", string(b), err)
+			}
+			if !reflect.DeepEqual(tt.expect, lan) {
+				t.Logf("Differences: %+v", deep.Equal(tt.expect, lan))
+				t.Errorf("Expected object: %+v does not match unmarshaled json %s", *tt.expect, string(b))
+			}
+		})
+	}
+}
+
+func TestUnmarshalSRv6BGPLSTLVLength(t *testing.T) {
+	if _, err := UnmarshalSRv6EndpointBehaviorTLV([]byte{0x00, 0x39}); err == nil {
+		t.Errorf("expected truncated endpoint behavior tlv to fail")
+	}
+	if _, err := UnmarshalSRv6SIDStructureTLV([]byte{0x20, 0x10}); err == nil {
+		t.Errorf("expected truncated sid structure tlv to fail")
+	}
+	if _, err := UnmarshalSRv6LocatorTLV([]byte{0x80, 0x00}); err == nil {
+		t.Errorf("expected truncated locator tlv to fail")
+	}
+	if _, err := UnmarshalSRv6BGPPeerNodeSIDTLV([]byte{0xa0, 0x01, 0x00, 0x00, 0x00, 0x00, 0xfd, 0xe9}); err == nil {
+		t.Errorf("expected truncated bgp peer node sid tlv to fail")
+	}
+	sid, err := UnmarshalSRv6BGPPeerNodeSIDTLV([]byte{0xa0, 0x01, 0x00, 0x00, 0x00, 0x00, 0xfd, 0xe9, 192, 0, 2, 1})
+	if err != nil {
+		t.Fatalf("failed to unmarshal bgp peer node sid tlv with error: %+v", err)
+	}
+	expect := &BGPPeerNodeSID{
+		Flags:     &BGPPeerNodeFlags{BFlag: true, PFlag: true},
+		Weight:    1,
+		PeerASN:   65001,
+		PeerID:    []byte{192, 0, 2, 1},
+		PeerBGPID: "192.0.2.1",
+	}
+	if !reflect.DeepEqual(sid, expect) {
+		t.Errorf("expected bgp peer node sid %+v but got %+v", *expect, *sid)
+	}
+}
@@ -26,7 +26,7 @@ func UnmarshalLocatorFlags(b []byte) (*LocatorFlags, error) {
 }
 
 // LocatorTLV defines SRv6 Locator TLV object
-// No RFC yet
+// https://www.rfc-editor.org/rfc/rfc9514.html#section-5.1
 type LocatorTLV struct {
 	Flag      *LocatorFlags  `json:"flags,omitempty"`
 	Algorithm uint8          `json:"algo"`
@@ -39,6 +39,9 @@ func UnmarshalSRv6LocatorTLV(b []byte) (*LocatorTLV, error) {
 	if glog.V(6) {
 		glog.Infof("SRv6 Locator TLV Raw: %s", tools.MessageHex(b))
 	}
+	if len(b) < 8 {
+		return nil, fmt.Errorf("invalid length %d of SRv6 Locator TLV", len(b))
+	}
 	p := 0
 	loc := LocatorTLV{}
 	f, err := UnmarshalLocatorFlags(b[p : p+1])
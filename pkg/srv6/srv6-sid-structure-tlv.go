@@ -2,13 +2,15 @@ package srv6
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/golang/glog"
 	"github.com/sbezverk/tools"
 )
 
-// SIDStructure defines SRv6 SID Structure TLV object
-// No RFC yet
+// SIDStructure defines SRv6 SID Structure TLV object, the lengths in bits of Locator Block, Locator Node,
+// Function and Argument of SID
+// https://www.rfc-editor.org/rfc/rfc9514.html#section-8
 type SIDStructure struct {
 	Type      uint16 `json:"type,omitempty"`
 	Length    uint16 `json:"length,omitempty"`
@@ -31,6 +33,9 @@ func UnmarshalSRv6SIDStructureTLV(b []byte) (*SIDStructure, error) {
 	if glog.V(6) {
 		glog.Infof("SRv6 SID Structure TLV Raw: %s", tools.MessageHex(b))
 	}
+	if len(b) != 4 {
+		return nil, fmt.Errorf("invalid length %d of SRv6 SID Structure TLV", len(b))
+	}
 	st := SIDStructure{}
 	p := 0
 	st.LBLength = b[p]
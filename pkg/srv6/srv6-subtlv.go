@@ -20,14 +20,14 @@ type SubTLV interface {
 // | TLV Code |             Description                |
 // |  Point   |                                        |
 // +----------+----------------------------------------+
-// |  1038    |   SRv6 Capabilities TLV                |
+// |  1038    |   SRv6 Capabilities TLV                |   Implemented
 // |  1106    |   SRv6 End.X SID TLV                   |   Implemented
-// |  1107    |   IS-IS SRv6 LAN End.X SID TLV         |
-// |  1108    |   OSPFv3 SRv6 LAN End.X SID TLV        |
-// |  1162    |   SRv6 Locator TLV                     |
-// |   518    |   SRv6 SID Information TLV             |
-// |  1250    |   SRv6 Endpoint Behavior TLV           |
-// |  1251    |   SRv6 BGP Peer Node SID TLV           |
+// |  1107    |   IS-IS SRv6 LAN End.X SID TLV         |   Implemented
+// |  1108    |   OSPFv3 SRv6 LAN End.X SID TLV        |   Implemented
+// |  1162    |   SRv6 Locator TLV                     |   Implemented
+// |   518    |   SRv6 SID Information TLV             |   Implemented
+// |  1250    |   SRv6 Endpoint Behavior TLV           |   Implemented
+// |  1251    |   SRv6 BGP Peer Node SID TLV           |   Implemented
 // |  1252    |   SRv6 SID Structure TLV               |   Implemented
 // +----------+----------------------------------------+
 
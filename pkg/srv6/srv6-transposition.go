@@ -0,0 +1,139 @@
+package srv6
+
+import (
+	"fmt"
+	"net"
+)
+
+// Service defines SRv6 L2 and L3 Services deriving SIDs of the NLRI they are attached to
+type Service interface {
+	SIDs(label uint32) ([]string, error)
+	ServiceSIDs(label *uint32) ([]*ServiceSID, error)
+}
+
+// ServiceSID defines SID of SRv6 Information Sub TLV of L2 or L3 service, with bits transposed into the MPLS Label
+// field of the NLRI restored, its Endpoint Behavior and the lengths of SID Structure Sub Sub TLV
+type ServiceSID struct {
+	// Service is "l3" for SRv6 L3 Service and "l2" for SRv6 L2 Service
+	Service          string `json:"service"`
+	SID              string `json:"sid"`
+	Flags            uint8  `json:"flags,omitempty"`
+	EndpointBehavior uint16 `json:"endpoint_behavior"`
+	*SIDStructureSubSubTLV
+}
+
+var _ Service = &L3Service{}
+var _ Service = &L2Service{}
+
+// Transpose returns SID with Transposition Length bits of the SID, carried in the MPLS Label field of the NLRI,
+// placed at Transposition Offset of the SID. label is the 24 bits MPLS Label field, transposed bits are its most
+// significant bits, for L3 services the field carries up to 20 bits and for EVPN up to 24 bits.
+// https://datatracker.ietf.org/doc/html/rfc9252#section-4
+func (tlv *SIDStructureSubSubTLV) Transpose(sid net.IP, label uint32) (net.IP, error) {
+	sid = sid.To16()
+	if sid == nil {
+		return nil, fmt.Errorf("invalid SRv6 SID")
+	}
+	tl, to := int(tlv.TranspositionLength), int(tlv.TranspositionOffset)
+	if tl == 0 {
+		return sid, nil
+	}
+	if tl > 24 || to+tl > 128 {
+		return nil, fmt.Errorf("invalid transposition length %d and offset %d", tl, to)
+	}
+	bits := label >> uint(24-tl)
+	s := make(net.IP, net.IPv6len)
+	copy(s, sid)
+	for i := 0; i < tl; i++ {
+		pos := to + i
+		mask := byte(0x80) >> uint(pos%8)
+		if bits&(1<<uint(tl-1-i)) != 0 {
+			s[pos/8] |= mask
+		} else {
+			s[pos/8] &^= mask
+		}
+	}
+
+	return s, nil
+}
+
+// Structure returns SID Structure Sub Sub TLV of SRv6 Information Sub TLV, nil is returned when the TLV does
+// not carry it
+func (istlv *InformationSubTLV) Structure() *SIDStructureSubSubTLV {
+	for _, t := range istlv.SubSubTLVs[1] {
+		if s, ok := t.(*SIDStructureSubSubTLV); ok {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// TransposedSID returns the SID of SRv6 Information Sub TLV with bits transposed into the MPLS Label field of
+// the NLRI restored, the SID is returned unchanged when the TLV carries no SID Structure Sub Sub TLV or
+// the Transposition Length is 0, label is the 24 bits MPLS Label field
+func (istlv *InformationSubTLV) TransposedSID(label uint32) (string, error) {
+	structure := istlv.Structure()
+	if structure == nil || structure.TranspositionLength == 0 {
+		return istlv.SID, nil
+	}
+	sid, err := structure.Transpose(net.ParseIP(istlv.SID), label)
+	if err != nil {
+		return "", fmt.Errorf("failed to transpose SRv6 SID %s with error: %+v", istlv.SID, err)
+	}
+
+	return sid.String(), nil
+}
+
+// SIDs returns SIDs of SRv6 Information Sub TLVs of the service with bits transposed into the MPLS Label field
+// of the NLRI restored, label is the 24 bits MPLS Label field
+func (l3s *L3Service) SIDs(label uint32) ([]string, error) {
+	return serviceSIDs(l3s.SubTLVs, label)
+}
+
+// ServiceSIDs returns SIDs of SRv6 Information Sub TLVs of L3 service with their Endpoint Behavior and
+// SID Structure, label is the 24 bits MPLS Label field of the NLRI, nil for NLRI without labels of which
+// SIDs are returned as advertised
+func (l3s *L3Service) ServiceSIDs(label *uint32) ([]*ServiceSID, error) {
+	return serviceSIDInfo("l3", l3s.SubTLVs, label)
+}
+
+func serviceSIDs(subTLVs map[uint8][]SvcSubTLV, label uint32) ([]string, error) {
+	ss, err := serviceSIDInfo("", subTLVs, &label)
+	if err != nil {
+		return nil, err
+	}
+	sids := make([]string, 0, len(ss))
+	for _, s := range ss {
+		sids = append(sids, s.SID)
+	}
+
+	return sids, nil
+}
+
+func serviceSIDInfo(service string, subTLVs map[uint8][]SvcSubTLV, label *uint32) ([]*ServiceSID, error) {
+	ss := make([]*ServiceSID, 0)
+	for _, t := range subTLVs[1] {
+		istlv, ok := t.(*InformationSubTLV)
+		if !ok {
+			continue
+		}
+		s := &ServiceSID{
+			Service:               service,
+			SID:                   istlv.SID,
+			Flags:                 istlv.Flags,
+			EndpointBehavior:      istlv.EndpointBehavior,
+			SIDStructureSubSubTLV: istlv.Structure(),
+		}
+		if label != nil {
+			sid, err := istlv.TransposedSID(*label)
+			if err != nil {
+				return nil, err
+			}
+			s.SID = sid
+		}
+		ss = append(ss, s)
+	}
+
+	return ss, nil
+}
@@ -0,0 +1,135 @@
+package srv6
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTransposedSID(t *testing.T) {
+	tests := []struct {
+		name   string
+		tlv    *InformationSubTLV
+		label  uint32
+		expect string
+		fail   bool
+	}{
+		{
+			name:   "no sid structure",
+			tlv:    &InformationSubTLV{SID: "2001:0:5:4::"},
+			label:  0x00e000,
+			expect: "2001:0:5:4::",
+		},
+		{
+			name: "function transposed into 16 bits",
+			tlv: &InformationSubTLV{
+				SID: "2001:0:5:4::",
+				SubSubTLVs: map[uint8][]SvcSubSubTLV{
+					1: {&SIDStructureSubSubTLV{LocalBlockLength: 40, LocalNodeLength: 24, FunctionLength: 16, TranspositionLength: 16, TranspositionOffset: 64}},
+				},
+			},
+			label:  0x00e000,
+			expect: "2001:0:5:4:e0::",
+		},
+		{
+			name: "20 bits transposed across bytes",
+			tlv: &InformationSubTLV{
+				SID: "2001:db8:0:ffff:ff00::",
+				SubSubTLVs: map[uint8][]SvcSubSubTLV{
+					1: {&SIDStructureSubSubTLV{LocalBlockLength: 32, LocalNodeLength: 16, FunctionLength: 20, TranspositionLength: 20, TranspositionOffset: 48}},
+				},
+			},
+			label:  0xabcde0,
+			expect: "2001:db8:0:abcd:ef00::",
+		},
+		{
+			name: "invalid transposition length",
+			tlv: &InformationSubTLV{
+				SID: "2001:db8::",
+				SubSubTLVs: map[uint8][]SvcSubSubTLV{
+					1: {&SIDStructureSubSubTLV{TranspositionLength: 25, TranspositionOffset: 64}},
+				},
+			},
+			fail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.tlv.TransposedSID(tt.label)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("expected to fail but got sid %s", got)
+			}
+			if got != tt.expect {
+				t.Errorf("expected sid %s but got %s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestL3ServiceSIDs(t *testing.T) {
+	l3, err := UnmarshalSRv6L3Service([]byte{0x00, 0x01, 0x00, 0x1e, 0x00, 0x20, 0x01, 0x00, 0x00, 0x00, 0x05, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x13, 0x00, 0x01, 0x00, 0x06, 0x28, 0x18, 0x10, 0x00, 0x10, 0x40})
+	if err != nil {
+		t.Fatalf("failed to unmarshal SRv6 L3 Service with error: %+v", err)
+	}
+	sids, err := l3.SIDs(0x012340)
+	if err != nil {
+		t.Fatalf("failed with error: %+v", err)
+	}
+	if expect := []string{"2001:0:5:4:123::"}; !reflect.DeepEqual(sids, expect) {
+		t.Errorf("expected sids %v but got %v", expect, sids)
+	}
+}
+
+func TestL3ServiceServiceSIDs(t *testing.T) {
+	l3, err := UnmarshalSRv6L3Service([]byte{0x00, 0x01, 0x00, 0x1e, 0x00, 0x20, 0x01, 0x00, 0x00, 0x00, 0x05, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x13, 0x00, 0x01, 0x00, 0x06, 0x28, 0x18, 0x10, 0x00, 0x10, 0x40})
+	if err != nil {
+		t.Fatalf("failed to unmarshal SRv6 L3 Service with error: %+v", err)
+	}
+	structure := &SIDStructureSubSubTLV{
+		LocalBlockLength:    40,
+		LocalNodeLength:     24,
+		FunctionLength:      16,
+		TranspositionLength: 16,
+		TranspositionOffset: 64,
+	}
+	label := uint32(0x012340)
+	tests := []struct {
+		name   string
+		label  *uint32
+		expect []*ServiceSID
+	}{
+		{
+			name:   "transposed into label",
+			label:  &label,
+			expect: []*ServiceSID{{Service: "l3", SID: "2001:0:5:4:123::", EndpointBehavior: 19, SIDStructureSubSubTLV: structure}},
+		},
+		{
+			name:   "without label",
+			expect: []*ServiceSID{{Service: "l3", SID: "2001:0:5:4::", EndpointBehavior: 19, SIDStructureSubSubTLV: structure}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sids, err := l3.ServiceSIDs(tt.label)
+			if err != nil {
+				t.Fatalf("failed with error: %+v", err)
+			}
+			if !reflect.DeepEqual(sids, tt.expect) {
+				t.Errorf("expected service sids %+v but got %+v", tt.expect, sids)
+			}
+		})
+	}
+	// Information Sub TLV shorter than its fixed fields
+	if _, err := UnmarshalSRv6L3Service([]byte{0x00, 0x01, 0x00, 0x04, 0x00, 0x20, 0x01, 0x00}); err == nil {
+		t.Errorf("expected truncated SRv6 Information Sub TLV to fail")
+	}
+	// Sub TLV length exceeding the service
+	if _, err := UnmarshalSRv6L3Service([]byte{0x00, 0x01, 0x00, 0x1e, 0x00, 0x20}); err == nil {
+		t.Errorf("expected SRv6 Service Sub TLV exceeding the service to fail")
+	}
+}
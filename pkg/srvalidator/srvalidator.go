@@ -0,0 +1,610 @@
+package srvalidator
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/srpolicy"
+)
+
+const (
+	// validationInterval is the period between validations of SR Policies when SR Policies or SIDs change,
+	// it lets BGP-LS topology settle after sessions come up.
+	validationInterval = 10 * time.Second
+	// maxReservedLabel is the highest of MPLS labels reserved for special purposes, RFC 3032
+	maxReservedLabel = 15
+)
+
+const (
+	// ActionInvalid is the action of the event reporting an SR Policy referencing SIDs unknown in BGP-LS topology
+	ActionInvalid = "invalid"
+	// ActionValid is the action of the event reporting a previously invalid SR Policy referencing only known SIDs
+	ActionValid = "valid"
+	// ActionDel is the action of the event reporting withdrawal of a previously invalid SR Policy
+	ActionDel = "del"
+)
+
+// Event defines sr_policy_validation message reporting changes of SR Policy validation state
+type Event struct {
+	Action        string   `json:"action"`
+	RouterIP      string   `json:"router_ip"`
+	PeerIP        string   `json:"peer_ip"`
+	Distinguisher uint32   `json:"distinguisher"`
+	Color         uint32   `json:"color"`
+	Endpoint      string   `json:"endpoint"`
+	PolicyName    string   `json:"policy_name,omitempty"`
+	UnknownSIDs   []uint32 `json:"unknown_sids,omitempty"`
+	Timestamp     string   `json:"timestamp"`
+}
+
+func init() {
+	if err := bmp.RegisterMessageSchema(bmp.SRPolicyValidationMsg, Event{}); err != nil {
+		panic(err)
+	}
+}
+
+type sidFlags struct {
+	VFlag bool `json:"v_flag"`
+}
+
+type lsNodeMsg struct {
+	Action         string `json:"action"`
+	RouterIP       string `json:"router_ip"`
+	PeerIP         string `json:"peer_ip"`
+	PeerRD         string `json:"peer_rd"`
+	DomainID       int64  `json:"domain_id"`
+	ProtocolID     int    `json:"protocol_id"`
+	IGPRouterID    string `json:"igp_router_id"`
+	SRCapabilities *struct {
+		SubTLV []struct {
+			Range uint32 `json:"range"`
+			SID   uint32 `json:"sid"`
+		} `json:"sr_capability_subtlv"`
+	} `json:"ls_sr_capabilities"`
+}
+
+type lsPrefixMsg struct {
+	Action         string `json:"action"`
+	RouterIP       string `json:"router_ip"`
+	PeerIP         string `json:"peer_ip"`
+	PeerRD         string `json:"peer_rd"`
+	DomainID       int64  `json:"domain_id"`
+	ProtocolID     int    `json:"protocol_id"`
+	IGPRouterID    string `json:"igp_router_id"`
+	Prefix         string `json:"prefix"`
+	PrefixLen      int32  `json:"prefix_len"`
+	PrefixAttrTLVs *struct {
+		LSPrefixSID []struct {
+			Flags *sidFlags `json:"flags"`
+			SID   uint32    `json:"prefix_sid"`
+		} `json:"ls_prefix_sid"`
+	} `json:"prefix_attr_tlvs"`
+}
+
+type peerSID struct {
+	SID uint32 `json:"sid"`
+}
+
+type lsLinkMsg struct {
+	Action            string          `json:"action"`
+	RouterIP          string          `json:"router_ip"`
+	PeerIP            string          `json:"peer_ip"`
+	PeerRD            string          `json:"peer_rd"`
+	DomainID          int64           `json:"domain_id"`
+	ProtocolID        int             `json:"protocol_id"`
+	IGPRouterID       string          `json:"igp_router_id"`
+	RemoteIGPRouterID string          `json:"remote_igp_router_id"`
+	LocalLinkID       json.RawMessage `json:"local_link_id"`
+	RemoteLinkID      json.RawMessage `json:"remote_link_id"`
+	LocalLinkIP       json.RawMessage `json:"local_link_ip"`
+	RemoteLinkIP      json.RawMessage `json:"remote_link_ip"`
+	LSAdjacencySID    []struct {
+		Flags *sidFlags `json:"flags"`
+		SID   uint32    `json:"sid"`
+	} `json:"ls_adjacency_sid"`
+	PeerNodeSID *peerSID `json:"peer_node_sid"`
+	PeerAdjSID  *peerSID `json:"peer_adj_sid"`
+	PeerSetSID  *peerSID `json:"peer_set_sid"`
+}
+
+type srPolicyMsg struct {
+	Action        string `json:"action"`
+	RouterIP      string `json:"router_ip"`
+	PeerIP        string `json:"peer_ip"`
+	PeerRD        string `json:"peer_rd"`
+	PathID        int32  `json:"path_id"`
+	Distinguisher uint32 `json:"distinguisher"`
+	Color         uint32 `json:"color"`
+	Endpoint      []byte `json:"endpoint"`
+	PolicyName    string `json:"policy_name"`
+	BSID          *struct {
+		Type srpolicy.BSIDType `json:"bsid_type"`
+		BSID struct {
+			Label uint32 `json:"label_bsid"`
+		} `json:"bsid"`
+	} `json:"binding_sid"`
+	SegmentList []struct {
+		Segments []struct {
+			Type  srpolicy.SegmentType `json:"segment_type"`
+			Label uint32               `json:"label"`
+		} `json:"segments"`
+	} `json:"segment_list_subtlv"`
+}
+
+type peerMsg struct {
+	Action   string `json:"action"`
+	RouterIP string `json:"router_ip"`
+	RemoteIP string `json:"remote_ip"`
+	PeerRD   string `json:"peer_rd"`
+}
+
+type srgbRange struct {
+	base uint32
+	size uint32
+}
+
+// prefixSID is either an index into SRGB of the advertising node or an absolute label
+type prefixSID struct {
+	value   uint32
+	isLabel bool
+}
+
+type prefixSIDs struct {
+	node string
+	sids []prefixSID
+}
+
+// feedKey identifies BGP-LS session of a router advertising IGP topology
+type feedKey struct {
+	routerIP string
+	peerRD   string
+	peerIP   string
+}
+
+// feed stores SIDs advertised over a BGP-LS session
+type feed struct {
+	nodes    map[string][]srgbRange
+	prefixes map[string]*prefixSIDs
+	links    map[string][]uint32
+}
+
+type policyKey struct {
+	routerIP      string
+	peerRD        string
+	peerIP        string
+	pathID        int32
+	distinguisher uint32
+	color         uint32
+	endpoint      string
+}
+
+type policy struct {
+	event   Event
+	bsid    uint32
+	labels  []uint32
+	invalid bool
+}
+
+type validator struct {
+	sync.Mutex
+	publisher pub.Publisher
+	feeds     map[feedKey]*feed
+	policies  map[policyKey]*policy
+	// dirty is set when SIDs or SR Policies change since the last validation
+	dirty bool
+	now   func() time.Time
+	stop  chan struct{}
+}
+
+func (v *validator) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	var err error
+	switch msgType {
+	case bmp.LSNodeMsg:
+		m := &lsNodeMsg{}
+		if err = json.Unmarshal(msg, m); err == nil {
+			v.updateNode(m)
+		}
+	case bmp.LSPrefixMsg:
+		m := &lsPrefixMsg{}
+		if err = json.Unmarshal(msg, m); err == nil {
+			v.updatePrefix(m)
+		}
+	case bmp.LSLinkMsg:
+		m := &lsLinkMsg{}
+		if err = json.Unmarshal(msg, m); err == nil {
+			v.updateLink(m)
+		}
+	case bmp.SRPolicyMsg, bmp.SRPolicyV4Msg, bmp.SRPolicyV6Msg:
+		m := &srPolicyMsg{}
+		if err = json.Unmarshal(msg, m); err == nil {
+			v.updatePolicy(m)
+		}
+	case bmp.PeerStateChangeMsg:
+		m := &peerMsg{}
+		if err = json.Unmarshal(msg, m); err == nil && m.Action != "add" {
+			v.removeFeed(feedKey{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.RemoteIP})
+		}
+	}
+	if err != nil {
+		glog.Errorf("failed to decode message of type %d for sr policy validation with error: %+v", msgType, err)
+	}
+
+	return v.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+func (v *validator) Stop() {
+	close(v.stop)
+	v.publisher.Stop()
+}
+
+func nodeKey(domainID int64, protocolID int, igpRouterID string) string {
+	return strconv.FormatInt(domainID, 10) + " " + strconv.Itoa(protocolID) + " " + igpRouterID
+}
+
+// feed returns SIDs of BGP-LS session, the feed is created when advertisement add is true
+func (v *validator) feed(fk feedKey, add bool) *feed {
+	f, ok := v.feeds[fk]
+	if !ok && add {
+		f = &feed{
+			nodes:    make(map[string][]srgbRange),
+			prefixes: make(map[string]*prefixSIDs),
+			links:    make(map[string][]uint32),
+		}
+		v.feeds[fk] = f
+	}
+
+	return f
+}
+
+func (v *validator) updateNode(m *lsNodeMsg) {
+	key := nodeKey(m.DomainID, m.ProtocolID, m.IGPRouterID)
+	v.Lock()
+	defer v.Unlock()
+	add := m.Action != "del" && m.SRCapabilities != nil
+	f := v.feed(feedKey{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.PeerIP}, add)
+	if f == nil {
+		return
+	}
+	v.dirty = true
+	if !add {
+		delete(f.nodes, key)
+		return
+	}
+	srgb := make([]srgbRange, 0, len(m.SRCapabilities.SubTLV))
+	for _, r := range m.SRCapabilities.SubTLV {
+		srgb = append(srgb, srgbRange{base: r.SID, size: r.Range})
+	}
+	f.nodes[key] = srgb
+}
+
+func (v *validator) updatePrefix(m *lsPrefixMsg) {
+	node := nodeKey(m.DomainID, m.ProtocolID, m.IGPRouterID)
+	key := node + " " + m.Prefix + "/" + strconv.Itoa(int(m.PrefixLen))
+	v.Lock()
+	defer v.Unlock()
+	add := m.Action != "del" && m.PrefixAttrTLVs != nil && len(m.PrefixAttrTLVs.LSPrefixSID) != 0
+	f := v.feed(feedKey{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.PeerIP}, add)
+	if f == nil {
+		return
+	}
+	v.dirty = true
+	if !add {
+		delete(f.prefixes, key)
+		return
+	}
+	p := &prefixSIDs{node: node, sids: make([]prefixSID, 0, len(m.PrefixAttrTLVs.LSPrefixSID))}
+	for _, s := range m.PrefixAttrTLVs.LSPrefixSID {
+		p.sids = append(p.sids, prefixSID{value: s.SID, isLabel: s.Flags != nil && s.Flags.VFlag})
+	}
+	f.prefixes[key] = p
+}
+
+func (v *validator) updateLink(m *lsLinkMsg) {
+	key := nodeKey(m.DomainID, m.ProtocolID, m.IGPRouterID) + " " + m.RemoteIGPRouterID + " " + string(m.LocalLinkID) + " " +
+		string(m.RemoteLinkID) + " " + string(m.LocalLinkIP) + " " + string(m.RemoteLinkIP)
+	labels := make([]uint32, 0, len(m.LSAdjacencySID))
+	for _, s := range m.LSAdjacencySID {
+		// Adjacency SIDs carried as an index are not resolved
+		if s.Flags == nil || s.Flags.VFlag {
+			labels = append(labels, s.SID)
+		}
+	}
+	for _, s := range []*peerSID{m.PeerNodeSID, m.PeerAdjSID, m.PeerSetSID} {
+		if s != nil {
+			labels = append(labels, s.SID)
+		}
+	}
+	v.Lock()
+	defer v.Unlock()
+	add := m.Action != "del" && len(labels) != 0
+	f := v.feed(feedKey{routerIP: m.RouterIP, peerRD: m.PeerRD, peerIP: m.PeerIP}, add)
+	if f == nil {
+		return
+	}
+	v.dirty = true
+	if !add {
+		delete(f.links, key)
+		return
+	}
+	f.links[key] = labels
+}
+
+func (v *validator) removeFeed(fk feedKey) {
+	v.Lock()
+	defer v.Unlock()
+	if _, ok := v.feeds[fk]; ok {
+		delete(v.feeds, fk)
+		v.dirty = true
+	}
+}
+
+// EvictPeer removes SIDs of BGP-LS session and SR Policies received from the peer of the router, or from all peers
+// of the router when peerIP is empty, previously invalid SR Policies are reported withdrawn
+func (v *validator) EvictPeer(routerIP, peerRD, peerIP string) int {
+	v.Lock()
+	defer v.Unlock()
+	n := 0
+	for fk, f := range v.feeds {
+		if fk.routerIP == routerIP && (peerIP == "" || fk.peerRD == peerRD && fk.peerIP == peerIP) {
+			n += len(f.nodes) + len(f.prefixes) + len(f.links)
+			delete(v.feeds, fk)
+			v.dirty = true
+		}
+	}
+	for k, p := range v.policies {
+		if k.routerIP != routerIP || (peerIP != "" && (k.peerRD != peerRD || k.peerIP != peerIP)) {
+			continue
+		}
+		n++
+		delete(v.policies, k)
+		v.dirty = true
+		if p.invalid {
+			e := p.event
+			e.Action = ActionDel
+			e.UnknownSIDs = nil
+			v.publishEvent(&e)
+		}
+	}
+
+	return n
+}
+
+func endpoint(b []byte) string {
+	if len(b) != net.IPv4len && len(b) != net.IPv6len {
+		return ""
+	}
+
+	return net.IP(b).String()
+}
+
+func (v *validator) updatePolicy(m *srPolicyMsg) {
+	k := policyKey{
+		routerIP:      m.RouterIP,
+		peerRD:        m.PeerRD,
+		peerIP:        m.PeerIP,
+		pathID:        m.PathID,
+		distinguisher: m.Distinguisher,
+		color:         m.Color,
+		endpoint:      endpoint(m.Endpoint),
+	}
+	v.Lock()
+	defer v.Unlock()
+	p, ok := v.policies[k]
+	if m.Action == "del" {
+		if !ok {
+			return
+		}
+		delete(v.policies, k)
+		v.dirty = true
+		if p.invalid {
+			e := p.event
+			e.Action = ActionDel
+			e.UnknownSIDs = nil
+			v.publishEvent(&e)
+		}
+		return
+	}
+	if !ok {
+		p = &policy{}
+		v.policies[k] = p
+	}
+	// Unknown SIDs of the previous validation are kept, so an unchanged invalid SR Policy is not reported again
+	p.event = Event{
+		RouterIP:      k.routerIP,
+		PeerIP:        k.peerIP,
+		Distinguisher: k.distinguisher,
+		Color:         k.color,
+		Endpoint:      k.endpoint,
+		PolicyName:    m.PolicyName,
+		UnknownSIDs:   p.event.UnknownSIDs,
+	}
+	p.bsid = 0
+	if m.BSID != nil && m.BSID.Type == srpolicy.LABELBSID {
+		p.bsid = m.BSID.BSID.Label
+	}
+	p.labels = p.labels[:0]
+	for _, sl := range m.SegmentList {
+		for _, s := range sl.Segments {
+			// Only SR-MPLS segments of type A carry SIDs
+			if s.Type == srpolicy.TypeA && s.Label > maxReservedLabel {
+				p.labels = append(p.labels, s.Label)
+			}
+		}
+	}
+	v.dirty = true
+}
+
+// labels returns labels of SIDs advertised in BGP-LS topology, prefix SIDs carrying an index are resolved by SRGB
+// of the advertising node
+func (v *validator) labels() map[uint32]bool {
+	labels := make(map[uint32]bool)
+	for _, f := range v.feeds {
+		for _, p := range f.prefixes {
+			for _, s := range p.sids {
+				if s.isLabel {
+					labels[s.value] = true
+					continue
+				}
+				index := s.value
+				for _, r := range f.nodes[p.node] {
+					if index < r.size {
+						labels[r.base+index] = true
+						break
+					}
+					index -= r.size
+				}
+			}
+		}
+		for _, l := range f.links {
+			for _, label := range l {
+				labels[label] = true
+			}
+		}
+	}
+
+	return labels
+}
+
+// validate validates SR Policies against SIDs of BGP-LS topology when SR Policies or SIDs changed since the last
+// validation, changes of SR Policies state are published as sr_policy_validation messages
+func (v *validator) validate() {
+	v.Lock()
+	defer v.Unlock()
+	if !v.dirty {
+		return
+	}
+	v.dirty = false
+	labels := v.labels()
+	if len(labels) == 0 {
+		// BGP-LS topology with SIDs is not known
+		return
+	}
+	// Binding SIDs of SR Policies can be used as segments of other SR Policies
+	for _, p := range v.policies {
+		if p.bsid != 0 {
+			labels[p.bsid] = true
+		}
+	}
+	for _, p := range v.policies {
+		var unknown []uint32
+		seen := make(map[uint32]bool)
+		for _, l := range p.labels {
+			if !labels[l] && !seen[l] {
+				seen[l] = true
+				unknown = append(unknown, l)
+			}
+		}
+		sort.Slice(unknown, func(i, j int) bool { return unknown[i] < unknown[j] })
+		switch {
+		case len(unknown) != 0 && (!p.invalid || !equal(unknown, p.event.UnknownSIDs)):
+			p.invalid = true
+			p.event.Action = ActionInvalid
+			p.event.UnknownSIDs = unknown
+			glog.Warningf("sr policy color %d endpoint %s of router %s references sids %v unknown in bgp-ls topology",
+				p.event.Color, p.event.Endpoint, p.event.RouterIP, unknown)
+			v.publishEvent(&p.event)
+		case len(unknown) == 0 && p.invalid:
+			p.invalid = false
+			p.event.Action = ActionValid
+			p.event.UnknownSIDs = nil
+			v.publishEvent(&p.event)
+		}
+	}
+}
+
+func equal(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *validator) publishEvent(e *Event) {
+	e.Timestamp = v.now().UTC().Format(time.RFC3339)
+	b, err := json.Marshal(e)
+	if err != nil {
+		glog.Errorf("failed to marshal sr policy validation event with error: %+v", err)
+		return
+	}
+	if err := v.publisher.PublishMessage(bmp.SRPolicyValidationMsg, []byte(e.RouterIP+e.Endpoint), b); err != nil {
+		glog.Errorf("failed to publish sr policy validation event with error: %+v", err)
+	}
+}
+
+func (v *validator) run() {
+	t := time.NewTicker(validationInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			v.validate()
+		case <-v.stop:
+			return
+		}
+	}
+}
+
+// MemoryUsage returns estimated memory used by SIDs advertised per BGP-LS session and SR Policies received
+// per peer of routers
+func (v *validator) MemoryUsage() memory.Usage {
+	v.Lock()
+	defer v.Unlock()
+	c := memory.NewCounter("sr_validator")
+	for fk, f := range v.feeds {
+		for key, srgb := range f.nodes {
+			c.Add(fk.routerIP, fk.peerIP, uint64(unsafe.Sizeof(key)+unsafe.Sizeof(srgb)+uintptr(cap(srgb))*unsafe.Sizeof(srgbRange{}))+
+				uint64(len(key))+memory.MapEntryOverhead)
+		}
+		for key, p := range f.prefixes {
+			c.Add(fk.routerIP, fk.peerIP, uint64(unsafe.Sizeof(key)+unsafe.Sizeof(p)+unsafe.Sizeof(*p)+uintptr(cap(p.sids))*unsafe.Sizeof(prefixSID{}))+
+				uint64(len(key))+memory.MapEntryOverhead)
+		}
+		for key, labels := range f.links {
+			c.Add(fk.routerIP, fk.peerIP, uint64(unsafe.Sizeof(key)+unsafe.Sizeof(labels)+uintptr(cap(labels))*unsafe.Sizeof(uint32(0)))+
+				uint64(len(key))+memory.MapEntryOverhead)
+		}
+	}
+	for k, p := range v.policies {
+		b := uint64(unsafe.Sizeof(k)+unsafe.Sizeof(p)+unsafe.Sizeof(*p)) + memory.MapEntryOverhead
+		b += uint64(len(k.routerIP)+len(k.peerIP)+len(k.endpoint)+len(p.event.PolicyName)) +
+			uint64(uintptr(cap(p.labels)+cap(p.event.UnknownSIDs))*unsafe.Sizeof(uint32(0)))
+		c.Add(k.routerIP, k.peerIP, b)
+	}
+
+	return c.Usage()
+}
+
+// NewValidator returns a publisher validating SR-MPLS segments of SR Policies against SIDs advertised in BGP-LS
+// topology, prefix SIDs, adjacency SIDs and BGP peering SIDs. Messages are passed to publisher, changes of
+// SR Policies validation state are published as sr_policy_validation messages.
+func NewValidator(publisher pub.Publisher) pub.Publisher {
+	v := newValidator(publisher, time.Now)
+	go v.run()
+
+	return v
+}
+
+func newValidator(publisher pub.Publisher, now func() time.Time) *validator {
+	return &validator{
+		publisher: publisher,
+		feeds:     make(map[feedKey]*feed),
+		policies:  make(map[policyKey]*policy),
+		now:       now,
+		stop:      make(chan struct{}),
+	}
+}
@@ -0,0 +1,134 @@
+package srvalidator
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	events []*Event
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if msgType != bmp.SRPolicyValidationMsg {
+		return nil
+	}
+	e := &Event{}
+	if err := json.Unmarshal(msg, e); err != nil {
+		return err
+	}
+	p.events = append(p.events, e)
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+type testMsg struct {
+	msgType int
+	msg     string
+}
+
+const (
+	// Node 0000.0000.0001 with SRGB 16000-23999 and prefix SID index 1, adjacency SID 24001
+	testNode   = `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","protocol_id":2,"igp_router_id":"0000.0000.0001","ls_sr_capabilities":{"sr_capability_subtlv":[{"range":8000,"sid":16000}]}}`
+	testPrefix = `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","protocol_id":2,"igp_router_id":"0000.0000.0001","prefix":"10.0.0.1","prefix_len":32,"prefix_attr_tlvs":{"ls_prefix_sid":[{"flags":{"r_flag":false,"v_flag":false},"prefix_sid":1}]}}`
+	testLink   = `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","protocol_id":2,"igp_router_id":"0000.0000.0001","remote_igp_router_id":"0000.0000.0002","local_link_ip":"10.1.1.1","ls_adjacency_sid":[{"flags":{"v_flag":true,"l_flag":true},"sid":24001}]}`
+	// testPolicy references SIDs 16001, 24001 and the label replacing label_placeholder
+	testPolicy = `{"action":"add","router_ip":"10.0.0.9","peer_ip":"10.0.0.100","color":100,"endpoint":"CgAAAg==","binding_sid":{"bsid_type":2,"bsid":{"label_bsid":15000}},"segment_list_subtlv":[{"segments":[{"segment_type":1,"label":16001},{"segment_type":1,"label":24001},{"segment_type":1,"label":label_placeholder}]}]}`
+)
+
+func policyMsg(label string) testMsg {
+	return testMsg{bmp.SRPolicyV4Msg, strings.ReplaceAll(testPolicy, "label_placeholder", label)}
+}
+
+func TestValidator(t *testing.T) {
+	topology := []testMsg{{bmp.LSNodeMsg, testNode}, {bmp.LSPrefixMsg, testPrefix}, {bmp.LSLinkMsg, testLink}}
+	tests := []struct {
+		name   string
+		steps  [][]testMsg
+		events [][]string
+	}{
+		{
+			name:   "valid policy",
+			steps:  [][]testMsg{append(topology, policyMsg("16001"))},
+			events: [][]string{nil},
+		},
+		{
+			name:   "policy with unknown sid",
+			steps:  [][]testMsg{append(topology, policyMsg("16999"))},
+			events: [][]string{{ActionInvalid + " [16999]"}},
+		},
+		{
+			name:   "policy without topology",
+			steps:  [][]testMsg{{policyMsg("16999")}},
+			events: [][]string{nil},
+		},
+		{
+			name:   "policy referencing reserved label and binding sid",
+			steps:  [][]testMsg{append(topology, policyMsg("3"), policyMsg("15000"))},
+			events: [][]string{nil},
+		},
+		{
+			name: "withdrawn prefix sid",
+			steps: [][]testMsg{
+				append(topology, policyMsg("16001")),
+				{{bmp.LSPrefixMsg, strings.ReplaceAll(testPrefix, `"action":"add"`, `"action":"del"`)}},
+			},
+			events: [][]string{nil, {ActionInvalid + " [16001]"}},
+		},
+		{
+			name: "policy fixed and withdrawn",
+			steps: [][]testMsg{
+				append(topology, policyMsg("16999")),
+				{policyMsg("16001")},
+				{policyMsg("16999")},
+				{policyMsg("16999")},
+				{{bmp.SRPolicyV4Msg, strings.ReplaceAll(policyMsg("16999").msg, `"action":"add"`, `"action":"del"`)}},
+			},
+			events: [][]string{{ActionInvalid + " [16999]"}, {ActionValid + " []"}, {ActionInvalid + " [16999]"}, nil, {ActionDel + " []"}},
+		},
+		{
+			name: "feed down",
+			steps: [][]testMsg{
+				append(topology, testMsg{bmp.LSLinkMsg, strings.ReplaceAll(strings.ReplaceAll(testLink, "10.0.0.1", "10.0.0.2"), "24001", "24002")}, policyMsg("16001")),
+				{{bmp.PeerStateChangeMsg, `{"action":"down","router_ip":"10.0.0.1","remote_ip":"192.168.0.1"}`}},
+			},
+			events: [][]string{nil, {ActionInvalid + " [16001 24001]"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{}
+			v := newValidator(p, func() time.Time { return time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC) })
+			for i, step := range tt.steps {
+				p.events = nil
+				for _, m := range step {
+					if err := v.PublishMessage(m.msgType, nil, []byte(m.msg)); err != nil {
+						t.Fatalf("failed to publish message with error: %+v", err)
+					}
+				}
+				v.validate()
+				var events []string
+				for _, e := range p.events {
+					if e.Color != 100 || e.Endpoint != "10.0.0.2" || e.RouterIP != "10.0.0.9" {
+						t.Errorf("unexpected policy in event %+v", e)
+					}
+					b, _ := json.Marshal(e.UnknownSIDs)
+					s := string(b)
+					if e.UnknownSIDs == nil {
+						s = "[]"
+					}
+					events = append(events, e.Action+" "+strings.ReplaceAll(s, ",", " "))
+				}
+				if !reflect.DeepEqual(events, tt.events[i]) {
+					t.Errorf("step %d: expected events %v but got %v", i, tt.events[i], events)
+				}
+			}
+		})
+	}
+}
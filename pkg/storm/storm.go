@@ -0,0 +1,251 @@
+package storm
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// checkInterval is the period between checks for storms ended by no peer going down for the window
+const checkInterval = time.Second
+
+// Storm defines peer_storm message summarizing peers of a router gone down within the window of each other
+type Storm struct {
+	RouterIP   string `json:"router_ip"`
+	RouterHash string `json:"router_hash,omitempty"`
+	// Start and End are times the first and the last peer of the storm went down
+	Start     string `json:"start"`
+	End       string `json:"end"`
+	PeerCount int    `json:"peer_count"`
+	// Reasons counts peers per BMP Peer Down reason code
+	Reasons   map[string]int `json:"reasons"`
+	Peers     []Peer         `json:"peers"`
+	Timestamp string         `json:"timestamp"`
+}
+
+// Peer defines a peer gone down during the storm
+type Peer struct {
+	PeerIP    string `json:"peer_ip"`
+	PeerASN   uint32 `json:"peer_asn,omitempty"`
+	PeerRD    string `json:"peer_rd,omitempty"`
+	BMPReason int    `json:"bmp_reason,omitempty"`
+	Timestamp string `json:"timestamp"`
+}
+
+func init() {
+	if err := bmp.RegisterMessageSchema(bmp.PeerStormMsg, Storm{}); err != nil {
+		panic(err)
+	}
+}
+
+type peerMsg struct {
+	Action     string `json:"action"`
+	RouterIP   string `json:"router_ip"`
+	RouterHash string `json:"router_hash"`
+	RemoteIP   string `json:"remote_ip"`
+	RemoteASN  uint32 `json:"remote_asn"`
+	PeerRD     string `json:"peer_rd"`
+	BMPReason  int    `json:"bmp_reason"`
+}
+
+type down struct {
+	peer Peer
+	at   time.Time
+}
+
+// router stores peers of the router gone down within the window and the storm in progress
+type router struct {
+	downs []down
+	storm *Storm
+	last  time.Time
+}
+
+type aggregator struct {
+	sync.Mutex
+	publisher pub.Publisher
+	threshold int
+	window    time.Duration
+	routers   map[string]*router
+	now       func() time.Time
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+func (a *aggregator) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if msgType == bmp.PeerStateChangeMsg {
+		m := &peerMsg{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			glog.Errorf("failed to decode peer message for peer storms with error: %+v", err)
+		} else if m.Action != "add" {
+			a.peerDown(m)
+		}
+	}
+
+	return a.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+// Stop publishes storms in progress before stopping publisher
+func (a *aggregator) Stop() {
+	close(a.stop)
+	<-a.done
+	for _, s := range a.flush(true) {
+		a.publishStorm(s)
+	}
+	a.publisher.Stop()
+}
+
+func (a *aggregator) peerDown(m *peerMsg) {
+	now := a.now()
+	a.Lock()
+	defer a.Unlock()
+	r, ok := a.routers[m.RouterIP]
+	if !ok {
+		r = &router{}
+		a.routers[m.RouterIP] = r
+	}
+	d := down{
+		peer: Peer{
+			PeerIP:    m.RemoteIP,
+			PeerASN:   m.RemoteASN,
+			PeerRD:    m.PeerRD,
+			BMPReason: m.BMPReason,
+			Timestamp: now.UTC().Format(time.RFC3339),
+		},
+		at: now,
+	}
+	r.last = now
+	if r.storm != nil {
+		addPeer(r.storm, d)
+		return
+	}
+	// Peers gone down before the window are not part of a storm
+	i := 0
+	for i < len(r.downs) && now.Sub(r.downs[i].at) >= a.window {
+		i++
+	}
+	r.downs = append(r.downs[i:], d)
+	if len(r.downs) < a.threshold {
+		return
+	}
+	r.storm = &Storm{
+		RouterIP:   m.RouterIP,
+		RouterHash: m.RouterHash,
+		Start:      r.downs[0].peer.Timestamp,
+		Reasons:    make(map[string]int),
+	}
+	for _, d := range r.downs {
+		addPeer(r.storm, d)
+	}
+	r.downs = nil
+	glog.Warningf("peer storm on router %s, %d peers went down within %s", m.RouterIP, r.storm.PeerCount, a.window)
+}
+
+func addPeer(s *Storm, d down) {
+	s.Peers = append(s.Peers, d.peer)
+	s.PeerCount++
+	s.Reasons[strconv.Itoa(d.peer.BMPReason)]++
+	s.End = d.peer.Timestamp
+}
+
+// flush returns storms no peer went down for the window, or all storms in progress when all is true, and removes
+// peers gone down before the window
+func (a *aggregator) flush(all bool) []*Storm {
+	now := a.now()
+	a.Lock()
+	defer a.Unlock()
+	var storms []*Storm
+	for ip, r := range a.routers {
+		if now.Sub(r.last) < a.window && !all {
+			continue
+		}
+		if r.storm != nil {
+			storms = append(storms, r.storm)
+		}
+		delete(a.routers, ip)
+	}
+	sort.Slice(storms, func(i, j int) bool { return storms[i].RouterIP < storms[j].RouterIP })
+
+	return storms
+}
+
+func (a *aggregator) publishStorm(s *Storm) {
+	s.Timestamp = a.now().UTC().Format(time.RFC3339)
+	b, err := json.Marshal(s)
+	if err != nil {
+		glog.Errorf("failed to marshal peer storm with error: %+v", err)
+		return
+	}
+	if err := a.publisher.PublishMessage(bmp.PeerStormMsg, []byte(s.RouterIP+s.Start), b); err != nil {
+		glog.Errorf("failed to publish peer storm with error: %+v", err)
+	}
+}
+
+func (a *aggregator) run() {
+	defer close(a.done)
+	t := time.NewTicker(checkInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			for _, s := range a.flush(false) {
+				a.publishStorm(s)
+			}
+		case <-a.stop:
+			return
+		}
+	}
+}
+
+// MemoryUsage returns estimated memory used by peers gone down per router
+func (a *aggregator) MemoryUsage() memory.Usage {
+	a.Lock()
+	defer a.Unlock()
+	c := memory.NewCounter("peer_storm")
+	for ip, r := range a.routers {
+		b := uint64(unsafe.Sizeof(ip)+unsafe.Sizeof(r)+unsafe.Sizeof(*r)) + uint64(len(ip)) + memory.MapEntryOverhead
+		b += uint64(uintptr(cap(r.downs)) * unsafe.Sizeof(down{}))
+		if r.storm != nil {
+			b += uint64(unsafe.Sizeof(*r.storm)) + uint64(uintptr(cap(r.storm.Peers))*unsafe.Sizeof(Peer{}))
+		}
+		c.AddSession(ip, len(r.downs)+1, b)
+	}
+
+	return c.Usage()
+}
+
+// NewAggregator returns a publisher summarizing peers of a router going down within the window of each other into
+// a peer_storm message, a storm starts when threshold peers go down within the window and ends when no peer goes
+// down for the window. Peer messages are passed to publisher as usual.
+func NewAggregator(publisher pub.Publisher, threshold int, window time.Duration) (pub.Publisher, error) {
+	if threshold < 2 {
+		return nil, fmt.Errorf("invalid peer storm threshold %d, at least 2 peers are required", threshold)
+	}
+	if window <= 0 {
+		return nil, fmt.Errorf("invalid peer storm window %s", window)
+	}
+	a := newAggregator(publisher, threshold, window, time.Now)
+	go a.run()
+
+	return a, nil
+}
+
+func newAggregator(publisher pub.Publisher, threshold int, window time.Duration, now func() time.Time) *aggregator {
+	return &aggregator{
+		publisher: publisher,
+		threshold: threshold,
+		window:    window,
+		routers:   make(map[string]*router),
+		now:       now,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
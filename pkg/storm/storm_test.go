@@ -0,0 +1,121 @@
+package storm
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	peers  int
+	storms []*Storm
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	switch msgType {
+	case bmp.PeerStateChangeMsg:
+		p.peers++
+	case bmp.PeerStormMsg:
+		s := &Storm{}
+		if err := json.Unmarshal(msg, s); err != nil {
+			return err
+		}
+		p.storms = append(p.storms, s)
+	}
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+func peerDown(router string, peer int, reason int) []byte {
+	return []byte(`{"action":"del","router_ip":"` + router + `","remote_ip":"192.168.0.` + strconv.Itoa(peer) +
+		`","remote_asn":6500` + strconv.Itoa(peer) + `,"bmp_reason":` + strconv.Itoa(reason) + `}`)
+}
+
+type testDown struct {
+	// after is the time since the previous peer down
+	after  time.Duration
+	router string
+	peer   int
+	reason int
+}
+
+func TestAggregator(t *testing.T) {
+	tests := []struct {
+		name string
+		// downs are followed by the window without peers going down
+		downs []testDown
+		want  []Storm
+	}{
+		{
+			name:  "below threshold",
+			downs: []testDown{{0, "10.0.0.1", 1, 1}, {time.Second, "10.0.0.1", 2, 1}},
+		},
+		{
+			name:  "peers outside the window",
+			downs: []testDown{{0, "10.0.0.1", 1, 1}, {time.Second, "10.0.0.1", 2, 1}, {30 * time.Second, "10.0.0.1", 3, 1}},
+		},
+		{
+			name:  "different routers",
+			downs: []testDown{{0, "10.0.0.1", 1, 1}, {time.Second, "10.0.0.2", 2, 1}, {time.Second, "10.0.0.3", 3, 1}},
+		},
+		{
+			name: "storm",
+			downs: []testDown{{0, "10.0.0.1", 1, 1}, {time.Second, "10.0.0.1", 2, 1}, {time.Second, "10.0.0.1", 3, 4},
+				{20 * time.Second, "10.0.0.1", 4, 4}},
+			want: []Storm{{
+				RouterIP:  "10.0.0.1",
+				Start:     "2026-01-01T00:00:00Z",
+				End:       "2026-01-01T00:00:22Z",
+				PeerCount: 4,
+				Reasons:   map[string]int{"1": 2, "4": 2},
+				Peers: []Peer{
+					{PeerIP: "192.168.0.1", PeerASN: 65001, BMPReason: 1, Timestamp: "2026-01-01T00:00:00Z"},
+					{PeerIP: "192.168.0.2", PeerASN: 65002, BMPReason: 1, Timestamp: "2026-01-01T00:00:01Z"},
+					{PeerIP: "192.168.0.3", PeerASN: 65003, BMPReason: 4, Timestamp: "2026-01-01T00:00:02Z"},
+					{PeerIP: "192.168.0.4", PeerASN: 65004, BMPReason: 4, Timestamp: "2026-01-01T00:00:22Z"},
+				},
+				Timestamp: "2026-01-01T00:00:52Z",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{}
+			now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			a := newAggregator(p, 3, 30*time.Second, func() time.Time { return now })
+			for _, d := range tt.downs {
+				now = now.Add(d.after)
+				if err := a.PublishMessage(bmp.PeerStateChangeMsg, nil, peerDown(d.router, d.peer, d.reason)); err != nil {
+					t.Fatalf("failed to publish message with error: %+v", err)
+				}
+				// Storms are not published before no peer goes down for the window
+				if s := a.flush(false); len(s) != 0 {
+					t.Fatalf("storm published before the end of the window")
+				}
+			}
+			now = now.Add(30 * time.Second)
+			for _, s := range a.flush(false) {
+				a.publishStorm(s)
+			}
+			if p.peers != len(tt.downs) {
+				t.Errorf("got %d peer messages, want %d", p.peers, len(tt.downs))
+			}
+			if len(p.storms) != len(tt.want) {
+				t.Fatalf("got %d storms, want %d", len(p.storms), len(tt.want))
+			}
+			for i := range tt.want {
+				if !reflect.DeepEqual(*p.storms[i], tt.want[i]) {
+					t.Errorf("got storm %+v, want %+v", *p.storms[i], tt.want[i])
+				}
+			}
+			if len(a.routers) != 0 {
+				t.Errorf("got %d routers after the window, want 0", len(a.routers))
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package systemd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// listenFDsStart is the first file descriptor passed by systemd socket activation
+	listenFDsStart = 3
+)
+
+// Listeners returns listeners passed by systemd socket activation (sd_listen_fds(3)) by their names,
+// as set by FileDescriptorName= of the socket unit. File descriptors without a name are named "fd" followed
+// by their number. No listeners are returned when the process was not socket activated. Environment variables
+// of socket activation are unset, so they are not inherited by child processes.
+func Listeners() (map[string]net.Listener, error) {
+	pid, fds, names := os.Getenv("LISTEN_PID"), os.Getenv("LISTEN_FDS"), os.Getenv("LISTEN_FDNAMES")
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+	os.Unsetenv("LISTEN_FDNAMES")
+	if pid == "" || fds == "" {
+		return nil, nil
+	}
+	p, err := strconv.Atoi(pid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LISTEN_PID %q", pid)
+	}
+	if p != os.Getpid() {
+		// File descriptors were passed to another process
+		return nil, nil
+	}
+	n, err := strconv.Atoi(fds)
+	if err != nil || n < 0 {
+		return nil, fmt.Errorf("invalid LISTEN_FDS %q", fds)
+	}
+	var nl []string
+	if names != "" {
+		nl = strings.Split(names, ":")
+	}
+	listeners := make(map[string]net.Listener, n)
+	for i := 0; i < n; i++ {
+		fd := listenFDsStart + i
+		name := "fd" + strconv.Itoa(fd)
+		if i < len(nl) && nl[i] != "" {
+			name = nl[i]
+		}
+		f := os.NewFile(uintptr(fd), name)
+		l, err := net.FileListener(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to use file descriptor %d named %s as listener with error: %+v", fd, name, err)
+		}
+		if _, ok := listeners[name]; ok {
+			l.Close()
+			return nil, fmt.Errorf("duplicate name %s of file descriptor %d", name, fd)
+		}
+		listeners[name] = l
+	}
+
+	return listeners, nil
+}
+
+// Notify sends the state to the service manager (sd_notify(3)), for example "READY=1", "STOPPING=1" or
+// "WATCHDOG=1". Notify does nothing when the service manager does not expect notifications.
+func Notify(state string) error {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return nil
+	}
+	// Names of abstract sockets start with '@', which is translated to the leading NUL byte
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return fmt.Errorf("failed to connect to notify socket %s with error: %+v", socket, err)
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte(state)); err != nil {
+		return fmt.Errorf("failed to send notification to socket %s with error: %+v", socket, err)
+	}
+
+	return nil
+}
+
+// WatchdogInterval returns the period within which the service manager expects "WATCHDOG=1" notifications
+// (sd_watchdog_enabled(3)), 0 is returned when the watchdog is not enabled for the process.
+func WatchdogInterval() (time.Duration, error) {
+	usec, pid := os.Getenv("WATCHDOG_USEC"), os.Getenv("WATCHDOG_PID")
+	if usec == "" {
+		return 0, nil
+	}
+	if pid != "" {
+		p, err := strconv.Atoi(pid)
+		if err != nil {
+			return 0, fmt.Errorf("invalid WATCHDOG_PID %q", pid)
+		}
+		if p != os.Getpid() {
+			return 0, nil
+		}
+	}
+	u, err := strconv.ParseInt(usec, 10, 64)
+	if err != nil || u <= 0 {
+		return 0, fmt.Errorf("invalid WATCHDOG_USEC %q", usec)
+	}
+
+	return time.Duration(u) * time.Microsecond, nil
+}
@@ -0,0 +1,141 @@
+package systemd
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestListeners(t *testing.T) {
+	tests := []struct {
+		name string
+		pid  string
+		fds  string
+		fail bool
+	}{
+		{
+			name: "not activated",
+		},
+		{
+			name: "other process",
+			pid:  strconv.Itoa(os.Getpid() + 1),
+			fds:  "1",
+		},
+		{
+			name: "invalid pid",
+			pid:  "pid",
+			fds:  "1",
+			fail: true,
+		},
+		{
+			name: "invalid fds",
+			pid:  strconv.Itoa(os.Getpid()),
+			fds:  "-1",
+			fail: true,
+		},
+		{
+			name: "no fds",
+			pid:  strconv.Itoa(os.Getpid()),
+			fds:  "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LISTEN_PID", tt.pid)
+			t.Setenv("LISTEN_FDS", tt.fds)
+			l, err := Listeners()
+			if err != nil && !tt.fail {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err == nil && tt.fail {
+				t.Fatalf("expected error but succeeded")
+			}
+			if len(l) != 0 {
+				t.Errorf("expected no listeners but got %d", len(l))
+			}
+			if _, ok := os.LookupEnv("LISTEN_FDS"); ok {
+				t.Errorf("expected LISTEN_FDS to be unset")
+			}
+		})
+	}
+}
+
+func TestNotify(t *testing.T) {
+	t.Setenv("NOTIFY_SOCKET", "")
+	if err := Notify("READY=1"); err != nil {
+		t.Fatalf("unexpected error without notify socket: %+v", err)
+	}
+	socket := filepath.Join(t.TempDir(), "notify")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		t.Skipf("unixgram sockets are not supported: %+v", err)
+	}
+	defer conn.Close()
+	t.Setenv("NOTIFY_SOCKET", socket)
+	if err := Notify("READY=1"); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	b := make([]byte, 64)
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	n, err := conn.Read(b)
+	if err != nil {
+		t.Fatalf("failed to read notification with error: %+v", err)
+	}
+	if got := string(b[:n]); got != "READY=1" {
+		t.Errorf("expected notification READY=1 but got %s", got)
+	}
+}
+
+func TestWatchdogInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		usec     string
+		pid      string
+		interval time.Duration
+		fail     bool
+	}{
+		{
+			name: "not enabled",
+		},
+		{
+			name:     "enabled",
+			usec:     "30000000",
+			interval: 30 * time.Second,
+		},
+		{
+			name:     "enabled for the process",
+			usec:     "1000",
+			pid:      strconv.Itoa(os.Getpid()),
+			interval: time.Millisecond,
+		},
+		{
+			name: "enabled for other process",
+			usec: "1000",
+			pid:  strconv.Itoa(os.Getpid() + 1),
+		},
+		{
+			name: "invalid",
+			usec: "0",
+			fail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("WATCHDOG_USEC", tt.usec)
+			t.Setenv("WATCHDOG_PID", tt.pid)
+			interval, err := WatchdogInterval()
+			if err != nil && !tt.fail {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err == nil && tt.fail {
+				t.Fatalf("expected error but succeeded")
+			}
+			if interval != tt.interval {
+				t.Errorf("expected interval %s but got %s", tt.interval, interval)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// scopeName is the instrumentation scope of exported spans
+const scopeName = "github.com/sbezverk/gobmp"
+
+// OTLP status code of spans failed with an error
+const statusCodeError = 2
+
+// Types below are OTLP/HTTP JSON encoding of ExportTraceServiceRequest, ids are hex encoded
+// and 64 bits integers are encoded as strings as required by OTLP.
+type exportRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scopeSpans struct {
+	Scope scope      `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string     `json:"traceId"`
+	SpanID            string     `json:"spanId"`
+	ParentSpanID      string     `json:"parentSpanId,omitempty"`
+	Name              string     `json:"name"`
+	Kind              SpanKind   `json:"kind"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string     `json:"endTimeUnixNano"`
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	Status            *status    `json:"status,omitempty"`
+}
+
+type status struct {
+	Message string `json:"message,omitempty"`
+	Code    int    `json:"code"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+func newKeyValue(a Attribute) keyValue {
+	kv := keyValue{Key: a.Key}
+	switch v := a.Value.(type) {
+	case string:
+		kv.Value.StringValue = &v
+	case int64:
+		s := strconv.FormatInt(v, 10)
+		kv.Value.IntValue = &s
+	case bool:
+		kv.Value.BoolValue = &v
+	default:
+		s := fmt.Sprintf("%v", v)
+		kv.Value.StringValue = &s
+	}
+
+	return kv
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// newExportRequest returns OTLP export request of spans of the service
+func newExportRequest(serviceName string, spans []*Span) *exportRequest {
+	out := make([]otlpSpan, 0, len(spans))
+	for _, s := range spans {
+		span := otlpSpan{
+			TraceID:           hex.EncodeToString(s.traceID[:]),
+			SpanID:            hex.EncodeToString(s.spanID[:]),
+			Name:              s.name,
+			Kind:              s.kind,
+			StartTimeUnixNano: unixNano(s.start),
+			EndTimeUnixNano:   unixNano(s.end),
+		}
+		if s.parentID != [8]byte{} {
+			span.ParentSpanID = hex.EncodeToString(s.parentID[:])
+		}
+		for _, a := range s.attrs {
+			span.Attributes = append(span.Attributes, newKeyValue(a))
+		}
+		if s.err != "" {
+			span.Status = &status{Code: statusCodeError, Message: s.err}
+		}
+		out = append(out, span)
+	}
+
+	return &exportRequest{
+		ResourceSpans: []resourceSpans{
+			{
+				Resource:   resource{Attributes: []keyValue{newKeyValue(String("service.name", serviceName))}},
+				ScopeSpans: []scopeSpans{{Scope: scope{Name: scopeName}, Spans: out}},
+			},
+		},
+	}
+}
+
+// newExporter returns the function posting spans to OTLP/HTTP receiver of the endpoint in JSON encoding
+func newExporter(endpoint string, headers map[string]string, serviceName string, timeout time.Duration) func([]*Span) error {
+	client := &http.Client{Timeout: timeout}
+	return func(spans []*Span) error {
+		b, err := json.Marshal(newExportRequest(serviceName, spans))
+		if err != nil {
+			return fmt.Errorf("failed to marshal spans with error: %+v", err)
+		}
+		req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+			return fmt.Errorf("receiver responded with status %s: %s", resp.Status, string(body))
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+
+		return nil
+	}
+}
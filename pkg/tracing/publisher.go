@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// publisher traces messages published for a BMP message as children of the span of the BMP message
+type publisher struct {
+	pub.Publisher
+	ctx context.Context
+}
+
+func (p *publisher) start(msgType int) *Span {
+	_, s := Start(p.ctx, "publish", KindProducer, Int("gobmp.message_type", msgType))
+	if topic, ok := bmp.MessageTopic(msgType); ok {
+		s.SetAttributes(String("messaging.destination.name", topic))
+	}
+	return s
+}
+
+func (p *publisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	s := p.start(msgType)
+	err := p.Publisher.PublishMessage(msgType, msgHash, msg)
+	s.SetError(err)
+	s.End()
+
+	return err
+}
+
+func (p *publisher) PublishValue(msgType int, msgHash []byte, v interface{}) error {
+	s := p.start(msgType)
+	err := pub.PublishValue(p.Publisher, msgType, msgHash, v)
+	s.SetError(err)
+	s.End()
+
+	return err
+}
+
+// NewPublisher returns the publisher tracing publishing of messages as children of the span of ctx, the
+// publisher is returned as it is when ctx does not carry a sampled span
+func NewPublisher(ctx context.Context, p pub.Publisher) pub.Publisher {
+	if SpanFromContext(ctx) == nil {
+		return p
+	}
+	return &publisher{Publisher: p, ctx: ctx}
+}
@@ -0,0 +1,319 @@
+package tracing
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// SpanKind is the kind of span as defined by OpenTelemetry
+type SpanKind int
+
+// Kinds of spans, values are the values of OTLP SpanKind
+const (
+	KindInternal SpanKind = 1
+	KindServer   SpanKind = 2
+	KindProducer SpanKind = 4
+)
+
+// Config defines OTLP exporter of spans and sampling of traces
+type Config struct {
+	// Endpoint is the URL of OTLP/HTTP traces receiver, /v1/traces is appended to the URL without path
+	Endpoint string
+	// Headers are added to export requests, for example authentication headers required by the receiver
+	Headers map[string]string
+	// ServiceName is service.name resource attribute of spans, empty name selects "gobmp"
+	ServiceName string
+	// SampleRatio is the fraction of BMP messages traced, spans of a traced message are all exported
+	SampleRatio float64
+	// BatchSize is the maximum number of spans exported by a request, 0 selects 512
+	BatchSize int
+	// QueueSize is the number of ended spans waiting for export, spans ended when the queue is full are dropped,
+	// 0 selects 2048
+	QueueSize int
+	// Interval is the period between exports of spans waiting in the queue, 0 selects 5 seconds
+	Interval time.Duration
+	// Timeout is the timeout of export requests, 0 selects 10 seconds
+	Timeout time.Duration
+}
+
+// Attribute is a key and a value of span attribute, values are strings, integers or booleans
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// String returns attribute with a string value
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Int returns attribute with an integer value
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: int64(value)}
+}
+
+// Bool returns attribute with a boolean value
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Span is an operation of a traced BMP message, methods of nil Span do nothing, nil Span is returned when
+// tracing is disabled or the message is not sampled.
+type Span struct {
+	tracer   *Tracer
+	traceID  [16]byte
+	spanID   [8]byte
+	parentID [8]byte
+	name     string
+	kind     SpanKind
+	start    time.Time
+	end      time.Time
+	attrs    []Attribute
+	err      string
+}
+
+// SetAttributes adds attributes to the span
+func (s *Span) SetAttributes(attrs ...Attribute) {
+	if s == nil {
+		return
+	}
+	s.attrs = append(s.attrs, attrs...)
+}
+
+// SetError sets the status of the span to error, nil err does not change the status
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.err = err.Error()
+}
+
+// End ends the span and queues it for export, the span must not be used after it is ended
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.end = time.Now()
+	s.tracer.queueSpan(s)
+}
+
+// TraceID returns hex encoded trace id of the span
+func (s *Span) TraceID() string {
+	if s == nil {
+		return ""
+	}
+	return hex.EncodeToString(s.traceID[:])
+}
+
+type spanKey struct{}
+
+// notSampled is stored in contexts of messages which are not sampled, so spans of their later operations
+// are not started as new traces
+var notSampled = &Span{}
+
+// SpanFromContext returns the span of the context, nil is returned when the context does not carry a sampled span
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	s, _ := ctx.Value(spanKey{}).(*Span)
+	if s == notSampled {
+		return nil
+	}
+	return s
+}
+
+// Tracer samples traces of BMP messages and exports their spans to OTLP/HTTP receiver in batches
+type Tracer struct {
+	config   Config
+	endpoint string
+	export   func([]*Span) error
+	queue    chan *Span
+	stop     chan struct{}
+	done     chan struct{}
+	dropped  atomic.Uint64
+	sync.Mutex
+	rand *rand.Rand
+}
+
+// Start starts the span of the operation as a child of the span of ctx, the span starts a new trace when ctx
+// does not carry a span and the trace is sampled. The returned context carries the span.
+func (t *Tracer) Start(ctx context.Context, name string, kind SpanKind, attrs ...Attribute) (context.Context, *Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	parent, _ := ctx.Value(spanKey{}).(*Span)
+	if parent == notSampled {
+		return ctx, nil
+	}
+	s := &Span{tracer: t, name: name, kind: kind, attrs: attrs}
+	t.Lock()
+	if parent == nil {
+		if t.rand.Float64() >= t.config.SampleRatio {
+			t.Unlock()
+			return context.WithValue(ctx, spanKey{}, notSampled), nil
+		}
+		binary.BigEndian.PutUint64(s.traceID[:8], t.rand.Uint64())
+		binary.BigEndian.PutUint64(s.traceID[8:], t.rand.Uint64())
+	} else {
+		s.traceID = parent.traceID
+		s.parentID = parent.spanID
+	}
+	binary.BigEndian.PutUint64(s.spanID[:], t.rand.Uint64())
+	t.Unlock()
+	s.start = time.Now()
+
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+// Dropped returns the number of spans dropped because the export queue was full or their export failed
+func (t *Tracer) Dropped() uint64 {
+	return t.dropped.Load()
+}
+
+func (t *Tracer) queueSpan(s *Span) {
+	select {
+	case t.queue <- s:
+	default:
+		t.dropped.Add(1)
+	}
+}
+
+func (t *Tracer) run() {
+	defer close(t.done)
+	ticker := time.NewTicker(t.config.Interval)
+	defer ticker.Stop()
+	batch := make([]*Span, 0, t.config.BatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := t.export(batch); err != nil {
+			t.dropped.Add(uint64(len(batch)))
+			glog.Warningf("failed to export %d spans to %s with error: %+v", len(batch), t.endpoint, err)
+		}
+		batch = batch[:0]
+	}
+	for {
+		select {
+		case s := <-t.queue:
+			if batch = append(batch, s); len(batch) >= t.config.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-t.stop:
+			// Spans ended before the tracer is stopped are exported
+			for {
+				select {
+				case s := <-t.queue:
+					if batch = append(batch, s); len(batch) >= t.config.BatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// Stop exports spans waiting in the queue and stops the tracer, spans ended after the tracer is stopped are dropped
+func (t *Tracer) Stop() {
+	close(t.stop)
+	<-t.done
+}
+
+// NewTracer instantiates a new instance of a tracer exporting spans to OTLP/HTTP receiver of c.Endpoint,
+// sizes and periods which are not set select their defaults
+func NewTracer(c *Config) (*Tracer, error) {
+	if c == nil || c.Endpoint == "" {
+		return nil, fmt.Errorf("OTLP endpoint is not specified")
+	}
+	config := *c
+	if config.SampleRatio < 0 || config.SampleRatio > 1 {
+		return nil, fmt.Errorf("invalid sample ratio %v, the ratio must be between 0 and 1", config.SampleRatio)
+	}
+	if config.BatchSize < 0 || config.QueueSize < 0 || config.Interval < 0 || config.Timeout < 0 {
+		return nil, fmt.Errorf("invalid batch size %d, queue size %d, interval %s or timeout %s of OTLP exporter",
+			config.BatchSize, config.QueueSize, config.Interval, config.Timeout)
+	}
+	if config.ServiceName == "" {
+		config.ServiceName = "gobmp"
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = 512
+	}
+	if config.QueueSize == 0 {
+		config.QueueSize = 2048
+	}
+	if config.Interval == 0 {
+		config.Interval = 5 * time.Second
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+	endpoint, err := tracesURL(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	var seed [8]byte
+	if _, err := crand.Read(seed[:]); err != nil {
+		return nil, fmt.Errorf("failed to seed trace ids with error: %+v", err)
+	}
+	t := &Tracer{
+		config:   config,
+		endpoint: endpoint,
+		queue:    make(chan *Span, config.QueueSize),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+		rand:     rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(seed[:])))),
+	}
+	t.export = newExporter(endpoint, config.Headers, config.ServiceName, config.Timeout)
+	go t.run()
+
+	return t, nil
+}
+
+// tracesURL returns the URL spans are exported to, OTLP/HTTP path of traces is appended to the URL without path
+func tracesURL(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid OTLP endpoint %s with error: %+v", endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return "", fmt.Errorf("invalid OTLP endpoint %s, the endpoint must be http or https URL", endpoint)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = "/v1/traces"
+	}
+
+	return u.String(), nil
+}
+
+var defaultTracer atomic.Pointer[Tracer]
+
+// SetDefault sets the tracer starting spans of Start, nil t disables tracing
+func SetDefault(t *Tracer) {
+	defaultTracer.Store(t)
+}
+
+// Start starts the span of the operation with the default tracer, ctx and nil span are returned when tracing is disabled
+func Start(ctx context.Context, name string, kind SpanKind, attrs ...Attribute) (context.Context, *Span) {
+	t := defaultTracer.Load()
+	if t == nil {
+		return ctx, nil
+	}
+	return t.Start(ctx, name, kind, attrs...)
+}
@@ -0,0 +1,205 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testReceiver struct {
+	sync.Mutex
+	requests []exportRequest
+	headers  []http.Header
+}
+
+func (r *testReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	e := exportRequest{}
+	if req.URL.Path != "/v1/traces" || json.NewDecoder(req.Body).Decode(&e) != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.Lock()
+	r.requests = append(r.requests, e)
+	r.headers = append(r.headers, req.Header)
+	r.Unlock()
+}
+
+func (r *testReceiver) spans() []otlpSpan {
+	r.Lock()
+	defer r.Unlock()
+	var spans []otlpSpan
+	for _, e := range r.requests {
+		for _, rs := range e.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+	}
+	return spans
+}
+
+type testPublisher struct{}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if msgType == bmp.PeerStateChangeMsg {
+		return errors.New("broker is not available")
+	}
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+func TestTracer(t *testing.T) {
+	r := &testReceiver{}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+	tracer, err := NewTracer(&Config{Endpoint: srv.URL, Headers: map[string]string{"Authorization": "Bearer token"}, SampleRatio: 1})
+	if err != nil {
+		t.Fatalf("failed to create tracer with error: %+v", err)
+	}
+	ctx, receive := tracer.Start(context.Background(), "bmp.receive", KindServer, String("bmp.router", "192.0.2.1"))
+	ctx, parse := tracer.Start(ctx, "bmp.parse", KindInternal, Int("bmp.length", 90))
+	SetDefault(tracer)
+	p := NewPublisher(ctx, &testPublisher{})
+	if err := p.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte("{}")); err != nil {
+		t.Fatalf("failed to publish message with error: %+v", err)
+	}
+	if err := p.PublishMessage(bmp.PeerStateChangeMsg, nil, []byte("{}")); err == nil {
+		t.Fatalf("expected publishing to fail")
+	}
+	SetDefault(nil)
+	parse.End()
+	receive.End()
+	tracer.Stop()
+
+	spans := r.spans()
+	if len(spans) != 4 {
+		t.Fatalf("expected 4 spans but got %d: %+v", len(spans), spans)
+	}
+	if h := r.headers[0]; h.Get("Authorization") != "Bearer token" || h.Get("Content-Type") != "application/json" {
+		t.Errorf("unexpected headers of export request %+v", h)
+	}
+	if name := r.requests[0].ResourceSpans[0].Resource.Attributes[0]; name.Key != "service.name" || *name.Value.StringValue != "gobmp" {
+		t.Errorf("unexpected resource attribute %+v", name)
+	}
+	byName := make(map[string][]otlpSpan)
+	for _, s := range spans {
+		if s.TraceID != receive.TraceID() {
+			t.Errorf("span %s has trace id %s, expected %s", s.Name, s.TraceID, receive.TraceID())
+		}
+		byName[s.Name] = append(byName[s.Name], s)
+	}
+	root, child := byName["bmp.receive"][0], byName["bmp.parse"][0]
+	if root.ParentSpanID != "" || root.Kind != KindServer || child.ParentSpanID != root.SpanID {
+		t.Errorf("unexpected parent of spans %+v and %+v", root, child)
+	}
+	if len(child.Attributes) != 1 || *child.Attributes[0].Value.IntValue != "90" {
+		t.Errorf("unexpected attributes %+v of parse span", child.Attributes)
+	}
+	publish := byName["publish"]
+	if len(publish) != 2 || publish[0].ParentSpanID != child.SpanID || publish[0].Kind != KindProducer {
+		t.Fatalf("unexpected publish spans %+v", publish)
+	}
+	if publish[0].Status != nil || publish[1].Status == nil || publish[1].Status.Code != statusCodeError {
+		t.Errorf("unexpected status of publish spans %+v and %+v", publish[0].Status, publish[1].Status)
+	}
+	if topic, _ := bmp.MessageTopic(bmp.UnicastPrefixV4Msg); len(publish[0].Attributes) != 2 ||
+		*publish[0].Attributes[1].Value.StringValue != topic {
+		t.Errorf("unexpected attributes %+v of publish span", publish[0].Attributes)
+	}
+}
+
+func TestTracerSampling(t *testing.T) {
+	r := &testReceiver{}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+	tracer, err := NewTracer(&Config{Endpoint: srv.URL, SampleRatio: 0})
+	if err != nil {
+		t.Fatalf("failed to create tracer with error: %+v", err)
+	}
+	ctx, s := tracer.Start(nil, "bmp.receive", KindServer)
+	if s != nil {
+		t.Fatalf("expected message not to be sampled")
+	}
+	// Operations of messages not sampled do not start new traces
+	if _, s := tracer.Start(ctx, "bmp.parse", KindInternal); s != nil || SpanFromContext(ctx) != nil {
+		t.Errorf("expected operation of message not sampled not to be traced")
+	}
+	if p := NewPublisher(ctx, &testPublisher{}); p == nil {
+		t.Errorf("expected publisher")
+	} else if _, ok := p.(*publisher); ok {
+		t.Errorf("expected publisher not to be traced")
+	}
+	s.End()
+	tracer.Stop()
+	if spans := r.spans(); len(spans) != 0 {
+		t.Errorf("expected no spans but got %+v", spans)
+	}
+	if ctx, s := Start(context.Background(), "bmp.receive", KindServer); s != nil || ctx != context.Background() {
+		t.Errorf("expected no span when tracing is disabled")
+	}
+}
+
+func TestNewTracer(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *Config
+		endpoint string
+		fail     bool
+	}{
+		{
+			name:     "endpoint without path",
+			config:   &Config{Endpoint: "http://localhost:4318"},
+			endpoint: "http://localhost:4318/v1/traces",
+		},
+		{
+			name:     "endpoint with path",
+			config:   &Config{Endpoint: "https://collector.example.com/otlp/v1/traces", SampleRatio: 0.5},
+			endpoint: "https://collector.example.com/otlp/v1/traces",
+		},
+		{
+			name:   "no endpoint",
+			config: &Config{},
+			fail:   true,
+		},
+		{
+			name:   "invalid scheme",
+			config: &Config{Endpoint: "grpc://localhost:4317"},
+			fail:   true,
+		},
+		{
+			name:   "invalid sample ratio",
+			config: &Config{Endpoint: "http://localhost:4318", SampleRatio: 1.5},
+			fail:   true,
+		},
+		{
+			name:   "invalid queue size",
+			config: &Config{Endpoint: "http://localhost:4318", QueueSize: -1},
+			fail:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracer, err := NewTracer(tt.config)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("supposed to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			defer tracer.Stop()
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if tracer.endpoint != tt.endpoint {
+				t.Errorf("expected endpoint %s but got %s", tt.endpoint, tracer.endpoint)
+			}
+		})
+	}
+}
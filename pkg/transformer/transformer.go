@@ -0,0 +1,257 @@
+package transformer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"text/template"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/events"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// Rule defines a transformation applied to messages of listed types, all messages when the list is empty.
+// Templates use Go text/template syntax and are executed with the message's fields as data, for example
+// {{ .peer_ip }}. Operations are applied in the following order: DropIf, Rename, Set, Remove.
+type Rule struct {
+	MessageTypes []string `json:"message_types,omitempty"`
+	// DropIf is a template, the message is dropped when it evaluates to "true"
+	DropIf string `json:"drop_if,omitempty"`
+	// Rename maps existing field names to new field names
+	Rename map[string]string `json:"rename,omitempty"`
+	// Set maps field names to templates computing their values, when the result is a valid json value,
+	// for example a number or a boolean, the value is stored as is, otherwise it is stored as a string.
+	Set map[string]string `json:"set,omitempty"`
+	// Remove is a list of fields to remove
+	Remove   []string `json:"remove,omitempty"`
+	msgTypes map[int]bool
+	dropIf   *template.Template
+	set      map[string]*template.Template
+}
+
+// Config defines the structure of transformation rules file
+type Config struct {
+	Rules []*Rule `json:"rules"`
+}
+
+var funcs = template.FuncMap{
+	"contains":  strings.Contains,
+	"hasPrefix": strings.HasPrefix,
+	"hasSuffix": strings.HasSuffix,
+	"lower":     strings.ToLower,
+	"upper":     strings.ToUpper,
+	"inPrefix":  inPrefix,
+}
+
+// inPrefix returns true if addr is covered by prefix in CIDR notation
+func inPrefix(addr interface{}, prefix string) bool {
+	ip := net.ParseIP(fmt.Sprint(addr))
+	if ip == nil {
+		return false
+	}
+	_, n, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return false
+	}
+
+	return n.Contains(ip)
+}
+
+func (r *Rule) init(i int) error {
+	r.msgTypes = make(map[int]bool)
+	for _, n := range r.MessageTypes {
+		t, ok := bmp.MessageTypeByName(n)
+		if !ok {
+			return fmt.Errorf("rule %d refers to unknown message type %q", i, n)
+		}
+		r.msgTypes[t] = true
+	}
+	var err error
+	if r.DropIf != "" {
+		if r.dropIf, err = template.New("drop_if").Funcs(funcs).Parse(r.DropIf); err != nil {
+			return fmt.Errorf("rule %d has invalid drop_if template with error: %+v", i, err)
+		}
+	}
+	r.set = make(map[string]*template.Template)
+	for f, t := range r.Set {
+		if r.set[f], err = template.New(f).Funcs(funcs).Parse(t); err != nil {
+			return fmt.Errorf("rule %d has invalid template of field %q with error: %+v", i, f, err)
+		}
+	}
+
+	return nil
+}
+
+func execute(t *template.Template, m map[string]interface{}) (string, error) {
+	var b bytes.Buffer
+	if err := t.Execute(&b, m); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
+
+// apply returns the message with the rule applied to a copy of its fields, so m is not changed when a template of
+// the rule fails. It returns false if the message must be dropped.
+func (r *Rule) apply(msgType int, m map[string]interface{}) (map[string]interface{}, bool, error) {
+	if len(r.msgTypes) != 0 && !r.msgTypes[msgType] {
+		return m, true, nil
+	}
+	if r.dropIf != nil {
+		s, err := execute(r.dropIf, m)
+		if err != nil {
+			return m, true, err
+		}
+		if s == "true" {
+			return m, false, nil
+		}
+	}
+	c := make(map[string]interface{}, len(m)+len(r.set))
+	for f, v := range m {
+		c[f] = v
+	}
+	for o, n := range r.Rename {
+		if v, ok := c[o]; ok {
+			delete(c, o)
+			c[n] = v
+		}
+	}
+	// Values are computed before any of them is set, so templates see the fields of the original message
+	values := make(map[string]interface{}, len(r.set))
+	for f, t := range r.set {
+		s, err := execute(t, c)
+		if err != nil {
+			return m, true, err
+		}
+		var v interface{}
+		d := json.NewDecoder(strings.NewReader(s))
+		d.UseNumber()
+		if err := d.Decode(&v); err != nil || d.More() {
+			v = s
+		}
+		values[f] = normalize(v)
+	}
+	for f, v := range values {
+		c[f] = v
+	}
+	for _, f := range r.Remove {
+		delete(c, f)
+	}
+
+	return c, true, nil
+}
+
+// normalize replaces json numbers with int64, uint64 or float64 values, so templates can compare
+// them with numeric constants, integers keep their exact values.
+func normalize(v interface{}) interface{} {
+	switch o := v.(type) {
+	case json.Number:
+		if i, err := o.Int64(); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(o.String(), 10, 64); err == nil {
+			return u
+		}
+		if f, err := o.Float64(); err == nil {
+			return f
+		}
+	case map[string]interface{}:
+		for k := range o {
+			o[k] = normalize(o[k])
+		}
+	case []interface{}:
+		for i := range o {
+			o[i] = normalize(o[i])
+		}
+	}
+
+	return v
+}
+
+// Reloader is implemented by publishers of NewTransformer, Reload replaces transformation rules applied to
+// messages published after it returns
+type Reloader interface {
+	Reload(config *Config) error
+}
+
+type transformer struct {
+	publisher pub.Publisher
+	rules     atomic.Pointer[[]*Rule]
+}
+
+func (t *transformer) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	d := json.NewDecoder(bytes.NewReader(msg))
+	d.UseNumber()
+	var m map[string]interface{}
+	if err := d.Decode(&m); err != nil {
+		return fmt.Errorf("failed to decode message of type %d for transformation with error: %+v", msgType, err)
+	}
+	normalize(m)
+	for i, r := range *t.rules.Load() {
+		applied, publish, err := r.apply(msgType, m)
+		if err != nil {
+			// A failing rule should not prevent publishing, fields of the message are kept as they were before the
+			// rule and the following rules are applied
+			glog.Errorf("failed to apply transformation rule %d to message of type %d with error: %+v", i, msgType, err)
+			events.Report(events.CodeTransformFailed, "", "failed to apply transformation rule %d to message of type %d with error: %+v", i, msgType, err)
+			continue
+		}
+		if !publish {
+			return nil
+		}
+		m = applied
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return t.publisher.PublishMessage(msgType, msgHash, b)
+}
+
+func (t *transformer) Reload(config *Config) error {
+	for i, r := range config.Rules {
+		if err := r.init(i); err != nil {
+			return err
+		}
+	}
+	t.rules.Store(&config.Rules)
+
+	return nil
+}
+
+func (t *transformer) Stop() {
+	t.publisher.Stop()
+}
+
+// LoadConfig reads transformation rules from json file
+func LoadConfig(file string) (*Config, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal transformation rules file %s with error: %+v", file, err)
+	}
+
+	return c, nil
+}
+
+// NewTransformer returns a publisher applying transformation rules to messages before passing them
+// to the wrapped publisher.
+func NewTransformer(publisher pub.Publisher, config *Config) (pub.Publisher, error) {
+	t := &transformer{publisher: publisher}
+	if err := t.Reload(config); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
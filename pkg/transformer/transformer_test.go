@@ -0,0 +1,173 @@
+package transformer
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	msgs [][]byte
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.msgs = append(p.msgs, msg)
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+func TestTransformer(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []*Rule
+		msgType int
+		msg     string
+		expect  map[string]interface{}
+		fail    bool
+	}{
+		{
+			name:    "drop matching message",
+			rules:   []*Rule{{DropIf: `{{ eq .prefix_len 32 }}`}},
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     `{"prefix":"10.0.0.1","prefix_len":32}`,
+			expect:  nil,
+		},
+		{
+			name:    "keep not matching message",
+			rules:   []*Rule{{DropIf: `{{ eq .prefix_len 32 }}`}},
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     `{"prefix":"10.0.0.0","prefix_len":24}`,
+			expect:  map[string]interface{}{"prefix": "10.0.0.0", "prefix_len": json.Number("24")},
+		},
+		{
+			name:    "rule of other message type",
+			rules:   []*Rule{{MessageTypes: []string{"peer"}, DropIf: `true`}},
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     `{"prefix_len":24}`,
+			expect:  map[string]interface{}{"prefix_len": json.Number("24")},
+		},
+		{
+			name: "rename, set and remove",
+			rules: []*Rule{{
+				Rename: map[string]string{"nexthop": "next_hop"},
+				Set: map[string]string{
+					"internal":  `{{ inPrefix .peer_ip "192.168.0.0/16" }}`,
+					"site":      `{{ if hasPrefix .router_ip "10.1." }}east{{ else }}west{{ end }}`,
+					"len_plus1": `{{ .prefix_len }}1`,
+				},
+				Remove: []string{"router_hash"},
+			}},
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     `{"router_ip":"10.1.0.1","router_hash":"abc","peer_ip":"192.168.1.1","nexthop":"10.0.0.2","prefix_len":24,"med":18446744073709551615}`,
+			expect: map[string]interface{}{
+				"router_ip":  "10.1.0.1",
+				"peer_ip":    "192.168.1.1",
+				"next_hop":   "10.0.0.2",
+				"prefix_len": json.Number("24"),
+				"med":        json.Number("18446744073709551615"),
+				"internal":   true,
+				"site":       "east",
+				"len_plus1":  json.Number("241"),
+			},
+		},
+		{
+			name: "failing rule not applied",
+			rules: []*Rule{
+				{
+					Rename: map[string]string{"nexthop": "next_hop"},
+					Set:    map[string]string{"internal": `{{ inPrefix .peer_ip 16 }}`},
+					Remove: []string{"router_hash"},
+				},
+				{Set: map[string]string{"site": `east`}},
+			},
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     `{"router_hash":"abc","peer_ip":"192.168.1.1","nexthop":"10.0.0.2"}`,
+			expect: map[string]interface{}{
+				"router_hash": "abc",
+				"peer_ip":     "192.168.1.1",
+				"nexthop":     "10.0.0.2",
+				"site":        "east",
+			},
+		},
+		{
+			name:  "invalid template",
+			rules: []*Rule{{DropIf: `{{ eq .prefix_len }`}},
+			fail:  true,
+		},
+		{
+			name:  "unknown message type",
+			rules: []*Rule{{MessageTypes: []string{"bogus"}}},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testPublisher{}
+			tr, err := NewTransformer(p, &Config{Rules: tt.rules})
+			if err != nil && !tt.fail {
+				t.Fatalf("expected to succeed but failed with error: %+v", err)
+			}
+			if err == nil && tt.fail {
+				t.Fatalf("expected to fail but succeeded")
+			}
+			if err != nil {
+				return
+			}
+			if err := tr.PublishMessage(tt.msgType, nil, []byte(tt.msg)); err != nil {
+				t.Fatalf("failed to publish message with error: %+v", err)
+			}
+			if tt.expect == nil {
+				if len(p.msgs) != 0 {
+					t.Fatalf("expected message to be dropped but it was published: %s", string(p.msgs[0]))
+				}
+				return
+			}
+			if len(p.msgs) != 1 {
+				t.Fatalf("expected message to be published")
+			}
+			var m map[string]interface{}
+			d := json.NewDecoder(bytes.NewReader(p.msgs[0]))
+			d.UseNumber()
+			if err := d.Decode(&m); err != nil {
+				t.Fatalf("failed to decode published message with error: %+v", err)
+			}
+			if !reflect.DeepEqual(m, tt.expect) {
+				t.Errorf("expected message %+v but got %+v", tt.expect, m)
+			}
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	p := &testPublisher{}
+	tr, err := NewTransformer(p, &Config{Rules: []*Rule{{Set: map[string]string{"site": "east"}}}})
+	if err != nil {
+		t.Fatalf("failed to initialize transformer with error: %+v", err)
+	}
+	r, ok := tr.(Reloader)
+	if !ok {
+		t.Fatalf("expected transformer to implement Reloader")
+	}
+	if err := r.Reload(&Config{Rules: []*Rule{{Set: map[string]string{"site": "{{ .missing"}}}}); err == nil {
+		t.Fatalf("expected invalid rule to fail")
+	}
+	if err := tr.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(`{"prefix_len":24}`)); err != nil {
+		t.Fatalf("failed to publish message with error: %+v", err)
+	}
+	if err := r.Reload(&Config{Rules: []*Rule{{Remove: []string{"prefix_len"}}}}); err != nil {
+		t.Fatalf("failed to reload rules with error: %+v", err)
+	}
+	if err := tr.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(`{"prefix_len":24}`)); err != nil {
+		t.Fatalf("failed to publish message with error: %+v", err)
+	}
+	expect := []string{`{"prefix_len":24,"site":"east"}`, `{}`}
+	for i, msg := range p.msgs {
+		if string(msg) != expect[i] {
+			t.Errorf("expected message %s but got %s", expect[i], string(msg))
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package verbosity
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/churn"
+	"github.com/sbezverk/gobmp/pkg/memory"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// Verbosity modes of peers
+const (
+	// ModeDetailed is the mode of peers with messages published per prefix
+	ModeDetailed = "detailed"
+	// ModeSummarized is the mode of peers with prefix messages summarized into churn_summary messages
+	ModeSummarized = "summarized"
+)
+
+// prefixMessageTypes lists types of messages carrying announcements and withdrawals of prefixes
+var prefixMessageTypes = map[int]bool{
+	bmp.UnicastPrefixMsg:   true,
+	bmp.UnicastPrefixV4Msg: true,
+	bmp.UnicastPrefixV6Msg: true,
+	bmp.L3VPNMsg:           true,
+	bmp.L3VPNV4Msg:         true,
+	bmp.L3VPNV6Msg:         true,
+	bmp.EVPNMsg:            true,
+	bmp.SRPolicyMsg:        true,
+	bmp.SRPolicyV4Msg:      true,
+	bmp.SRPolicyV6Msg:      true,
+	bmp.FlowspecMsg:        true,
+	bmp.FlowspecV4Msg:      true,
+	bmp.FlowspecV6Msg:      true,
+}
+
+// PeerInfo identifies the peer of verbosity_change and churn_summary messages
+type PeerInfo struct {
+	RouterIP   string `json:"router_ip"`
+	RouterHash string `json:"router_hash,omitempty"`
+	PeerIP     string `json:"peer_ip"`
+	PeerHash   string `json:"peer_hash,omitempty"`
+	PeerASN    uint32 `json:"peer_asn,omitempty"`
+	PeerRD     string `json:"peer_rd,omitempty"`
+}
+
+// Change defines verbosity_change message published when the peer switches between verbosity modes
+type Change struct {
+	PeerInfo
+	// Mode is ModeSummarized or ModeDetailed
+	Mode string `json:"mode"`
+	// Rate is the rate of prefix messages per second of the peer causing the change
+	Rate      float64 `json:"rate"`
+	Threshold int     `json:"threshold"`
+	Timestamp string  `json:"timestamp"`
+}
+
+// Summary defines churn_summary message published every interval for peers in summarized mode in place of
+// prefix messages
+type Summary struct {
+	PeerInfo
+	Start         string `json:"start"`
+	End           string `json:"end"`
+	Announcements uint64 `json:"announcements"`
+	Withdrawals   uint64 `json:"withdrawals"`
+	// Messages counts summarized prefix messages per type of messages
+	Messages  map[string]uint64 `json:"messages"`
+	Timestamp string            `json:"timestamp"`
+}
+
+func init() {
+	if err := bmp.RegisterMessageSchema(bmp.VerbosityChangeMsg, Change{}); err != nil {
+		panic(err)
+	}
+	if err := bmp.RegisterMessageSchema(bmp.ChurnSummaryMsg, Summary{}); err != nil {
+		panic(err)
+	}
+}
+
+// peer stores prefix messages of the peer counted within the current interval, churn counts announcements and
+// withdrawals of the summary
+type peer struct {
+	info    PeerInfo
+	count   uint64
+	summary *Summary
+	churn   churn.Counter
+}
+
+type adaptive struct {
+	sync.Mutex
+	publisher pub.Publisher
+	threshold int
+	interval  time.Duration
+	// limit is the number of prefix messages of a peer within the interval switching the peer to summarized mode
+	limit uint64
+	start time.Time
+	peers map[string]*peer
+	now   func() time.Time
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+func (a *adaptive) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if !prefixMessageTypes[msgType] {
+		return a.publisher.PublishMessage(msgType, msgHash, msg)
+	}
+	m, err := churn.DecodePrefix(msg)
+	if err != nil {
+		glog.Errorf("failed to decode prefix message for adaptive verbosity with error: %+v", err)
+		return a.publisher.PublishMessage(msgType, msgHash, msg)
+	}
+	// End-of-RIB markers are always published, consumers rely on them to complete initial synchronization
+	if m.IsEOR {
+		return a.publisher.PublishMessage(msgType, msgHash, msg)
+	}
+	summarized, c := a.prefix(msgType, m)
+	if c != nil {
+		a.publishChange(c)
+	}
+	if summarized {
+		return nil
+	}
+
+	return a.publisher.PublishMessage(msgType, msgHash, msg)
+}
+
+// prefix counts the prefix message of the peer, it returns true when the message is summarized and the change
+// when the message switched the peer to summarized mode
+func (a *adaptive) prefix(msgType int, m *churn.Prefix) (bool, *Change) {
+	now := a.now()
+	k := m.RouterIP + "|" + m.PeerHash
+	if m.PeerHash == "" {
+		k = m.RouterIP + "|" + m.PeerRD + "|" + m.PeerIP
+	}
+	a.Lock()
+	defer a.Unlock()
+	p, ok := a.peers[k]
+	if !ok {
+		p = &peer{
+			info: PeerInfo{
+				RouterIP:   m.RouterIP,
+				RouterHash: m.RouterHash,
+				PeerIP:     m.PeerIP,
+				PeerHash:   m.PeerHash,
+				PeerASN:    m.PeerASN,
+				PeerRD:     m.PeerRD,
+			},
+		}
+		a.peers[k] = p
+	}
+	p.count++
+	var c *Change
+	if p.summary == nil {
+		if p.count <= a.limit {
+			return false, nil
+		}
+		p.summary = a.newSummary(p, now)
+		elapsed := now.Sub(a.start)
+		if elapsed < time.Second {
+			elapsed = time.Second
+		}
+		c = a.newChange(p, ModeSummarized, float64(p.count)/elapsed.Seconds())
+		glog.Warningf("prefix messages of peer %s of router %s exceeded %d per second, switching to summarized mode",
+			m.PeerIP, m.RouterIP, a.threshold)
+	}
+	p.summary.Messages[bmp.MessageTypeName(msgType)]++
+	p.churn.Count(m)
+
+	return true, c
+}
+
+func (a *adaptive) newSummary(p *peer, start time.Time) *Summary {
+	p.churn = churn.Counter{}
+	return &Summary{
+		PeerInfo: p.info,
+		Start:    start.UTC().Format(time.RFC3339),
+		Messages: make(map[string]uint64),
+	}
+}
+
+func (a *adaptive) newChange(p *peer, mode string, rate float64) *Change {
+	return &Change{
+		PeerInfo:  p.info,
+		Mode:      mode,
+		Rate:      rate,
+		Threshold: a.threshold,
+		Timestamp: a.now().UTC().Format(time.RFC3339),
+	}
+}
+
+// flush returns summaries of the ended interval and changes of peers switching back to detailed mode with
+// the rate of the interval not exceeding the threshold, peers without prefix messages in the interval are removed.
+// When all is true, summaries are returned without changes and all peers are removed.
+func (a *adaptive) flush(all bool) ([]*Summary, []*Change) {
+	now := a.now()
+	a.Lock()
+	defer a.Unlock()
+	var summaries []*Summary
+	var changes []*Change
+	rate := func(p *peer) float64 {
+		elapsed := now.Sub(a.start)
+		if elapsed < time.Second {
+			elapsed = time.Second
+		}
+		return float64(p.count) / elapsed.Seconds()
+	}
+	for k, p := range a.peers {
+		if p.summary != nil {
+			s := p.summary
+			s.End = now.UTC().Format(time.RFC3339)
+			s.Announcements, s.Withdrawals = p.churn.Announcements, p.churn.Withdrawals
+			summaries = append(summaries, s)
+			switch {
+			case all:
+			case p.count <= a.limit:
+				changes = append(changes, a.newChange(p, ModeDetailed, rate(p)))
+				p.summary = nil
+			default:
+				p.summary = a.newSummary(p, now)
+			}
+		}
+		if all || (p.summary == nil && p.count == 0) {
+			delete(a.peers, k)
+			continue
+		}
+		p.count = 0
+	}
+	a.start = now
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].RouterIP != summaries[j].RouterIP {
+			return summaries[i].RouterIP < summaries[j].RouterIP
+		}
+		return summaries[i].PeerIP < summaries[j].PeerIP
+	})
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].RouterIP != changes[j].RouterIP {
+			return changes[i].RouterIP < changes[j].RouterIP
+		}
+		return changes[i].PeerIP < changes[j].PeerIP
+	})
+
+	return summaries, changes
+}
+
+func (a *adaptive) publishSummary(s *Summary) {
+	s.Timestamp = a.now().UTC().Format(time.RFC3339)
+	b, err := json.Marshal(s)
+	if err != nil {
+		glog.Errorf("failed to marshal churn summary with error: %+v", err)
+		return
+	}
+	if err := a.publisher.PublishMessage(bmp.ChurnSummaryMsg, []byte(s.RouterIP+s.PeerIP), b); err != nil {
+		glog.Errorf("failed to publish churn summary with error: %+v", err)
+	}
+}
+
+func (a *adaptive) publishChange(c *Change) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		glog.Errorf("failed to marshal verbosity change with error: %+v", err)
+		return
+	}
+	if err := a.publisher.PublishMessage(bmp.VerbosityChangeMsg, []byte(c.RouterIP+c.PeerIP), b); err != nil {
+		glog.Errorf("failed to publish verbosity change with error: %+v", err)
+	}
+}
+
+func (a *adaptive) publish(all bool) {
+	summaries, changes := a.flush(all)
+	for _, s := range summaries {
+		a.publishSummary(s)
+	}
+	for _, c := range changes {
+		glog.Infof("prefix messages of peer %s of router %s are back to detailed mode", c.PeerIP, c.RouterIP)
+		a.publishChange(c)
+	}
+}
+
+func (a *adaptive) run() {
+	defer close(a.done)
+	t := time.NewTicker(a.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			a.publish(false)
+		case <-a.stop:
+			return
+		}
+	}
+}
+
+// Stop publishes summaries of peers in summarized mode before stopping publisher
+func (a *adaptive) Stop() {
+	close(a.stop)
+	<-a.done
+	a.publish(true)
+	a.publisher.Stop()
+}
+
+// MemoryUsage returns estimated memory used by counters of prefix messages per peer
+func (a *adaptive) MemoryUsage() memory.Usage {
+	a.Lock()
+	defer a.Unlock()
+	c := memory.NewCounter("verbosity")
+	for k, p := range a.peers {
+		b := uint64(unsafe.Sizeof(k)+unsafe.Sizeof(p)+unsafe.Sizeof(*p)) + uint64(len(k)) + memory.MapEntryOverhead
+		if p.summary != nil {
+			b += uint64(unsafe.Sizeof(*p.summary)) + uint64(len(p.summary.Messages))*memory.MapEntryOverhead
+		}
+		c.Add(p.info.RouterIP, p.info.PeerIP, b)
+	}
+
+	return c.Usage()
+}
+
+// NewAdaptive returns a publisher switching a peer to summarized mode when prefix messages of the peer exceed
+// threshold per second within the interval. Prefix messages of peers in summarized mode are not passed to publisher,
+// they are counted into churn_summary message published every interval. The peer switches back to detailed mode
+// after the interval its prefix messages do not exceed the threshold, both changes are published as
+// verbosity_change messages.
+func NewAdaptive(publisher pub.Publisher, threshold int, interval time.Duration) (pub.Publisher, error) {
+	if threshold <= 0 {
+		return nil, fmt.Errorf("invalid churn summary threshold %d", threshold)
+	}
+	if interval < time.Second {
+		return nil, fmt.Errorf("invalid churn summary interval %s, at least 1s is required", interval)
+	}
+	a := newAdaptive(publisher, threshold, interval, time.Now)
+	go a.run()
+
+	return a, nil
+}
+
+func newAdaptive(publisher pub.Publisher, threshold int, interval time.Duration, now func() time.Time) *adaptive {
+	return &adaptive{
+		publisher: publisher,
+		threshold: threshold,
+		interval:  interval,
+		limit:     uint64(threshold) * uint64(interval/time.Second),
+		start:     now(),
+		peers:     make(map[string]*peer),
+		now:       now,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
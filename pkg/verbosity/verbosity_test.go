@@ -0,0 +1,161 @@
+package verbosity
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testPublisher struct {
+	prefixes  int
+	changes   []*Change
+	summaries []*Summary
+}
+
+func (p *testPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	switch msgType {
+	case bmp.VerbosityChangeMsg:
+		c := &Change{}
+		if err := json.Unmarshal(msg, c); err != nil {
+			return err
+		}
+		p.changes = append(p.changes, c)
+	case bmp.ChurnSummaryMsg:
+		s := &Summary{}
+		if err := json.Unmarshal(msg, s); err != nil {
+			return err
+		}
+		p.summaries = append(p.summaries, s)
+	default:
+		p.prefixes++
+	}
+	return nil
+}
+
+func (p *testPublisher) Stop() {}
+
+type testClock struct {
+	t time.Time
+}
+
+func (c *testClock) now() time.Time {
+	return c.t
+}
+
+func prefix(peer string, action string) []byte {
+	return []byte(`{"action":"` + action + `","router_ip":"10.0.0.1","peer_ip":"` + peer + `","peer_asn":65001,"prefix":"10.1.0.0"}`)
+}
+
+func TestAdaptive(t *testing.T) {
+	p := &testPublisher{}
+	clock := &testClock{t: time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)}
+	// Up to 20 prefix messages per peer within 10 seconds interval are published
+	a := newAdaptive(p, 2, 10*time.Second, clock.now)
+	publish := func(msgType int, msg []byte) {
+		if err := a.PublishMessage(msgType, nil, msg); err != nil {
+			t.Fatalf("failed to publish message with error: %+v", err)
+		}
+	}
+	for i := 0; i < 30; i++ {
+		action := "add"
+		if i%3 == 0 {
+			action = "del"
+		}
+		publish(bmp.UnicastPrefixV4Msg, prefix("192.168.0.1", action))
+	}
+	for i := 0; i < 5; i++ {
+		publish(bmp.UnicastPrefixV4Msg, prefix("192.168.0.2", "add"))
+	}
+	// End-of-RIB and non prefix messages of the summarized peer are published
+	publish(bmp.UnicastPrefixV4Msg, []byte(`{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.168.0.1","is_eor":true}`))
+	publish(bmp.PeerStateChangeMsg, []byte(`{"action":"add","router_ip":"10.0.0.1","remote_ip":"192.168.0.1"}`))
+	if p.prefixes != 27 {
+		t.Errorf("expected 27 published messages but got %d", p.prefixes)
+	}
+	if len(p.changes) != 1 || p.changes[0].Mode != ModeSummarized || p.changes[0].PeerIP != "192.168.0.1" ||
+		p.changes[0].Threshold != 2 {
+		t.Fatalf("expected peer 192.168.0.1 to switch to summarized mode but got %+v", p.changes)
+	}
+	if len(p.summaries) != 0 {
+		t.Errorf("expected no summaries before the end of the interval but got %d", len(p.summaries))
+	}
+
+	// The peer keeps exceeding the threshold in the first interval
+	clock.t = clock.t.Add(10 * time.Second)
+	a.publish(false)
+	if len(p.summaries) != 1 {
+		t.Fatalf("expected 1 summary but got %d", len(p.summaries))
+	}
+	s := p.summaries[0]
+	if s.PeerIP != "192.168.0.1" || s.PeerASN != 65001 || s.Announcements != 7 || s.Withdrawals != 3 ||
+		s.Messages["unicast_prefix_v4"] != 10 || s.Start != "2026-10-14T12:00:00Z" || s.End != "2026-10-14T12:00:10Z" {
+		t.Errorf("unexpected summary %+v", s)
+	}
+	for i := 0; i < 25; i++ {
+		publish(bmp.UnicastPrefixV4Msg, prefix("192.168.0.1", "add"))
+	}
+	clock.t = clock.t.Add(10 * time.Second)
+	a.publish(false)
+	if len(p.summaries) != 2 || p.summaries[1].Announcements != 25 || len(p.changes) != 1 {
+		t.Fatalf("expected the peer to stay in summarized mode but got summaries %d and changes %d",
+			len(p.summaries), len(p.changes))
+	}
+
+	// The peer switches back to detailed mode after the interval below the threshold
+	for i := 0; i < 5; i++ {
+		publish(bmp.UnicastPrefixV4Msg, prefix("192.168.0.1", "add"))
+	}
+	clock.t = clock.t.Add(10 * time.Second)
+	a.publish(false)
+	if len(p.summaries) != 3 || p.summaries[2].Announcements != 5 {
+		t.Fatalf("expected the last summary of 5 announcements but got %+v", p.summaries)
+	}
+	if len(p.changes) != 2 || p.changes[1].Mode != ModeDetailed || p.changes[1].Rate != 0.5 {
+		t.Fatalf("expected peer 192.168.0.1 to switch back to detailed mode but got %+v", p.changes)
+	}
+	published := p.prefixes
+	publish(bmp.UnicastPrefixV4Msg, prefix("192.168.0.1", "add"))
+	if p.prefixes != published+1 {
+		t.Errorf("expected prefix message to be published in detailed mode")
+	}
+
+	// Idle peers are removed
+	clock.t = clock.t.Add(10 * time.Second)
+	a.publish(false)
+	clock.t = clock.t.Add(10 * time.Second)
+	a.publish(false)
+	if len(a.peers) != 0 {
+		t.Errorf("expected idle peers to be removed but got %d", len(a.peers))
+	}
+}
+
+func TestAdaptiveStop(t *testing.T) {
+	p := &testPublisher{}
+	a, err := NewAdaptive(p, 1, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to initialize adaptive verbosity with error: %+v", err)
+	}
+	for i := 0; i < 100; i++ {
+		if err := a.PublishMessage(bmp.EVPNMsg, nil, prefix("192.168.0.1", "add")); err != nil {
+			t.Fatalf("failed to publish message with error: %+v", err)
+		}
+	}
+	a.Stop()
+	if p.prefixes != 60 || len(p.summaries) != 1 || p.summaries[0].Messages["evpn"] != 40 {
+		t.Errorf("expected 60 published messages and summary of 40 messages but got %d and %+v", p.prefixes, p.summaries)
+	}
+	if len(p.changes) != 1 {
+		t.Errorf("expected only the change to summarized mode but got %+v", p.changes)
+	}
+}
+
+func TestInvalidAdaptive(t *testing.T) {
+	if _, err := NewAdaptive(&testPublisher{}, 0, time.Minute); err == nil {
+		t.Errorf("expected zero threshold to fail")
+	}
+	if _, err := NewAdaptive(&testPublisher{}, 10, time.Millisecond); err == nil {
+		t.Errorf("expected interval shorter than a second to fail")
+	}
+}